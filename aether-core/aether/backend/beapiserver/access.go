@@ -0,0 +1,222 @@
+// Backend > BackendAPI > Access
+// This file handles the access control of the backend API: giving access tokens to frontends, and checking every request against its token, nonce and the rate limit of its frontend.
+
+package beapiserver
+
+import (
+	pb "aether-core/aether/protos/beapi"
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/nonces"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+/*
+How this works:
+
+A frontend first calls RequestBackendAccess with its public key, a timestamp and a signed nonce (see services/nonces/signed.go), and no access token. If the frontend is allowed, we give it an access token bound to its public key, which expires after BackendAPIAccessTokenExpiry.
+
+Every request after that carries the access token, the public key it was given to, a timestamp and a fresh signed nonce. The nonce goes through the same clock skew and replay checks as the nonces of the remotes, and the number of nonces a frontend has used within the last minute is what its requests per minute limit counts.
+
+Which frontends are allowed: the admin frontend always is. If the backend API is served publicly, only the admin frontend and the ones in AllowedFrontends are. If it is served only locally, any frontend on this machine is, since anything that can reach a local port is already on the machine.
+*/
+
+const (
+	statusUnauthorised    = 401 // HTTP 401 Unauthorised
+	statusForbidden       = 403 // HTTP 403 Forbidden
+	statusTooManyRequests = 429 // HTTP 429 Too Many Requests
+)
+
+var (
+	issuedTokens   = accessTokens{tokens: make(map[string]accessToken)}
+	frontendNonces = nonces.NewRemotesNonces()
+)
+
+type accessToken struct {
+	publicKey string
+	expiry    int64
+}
+
+type accessTokens struct {
+	lock   sync.Mutex
+	tokens map[string]accessToken
+}
+
+func (at *accessTokens) issue(pk string) (string, error) {
+	raw := make([]byte, 32)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Access token generation failed. Error: %v", err))
+	}
+	token := hex.EncodeToString(raw)
+	now := clock.Now()
+	at.lock.Lock()
+	defer at.lock.Unlock()
+	// Drop the expired tokens while we're here, so that the list doesn't grow forever.
+	for k, _ := range at.tokens {
+		if at.tokens[k].expiry < now.Unix() {
+			delete(at.tokens, k)
+		}
+	}
+	at.tokens[token] = accessToken{
+		publicKey: pk,
+		expiry:    now.Add(globals.BackendConfig.GetBackendAPIAccessTokenExpiry()).Unix(),
+	}
+	return token, nil
+}
+
+func (at *accessTokens) check(token, pk string) error {
+	at.lock.Lock()
+	defer at.lock.Unlock()
+	t, ok := at.tokens[token]
+	if !ok {
+		return errors.New("This access token was not given by this backend. Please request access first.")
+	}
+	if t.expiry < clock.Now().Unix() {
+		delete(at.tokens, token)
+		return errors.New("This access token has expired. Please request access again.")
+	}
+	if t.publicKey != pk {
+		return errors.New("This access token was given to a different frontend.")
+	}
+	return nil
+}
+
+// frontendAllowed returns whether the frontend with the given public key can use this backend, and how many requests per minute it can make.
+func frontendAllowed(pk string) (bool, int) {
+	defaultRpm := globals.BackendConfig.GetDefaultFrontendRequestsPerMinute()
+	if len(pk) == 0 {
+		return false, 0
+	}
+	if pk == globals.BackendConfig.GetAdminFrontendPublicKey() {
+		return true, defaultRpm
+	}
+	for _, fe := range globals.BackendConfig.GetAllowedFrontends() {
+		if fe.PublicKey != pk {
+			continue
+		}
+		if fe.RequestsPerMinute > 0 {
+			return true, fe.RequestsPerMinute
+		}
+		return true, defaultRpm
+	}
+	if !globals.BackendConfig.GetBackendAPIPublic() {
+		return true, defaultRpm
+	}
+	return false, 0
+}
+
+// isAdminFrontend checks whether the frontend is the admin. If the backend has no admin frontend set and it's served only locally, every local frontend counts as the admin, as it was before access control.
+func isAdminFrontend(pk string) bool {
+	adminPk := globals.BackendConfig.GetAdminFrontendPublicKey()
+	if len(adminPk) == 0 {
+		return !globals.BackendConfig.GetBackendAPIPublic()
+	}
+	return pk == adminPk
+}
+
+// checkRequesterId checks the signature and the nonce of the requester id, and returns the status code and the error to respond with if the request should be declined.
+func checkRequesterId(rid *pb.RequesterId, rpm int) (int32, error) {
+	nonceStr, valid := nonces.VerifySignedNonce(rid.GetNonce(), rid.GetAccessToken(), rid.GetTimestamp(), rid.GetPublicKey())
+	if !valid {
+		return statusUnauthorised, errors.New("The nonce is missing or its signature does not match the public key.")
+	}
+	err := frontendNonces.CheckWithinRate(rid.GetPublicKey(), nonceStr, rid.GetTimestamp(), rpm)
+	if err == nonces.ErrRateLimited {
+		return statusTooManyRequests, err
+	}
+	if err != nil {
+		return statusUnauthorised, err
+	}
+	return 0, nil
+}
+
+// grantAccess checks an access request, and if the frontend is allowed, gives it an access token.
+func grantAccess(rid *pb.RequesterId, status *pb.Status) string {
+	allowed, rpm := frontendAllowed(rid.GetPublicKey())
+	if !allowed {
+		decline(status, statusForbidden, errors.New("This frontend is not allowed to use this backend."), rid)
+		return ""
+	}
+	code, err := checkRequesterId(rid, rpm)
+	if err != nil {
+		decline(status, code, err, rid)
+		return ""
+	}
+	token, err2 := issuedTokens.issue(rid.GetPublicKey())
+	if err2 != nil {
+		status.StatusCode = 500 // HTTP 500 Internal Server Error
+		status.ErrorMessage = err2.Error()
+		return ""
+	}
+	logging.Logf(1, "We gave an access token to a frontend. PK: %v", rid.GetPublicKey())
+	status.StatusCode = 200
+	return token
+}
+
+// requestAllowed checks whether the request can be served. This is where we take a look at the access token, nonce, PK, timestamp and the rate limit of the frontend. If the request is declined, the status is filled in with the reason.
+func requestAllowed(req interface{}, status *pb.Status) bool {
+	var rid *pb.RequesterId
+	adminOnly := false
+	switch r := req.(type) {
+	case *pb.BoardsRequest:
+		rid = r.GetRequesterId()
+	case *pb.ThreadsRequest:
+		rid = r.GetRequesterId()
+	case *pb.PostsRequest:
+		rid = r.GetRequesterId()
+	case *pb.VotesRequest:
+		rid = r.GetRequesterId()
+	case *pb.KeysRequest:
+		rid = r.GetRequesterId()
+	case *pb.TruststatesRequest:
+		rid = r.GetRequesterId()
+	case *pb.BoardThreadsCountRequest:
+		rid = r.GetRequesterId()
+	case *pb.ThreadPostsCountRequest:
+		rid = r.GetRequesterId()
+	case *pb.EntityHistoryRequest:
+		rid = r.GetRequesterId()
+	case *pb.DirectMessagesRequest:
+		rid = r.GetRequesterId()
+	case *pb.MintedContentPayload:
+		rid = r.GetRequesterId()
+	case *pb.ConnectToRemoteRequest:
+		rid = r.GetRequesterId()
+		adminOnly = true
+	default:
+		decline(status, statusForbidden, errors.New("This request type is not served."), nil)
+		return false
+	}
+	allowed, rpm := frontendAllowed(rid.GetPublicKey())
+	if !allowed {
+		decline(status, statusForbidden, errors.New("This frontend is not allowed to use this backend."), rid)
+		return false
+	}
+	if adminOnly && !isAdminFrontend(rid.GetPublicKey()) {
+		decline(status, statusForbidden, errors.New("This request can only be made by the admin frontend."), rid)
+		return false
+	}
+	err := issuedTokens.check(rid.GetAccessToken(), rid.GetPublicKey())
+	if err != nil {
+		decline(status, statusUnauthorised, err, rid)
+		return false
+	}
+	code, err2 := checkRequesterId(rid, rpm)
+	if err2 != nil {
+		decline(status, code, err2, rid)
+		return false
+	}
+	return true
+}
+
+func decline(status *pb.Status, code int32, err error, rid *pb.RequesterId) {
+	logging.Logf(2, "We declined a backend API request. Code: %v, PK: %v, Reason: %v", code, rid.GetPublicKey(), err)
+	status.StatusCode = code
+	status.ErrorMessage = err.Error()
+}
@@ -0,0 +1,204 @@
+package beapiserver
+
+// These test the access control of the backend API: the access tokens, the allowlist of the frontends, the admin-only requests and the rate limits. The backend config is a bare one of the test's own, and the time runs on the virtual clock.
+
+import (
+	pb "aether-core/aether/protos/beapi"
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/nonces"
+	"aether-core/aether/services/signaturing"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+type testFrontend struct {
+	key   *ed25519.PrivateKey
+	pk    string
+	token string
+}
+
+func newTestFrontend(t *testing.T) *testFrontend {
+	key, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("The frontend key could not be created. Error: %v", err)
+	}
+	return &testFrontend{key: key, pk: signaturing.MarshalPublicKey(key.Public().(ed25519.PublicKey))}
+}
+
+// rid is the requester id of the frontend's next request, with a fresh signed nonce.
+func (fe *testFrontend) rid(t *testing.T) *pb.RequesterId {
+	ts := clock.Now().Unix()
+	n, err := nonces.NewSignedNonce(fe.token, ts, fe.key)
+	if err != nil {
+		t.Fatalf("The nonce could not be signed. Error: %v", err)
+	}
+	return &pb.RequesterId{AccessToken: fe.token, Nonce: n, PublicKey: fe.pk, Timestamp: ts}
+}
+
+func (fe *testFrontend) requestAccess(t *testing.T) *pb.Status {
+	status := &pb.Status{}
+	fe.token = grantAccess(fe.rid(t), status)
+	return status
+}
+
+// setupAccess gives the backend a config with the given admin and allowed frontends, and fresh tokens and nonces.
+func setupAccess(t *testing.T, public bool, admin *testFrontend, allowed ...configstore.AllowedFrontend) (*clock.Virtual, func()) {
+	v := clock.NewVirtual(time.Now())
+	restoreClock := clock.Use(v)
+	priorConfig := globals.BackendConfig
+	priorTokens := issuedTokens.tokens
+	priorNonces := frontendNonces.NoncesMap
+	cfg := &configstore.BackendConfig{
+		Initialised:                      true,
+		BackendAPIPublic:                 public,
+		AllowedFrontends:                 allowed,
+		BackendAPIAccessTokenExpiry:      10 * time.Minute,
+		DefaultFrontendRequestsPerMinute: 5,
+	}
+	if admin != nil {
+		cfg.AdminFrontendPublicKey = admin.pk
+	}
+	globals.BackendConfig = cfg
+	issuedTokens.tokens = make(map[string]accessToken)
+	frontendNonces.NoncesMap = nonces.NewRemotesNonces().NoncesMap
+	return v, func() {
+		restoreClock()
+		globals.BackendConfig = priorConfig
+		issuedTokens.tokens = priorTokens
+		frontendNonces.NoncesMap = priorNonces
+	}
+}
+
+// Tests
+
+func TestGrantAccess_Success(t *testing.T) {
+	admin := newTestFrontend(t)
+	_, teardown := setupAccess(t, true, admin)
+	defer teardown()
+	status := admin.requestAccess(t)
+	if status.StatusCode != 200 || len(admin.token) == 0 {
+		t.Fatalf("The admin frontend should have been given an access token. Status: %#v", status)
+	}
+	if err := issuedTokens.check(admin.token, admin.pk); err != nil {
+		t.Errorf("The access token should be valid for the frontend it was given to. Error: %v", err)
+	}
+	other := newTestFrontend(t)
+	if err := issuedTokens.check(admin.token, other.pk); err == nil {
+		t.Errorf("The access token should not be valid for another frontend.")
+	}
+	st := &pb.Status{}
+	if !requestAllowed(&pb.BoardsRequest{RequesterId: admin.rid(t)}, st) {
+		t.Errorf("A request with the access token should be served. Status: %#v", st)
+	}
+}
+
+func TestGrantAccess_NotAllowed_Fail(t *testing.T) {
+	_, teardown := setupAccess(t, true, newTestFrontend(t))
+	defer teardown()
+	stranger := newTestFrontend(t)
+	status := stranger.requestAccess(t)
+	if status.StatusCode != statusForbidden || len(stranger.token) != 0 {
+		t.Errorf("A frontend that isn't allowed should not be given an access token. Status: %#v, Token: %v", status, stranger.token)
+	}
+}
+
+func TestAccessToken_Expired_Fail(t *testing.T) {
+	admin := newTestFrontend(t)
+	v, teardown := setupAccess(t, true, admin)
+	defer teardown()
+	admin.requestAccess(t)
+	v.Advance(9 * time.Minute)
+	if err := issuedTokens.check(admin.token, admin.pk); err != nil {
+		t.Fatalf("The access token should be valid until it expires. Error: %v", err)
+	}
+	v.Advance(2 * time.Minute)
+	status := &pb.Status{}
+	if requestAllowed(&pb.BoardsRequest{RequesterId: admin.rid(t)}, status) {
+		t.Errorf("A request with an expired access token should not be served.")
+	}
+	if status.StatusCode != statusUnauthorised {
+		t.Errorf("An expired access token should be declined as unauthorised. Status: %#v", status)
+	}
+	// Asking again gives a new one.
+	if s := admin.requestAccess(t); s.StatusCode != 200 {
+		t.Errorf("The frontend should be able to request access again after its token expired. Status: %#v", s)
+	}
+}
+
+func TestFrontendAllowed_Allowlist_Success(t *testing.T) {
+	admin := newTestFrontend(t)
+	custom := newTestFrontend(t)
+	plain := newTestFrontend(t)
+	stranger := newTestFrontend(t)
+	allowlist := []configstore.AllowedFrontend{{PublicKey: custom.pk, RequestsPerMinute: 20}, {PublicKey: plain.pk}}
+	cases := []struct {
+		name    string
+		public  bool
+		pk      string
+		allowed bool
+		rpm     int
+	}{
+		{"admin", true, admin.pk, true, 5},
+		{"allowlisted with its own rate", true, custom.pk, true, 20},
+		{"allowlisted with the default rate", true, plain.pk, true, 5},
+		{"stranger on a public backend", true, stranger.pk, false, 0},
+		{"stranger on a local backend", false, stranger.pk, true, 5},
+		{"no public key", false, "", false, 0},
+	}
+	for _, c := range cases {
+		_, teardown := setupAccess(t, c.public, admin, allowlist...)
+		allowed, rpm := frontendAllowed(c.pk)
+		teardown()
+		if allowed != c.allowed || rpm != c.rpm {
+			t.Errorf("Case: %v, Expected: %v, %v, Got: %v, %v", c.name, c.allowed, c.rpm, allowed, rpm)
+		}
+	}
+}
+
+func TestRequestAllowed_ConnectToRemote_AdminOnly_Fail(t *testing.T) {
+	admin := newTestFrontend(t)
+	plain := newTestFrontend(t)
+	_, teardown := setupAccess(t, true, admin, configstore.AllowedFrontend{PublicKey: plain.pk})
+	defer teardown()
+	admin.requestAccess(t)
+	plain.requestAccess(t)
+	status := &pb.Status{}
+	if requestAllowed(&pb.ConnectToRemoteRequest{RequesterId: plain.rid(t)}, status) {
+		t.Errorf("A frontend that isn't the admin should not be able to connect the backend to a remote.")
+	}
+	if status.StatusCode != statusForbidden {
+		t.Errorf("The request should be declined as forbidden. Status: %#v", status)
+	}
+	// The same frontend can still read.
+	if st := (&pb.Status{}); !requestAllowed(&pb.BoardsRequest{RequesterId: plain.rid(t)}, st) {
+		t.Errorf("The allowlisted frontend should still be able to make the other requests. Status: %#v", st)
+	}
+	if st := (&pb.Status{}); !requestAllowed(&pb.ConnectToRemoteRequest{RequesterId: admin.rid(t)}, st) {
+		t.Errorf("The admin frontend should be able to connect the backend to a remote. Status: %#v", st)
+	}
+}
+
+func TestRequestAllowed_RateLimited_Fail(t *testing.T) {
+	admin := newTestFrontend(t)
+	v, teardown := setupAccess(t, true, admin)
+	defer teardown()
+	admin.requestAccess(t) // This is the first request of the minute.
+	for i := 1; i < 5; i++ {
+		if st := (&pb.Status{}); !requestAllowed(&pb.BoardsRequest{RequesterId: admin.rid(t)}, st) {
+			t.Fatalf("A request within the per minute limit should be served. Request: %d, Status: %#v", i, st)
+		}
+	}
+	status := &pb.Status{}
+	if requestAllowed(&pb.BoardsRequest{RequesterId: admin.rid(t)}, status) || status.StatusCode != statusTooManyRequests {
+		t.Errorf("A request over the per minute limit should be declined. Status: %#v", status)
+	}
+	v.Advance(1 * time.Minute)
+	if st := (&pb.Status{}); !requestAllowed(&pb.BoardsRequest{RequesterId: admin.rid(t)}, st) {
+		t.Errorf("The frontend should be served again the next minute. Status: %#v", st)
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"aether-core/aether/services/logging"
 	// "google.golang.org/grpc"
 	// "google.golang.org/grpc/reflection"
-	"fmt"
 	"golang.org/x/net/context"
 )
 
@@ -22,17 +21,15 @@ type server struct{}
 func (s *server) RequestBackendAccess(
 	ctx context.Context, req *pb.AccessRequest) (
 	*pb.AccessResponse, error) {
-	fmt.Println("We received an access request.")
-	resp := pb.AccessResponse{}
-	resp.Status = &pb.Status{StatusCode: 200}
+	resp := pb.AccessResponse{Status: &pb.Status{}}
+	resp.AccessToken = grantAccess(req.GetRequesterId(), resp.Status)
 	return &resp, nil
 }
 
 func (s *server) GetBoards(
 	ctx context.Context, req *pb.BoardsRequest) (*pb.BoardsResponse, error) {
 	resp := pb.BoardsResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	// The reason we use Get.. is that it can push our null check to the end, so we only have to nil check it once.
@@ -66,8 +63,7 @@ func (s *server) GetBoards(
 func (s *server) GetThreads(
 	ctx context.Context, req *pb.ThreadsRequest) (*pb.ThreadsResponse, error) {
 	resp := pb.ThreadsResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	// The reason we use Get.. is that it can push our null check to the end, so we only have to nil check it once. If we end up with a primitive type at the end of the chain, we don't have to nil check.
@@ -99,8 +95,7 @@ func (s *server) GetThreads(
 func (s *server) GetPosts(
 	ctx context.Context, req *pb.PostsRequest) (*pb.PostsResponse, error) {
 	resp := pb.PostsResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	// The reason we use Get.. is that it can push our null check to the end, so we only have to nil check it once.
@@ -130,8 +125,7 @@ func (s *server) GetPosts(
 func (s *server) GetVotes(
 	ctx context.Context, req *pb.VotesRequest) (*pb.VotesResponse, error) {
 	resp := pb.VotesResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	// The reason we use Get.. is that it can push our null check to the end, so we only have to nil check it once.
@@ -165,8 +159,7 @@ func (s *server) GetVotes(
 func (s *server) GetKeys(
 	ctx context.Context, req *pb.KeysRequest) (*pb.KeysResponse, error) {
 	resp := pb.KeysResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	// The reason we use Get.. is that it can push our null check to the end, so we only have to nil check it once.
@@ -200,8 +193,7 @@ func (s *server) GetKeys(
 func (s *server) GetTruststates(
 	ctx context.Context, req *pb.TruststatesRequest) (*pb.TruststatesResponse, error) {
 	resp := pb.TruststatesResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	// The reason we use Get.. is that it can push our null check to the end, so we only have to nil check it once.
@@ -230,36 +222,40 @@ func (s *server) GetTruststates(
 	return &resp, nil
 }
 
-// checkRequestAllowed checks whether the given type is allowed. This is our future point of check gate where we take a look at nonces, PKs, timestamps, and whether the backend is too busy to respond. This is unnecessary when the backend is local, but useful if one wants to provide it as a public service. TODO FUTURE. (This is needed to have publicly accesible backends serving multiple frontends)
-func requestAllowed(req interface{}) bool {
-	switch req.(type) {
-	case *pb.BoardsRequest:
-		return true
-	case *pb.ThreadsRequest:
-		return true
-	case *pb.PostsRequest:
-		return true
-	case *pb.VotesRequest:
-		return true
-	case *pb.KeysRequest:
-		return true
-	case *pb.TruststatesRequest:
-		return true
-	case *pb.MintedContentPayload:
-		return true
-	case *pb.ConnectToRemoteRequest:
-		return true
-	default:
-		return false
+// GetDirectMessages returns the direct messages to or from a user. The bodies are sealed, the frontend opens them with the local user's key.
+func (s *server) GetDirectMessages(
+	ctx context.Context, req *pb.DirectMessagesRequest) (*pb.DirectMessagesResponse, error) {
+	resp := pb.DirectMessagesResponse{Status: &pb.Status{}}
+	if !requestAllowed(req, resp.Status) {
+		return &resp, nil
+	}
+	start := api.Timestamp(req.GetFilters().GetLastRefTimeRange().GetStart())
+	end := api.Timestamp(req.GetFilters().GetLastRefTimeRange().GetEnd())
+	fps := req.GetFilters().GetFingerprints().GetFingerprints()
+	apiFps := []api.Fingerprint{}
+	for key, _ := range fps {
+		apiFps = append(apiFps, api.Fingerprint(fps[key]))
 	}
+	result, _ := persistence.Read("directmessages", apiFps, []string{}, start, end, true,
+		&persistence.OptionalReadInputs{
+			DirectMessage_Recipient: req.GetFilters().GetGraphFilters().GetTarget(),
+			AllProvables_Owner:      req.GetFilters().GetGraphFilters().GetOwner(),
+			AllProvables_Limit:      int(req.GetFilters().GetGraphFilters().GetLimit()),
+			AllProvables_Offset:     int(req.GetFilters().GetGraphFilters().GetOffset()),
+		})
+	for key, _ := range result.DirectMessages {
+		r := result.DirectMessages[key].Protobuf()
+		resp.DirectMessages = append(resp.DirectMessages, &r)
+	}
+	resp.Status.StatusCode = 200
+	return &resp, nil
 }
 
 // GetBoardThreadsCount counts all threads in a board without a time limit. This will give you all stuff that is available in the local memory. The results of this is not cached, so it will directly hit the backend. If you do this in too many parallel threads, the backend will start to send you 'connection refused's as you exceed the maximum number of simultaneous connections. Be careful with that.
 func (s *server) GetBoardThreadsCount(
 	ctx context.Context, req *pb.BoardThreadsCountRequest) (*pb.BoardThreadsCountResponse, error) {
-	resp := pb.BoardThreadsCountResponse{Count: 0}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	resp := pb.BoardThreadsCountResponse{Status: &pb.Status{}, Count: 0}
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	ct := persistence.GetBoardThreadsCount(req.Fingerprint)
@@ -272,8 +268,7 @@ func (s *server) GetBoardThreadsCount(
 func (s *server) GetThreadPostsCount(
 	ctx context.Context, req *pb.ThreadPostsCountRequest) (*pb.ThreadPostsCountResponse, error) {
 	resp := pb.ThreadPostsCountResponse{Status: &pb.Status{}, Count: 0}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	ct := persistence.GetThreadPostsCount(req.Fingerprint)
@@ -286,8 +281,7 @@ func (s *server) GetThreadPostsCount(
 func (s *server) SendMintedContent(
 	ctx context.Context, req *pb.MintedContentPayload) (*pb.MintedContentResponse, error) {
 	resp := pb.MintedContentResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	allItems := []interface{}{}
@@ -363,6 +357,18 @@ func (s *server) SendMintedContent(
 		}
 		allItems = append(allItems, interface{}(e))
 	}
+	directMessagesProto := req.GetDirectMessages()
+	for k, _ := range directMessagesProto {
+		e := api.DirectMessage{}
+		e.FillFromProtobuf(*directMessagesProto[k])
+		err2 := api.Verify(api.Provable(&e))
+		if err2 != nil {
+			logging.Logf(1, "Verification of an entity received from the frontend failed. Error: %v", err2)
+			resp.Status.StatusCode = 400 // HTTP 400 Bad Request
+			return &resp, nil
+		}
+		allItems = append(allItems, interface{}(e))
+	}
 	addressesProto := req.GetAddresses()
 	if len(addressesProto) > 0 {
 		logging.LogCrashf("Addresses insert is not yet implemented.")
@@ -391,8 +397,7 @@ func (s *server) SendMintedContent(
 func (s *server) SendConnectToRemoteRequest(
 	ctx context.Context, req *pb.ConnectToRemoteRequest) (*pb.ConnectToRemoteResponse, error) {
 	resp := pb.ConnectToRemoteResponse{Status: &pb.Status{}}
-	if !requestAllowed(req) {
-		resp.Status.StatusCode = 401 // HTTP 401 Unauthorised
+	if !requestAllowed(req, resp.Status) {
 		return &resp, nil
 	}
 	logging.Logf(1, "Backend received a connect request to a remote node. Addr: %#v", req.GetAddress())
@@ -410,6 +415,41 @@ func (s *server) SendConnectToRemoteRequest(
 	return &resp, nil
 }
 
+// GetEntityHistory returns the prior versions of an updated thread or post, oldest first. The current version isn't in it, you can get that one with GetThreads or GetPosts. These are the signed versions as they came from the network, so the frontend can verify each one on its own. Like the counts, this is not cached.
+func (s *server) GetEntityHistory(
+	ctx context.Context, req *pb.EntityHistoryRequest) (*pb.EntityHistoryResponse, error) {
+	resp := pb.EntityHistoryResponse{Status: &pb.Status{}}
+	if !requestAllowed(req, resp.Status) {
+		return &resp, nil
+	}
+	fp := api.Fingerprint(req.GetFingerprint())
+	threads, err := persistence.ReadThreadHistory(fp)
+	if err != nil {
+		resp.Status.StatusCode = 500 // HTTP 500 Internal Server Error
+		resp.Status.ErrorMessage = err.Error()
+		return &resp, nil
+	}
+	for key, _ := range threads {
+		r := threads[key].Protobuf()
+		resp.Threads = append(resp.Threads, &r)
+	}
+	if len(resp.Threads) == 0 {
+		// Fingerprints don't collide across entity types, if it's not a thread it can only be a post.
+		posts, err := persistence.ReadPostHistory(fp)
+		if err != nil {
+			resp.Status.StatusCode = 500 // HTTP 500 Internal Server Error
+			resp.Status.ErrorMessage = err.Error()
+			return &resp, nil
+		}
+		for key, _ := range posts {
+			r := posts[key].Protobuf()
+			resp.Posts = append(resp.Posts, &r)
+		}
+	}
+	resp.Status.StatusCode = 200
+	return &resp, nil
+}
+
 func constructDirectConnectAddress(loc, subloc string, port int) api.Address {
 	subprots := []api.Subprotocol{api.Subprotocol{"c0", 1, 0, []string{"board", "thread", "post", "vote", "key", "truststate"}}}
 	addr, err := create.CreateAddress(api.Location(loc), api.Location(subloc), 4, uint16(port), 2, 1, 1, 1, 0, subprots, 2, 0, 0, "Aether", "")
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"aether-core/aether/backend/dispatch"
+	"aether-core/aether/backend/responsegenerator"
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"fmt"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+	"time"
+)
+
+func init() {
+	var loggingLevel int
+	var start int
+	var end int
+	var exportFile string
+	var importFile string
+	var realms []string
+	cmdExport.Flags().IntVarP(&loggingLevel, "logginglevel", "", 0, "Global logging level of the app.")
+	cmdExport.Flags().IntVarP(&start, "start", "", 0, "The start of the time range to export, as a unix timestamp. Defaults to the beginning of the network head.")
+	cmdExport.Flags().IntVarP(&end, "end", "", 0, "The end of the time range to export, as a unix timestamp. Defaults to now.")
+	cmdExport.Flags().StringVarP(&exportFile, "file", "", "aether-bundle.gz", "The file to write the bundle into.")
+	cmdExport.Flags().StringSliceVarP(&realms, "realm", "", []string{}, "A non-public realm to add to the bundle. Can be given more than once. Only the public realm is exported by default, since anyone who has the file can read every realm in it.")
+	cmdImport.Flags().IntVarP(&loggingLevel, "logginglevel", "", 0, "Global logging level of the app.")
+	cmdImport.Flags().StringVarP(&importFile, "file", "", "aether-bundle.gz", "The bundle file to import.")
+	cmdRoot.AddCommand(cmdExport)
+	cmdRoot.AddCommand(cmdImport)
+}
+
+var cmdExport = &cobra.Command{
+	Use:   "export",
+	Short: "Export a time range of the entities in this node into a bundle file that can be imported on a node without network access.",
+	Long: `Export a time range of the entities in this node into a bundle file that can be imported on a node without network access.
+
+The bundle carries the same signed pages as the caches of this node, so the node importing it will check it the same way it checks the data it gets from this node over the network.
+
+Only the public realm is exported, unless other realms this node serves are named with --realm. Anyone who has the file can read every realm in it.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := EstablishConfigs(cmd)
+		persistence.CreateDatabase()
+		persistence.CheckDatabaseReady()
+		end := time.Now()
+		if flags.bundleEnd.changed {
+			end = time.Unix(int64(flags.bundleEnd.value.(int)), 0)
+		}
+		start := end.Add(-time.Duration(globals.BackendConfig.GetNetworkHeadDays()*24) * time.Hour)
+		if flags.bundleStart.changed {
+			start = time.Unix(int64(flags.bundleStart.value.(int)), 0)
+		}
+		realms := []api.Fingerprint{}
+		if flags.bundleRealms.changed {
+			for _, realm := range flags.bundleRealms.value.([]string) {
+				realms = append(realms, api.Fingerprint(realm))
+			}
+		}
+		bundle, err := responsegenerator.CreateBundle(api.Timestamp(start.Unix()), api.Timestamp(end.Unix()), realms)
+		if err != nil {
+			logging.LogCrash(err)
+		}
+		file := flags.bundleFile.value.(string)
+		err2 := ioutil.WriteFile(file, bundle, 0644)
+		if err2 != nil {
+			logging.LogCrash(fmt.Sprintf("The bundle could not be saved. File: %v, Error: %v", file, err2))
+		}
+		fmt.Printf("The bundle is saved. File: %v, Starts from: %v, Ends at: %v, Non-public realms: %v\n", file, start, end, realms)
+	},
+}
+
+var cmdImport = &cobra.Command{
+	Use:   "import",
+	Short: "Import a bundle file exported from another node.",
+	Long: `Import a bundle file exported from another node.
+
+Every page in the bundle goes through the same checks as the pages that arrive in a sync: the page signature, the entity verification, and the purgatory. Pages that fail are skipped.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := EstablishConfigs(cmd)
+		persistence.CreateDatabase()
+		persistence.CheckDatabaseReady()
+		file := flags.bundleFile.value.(string)
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			logging.LogCrash(fmt.Sprintf("The bundle could not be read. File: %v, Error: %v", file, err))
+		}
+		bundle, err2 := responsegenerator.ReadBundle(data)
+		if err2 != nil {
+			logging.LogCrash(err2)
+		}
+		im, rejected, err3 := dispatch.ImportBundle(bundle)
+		if err3 != nil {
+			logging.LogCrash(err3)
+		}
+		fmt.Printf("The bundle is imported. Pages: %v, Rejected pages: %v\nB: %v, T: %v, P: %v, V: %v, K: %v, TS: %v, A: %v\n", len(bundle.Pages), rejected, im.BoardsReceived, im.ThreadsReceived, im.PostsReceived, im.VotesReceived, im.KeysReceived, im.TruststatesReceived, im.AddressesReceived)
+	},
+}
@@ -41,6 +41,14 @@ type flags struct {
 	adminFePk             flag // string
 	allowLocalhostRemotes flag // string
 	imprint               flag // bool
+	bundleStart           flag // int
+	bundleEnd             flag // int
+	bundleFile            flag // string
+	bundleRealms          flag // []string
+	rotationFile          flag // string
+	swarmScenario         flag // string
+	swarmStart            flag // int
+	swarmReportDir        flag // string
 	// Flags will be all lowercase in terminal input, heads up.
 }
 
@@ -245,6 +253,65 @@ func renderFlags(cmd *cobra.Command) flags {
 	fl.imprint.value = impr
 	fl.imprint.changed = cmd.Flags().Changed("imprint")
 
+	bs, err26 := cmd.Flags().GetInt("start")
+	if err26 != nil && !strings.Contains(
+		err26.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err26)
+	}
+	fl.bundleStart.value = bs
+	fl.bundleStart.changed = cmd.Flags().Changed("start")
+
+	be, err27 := cmd.Flags().GetInt("end")
+	if err27 != nil && !strings.Contains(
+		err27.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err27)
+	}
+	fl.bundleEnd.value = be
+	fl.bundleEnd.changed = cmd.Flags().Changed("end")
+
+	bf, err28 := cmd.Flags().GetString("file")
+	if err28 != nil && !strings.Contains(
+		err28.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err28)
+	}
+	fl.bundleFile.value = bf
+	fl.bundleFile.changed = cmd.Flags().Changed("file")
+	// The rotation statement of rotateca comes in through the same flag.
+	fl.rotationFile.value = bf
+	fl.rotationFile.changed = fl.bundleFile.changed
+
+	ssc, err29 := cmd.Flags().GetString("swarmscenario")
+	if err29 != nil && !strings.Contains(
+		err29.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err29)
+	}
+	fl.swarmScenario.value = ssc
+	fl.swarmScenario.changed = cmd.Flags().Changed("swarmscenario")
+
+	sst, err30 := cmd.Flags().GetInt("swarmstart")
+	if err30 != nil && !strings.Contains(
+		err30.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err30)
+	}
+	fl.swarmStart.value = sst
+	fl.swarmStart.changed = cmd.Flags().Changed("swarmstart")
+
+	srd, err31 := cmd.Flags().GetString("swarmreportdir")
+	if err31 != nil && !strings.Contains(
+		err31.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err31)
+	}
+	fl.swarmReportDir.value = srd
+	fl.swarmReportDir.changed = cmd.Flags().Changed("swarmreportdir")
+
+	br, err32 := cmd.Flags().GetStringSlice("realm")
+	if err32 != nil && !strings.Contains(
+		err32.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err32)
+	}
+	fl.bundleRealms.value = br
+	fl.bundleRealms.changed = cmd.Flags().Changed("realm")
+
 	return fl
 }
 
@@ -257,7 +324,11 @@ func flagsChanged(cmd *cobra.Command) bool {
 			name == "backendapiport" ||
 			name == "backendapipublic" ||
 			name == "adminfeaddr" ||
-			name == "adminfepk"
+			name == "adminfepk" ||
+			name == "start" ||
+			name == "end" ||
+			name == "file" ||
+			name == "realm"
 	}
 	changeChecker := func(flag *pflag.Flag) {
 		if flag.Changed {
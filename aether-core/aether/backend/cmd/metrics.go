@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"aether-core/aether/backend/dispatch"
+	"aether-core/aether/backend/responsegenerator"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/metricsexport"
+)
+
+// startMetricsExporter registers the collectors of the backend and starts serving them at the local /metrics endpoint. This only runs if the metrics exporter is enabled in the config.
+func startMetricsExporter() {
+	metricsexport.Register(exportNodeMetrics)
+	metricsexport.Register(dispatch.ExportMetrics)
+	metricsexport.Register(responsegenerator.ExportMetrics)
+	go metricsexport.StartServer(globals.BackendConfig.GetMetricsExporterAddress())
+}
+
+// exportNodeMetrics writes the state of the node that is already kept elsewhere: the bouncer leases, the database and the event horizon.
+func exportNodeMetrics(w *metricsexport.Writer) {
+	b := &globals.BackendTransientConfig.Bouncer
+	leases := []struct {
+		direction string
+		get       func() (int, int)
+	}{
+		{"inbound", b.GetInboundSaturation},
+		{"outbound", b.GetOutboundSaturation},
+		{"ping", b.GetPingSaturation},
+	}
+	for _, l := range leases {
+		used, _ := l.get()
+		w.Gauge("aether_bouncer_leases_used", "Leases given out by the bouncer, by direction.", float64(used), metricsexport.L("direction", l.direction))
+	}
+	for _, l := range leases {
+		_, total := l.get()
+		w.Gauge("aether_bouncer_leases_max", "Maximum leases the bouncer can give out, by direction.", float64(total), metricsexport.L("direction", l.direction))
+	}
+	for _, l := range leases {
+		used, total := l.get()
+		saturation := 0.0
+		if total > 0 {
+			saturation = float64(used) / float64(total)
+		}
+		w.Gauge("aether_bouncer_saturation_ratio", "Leases used over the maximum leases, by direction.", saturation, metricsexport.L("direction", l.direction))
+	}
+	w.Gauge("aether_db_size_megabytes", "Size of the database.", float64(globals.GetDbSize()))
+	w.Gauge("aether_db_max_size_megabytes", "Size the event horizon tries to keep the database under.", float64(globals.BackendConfig.GetMaxDbSizeMb()))
+	w.Gauge("aether_event_horizon_timestamp_seconds", "The event horizon. Entities older than this are deleted from the database.", float64(globals.BackendConfig.GetEventHorizonTimestamp()))
+	w.Gauge("aether_last_cache_generation_timestamp_seconds", "The end of the oldest cache made in the last cache generation.", float64(globals.BackendConfig.GetLastCacheGenerationTimestamp()))
+}
@@ -76,6 +76,7 @@ func selectCmdFunc(str string, plan PlanCommand) func() {
 	} else if str == "reverseopen" {
 		return requestReverseOpen
 	} else {
+		logging.LogCrash(fmt.Sprintf("Unknown swarm plan command: %s", str))
 		return func() {}
 	}
 }
@@ -0,0 +1,452 @@
+// Cmd > Orchestrate > OrchestrateScenarios
+// This is the part of a swarm node that runs the node's steps of a swarm scenario, and saves how they went. The scenario language itself is in backend/swarmtest/scenario.
+
+package cmd
+
+import (
+	"aether-core/aether/backend/dispatch"
+	"aether-core/aether/backend/responsegenerator"
+	"aether-core/aether/backend/swarmtest/scenario"
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/proofofwork"
+	"aether-core/aether/services/signaturing"
+	"errors"
+	"fmt"
+	"github.com/fatih/color"
+	"golang.org/x/crypto/ed25519"
+	"strings"
+	"time"
+)
+
+// How often waitfor and assert look at the database while they wait for their condition.
+const scenarioPollInterval = 2 * time.Second
+
+// The key type the frontend gives to the keys it creates. The backend doesn't have it in its configs, since it doesn't create keys otherwise.
+const scenarioKeyType = "ed25519"
+
+const scenarioReadBatch = 500
+
+type scenarioRunner struct {
+	sc        scenario.Scenario
+	start     time.Time
+	reportDir string
+	report    scenario.NodeReport
+}
+
+// runSwarmScenario starts running the steps of this node in the scenario. The steps run one after the other, each at its time after the start of the scenario. If this node was killed and restarted by the scenario, the steps it already ran are skipped.
+func runSwarmScenario(scenarioLoc string, start int64, reportDir string) {
+	sc, err := scenario.Load(scenarioLoc)
+	if err != nil {
+		logging.LogCrash(err)
+	}
+	me := globals.BackendTransientConfig.AppIdentifier
+	nr, err2 := scenario.ReadNodeReport(reportDir, me)
+	if err2 != nil {
+		logging.LogCrash(err2)
+	}
+	r := scenarioRunner{sc: sc, start: time.Unix(start, 0), reportDir: reportDir, report: nr}
+	steps := sc.StepsFor(me)
+	logging.Logf(1, "This node has %d steps to run in the swarm scenario '%s'. %d of them are already done.", len(steps), sc.Name, len(nr.Results))
+	go r.run(steps)
+}
+
+func (r *scenarioRunner) run(steps []scenario.Step) {
+	for _, st := range steps {
+		if r.report.Done(st.ID) {
+			continue
+		}
+		wait := time.Until(r.start.Add(st.At.Duration))
+		if wait > 0 {
+			time.Sleep(wait)
+		}
+		if globals.BackendTransientConfig.ShutdownInitiated {
+			return
+		}
+		clr := color.New(color.FgYellow)
+		logging.Log(1, clr.Sprintf("Running the swarm scenario step %d (%s) now.", st.ID, st.Verb))
+		res := r.runStep(st)
+		logging.Logf(1, "The swarm scenario step %d (%s) is done. Passed: %v, Detail: %s", st.ID, st.Verb, res.Passed, res.Detail)
+		r.report.Results = append(r.report.Results, res)
+		err := r.report.Save(r.reportDir)
+		if err != nil {
+			logging.Logf(1, "Saving the results of the swarm scenario failed. Error: %v", err)
+		}
+		if st.Verb == "kill" {
+			// This does not return, it exits the process. The coordinator restarts us.
+			shutdown()
+		}
+	}
+}
+
+func (r *scenarioRunner) runStep(st scenario.Step) scenario.StepResult {
+	res := scenario.StepResult{Step: st.ID, Node: st.Node, Verb: st.Verb, Started: time.Now().Unix()}
+	detail, err := r.do(st)
+	res.Ended = time.Now().Unix()
+	res.Passed = err == nil
+	res.Detail = detail
+	if err != nil {
+		res.Detail = err.Error()
+	}
+	return res
+}
+
+func (r *scenarioRunner) do(st scenario.Step) (string, error) {
+	switch st.Verb {
+	case "connect":
+		port, err := r.port(st.Peer)
+		if err != nil {
+			return "", err
+		}
+		addr := constructCallAddress(api.Location("127.0.0.1"), port, 2)
+		if st.Force {
+			err2 := dispatch.Sync(addr, []string{}, nil)
+			if err2 != nil {
+				return "", err2
+			}
+			return fmt.Sprintf("Synced with %s.", st.Peer), nil
+		}
+		addrs := []api.Address{addr}
+		errs := persistence.InsertOrUpdateAddresses(&addrs)
+		if len(errs) > 0 {
+			return "", errors.New(fmt.Sprintf("These errors were encountered on InsertOrUpdateAddress attempt: %s", errs))
+		}
+		return fmt.Sprintf("Inserted the address of %s.", st.Peer), nil
+	case "reverseopen":
+		port, err := r.port(st.Peer)
+		if err != nil {
+			return "", err
+		}
+		err2 := dispatch.RequestInboundSync("127.0.0.1", "", uint16(port))
+		if err2 != nil {
+			return "", err2
+		}
+		return fmt.Sprintf("Requested an inbound sync from %s.", st.Peer), nil
+	case "cachegen":
+		responsegenerator.GenerateCaches()
+		return "Caches generated.", nil
+	case "create":
+		return r.create(st)
+	case "partition", "heal":
+		var ports []uint16
+		for _, peer := range st.Peers {
+			port, err := r.port(peer)
+			if err != nil {
+				return "", err
+			}
+			ports = append(ports, uint16(port))
+		}
+		if st.Verb == "partition" {
+			dispatch.Partition(ports)
+			return fmt.Sprintf("Partitioned away from %s.", strings.Join(st.Peers, ", ")), nil
+		}
+		dispatch.Heal(ports)
+		return fmt.Sprintf("Healed the partition with %s.", strings.Join(st.Peers, ", ")), nil
+	case "kill":
+		if st.RestartAfter.Duration == 0 {
+			return "Shutting down for the rest of the scenario.", nil
+		}
+		return fmt.Sprintf("Shutting down. The coordinator will restart this node in %v.", st.RestartAfter), nil
+	case "waitfor", "assert":
+		return r.poll(st)
+	}
+	// The scenario is validated at load, so this can only happen if a verb is added there and not here.
+	return "", errors.New(fmt.Sprintf("This node doesn't know how to run the verb: %s", st.Verb))
+}
+
+func (r *scenarioRunner) port(node string) (int, error) {
+	port, ok := r.sc.Ports[node]
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("The scenario doesn't have the port of the node %s. The coordinator should have filled it in.", node))
+	}
+	return port, nil
+}
+
+/*----------  Conditions  ----------*/
+
+// poll checks the condition of the step until it holds, or until its time runs out. An assert without a within is checked once.
+func (r *scenarioRunner) poll(st scenario.Step) (string, error) {
+	deadline := time.Now().Add(st.Within.Duration)
+	for {
+		holds, observed, err := r.check(st.Condition)
+		if err == nil && holds {
+			return observed, nil
+		}
+		if !time.Now().Before(deadline) || globals.BackendTransientConfig.ShutdownInitiated {
+			if err != nil {
+				return "", err
+			}
+			return "", errors.New(fmt.Sprintf("The condition didn't hold by the deadline. Last seen: %s", observed))
+		}
+		time.Sleep(scenarioPollInterval)
+	}
+}
+
+func (r *scenarioRunner) check(c *scenario.Condition) (bool, string, error) {
+	entity := c.Entity
+	fps := c.Fingerprints
+	if len(c.Label) > 0 {
+		l, found, err := scenario.ReadLabel(r.reportDir, c.Label)
+		if err != nil {
+			return false, "", err
+		}
+		if !found {
+			return false, fmt.Sprintf("The label %s is not published yet.", c.Label), nil
+		}
+		entity = l.Entity
+		fps = l.Fingerprints
+	}
+	if len(fps) > 0 {
+		present := 0
+		// In batches, so that a large label doesn't go over the database's limit of query parameters.
+		for i := 0; i < len(fps); i += scenarioReadBatch {
+			end := i + scenarioReadBatch
+			if end > len(fps) {
+				end = len(fps)
+			}
+			var apifps []api.Fingerprint
+			for _, fp := range fps[i:end] {
+				apifps = append(apifps, api.Fingerprint(fp))
+			}
+			resp, err := persistence.Read(entity+"s", apifps, nil, 0, 0, true, nil)
+			if err != nil {
+				return false, "", err
+			}
+			present += len(resp.Boards) + len(resp.Threads) + len(resp.Posts) + len(resp.Votes) + len(resp.Keys) + len(resp.Truststates)
+		}
+		return present == len(fps), fmt.Sprintf("%d of the %d %ss are present.", present, len(fps), entity), nil
+	}
+	count := entityCount(entity)
+	observed := fmt.Sprintf("There are %d %ss.", count, entity)
+	if c.Exactly != nil {
+		return count == *c.Exactly, observed, nil
+	}
+	return count >= *c.AtLeast, observed, nil
+}
+
+func entityCount(entity string) int {
+	counts := persistence.Dbg_ReadDatabaseCounts()
+	switch entity {
+	case "board":
+		return int(counts.Boards)
+	case "thread":
+		return int(counts.Threads)
+	case "post":
+		return int(counts.Posts)
+	case "vote":
+		return int(counts.Votes)
+	case "key":
+		return int(counts.Keys)
+	case "truststate":
+		return int(counts.Truststates)
+	}
+	return 0
+}
+
+/*----------  Entity creation  ----------*/
+
+/*
+The entities are created by the node itself, signed with its backend key. A swarm node doesn't have a user (that's the frontend's), so the owner is a key entity made out of the backend key. That key entity is not inserted, the owner only needs its fingerprint. The entities that the requested ones need to exist (the board of a thread, the thread of a post...) are created along with them, one of each per step.
+*/
+
+type bakeable interface {
+	CreateSignature(keyPair *ed25519.PrivateKey) error
+	CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error
+	CreateFingerprint() error
+}
+
+type scenarioCreator struct {
+	keyPair *ed25519.PrivateKey
+	ownerFp api.Fingerprint
+	ownerPk string
+	now     api.Timestamp
+	seq     int
+	items   []interface{}
+}
+
+func (r *scenarioRunner) create(st scenario.Step) (string, error) {
+	c, err := newScenarioCreator()
+	if err != nil {
+		return "", err
+	}
+	fps, err2 := c.createAll(st.Entity, st.Count)
+	if err2 != nil {
+		return "", err2
+	}
+	_, err3 := persistence.BatchInsert(c.items)
+	if err3 != nil {
+		return "", err3
+	}
+	if len(st.Label) > 0 {
+		err4 := scenario.SaveLabel(r.reportDir, st.Label, scenario.Label{Entity: st.Entity, Fingerprints: fps})
+		if err4 != nil {
+			return "", err4
+		}
+	}
+	return fmt.Sprintf("Created %d %ss (%d entities in total, with their parents).", len(fps), st.Entity, len(c.items)), nil
+}
+
+func newScenarioCreator() (*scenarioCreator, error) {
+	c := scenarioCreator{keyPair: globals.BackendConfig.GetBackendKeyPair(), now: api.Timestamp(time.Now().Unix())}
+	fp, pk, err := c.owner(c.keyPair)
+	if err != nil {
+		return nil, err
+	}
+	c.ownerFp = fp
+	c.ownerPk = pk
+	return &c, nil
+}
+
+// owner makes the key entity of the key pair, and returns its fingerprint and public key. The key entity itself is not kept.
+func (c *scenarioCreator) owner(keyPair *ed25519.PrivateKey) (api.Fingerprint, string, error) {
+	pk := signaturing.MarshalPublicKey(keyPair.Public().(ed25519.PublicKey))
+	k := api.Key{Type: scenarioKeyType, Key: pk, Name: c.name("owner"), EntityVersion: globals.BackendTransientConfig.EntityVersions.Key}
+	k.Creation = c.now
+	err := k.CreateSignature(keyPair)
+	if err != nil {
+		return "", "", err
+	}
+	k.CreateFingerprint()
+	return k.Fingerprint, pk, nil
+}
+
+func (c *scenarioCreator) createAll(entity string, count int) ([]string, error) {
+	var fps []string
+	var board, thread, post, key api.Fingerprint
+	var err error
+	if entity == "thread" || entity == "post" || entity == "vote" {
+		if board, err = c.board(); err != nil {
+			return nil, err
+		}
+	}
+	if entity == "post" || entity == "vote" {
+		if thread, err = c.thread(board); err != nil {
+			return nil, err
+		}
+	}
+	if entity == "vote" {
+		if post, err = c.post(board, thread); err != nil {
+			return nil, err
+		}
+	}
+	if entity == "truststate" {
+		if key, err = c.key(); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < count; i++ {
+		var fp api.Fingerprint
+		switch entity {
+		case "board":
+			fp, err = c.board()
+		case "thread":
+			fp, err = c.thread(board)
+		case "post":
+			fp, err = c.post(board, thread)
+		case "vote":
+			fp, err = c.vote(board, thread, post)
+		case "key":
+			fp, err = c.key()
+		case "truststate":
+			fp, err = c.truststate(key)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fps = append(fps, string(fp))
+	}
+	return fps, nil
+}
+
+// name makes every entity of the step unique, since two entities with the same content created in the same second would have the same fingerprint.
+func (c *scenarioCreator) name(entity string) string {
+	c.seq++
+	return fmt.Sprintf("Swarm %s %s-%d-%d", entity, globals.BackendTransientConfig.AppIdentifier, time.Now().UnixNano(), c.seq)
+}
+
+func (c *scenarioCreator) board() (api.Fingerprint, error) {
+	e := api.Board{Name: c.name("board"), Owner: c.ownerFp, OwnerPublicKey: c.ownerPk, EntityVersion: globals.BackendTransientConfig.EntityVersions.Board}
+	e.Creation = c.now
+	err := c.bake(&e, c.keyPair, globals.BackendConfig.GetMinimumPoWStrengths().Board)
+	c.items = append(c.items, e)
+	return e.Fingerprint, err
+}
+
+func (c *scenarioCreator) thread(board api.Fingerprint) (api.Fingerprint, error) {
+	e := api.Thread{Board: board, Name: c.name("thread"), Owner: c.ownerFp, OwnerPublicKey: c.ownerPk, EntityVersion: globals.BackendTransientConfig.EntityVersions.Thread}
+	e.Creation = c.now
+	err := c.bake(&e, c.keyPair, globals.BackendConfig.GetMinimumPoWStrengths().Thread)
+	c.items = append(c.items, e)
+	return e.Fingerprint, err
+}
+
+func (c *scenarioCreator) post(board, thread api.Fingerprint) (api.Fingerprint, error) {
+	e := api.Post{Board: board, Thread: thread, Parent: thread, Body: c.name("post"), Owner: c.ownerFp, OwnerPublicKey: c.ownerPk, EntityVersion: globals.BackendTransientConfig.EntityVersions.Post}
+	e.Creation = c.now
+	err := c.bake(&e, c.keyPair, globals.BackendConfig.GetMinimumPoWStrengths().Post)
+	c.items = append(c.items, e)
+	return e.Fingerprint, err
+}
+
+func (c *scenarioCreator) vote(board, thread, target api.Fingerprint) (api.Fingerprint, error) {
+	// Every vote needs its own owner, since a second vote of the same owner on the same target would be an update of the first one.
+	kp, err := signaturing.CreateKeyPair()
+	if err != nil {
+		return "", err
+	}
+	ownerFp, ownerPk, err2 := c.owner(kp)
+	if err2 != nil {
+		return "", err2
+	}
+	e := api.Vote{Board: board, Thread: thread, Target: target, TypeClass: 1, Type: 1, Owner: ownerFp, OwnerPublicKey: ownerPk, EntityVersion: globals.BackendTransientConfig.EntityVersions.Vote}
+	e.Creation = c.now
+	err3 := c.bake(&e, kp, globals.BackendConfig.GetMinimumPoWStrengths().Vote)
+	c.items = append(c.items, e)
+	return e.Fingerprint, err3
+}
+
+func (c *scenarioCreator) key() (api.Fingerprint, error) {
+	kp, err := signaturing.CreateKeyPair()
+	if err != nil {
+		return "", err
+	}
+	e := api.Key{Type: scenarioKeyType, Key: signaturing.MarshalPublicKey(kp.Public().(ed25519.PublicKey)), Name: c.name("key"), EntityVersion: globals.BackendTransientConfig.EntityVersions.Key}
+	e.Creation = c.now
+	err2 := c.bake(&e, kp, globals.BackendConfig.GetMinimumPoWStrengths().Key)
+	c.items = append(c.items, e)
+	return e.Fingerprint, err2
+}
+
+func (c *scenarioCreator) truststate(target api.Fingerprint) (api.Fingerprint, error) {
+	// Same as votes, one per owner.
+	kp, err := signaturing.CreateKeyPair()
+	if err != nil {
+		return "", err
+	}
+	ownerFp, ownerPk, err2 := c.owner(kp)
+	if err2 != nil {
+		return "", err2
+	}
+	e := api.Truststate{Target: target, TypeClass: 1, Type: 1, Owner: ownerFp, OwnerPublicKey: ownerPk, EntityVersion: globals.BackendTransientConfig.EntityVersions.Truststate}
+	e.Creation = c.now
+	err3 := c.bake(&e, kp, globals.BackendConfig.GetMinimumPoWStrengths().Truststate)
+	c.items = append(c.items, e)
+	return e.Fingerprint, err3
+}
+
+// bake is the same order as create.Bake: signature, PoW, fingerprint. The PoW is skipped if the swarm has the PoW checks disabled, minting thousands of entities would otherwise take most of the test.
+func (c *scenarioCreator) bake(e bakeable, keyPair *ed25519.PrivateKey, strength int) error {
+	err := e.CreateSignature(keyPair)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Entity creation failed. Error: %s, Entity: %#v\n", err, e))
+	}
+	if globals.BackendTransientConfig.ProofOfWorkCheckEnabled {
+		err2 := e.CreatePoW(keyPair, strength)
+		if err2 != nil {
+			return errors.New(fmt.Sprintf("Entity creation failed. Error: %s, Entity: %#v\n", err2, e))
+		}
+	}
+	return e.CreateFingerprint()
+}
@@ -17,6 +17,7 @@ import (
 	// "github.com/davecgh/go-spew/spew"
 	"github.com/spf13/cobra"
 	"io/ioutil"
+	"path/filepath"
 	"time"
 )
 
@@ -41,6 +42,9 @@ func init() {
 	var pageSigCheckEnabled bool
 	var tlsEnabled bool
 	var allowLocalhostRemotes bool
+	var swarmScenario string
+	var swarmStart int
+	var swarmReportDir string
 	cmdOrchestrate.Flags().StringVarP(&orgName, "orgname", "", "Air Labs", "Global transient org name for the app.")
 	cmdOrchestrate.Flags().StringVarP(&appName, "appname", "", "Aether", "Global transient app name for the app.")
 	cmdOrchestrate.Flags().IntVarP(&loggingLevel, "logginglevel", "", 0, "Global logging level of the app.")
@@ -61,6 +65,9 @@ func init() {
 	cmdOrchestrate.Flags().BoolVarP(&pageSigCheckEnabled, "pagesigcheckenabled", "", true, "Setting this to false will disable page signature checks on pages. True by default.")
 	cmdOrchestrate.Flags().BoolVarP(&tlsEnabled, "tlsenabled", "", true, "Setting this to false will disable the TLS encryption layer in peer to peer connections. This is for debug purposes only, mainnet nodes will refuse to connect to or accept connections from any remote with TLS disabled.")
 	cmdOrchestrate.Flags().BoolVarP(&allowLocalhostRemotes, "allowlocalhostremotes", "", false, "Setting this to true will allow localhost remotes to be saved into the database. This is useful for swarm testing.")
+	cmdOrchestrate.Flags().StringVarP(&swarmScenario, "swarmscenario", "", "", "This flag allows you to load a swarm scenario to your swarm nodes. A scenario is the declarative successor of the swarm plan: it can create entities, partition and heal nodes, kill and restart them, wait for conditions, and assert what a node should have by a deadline. See backend/swarmtest/scenario for the language.")
+	cmdOrchestrate.Flags().IntVarP(&swarmStart, "swarmstart", "", 0, "The unix timestamp the swarm scenario started at. The times of the steps are relative to this. If not given, the scenario starts when the node does. The coordinator gives this so that all nodes share the same clock, and so that a restarted node can pick up where it left.")
+	cmdOrchestrate.Flags().StringVarP(&swarmReportDir, "swarmreportdir", "", "", "The folder the node saves its swarm scenario results and labels into. All nodes of the swarm have to share it. If not given, it's the folder the scenario is in.")
 	cmdRoot.AddCommand(cmdOrchestrate)
 }
 
@@ -105,6 +112,18 @@ var cmdOrchestrate = &cobra.Command{
 			if flags.swarmPlan.changed {
 				scheduleSwarmPlan(flags.swarmPlan.value.(string))
 			}
+			if flags.swarmScenario.changed {
+				scenarioLoc := flags.swarmScenario.value.(string)
+				start := int64(flags.swarmStart.value.(int))
+				if !flags.swarmStart.changed {
+					start = time.Now().Unix()
+				}
+				reportDir := filepath.Dir(scenarioLoc)
+				if flags.swarmReportDir.changed {
+					reportDir = flags.swarmReportDir.value.(string)
+				}
+				runSwarmScenario(scenarioLoc, start, reportDir)
+			}
 			if flags.killTimeout.changed {
 				logging.Log(1, fmt.Sprintf("This node set to shut down in %d seconds.", flags.killTimeout.value.(int)))
 				scheduling.ScheduleOnce(func() {
@@ -172,7 +191,9 @@ func scheduleSwarmPlan(planloc string) {
 		} else if plan.CommandName == "reverseopen" {
 			logging.Logf(1, "This node will attempt to request inbound sync from remote: %s:%v", plan.ToIp, plan.ToPort)
 			scheduling.ScheduleOnce(selectCmdFunc("reverseopen", plan), plan.TriggerAfter)
+		} else {
+			// An unknown command used to be dropped silently, which made typos in plans look like network behaviour.
+			logging.LogCrash(fmt.Sprintf("The swarm plan has a command this node doesn't know. Known commands are: connect, cachegen, reverseopen. Command: %s", plan.CommandName))
 		}
-
 	}
 }
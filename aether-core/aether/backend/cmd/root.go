@@ -1,19 +1,22 @@
 package cmd
 
 import (
+	"aether-core/aether/services/ca"
 	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/tlscerts"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	"fmt"
 	"github.com/fatih/color"
 	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
 	"github.com/spf13/cobra"
 	// "github.com/spf13/pflag"
 	"os"
 	"path/filepath"
-	// "strings"
+	"strings"
 )
 
 // cmdRoot represents the base command when called without any subcommands
@@ -136,6 +139,7 @@ func EstablishConfigs(cmd *cobra.Command) flags {
 	}
 	becfg.Cycle()
 	globals.BackendConfig = becfg
+	ca.SetTrustedKeys(globals.BackendConfig.GetTrustedCAKeys())
 	// fecfg, err := configstore.EstablishFrontendConfig()
 	// if err != nil {
 	// 	logging.LogCrash(err)
@@ -166,6 +170,16 @@ func EstablishConfigs(cmd *cobra.Command) flags {
 	}
 	if flgs.externalIp.changed {
 		globals.BackendConfig.SetExternalIp(flgs.externalIp.value.(string))
+		// A location of a transport other than TCP, such as "unix:/tmp/swarm", also sets the transport the node listens on.
+		if lt := transport.LocationTypeOf(flgs.externalIp.value.(string)); !transport.IsTCP(lt) {
+			globals.BackendConfig.SetExternalIpType(int(lt))
+		}
+	}
+	// Only TCP is carried by default. A node on another transport carries that one as well, so that it can reach the other nodes on it.
+	if lt := globals.BackendConfig.GetExternalIpType(); !transport.IsTCP(lt) {
+		if err := transport.EnableLocal(lt); err != nil {
+			logging.LogCrash(err)
+		}
 	}
 	// These are booleans but still gated, because the values can be nil in case of testing, not only true/false.
 	if flgs.printToStdout.changed {
@@ -269,6 +283,22 @@ func EstablishConfigs(cmd *cobra.Command) flags {
 			Single-connection restriction does not apply to MySQL. Relaxing it helps in server situations.
 		*/
 		globals.DbInstance.SetMaxOpenConns(100)
+	} else if globals.BackendConfig.GetDbEngine() == "postgres" {
+		// Unlike MySQL, the database needs to exist beforehand. Create a database with the name in DbName (default 'aetherdb') and give your user read / write access to it.
+		postgresConnectionString := fmt.Sprintf(
+			"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+			globals.BackendConfig.GetDbIp(),
+			globals.BackendConfig.GetDbPort(),
+			globals.BackendConfig.GetDbUsername(),
+			globals.BackendConfig.GetDbPassword(),
+			globals.BackendConfig.GetDbName(),
+			globals.BackendConfig.GetDbSSLMode())
+		globals.DbInstance = sqlx.MustConnect("postgres", postgresConnectionString)
+		/*
+			Postgres folds our unquoted column names into lowercase, so the column names that come back are lowercase, and they won't match the struct tags (which are in CamelCase). This mapper lowercases the struct tags, so that they match. Mind that the named parameters in Postgres queries need to be lowercase for the same reason.
+		*/
+		globals.DbInstance.Mapper = reflectx.NewMapperTagFunc("db", strings.ToLower, strings.ToLower)
+		globals.DbInstance.SetMaxOpenConns(100)
 	} else {
 		logging.LogCrash(fmt.Sprintf("Storage engine you've inputted is not supported. Please change it from the backend user config into something that is supported. You've provided: %s", globals.BackendConfig.GetDbEngine()))
 	}
@@ -6,7 +6,7 @@ import (
 	"aether-core/aether/backend/feapiconsumer"
 	"aether-core/aether/backend/responsegenerator"
 	"aether-core/aether/backend/server"
-	// "aether-core/aether/io/api"
+	"aether-core/aether/io/api"
 	"aether-core/aether/io/persistence"
 	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
@@ -60,8 +60,13 @@ This will do three main things:
 		}
 		persistence.CreateDatabase()
 		persistence.CheckDatabaseReady()
+		// The pages that fail the verification count against the reputation of the remote that served them.
+		api.ReportInvalidPage = dispatch.ReportInvalidPage
 		startSchedules()
 		handleTemporaryConfigUpdates()
+		if globals.BackendConfig.GetMetricsExporterEnabled() {
+			startMetricsExporter()
+		}
 		gotValidPort := make(chan bool)
 		go beapiserver.StartBackendServer(gotValidPort)
 		<-gotValidPort // Only proceed after this is true.
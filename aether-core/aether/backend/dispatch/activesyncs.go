@@ -0,0 +1,84 @@
+// Backend > Dispatch > ActiveSyncs
+// This file keeps track of the syncs that are in flight, so that we don't sync with the same remote twice at the same time, and so that the progress of each sync can be looked at while it's running.
+
+package dispatch
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/services/clock"
+	"sync"
+)
+
+/*
+Multiple syncs can run at the same time, up to the number of outbound leases the bouncer gives out (MaxOutboundConns). Every sync has its own purgatory and its own metrics container, and the database writes of all of them go through persistence.BatchInsert, which commits one batch at a time. The only thing they need to agree on is not to sync with the same remote at the same time, since both would be pulling the same data and moving the same checkin timestamps of the same node.
+
+The syncs are keyed by the node id of the remote, not by its address. A node can be reachable at more than one address (IPv4 and IPv6, a URL, or over a reverse connection from wherever it is behind its NAT), and it's the node's record in the database that two syncs would be fighting over. A sync registers as soon as it learns the node id from the remote, before it reads or writes anything of that node.
+*/
+
+var activeSyncs = syncRegistry{syncs: make(map[api.Fingerprint]*CurrentOutboundSyncMetrics)}
+
+type syncRegistry struct {
+	lock  sync.Mutex
+	syncs map[api.Fingerprint]*CurrentOutboundSyncMetrics
+}
+
+// add registers a sync with the node at the given address, and returns false if there is already one in flight with it. Until the sync has its metrics container (see start), only the remote and the start time of it are known.
+func (r *syncRegistry) add(nodeId api.Fingerprint, a api.Address) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, exists := r.syncs[nodeId]; exists {
+		return false
+	}
+	r.syncs[nodeId] = &CurrentOutboundSyncMetrics{
+		RemoteIp:       string(a.Location),
+		RemotePort:     int(a.Port),
+		StartTimestamp: clock.Now().Unix(),
+	}
+	return true
+}
+
+// start gives the registered sync with the node its metrics container, once the sync has read what it knows of the node.
+func (r *syncRegistry) start(nodeId api.Fingerprint, c *CurrentOutboundSyncMetrics, endpointsTotal int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if existing, ok := r.syncs[nodeId]; ok {
+		c.StartTimestamp = existing.StartTimestamp
+	}
+	c.EndpointsTotal = endpointsTotal
+	r.syncs[nodeId] = c
+}
+
+func (r *syncRegistry) remove(nodeId api.Fingerprint) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.syncs, nodeId)
+}
+
+// progress records the endpoint the sync is working on, and how many endpoints it has completed.
+func (r *syncRegistry) progress(c *CurrentOutboundSyncMetrics, currentEndpoint string, endpointsCompleted int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	c.CurrentEndpoint = currentEndpoint
+	c.EndpointsCompleted = endpointsCompleted
+}
+
+// ActiveSyncs returns the progress of the syncs that are in flight. The rest of the metrics of a sync are written by the sync as it goes without a lock, so only the remote and the progress fields are filled in here. The full metrics are logged at the end of each sync.
+func ActiveSyncs() []CurrentOutboundSyncMetrics {
+	activeSyncs.lock.Lock()
+	defer activeSyncs.lock.Unlock()
+	result := []CurrentOutboundSyncMetrics{}
+	for _, c := range activeSyncs.syncs {
+		result = append(result, CurrentOutboundSyncMetrics{
+			RemoteIp:           c.RemoteIp,
+			RemotePort:         c.RemotePort,
+			RemoteClientName:   c.RemoteClientName,
+			SyncHistory:        c.SyncHistory,
+			IsReverseConn:      c.IsReverseConn,
+			StartTimestamp:     c.StartTimestamp,
+			CurrentEndpoint:    c.CurrentEndpoint,
+			EndpointsCompleted: c.EndpointsCompleted,
+			EndpointsTotal:     c.EndpointsTotal,
+		})
+	}
+	return result
+}
@@ -6,6 +6,7 @@ package dispatch
 import (
 	"aether-core/aether/io/api"
 	pers "aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	// "aether-core/aether/services/safesleep"
@@ -29,7 +30,7 @@ func getAllAddresses(isDesc bool) ([]api.Address, error) {
 	}
 	resp, err := pers.ReadAddresses("", "", 0, 0, 0, 0, 0, 0, searchType)
 	if err != nil {
-		errors.New(fmt.Sprintf("getAllAddresses in AddressScanner failed.", err))
+		errors.New(fmt.Sprintf("getAllAddresses in AddressScanner failed. Error: %v", err))
 	}
 	return resp, nil
 }
@@ -74,7 +75,7 @@ func updateAddrs(addrs []api.Address) ([]api.Address, error) {
 	updatedAddrs := Pinger(addrs)
 	err := pers.AddrTrustedInsert(&updatedAddrs)
 	if err != nil {
-		return []api.Address{}, errors.New(fmt.Sprintf("updateAddrs encountered an error in AddrTrustedInsert.", err))
+		return []api.Address{}, errors.New(fmt.Sprintf("updateAddrs encountered an error in AddrTrustedInsert. Error: %v", err))
 	}
 	return updatedAddrs, nil
 }
@@ -96,7 +97,7 @@ func updateAddrs(addrs []api.Address) ([]api.Address, error) {
 // 	start := api.Timestamp(time.Now().Unix())
 // 	addrs, err := getAllAddresses(true) // desc - last synced first primary, last pinged first secondary sort
 // 	if err != nil {
-// 		return []api.Address{}, errors.New(fmt.Sprintf("findOnlineNodes: getAllAddresses within this function failed.", err))
+// 		return []api.Address{}, errors.New(fmt.Sprintf("findOnlineNodes: getAllAddresses within this function failed. Error: %v", err))
 // 	}
 // 	// logging.Logf(1, "All addresses: %s", )
 // 	// logging.LogObj(2, "All addresses", Dbg_convertAddrSliceToNameSlice(addrs))
@@ -140,7 +141,7 @@ func updateAddrs(addrs []api.Address) ([]api.Address, error) {
 // 		var addrUpdateErr error
 // 		updatedAddrs, addrUpdateErr = updateAddrs(addrs)
 // 		if addrUpdateErr != nil {
-// 			logging.Logf(1, "findOnlineNodes: updateAddress within this function failed.", addrUpdateErr)
+// 			logging.Logf(1, "findOnlineNodes: updateAddress within this function failed. Error: %v", addrUpdateErr)
 // 		}
 // 	} else {
 // 		logging.Logf(1, "Not doing a network scan within findOnlineNodes.")
@@ -213,7 +214,7 @@ func findOnlineNodesV2(count int, reqType, addrType int, excl *[]api.Address, re
 	logging.Logf(1, "Network scan complete within findOnlineNodes.")
 	addrs, err := getAllAddresses(true) // desc - last synced first primary, last pinged first secondary sort
 	if err != nil {
-		return []api.Address{}, errors.New(fmt.Sprintf("findOnlineNodes: getAllAddresses within this function failed.", err))
+		return []api.Address{}, errors.New(fmt.Sprintf("findOnlineNodes: getAllAddresses within this function failed. Error: %v", err))
 	}
 	if addrType > -1 {
 		addrs, _ = filterByAddressType(uint8(addrType), addrs)
@@ -223,7 +224,8 @@ func findOnlineNodesV2(count int, reqType, addrType int, excl *[]api.Address, re
 			addrs = removeAddr(addr, addrs)
 		}
 	}
-	liveNodes := addrs
+	// Remotes that have been useful and well-behaved come first, the banned ones are out. The unconnected-first order below keeps this order within each group.
+	liveNodes := peerReps.rank(addrs)
 	if reqType == -2 {
 		logging.Log(1, "ReqType = -2, we are looking for nonconnected addrs.")
 		nonconnecteds, connecteds := pickUnconnectedAddrs(liveNodes)
@@ -278,7 +280,7 @@ func pickUnconnectedAddrs(addrs []api.Address) ([]api.Address, []api.Address) {
 func RefreshAddresses() error {
 	addrs, err := getAllAddresses(false) // asc - the oldest unconnected first
 	if err != nil {
-		return errors.New(fmt.Sprintf("RefreshAddresses: getAllAddresses within this function failed.", err))
+		return errors.New(fmt.Sprintf("RefreshAddresses: getAllAddresses within this function failed. Error: %v", err))
 	}
 	updateAddrs(addrs)
 	return nil
@@ -319,16 +321,16 @@ func DoNetworkScan() {
 		logging.Logf(1, "We've done a network scan less than 10 minutes ago. Skipping.")
 		return
 	}
-	defer func() { lastNetworkScan = time.Now().Unix() }()
+	defer func() { lastNetworkScan = clock.Now().Unix() }()
 	addrs, err := getAllAddresses(true) // desc - last synced first primary, last pinged first secondary sort
 	if err != nil {
-		logging.Logf(1, "DoNetworkScan: getAllAddresses within this function failed.", err)
+		logging.Logf(1, "DoNetworkScan: getAllAddresses within this function failed. Error: %v", err)
 		return
 	}
 	var addrUpdateErr error
 	_, addrUpdateErr = updateAddrs(addrs)
 	if addrUpdateErr != nil {
-		logging.Logf(1, "findOnlineNodes: updateAddress within this function failed.", addrUpdateErr)
+		logging.Logf(1, "findOnlineNodes: updateAddress within this function failed. Error: %v", addrUpdateErr)
 		return
 	}
 }
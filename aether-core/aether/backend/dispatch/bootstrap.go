@@ -6,6 +6,7 @@ package dispatch
 import (
 	"aether-core/aether/backend/feapiconsumer"
 	"aether-core/aether/io/api"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
@@ -19,18 +20,27 @@ const (
 	bsPort   = configstore.DefaultBootstrapperPort
 )
 
-func getBootstrappers() []api.Address {
-	// defaultBootstrapper := constructBootstrapper(bsLoc, bsSubloc, bsPort)
-	resp, err := api.GetPageRaw(bsLoc, bsSubloc, bsPort, "bootstrappers", "GET", []byte{}, nil)
+// defaultBootstrapper is the address we ask for the bootstrappers.
+func defaultBootstrapper() api.Address {
+	bs := api.Address{}
+	bs.Location = bsLoc
+	bs.Sublocation = bsSubloc
+	bs.Port = bsPort
+	return bs
+}
+
+// getBootstrappers asks the given address for the bootstrappers it knows of.
+func getBootstrappers(bs api.Address) []api.Address {
+	resp, err := api.GetPageRaw(string(bs.Location), string(bs.Sublocation), bs.Port, "bootstrappers", "GET", []byte{}, nil)
 	if err != nil {
-		logging.Logf(1, "Getting bootstrappers failed from this address. Error: %v, Address: %v/%v:%v", err, bsLoc, bsSubloc, bsPort)
+		logging.Logf(1, "Getting bootstrappers failed from this address. Error: %v, Address: %v/%v:%v", err, bs.Location, bs.Sublocation, bs.Port)
 	}
 	// spew.Dump(resp)
 	bsers := []api.Address{}
 	if resp.Address.Type == 254 || resp.Address.Type == 3 {
-		resp.Address.Location = bsLoc
-		resp.Address.Sublocation = bsSubloc
-		resp.Address.Port = bsPort
+		resp.Address.Location = bs.Location
+		resp.Address.Sublocation = bs.Sublocation
+		resp.Address.Port = bs.Port
 		bsers = append(bsers, resp.Address) // The first bootstrapper is the address we connected to if it's a bootstrapper itself (type=3)
 	}
 	bsers = append(bsers, resp.ResponseBody.Addresses...)
@@ -70,8 +80,8 @@ func constructExecPlans(bootstrappers []api.Address) []execPlan {
 	return execplans
 }
 
-// Bootstrap is the 'catch-up' logic that runs whenever a node falls too far behind the network head for any reason. One of the main uses is the start from the first boot, but it can also be that the node has been offline for more than bootstrap hit interval.
-func doBootstrap() {
+// Bootstrap is the 'catch-up' logic that runs whenever a node falls too far behind the network head for any reason. One of the main uses is the start from the first boot, but it can also be that the node has been offline for more than bootstrap hit interval. The bootstrappers are asked for at the given address.
+func doBootstrap(bs api.Address) {
 	if feapiconsumer.BackendAmbientStatus.BootstrapInProgress {
 		return
 	}
@@ -85,9 +95,9 @@ func doBootstrap() {
 	*/
 	feapiconsumer.BackendAmbientStatus.BootstrapInProgress = true
 	feapiconsumer.SendBackendAmbientStatus()
-	lastBs := time.Now().Unix()
+	lastBs := clock.Now().Unix()
 	successful := false
-	bootstrappers := getBootstrappers()
+	bootstrappers := getBootstrappers(bs)
 	onlineBootstrappers := Pinger(bootstrappers)
 	defer func() {
 		feapiconsumer.BackendAmbientStatus.BootstrapInProgress = false
@@ -140,10 +150,10 @@ func doBootstrap() {
 func Bootstrap() {
 	bsOfflineMinutes := globals.BackendConfig.GetBootstrapAfterOfflineMinutes()
 	lastBs := globals.BackendConfig.GetLastBootstrapAddressConnectionTimestamp()
-	cutoff := int64(time.Now().Add(-(time.Duration(bsOfflineMinutes) * time.Minute)).Unix())
+	cutoff := int64(clock.Now().Add(-(time.Duration(bsOfflineMinutes) * time.Minute)).Unix())
 	feapiconsumer.BackendAmbientStatus.LastBootstrapTimestamp = lastBs
 	if cutoff > lastBs {
 		logging.Logf(1, "Bootstrap decided it needs to run because it's been longer than allowed cutoff since the last time it was run.")
-		go doBootstrap()
+		go doBootstrap(defaultBootstrapper())
 	}
 }
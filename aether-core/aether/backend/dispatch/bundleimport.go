@@ -0,0 +1,82 @@
+// Backend > Dispatch > BundleImport
+// This file imports offline bundles through the same path the data of a sync goes through.
+
+package dispatch
+
+import (
+	"aether-core/aether/backend/responsegenerator"
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"errors"
+	"fmt"
+)
+
+/*
+An offline bundle is a set of cache pages that another node has signed and put into a file (see responsegenerator/bundle.go). We treat every page as if it just arrived over the network from that node: it goes through the page signature and entity verification (api.VerifyPage), the realm and the subscription filters, the purgatory and BatchInsert, in that order, as the pages of a sync do. So a bundle can't bring in anything a live sync with the node that made it couldn't have.
+
+A page that fails verification is skipped, not the whole bundle, the same way a sync skips a bad page and continues with the rest of the endpoint.
+*/
+
+// maxBundleAddresses is the number of addresses we take from a bundle. This is the same as the number of addresses we take from the addresses endpoint of a remote in a sync.
+const maxBundleAddresses = 100
+
+// ImportBundle verifies the pages in the bundle and commits the ones that pass into the database. It returns the insert metrics and the number of pages that were rejected.
+func ImportBundle(b responsegenerator.Bundle) (persistence.InsertMetrics, int, error) {
+	total := persistence.InsertMetrics{}
+	subs := api.LocalSubscriptions()
+	if globals.BackendConfig.GetScaledMode() && !subs.Partial() {
+		// Same as a sync: in scaled mode, we don't take in new content until the event horizon brings the DB under the max size, unless we only take in our subscriptions.
+		return total, 0, errors.New("This node is in scaled mode, so it can't import bundles right now.")
+	}
+	logging.Logf(1, "Bundle import has started. Node: %v, Starts from: %v, Ends at: %v, Pages: %v", b.NodePublicKey, b.StartsFrom, b.EndsAt, len(b.Pages))
+	p := Purgatory{}
+	servedRealms := api.ServedRealms()
+	ims := []persistence.InsertMetrics{}
+	rejected := 0
+	addressesTaken := 0
+	for i, _ := range b.Pages {
+		page := b.Pages[i]
+		if page.NodePublicKey != b.NodePublicKey {
+			logging.Logf(1, "This bundle page was made by a different node than the bundle. Skipping. Page: %v, Bundle node: %v, Page node: %v", i, b.NodePublicKey, page.NodePublicKey)
+			rejected++
+			continue
+		}
+		err := api.VerifyPage(&page)
+		if err != nil {
+			logging.Logf(1, "This bundle page failed verification. Skipping. Page: %v, Error: %v", i, err)
+			rejected++
+			continue
+		}
+		resp := api.InsertApiResponseToResponse(api.Response{}, page)
+		resp.FilterRealms(servedRealms)
+		resp.FilterSubscriptions(subs)
+		if addressesTaken+len(resp.Addresses) > maxBundleAddresses {
+			resp.Addresses = resp.Addresses[0 : maxBundleAddresses-addressesTaken]
+		}
+		addressesTaken = addressesTaken + len(resp.Addresses)
+		p.Filter(&resp)
+		iface := prepareForBatchInsert(&resp)
+		im, err2 := persistence.BatchInsert(*iface)
+		if err2 != nil {
+			logging.Logf(1, "BatchInsert inside bundle import has errored out. Page: %v, Error: %v", i, err2)
+		}
+		ims = append(ims, im)
+	}
+	// Same as the end of a sync: the items the purgatory held are committed if they're the ancestors of something that arrived.
+	iface := p.Process()
+	im, err := persistence.BatchInsert(iface)
+	if err != nil {
+		logging.Logf(1, "Purgatory BatchInsert inside bundle import has errored out. Error: %v", err)
+	}
+	ims = append(ims, im)
+	for _, val := range ims {
+		total.Add(val)
+	}
+	logging.Logf(1, "Bundle import is complete. Pages: %v, Rejected pages: %v", len(b.Pages), rejected)
+	if rejected == len(b.Pages) && len(b.Pages) > 0 {
+		return total, rejected, errors.New(fmt.Sprintf("None of the pages in this bundle passed verification. Rejected pages: %v", rejected))
+	}
+	return total, rejected, nil
+}
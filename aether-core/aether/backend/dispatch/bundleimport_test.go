@@ -0,0 +1,242 @@
+package dispatch_test
+
+// These test that an offline bundle carries the entities of one node into another, and that the importer rejects the pages that were changed after the node signed them.
+
+import (
+	"aether-core/aether/backend/dispatch"
+	"aether-core/aether/backend/responsegenerator"
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/signaturing"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/ed25519"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+// priorDbOfTests is the database the other tests had before the bundle tests, which the bundle tests shouldn't close.
+var priorDbOfTests *sqlx.DB
+
+// setupBundleNode sets up the configs of a node in a directory of its own, so that it doesn't step on the databases of the other tests.
+func setupBundleNode(t *testing.T) func() {
+	priorTransient := globals.BackendTransientConfig
+	priorConfig := globals.BackendConfig
+	priorDb := globals.DbInstance
+	priorDbOfTests = priorDb
+	priorLookup := api.BoardLookup
+	globals.BackendTransientConfig = &configstore.Btc
+	globals.BackendTransientConfig.SetDefaults()
+	globals.BackendTransientConfig.AppIdentifier = "A-UnitTest-Bundle"
+	becfg, err := configstore.EstablishBackendConfig()
+	if err != nil {
+		t.Fatalf("The backend config could not be established. Error: %v", err)
+	}
+	becfg.Cycle()
+	globals.BackendConfig = becfg
+	// The fixtures below aren't minted, but the pages are signed for real. The page signature is what the tampered page fails on.
+	globals.BackendTransientConfig.FingerprintCheckEnabled = false
+	globals.BackendTransientConfig.SignatureCheckEnabled = false
+	globals.BackendTransientConfig.ProofOfWorkCheckEnabled = false
+	globals.BackendTransientConfig.PageSignatureCheckEnabled = true
+	return func() {
+		if globals.DbInstance != nil && globals.DbInstance != priorDb {
+			globals.DbInstance.Close()
+		}
+		os.RemoveAll(becfg.GetUserDirectory())
+		os.RemoveAll(becfg.GetCachesDirectory())
+		globals.BackendTransientConfig = priorTransient
+		globals.BackendConfig = priorConfig
+		globals.DbInstance = priorDb
+		api.BoardLookup = priorLookup
+	}
+}
+
+// freshDatabase replaces the database with a new, empty one. The node that exports the bundle and the one that imports it each get one, in turn.
+func freshDatabase(t *testing.T) {
+	if globals.DbInstance != nil && globals.DbInstance != priorDbOfTests {
+		globals.DbInstance.Close()
+	}
+	dir := globals.BackendConfig.GetSQLiteDBLocation()
+	os.MkdirAll(dir, 0755)
+	dbLoc := filepath.Join(dir, "AetherDB.db")
+	os.Remove(dbLoc)
+	conn, err := sqlx.Connect("sqlite3", dbLoc)
+	if err != nil {
+		t.Fatalf("The database could not be opened. Error: %v", err)
+	}
+	conn.SetMaxOpenConns(1)
+	globals.DbInstance = conn
+	persistence.CreateDatabase()
+	persistence.CheckDatabaseReady()
+}
+
+func insertFixtures(t *testing.T) {
+	now := api.Timestamp(time.Now().Unix())
+	priv, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("The key pair could not be created. Error: %v", err)
+	}
+	pk := signaturing.MarshalPublicKey(priv.Public().(ed25519.PublicKey))
+	k := api.Key{Key: pk, Name: "bundle user", Type: "key type", Expiry: now + 86400, EntityVersion: 1}
+	k.Fingerprint = "bundle key fingerprint"
+	k.Creation = now
+	k.ProofOfWork = "pow"
+	k.Signature = "sig"
+	k.Verified = true
+	b := api.Board{Name: "bundle board", Owner: k.Fingerprint, OwnerPublicKey: k.Key, Language: "en", EntityVersion: 1}
+	b.Fingerprint = "bundle board fingerprint"
+	b.Creation = now
+	b.ProofOfWork = "pow"
+	b.Signature = "sig"
+	b.Verified = true
+	_, err2 := persistence.BatchInsert([]interface{}{k, b})
+	if err2 != nil {
+		t.Fatalf("The fixtures could not be inserted. Error: %v", err2)
+	}
+}
+
+// exportBundle creates a bundle of the given realms out of the current database, and reads it back the way the importing node does.
+func exportBundle(t *testing.T, realms ...api.Fingerprint) responsegenerator.Bundle {
+	now := api.Timestamp(time.Now().Unix())
+	data, err := responsegenerator.CreateBundle(now-3600, now+60, realms)
+	if err != nil {
+		t.Fatalf("The bundle could not be created. Error: %v", err)
+	}
+	b, err2 := responsegenerator.ReadBundle(data)
+	if err2 != nil {
+		t.Fatalf("The bundle could not be read. Error: %v", err2)
+	}
+	return b
+}
+
+func readBoards(t *testing.T) []api.Board {
+	boards, err := persistence.ReadBoards([]api.Fingerprint{"bundle board fingerprint"}, 0, 0, "", "", 0, 0)
+	if err != nil {
+		t.Fatalf("The boards could not be read. Error: %v", err)
+	}
+	return boards
+}
+
+// Tests
+
+func TestBundle_RoundTrip_Success(t *testing.T) {
+	defer setupBundleNode(t)()
+	freshDatabase(t)
+	insertFixtures(t)
+	b := exportBundle(t)
+	if b.NodePublicKey != globals.BackendConfig.GetMarshaledBackendPublicKey() {
+		t.Errorf("The bundle should carry the public key of the node that made it. Bundle node: %v", b.NodePublicKey)
+	}
+	freshDatabase(t)
+	if len(readBoards(t)) != 0 {
+		t.Fatalf("The importing node should start out empty.")
+	}
+	im, rejected, err := dispatch.ImportBundle(b)
+	if err != nil || rejected != 0 {
+		t.Fatalf("The bundle should import in full. Rejected pages: %v, Error: %v", rejected, err)
+	}
+	if im.BoardsReceived != 1 || im.KeysReceived != 1 {
+		t.Errorf("The import should commit the entities in the bundle. Insert metrics: %#v", im)
+	}
+	boards := readBoards(t)
+	if len(boards) != 1 || boards[0].Name != "bundle board" {
+		t.Errorf("The board should be in the database of the importing node. Boards: %#v", boards)
+	}
+}
+
+func TestBundle_TamperedPage_Fail(t *testing.T) {
+	defer setupBundleNode(t)()
+	freshDatabase(t)
+	insertFixtures(t)
+	b := exportBundle(t)
+	tampered := false
+	for i, _ := range b.Pages {
+		if len(b.Pages[i].ResponseBody.Boards) > 0 {
+			b.Pages[i].ResponseBody.Boards[0].Name = "tampered board"
+			tampered = true
+		}
+	}
+	if !tampered {
+		t.Fatalf("The bundle should have a page with the board in it.")
+	}
+	freshDatabase(t)
+	im, rejected, err := dispatch.ImportBundle(b)
+	if err != nil {
+		t.Fatalf("The pages that weren't tampered with should still import. Error: %v", err)
+	}
+	if rejected != 1 {
+		t.Errorf("Only the tampered page should be rejected. Rejected pages: %v", rejected)
+	}
+	if im.KeysReceived != 1 {
+		t.Errorf("The key should import from its own page. Insert metrics: %#v", im)
+	}
+	if boards := readBoards(t); len(boards) != 0 {
+		t.Errorf("The tampered board should not be in the database. Boards: %#v", boards)
+	}
+}
+
+func TestBundle_PageOfAnotherNode_Fail(t *testing.T) {
+	defer setupBundleNode(t)()
+	freshDatabase(t)
+	insertFixtures(t)
+	b := exportBundle(t)
+	// Pages signed by the node are put into a bundle that claims to be from another node.
+	b.NodePublicKey = "another node public key"
+	freshDatabase(t)
+	_, rejected, err := dispatch.ImportBundle(b)
+	if err == nil || rejected != len(b.Pages) {
+		t.Errorf("None of the pages should import. Rejected pages: %v of %v, Error: %v", rejected, len(b.Pages), err)
+	}
+	if boards := readBoards(t); len(boards) != 0 {
+		t.Errorf("No board should be in the database. Boards: %#v", boards)
+	}
+}
+
+func TestBundle_Realms_Success(t *testing.T) {
+	defer setupBundleNode(t)()
+	freshDatabase(t)
+	insertFixtures(t)
+	if err := globals.BackendConfig.SetServedRealms([]string{"bundle-realm"}); err != nil {
+		t.Fatalf("The realm could not be served. Error: %v", err)
+	}
+	b := api.Board{Name: "realm board", Owner: "bundle key fingerprint", OwnerPublicKey: "realm board owner public key", Language: "en", EntityVersion: 1, RealmId: "bundle-realm"}
+	b.Fingerprint = "realm board fingerprint"
+	b.Creation = api.Timestamp(time.Now().Unix())
+	b.ProofOfWork = "pow"
+	b.Signature = "sig"
+	b.Verified = true
+	if _, err := persistence.BatchInsert([]interface{}{b}); err != nil {
+		t.Fatalf("The realm board could not be inserted. Error: %v", err)
+	}
+	hasRealmBoard := func(bundle responsegenerator.Bundle) bool {
+		for _, page := range bundle.Pages {
+			for _, board := range page.ResponseBody.Boards {
+				if board.RealmId == "bundle-realm" {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if hasRealmBoard(exportBundle(t)) {
+		t.Errorf("A bundle should carry only the public realm unless other realms are asked for.")
+	}
+	if !hasRealmBoard(exportBundle(t, "bundle-realm")) {
+		t.Errorf("A bundle should carry the realms that are asked for.")
+	}
+}
+
+func TestBundle_UnservedRealm_Fail(t *testing.T) {
+	defer setupBundleNode(t)()
+	freshDatabase(t)
+	now := api.Timestamp(time.Now().Unix())
+	if _, err := responsegenerator.CreateBundle(now-3600, now+60, []api.Fingerprint{"not-served"}); err == nil {
+		t.Errorf("A realm this node doesn't serve should not go into a bundle.")
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"aether-core/aether/io/api"
 	// "aether-core/aether/io/persistence"
 	"aether-core/aether/services/ca"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	// "aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	// tb "aether-core/aether/services/toolbox"
 	// "aether-core/aether/services/verify"
 	"errors"
@@ -20,7 +22,6 @@ import (
 	"net"
 	// "strconv"
 	"strings"
-	"time"
 )
 
 // Check is the short routine that reaches out to a node to see if it is online, and if so, pull the node data. This returns an updated api.Address object. Sync logic uses check as a starting point.
@@ -31,9 +32,9 @@ func Check(a api.Address, reverseConn *net.Conn, purpose string) (api.Address, b
 	NODE_STATIC := false
 	directlyConnectible := false
 	/*
-		If the port is 0, the node doesn't want us to connect. Skip directly.
+		If the port is 0, the node doesn't want us to connect. Skip directly. A reverse connection has no address to go by until the remote tells us, so it's checked regardless.
 	*/
-	if a.Port == 0 {
+	if a.Port == 0 && reverseConn == nil {
 		return a, false, api.ApiResponse{}, false, nil
 	}
 	/*
@@ -133,7 +134,7 @@ func Check(a api.Address, reverseConn *net.Conn, purpose string) (api.Address, b
 		lastSuccessfulPing = apiResp.Timestamp
 	} else {
 		addr = postApiResp.Address // addr is what comes from remote, a is local.
-		lastSuccessfulPing = api.Timestamp(time.Now().Unix())
+		lastSuccessfulPing = api.Timestamp(clock.Now().Unix())
 	}
 	addr = *insertFirstPartyAddressData(&addr, &a, lastSuccessfulPing, reverseConn)
 	// if a.Location == "127.0.0.1" {
@@ -259,6 +260,13 @@ func insertFirstPartyAddressData(inboundAddrPtr *api.Address, localAddrPtr *api.
 		*/
 		host, _, _ := net.SplitHostPort((*reverseConn).RemoteAddr().String())
 		addr.Location = api.Location(host)
+		if lt := globals.BackendConfig.GetExternalIpType(); !transport.IsTCP(lt) {
+			// Over the other transports, the connection doesn't come from a location the remote can be reached at, so the location the remote declares is all we have. It has to be one of our own transport, since that is the only one the remote could have reached us through. This is just as untrusted as the port below.
+			addr.Location = ""
+			if transport.LocationTypeOf(string(inboundAddrPtr.Location)) == lt {
+				addr.Location = inboundAddrPtr.Location
+			}
+		}
 		addr.Port = inboundAddrPtr.Port // Heads up, untrusted data entry, check connectivity before using / saving. See commentary above for details.
 	} else {
 		// Normal connection initiated by us. //
@@ -267,13 +275,7 @@ func insertFirstPartyAddressData(inboundAddrPtr *api.Address, localAddrPtr *api.
 		// Determine IP type from the local address we just used to connect to this remote.
 		addr.Port = localAddrPtr.Port // Because we just connected to this port and it worked. If the remote says it's a different port, it's lying.
 	}
-	if IsIPv4(addr) {
-		addr.LocationType = 4
-	} else if IsIPv6(addr) {
-		addr.LocationType = 6
-	} else {
-		addr.LocationType = 3 // = URL
-	}
+	addr.LocationType = transport.LocationTypeOf(string(addr.Location)) // 4: IPv4, 6: IPv6, 3: URL, or the type of the transport that claims the location.
 	addr.LastSuccessfulPing = lastSuccessfulPing
 	addr.EntityVersion = globals.BackendTransientConfig.EntityVersions.Address
 	return &addr
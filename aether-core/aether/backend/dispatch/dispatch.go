@@ -6,6 +6,7 @@ package dispatch
 import (
 	"aether-core/aether/io/api"
 	// "aether-core/aether/io/persistence"
+	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	// "aether-core/aether/services/safesleep"
@@ -15,6 +16,7 @@ import (
 	// "github.com/pkg/errors"
 	// "aether-core/aether/services/toolbox"
 	"strings"
+	"sync"
 	// "time"
 	// "net"
 )
@@ -46,7 +48,29 @@ func NeighbourWatch() {
 	Bootstrap()
 	/*=====  End of Bootstrap check  ======*/
 
-	loc, subloc, port := globals.BackendTransientConfig.NeighboursList.Pop()
+	/*
+		We run as many neighbour syncs in parallel as we have free outbound leases. For the default of one outbound connection, this is a single sync, as it always was. Nodes that allow more outbounds catch up with the network that much faster, which matters the most after downtime.
+	*/
+	used, total := globals.BackendTransientConfig.Bouncer.GetOutboundSaturation()
+	free := total - used
+	if free < 1 {
+		free = 1
+		// We still try one, it'll fail to get a lease if everything is still busy by the time it gets to it.
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < free; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			watchNeighbour()
+		}()
+	}
+	wg.Wait()
+}
+
+// watchNeighbour syncs with the neighbour with the best reputation, or if there isn't one, scouts for a new one.
+func watchNeighbour() {
+	loc, subloc, port := globals.BackendTransientConfig.NeighboursList.PopPreferred(rankNeighbours)
 	a := api.Address{
 		Location:    api.Location(loc),
 		Sublocation: api.Location(subloc),
@@ -211,12 +235,17 @@ func connect(a api.Address) error {
 		logging.Logf(2, errText)
 		return errors.New(errText)
 	}
+	if peerReps.IsBanned(a) {
+		errText := fmt.Sprintf("Connect failed. This address is banned for a while for repeatedly sending invalid data. We're skipping this connect. Address: %#v", a)
+		logging.Logf(2, errText)
+		return errors.New(errText)
+	}
 	maxAttempts := 2
 	attempts := 0
 	var err error
 	for {
 		/*
-			We try X times to get an outbound lease. If it still doesn't work, we bail. In practice, the second attempt should always work, because second attempt will immediately queue up a sync and it'll get stuck on the mutex. Go releases mutex to the earliest call, so it should always be this one - since the other competing thing for the outbounds is the reversescout, and that does not ever retry getting a lease.
+			We try X times to get an outbound lease. If it still doesn't work, we bail. All outbound leases being taken means we're already running as many syncs as we're allowed to, so there's no point in waiting around for long.
 		*/
 		attempts++
 		if attempts > maxAttempts {
@@ -231,6 +260,10 @@ func connect(a api.Address) error {
 				logging.Logf(1, "Failed to secure an outbound lease. This was attempt #%v", attempts)
 				continue
 			}
+			if strings.Contains(err.Error(), "Already syncing with this remote") {
+				// Another sync running in parallel got to this remote first. Nothing wrong with the remote, so we don't exclude it.
+				return err
+			}
 			// If some other error
 			dpe.Add(a)
 			// ^ We now exclude when it fails too.
@@ -247,6 +280,19 @@ func connect(a api.Address) error {
 	return nil
 }
 
+// rankNeighbours orders the neighbours by their reputation, the same way as the address selection does. The banned ones are left out, which removes them from the neighbours.
+func rankNeighbours(ns []configstore.Address) []configstore.Address {
+	addrs := []api.Address{}
+	for k, _ := range ns {
+		addrs = append(addrs, api.Address{Location: api.Location(ns[k].Location), Sublocation: api.Location(ns[k].Sublocation), Port: ns[k].Port})
+	}
+	ranked := []configstore.Address{}
+	for _, a := range peerReps.rank(addrs) {
+		ranked = append(ranked, configstore.Address{Location: string(a.Location), Sublocation: string(a.Sublocation), Port: a.Port})
+	}
+	return ranked
+}
+
 // sameAddress checks if the addresses given are the same
 func sameAddress(a1 *api.Address, a2 *api.Address) bool {
 	if a1.Location == a2.Location && a1.Sublocation == a2.Sublocation && a1.Port == a2.Port {
@@ -7,6 +7,7 @@ import (
 	// "aether-core/aether/backend/responsegenerator"
 	"aether-core/aether/io/api"
 	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	// "aether-core/aether/services/logging"
 	// tb "aether-core/aether/services/toolbox"
@@ -87,6 +88,12 @@ type CurrentOutboundSyncMetrics struct {
 	RemoteClientName        string
 	SyncHistory             string
 	IsReverseConn           bool
+
+	// Progress of the sync while it's in flight. See ActiveSyncs.
+	StartTimestamp     int64
+	CurrentEndpoint    string
+	EndpointsCompleted int
+	EndpointsTotal     int
 }
 
 func startMetricsContainer(
@@ -196,7 +203,7 @@ func generateCloseMessage(c *CurrentOutboundSyncMetrics, clr *color.Color, ims *
 	}
 	closeMessage := clr.Sprintf("\nCLOSE: %s >>> %s (%s) %s @ %s\n(%s:%d >>> %s:%d) \nReceived: Total: %d. \n%s \nTime: Total: %ds. %s",
 		c.LocalClientName, c.RemoteClientName, c.SyncHistory, reverseConnString,
-		time.Now().Format(time.RFC1123),
+		clock.Now().Format(time.RFC1123),
 		c.LocalIp, c.LocalPort, c.RemoteIp, c.RemotePort,
 		totalEntitiesReceived, insertDbDetailString,
 		c.TotalDurationSeconds, timeDetailString)
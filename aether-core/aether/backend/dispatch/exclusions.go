@@ -5,6 +5,7 @@ package dispatch
 
 import (
 	"aether-core/aether/io/api"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"fmt"
 	"sync"
@@ -25,7 +26,7 @@ func (d *dispatcherExclusions) Add(a api.Address) {
 	defer d.lock.Unlock()
 	d.prepare()
 	d.maintain()
-	d.Exclusions[d.canonicaliseAddr(a)] = time.Now()
+	d.Exclusions[d.canonicaliseAddr(a)] = clock.Now()
 }
 
 func (d *dispatcherExclusions) IsExcluded(a api.Address) bool {
@@ -38,13 +39,13 @@ func (d *dispatcherExclusions) IsExcluded(a api.Address) bool {
 	ts := d.Exclusions[d.canonicaliseAddr(a)]
 	if ts.Unix() > 0 {
 		if a.Type == 255 || a.Type == 254 || a.Type == 253 { // Static
-			if time.Since(ts) > staticExpiry {
+			if clock.Since(ts) > staticExpiry {
 				return false // we can connect
 			}
 			return true // too recent, can't connect
 		}
 		if a.Type == 2 || a.Type == 3 || a.Type == 4 { // Live
-			if time.Since(ts) > liveExpiry {
+			if clock.Since(ts) > liveExpiry {
 				return false // we can connect
 			}
 			return true // too recent, can't connect
@@ -63,7 +64,7 @@ func (d *dispatcherExclusions) prepare() {
 }
 
 func (d *dispatcherExclusions) maintain() {
-	now := time.Now()
+	now := clock.Now()
 	maintenanceCutoff := now.Add(-1 * time.Hour).Unix()
 	exclusionsCutoff := now.Add(-12 * time.Hour).Unix()
 	if d.LastMaintained > maintenanceCutoff {
@@ -77,5 +78,5 @@ func (d *dispatcherExclusions) maintain() {
 }
 
 func (d *dispatcherExclusions) canonicaliseAddr(a api.Address) string {
-	return fmt.Sprintf("%s %s %s", a.Location, a.Sublocation, a.Port)
+	return fmt.Sprintf("%s %s %v", a.Location, a.Sublocation, a.Port)
 }
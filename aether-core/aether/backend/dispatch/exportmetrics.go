@@ -0,0 +1,131 @@
+// Backend > Dispatch > ExportMetrics
+// This file keeps the running totals of the syncs for the metrics exporter, and writes them out at scrape time.
+
+package dispatch
+
+import (
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/metricsexport"
+	"sync"
+	"time"
+)
+
+// exportedEntityTypes is in a fixed order, so that every scrape writes the samples in the same order.
+var exportedEntityTypes = []string{"boards", "threads", "posts", "votes", "keys", "truststates", "directmessages", "addresses"}
+
+var syncStats = syncTotals{
+	entitiesReceived:      make(map[string]int64),
+	dbCommitSeconds:       make(map[string]float64),
+	purgatoryLastHeld:     make(map[string]int64),
+	purgatoryTakenInTotal: make(map[string]int64),
+}
+
+type syncTotals struct {
+	lock                  sync.Mutex
+	succeeded             int64
+	failed                int64
+	durationSecondsSum    float64
+	lastDurationSeconds   float64
+	entitiesReceived      map[string]int64
+	dbCommitSeconds       map[string]float64
+	purgatoryLastHeld     map[string]int64
+	purgatoryTakenInTotal map[string]int64
+	purgatoryReleased     int64
+}
+
+// outcome counts a sync that has ended, successful or not. Only the successful syncs count towards the durations, since a failed sync can end at any point.
+func (st *syncTotals) outcome(successful bool, dur time.Duration) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	if !successful {
+		st.failed++
+		return
+	}
+	st.succeeded++
+	st.durationSecondsSum += dur.Seconds()
+	st.lastDurationSeconds = dur.Seconds()
+}
+
+// inserted adds the insert metrics of a sync into the totals per entity type.
+func (st *syncTotals) inserted(ims []persistence.InsertMetrics) {
+	im := persistence.InsertMetrics{}
+	for _, val := range ims {
+		im.Add(val)
+	}
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	st.entitiesReceived["boards"] += int64(im.BoardsReceived)
+	st.entitiesReceived["threads"] += int64(im.ThreadsReceived)
+	st.entitiesReceived["posts"] += int64(im.PostsReceived)
+	st.entitiesReceived["votes"] += int64(im.VotesReceived)
+	st.entitiesReceived["keys"] += int64(im.KeysReceived)
+	st.entitiesReceived["truststates"] += int64(im.TruststatesReceived)
+	st.entitiesReceived["directmessages"] += int64(im.DirectMessagesReceived)
+	st.entitiesReceived["addresses"] += int64(im.AddressesReceived)
+	st.dbCommitSeconds["boards"] += im.BoardsDBCommitTime
+	st.dbCommitSeconds["threads"] += im.ThreadsDBCommitTime
+	st.dbCommitSeconds["posts"] += im.PostsDBCommitTime
+	st.dbCommitSeconds["votes"] += im.VotesDBCommitTime
+	st.dbCommitSeconds["keys"] += im.KeysDBCommitTime
+	st.dbCommitSeconds["truststates"] += im.TruststatesDBCommitTime
+	st.dbCommitSeconds["directmessages"] += im.DirectMessagesDBCommitTime
+	st.dbCommitSeconds["addresses"] += im.AddressesDBCommitTime
+	st.dbCommitSeconds["multiple"] += im.MultipleInsertDBCommitTime
+}
+
+// purgatory records how many items the purgatory of a sync was holding when it was processed, and how many of those it released to be inserted.
+func (st *syncTotals) purgatory(held map[string]int64, released int) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	for key, val := range held {
+		st.purgatoryLastHeld[key] = val
+		st.purgatoryTakenInTotal[key] += val
+	}
+	st.purgatoryReleased += int64(released)
+}
+
+// sizes returns the number of items in the purgatory per entity type.
+func (p *Purgatory) sizes() map[string]int64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return map[string]int64{
+		"boards":      int64(len(p.BoardsPurg)),
+		"threads":     int64(len(p.ThreadsPurg)),
+		"posts":       int64(len(p.PostsPurg)),
+		"votes":       int64(len(p.VotesPurg)),
+		"keys":        int64(len(p.KeysPurg)),
+		"truststates": int64(len(p.TruststatesPurg)),
+	}
+}
+
+// ExportMetrics writes the sync metrics for the metrics exporter.
+func ExportMetrics(w *metricsexport.Writer) {
+	syncStats.lock.Lock()
+	defer syncStats.lock.Unlock()
+	w.Counter("aether_syncs_total", "Outbound syncs that have ended, by result.", float64(syncStats.succeeded), metricsexport.L("result", "success"))
+	w.Counter("aether_syncs_total", "Outbound syncs that have ended, by result.", float64(syncStats.failed), metricsexport.L("result", "failure"))
+	w.Summary("aether_sync_duration_seconds", "Duration of the successful outbound syncs.", syncStats.durationSecondsSum, syncStats.succeeded)
+	w.Gauge("aether_sync_last_duration_seconds", "Duration of the last successful outbound sync.", syncStats.lastDurationSeconds)
+	w.Gauge("aether_syncs_active", "Outbound syncs in flight.", float64(len(ActiveSyncs())))
+	for _, key := range exportedEntityTypes {
+		w.Counter("aether_sync_entities_received_total", "Entities received in outbound syncs, by entity type.", float64(syncStats.entitiesReceived[key]), metricsexport.L("type", key))
+	}
+	for _, key := range append(exportedEntityTypes, "multiple") {
+		// Multiple is the time of the inserts that carry more than one entity type, the purgatory inserts.
+		w.Counter("aether_sync_db_commit_seconds_total", "Time spent committing the entities received in outbound syncs into the database, by entity type.", syncStats.dbCommitSeconds[key], metricsexport.L("type", key))
+	}
+	for _, key := range exportedEntityTypes {
+		if key == "addresses" {
+			continue // Addresses don't go through the purgatory.
+		}
+		w.Gauge("aether_purgatory_last_size", "Items the purgatory of the last sync was holding when it was processed, by entity type.", float64(syncStats.purgatoryLastHeld[key]), metricsexport.L("type", key))
+	}
+	for _, key := range exportedEntityTypes {
+		if key == "addresses" {
+			continue
+		}
+		w.Counter("aether_purgatory_taken_in_total", "Items taken into the purgatories of syncs, by entity type.", float64(syncStats.purgatoryTakenInTotal[key]), metricsexport.L("type", key))
+	}
+	w.Counter("aether_purgatory_released_total", "Items released from the purgatories of syncs to be inserted.", float64(syncStats.purgatoryReleased))
+	w.Gauge("aether_peers_banned", "Remotes serving a ban for repeatedly sending invalid data.", float64(peerReps.bannedCount()))
+}
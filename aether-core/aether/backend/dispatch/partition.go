@@ -0,0 +1,52 @@
+// Backend > Dispatch > Partition
+// This subsystem lets a swarm test cut this node off from some of its peers, and later heal that. It does nothing unless a swarm scenario asks for it.
+
+package dispatch
+
+import (
+	"aether-core/aether/io/api"
+	"errors"
+	"sync"
+)
+
+/*
+All swarm nodes run on the same machine, so a port is enough to tell a node apart. A partitioned remote is refused at the start of every sync with it, outbound or over a reverse connection. The scenario partitions both sides, so no data flows either way, since all of our data moves in syncs.
+
+Partitions don't count against the reputation of the remote, they're not the remote's doing.
+*/
+
+type partitions struct {
+	lock    sync.Mutex
+	Blocked map[uint16]bool
+}
+
+var parts partitions
+
+var errPartitioned = errors.New("Sync refused. This remote is partitioned away from us by the swarm scenario.")
+
+// Partition stops this node from syncing with the remotes at the given ports until they're healed.
+func Partition(ports []uint16) {
+	parts.lock.Lock()
+	defer parts.lock.Unlock()
+	if parts.Blocked == nil {
+		parts.Blocked = make(map[uint16]bool)
+	}
+	for _, port := range ports {
+		parts.Blocked[port] = true
+	}
+}
+
+// Heal lets this node sync with the remotes at the given ports again.
+func Heal(ports []uint16) {
+	parts.lock.Lock()
+	defer parts.lock.Unlock()
+	for _, port := range ports {
+		delete(parts.Blocked, port)
+	}
+}
+
+func (p *partitions) isPartitioned(a api.Address) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.Blocked[a.Port]
+}
@@ -6,6 +6,7 @@ package dispatch
 import (
 	"aether-core/aether/io/api"
 	// "aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	// "aether-core/aether/services/safesleep"
@@ -21,7 +22,7 @@ import (
 // We need to do this in batches of 100. Otherwise we end up with "socket: too many open files" error.
 func Pinger(fullAddressesSlice []api.Address) []api.Address {
 	// Paginate addresses first. We batch these into pages of 100, because it's very easy to run into too many open files error if you just dump it through.
-	logging.Log(2, fmt.Sprintf("Pinger is called for this number of addresses: %#d", len(fullAddressesSlice)))
+	logging.Log(2, fmt.Sprintf("Pinger is called for this number of addresses: %d", len(fullAddressesSlice)))
 	var pages [][]api.Address
 	dataSet := fullAddressesSlice
 	pgSize := globals.BackendConfig.GetPingerPageSize()
@@ -87,7 +88,7 @@ func Pinger(fullAddressesSlice []api.Address) []api.Address {
 func Ping(addr api.Address, processedAddresses chan<- api.Address) {
 	logging.Log(3, fmt.Sprintf("Connection attempt started: %v:%v", addr.Location, addr.Port))
 	var blankAddr api.Address
-	if addr.LastSuccessfulPing > api.Timestamp(time.Now().Add(time.Duration(-2)*time.Minute).Unix()) {
+	if addr.LastSuccessfulPing > api.Timestamp(clock.Now().Add(time.Duration(-2)*time.Minute).Unix()) {
 		// If it's been less than 2 minutes since we last pinged this address. We'll just pass this ping to not create excessive traffic.
 		logging.Logf(2, "We pinged this address already in the last 2 minutes. Skipping this ping and using the past result. Address: %s/%s:%d", addr.Location, addr.Sublocation, addr.Port)
 		// Mark it timestamped as now, and send it back.
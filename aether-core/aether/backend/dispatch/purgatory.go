@@ -28,72 +28,88 @@ package dispatch
 
 import (
 	"aether-core/aether/io/api"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
 	// "fmt"
 	"sync"
-	"time"
 )
 
 type Purgatory struct {
-	lock              sync.Mutex
-	BoardsPurg        []api.Board
-	ThreadsPurg       []api.Thread
-	PostsPurg         []api.Post
-	VotesPurg         []api.Vote
-	KeysPurg          []api.Key
-	TruststatesPurg   []api.Truststate
-	BoardIndexes      []api.BoardIndex
-	ThreadIndexes     []api.ThreadIndex
-	PostIndexes       []api.PostIndex
-	VoteIndexes       []api.VoteIndex
-	KeyIndexes        []api.KeyIndex
-	TruststateIndexes []api.TruststateIndex
+	lock            sync.Mutex
+	BoardsPurg      []api.Board
+	ThreadsPurg     []api.Thread
+	PostsPurg       []api.Post
+	VotesPurg       []api.Vote
+	KeysPurg        []api.Key
+	TruststatesPurg []api.Truststate
+	// The entities within the network head, as vertices, keyed by the fingerprint they point to. The items in the purgatory look for their descendants in these.
+	threadsByBoard map[api.Fingerprint][]vertex
+	postsByThread  map[api.Fingerprint][]vertex
+	postsByParent  map[api.Fingerprint][]vertex
+	byOwner        map[api.Fingerprint][]vertex // Boards, threads, posts, votes and truststates.
+	// [entityType][fingerprint]position of the items in the purgatory slices above.
+	purgPositions map[string]map[api.Fingerprint]int
+}
+
+/*
+  Everything the purgatory looks up is keyed by fingerprint, so that both taking in a page and processing the purgatory at the end of the sync are linear in the number of items. A large bootstrap can bring in tens of thousands of items, and the prior linear scans over every index for every item would stall the node at the end of such a sync.
+*/
+
+func (p *Purgatory) prepare() {
+	if p.purgPositions != nil {
+		return
+	}
+	p.threadsByBoard = make(map[api.Fingerprint][]vertex)
+	p.postsByThread = make(map[api.Fingerprint][]vertex)
+	p.postsByParent = make(map[api.Fingerprint][]vertex)
+	p.byOwner = make(map[api.Fingerprint][]vertex)
+	p.purgPositions = make(map[string]map[api.Fingerprint]int)
+	for _, entityType := range []string{"board", "thread", "post", "vote", "key", "truststate"} {
+		p.purgPositions[entityType] = make(map[api.Fingerprint]int)
+	}
+}
+
+// takeIn records the position of an item that just entered the purgatory. If the same item came in before, the first one stands.
+func (p *Purgatory) takeIn(item api.Provable, pos int) {
+	if _, ok := p.purgPositions[item.GetEntityType()][item.GetFingerprint()]; !ok {
+		p.purgPositions[item.GetEntityType()][item.GetFingerprint()] = pos
+	}
 }
 
 func (p *Purgatory) indexOf(item api.Provable) int {
-	switch entity := item.(type) {
-	case *api.Board:
-		for key, _ := range p.BoardsPurg {
-			if p.BoardsPurg[key].Fingerprint == entity.Fingerprint {
-				return key
-			}
-		}
-	case *api.Thread:
-		for key, _ := range p.ThreadsPurg {
-			if p.ThreadsPurg[key].Fingerprint == entity.Fingerprint {
-				return key
-			}
-		}
-	case *api.Post:
-		for key, _ := range p.PostsPurg {
-			if p.PostsPurg[key].Fingerprint == entity.Fingerprint {
-				return key
-			}
-		}
-	case *api.Vote:
-		for key, _ := range p.VotesPurg {
-			if p.VotesPurg[key].Fingerprint == entity.Fingerprint {
-				return key
-			}
-		}
-	case *api.Key:
-		for key, _ := range p.KeysPurg {
-			if p.KeysPurg[key].Fingerprint == entity.Fingerprint {
-				return key
-			}
-		}
-	case *api.Truststate:
-		for key, _ := range p.TruststatesPurg {
-			if p.TruststatesPurg[key].Fingerprint == entity.Fingerprint {
-				return key
-			}
-		}
+	p.prepare()
+	if pos, ok := p.purgPositions[item.GetEntityType()][item.GetFingerprint()]; ok {
+		return pos
 	}
 	return -1
 }
 
+// resetPositions rebuilds the positions after the purgatory slices are replaced.
+func (p *Purgatory) resetPositions() {
+	p.purgPositions = nil
+	p.prepare()
+	for key, _ := range p.BoardsPurg {
+		p.takeIn(&p.BoardsPurg[key], key)
+	}
+	for key, _ := range p.ThreadsPurg {
+		p.takeIn(&p.ThreadsPurg[key], key)
+	}
+	for key, _ := range p.PostsPurg {
+		p.takeIn(&p.PostsPurg[key], key)
+	}
+	for key, _ := range p.VotesPurg {
+		p.takeIn(&p.VotesPurg[key], key)
+	}
+	for key, _ := range p.KeysPurg {
+		p.takeIn(&p.KeysPurg[key], key)
+	}
+	for key, _ := range p.TruststatesPurg {
+		p.takeIn(&p.TruststatesPurg[key], key)
+	}
+}
+
 // func (p *Purgatory) remove(item api.Provable) { // there's something weird here.
 // 	switch item.(type) {
 // 	case *api.Board:
@@ -162,65 +178,61 @@ func cnvToVertexIdx(item api.ProvableIndex) vertex {
 	return v
 }
 
-func (p *Purgatory) getDirectDescendants(vs []vertex) []vertex {
+// getDirectDescendants returns the children of the given vertices that we haven't seen yet in this search.
+func (p *Purgatory) getDirectDescendants(vs []vertex, seen map[api.Fingerprint]bool) []vertex {
 	children := []vertex{}
+	add := func(cs []vertex) {
+		for key, _ := range cs {
+			if seen[cs[key].fingerprint] {
+				continue
+			}
+			seen[cs[key].fingerprint] = true
+			children = append(children, cs[key])
+		}
+	}
 	for key1, _ := range vs {
 		switch vs[key1].entityType {
 		case "board":
-			for key, _ := range p.ThreadIndexes {
-				if p.ThreadIndexes[key].Board == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.ThreadIndexes[key]))
-				}
-			}
+			add(p.threadsByBoard[vs[key1].fingerprint])
 		case "thread":
-			for key, _ := range p.PostIndexes {
-				if p.PostIndexes[key].Thread == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.PostIndexes[key]))
-				}
-			}
+			add(p.postsByThread[vs[key1].fingerprint])
 		case "post":
-			for key, _ := range p.PostIndexes {
-				if p.PostIndexes[key].Parent == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.PostIndexes[key]))
-				}
-			}
+			add(p.postsByParent[vs[key1].fingerprint])
 		case "vote":
 			// no descendants
 		case "key":
-			for key, _ := range p.BoardIndexes {
-				if p.BoardIndexes[key].Owner == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.BoardIndexes[key]))
-				}
-			}
-			for key, _ := range p.ThreadIndexes {
-				if p.ThreadIndexes[key].Owner == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.ThreadIndexes[key]))
-				}
-			}
-			for key, _ := range p.PostIndexes {
-				if p.PostIndexes[key].Owner == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.PostIndexes[key]))
-				}
-			}
-			for key, _ := range p.VoteIndexes {
-				if p.VoteIndexes[key].Owner == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.VoteIndexes[key]))
-				}
-			}
-			for key, _ := range p.TruststateIndexes {
-				if p.TruststateIndexes[key].Owner == vs[key1].fingerprint {
-					children = append(children, cnvToVertexIdx(&p.TruststateIndexes[key]))
-				}
-			}
+			add(p.byOwner[vs[key1].fingerprint])
 		case "truststate":
 			// no descendants.
 			// This means if a truststate is outside the network head, it is not taken in.
 		}
-		logging.Logf(2, "For item :%#v, Direct descendants found: %#v", vs[key1], children)
 	}
+	logging.Logf(2, "For %v items, direct descendants found: %v", len(vs), len(children))
 	return children
 }
 
+// index files an entity within the network head under the fingerprints it points to, so that the items in the purgatory can find it as their descendant.
+func (p *Purgatory) index(item api.Provable) {
+	v := cnvToVertex(item)
+	switch entity := item.(type) {
+	case *api.Board:
+		p.byOwner[entity.Owner] = append(p.byOwner[entity.Owner], v)
+	case *api.Thread:
+		p.threadsByBoard[entity.Board] = append(p.threadsByBoard[entity.Board], v)
+		p.byOwner[entity.Owner] = append(p.byOwner[entity.Owner], v)
+	case *api.Post:
+		p.postsByThread[entity.Thread] = append(p.postsByThread[entity.Thread], v)
+		p.postsByParent[entity.Parent] = append(p.postsByParent[entity.Parent], v)
+		p.byOwner[entity.Owner] = append(p.byOwner[entity.Owner], v)
+	case *api.Vote:
+		p.byOwner[entity.Owner] = append(p.byOwner[entity.Owner], v)
+	case *api.Key:
+		// Keys are nobody's descendant.
+	case *api.Truststate:
+		p.byOwner[entity.Owner] = append(p.byOwner[entity.Owner], v)
+	}
+}
+
 func getMostRecentLastModified(vs []vertex) api.Timestamp {
 	var mostRecentLm api.Timestamp
 	for key, _ := range vs {
@@ -265,19 +277,20 @@ func getMostRecentLastModified(vs []vertex) api.Timestamp {
 */
 
 // we have two conditions, get children, and so long as the NH isn't achieved, or we run out of items, in the children, we repeat. We also should have a max depth, something super high, but finite. So that if somebody comes up with a cute little idea of coming up with circular references, it won't break this.
-func (p *Purgatory) verify(item api.Provable) bool {
-	cutoff := api.Timestamp(globals.BackendConfig.GetEventHorizonTimestamp())
+func (p *Purgatory) verify(cutoff api.Timestamp, item api.Provable) bool {
 	// nhD := globals.BackendConfig.GetNetworkHeadDays()
 	// nhCutoff := api.Timestamp(toolbox.CnvToCutoffDays(nhD))
 	v := cnvToVertex(item)
 	toBeSearched := []vertex{v}
+	// Every vertex is visited once in a search. This also takes care of the circular references.
+	seen := map[api.Fingerprint]bool{v.fingerprint: true}
 	itercount := 0
 	var mostRecentLastModified api.Timestamp
 	for cutoff > mostRecentLastModified {
 		if itercount > 1000 {
 			return false
 		}
-		dd := p.getDirectDescendants(toBeSearched)
+		dd := p.getDirectDescendants(toBeSearched, seen)
 		if len(dd) == 0 {
 			// logging.Logf(2, "This item ran out of ancestors before achieving cutoff. Item: %#v", v)
 			return false
@@ -295,6 +308,8 @@ func (p *Purgatory) verify(item api.Provable) bool {
 }
 
 func (p *Purgatory) process() {
+	p.prepare()
+	cutoff := api.Timestamp(globals.BackendConfig.GetEventHorizonTimestamp())
 	newB := []api.Board{}
 	newT := []api.Thread{}
 	newP := []api.Post{}
@@ -302,32 +317,32 @@ func (p *Purgatory) process() {
 	newK := []api.Key{}
 	newTs := []api.Truststate{}
 	for key, _ := range p.BoardsPurg {
-		if p.verify(&p.BoardsPurg[key]) {
+		if p.verify(cutoff, &p.BoardsPurg[key]) {
 			newB = append(newB, p.BoardsPurg[key])
 		}
 	}
 	for key, _ := range p.ThreadsPurg {
-		if p.verify(&p.ThreadsPurg[key]) {
+		if p.verify(cutoff, &p.ThreadsPurg[key]) {
 			newT = append(newT, p.ThreadsPurg[key])
 		}
 	}
 	for key, _ := range p.PostsPurg {
-		if p.verify(&p.PostsPurg[key]) {
+		if p.verify(cutoff, &p.PostsPurg[key]) {
 			newP = append(newP, p.PostsPurg[key])
 		}
 	}
 	for key, _ := range p.VotesPurg {
-		if p.verify(&p.VotesPurg[key]) {
+		if p.verify(cutoff, &p.VotesPurg[key]) {
 			newV = append(newV, p.VotesPurg[key])
 		}
 	}
 	for key, _ := range p.KeysPurg {
-		if p.verify(&p.KeysPurg[key]) {
+		if p.verify(cutoff, &p.KeysPurg[key]) {
 			newK = append(newK, p.KeysPurg[key])
 		}
 	}
 	for key, _ := range p.TruststatesPurg {
-		if p.verify(&p.TruststatesPurg[key]) {
+		if p.verify(cutoff, &p.TruststatesPurg[key]) {
 			newTs = append(newTs, p.TruststatesPurg[key])
 		}
 	}
@@ -337,6 +352,7 @@ func (p *Purgatory) process() {
 	p.VotesPurg = newV
 	p.KeysPurg = newK
 	p.TruststatesPurg = newTs
+	p.resetPositions()
 }
 
 func (p *Purgatory) convertAllToIface() []interface{} {
@@ -366,9 +382,9 @@ func (p *Purgatory) convertAllToIface() []interface{} {
 func (p *Purgatory) Process() []interface{} {
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	start := time.Now()
+	start := clock.Now()
 	p.process()
-	elapsed := time.Since(start)
+	elapsed := clock.Since(start)
 	logging.Logf(2, "This purgatory process run took %vs.", toolbox.Round(elapsed.Seconds(), 0.1))
 	logging.Logf(2, "At the end of this purgatory process run, this is our purgatory: B: %v, T: %v, P: %v, V: %v, K: %v, TS: %v.\n", len(p.BoardsPurg), len(p.ThreadsPurg), len(p.PostsPurg), len(p.VotesPurg), len(p.KeysPurg), len(p.TruststatesPurg))
 	resultAsIface := p.convertAllToIface()
@@ -394,6 +410,7 @@ func (p *Purgatory) accept(items []api.Provable) {
 	// nhD := globals.BackendConfig.GetNetworkHeadDays()
 	// nhCutoff := api.Timestamp(toolbox.CnvToCutoffDays(nhD))
 	cutoff := api.Timestamp(globals.BackendConfig.GetEventHorizonTimestamp()) // todo: should purgatory be gated on network head or event horizon?
+	p.prepare()
 	for key, _ := range items {
 		// gate := calcGate(items[key])
 		if cutoff > items[key].GetLastModified() {
@@ -401,147 +418,92 @@ func (p *Purgatory) accept(items []api.Provable) {
 			switch entity := items[key].(type) {
 			case *api.Board:
 				p.BoardsPurg = append(p.BoardsPurg, *entity)
+				p.takeIn(entity, len(p.BoardsPurg)-1)
 			case *api.Thread:
 				p.ThreadsPurg = append(p.ThreadsPurg, *entity)
+				p.takeIn(entity, len(p.ThreadsPurg)-1)
 			case *api.Post:
 				p.PostsPurg = append(p.PostsPurg, *entity)
+				p.takeIn(entity, len(p.PostsPurg)-1)
 			case *api.Vote:
 				p.VotesPurg = append(p.VotesPurg, *entity)
+				p.takeIn(entity, len(p.VotesPurg)-1)
 			case *api.Key:
 				p.KeysPurg = append(p.KeysPurg, *entity)
+				p.takeIn(entity, len(p.KeysPurg)-1)
 			case *api.Truststate:
 				p.TruststatesPurg = append(p.TruststatesPurg, *entity)
+				p.takeIn(entity, len(p.TruststatesPurg)-1)
 			}
 		} else {
-			// Entity is within the network head. We don't take it into the purgatory, but we get its index, so we can use it to search for items in the purgatory.
-			switch entity := items[key].(type) {
-			case *api.Board:
-				index := api.BoardIndex{
-					Fingerprint: entity.Fingerprint,
-					Owner:       entity.Owner,
-					Creation:    entity.Creation,
-					LastUpdate:  entity.LastUpdate,
-				}
-				p.BoardIndexes = append(p.BoardIndexes, index)
-			case *api.Thread:
-				index := api.ThreadIndex{
-					Fingerprint: entity.Fingerprint,
-					Owner:       entity.Owner,
-					Board:       entity.Board,
-					Creation:    entity.Creation,
-					LastUpdate:  entity.LastUpdate,
-				}
-				p.ThreadIndexes = append(p.ThreadIndexes, index)
-			case *api.Post:
-				index := api.PostIndex{
-					Fingerprint: entity.Fingerprint,
-					Owner:       entity.Owner,
-					Board:       entity.Board,
-					Thread:      entity.Thread,
-					Parent:      entity.Parent,
-					Creation:    entity.Creation,
-					LastUpdate:  entity.LastUpdate,
-				}
-				p.PostIndexes = append(p.PostIndexes, index)
-			case *api.Vote:
-				index := api.VoteIndex{
-					Fingerprint: entity.Fingerprint,
-					Owner:       entity.Owner,
-					Board:       entity.Board,
-					Thread:      entity.Thread,
-					Target:      entity.Target,
-					Creation:    entity.Creation,
-					LastUpdate:  entity.LastUpdate,
-				}
-				p.VoteIndexes = append(p.VoteIndexes, index)
-			case *api.Key:
-				index := api.KeyIndex{
-					Fingerprint: entity.Fingerprint,
-					Creation:    entity.Creation,
-					LastUpdate:  entity.LastUpdate,
-				}
-				p.KeyIndexes = append(p.KeyIndexes, index)
-			case *api.Truststate:
-				index := api.TruststateIndex{
-					Fingerprint: entity.Fingerprint,
-					Owner:       entity.Owner,
-					Target:      entity.Target,
-					Creation:    entity.Creation,
-					LastUpdate:  entity.LastUpdate,
-				}
-				p.TruststateIndexes = append(p.TruststateIndexes, index)
-			}
+			// Entity is within the network head. We don't take it into the purgatory, but we index it, so we can use it to search for items in the purgatory.
+			p.index(items[key])
 		}
 	}
 }
 
+// removeFromResp removes the items that are in the purgatory from the response, so that they're not inserted with the rest of it.
 func (p *Purgatory) removeFromResp(r *api.Response) {
 	if len(r.Boards) > 0 {
-		removalIdxs := []int{}
-		for key, _ := range p.BoardsPurg {
-			idx := r.IndexOf(&p.BoardsPurg[key])
-			if idx != -1 {
-				removalIdxs = append(removalIdxs, idx)
+		retained := []api.Board{}
+		for key, _ := range r.Boards {
+			if p.indexOf(&r.Boards[key]) == -1 {
+				retained = append(retained, r.Boards[key])
 			}
 		}
-		r.MassRemoveByIndex(removalIdxs, "board")
+		r.Boards = retained
 	}
 	if len(r.Threads) > 0 {
-		removalIdxs := []int{}
-		for key, _ := range p.ThreadsPurg {
-			idx := r.IndexOf(&p.ThreadsPurg[key])
-			if idx != -1 {
-				removalIdxs = append(removalIdxs, idx)
+		retained := []api.Thread{}
+		for key, _ := range r.Threads {
+			if p.indexOf(&r.Threads[key]) == -1 {
+				retained = append(retained, r.Threads[key])
 			}
 		}
-		r.MassRemoveByIndex(removalIdxs, "thread")
+		r.Threads = retained
 	}
 	if len(r.Posts) > 0 {
-		removalIdxs := []int{}
-		for key, _ := range p.PostsPurg {
-			idx := r.IndexOf(&p.PostsPurg[key])
-			if idx != -1 {
-				removalIdxs = append(removalIdxs, idx)
+		retained := []api.Post{}
+		for key, _ := range r.Posts {
+			if p.indexOf(&r.Posts[key]) == -1 {
+				retained = append(retained, r.Posts[key])
 			}
 		}
-		r.MassRemoveByIndex(removalIdxs, "post")
+		r.Posts = retained
 	}
 	if len(r.Votes) > 0 {
-		removalIdxs := []int{}
-		for key, _ := range p.VotesPurg {
-			idx := r.IndexOf(&p.VotesPurg[key])
-			if idx != -1 {
-				removalIdxs = append(removalIdxs, idx)
+		retained := []api.Vote{}
+		for key, _ := range r.Votes {
+			if p.indexOf(&r.Votes[key]) == -1 {
+				retained = append(retained, r.Votes[key])
 			}
 		}
-		r.MassRemoveByIndex(removalIdxs, "vote")
+		r.Votes = retained
 	}
 	if len(r.Keys) > 0 {
-		removalIdxs := []int{}
-		for key, _ := range p.KeysPurg {
-			idx := r.IndexOf(&p.KeysPurg[key])
-			if idx != -1 {
-				removalIdxs = append(removalIdxs, idx)
+		retained := []api.Key{}
+		for key, _ := range r.Keys {
+			if p.indexOf(&r.Keys[key]) == -1 {
+				retained = append(retained, r.Keys[key])
 			}
 		}
-		r.MassRemoveByIndex(removalIdxs, "key")
+		r.Keys = retained
 	}
 	if len(r.Truststates) > 0 {
-		removalIdxs := []int{}
-		for key, _ := range p.TruststatesPurg {
-			idx := r.IndexOf(&p.TruststatesPurg[key])
-			if idx != -1 {
-				removalIdxs = append(removalIdxs, idx)
+		retained := []api.Truststate{}
+		for key, _ := range r.Truststates {
+			if p.indexOf(&r.Truststates[key]) == -1 {
+				retained = append(retained, r.Truststates[key])
 			}
 		}
-		r.MassRemoveByIndex(removalIdxs, "truststate")
+		r.Truststates = retained
 	}
 }
 
 func (p *Purgatory) Filter(r *api.Response) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	start := time.Now()
+	start := clock.Now()
 	bProv := []api.Provable{}
 	for key, _ := range r.Boards {
 		bProv = append(bProv, api.Provable(&r.Boards[key]))
@@ -577,7 +539,7 @@ func (p *Purgatory) Filter(r *api.Response) {
 	p.removeFromResp(r)
 	logging.Logf(2, "At the end of this purgatory run, this is our response: B: %v, T: %v, P: %v, V: %v, K: %v, TS: %v.\n", len(r.Boards), len(r.Threads), len(r.Posts), len(r.Votes), len(r.Keys), len(r.Truststates))
 
-	elapsed := time.Since(start)
+	elapsed := clock.Since(start)
 	logging.Logf(2, "At the end of this purgatory filter run, this is our purgatory: B: %v, T: %v, P: %v, V: %v, K: %v, TS: %v.\n", len(p.BoardsPurg), len(p.ThreadsPurg), len(p.PostsPurg), len(p.VotesPurg), len(p.KeysPurg), len(p.TruststatesPurg))
 	logging.Logf(2, "This purgatory filter run took %vs.", toolbox.Round(elapsed.Seconds(), 0.1))
 }
@@ -0,0 +1,124 @@
+package dispatch
+
+// These test the purgatory's mapping of the old items to their descendants within the network head, and benchmark it at the sizes a large bootstrap brings in.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+var purgCutoff = api.Timestamp(time.Now().Add(-24 * time.Hour).Unix())
+
+func setupPurgatoryConfig() {
+	globals.BackendConfig = &configstore.BackendConfig{
+		Initialised:           true,
+		EventHorizonTimestamp: int64(purgCutoff),
+	}
+}
+
+func old(i int) api.ProvableFieldSet {
+	return api.ProvableFieldSet{
+		Fingerprint: api.Fingerprint(fmt.Sprintf("old-%d", i)),
+		Creation:    purgCutoff - 1000,
+	}
+}
+
+func fresh(prefix string, i int) api.ProvableFieldSet {
+	return api.ProvableFieldSet{
+		Fingerprint: api.Fingerprint(fmt.Sprintf("%s-%d", prefix, i)),
+		Creation:    purgCutoff + 1000,
+	}
+}
+
+// syntheticResponse generates a response with n threads and n posts within the network head, and n old threads, n old posts and n old keys. Half of the old items have a descendant within the network head, half don't.
+func syntheticResponse(n int) api.Response {
+	r := api.Response{}
+	for i := 0; i < n; i++ {
+		// Old items. The even ones have descendants below, the odd ones don't.
+		r.Threads = append(r.Threads, api.Thread{ProvableFieldSet: old(3 * i), Board: "board", Owner: api.Fingerprint(fmt.Sprintf("old-%d", 3*i+2))})
+		r.Posts = append(r.Posts, api.Post{ProvableFieldSet: old(3*i + 1), Board: "board", Thread: "thread", Parent: "thread", Owner: api.Fingerprint(fmt.Sprintf("old-%d", 3*i+2))})
+		r.Keys = append(r.Keys, api.Key{ProvableFieldSet: old(3*i + 2)})
+		// Fresh items.
+		if i%2 == 0 {
+			r.Posts = append(r.Posts, api.Post{ProvableFieldSet: fresh("post", i), Board: "board", Thread: api.Fingerprint(fmt.Sprintf("old-%d", 3*i)), Parent: api.Fingerprint(fmt.Sprintf("old-%d", 3*i+1)), Owner: api.Fingerprint(fmt.Sprintf("old-%d", 3*i+2))})
+		} else {
+			r.Posts = append(r.Posts, api.Post{ProvableFieldSet: fresh("post", i), Board: "board", Thread: "thread", Parent: "thread", Owner: "someone"})
+		}
+		r.Threads = append(r.Threads, api.Thread{ProvableFieldSet: fresh("thread", i), Board: "board", Owner: "someone"})
+	}
+	return r
+}
+
+// Tests
+
+func TestPurgatoryProcess_KeepsOnlyAncestors_Success(t *testing.T) {
+	setupPurgatoryConfig()
+	p := Purgatory{}
+	r := syntheticResponse(10)
+	p.Filter(&r)
+	if len(r.Threads) != 10 || len(r.Posts) != 10 || len(r.Keys) != 0 {
+		t.Errorf("The old items should have been taken out of the response. Threads: %v, Posts: %v, Keys: %v", len(r.Threads), len(r.Posts), len(r.Keys))
+	}
+	result := p.Process()
+	if len(result) != 15 {
+		t.Errorf("Only the old items with descendants should have been released. Expected: 15, Got: %v", len(result))
+	}
+	for _, item := range result {
+		var fp api.Fingerprint
+		switch entity := item.(type) {
+		case api.Thread:
+			fp = entity.Fingerprint
+		case api.Post:
+			fp = entity.Fingerprint
+		case api.Key:
+			fp = entity.Fingerprint
+		}
+		var i int
+		fmt.Sscanf(string(fp), "old-%d", &i)
+		if (i/3)%2 != 0 {
+			t.Errorf("An old item without a descendant was released. Fingerprint: %v", fp)
+		}
+	}
+}
+
+func TestPurgatoryProcess_CircularReference_Success(t *testing.T) {
+	setupPurgatoryConfig()
+	p := Purgatory{}
+	r := api.Response{
+		Posts: []api.Post{
+			{ProvableFieldSet: old(1), Thread: "thread", Parent: "old-2"},
+			{ProvableFieldSet: old(2), Thread: "thread", Parent: "old-1"},
+		},
+	}
+	p.Filter(&r)
+	result := p.Process()
+	if len(result) != 0 {
+		t.Errorf("Old items referring to each other should not be released. Got: %v", len(result))
+	}
+}
+
+// Benchmarks
+
+func benchmarkPurgatory(n int, b *testing.B) {
+	setupPurgatoryConfig()
+	resps := []api.Response{}
+	for i := 0; i < b.N; i++ {
+		resps = append(resps, syntheticResponse(n))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := Purgatory{}
+		p.Filter(&resps[i])
+		p.Process()
+	}
+}
+
+func BenchmarkPurgatory_10000(b *testing.B) { benchmarkPurgatory(10000, b) }
+func BenchmarkPurgatory_25000(b *testing.B) { benchmarkPurgatory(25000, b) }
+func BenchmarkPurgatory_50000(b *testing.B) { benchmarkPurgatory(50000, b) }
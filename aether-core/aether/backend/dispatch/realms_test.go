@@ -0,0 +1,78 @@
+package dispatch
+
+// These test that a node declares its realms in a form only the other members of the realm can recognise.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"testing"
+)
+
+// Infrastructure
+
+const (
+	ourKey    = "our node public key"
+	remoteKey = "remote node public key"
+	otherKey  = "other node public key"
+)
+
+func setupRealms(realms []string) func() {
+	priorConfig := globals.BackendConfig
+	priorTransient := globals.BackendTransientConfig
+	globals.BackendConfig = &configstore.BackendConfig{
+		Initialised:  true,
+		ServedRealms: realms,
+	}
+	// The realms are a backend setting. Without the transient config, the API layer thinks it's in the frontend.
+	globals.BackendTransientConfig = &configstore.BackendTransientConfig{}
+	return func() {
+		globals.BackendConfig = priorConfig
+		globals.BackendTransientConfig = priorTransient
+	}
+}
+
+// Tests
+
+func TestRealmTags_DoNotCarryTheRealm_Success(t *testing.T) {
+	tags := api.RealmTags([]api.Fingerprint{"secretrealm"}, ourKey)
+	if len(tags) != 1 {
+		t.Fatalf("There should be one tag per realm. Tags: %#v", tags)
+	}
+	if tags[0] == "secretrealm" {
+		t.Errorf("The tag should not be the realm itself.")
+	}
+	if api.RealmTag("secretrealm", ourKey) == api.RealmTag("secretrealm", otherKey) {
+		t.Errorf("Two nodes in the same realm should declare it under different tags.")
+	}
+}
+
+func TestSharedRealms_Success(t *testing.T) {
+	defer setupRealms([]string{"realm1", "realm2"})()
+	remote := api.Protocol{Realms: api.RealmTags([]api.Fingerprint{"realm2", "realm3"}, remoteKey)}
+	shared := api.SharedRealms(remote, remoteKey)
+	if len(shared) != 1 || shared[0] != "realm2" {
+		t.Errorf("Only the realm both nodes carry should be shared. Shared: %#v", shared)
+	}
+}
+
+func TestSharedRealms_PlainRealmIds_Fail(t *testing.T) {
+	defer setupRealms([]string{"realm1"})()
+	// A remote that declares the RealmId as is, such as a node that guesses it, doesn't get in.
+	remote := api.Protocol{Realms: []api.Fingerprint{"realm1"}}
+	if shared := api.SharedRealms(remote, remoteKey); len(shared) != 0 {
+		t.Errorf("A realm declared in the clear should not be shared. Shared: %#v", shared)
+	}
+}
+
+func TestSharedRealms_TagsOfAnotherNode_Fail(t *testing.T) {
+	defer setupRealms([]string{"realm1"})()
+	// A non-member that copies the tags of a member, and declares them under its own key, doesn't get in.
+	remote := api.Protocol{Realms: api.RealmTags([]api.Fingerprint{"realm1"}, otherKey)}
+	if shared := api.SharedRealms(remote, remoteKey); len(shared) != 0 {
+		t.Errorf("Tags copied from another node should not be shared. Shared: %#v", shared)
+	}
+	if shared := api.SharedRealms(remote, ""); len(shared) != 0 {
+		t.Errorf("A remote without a public key should not share any realms. Shared: %#v", shared)
+	}
+}
@@ -0,0 +1,295 @@
+// Backend > Dispatch > Reputation
+// This subsystem remembers how useful and how well-behaved the remotes were in our syncs with them, so that the address selection can prefer the good ones, and stay away from the ones that serve invalid data for a while.
+
+package dispatch
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/logging"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+How this works:
+
+Every remote we sync with has a reputation, keyed by its address, the same way as the addresses table. We count:
+
+- How our syncs with it ended: successes, failures, and out of the failures, the timeouts.
+- How many entities we received from it in the successful syncs (the InsertMetrics yield).
+- How many entities it sent that failed the verification, and how many of its pages we rejected as a whole (bad page signature, failed bounds check, or too many invalid entities in one page).
+- How many of the items it sent ended up discarded in the purgatory.
+
+Out of these, we compute a score between 0 and 1. A remote we know nothing about scores 0.5. Remotes that reliably send a lot of valid, useful data score higher, the ones that fail, time out, or send data we end up throwing away score lower. The address selection (findOnlineNodesV2, and through it, Scout and GetUnconnAddr) puts the higher scores first, and so does the neighbour watch when it picks which neighbour to sync with next.
+
+A rejected page is an offence. A remote that commits banOffenceThreshold offences within banOffenceWindow is banned: we don't connect to it, the address selection skips it, and the neighbour watch drops it from the neighbours. Every repeat ban doubles the duration, up to banMaxDuration. Entities failing the verification in a page that was otherwise accepted lower the score, but they don't count as an offence. A few of those can happen in good faith, such as when the board of a thread hasn't arrived yet. Entities that only fail a check of this node's own policy, such as a board PoW declaration or a meta field an older build doesn't know about, never get a page rejected. See api.IsPolicyFailure.
+
+The reputations are kept in memory, and the ones that changed are saved to the database at the end of every sync. The ones that haven't been updated in reputationExpiry are dropped when they're first loaded.
+*/
+
+const (
+	banOffenceThreshold = 3
+	banOffenceWindow    = 24 * time.Hour
+	banBaseDuration     = 6 * time.Hour
+	banMaxDuration      = 7 * 24 * time.Hour
+	reputationExpiry    = 30 * 24 * time.Hour
+	// After this many syncs, the sync counts of a remote are halved, so that what it did recently weighs more than what it did a long time ago.
+	reputationHalvingPoint = 100
+)
+
+type peerReputations struct {
+	lock   sync.Mutex
+	loaded bool
+	Reps   map[string]*persistence.DbPeerReputation
+	dirty  map[string]bool
+}
+
+var peerReps peerReputations
+
+// syncReport is what a sync found out about the remote, handed to the reputations when the sync ends.
+type syncReport struct {
+	addr              api.Address
+	received          int64
+	purgatoryDiscards int64
+}
+
+// ReportInvalidPage counts the invalid entities and the rejected pages against the remote that served them. This is the hook the API layer calls from the fetcher.
+func ReportInvalidPage(host string, subhost string, port uint16, invalidEntities int, rejected bool) {
+	peerReps.lock.Lock()
+	defer peerReps.lock.Unlock()
+	peerReps.prepare()
+	r := peerReps.get(api.Location(host), api.Location(subhost), port)
+	now := clock.Now()
+	r.InvalidEntities += int64(invalidEntities)
+	if rejected {
+		r.RejectedPages++
+		peerReps.offend(r, now)
+	}
+	peerReps.touch(r, now)
+}
+
+// IsBanned tells whether the remote is serving a ban for repeatedly sending invalid data.
+func (pr *peerReputations) IsBanned(a api.Address) bool {
+	pr.lock.Lock()
+	defer pr.lock.Unlock()
+	pr.prepare()
+	return pr.isBanned(a, clock.Now())
+}
+
+// rank removes the banned remotes from the addresses, and orders the rest by their score, highest first. Addresses with the same score keep their order.
+func (pr *peerReputations) rank(addrs []api.Address) []api.Address {
+	pr.lock.Lock()
+	defer pr.lock.Unlock()
+	pr.prepare()
+	now := clock.Now()
+	ranked := []api.Address{}
+	scores := make(map[string]float64)
+	for k, _ := range addrs {
+		if pr.isBanned(addrs[k], now) {
+			continue
+		}
+		key := reputationKey(addrs[k].Location, addrs[k].Sublocation, addrs[k].Port)
+		if r, ok := pr.Reps[key]; ok {
+			scores[key] = reputationScore(r)
+		} else {
+			scores[key] = reputationScore(&persistence.DbPeerReputation{})
+		}
+		ranked = append(ranked, addrs[k])
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[reputationKey(ranked[i].Location, ranked[i].Sublocation, ranked[i].Port)] >
+			scores[reputationKey(ranked[j].Location, ranked[j].Sublocation, ranked[j].Port)]
+	})
+	return ranked
+}
+
+// syncEnded counts how the sync went, and saves the reputations that changed since the last save.
+func (pr *peerReputations) syncEnded(rep syncReport, err error) {
+	if isBlank(rep.addr) {
+		// A reverse sync that failed before we knew who the remote was.
+		return
+	}
+	if err != nil && strings.Contains(err.Error(), "Already syncing with this remote") || err == errPartitioned {
+		// Not the remote's doing.
+		return
+	}
+	pr.lock.Lock()
+	pr.prepare()
+	r := pr.get(rep.addr.Location, rep.addr.Sublocation, rep.addr.Port)
+	r.PurgatoryDiscards += rep.purgatoryDiscards
+	if err == nil {
+		r.Successes++
+		r.Received += rep.received
+	} else {
+		r.Failures++
+		if isTimeout(err) {
+			r.Timeouts++
+		}
+	}
+	if r.Successes+r.Failures > reputationHalvingPoint {
+		halve(r)
+	}
+	pr.touch(r, clock.Now())
+	changed := pr.takeDirty()
+	pr.lock.Unlock()
+	// The DB write happens outside the lock, so that the address selection of the other syncs doesn't wait on it.
+	err2 := persistence.InsertOrUpdatePeerReputations(changed)
+	if err2 != nil {
+		logging.Logf(1, "Saving the peer reputations at the end of the sync failed. Error: %v", err2)
+	}
+}
+
+/*----------  Scoring  ----------*/
+
+// reputationScore is between 0 and 1. It's the product of how reliable the remote is, how much of what it sends is valid, and how much of what it sends we keep. A remote we have no data on scores 0.5.
+func reputationScore(r *persistence.DbPeerReputation) float64 {
+	// Timeouts are failures already, they count twice because they hold a lease for the whole timeout.
+	reliability := float64(r.Successes+1) / float64(r.Successes+r.Failures+r.Timeouts+2)
+	validity := float64(r.Received+1) / float64(r.Received+1+r.InvalidEntities+10*r.RejectedPages)
+	usefulness := float64(r.Received+1) / float64(r.Received+1+r.PurgatoryDiscards)
+	return reliability * validity * usefulness
+}
+
+func halve(r *persistence.DbPeerReputation) {
+	r.Successes = r.Successes / 2
+	r.Failures = r.Failures / 2
+	r.Timeouts = r.Timeouts / 2
+	r.Received = r.Received / 2
+	r.InvalidEntities = r.InvalidEntities / 2
+	r.RejectedPages = r.RejectedPages / 2
+	r.PurgatoryDiscards = r.PurgatoryDiscards / 2
+}
+
+// offend counts an offence against the remote, and bans it if it has committed enough of them recently.
+func (pr *peerReputations) offend(r *persistence.DbPeerReputation, now time.Time) {
+	if now.Sub(time.Unix(int64(r.LastOffence), 0)) > banOffenceWindow {
+		r.RecentOffences = 0
+	}
+	r.RecentOffences++
+	r.LastOffence = api.Timestamp(now.Unix())
+	if r.RecentOffences < banOffenceThreshold {
+		return
+	}
+	r.Bans++
+	dur := banBaseDuration
+	for i := 1; i < r.Bans && dur < banMaxDuration; i++ {
+		dur = dur * 2
+	}
+	if dur > banMaxDuration {
+		dur = banMaxDuration
+	}
+	r.BannedUntil = api.Timestamp(now.Add(dur).Unix())
+	r.RecentOffences = 0
+	logging.Logf(1, "Remote %s:%d has repeatedly sent us invalid data. It's banned until %v. This is its ban #%v.", r.Location, r.Port, time.Unix(int64(r.BannedUntil), 0), r.Bans)
+}
+
+// purgatoryDiscards is how many of the items held in the purgatory were not released to be inserted.
+func purgatoryDiscards(held map[string]int64, released int) int64 {
+	var total int64
+	for _, val := range held {
+		total += val
+	}
+	if total < int64(released) {
+		return 0
+	}
+	return total - int64(released)
+}
+
+// receivedCount is the total of the entities received in a sync, of all types.
+func receivedCount(ims []persistence.InsertMetrics) int64 {
+	im := persistence.InsertMetrics{}
+	for _, val := range ims {
+		im.Add(val)
+	}
+	return int64(im.BoardsReceived + im.ThreadsReceived + im.PostsReceived + im.VotesReceived + im.KeysReceived + im.TruststatesReceived + im.DirectMessagesReceived + im.AddressesReceived)
+}
+
+func isTimeout(err error) bool {
+	e := strings.ToLower(err.Error())
+	return strings.Contains(e, "timeout") || strings.Contains(e, "deadline exceeded")
+}
+
+/*----------  Maintenance / service methods  ----------*/
+
+// prepare loads the reputations from the database the first time they're needed.
+func (pr *peerReputations) prepare() {
+	if pr.loaded {
+		return
+	}
+	pr.loaded = true
+	pr.Reps = make(map[string]*persistence.DbPeerReputation)
+	pr.dirty = make(map[string]bool)
+	err := persistence.DeletePeerReputationsBefore(api.Timestamp(clock.Now().Add(-reputationExpiry).Unix()))
+	if err != nil {
+		logging.Logf(1, "Removing the expired peer reputations failed. Error: %v", err)
+	}
+	reps, err2 := persistence.ReadDbPeerReputations()
+	if err2 != nil {
+		logging.Logf(1, "Reading the peer reputations failed. We'll start from scratch. Error: %v", err2)
+		return
+	}
+	for k, _ := range reps {
+		r := reps[k]
+		pr.Reps[reputationKey(r.Location, r.Sublocation, r.Port)] = &r
+	}
+}
+
+func (pr *peerReputations) get(loc, subloc api.Location, port uint16) *persistence.DbPeerReputation {
+	key := reputationKey(loc, subloc, port)
+	r, ok := pr.Reps[key]
+	if !ok {
+		r = &persistence.DbPeerReputation{Location: loc, Sublocation: subloc, Port: port}
+		pr.Reps[key] = r
+	}
+	return r
+}
+
+func (pr *peerReputations) touch(r *persistence.DbPeerReputation, now time.Time) {
+	r.LastUpdate = api.Timestamp(now.Unix())
+	pr.dirty[reputationKey(r.Location, r.Sublocation, r.Port)] = true
+}
+
+func (pr *peerReputations) takeDirty() []persistence.DbPeerReputation {
+	changed := []persistence.DbPeerReputation{}
+	for key, _ := range pr.dirty {
+		if r, ok := pr.Reps[key]; ok {
+			changed = append(changed, *r)
+		}
+	}
+	pr.dirty = make(map[string]bool)
+	return changed
+}
+
+func (pr *peerReputations) isBanned(a api.Address, now time.Time) bool {
+	r, ok := pr.Reps[reputationKey(a.Location, a.Sublocation, a.Port)]
+	if !ok {
+		return false
+	}
+	return int64(r.BannedUntil) > now.Unix()
+}
+
+// bannedCount is the number of remotes serving a ban right now.
+func (pr *peerReputations) bannedCount() int {
+	pr.lock.Lock()
+	defer pr.lock.Unlock()
+	if !pr.loaded {
+		return 0
+	}
+	now := clock.Now().Unix()
+	c := 0
+	for _, r := range pr.Reps {
+		if int64(r.BannedUntil) > now {
+			c++
+		}
+	}
+	return c
+}
+
+func reputationKey(loc, subloc api.Location, port uint16) string {
+	return fmt.Sprintf("%s %s %d", loc, subloc, port)
+}
@@ -0,0 +1,215 @@
+package dispatch
+
+// These test the peer reputations: how the score moves with what a remote does, when a remote gets banned and for how long, and the order the address selection and the neighbour watch get the remotes in.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/configstore"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+// setReputation puts in what we know about the remote, as if it had been loaded from the database.
+func setReputation(a api.Address, r persistence.DbPeerReputation) {
+	peerReps.lock.Lock()
+	defer peerReps.lock.Unlock()
+	r.Location, r.Sublocation, r.Port = a.Location, a.Sublocation, a.Port
+	peerReps.Reps[reputationKey(a.Location, a.Sublocation, a.Port)] = &r
+}
+
+func reputationOf(a api.Address) persistence.DbPeerReputation {
+	peerReps.lock.Lock()
+	defer peerReps.lock.Unlock()
+	return *peerReps.get(a.Location, a.Sublocation, a.Port)
+}
+
+func rejectPages(a api.Address, n int) {
+	for i := 0; i < n; i++ {
+		ReportInvalidPage(string(a.Location), string(a.Sublocation), a.Port, 1, true)
+	}
+}
+
+func addrPorts(addrs []api.Address) []uint16 {
+	ps := []uint16{}
+	for _, a := range addrs {
+		ps = append(ps, a.Port)
+	}
+	return ps
+}
+
+func samePorts(a, b []uint16) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, _ := range a {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Tests
+
+func TestReputationScore_Success(t *testing.T) {
+	unknown := reputationScore(&persistence.DbPeerReputation{})
+	if unknown != 0.5 {
+		t.Errorf("A remote we know nothing about should score 0.5. Got: %v", unknown)
+	}
+	cases := []struct {
+		name   string
+		r      persistence.DbPeerReputation
+		better bool
+	}{
+		{"reliable and useful", persistence.DbPeerReputation{Successes: 20, Received: 5000}, true},
+		{"failing", persistence.DbPeerReputation{Failures: 5}, false},
+		{"sending invalid entities", persistence.DbPeerReputation{Successes: 5, Received: 100, InvalidEntities: 500}, false},
+		{"sending rejected pages", persistence.DbPeerReputation{Successes: 5, Received: 100, RejectedPages: 50}, false},
+		{"sending what we discard", persistence.DbPeerReputation{Successes: 5, Received: 100, PurgatoryDiscards: 1000}, false},
+	}
+	for _, c := range cases {
+		score := reputationScore(&c.r)
+		if score < 0 || score > 1 {
+			t.Errorf("The score should be between 0 and 1. Case: %v, Got: %v", c.name, score)
+		}
+		if (score > unknown) != c.better {
+			t.Errorf("Case: %v, Better than unknown expected: %v, Got: %v", c.name, c.better, score)
+		}
+	}
+	failing := reputationScore(&persistence.DbPeerReputation{Failures: 5})
+	timingOut := reputationScore(&persistence.DbPeerReputation{Failures: 5, Timeouts: 5})
+	if timingOut >= failing {
+		t.Errorf("Timeouts should cost more than other failures. Failing: %v, Timing out: %v", failing, timingOut)
+	}
+}
+
+func TestReputationHalve_KeepsTheScore_Success(t *testing.T) {
+	r := persistence.DbPeerReputation{Successes: 80, Failures: 20, Received: 8000, InvalidEntities: 40}
+	before := reputationScore(&r)
+	halve(&r)
+	if r.Successes != 40 || r.Failures != 10 || r.Received != 4000 || r.InvalidEntities != 20 {
+		t.Errorf("The counts should be halved. Got: %#v", r)
+	}
+	if after := reputationScore(&r); after < before-0.05 || after > before+0.05 {
+		t.Errorf("Halving should keep the score about the same. Before: %v, After: %v", before, after)
+	}
+}
+
+func TestReputation_BanThreshold_Success(t *testing.T) {
+	_, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	a := simAddr(51000, 2)
+	rejectPages(a, banOffenceThreshold-1)
+	if peerReps.IsBanned(a) {
+		t.Errorf("The remote should not be banned before %d rejected pages.", banOffenceThreshold)
+	}
+	rejectPages(a, 1)
+	if !peerReps.IsBanned(a) {
+		t.Errorf("The remote should be banned after %d rejected pages.", banOffenceThreshold)
+	}
+	r := reputationOf(a)
+	if r.Bans != 1 || r.RejectedPages != int64(banOffenceThreshold) || r.RecentOffences != 0 {
+		t.Errorf("The ban should be counted, and the offences reset. Got: %#v", r)
+	}
+	if time.Unix(int64(r.BannedUntil), 0) != clock.Now().Add(banBaseDuration) {
+		t.Errorf("The first ban should last %v. Banned until: %v", banBaseDuration, time.Unix(int64(r.BannedUntil), 0))
+	}
+}
+
+func TestReputation_InvalidEntitiesInAcceptedPages_Fail(t *testing.T) {
+	_, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	a := simAddr(51000, 2)
+	for i := 0; i < 10*banOffenceThreshold; i++ {
+		ReportInvalidPage(string(a.Location), "", a.Port, 5, false)
+	}
+	if peerReps.IsBanned(a) {
+		t.Errorf("Invalid entities in the pages we accepted should not ban the remote.")
+	}
+	r := reputationOf(a)
+	if r.InvalidEntities != int64(50*banOffenceThreshold) || r.RecentOffences != 0 {
+		t.Errorf("The invalid entities should be counted, but not as offences. Got: %#v", r)
+	}
+	if score := reputationScore(&r); score >= 0.5 {
+		t.Errorf("The invalid entities should lower the score. Got: %v", score)
+	}
+}
+
+func TestReputation_OffencesOutsideTheWindow_Fail(t *testing.T) {
+	v, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	a := simAddr(51000, 2)
+	for i := 0; i < 2*banOffenceThreshold; i++ {
+		rejectPages(a, 1)
+		v.Advance(banOffenceWindow + time.Minute)
+	}
+	if r := reputationOf(a); r.Bans != 0 || peerReps.IsBanned(a) {
+		t.Errorf("Offences spread wider than the window should not ban the remote. Got: %#v", r)
+	}
+}
+
+func TestReputation_BanIsCapped_Success(t *testing.T) {
+	v, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	a := simAddr(51000, 2)
+	for round := 0; round < 10; round++ {
+		rejectPages(a, banOffenceThreshold)
+		r := reputationOf(a)
+		if dur := time.Unix(int64(r.BannedUntil), 0).Sub(clock.Now()); dur > banMaxDuration {
+			t.Errorf("A ban should not last longer than %v. Ban #%d lasts %v.", banMaxDuration, r.Bans, dur)
+		}
+		v.Advance(banMaxDuration + time.Second)
+	}
+	if r := reputationOf(a); time.Unix(int64(r.BannedUntil), 0).Sub(clock.Now()) != -time.Second {
+		t.Errorf("The last ban should have lasted %v. Got: %#v", banMaxDuration, r)
+	}
+}
+
+func TestReputationRank_Success(t *testing.T) {
+	_, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	good, unknown1, unknown2, bad, banned := simAddr(51000, 2), simAddr(51001, 2), simAddr(51002, 2), simAddr(51003, 2), simAddr(51004, 2)
+	setReputation(good, persistence.DbPeerReputation{Successes: 20, Received: 5000})
+	setReputation(bad, persistence.DbPeerReputation{Failures: 10, Timeouts: 10})
+	setReputation(banned, persistence.DbPeerReputation{Successes: 50, Received: 50000, BannedUntil: api.Timestamp(clock.Now().Add(time.Hour).Unix())})
+	ranked := peerReps.rank([]api.Address{bad, unknown1, banned, good, unknown2})
+	// The unknown ones score the same, so they keep the order they were given in.
+	if expected := []uint16{good.Port, unknown1.Port, unknown2.Port, bad.Port}; !samePorts(addrPorts(ranked), expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, addrPorts(ranked))
+	}
+}
+
+func TestRankNeighbours_Success(t *testing.T) {
+	_, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	setReputation(simAddr(51001, 0), persistence.DbPeerReputation{Failures: 3})
+	setReputation(simAddr(51002, 0), persistence.DbPeerReputation{Successes: 10, Received: 1000})
+	setReputation(simAddr(51003, 0), persistence.DbPeerReputation{BannedUntil: api.Timestamp(clock.Now().Add(time.Hour).Unix())})
+	ns := []configstore.Address{}
+	// Oldest first, as the neighbours list gives them.
+	for _, p := range []uint16{51001, 51002, 51003, 51004} {
+		ns = append(ns, configstore.Address{Location: "127.0.0.1", Port: p})
+	}
+	ranked := rankNeighbours(ns)
+	got := []uint16{}
+	for _, n := range ranked {
+		if n.Location != "127.0.0.1" || n.Sublocation != "" {
+			t.Errorf("The neighbour should come back as it was given. Got: %#v", n)
+		}
+		got = append(got, n.Port)
+	}
+	// The neighbour watch syncs with the first. The banned one is left out, which removes it from the neighbours.
+	if expected := []uint16{51002, 51004, 51001}; !samePorts(got, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, got)
+	}
+}
@@ -2,10 +2,12 @@ package dispatch
 
 import (
 	"aether-core/aether/io/api"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/tcpmim"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	"errors"
 	"fmt"
 	"net"
@@ -29,15 +31,13 @@ func RequestInboundSync(host string, subhost string, port uint16) error {
 	dev6plusPort := port - 1
 	// dev.6+ code path
 	logging.Logf(1, "Attempting to request inbound sync from remote: %s:%v", host, dev6plusPort)
-	to := fmt.Sprint(host, ":", dev6plusPort)
-	connToRemote, err := net.Dial("tcp4", to)
+	connToRemote, err := dialReverse(host, dev6plusPort)
 	if err != nil {
 		errText := fmt.Sprintf("Request inbound sync failed while attempting to establish a connection to the remote. Error: %v", err)
 		logging.Logf(1, errText)
 		return errors.New(errText)
 	}
-	localSrvAddr := fmt.Sprint(":", globals.BackendConfig.GetExternalPort())
-	connToLocal, err := net.Dial("tcp4", localSrvAddr)
+	connToLocal, err := dialLocalServer()
 	if err != nil {
 		errText := fmt.Sprintf("Request inbound sync failed while attempting to establish a connection to the local server. Error: %v", err)
 		logging.Logf(1, errText)
@@ -55,11 +55,11 @@ func RequestInboundSync(host string, subhost string, port uint16) error {
 		connToRemote.LocalAddr().String(),
 		connToRemote.RemoteAddr().String(),
 	)
-	start := time.Now()
+	start := clock.Now()
 	// Set timeouts to infinite - both are successful.
 	pipe(connToRemote, connToLocal)
 	// The remote will auto-close the connection, or the local server will, or it will just timeout on its own based on inactivity.
-	elapsed := time.Since(start)
+	elapsed := clock.Since(start)
 	fmt.Printf("Reverse conn took: %v\n", elapsed)
 	es := ReverseConnInfo.GetEndStatus()
 	switch es {
@@ -78,6 +78,32 @@ func RequestInboundSync(host string, subhost string, port uint16) error {
 	}
 }
 
+// dialReverse opens the connection to the TCPMim server of the remote. The transports other than TCP carry the reverse opens the same way they carry the rest.
+func dialReverse(host string, port uint16) (net.Conn, error) {
+	lt, tr, err := transport.For(host)
+	if err != nil {
+		return nil, err
+	}
+	if transport.IsTCP(lt) {
+		// Raw TCP, not through the dialer of the api package, since a reverse open is not a HTTP request.
+		return net.Dial("tcp4", fmt.Sprint(host, ":", port))
+	}
+	return tr.Dial(host, port)
+}
+
+// dialLocalServer opens the connection to our own Mim server, wherever it listens.
+func dialLocalServer() (net.Conn, error) {
+	lt := globals.BackendConfig.GetExternalIpType()
+	if transport.IsTCP(lt) {
+		return net.Dial("tcp4", fmt.Sprint(":", globals.BackendConfig.GetExternalPort()))
+	}
+	tr, err := transport.ForType(lt)
+	if err != nil {
+		return nil, err
+	}
+	return tr.Dial(globals.BackendConfig.GetExternalIp(), globals.BackendConfig.GetExternalPort())
+}
+
 /*=====================================
 =            Reverse scout            =
 =====================================*/
@@ -0,0 +1,121 @@
+package dispatch
+
+// These run the time-dependent parts of dispatch on the virtual clock, so that what takes hours in the network takes no time here, and comes out the same every run. The syncs over the simulated network are in sync_test.go.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+var simEpoch = time.Unix(1500000000, 0)
+
+func setupSimulation(t *testing.T) (*clock.Virtual, func()) {
+	v := clock.NewVirtual(simEpoch)
+	restoreClock := clock.Use(v)
+	priorConfig := globals.BackendConfig
+	globals.BackendConfig = &configstore.BackendConfig{
+		Initialised:                             true,
+		DispatchExclusionExpiryForLiveAddress:   5 * time.Minute,
+		DispatchExclusionExpiryForStaticAddress: 3 * time.Hour,
+	}
+	return v, func() {
+		restoreClock()
+		globals.BackendConfig = priorConfig
+	}
+}
+
+// freshReputations replaces the reputations with empty ones that don't load from the database.
+func freshReputations() func() {
+	peerReps.lock.Lock()
+	defer peerReps.lock.Unlock()
+	prior := peerReps.Reps
+	priorDirty := peerReps.dirty
+	priorLoaded := peerReps.loaded
+	peerReps.loaded = true
+	peerReps.Reps = make(map[string]*persistence.DbPeerReputation)
+	peerReps.dirty = make(map[string]bool)
+	return func() {
+		peerReps.lock.Lock()
+		defer peerReps.lock.Unlock()
+		peerReps.Reps = prior
+		peerReps.dirty = priorDirty
+		peerReps.loaded = priorLoaded
+	}
+}
+
+func simAddr(port uint16, addrType uint8) api.Address {
+	return api.Address{Location: "127.0.0.1", Port: port, Type: addrType}
+}
+
+// Tests
+
+func TestExclusions_ExpireOnTheClock_Success(t *testing.T) {
+	v, teardown := setupSimulation(t)
+	defer teardown()
+	var d dispatcherExclusions
+	live, static := simAddr(51000, 2), simAddr(51001, 255)
+	d.Add(live)
+	d.Add(static)
+	v.Advance(4 * time.Minute)
+	if !d.IsExcluded(live) || !d.IsExcluded(static) {
+		t.Errorf("Both addresses should still be excluded.")
+	}
+	v.Advance(2 * time.Minute)
+	if d.IsExcluded(live) {
+		t.Errorf("The live address should no longer be excluded.")
+	}
+	if !d.IsExcluded(static) {
+		t.Errorf("The static address should still be excluded.")
+	}
+	v.Advance(3 * time.Hour)
+	if d.IsExcluded(static) {
+		t.Errorf("The static address should no longer be excluded.")
+	}
+}
+
+func TestReputation_BanExpiresOnTheClock_Success(t *testing.T) {
+	v, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	a := simAddr(51000, 2)
+	for i := 0; i < banOffenceThreshold; i++ {
+		ReportInvalidPage(string(a.Location), "", a.Port, 1, true)
+	}
+	if !peerReps.IsBanned(a) {
+		t.Fatalf("The remote should be banned after %d rejected pages.", banOffenceThreshold)
+	}
+	if ranked := peerReps.rank([]api.Address{a}); len(ranked) != 0 {
+		t.Errorf("A banned remote should not be ranked. Ranked: %#v", ranked)
+	}
+	v.Advance(banBaseDuration + time.Second)
+	if peerReps.IsBanned(a) {
+		t.Errorf("The ban should have expired.")
+	}
+}
+
+func TestReputation_RepeatBanIsLonger_Success(t *testing.T) {
+	v, teardown := setupSimulation(t)
+	defer teardown()
+	defer freshReputations()()
+	a := simAddr(51000, 2)
+	for round := 0; round < 2; round++ {
+		for i := 0; i < banOffenceThreshold; i++ {
+			ReportInvalidPage(string(a.Location), "", a.Port, 1, true)
+		}
+		v.Advance(banBaseDuration + time.Second)
+	}
+	if !peerReps.IsBanned(a) {
+		t.Errorf("The second ban should last longer than the first.")
+	}
+	v.Advance(banBaseDuration)
+	if peerReps.IsBanned(a) {
+		t.Errorf("The second ban should have expired.")
+	}
+}
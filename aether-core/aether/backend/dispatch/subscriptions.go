@@ -0,0 +1,72 @@
+// Backend > Dispatch > Subscriptions
+// This subsystem keeps the sync timestamps of the remotes in step with the subscriptions of this node, if it syncs only a part of the network.
+
+package dispatch
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"sync"
+)
+
+/*
+The sync timestamps of a remote say up to when we have what the remote has. For a node with subscriptions, that's only true for what it was subscribed to at the time. When the subscriptions widen, such as a new board, we'd never fetch the history of the new board, because the timestamps are already past it. So when they widen, we forget the thread, post and vote timestamps of all remotes, and the next syncs fetch these from the beginning. What we already have is skipped at insert.
+
+When the subscriptions narrow, the timestamps are still right for what's left, so nothing changes.
+*/
+
+var subscriptionsLock sync.Mutex
+
+// checkSubscriptions forgets the sync timestamps if the subscriptions have widened since they were recorded, and records the current subscriptions.
+func checkSubscriptions() {
+	subscriptionsLock.Lock()
+	defer subscriptionsLock.Unlock()
+	current := api.LocalSubscriptions()
+	synced := syncedSubscriptions()
+	if sameSubscriptions(current, synced) {
+		return
+	}
+	if current.Widens(synced) {
+		logging.Logf(1, "The subscriptions of this node have widened. We're forgetting the thread, post and vote sync timestamps of the remotes, so that we can fetch the history of what's newly subscribed. Subscriptions: %#v", current)
+		err := persistence.ResetSubscriptionCheckins()
+		if err != nil {
+			logging.Logf(1, "Resetting the sync timestamps for the widened subscriptions failed. We'll try again at the next sync. Error: %v", err)
+			return
+		}
+	}
+	boards, users := []string{}, []string{}
+	for _, val := range current.Boards {
+		boards = append(boards, string(val))
+	}
+	for _, val := range current.Users {
+		users = append(users, string(val))
+	}
+	globals.BackendConfig.SetSyncedBoards(boards)
+	globals.BackendConfig.SetSyncedUsers(users)
+}
+
+func syncedSubscriptions() api.Subscriptions {
+	s := api.Subscriptions{}
+	for _, val := range globals.BackendConfig.GetSyncedBoards() {
+		s.Boards = append(s.Boards, api.Fingerprint(val))
+	}
+	for _, val := range globals.BackendConfig.GetSyncedUsers() {
+		s.Users = append(s.Users, api.Fingerprint(val))
+	}
+	return s
+}
+
+// sameSubscriptions returns whether the two cover the same part of the network.
+func sameSubscriptions(a, b api.Subscriptions) bool {
+	return !a.Widens(b) && !b.Widens(a) && a.Partial() == b.Partial()
+}
+
+// postSubscriptions returns the subscriptions to ask the remote for in the POST requests. A remote that doesn't understand the subscription filters is asked for everything, and its response is filtered on our side.
+func postSubscriptions(subs api.Subscriptions, remote api.Protocol) api.Subscriptions {
+	if !api.SupportsSubscriptionFilters(remote) {
+		return api.Subscriptions{}
+	}
+	return subs
+}
@@ -0,0 +1,154 @@
+package dispatch
+
+// These test what a node with subscriptions takes in, and when it has to forget the sync timestamps of the remotes.
+
+import (
+	"aether-core/aether/io/api"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+func subscribedResponse() api.Response {
+	return api.Response{
+		Boards: []api.Board{{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "b1"}}, {ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "b2"}}},
+		Threads: []api.Thread{
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "t1"}, Board: "b1", Owner: "someone"},
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "t2"}, Board: "b2", Owner: "someone"},
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "t3"}, Board: "b2", Owner: "friend"},
+		},
+		Posts: []api.Post{
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "p1"}, Board: "b1", Owner: "someone"},
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "p2"}, Board: "b2", Owner: "someone"},
+		},
+		Votes: []api.Vote{
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "v1"}, Board: "b2", Owner: "friend"},
+			{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "v2"}, Board: "b2", Owner: "someone"},
+		},
+		Keys: []api.Key{{ProvableFieldSet: api.ProvableFieldSet{Fingerprint: "someone"}}},
+	}
+}
+
+// Tests
+
+func TestFilterSubscriptions_Success(t *testing.T) {
+	r := subscribedResponse()
+	r.FilterSubscriptions(api.Subscriptions{Boards: []api.Fingerprint{"b1"}, Users: []api.Fingerprint{"friend"}})
+	if len(r.Threads) != 2 || r.Threads[0].Fingerprint != "t1" || r.Threads[1].Fingerprint != "t3" {
+		t.Errorf("Only the threads in the board or by the user should be left. Threads: %#v", r.Threads)
+	}
+	if len(r.Posts) != 1 || r.Posts[0].Fingerprint != "p1" {
+		t.Errorf("Only the posts in the board should be left. Posts: %#v", r.Posts)
+	}
+	if len(r.Votes) != 1 || r.Votes[0].Fingerprint != "v1" {
+		t.Errorf("Only the votes by the user should be left. Votes: %#v", r.Votes)
+	}
+	if len(r.Boards) != 2 || len(r.Keys) != 1 {
+		t.Errorf("Boards and keys should be left intact. Boards: %v, Keys: %v", len(r.Boards), len(r.Keys))
+	}
+}
+
+func TestFilterSubscriptions_NoSubscriptions_Success(t *testing.T) {
+	r := subscribedResponse()
+	r.FilterSubscriptions(api.Subscriptions{})
+	if len(r.Threads) != 3 || len(r.Posts) != 2 || len(r.Votes) != 2 {
+		t.Errorf("A node without subscriptions should take in everything. Threads: %v, Posts: %v, Votes: %v", len(r.Threads), len(r.Posts), len(r.Votes))
+	}
+}
+
+func TestSubscriptions_Filters_Success(t *testing.T) {
+	s := api.Subscriptions{Boards: []api.Fingerprint{"b1", "b2"}, Users: []api.Fingerprint{"friend"}}
+	f := s.Filters()
+	if len(f) != 2 || f[0].Type != "board" || len(f[0].Values) != 2 || f[1].Type != "owner" || f[1].Values[0] != "friend" {
+		t.Errorf("Unexpected filters. Filters: %#v", f)
+	}
+	empty := api.Subscriptions{}
+	if len(empty.Filters()) != 0 {
+		t.Errorf("A node without subscriptions should not send any subscription filters.")
+	}
+}
+
+func TestSubscriptions_Widens_Success(t *testing.T) {
+	b1 := api.Subscriptions{Boards: []api.Fingerprint{"b1"}}
+	b1b2 := api.Subscriptions{Boards: []api.Fingerprint{"b1", "b2"}}
+	b1friend := api.Subscriptions{Boards: []api.Fingerprint{"b1"}, Users: []api.Fingerprint{"friend"}}
+	all := api.Subscriptions{}
+	cases := []struct {
+		name           string
+		current, prior api.Subscriptions
+		widens         bool
+	}{
+		{"new board", b1b2, b1, true},
+		{"new user", b1friend, b1, true},
+		{"removed board", b1, b1b2, false},
+		{"partial to all", all, b1, true},
+		{"all to partial", b1, all, false},
+		{"unchanged", b1b2, b1b2, false},
+	}
+	for _, c := range cases {
+		if c.current.Widens(c.prior) != c.widens {
+			t.Errorf("Unexpected widening. Case: %s, Expected: %v", c.name, c.widens)
+		}
+	}
+	if !sameSubscriptions(b1b2, api.Subscriptions{Boards: []api.Fingerprint{"b2", "b1"}}) {
+		t.Errorf("The order of the subscriptions should not matter.")
+	}
+	if sameSubscriptions(b1, all) {
+		t.Errorf("Partial subscriptions should not be the same as the whole network.")
+	}
+}
+
+func TestPostSubscriptions_OlderRemote_Success(t *testing.T) {
+	s := api.Subscriptions{Boards: []api.Fingerprint{"b1"}}
+	c0 := api.Subprotocol{Name: "c0", VersionMajor: 1, SupportedEntities: []string{"board", "thread", "post", "vote", "key", "truststate"}}
+	older := postSubscriptions(s, api.Protocol{VersionMajor: 1, VersionMinor: 0, Subprotocols: []api.Subprotocol{c0}})
+	if older.Partial() {
+		t.Errorf("A remote that doesn't understand the subscription filters should not get them.")
+	}
+	// The protocol version doesn't declare the filters, only the subprotocol does.
+	unflagged := postSubscriptions(s, api.Protocol{VersionMajor: 1, VersionMinor: 1, Subprotocols: []api.Subprotocol{c0}})
+	if unflagged.Partial() {
+		t.Errorf("A remote that doesn't declare the subscription filters should not get them, whatever its minor version.")
+	}
+	onlyBoards := api.Subprotocol{Name: api.SubscriptionFiltersSubprotocol, VersionMajor: 1, SupportedEntities: []string{"boardfilter"}}
+	partial := postSubscriptions(s, api.Protocol{VersionMajor: 1, VersionMinor: 0, Subprotocols: []api.Subprotocol{c0, onlyBoards}})
+	if partial.Partial() {
+		t.Errorf("A remote that declares only some of the subscription filters should not get them.")
+	}
+	sf0 := api.Subprotocol{Name: api.SubscriptionFiltersSubprotocol, VersionMajor: 1, SupportedEntities: api.SubscriptionFilters}
+	newer := postSubscriptions(s, api.Protocol{VersionMajor: 1, VersionMinor: 0, Subprotocols: []api.Subprotocol{c0, sf0}})
+	if !newer.Partial() {
+		t.Errorf("A remote that understands the subscription filters should get them.")
+	}
+}
+
+func TestApiResponseBounds_NewerMinorVersion_Success(t *testing.T) {
+	// Minor versions only add to the protocol, so the pages of a later 1.x node should pass the same checks as the pages of a 1.0 node.
+	for _, minor := range []uint16{0, 1, 5} {
+		resp := api.ApiResponse{
+			NodePublicKey: strings.Repeat("a", 64),
+			Signature:     api.Signature(strings.Repeat("b", 128)),
+			Nonce:         api.Nonce(strings.Repeat("c", 64)),
+			Entity:        "boards",
+			Endpoint:      "boards",
+			Timestamp:     api.Timestamp(time.Now().Unix()),
+			Pagination:    api.Pagination{Pages: 1},
+		}
+		resp.Address.Protocol.VersionMajor = 1
+		resp.Address.Protocol.VersionMinor = minor
+		ok, err := resp.CheckBounds()
+		if !ok || err != nil {
+			t.Errorf("A page of protocol 1.%v should pass the bounds check. Error: %v", minor, err)
+		}
+	}
+}
+
+func TestApiResponseBounds_UnknownMajorVersion_Fail(t *testing.T) {
+	resp := api.ApiResponse{}
+	resp.Address.Protocol.VersionMajor = 2
+	if ok, err := resp.CheckBounds(); ok || err == nil {
+		t.Errorf("A page of an unknown major version should not pass the bounds check.")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"aether-core/aether/backend/feapiconsumer"
 	"aether-core/aether/io/api"
 	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/scheduling"
@@ -63,19 +64,13 @@ func OutboundAllowed(a api.Address, reverseConn *net.Conn) (bool, func(wasSucces
 }
 
 // Sync is the core logic of a single connection. It pulls updates from a remote node and patches it to the current node.
-func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
+func Sync(a api.Address, lineup []string, reverseConn *net.Conn) (err error) {
 	//////////
 	// PREP //
 	//////////
 
-	// MUTEX
 	/*
-		Heads up, this is a basic way to enforce there will only ever be one single sync happening at any one time, but it is not a given that this will hold true. The user can change the allowed outbounds count to something other than 1 and that would make it.
-	*/
-	globals.BackendTransientConfig.SyncActive.Lock()
-	defer globals.BackendTransientConfig.SyncActive.Unlock()
-	/*
-		We rely on this mutex to hold our queue - we'll eventually need to remove this though, at least for backend-only node installations, since this limits the throughput to only one sync at a time. FUTURE TODO
+		Multiple syncs can run at the same time, up to the number of outbound leases we give out (MaxOutboundConns). Each sync has its own purgatory and metrics, and the DB writes are serialised in persistence.BatchInsert. We only refuse to sync with a remote that we're already syncing with, see activesyncs.go.
 	*/
 
 	var syncSuccessful bool
@@ -107,6 +102,10 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 	/*=================================================
 	=            Requesting outbound lease            =
 	=================================================*/
+	// A swarm scenario can partition us away from the remote. See partition.go.
+	if parts.isPartitioned(a) {
+		return errPartitioned
+	}
 	// Request lease
 	allowed, releaseLease, renewLease := OutboundAllowed(a, reverseConn)
 	if !allowed {
@@ -156,7 +155,11 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 	// - At the completion of every endpoint (get + post), save the timestamp.
 
 	logging.Log(2, fmt.Sprintf("SYNC STARTED with node: %s:%d", a.Location, a.Port))
-	start := time.Now()
+	start := clock.Now()
+	defer func() { syncStats.outcome(syncSuccessful, clock.Since(start)) }()
+	// How the sync ends counts towards the reputation of the remote. See reputation.go.
+	rep := syncReport{addr: a}
+	defer func() { peerReps.syncEnded(rep, err) }()
 	if reverseConn != nil {
 		(*reverseConn).SetDeadline(time.Now().Add(30 * time.Second))
 	}
@@ -165,10 +168,22 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 		logging.Logf(1, "Sync errored out. Error: %v", err)
 		return err
 	}
+	if parts.isPartitioned(addr) {
+		// We only learn who the remote is here in a reverse sync.
+		return errPartitioned
+	}
+	rep.addr = addr
+	// From here on we read and write the records of this node, so another sync with it can't be running. We know which node it is only now, and it can be reachable at more than one address, so this is keyed by its node id. See activesyncs.go.
+	nodeId := api.Fingerprint(apiResp.NodeId)
+	if !activeSyncs.add(nodeId, addr) {
+		logging.Logf(1, "Sync: We're already syncing with this remote, so we're skipping this sync. Addr: %s:%d, NodeId: %s", addr.Location, addr.Port, nodeId)
+		return errors.New(fmt.Sprintf("Sync: Already syncing with this remote. Addr: %s:%d, NodeId: %s", addr.Location, addr.Port, nodeId))
+	}
+	defer activeSyncs.remove(nodeId)
 	if reverseConn != nil {
 		(*reverseConn).SetDeadline(time.Now().Add(10 * time.Minute))
 	}
-	// Establish purgatory. This is where we keep received items that are older than our network head. At the end of the sync, we will take a look at those items and determine if they're ancestor of something that arrived in the sync. If so, we'll insert them as the last step of the sync. If not so, we'll discard them.
+	// Establish purgatory. This is where we keep received items that are older than our network head. At the end of the sync, we will take a look at those items and determine if they're ancestor of something that arrived in the sync. If so, we'll insert them as the last step of the sync. If not so, we'll discard them. Every sync has its own purgatory, so parallel syncs don't see each other's items.
 	p := Purgatory{}
 
 	// FULLY TRUSTED ADDRESS ENTRY
@@ -189,12 +204,14 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 			return err
 		}
 	}
+	// If our subscriptions widened, the sync timestamps of the remotes have to go before we read them. See subscriptions.go.
+	checkSubscriptions()
 	var n persistence.DbNode
 	var err4 error
-	n, err4 = persistence.ReadNode(api.Fingerprint(apiResp.NodeId))
+	n, err4 = persistence.ReadNode(nodeId)
 	if err4 != nil && strings.Contains(err4.Error(), "The node you have asked for could not be found") {
 		// Node does not exist in the DB. Create it and commit it to DB.
-		n.Fingerprint = api.Fingerprint(apiResp.NodeId)
+		n.Fingerprint = nodeId
 		err5 := persistence.InsertNode(n)
 		if err5 != nil {
 			// DB commit error, or node was using the same id as ours.
@@ -214,23 +231,32 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 	metrics.SendConnState(addr, true, firstSync, nil)
 	// metrics server end for conn state
 	c := startMetricsContainer(apiResp, addr, n, reverseConn != nil)
+	// callOrder := []string{"addresses", "votes", "truststates", "posts", "threads", "boards", "keys"}
+	callOrder := constructCallOrder(addr, lineup)
+	activeSyncs.start(nodeId, c, len(callOrder))
 	openClr := color.New(color.FgWhite, color.BgYellow)
 	logging.Log(2, generateStartMessage(c, openClr))
 	// For every endpoint, hit the caches. If the node is not static, hit the POSTs too.
 	endpoints := map[string]api.Timestamp{
-		"boards":      n.BoardsLastCheckin,
-		"threads":     n.ThreadsLastCheckin,
-		"posts":       n.PostsLastCheckin,
-		"votes":       n.VotesLastCheckin,
-		"addresses":   n.AddressesLastCheckin,
-		"keys":        n.KeysLastCheckin,
-		"truststates": n.TruststatesLastCheckin}
+		"boards":         n.BoardsLastCheckin,
+		"threads":        n.ThreadsLastCheckin,
+		"posts":          n.PostsLastCheckin,
+		"votes":          n.VotesLastCheckin,
+		"addresses":      n.AddressesLastCheckin,
+		"keys":           n.KeysLastCheckin,
+		"truststates":    n.TruststatesLastCheckin,
+		"directmessages": n.DirectMessagesLastCheckin}
 	logging.Log(2, fmt.Sprintf("SYNC:PULL STARTED with data from node: %s:%d", a.Location, a.Port))
 	logging.Log(2, fmt.Sprintf("Endpoints: %#v", endpoints))
 	ims := []persistence.InsertMetrics{}
-	// callOrder := []string{"addresses", "votes", "truststates", "posts", "threads", "boards", "keys"}
-	callOrder := constructCallOrder(addr, lineup)
-	for _, endpointName := range callOrder {
+	// The realms other than the public one that both we and the remote carry. We only ask for those, and we only accept entities from the realms we carry.
+	sharedRealms := api.SharedRealms(addr.Protocol, apiResp.NodePublicKey)
+	servedRealms := api.ServedRealms()
+	// If we sync only a part of the network, we take in only the threads, posts and votes our subscriptions cover. We ask the remote for only those if it understands the subscription filters, and filter what we get either way.
+	subs := api.LocalSubscriptions()
+	remoteSubs := postSubscriptions(subs, addr.Protocol)
+	for i, endpointName := range callOrder {
+		activeSyncs.progress(c, endpointName, i)
 		addrSatiated := false
 		logging.Logf(1, "Getting: %s", endpointName)
 		if endpointName == "addresses" && !NODE_STATIC {
@@ -241,13 +267,13 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 
 			// We have a special provision for addresses. Unlike others, addresses endpoint scan needs to start from the most recent, and move backwards, because most recent addresses are much more valuable than the older ones. We also have a limit of 100 addresses downloaded at every sync, which means we will stop downloading when we reach the number. If we download all and then pick 100, then we can potentially end up downloading a lot of unwanted data, that would be not that useful.
 			// fmt.Println("Addresses endpoint special provision enters.")
-			start := time.Now()
+			start := clock.Now()
 			var elapsed time.Duration
-			postResp, timeToFirstResponse, err := api.GetPOSTEndpoint(string(a.Location), string(a.Sublocation), a.Port, endpointName, endpoints[endpointName], reverseConn)
+			postResp, timeToFirstResponse, err := api.GetPOSTEndpoint(string(a.Location), string(a.Sublocation), a.Port, endpointName, endpoints[endpointName], nil, api.Subscriptions{}, reverseConn)
 			if err != nil {
 				logging.Logf(1, "GetPOSTEndpoint inside Sync has errored out. Error: %v", err)
 			}
-			elapsed = time.Since(start)
+			elapsed = clock.Since(start)
 			if len(postResp.Addresses) >= 100 {
 				// fmt.Println("post address response satiated, won't hit get")
 				addrSatiated = true
@@ -269,13 +295,14 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 			// We've checked addresses and we're all full for addresses. We won't check the address GET endpoint. Skip addresses GET portion of this sync.
 			continue
 		}
-		start := time.Now()
-		if globals.BackendConfig.GetScaledMode() {
+		start := clock.Now()
+		if globals.BackendConfig.GetScaledMode() && !subs.Partial() {
 			/*
 				First check if we're in the scaled mode. If so, skip this part - we'll only sync addresses until we're out of the scaled mode.
 				Why?
 				Scaled mode means that the node is under so much disk pressure that the event horizon (the threshold of history deletion that can move forwards or backwards in time) has touched the network head, which renders this node one that is not able to provide a full network head to its peers. In the future, in this mode the node will switch to a mode where it only tracks the boards and people followed by its users, but for now, it temporarily stops accepting new content until the network head moves far enough ahead that event horizon can reduce the DB size to under maximum allowable.
 				To prepare for that moment, though, we keep updating the addresses tables. Since that table is limited to 1000 addresses, it takes up a constant space.
+				A node with subscriptions keeps syncing in the scaled mode. It only takes in the part of the network it's subscribed to, and the event horizon gets to delete what's outside of that before it eats into the history of what's inside. See eventhorizon.go.
 				(This also appropriately skips setting up the timestamps, so that it won't set timestamps for things that it did not sync.)
 			*/
 			logging.Logf(1, "This node is in scaled mode, so it's skipping sync with this remote. Remote: %s:%d", a.Location, a.Port)
@@ -292,8 +319,24 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 		if err6 != nil {
 			logging.Log(2, fmt.Sprintf("Getting GET Endpoint for the entity type '%s' failed. Error: %s, Address: %#v", endpointName, err6, a))
 		}
+		for _, realm := range sharedRealms {
+			if endpointName == "addresses" {
+				// Addresses are not realm-bound.
+				break
+			}
+			realmResp, err := api.GetRealmGETEndpoint(string(a.Location), string(a.Sublocation), a.Port, endpointName, realm, endpoints[endpointName], reverseConn)
+			if err != nil {
+				logging.Log(2, fmt.Sprintf("Getting GET Endpoint for the entity type '%s' in realm '%s' failed. Error: %s, Address: %#v", endpointName, realm, err, a))
+			}
+			// The checkin timestamp is driven by the public realm's endpoint. A realm endpoint that's ahead of it shouldn't make us skip public caches we failed to get.
+			ts := resp.MostRecentSourceTimestamp
+			resp.Insert(&realmResp)
+			resp.MostRecentSourceTimestamp = ts
+		}
+		resp.FilterRealms(servedRealms)
+		resp.FilterSubscriptions(subs)
 		logging.Log(3, fmt.Sprintf("Response to be moved to the interface pack: %#v", resp))
-		elapsed := time.Since(start) // We end this counter before DB insert starts, because this is the network-time counter.
+		elapsed := clock.Since(start) // We end this counter before DB insert starts, because this is the network-time counter.
 		// Move the objects into an interface to prepare them to be committed.
 		// Address specific
 		if len(resp.Addresses) > 100 {
@@ -347,10 +390,12 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 			//  {"type":"timestamp", "values": ["0", "1483641920"]}
 			//  ]
 			// which allows us to filter. But if you create an empty request for POST to an entity endpoint, it will give you all the entities for that endpoint since the last cache generation, automatically. There are no filters required for that kind of query.
-			start := time.Now()
+			start := clock.Now()
 			var elapsed time.Duration
-			postResp, timeToFirstResponse, err := api.GetPOSTEndpoint(string(a.Location), string(a.Sublocation), a.Port, endpointName, endpoints[endpointName], reverseConn)
-			elapsed = time.Since(start)
+			postResp, timeToFirstResponse, err := api.GetPOSTEndpoint(string(a.Location), string(a.Sublocation), a.Port, endpointName, endpoints[endpointName], sharedRealms, remoteSubs, reverseConn)
+			elapsed = clock.Since(start)
+			postResp.FilterRealms(servedRealms)
+			postResp.FilterSubscriptions(subs)
 			p.Filter(&postResp)
 			postIface := prepareForBatchInsert(&postResp)
 			im, err := persistence.BatchInsert(*postIface)
@@ -403,7 +448,11 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 		}
 	}
 	// Here, after all the endpoint pulls are complete, we process the purgatory and commit it separately.
+	activeSyncs.progress(c, "purgatory", len(callOrder))
+	purgHeld := p.sizes()
 	iface := p.Process()
+	syncStats.purgatory(purgHeld, len(iface))
+	rep.purgatoryDiscards = purgatoryDiscards(purgHeld, len(iface))
 	// Save the response to the database.
 	im, err := persistence.BatchInsert(iface)
 	if err != nil {
@@ -420,13 +469,14 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 	n.AddressesLastCheckin = endpoints["addresses"]
 	n.KeysLastCheckin = endpoints["keys"]
 	n.TruststatesLastCheckin = endpoints["truststates"]
+	n.DirectMessagesLastCheckin = endpoints["directmessages"]
 	err9 := persistence.InsertNode(n)
 	if err9 != nil {
 		return err9
 	}
 	if directlyConnectible {
-		addrs[0].LastSuccessfulPing = api.Timestamp(time.Now().Unix())
-		addrs[0].LastSuccessfulSync = api.Timestamp(time.Now().Unix())
+		addrs[0].LastSuccessfulPing = api.Timestamp(clock.Now().Unix())
+		addrs[0].LastSuccessfulSync = api.Timestamp(clock.Now().Unix())
 		errs2 := persistence.InsertOrUpdateAddresses(&addrs)
 		if len(errs2) > 0 {
 			err := errors.New(fmt.Sprintf("Some errors were encountered when the Sync attempted InsertOrUpdateAddresses. Sync aborted. Errors: %s", errs2))
@@ -437,25 +487,27 @@ func Sync(a api.Address, lineup []string, reverseConn *net.Conn) error {
 		}
 	}
 	logging.Log(2, "Inserted the last successful sync stamp at the end of the sync.")
-	logging.Log(2, fmt.Sprintf("SYNC COMPLETE with node: %s:%d. It took %d seconds", a.Location, a.Port, int(time.Since(start).Seconds())))
+	logging.Log(2, fmt.Sprintf("SYNC COMPLETE with node: %s:%d. It took %d seconds", a.Location, a.Port, int(clock.Since(start).Seconds())))
 	closeClr := color.New(color.FgBlack, color.BgWhite)
-	logging.Log(1, generateCloseMessage(c, closeClr, &ims, int(time.Since(start).Seconds()), true))
+	logging.Log(1, generateCloseMessage(c, closeClr, &ims, int(clock.Since(start).Seconds()), true))
 	// Send the connection state to the metrics server.
 	metrics.SendConnState(addr, false, firstSync, &ims)
+	syncStats.inserted(ims)
+	rep.received = receivedCount(ims)
 	// Insert the appropriate markers to the config
 	switch addr.Type {
 	case 2:
-		globals.BackendConfig.SetLastLiveAddressConnectionTimestamp(time.Now().Unix())
+		globals.BackendConfig.SetLastLiveAddressConnectionTimestamp(clock.Now().Unix())
 	case 3, 254:
-		globals.BackendConfig.SetLastBootstrapAddressConnectionTimestamp(time.Now().Unix())
+		globals.BackendConfig.SetLastBootstrapAddressConnectionTimestamp(clock.Now().Unix())
 	case 255:
-		globals.BackendConfig.SetLastStaticAddressConnectionTimestamp(time.Now().Unix())
+		globals.BackendConfig.SetLastStaticAddressConnectionTimestamp(clock.Now().Unix())
 	}
 	syncSuccessful = true
 
 	/*----------  Send sync metrics to frontend  ----------*/
-	feapiconsumer.BackendAmbientStatus.LastOutboundDurationSeconds = int32(time.Since(start).Seconds())
-	feapiconsumer.BackendAmbientStatus.LastOutboundConnTimestamp = time.Now().Unix()
+	feapiconsumer.BackendAmbientStatus.LastOutboundDurationSeconds = int32(clock.Since(start).Seconds())
+	feapiconsumer.BackendAmbientStatus.LastOutboundConnTimestamp = clock.Now().Unix()
 	feapiconsumer.SendBackendAmbientStatus()
 	/*----------  And all done!  ----------*/
 
@@ -470,7 +522,12 @@ Internal functions
 
 func constructCallOrder(remote api.Address, lineup []string) []string {
 	// debug TODO
-	return []string{"addresses", "votes", "truststates", "posts", "threads", "boards", "keys"}
+	callOrder := []string{"addresses", "votes", "truststates", "posts", "threads", "boards", "keys"}
+	// Direct messages come last, and only from the remotes that carry them. Their recipients are keys, so by then we have the keys they point to.
+	if api.SubprotocolShared(remote.Protocol, api.DirectMessagesSubprotocol, "directmessage") {
+		callOrder = append(callOrder, "directmessages")
+	}
+	return callOrder
 
 	// All mim nodes support addresses to enable proper protocol function.
 	supported := []string{"addresses"}
@@ -536,6 +593,9 @@ func prepareForBatchInsert(r *api.Response) *[]interface{} {
 	for i, _ := range resp.Truststates {
 		carrier = append(carrier, resp.Truststates[i])
 	}
+	for i, _ := range resp.DirectMessages {
+		carrier = append(carrier, resp.DirectMessages[i])
+	}
 	for i, _ := range resp.Addresses {
 		carrier = append(carrier, resp.Addresses[i])
 	}
@@ -0,0 +1,785 @@
+package dispatch
+
+// These run this node's syncs and its bootstrap for real, against scripted peers on the simulated network. The node has a database and a config of its own, the peers answer the Mim protocol the way a remote would, with what the test gives them. Every peer signs its pages with a key of its own, so the pages go through the same checks as the ones from the network.
+
+import (
+	"aether-core/aether/backend/feapiconsumer"
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/fingerprinting"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/signaturing"
+	"aether-core/aether/services/tcpmim"
+	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/ed25519"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+// setupSimNode sets up this node with a config and a database of its own, and puts it on a simulated network of its own, with no peers on it yet.
+func setupSimNode(t *testing.T) (*transport.SimNetwork, func()) {
+	priorTransient := globals.BackendTransientConfig
+	priorConfig := globals.BackendConfig
+	priorDb := globals.DbInstance
+	priorLookup := api.BoardLookup
+	priorReport := api.ReportInvalidPage
+	globals.BackendTransientConfig = &configstore.Btc
+	globals.BackendTransientConfig.SetDefaults()
+	globals.BackendTransientConfig.AppIdentifier = "A-UnitTest-Sim"
+	becfg, err := configstore.EstablishBackendConfig()
+	if err != nil {
+		t.Fatalf("The backend config could not be established. Error: %v", err)
+	}
+	becfg.Cycle()
+	globals.BackendConfig = becfg
+	// The entities of the peers aren't minted. Their pages are signed for real, though.
+	globals.BackendTransientConfig.FingerprintCheckEnabled = false
+	globals.BackendTransientConfig.SignatureCheckEnabled = false
+	globals.BackendTransientConfig.ProofOfWorkCheckEnabled = false
+	globals.BackendTransientConfig.PageSignatureCheckEnabled = true
+	globals.BackendTransientConfig.TLSEnabled = false
+	api.ReportInvalidPage = ReportInvalidPage
+	dir := becfg.GetSQLiteDBLocation()
+	os.MkdirAll(dir, 0755)
+	conn, err2 := sqlx.Connect("sqlite3", filepath.Join(dir, "AetherDB.db"))
+	if err2 != nil {
+		t.Fatalf("The database could not be opened. Error: %v", err2)
+	}
+	conn.SetMaxOpenConns(1)
+	globals.DbInstance = conn
+	persistence.CreateDatabase()
+	persistence.CheckDatabaseReady()
+	restoreReps := freshReputations()
+	sim := transport.NewSimNetwork(1)
+	transport.Register(transport.LocationTypeSim, sim)
+	return sim, func() {
+		transport.Register(transport.LocationTypeSim, transport.NewSimNetwork(0))
+		restoreReps()
+		conn.Close()
+		os.RemoveAll(becfg.GetUserDirectory())
+		os.RemoveAll(becfg.GetCachesDirectory())
+		globals.BackendTransientConfig = priorTransient
+		globals.BackendConfig = priorConfig
+		globals.DbInstance = priorDb
+		api.BoardLookup = priorLookup
+		api.ReportInvalidPage = priorReport
+	}
+}
+
+// scriptedPeer is a remote that answers the Mim protocol with what the test gives it. It has no caches, everything it has, it gives out in its POST responses.
+type scriptedPeer struct {
+	lock     sync.Mutex
+	loc      string
+	port     uint16
+	key      *ed25519.PrivateKey
+	pk       string
+	addrType uint8
+	// cached is when it last made its caches, which its index pages carry. stamp is the timestamp of the rest of its pages. The syncs take these as how far they got.
+	cached api.Timestamp
+	stamp  api.Timestamp
+	// content is what it has. Each POST endpoint gives out its own part of it.
+	content       api.Answer
+	bootstrappers []api.Address
+	// tamper makes it change its pages after signing them.
+	tamper bool
+	// hold, if set, keeps its POST responses of the entities back until it's closed. held gets a message for every response it keeps back.
+	hold      chan struct{}
+	held      chan string
+	requests  []string
+	filters   map[string][]api.Filter
+	listeners []net.Listener
+}
+
+func newScriptedPeer(t *testing.T, name string, port uint16) *scriptedPeer {
+	priv, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("The key pair could not be created. Error: %v", err)
+	}
+	p := &scriptedPeer{
+		loc:      "sim:" + name,
+		port:     port,
+		key:      priv,
+		pk:       signaturing.MarshalPublicKey(priv.Public().(ed25519.PublicKey)),
+		addrType: 2,
+		cached:   api.Timestamp(time.Now().Add(-2 * time.Hour).Unix()),
+		stamp:    api.Timestamp(time.Now().Add(-time.Hour).Unix()),
+		filters:  make(map[string][]api.Filter),
+	}
+	p.alsoAt(t, p.loc, port)
+	return p
+}
+
+// alsoAt makes the peer reachable at another location as well, the way a node can be reachable over IPv4 and IPv6 at the same time.
+func (p *scriptedPeer) alsoAt(t *testing.T, loc string, port uint16) {
+	tr, _ := transport.ForType(transport.LocationTypeSim)
+	l, err := tr.Listen(loc, port)
+	if err != nil {
+		t.Fatalf("The peer could not listen. Error: %v", err)
+	}
+	p.listeners = append(p.listeners, l)
+	go http.Serve(l, p)
+}
+
+func (p *scriptedPeer) close() {
+	for _, l := range p.listeners {
+		l.Close()
+	}
+}
+
+func (p *scriptedPeer) addr() api.Address {
+	return api.Address{Location: api.Location(p.loc), Port: p.port, Type: p.addrType}
+}
+
+func (p *scriptedPeer) nodeId() api.Fingerprint {
+	return api.Fingerprint(fingerprinting.Create(p.pk))
+}
+
+func (p *scriptedPeer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v0/")
+	if p.hold != nil && r.Method == "POST" && strings.HasPrefix(path, "c0/") {
+		// Held outside the lock, so that the peer keeps answering everything else.
+		p.held <- path
+		<-p.hold
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.requests = append(p.requests, r.Method+" "+path)
+	switch {
+	case path == "status" || path == "ping/status":
+		w.Write([]byte("{}"))
+	case path == "node" || path == "ping/node":
+		p.writePage(w, "node", "node", p.stamp, api.Answer{})
+	case path == "bootstrappers":
+		p.writePage(w, "addresses", "bootstrappers", p.stamp, api.Answer{Addresses: p.bootstrappers})
+	case r.Method == "GET" && strings.HasSuffix(path, "/index.json"):
+		// No caches.
+		etype := entityOf(strings.TrimSuffix(path, "/index.json"))
+		p.writePage(w, etype, etype, p.cached, api.Answer{})
+	case r.Method == "POST":
+		var req api.ApiResponse
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		p.filters[path] = req.Filters
+		p.writePage(w, entityOf(path), "entity", p.stamp, p.answerFor(path))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// entityOf is the entity type an endpoint serves, as in c0/boards > boards.
+func entityOf(endpoint string) string {
+	return endpoint[strings.LastIndex(endpoint, "/")+1:]
+}
+
+func (p *scriptedPeer) answerFor(endpoint string) api.Answer {
+	switch endpoint {
+	case "c0/boards":
+		return api.Answer{Boards: p.content.Boards}
+	case "c0/threads":
+		return api.Answer{Threads: p.content.Threads}
+	case "c0/posts":
+		return api.Answer{Posts: p.content.Posts}
+	case "c0/votes":
+		return api.Answer{Votes: p.content.Votes}
+	case "c0/keys":
+		return api.Answer{Keys: p.content.Keys}
+	case "c0/truststates":
+		return api.Answer{Truststates: p.content.Truststates}
+	case "addresses":
+		return api.Answer{Addresses: p.content.Addresses}
+	}
+	return api.Answer{}
+}
+
+// writePage writes out a page the way a node does, signed with the peer's key.
+func (p *scriptedPeer) writePage(w http.ResponseWriter, entity, endpoint string, ts api.Timestamp, body api.Answer) {
+	r := api.ApiResponse{}
+	r.Prefill()
+	r.Entity = entity
+	r.Endpoint = endpoint
+	r.NodePublicKey = p.pk
+	r.Address.Location = api.Location(p.loc)
+	r.Address.LocationType = transport.LocationTypeSim
+	r.Address.Port = p.port
+	r.Address.Type = p.addrType
+	r.Address.Protocol.Realms = nil
+	r.Timestamp = ts
+	r.ResponseBody = body
+	r.CreateSignature(p.key)
+	if p.tamper {
+		r.Timestamp++
+	}
+	page, _ := r.ToJSON()
+	w.Write(page)
+}
+
+func (p *scriptedPeer) has(content api.Answer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.content = content
+}
+
+func (p *scriptedPeer) setStamp(ts api.Timestamp) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.stamp = ts
+}
+
+func (p *scriptedPeer) setTamper(tamper bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.tamper = tamper
+}
+
+func (p *scriptedPeer) requestsMade() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]string{}, p.requests...)
+}
+
+// filterStart is where the last POST to the endpoint asked for the entities to start from.
+func (p *scriptedPeer) filterStart(endpoint string) string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, f := range p.filters[endpoint] {
+		if f.Type == "timestamp" && len(f.Values) > 0 {
+			return f.Values[0]
+		}
+	}
+	return ""
+}
+
+// boardWithOwner is a board and the key of its owner, the smallest set of entities a peer can give out that makes sense. The fingerprints and the key are made from the name, they only need to look like the real ones.
+func boardWithOwner(name string) api.Answer {
+	now := api.Timestamp(time.Now().Add(-2 * time.Hour).Unix())
+	k := api.Key{Key: fingerprinting.Create("key of " + name), Name: "owner", Type: "key type", Expiry: now + 86400*30, EntityVersion: 1}
+	k.Fingerprint = api.Fingerprint(fingerprinting.Create("key fingerprint " + name))
+	k.Creation = now
+	k.ProofOfWork = "pow"
+	k.Signature = "sig"
+	b := api.Board{Name: name, Owner: k.Fingerprint, OwnerPublicKey: k.Key, Language: "en", EntityVersion: 1}
+	b.Fingerprint = boardFingerprint(name)
+	b.Creation = now
+	b.ProofOfWork = "pow"
+	b.Signature = "sig"
+	return api.Answer{Keys: []api.Key{k}, Boards: []api.Board{b}}
+}
+
+func boardFingerprint(name string) api.Fingerprint {
+	return api.Fingerprint(fingerprinting.Create("board fingerprint " + name))
+}
+
+func haveBoard(t *testing.T, name string) bool {
+	boards, err := persistence.ReadBoards([]api.Fingerprint{boardFingerprint(name)}, 0, 0, "", "", 0, 0)
+	if err != nil {
+		t.Fatalf("The boards could not be read. Error: %v", err)
+	}
+	return len(boards) == 1
+}
+
+// onSim puts this node on the simulated network, at the location and port its Mim server would listen at.
+func onSim(t *testing.T, loc string, port uint16) {
+	if err := globals.BackendConfig.SetExternalIpType(transport.LocationTypeSim); err != nil {
+		t.Fatalf("The external IP type could not be set. Error: %v", err)
+	}
+	globals.BackendConfig.SetExternalIp(loc)
+	globals.BackendConfig.SetExternalPort(int(port))
+}
+
+// oneConnListener gives out a single connection that is already open, so that a HTTP server can be run over a connection that was dialed, as the remote end of a reverse open does.
+type oneConnListener struct {
+	conns  chan net.Conn
+	closed chan struct{}
+	once   sync.Once
+	addr   net.Addr
+}
+
+func newOneConnListener(c net.Conn) *oneConnListener {
+	l := &oneConnListener{conns: make(chan net.Conn, 1), closed: make(chan struct{}), addr: c.LocalAddr()}
+	l.conns <- c
+	return l
+}
+
+func (l *oneConnListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, errors.New("use of closed network connection")
+	}
+}
+
+func (l *oneConnListener) Close() error {
+	l.once.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *oneConnListener) Addr() net.Addr { return l.addr }
+
+func contains(list []string, item string) bool {
+	for _, val := range list {
+		if val == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Tests
+
+func TestSync_ScriptedPeer_Success(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	p := newScriptedPeer(t, "peer", 51000)
+	defer p.close()
+	p.has(boardWithOwner("sim board"))
+	if err := Sync(p.addr(), []string{}, nil); err != nil {
+		t.Fatalf("The sync failed. Error: %v", err)
+	}
+	if !haveBoard(t, "sim board") {
+		t.Errorf("The board of the peer should have arrived.")
+	}
+	reqs := p.requestsMade()
+	if len(reqs) < 3 || reqs[0] != "GET status" || reqs[1] != "GET node" || reqs[2] != "POST node" {
+		t.Errorf("The sync should start with the check. Requests: %v", reqs)
+	}
+	for _, ep := range []string{"c0/boards", "c0/keys", "c0/threads", "c0/posts", "c0/votes", "c0/truststates"} {
+		if !contains(reqs, "GET "+ep+"/index.json") || !contains(reqs, "POST "+ep) {
+			t.Errorf("The sync should have asked for both the caches and the POST response of %v. Requests: %v", ep, reqs)
+		}
+	}
+	n, err := persistence.ReadNode(p.nodeId())
+	if err != nil {
+		t.Fatalf("The peer should have been saved as a node. Error: %v", err)
+	}
+	if n.BoardsLastCheckin != p.stamp || n.KeysLastCheckin != p.stamp {
+		t.Errorf("The sync should have saved how far it got. Expected: %v, Got: %#v", p.stamp, n)
+	}
+	addrs, err2 := persistence.ReadAddresses(api.Location(p.loc), "", p.port, 0, 0, 0, 0, 0, "basic")
+	if err2 != nil || len(addrs) != 1 || addrs[0].LastSuccessfulSync == 0 || addrs[0].LocationType != transport.LocationTypeSim {
+		t.Errorf("The address of the peer should have been saved with the sync. Addresses: %#v, Error: %v", addrs, err2)
+	}
+	if r := reputationOf(p.addr()); r.Successes != 1 || r.Failures != 0 || r.Received < 2 {
+		t.Errorf("The sync should count towards the peer's reputation. Got: %#v", r)
+	}
+}
+
+func TestSync_ScriptedPeer_Incremental_Success(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	p := newScriptedPeer(t, "peer", 51000)
+	defer p.close()
+	p.has(boardWithOwner("first board"))
+	first := p.stamp
+	if err := Sync(p.addr(), []string{}, nil); err != nil {
+		t.Fatalf("The first sync failed. Error: %v", err)
+	}
+	// What came before the caches would be in the caches, so the first sync asks for what came after them.
+	if start := p.filterStart("c0/boards"); start != strconv.Itoa(int(p.cached)) {
+		t.Errorf("The first sync should ask for what came after the caches. Expected: %v, Got: %v", p.cached, start)
+	}
+	p.has(boardWithOwner("second board"))
+	p.setStamp(first + 600)
+	if err := Sync(p.addr(), []string{}, nil); err != nil {
+		t.Fatalf("The second sync failed. Error: %v", err)
+	}
+	// The second sync picks up where the first left off.
+	if start := p.filterStart("c0/boards"); start != strconv.Itoa(int(first)) {
+		t.Errorf("The second sync should ask for what came after the first. Expected: %v, Got: %v", first, start)
+	}
+	if !haveBoard(t, "first board") || !haveBoard(t, "second board") {
+		t.Errorf("Both boards should have arrived.")
+	}
+	if n, _ := persistence.ReadNode(p.nodeId()); n.BoardsLastCheckin != first+600 {
+		t.Errorf("The second sync should have moved the checkin forward. Got: %v", n.BoardsLastCheckin)
+	}
+	if r := reputationOf(p.addr()); r.Successes != 2 {
+		t.Errorf("Both syncs should count towards the peer's reputation. Got: %#v", r)
+	}
+}
+
+func TestSync_Unreachable_Fail(t *testing.T) {
+	cases := []struct {
+		name string
+		lc   transport.LinkConditions
+	}{
+		{"lost dials", transport.LinkConditions{Loss: 1}},
+		{"behind a NAT", transport.LinkConditions{NAT: true}},
+	}
+	for _, c := range cases {
+		func() {
+			sim, teardown := setupSimNode(t)
+			defer teardown()
+			p := newScriptedPeer(t, "peer", 51000)
+			defer p.close()
+			p.has(boardWithOwner("unreachable board"))
+			sim.SetConditions(p.loc, c.lc)
+			err := Sync(p.addr(), []string{}, nil)
+			if err == nil || !isTimeout(err) {
+				t.Errorf("The sync should have timed out. Case: %v, Error: %v", c.name, err)
+			}
+			if reqs := p.requestsMade(); len(reqs) != 0 {
+				t.Errorf("Nothing should have reached the peer. Case: %v, Requests: %v", c.name, reqs)
+			}
+			if haveBoard(t, "unreachable board") {
+				t.Errorf("Nothing should have arrived. Case: %v", c.name)
+			}
+			if r := reputationOf(p.addr()); r.Failures != 1 || r.Timeouts != 1 {
+				t.Errorf("The timeout should count against the peer's reputation. Case: %v, Got: %#v", c.name, r)
+			}
+		}()
+	}
+}
+
+func TestSync_TamperedPages_Fail(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	p := newScriptedPeer(t, "peer", 51000)
+	defer p.close()
+	p.has(boardWithOwner("tampered board"))
+	p.setTamper(true)
+	for i := 0; i < banOffenceThreshold; i++ {
+		if err := Sync(p.addr(), []string{}, nil); err == nil {
+			t.Errorf("A sync with a peer whose pages don't match their signatures should fail. Attempt: %d", i)
+		}
+	}
+	if haveBoard(t, "tampered board") {
+		t.Errorf("Nothing from the tampered pages should have arrived.")
+	}
+	if !peerReps.IsBanned(p.addr()) {
+		t.Fatalf("The peer should be banned after %d rejected pages. Got: %#v", banOffenceThreshold, reputationOf(p.addr()))
+	}
+	// The neighbour watch and the scout go through connect, which doesn't reach out to a banned peer.
+	before := len(p.requestsMade())
+	if err := connect(p.addr()); err == nil || !strings.Contains(err.Error(), "banned") {
+		t.Errorf("A banned peer should not be connected to. Error: %v", err)
+	}
+	if after := len(p.requestsMade()); after != before {
+		t.Errorf("Nothing should have reached the banned peer. Requests before: %d, after: %d", before, after)
+	}
+}
+
+func TestSync_PolicyFailures_NotBanned_Success(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	p := newScriptedPeer(t, "peer", 51000)
+	defer p.close()
+	// A peer on an older build relays boards whose PoW declarations are stronger than what this build allows. Enough of them in one page to get it rejected, if they counted as malformed.
+	content := boardWithOwner("good board")
+	for i := 0; i < 3; i++ {
+		b := boardWithOwner(fmt.Sprint("overdeclared board ", i)).Boards[0]
+		b.Meta = fmt.Sprintf(`{"v":1,"min_pow":{"thread":%d}}`, api.MAX_BOARD_POW_V1+1)
+		content.Boards = append(content.Boards, b)
+	}
+	p.has(content)
+	for i := 0; i < banOffenceThreshold; i++ {
+		if err := Sync(p.addr(), []string{}, nil); err != nil {
+			t.Errorf("A sync with a peer that sends entities failing only our policy should go through. Attempt: %d, Err: %v", i, err)
+		}
+	}
+	if !haveBoard(t, "good board") {
+		t.Errorf("The valid board in the page should have arrived.")
+	}
+	if haveBoard(t, "overdeclared board 0") {
+		t.Errorf("The boards failing the policy should have been dropped.")
+	}
+	if peerReps.IsBanned(p.addr()) {
+		t.Fatalf("The peer should not be banned for entities failing only our policy. Got: %#v", reputationOf(p.addr()))
+	}
+	r := reputationOf(p.addr())
+	if r.RejectedPages != 0 || r.RecentOffences != 0 {
+		t.Errorf("No page should have been rejected, and nothing should count as an offence. Got: %#v", r)
+	}
+	if r.InvalidEntities == 0 || reputationScore(&r) >= reputationScore(&persistence.DbPeerReputation{Successes: r.Successes, Received: r.Received}) {
+		t.Errorf("The entities failing our policy should still lower the peer's score. Got: %#v", r)
+	}
+}
+
+func TestSync_Partitioned_Fail(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	p := newScriptedPeer(t, "peer", 51000)
+	defer p.close()
+	p.has(boardWithOwner("partitioned board"))
+	Partition([]uint16{p.port})
+	defer Heal([]uint16{p.port})
+	err := Sync(p.addr(), []string{}, nil)
+	if err != errPartitioned {
+		t.Errorf("A sync with a partitioned remote should be refused. Err: %v", err)
+	}
+	if reqs := p.requestsMade(); len(reqs) != 0 {
+		t.Errorf("Nothing should have reached the partitioned peer. Requests: %v", reqs)
+	}
+	if _, ok := peerReps.Reps[reputationKey(api.Location(p.loc), "", p.port)]; ok {
+		t.Errorf("A partition should not count against the peer's reputation.")
+	}
+	// Once healed, the same peer syncs.
+	Heal([]uint16{p.port})
+	if err := Sync(p.addr(), []string{}, nil); err != nil {
+		t.Errorf("The sync should go through once the partition is healed. Err: %v", err)
+	}
+	if !haveBoard(t, "partitioned board") {
+		t.Errorf("The board should have arrived after the partition was healed.")
+	}
+}
+
+func TestSync_Concurrent_SameNode_Fail(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	// Leases for both, so that only the node can keep the second one out.
+	globals.BackendConfig.SetMaxOutboundConns(2)
+	p := newScriptedPeer(t, "peer", 51000)
+	defer p.close()
+	p.alsoAt(t, "sim:peer-other", 51001)
+	other := api.Address{Location: "sim:peer-other", Port: 51001, Type: p.addrType}
+	p.has(boardWithOwner("concurrent board"))
+	p.hold = make(chan struct{})
+	p.held = make(chan string, 100)
+	firstErr := make(chan error)
+	go func() { firstErr <- Sync(p.addr(), []string{}, nil) }()
+	select {
+	case <-p.held:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("The first sync should have got to the POST requests.")
+	}
+	// The first sync is now in flight. The same node at another address is the same remote.
+	err := Sync(other, []string{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "Already syncing with this remote") {
+		t.Errorf("A second sync with the same node should be refused while the first is in flight. Error: %v", err)
+	}
+	if addrs, _ := persistence.ReadAddresses(api.Location(other.Location), "", other.Port, 0, 0, 0, 0, 0, "basic"); len(addrs) != 0 {
+		t.Errorf("The refused sync should not have written anything of the node. Addresses: %#v", addrs)
+	}
+	if r := reputationOf(other); r.Failures != 0 {
+		t.Errorf("A refused sync should not count against the peer's reputation. Got: %#v", r)
+	}
+	close(p.hold)
+	if err := <-firstErr; err != nil {
+		t.Fatalf("The first sync failed. Error: %v", err)
+	}
+	if !haveBoard(t, "concurrent board") {
+		t.Errorf("The board of the peer should have arrived with the first sync.")
+	}
+	if len(ActiveSyncs()) != 0 {
+		t.Errorf("No sync should be in flight after the first is done. Got: %#v", ActiveSyncs())
+	}
+	// Once the first is done, the node syncs at its other address, too.
+	if err := Sync(other, []string{}, nil); err != nil {
+		t.Errorf("A sync with the node should go through once the one before it is done. Error: %v", err)
+	}
+}
+
+func TestSync_Concurrent_DifferentNodes_Success(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	globals.BackendConfig.SetMaxOutboundConns(2)
+	first := newScriptedPeer(t, "first", 51000)
+	defer first.close()
+	second := newScriptedPeer(t, "second", 51001)
+	defer second.close()
+	first.has(boardWithOwner("first board"))
+	second.has(boardWithOwner("second board"))
+	first.hold = make(chan struct{})
+	first.held = make(chan string, 100)
+	firstErr := make(chan error)
+	go func() { firstErr <- Sync(first.addr(), []string{}, nil) }()
+	select {
+	case <-first.held:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("The first sync should have got to the POST requests.")
+	}
+	// A sync with another node runs next to the one in flight.
+	if err := Sync(second.addr(), []string{}, nil); err != nil {
+		t.Errorf("A sync with another node should go through while the first is in flight. Error: %v", err)
+	}
+	close(first.hold)
+	if err := <-firstErr; err != nil {
+		t.Fatalf("The first sync failed. Error: %v", err)
+	}
+	if !haveBoard(t, "first board") || !haveBoard(t, "second board") {
+		t.Errorf("The boards of both peers should have arrived.")
+	}
+}
+
+func TestSync_ReverseConn_Success(t *testing.T) {
+	sim, teardown := setupSimNode(t)
+	defer teardown()
+	onSim(t, "sim:me", 51000)
+	p := newScriptedPeer(t, "natpeer", 51000)
+	defer p.close()
+	p.has(boardWithOwner("reverse board"))
+	// The peer asks us to connect into it: it dials our TCPMim server, and serves its Mim server over that connection.
+	tcpmimListener, err := sim.Listen("sim:me", 50999)
+	if err != nil {
+		t.Fatalf("The TCPMim listener could not be opened. Error: %v", err)
+	}
+	defer tcpmimListener.Close()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		c, _ := tcpmimListener.Accept()
+		accepted <- c
+	}()
+	peerEnd, err2 := sim.Dial("sim:me", 50999)
+	if err2 != nil {
+		t.Fatalf("The peer could not dial us. Error: %v", err2)
+	}
+	peerListener := newOneConnListener(peerEnd)
+	defer peerListener.Close()
+	go http.Serve(peerListener, p)
+	reverseConn := <-accepted
+	if reverseConn == nil {
+		t.Fatalf("The reverse connection could not be accepted.")
+	}
+	if err := Sync(api.Address{}, []string{}, &reverseConn); err != nil {
+		t.Fatalf("The sync over the reverse connection failed. Error: %v", err)
+	}
+	if !haveBoard(t, "reverse board") {
+		t.Errorf("The board of the peer should have arrived over the reverse connection.")
+	}
+	if reqs := p.requestsMade(); !contains(reqs, "POST c0/boards") || !contains(reqs, "GET revconn/successful") {
+		t.Errorf("The sync should have run over the reverse connection, and told the peer it succeeded. Requests: %v", reqs)
+	}
+	addrs, err4 := persistence.ReadAddresses(api.Location(p.loc), "", p.port, 0, 0, 0, 0, 0, "basic")
+	if err4 != nil || len(addrs) == 0 {
+		t.Errorf("The peer should have been saved at the location it declared on our transport. Addresses: %#v, Error: %v", addrs, err4)
+	}
+}
+
+func TestRequestInboundSync_Sim_Success(t *testing.T) {
+	sim, teardown := setupSimNode(t)
+	defer teardown()
+	onSim(t, "sim:me", 51000)
+	// Our own Mim server. The only part of it that matters here is that it takes the end status the remote sends.
+	var lock sync.Mutex
+	localRemoteAddrs := []string{}
+	local, err := sim.Listen("sim:me", 51000)
+	if err != nil {
+		t.Fatalf("The local server could not listen. Error: %v", err)
+	}
+	defer local.Close()
+	go http.Serve(local, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		localRemoteAddrs = append(localRemoteAddrs, r.RemoteAddr)
+		lock.Unlock()
+		if r.URL.Path == "/v0/revconn/successful" {
+			ReverseConnInfo.SetEndStatus("SUCCESSFUL")
+		}
+		w.Write([]byte("{}"))
+	}))
+	// The TCPMim server of the remote. It takes the reverse open request and makes its requests over the same connection.
+	remote, err2 := sim.Listen("sim:remote", 50999)
+	if err2 != nil {
+		t.Fatalf("The remote could not listen. Error: %v", err2)
+	}
+	defer remote.Close()
+	remoteErrs := make(chan error, 1)
+	go func() {
+		conn, err := remote.Accept()
+		if err != nil {
+			remoteErrs <- err
+			return
+		}
+		defer conn.Close()
+		msg := make([]byte, 9)
+		if _, err := io.ReadFull(conn, msg); err != nil || tcpmim.ParseMimMessage(msg) != tcpmim.ReverseOpenRequest {
+			remoteErrs <- errors.New(fmt.Sprintf("The reverse open request did not arrive. Message: %v, Error: %v", string(msg), err))
+			return
+		}
+		client := &http.Client{Transport: &http.Transport{Dial: func(network, address string) (net.Conn, error) { return conn, nil }}}
+		for _, path := range []string{"status", "revconn/successful"} {
+			resp, err := client.Get("http://localhost/v0/" + path)
+			if err != nil {
+				remoteErrs <- err
+				return
+			}
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+		remoteErrs <- nil
+	}()
+	if err := RequestInboundSync("sim:remote", "", 51000); err != nil {
+		t.Fatalf("The reverse open failed. Error: %v", err)
+	}
+	if err := <-remoteErrs; err != nil {
+		t.Fatalf("The remote end of the reverse open failed. Error: %v", err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if len(localRemoteAddrs) != 2 {
+		t.Fatalf("Both requests of the remote should have arrived at our server. Requests: %v", localRemoteAddrs)
+	}
+	rcd := globals.BackendTransientConfig.ReverseConnData
+	for _, ra := range localRemoteAddrs {
+		host, port := toolbox.SplitHostPort(ra)
+		if host != rcd.C1LocalLocalAddr || port != rcd.C1LocalLocalPort || len(host) == 0 {
+			t.Errorf("Our server should be able to tell the requests came in over the reverse connection. Remote address: %v, Reverse connection: %#v", ra, rcd)
+		}
+	}
+}
+
+func TestBootstrap_ScriptedPeers_Success(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	first := newScriptedPeer(t, "bootstrapper1", 51000)
+	defer first.close()
+	second := newScriptedPeer(t, "bootstrapper2", 51001)
+	defer second.close()
+	// Live bootstrappers. The first one is where we ask for the bootstrappers, and it knows of the second.
+	first.addrType, second.addrType = 3, 3
+	first.has(boardWithOwner("first bootstrapper's board"))
+	second.has(boardWithOwner("second bootstrapper's board"))
+	first.bootstrappers = []api.Address{second.addr()}
+	doBootstrap(first.addr())
+	if !haveBoard(t, "first bootstrapper's board") || !haveBoard(t, "second bootstrapper's board") {
+		t.Errorf("The boards of both bootstrappers should have arrived.")
+	}
+	for _, p := range []*scriptedPeer{first, second} {
+		reqs := p.requestsMade()
+		if !contains(reqs, "GET ping/node") || !contains(reqs, "POST c0/boards") {
+			t.Errorf("The bootstrapper should have been pinged, then synced with. Bootstrapper: %v, Requests: %v", p.loc, reqs)
+		}
+		if !dpe.IsExcluded(p.addr()) {
+			t.Errorf("The bootstrapper should be excluded for a while after a successful bootstrap. Bootstrapper: %v", p.loc)
+		}
+	}
+	if reqs := second.requestsMade(); contains(reqs, "GET bootstrappers") {
+		t.Errorf("Only the first bootstrapper should have been asked for the bootstrappers. Requests: %v", reqs)
+	}
+	if globals.BackendConfig.GetLastBootstrapAddressConnectionTimestamp() == 0 {
+		t.Errorf("The bootstrap should have been recorded.")
+	}
+	if feapiconsumer.BackendAmbientStatus.BootstrapInProgress {
+		t.Errorf("The bootstrap should not be in progress after it's done.")
+	}
+}
+
+func TestBootstrap_NoBootstrappers_Fail(t *testing.T) {
+	_, teardown := setupSimNode(t)
+	defer teardown()
+	nobody := api.Address{Location: "sim:nobody", Port: 51000}
+	before := globals.BackendConfig.GetLastBootstrapAddressConnectionTimestamp()
+	doBootstrap(nobody)
+	if after := globals.BackendConfig.GetLastBootstrapAddressConnectionTimestamp(); after != before {
+		t.Errorf("A bootstrap that found no bootstrappers should not be recorded. Before: %v, After: %v", before, after)
+	}
+	if feapiconsumer.BackendAmbientStatus.BootstrapInProgress {
+		t.Errorf("The bootstrap should not be in progress after it's done.")
+	}
+}
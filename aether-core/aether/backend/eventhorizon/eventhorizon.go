@@ -18,8 +18,10 @@ import (
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
 	"fmt"
+	"github.com/jmoiron/sqlx"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -31,6 +33,8 @@ const (
 
 func delete(ts Timestamp, entityType string) {
 	tableName := ""
+	// The prior versions of updated entities are never referenced by anything, they go by when they were replaced instead. A version is replaced no later than the current one was last referenced, so the history of an entity never outlives it.
+	column := "LastReferenced"
 	switch entityType {
 	case "boards":
 		tableName = "Boards"
@@ -44,19 +48,27 @@ func delete(ts Timestamp, entityType string) {
 		tableName = "Keys"
 	case "truststates":
 		tableName = "Truststates"
+	case "directmessages":
+		tableName = "DirectMessages"
 	case "addresses":
 		tableName = "Addresses"
+	case "threadhistory":
+		tableName = "ThreadHistory"
+		column = "Superseded"
+	case "posthistory":
+		tableName = "PostHistory"
+		column = "Superseded"
 	default:
 		return
 	}
-	query := fmt.Sprintf("DELETE FROM %s WHERE LastReferenced < ?", tableName)
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s < ?", tableName, column)
 	tx, err := globals.DbInstance.Beginx()
 	if err != nil {
 		tx.Rollback()
 		logging.Logf(1, "We couldn't begin the deletion process, transaction open failed. Error: %v", err)
 		return
 	}
-	tx.Exec(query, ts)
+	tx.Exec(tx.Rebind(query), ts)
 	tx.Commit()
 }
 
@@ -79,8 +91,11 @@ func deleteUpToLocalMemory() {
 	delete(lmCutoff, "boards")
 	delete(lmCutoff, "threads")
 	delete(lmCutoff, "posts")
+	delete(lmCutoff, "threadhistory")
+	delete(lmCutoff, "posthistory")
 	delete(lmCutoff, "keys")
 	delete(lmCutoff, "truststates")
+	delete(lmCutoff, "directmessages")
 	delete(lmCutoff, "addresses")
 	// These are the special ones
 	delete(lmCutoff, "votes")
@@ -92,12 +107,43 @@ func deleteUpToEH(eventhorizon Timestamp) {
 	delete(eventhorizon, "boards")
 	delete(eventhorizon, "threads")
 	delete(eventhorizon, "posts")
+	delete(eventhorizon, "threadhistory")
+	delete(eventhorizon, "posthistory")
 	delete(eventhorizon, "keys")
 	delete(eventhorizon, "truststates")
+	delete(eventhorizon, "directmessages")
 	// Addresses is limited to 1000 items and it has its own cycling logic. No need to delete based on event horizon, it will likely yield not many items. The LM cutoff deletion (deleteUpToLocalMemory) does that for us.
 	// delete(eventhorizon, "addresses")
 }
 
+// deleteOutsideSubscriptions removes the threads, posts and votes (and their prior versions) that the subscriptions of this node don't cover. These come from before the subscriptions were set or narrowed. A node with subscriptions doesn't need them, so they go before any of the history of what it's subscribed to does.
+func deleteOutsideSubscriptions(boards []string, users []string) {
+	if len(boards) == 0 && len(users) == 0 {
+		return
+	}
+	conds := []string{}
+	args := []interface{}{}
+	if len(boards) > 0 {
+		conds = append(conds, "Board NOT IN (?)")
+		args = append(args, boards)
+	}
+	if len(users) > 0 {
+		conds = append(conds, "Owner NOT IN (?)")
+		args = append(args, users)
+	}
+	for _, tableName := range []string{"Threads", "Posts", "Votes", "ThreadHistory", "PostHistory"} {
+		query, qargs, err := sqlx.In(fmt.Sprintf("DELETE FROM %s WHERE %s", tableName, strings.Join(conds, " AND ")), args...)
+		if err != nil {
+			logging.Logf(1, "We couldn't build the query to delete what's outside the subscriptions. Table: %v, Error: %v", tableName, err)
+			return
+		}
+		_, err2 := globals.DbInstance.Exec(globals.DbInstance.Rebind(query), qargs...)
+		if err2 != nil {
+			logging.Logf(1, "Deleting what's outside the subscriptions failed. Table: %v, Error: %v", tableName, err2)
+		}
+	}
+}
+
 func getDbSize() int {
 	switch globals.BackendConfig.GetDbEngine() {
 	case "mysql":
@@ -117,6 +163,14 @@ func getDbSize() int {
 			logging.LogCrash("The attempt to read the MySQL database size failed.")
 		}
 		return size
+	case "postgres":
+		query := `SELECT pg_database_size(current_database()) / 1000000`
+		var size int
+		err := globals.DbInstance.Get(&size, query)
+		if err != nil {
+			logging.LogCrash("The attempt to read the Postgres database size failed.")
+		}
+		return size
 	case "sqlite":
 		dbLoc := filepath.Join(globals.BackendConfig.GetSQLiteDBLocation(), "AetherDB.db")
 		fi, _ := os.Stat(dbLoc)
@@ -139,6 +193,12 @@ func PruneDB() {
 	logging.Logf(2, "DbSize at the beginning of PruneDB: %v", getDbSize())
 	logging.Logf(2, "Event horizon at the beginning of PruneDB: %v", time.Unix(int64(tempeh), 0).String())
 	deleteUpToLocalMemory()
+	if getDbSize() > globals.BackendConfig.GetMaxDbSizeMb() {
+		/*
+			If this node syncs only a part of the network, what's outside of its subscriptions goes first. The event horizon should only have to move closer to now when what the node is subscribed to doesn't fit.
+		*/
+		deleteOutsideSubscriptions(globals.BackendConfig.GetSubscribedBoards(), globals.BackendConfig.GetFollowedUsers())
+	}
 	if getDbSize() <= globals.BackendConfig.GetMaxDbSizeMb() {
 		/*
 			Below, we move event horizon one day behind, OR, if one day behind the EH goes out of the range for local memory, the local memory.
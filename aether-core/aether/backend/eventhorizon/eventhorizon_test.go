@@ -151,7 +151,7 @@ func setEventHorizonToNow() {
 
 func setEventHorizonToEndOfLocalMemory() {
 	lmD := globals.BackendConfig.GetLocalMemoryDays()
-	lmCutoff := Timestamp(toolbox.CnvToCutoffDays(lmD))
+	lmCutoff := toolbox.CnvToCutoffDays(lmD)
 	globals.BackendConfig.SetEventHorizonTimestamp(lmCutoff)
 }
 
@@ -163,7 +163,7 @@ func TestPostInsert_Success(t *testing.T) {
 	ps := generatePosts(count, "")
 	insertPosts(ps, time.Unix(5, 0))
 	now := api.Timestamp(time.Now().Unix())
-	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now)
+	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now, "", "", "", "", 0, 0)
 	if count > len(p) {
 		t.Errorf("Insertion failed, not all data requested has been inserted.")
 	}
@@ -181,7 +181,7 @@ func TestPruneDB_PastLocalMemory_Success(t *testing.T) {
 	insertPosts(ps, time.Unix(5, 0))
 	eventhorizon.PruneDB()
 	now := api.Timestamp(time.Now().Unix())
-	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now)
+	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now, "", "", "", "", 0, 0)
 	if len(p) != 0 {
 		t.Errorf("Event horizon failed to clear data that is past local memory. Local memory still has %v posts", len(p))
 
@@ -197,7 +197,7 @@ func TestPruneDB_WithinLocalMemory_Success(t *testing.T) {
 	insertPosts(ps, time.Now().Add(-time.Duration(1)*time.Second))
 	eventhorizon.PruneDB()
 	now := api.Timestamp(time.Now().Unix())
-	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now)
+	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now, "", "", "", "", 0, 0)
 	if len(p) != count {
 		t.Errorf("Event horizon accidentally cleared data that was within the network memory.")
 	}
@@ -223,7 +223,7 @@ func TestPruneDB_WithinLocalMemory_TooBigDb_Success(t *testing.T) {
 	insertPosts(ps3, time.Now().Add(-time.Duration(38*time.Hour*24)))
 	eventhorizon.PruneDB()
 	now := api.Timestamp(time.Now().Unix())
-	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now)
+	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now, "", "", "", "", 0, 0)
 	if len(p) != count1+count2 {
 		t.Errorf("Event horizon accidentally cleared data that was within the network memory.")
 	}
@@ -256,7 +256,7 @@ func TestPruneDB_NonBacktrack_Success(t *testing.T) {
 	eventhorizon.PruneDB()
 	newEh := globals.BackendConfig.GetEventHorizonTimestamp()
 	lmD := globals.BackendConfig.GetLocalMemoryDays()
-	lmCutoff := Timestamp(toolbox.CnvToCutoffDays(lmD))
+	lmCutoff := toolbox.CnvToCutoffDays(lmD)
 	newSupposedEh := lmCutoff
 	if newEh != newSupposedEh {
 		t.Errorf("Event horizon failed to not backtrack backtrack on 3 runs. EH: %v, Supposed EH: %v", newEh, newSupposedEh)
@@ -278,7 +278,7 @@ func TestPruneDB_ScaledModeGetsEnabled_Success(t *testing.T) {
 	if globals.BackendConfig.GetScaledMode() != true {
 		t.Errorf("Event horizon failed to enable the scaled mode when it should have.")
 	}
-	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, api.Timestamp(time.Now().Unix()))
+	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, api.Timestamp(time.Now().Unix()), "", "", "", "", 0, 0)
 	// fmt.Println(len(p))
 	if len(p) != count1 {
 		t.Errorf("Event horizon did not stop deleting from within the network head when it should have.")
@@ -298,9 +298,80 @@ func TestPruneDB_ScaledModeManuallySet_Success(t *testing.T) {
 	if globals.BackendConfig.GetScaledMode() != false {
 		t.Errorf("Event horizon shouldn't have touched the scaled mode because the it is manually set by the user.")
 	}
-	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, api.Timestamp(time.Now().Unix()))
+	p, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, api.Timestamp(time.Now().Unix()), "", "", "", "", 0, 0)
 	// fmt.Println(len(p))
 	if len(p) != count1 {
 		t.Errorf("Event horizon did not stop deleting from within the network head when it should have.")
 	}
 }
+
+// The prior versions of a post go when they were replaced before the local memory, the current version stays.
+func TestPruneDB_PostHistory_PastLocalMemory_Success(t *testing.T) {
+	deleteAllPosts()
+	globals.DbInstance.Exec("DELETE FROM PostHistory")
+	setEventHorizonToEndOfLocalMemory()
+	oldFp := api.Fingerprint("fp-history-old")
+	recentFp := api.Fingerprint("fp-history-recent")
+	// The generated posts aren't minted.
+	globals.BackendTransientConfig.FingerprintCheckEnabled = false
+	globals.BackendTransientConfig.SignatureCheckEnabled = false
+	globals.BackendTransientConfig.ProofOfWorkCheckEnabled = false
+	defer func() {
+		globals.BackendTransientConfig.FingerprintCheckEnabled = true
+		globals.BackendTransientConfig.SignatureCheckEnabled = true
+		globals.BackendTransientConfig.ProofOfWorkCheckEnabled = true
+	}()
+	for _, fp := range []api.Fingerprint{oldFp, recentFp} {
+		p := generatePost(fp, "threadpk")
+		persistence.BatchInsert([]interface{}{p})
+		p.Body = "edited"
+		p.LastUpdate = 3
+		persistence.BatchInsert([]interface{}{p})
+	}
+	// The old one was replaced before the local memory.
+	lmD := globals.BackendConfig.GetLocalMemoryDays()
+	globals.DbInstance.Exec(globals.DbInstance.Rebind("UPDATE PostHistory SET Superseded = ? WHERE Fingerprint = ?"), toolbox.CnvToCutoffDays(lmD+1), oldFp)
+	eventhorizon.PruneDB()
+	oldHist, _ := persistence.ReadPostHistory(oldFp)
+	if len(oldHist) != 0 {
+		t.Errorf("Event horizon failed to clear the prior versions that were replaced before the local memory. History: %#v", oldHist)
+	}
+	recentHist, _ := persistence.ReadPostHistory(recentFp)
+	if len(recentHist) != 1 {
+		t.Errorf("Event horizon accidentally cleared the prior versions that were replaced within the local memory. History: %#v", recentHist)
+	}
+	p, _ := persistence.ReadPosts([]api.Fingerprint{oldFp, recentFp}, 0, 0, "", "", "", "", 0, 0)
+	if len(p) != 2 {
+		t.Errorf("Event horizon cleared the current versions of the posts along with their history. Posts: %v", len(p))
+	}
+}
+
+// When the DB is too big, what's outside the subscriptions goes first, even from within the network head. What's inside stays.
+func TestPruneDB_OutsideSubscriptions_TooBigDb_Success(t *testing.T) {
+	deleteAllPosts()
+	setEventHorizonToEndOfLocalMemory()
+	priorMaxDbSize := globals.BackendConfig.GetMaxDbSizeMb()
+	priorBoards := globals.BackendConfig.GetSubscribedBoards()
+	globals.BackendConfig.SetScaledModeUserSet(false)
+	globals.BackendConfig.SetMaxDbSizeMb(2)
+	globals.BackendConfig.SetSubscribedBoards([]string{"boardpk"})
+	count := 1000 // ~4mb
+	ps := generatePosts(count, "-subscribed-")
+	others := generatePosts(count, "-other-")
+	for key, _ := range others {
+		others[key].Board = "otherboardpk"
+	}
+	insertPosts(append(ps, others...), time.Now().Add(-time.Duration(1*time.Hour))) // within the network head.
+	eventhorizon.PruneDB()
+	now := api.Timestamp(time.Now().Unix())
+	subscribed, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now, "boardpk", "", "", "", 0, 0)
+	if len(subscribed) != count {
+		t.Errorf("Event horizon deleted from the subscribed board within the network head. Remaining: %v", len(subscribed))
+	}
+	outside, _ := persistence.ReadPosts([]api.Fingerprint{}, 0, now, "otherboardpk", "", "", "", 0, 0)
+	if len(outside) != 0 {
+		t.Errorf("Event horizon failed to clear what's outside the subscriptions when the DB was too big. Remaining: %v", len(outside))
+	}
+	globals.BackendConfig.SetMaxDbSizeMb(priorMaxDbSize)
+	globals.BackendConfig.SetSubscribedBoards(priorBoards)
+}
@@ -0,0 +1,114 @@
+// Backend > ResponseGenerator > Bundle
+// This file creates offline bundles: a time range of the entities we have, as signed cache pages, in a single compressed file that can be carried to a node without network access.
+
+package responsegenerator
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/services/compress"
+	"aether-core/aether/services/globals"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+A bundle is the pages of the caches that we would generate for the given time range, one set per entity type and per realm asked for, put into one file. The pages are the same as the pages of a cache, and they are signed by this node the same way, so the node importing the bundle can check them exactly the way it checks the pages it fetches over the network.
+
+A bundle carries only the public realm, unless other realms are asked for by name. A file can be carried anywhere, and whoever has it can read it, so a realm goes into a bundle only if the operator means it to. (See the realms in io/api/apistructs.go.)
+
+Only the entity pages go into a bundle. Indexes and manifests are there to let a remote pick what to download, but a bundle is always imported in full, so they would only take up space.
+*/
+
+// BundleVersion is the version of the bundle format. The importer refuses bundles with a version it doesn't know.
+const BundleVersion = 1
+
+// Bundle is the content of an offline bundle file, before compression.
+type Bundle struct {
+	BundleVersion int               `json:"bundle_version"`
+	NodePublicKey string            `json:"node_public_key"`
+	Timestamp     api.Timestamp     `json:"timestamp"`
+	StartsFrom    api.Timestamp     `json:"starts_from"`
+	EndsAt        api.Timestamp     `json:"ends_at"`
+	Pages         []api.ApiResponse `json:"pages"`
+}
+
+// CreateBundle gathers the entities between start and end into signed pages, and returns them as a compressed bundle. The public realm is always in the bundle, and the realms given are added to it. Every one of them has to be a realm we serve.
+func CreateBundle(start, end api.Timestamp, realms []api.Fingerprint) ([]byte, error) {
+	if start >= end {
+		return []byte{}, errors.New(fmt.Sprintf("The start of the bundle needs to be before its end. Start: %v, End: %v", start, end))
+	}
+	bundleRealms := []api.Fingerprint{api.PublicRealm}
+	for _, realm := range realms {
+		if realm == api.PublicRealm {
+			continue
+		}
+		if !api.RealmServed(realm) {
+			return []byte{}, errors.New(fmt.Sprintf("This realm is not one this node serves, so it can't go into a bundle. Realm: %v", realm))
+		}
+		bundleRealms = append(bundleRealms, realm)
+	}
+	b := Bundle{
+		BundleVersion: BundleVersion,
+		NodePublicKey: globals.BackendConfig.GetMarshaledBackendPublicKey(),
+		Timestamp:     api.Timestamp(time.Now().Unix()),
+		StartsFrom:    start,
+		EndsAt:        end,
+	}
+	entityTypes := []string{"boards", "threads", "posts", "votes", "keys", "truststates", "addresses"}
+	if api.SubprotocolServed(api.DirectMessagesSubprotocol, "directmessage") {
+		entityTypes = append(entityTypes, "directmessages")
+	}
+	for _, etype := range entityTypes {
+		etypeRealms := bundleRealms
+		if etype == "addresses" {
+			// Addresses are not bound to a realm, they only live in the public caches.
+			etypeRealms = []api.Fingerprint{api.PublicRealm}
+		}
+		for _, realm := range etypeRealms {
+			cacheData, err := GatherCacheData(etype, realm, start, end)
+			if err != nil {
+				return []byte{}, errors.New(fmt.Sprintf("Bundle creation failed while gathering the entities. Entity type: %v, Realm: %v, Error: %v", etype, realm, err))
+			}
+			pages := convertResponsesToApiResponses(cacheData.entityPages)
+			for i, _ := range *pages {
+				(*pages)[i].Caching.EntityCounts = *cacheData.counts
+				(*pages)[i].Pagination.Pages = uint64(len(*pages))
+				(*pages)[i].Pagination.CurrentPage = uint64(i)
+				(*pages)[i].Timestamp = b.Timestamp
+				(*pages)[i].StartsFrom = start
+				(*pages)[i].EndsAt = end
+				(*pages)[i].Entity = etype
+				(*pages)[i].Endpoint = etype
+				signingErr := (*pages)[i].CreateSignature(globals.BackendConfig.GetBackendKeyPair())
+				if signingErr != nil {
+					return []byte{}, errors.New(fmt.Sprintf("A page of the bundle failed to be page-signed. Entity type: %v, Realm: %v, Error: %v", etype, realm, signingErr))
+				}
+				b.Pages = append(b.Pages, (*pages)[i])
+			}
+		}
+	}
+	bundleAsJson, err := json.Marshal(b)
+	if err != nil {
+		return []byte{}, errors.New(fmt.Sprintf("The bundle could not be converted to JSON. Error: %v", err))
+	}
+	return compress.Zip(string(bundleAsJson)), nil
+}
+
+// ReadBundle uncompresses and parses a bundle. This does not verify the pages in it, that's up to the importer.
+func ReadBundle(data []byte) (Bundle, error) {
+	var b Bundle
+	bundleAsJson, err := compress.Unzip(data)
+	if err != nil {
+		return b, errors.New(fmt.Sprintf("The bundle could not be uncompressed. Error: %v", err))
+	}
+	err2 := json.Unmarshal([]byte(bundleAsJson), &b)
+	if err2 != nil {
+		return b, errors.New(fmt.Sprintf("The bundle is malformed. Error: %v", err2))
+	}
+	if b.BundleVersion != BundleVersion {
+		return b, errors.New(fmt.Sprintf("This bundle version is not supported. Bundle version: %v, Supported: %v", b.BundleVersion, BundleVersion))
+	}
+	return b, nil
+}
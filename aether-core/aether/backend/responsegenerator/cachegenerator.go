@@ -38,12 +38,14 @@ type CacheResponse struct {
 }
 
 // GatherCacheData responds to a cache generation request. This returns an Api.Response entity with entities, entity indexes, and the cache link that needs to be inserted into the index of the endpoint.
-// This has no filters.
-func GatherCacheData(etype string, start api.Timestamp, end api.Timestamp) (CacheResponse, error) {
+// This has no filters other than the realm: every realm we serve gets its own set of caches.
+func GatherCacheData(etype string, realm api.Fingerprint, start api.Timestamp, end api.Timestamp) (CacheResponse, error) {
 	var cacheRespStruct CacheResponse
 	switch etype {
-	case "boards", "threads", "posts", "votes", "keys", "truststates":
-		localData, dbError := persistence.Read(etype, []api.Fingerprint{}, []string{}, start, end, true, nil)
+	case "boards", "threads", "posts", "votes", "keys", "truststates", "directmessages":
+		opts := persistence.NewOptionalReadInputs()
+		opts.Realms = []api.Fingerprint{realm}
+		localData, dbError := persistence.Read(etype, []api.Fingerprint{}, []string{}, start, end, true, opts)
 		if dbError != nil {
 			return cacheRespStruct, errors.New(fmt.Sprintf("This cache generation request caused an error in the local database while trying to respond to this request. Error: %#v\n", dbError))
 		}
@@ -155,9 +157,9 @@ func createResultCacheBlockForIndex(cacheData *CacheResponse) api.ResultCache {
 	1) Delete caches that have gone past the network head threshold
 	2) Delete caches that we do no longer carry in the index payload. (i.e. consolidated caches' non-consolidated old versions.) We do this trailing caches by one hour, to allow anyone that is still downloading from the cache to finish downloading.
 */
-func deleteTooOldCaches(etype string, cacheIndex *api.ApiResponse) {
-	logging.Logf(1, "DeleteTooOldCaches starts to run for Entity type: %v", etype)
-	entityCacheDir, err := generateEndpointDir(etype)
+func deleteTooOldCaches(etype string, realm api.Fingerprint, cacheIndex *api.ApiResponse) {
+	logging.Logf(1, "DeleteTooOldCaches starts to run for Entity type: %v, Realm: %v", etype, realm)
+	entityCacheDir, err := generateEndpointDir(etype, realm)
 	if err != nil {
 		logging.Logf(1, "deleteTooOldCaches errored out when trying to generate the entity cache directory for this entity type. Err: %v", err)
 		return
@@ -255,49 +257,35 @@ func markCacheForDeletion(cachePath string) {
 	logging.Logf(1, "Delete in next cycle marker inserted to cachepath: %v", cachePath)
 }
 
-func generateEndpointDir(etype string) (string, error) {
-	protv := globals.BackendConfig.GetProtURLVersion()
-	var ecd string
-	if etype == "boards" ||
-		etype == "threads" ||
-		etype == "posts" ||
-		etype == "votes" ||
-		etype == "keys" ||
-		etype == "truststates" {
-		ecd = filepath.Join(globals.BackendConfig.GetCachesDirectory(), protv, "c0", etype)
-	} else if etype == "addresses" {
-		ecd = filepath.Join(globals.BackendConfig.GetCachesDirectory(), protv, etype)
-	} else {
-		return ecd, errors.New(fmt.Sprintf("Unknown response type: %s", etype))
+func generateEndpointDir(etype string, realm api.Fingerprint) (string, error) {
+	rpath, err := generateEndpointRelativePath(etype, realm)
+	if err != nil {
+		return rpath, err
 	}
-	return ecd, nil
+	return filepath.Join(globals.BackendConfig.GetCachesDirectory(), rpath), nil
 }
 
-func generateEndpointRelativePath(etype string) (string, error) {
-	protv := globals.BackendConfig.GetProtURLVersion()
-	var ecd string
+func generateEndpointRelativePath(etype string, realm api.Fingerprint) (string, error) {
 	if etype == "boards" ||
 		etype == "threads" ||
 		etype == "posts" ||
 		etype == "votes" ||
 		etype == "keys" ||
-		etype == "truststates" {
-		ecd = filepath.Join(protv, "c0", etype)
-	} else if etype == "addresses" {
-		ecd = filepath.Join(protv, etype)
-	} else {
-		return ecd, errors.New(fmt.Sprintf("Unknown response type: %s", etype))
+		etype == "truststates" ||
+		etype == "directmessages" ||
+		etype == "addresses" {
+		return cacheTypeDir(etype, realm), nil
 	}
-	return ecd, nil
+	return "", errors.New(fmt.Sprintf("Unknown response type: %s", etype))
 }
 
 // CreateNewCache creates the cache for the given entity type for the given time range.
-func CreateNewCache(etype string, start api.Timestamp, end api.Timestamp, allPriorCachesGeneratedSoFarAreEmpty bool) (bool, error) {
+func CreateNewCache(etype string, realm api.Fingerprint, start api.Timestamp, end api.Timestamp, allPriorCachesGeneratedSoFarAreEmpty bool) (bool, error) {
 	// - Pull the data from the DB
 	// - Look at the cache folder. If there is a cache folder and an index there, save the cache and add to index.
 	// - If there is no cache present there, create the index and add it as the first entry.
 	// fmt.Printf("CreateNewCache was asked to generate a cache for the resp type %#v that ended at the timestamp: %#v\n", etype, end)
-	cacheData, err := GatherCacheData(etype, start, end)
+	cacheData, err := GatherCacheData(etype, realm, start, end)
 	if err != nil {
 		return false, errors.New(fmt.Sprintf("Cache creation process encountered an error. Error: %s", err))
 	}
@@ -314,9 +302,9 @@ func CreateNewCache(etype string, start api.Timestamp, end api.Timestamp, allPri
 	startAsString := strconv.FormatInt(int64(cacheData.start), 10)
 	endAsString := strconv.FormatInt(int64(cacheData.end), 10)
 	filter := api.Filter{Type: "timestamp", Values: []string{startAsString, endAsString}}
-	generateContainer(ePagesApiresp, iPagesApiresp, mPagesApiresp, cacheData.counts, &[]api.Filter{filter}, cacheData.cacheName, false, etype, api.Timestamp(cacheData.start))
+	generateContainer(ePagesApiresp, iPagesApiresp, mPagesApiresp, cacheData.counts, &[]api.Filter{filter}, cacheData.cacheName, false, etype, realm, api.Timestamp(cacheData.start))
 	// Generate endpoint index.
-	epd, err := generateEndpointDir(etype)
+	epd, err := generateEndpointDir(etype, realm)
 	if err != nil {
 		return false, err
 	}
@@ -346,7 +334,7 @@ func CreateNewCache(etype string, start api.Timestamp, end api.Timestamp, allPri
 	}
 	// If the file exists, go through with regular processing.
 	updateEndpointIndex(&endpointIndex, &cacheData)
-	deleteTooOldCaches(etype, &endpointIndex)
+	deleteTooOldCaches(etype, realm, &endpointIndex)
 	signingErr := endpointIndex.CreateSignature(globals.BackendConfig.GetBackendKeyPair())
 	if signingErr != nil {
 		return false, errors.New(fmt.Sprintf("This entity index failed to be page-signed. Error: %#v Page: %#v\n", signingErr, endpointIndex))
@@ -360,9 +348,9 @@ func CreateNewCache(etype string, start api.Timestamp, end api.Timestamp, allPri
 }
 
 // CreateNewCache creates the cache for the given entity type for the given time range.
-func CreateNewCacheV2(etype string, start, end api.Timestamp) (cachegenSkipped bool, resultCache api.ResultCache, err error) {
+func CreateNewCacheV2(etype string, realm api.Fingerprint, start, end api.Timestamp) (cachegenSkipped bool, resultCache api.ResultCache, err error) {
 	// logging.Logf((1, "CreateNewCache was asked to generate a cache for the resp type %#v that ended at the timestamp: %#v\n", etype, end)
-	cacheData, err := GatherCacheData(etype, start, end)
+	cacheData, err := GatherCacheData(etype, realm, start, end)
 	if err != nil {
 		return false, api.ResultCache{}, errors.New(fmt.Sprintf("Cache creation process encountered an error. Error: %s", err))
 	}
@@ -372,7 +360,7 @@ func CreateNewCacheV2(etype string, start, end api.Timestamp) (cachegenSkipped b
 	startAsString := strconv.FormatInt(int64(cacheData.start), 10)
 	endAsString := strconv.FormatInt(int64(cacheData.end), 10)
 	filter := api.Filter{Type: "timestamp", Values: []string{startAsString, endAsString}}
-	generateContainer(ePagesApiresp, iPagesApiresp, mPagesApiresp, cacheData.counts, &[]api.Filter{filter}, cacheData.cacheName, false, etype, api.Timestamp(cacheData.start))
+	generateContainer(ePagesApiresp, iPagesApiresp, mPagesApiresp, cacheData.counts, &[]api.Filter{filter}, cacheData.cacheName, false, etype, realm, api.Timestamp(cacheData.start))
 	rc := createResultCacheBlockForIndex(&cacheData)
 	return false, rc, nil
 }
@@ -384,13 +372,10 @@ func CreateNewCacheV2(etype string, start, end api.Timestamp) (cachegenSkipped b
 */
 
 // readEndpointIndex reads the cache index of the requested endpoint from the local drive. This is then used for finding the end timestamp of the last cache generated.
-func readEndpointIndex(etype string) (api.ApiResponse, error) {
-	protv := globals.BackendConfig.GetProtURLVersion()
-	var cacheDir string
-	if etype == "boards" || etype == "threads" || etype == "posts" || etype == "votes" || etype == "keys" || etype == "truststates" {
-		cacheDir = globals.BackendConfig.GetCachesDirectory() + "/" + protv + "/c0/" + etype
-	} else if etype == "addresses" {
-		cacheDir = globals.BackendConfig.GetCachesDirectory() + "/" + protv + "/" + etype
+func readEndpointIndex(etype string, realm api.Fingerprint) (api.ApiResponse, error) {
+	cacheDir, err := generateEndpointDir(etype, realm)
+	if err != nil {
+		return api.ApiResponse{}, err
 	}
 	cacheIndex := filepath.Join(cacheDir, "index.json")
 	dat, err := ioutil.ReadFile(cacheIndex)
@@ -409,7 +394,7 @@ func readEndpointIndex(etype string) (api.ApiResponse, error) {
 	return apiresp, nil
 }
 
-func saveEndpointIndex(epi api.ApiResponse, etype string) error {
+func saveEndpointIndex(epi api.ApiResponse, etype string, realm api.Fingerprint) error {
 	signingErr := epi.CreateSignature(globals.BackendConfig.GetBackendKeyPair())
 	if signingErr != nil {
 		logging.Logf(1, "saveEndpointIndex could not sign this entity index: failed to be page-signed. Error: %#v Page: %#v\n", signingErr, epi)
@@ -420,7 +405,7 @@ func saveEndpointIndex(epi api.ApiResponse, etype string) error {
 		logging.Logf(1, "saveEndpointIndex could not convert the endpoint index to JSON. Err: %v", err)
 		return errors.New(fmt.Sprintf("saveEndpointIndex could not convert the endpoint index to JSON. Err: %v", err))
 	}
-	endpointDir, err := generateEndpointDir(etype)
+	endpointDir, err := generateEndpointDir(etype, realm)
 	if err != nil {
 		logging.Logf(1, "saveEndpointIndex could not generate the endpoint path. Err: %v", err)
 		return errors.New(fmt.Sprintf("saveEndpointIndex could not generate the endpoint path. Err: %v", err))
@@ -430,8 +415,8 @@ func saveEndpointIndex(epi api.ApiResponse, etype string) error {
 }
 
 // determineLastCacheEnd figures out when was the last cache for this entity type was generated. For each entity, we need to look at the last cache that is generated by the entity and find its end timestamp.
-func determineLastCacheEnd(etype string) api.Timestamp {
-	cacheIndex, err := readEndpointIndex(etype)
+func determineLastCacheEnd(etype string, realm api.Fingerprint) api.Timestamp {
+	cacheIndex, err := readEndpointIndex(etype, realm)
 	if err != nil {
 		// logging.LogCrash(err)
 		// FUTURE: Add tampered caches gating
@@ -517,11 +502,11 @@ func generateRequestedCachesTable(mostRecentExtantCacheEndTs api.Timestamp) []ap
 }
 
 // GenerateCachedEndpoint determines how many caches we will need to create for a given entity type, and generates them. This is a separate function because different endpoint can have different last cache ends.
-func GenerateCachedEndpointV1(etype string) int64 {
+func GenerateCachedEndpointV1(etype string, realm api.Fingerprint) int64 {
 	currentCacheEnd := int64(0)
 	// ^ What are are going to set the last cachegen timestamp
 	// Read the end of the last cache, or if there are none, start from the beginning.
-	lastCacheEndTs := determineLastCacheEnd(etype)
+	lastCacheEndTs := determineLastCacheEnd(etype, realm)
 	// If the lastCacheEndTs is younger than globals.BackendConfig.GetCacheGenerationIntervalHours()-1) hours, we do nothing. The cache generator cycle will attempt to create a cache every hour, so this is where we gate how often we create caches.
 
 	// If last cache end is more than 1 hour ago
@@ -545,7 +530,7 @@ func GenerateCachedEndpointV1(etype string) int64 {
 					If this is the case, this has been grabbed from a prior cache list as a consolidation intact-survivor. We don't generate those caches.
 				*/
 			}
-			empty, err := CreateNewCache(etype, val.StartsFrom, val.EndsAt, allPriorCachesGeneratedSoFarAreEmpty)
+			empty, err := CreateNewCache(etype, realm, val.StartsFrom, val.EndsAt, allPriorCachesGeneratedSoFarAreEmpty)
 			if err != nil {
 				logging.Log(1, err)
 			}
@@ -565,14 +550,14 @@ func GenerateCachedEndpointV1(etype string) int64 {
 =            V2 CACHING FOR EXPONENTIAL GENERATION & CONSOLIDATION            =
 =============================================================================*/
 
-func generateRequestedCachesTableV2(etype string, mostRecentExtantCacheEndTs api.Timestamp) []api.ResultCache {
+func generateRequestedCachesTableV2(etype string, realm api.Fingerprint, mostRecentExtantCacheEndTs api.Timestamp) []api.ResultCache {
 	// Split the difference of most recent cache end and now into 24H slices.
 	now := api.Timestamp(time.Now().Unix())
 	currentEndTs := mostRecentExtantCacheEndTs
 	newtt := NewCacheTimeTable(currentEndTs, now, cacheTimeBlocks)
 	// Get the currently present cache index.
 	extanttt := []api.ResultCache{}
-	cacheIndex, err := readEndpointIndex(etype)
+	cacheIndex, err := readEndpointIndex(etype, realm)
 	if err != nil {
 		logging.Logf(1, "Read cache index errored out we'll regenerate every cache from scratch. Err: %v", err)
 		// todo
@@ -584,12 +569,12 @@ func generateRequestedCachesTableV2(etype string, mostRecentExtantCacheEndTs api
 	return consolidatedtt
 }
 
-func GenerateCachedEndpointV2(etype string) int64 {
-	logging.Logf(1, "GenerateCachedEndpointV2 starting to run. Endpoint: %v", etype)
+func GenerateCachedEndpointV2(etype string, realm api.Fingerprint) int64 {
+	logging.Logf(1, "GenerateCachedEndpointV2 starting to run. Endpoint: %v, Realm: %v", etype, realm)
 	currentCacheEnd := int64(0)
 	// ^ What are are going to set the last cachegen timestamp
 	// Read the end of the last cache, or if there are none, start from the beginning.
-	lastCacheEndTs := determineLastCacheEnd(etype)
+	lastCacheEndTs := determineLastCacheEnd(etype, realm)
 	// If the lastCacheEndTs is younger than globals.BackendConfig.GetCacheGenerationIntervalHours()-1) hours, we do nothing. The cache generator cycle will attempt to create a cache every hour, so this is where we gate how often we create caches.
 	cachegenThreshold := api.Timestamp(
 		time.Now().Add(-time.Duration(globals.BackendConfig.GetCacheGenerationInterval())).Unix())
@@ -599,7 +584,7 @@ func GenerateCachedEndpointV2(etype string) int64 {
 		return currentCacheEnd
 	}
 	// We need to generate some caches.
-	cachesTable := generateRequestedCachesTableV2(etype, lastCacheEndTs)
+	cachesTable := generateRequestedCachesTableV2(etype, realm, lastCacheEndTs)
 	logging.Logf(1, "New caches table: %v", cachesTable)
 	currentCacheEnd = int64(cachesTable[len(cachesTable)-1].EndsAt)
 	// ^ We have caches to generate. The end of our last cache is going to be our last cache generation timestamp.
@@ -615,14 +600,14 @@ func GenerateCachedEndpointV2(etype string) int64 {
 				If this is the case, this has been grabbed from a prior cache list as a consolidation intact-survivor. We don't generate those caches.
 			*/
 		}
-		_, resultCache, err := CreateNewCacheV2(etype, val.StartsFrom, val.EndsAt)
+		_, resultCache, err := CreateNewCacheV2(etype, realm, val.StartsFrom, val.EndsAt)
 		finalCachesTable = append(finalCachesTable, resultCache)
 		if err != nil {
 			logging.Log(1, err)
 		}
 	}
 	// Read or create the endpoint index.
-	epd, err := generateEndpointDir(etype)
+	epd, err := generateEndpointDir(etype, realm)
 	if err != nil {
 		logging.Logf(1, "GenerateCachedEndpoint encountered an error while trying to generate the path for the endpoint. Err: %v", err)
 		return int64(lastCacheEndTs)
@@ -649,7 +634,7 @@ func GenerateCachedEndpointV2(etype string) int64 {
 	// Write the caches table in.
 	endpointIndex.Results = finalCachesTable
 	// Delete too old caches
-	deleteTooOldCaches(etype, &endpointIndex)
+	deleteTooOldCaches(etype, realm, &endpointIndex)
 	// ^ This modifies the endpoint index. that's why it's before signing.
 	// Sign the endpoint index after the changes.
 	signingErr := endpointIndex.CreateSignature(globals.BackendConfig.GetBackendKeyPair())
@@ -658,12 +643,12 @@ func GenerateCachedEndpointV2(etype string) int64 {
 		return int64(lastCacheEndTs)
 	}
 	// Save the endpoint index to the disk.
-	err2 := saveEndpointIndex(endpointIndex, etype)
+	err2 := saveEndpointIndex(endpointIndex, etype, realm)
 	if err2 != nil {
 		logging.Logf(1, "GenerateCachedEndpointV2 could not save the endpoint index to update the []ResultsCache in there. Err: %v", err2)
 		return int64(lastCacheEndTs)
 	}
-	rpath, err := generateEndpointRelativePath(etype)
+	rpath, err := generateEndpointRelativePath(etype, realm)
 	if err != nil {
 		logging.Logf(1, "generateRequestedCachesTableV2 could not generate endpoint relative path for invalidation. Err: %v", err)
 	}
@@ -675,12 +660,12 @@ func GenerateCachedEndpointV2(etype string) int64 {
 
 /*=====  End of V2 CACHING FOR EXPONENTIAL GENERATION & CONSOLIDATION  ======*/
 
-func GenerateCachedEndpoint(etype string) int64 {
+func GenerateCachedEndpoint(etype string, realm api.Fingerprint) int64 {
 	if globals.BackendConfig.GetNetworkHeadDays() > 28 {
 		// If network head is larger than 28 days, we enable the cache consolidation. This reduces the number of individual cache files by consolidating them so that syncs happen faster, but it also costs some CPU, because consolidating caches means caches sometimes need to be regenerated to consolidate.
-		return GenerateCachedEndpointV2(etype)
+		return GenerateCachedEndpointV2(etype, realm)
 	}
-	return GenerateCachedEndpointV1(etype)
+	return GenerateCachedEndpointV1(etype, realm)
 }
 
 // GenerateCaches generates all caches for all entities and saves them to disk.
@@ -692,11 +677,23 @@ func GenerateCaches() {
 	feapiconsumer.SendBackendAmbientStatus()
 	start := time.Now()
 	entityTypes := []string{"boards", "threads", "posts", "votes", "keys", "truststates", "addresses"}
+	if api.SubprotocolServed(api.DirectMessagesSubprotocol, "directmessage") {
+		entityTypes = append(entityTypes, "directmessages")
+	}
 	for _, val := range entityTypes {
-		cachedEndpointEndTs := GenerateCachedEndpoint(val)
-		if cachedEndpointEndTs < oldestCacheEnd {
-			oldestCacheEnd = cachedEndpointEndTs
+		realms := api.ServedRealms()
+		if val == "addresses" {
+			// Addresses are not bound to a realm, they only live in the public caches.
+			realms = []api.Fingerprint{api.PublicRealm}
+		}
+		entityStart := time.Now()
+		for _, realm := range realms {
+			cachedEndpointEndTs := GenerateCachedEndpoint(val, realm)
+			if cachedEndpointEndTs < oldestCacheEnd {
+				oldestCacheEnd = cachedEndpointEndTs
+			}
 		}
+		cacheStats.entityGenerated(val, time.Since(entityStart))
 	}
 	// /*================================
 	// =            debugger            =
@@ -727,6 +724,7 @@ func GenerateCaches() {
 	globals.BackendConfig.SetLastCacheGenerationTimestamp(oldestCacheEnd)
 	elapsed := time.Since(start)
 	logging.Logf(1, "Cache generation is complete. It took: %s", elapsed)
+	cacheStats.generated(elapsed)
 	feapiconsumer.BackendAmbientStatus.CachingStatus = "Idle"
 	feapiconsumer.BackendAmbientStatus.LastCacheGenerationTimestamp = globals.BackendConfig.GetLastCacheGenerationTimestamp()
 	feapiconsumer.BackendAmbientStatus.LastCacheGenerationDurationSeconds = int32(elapsed.Seconds())
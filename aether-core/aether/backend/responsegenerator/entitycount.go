@@ -71,6 +71,14 @@ func countEntities(r *api.Response) *[]api.EntityCount {
 		}
 		ecs = append(ecs, ec)
 	}
+	if len(r.DirectMessages) > 0 {
+		ec := api.EntityCount{
+			Protocol: "dm0",
+			Name:     "directmessage",
+			Count:    len(r.DirectMessages),
+		}
+		ecs = append(ecs, ec)
+	}
 	if len(r.Addresses) > 0 {
 		ec := api.EntityCount{
 			Protocol: "c0",
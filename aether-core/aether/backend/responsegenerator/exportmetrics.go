@@ -0,0 +1,51 @@
+// Backend > ResponseGenerator > ExportMetrics
+// This file keeps the cache generation timings for the metrics exporter, and writes them out at scrape time.
+
+package responsegenerator
+
+import (
+	"aether-core/aether/services/metricsexport"
+	"sync"
+	"time"
+)
+
+var cacheStats = cacheTotals{entityLastDurationSeconds: make(map[string]float64)}
+
+type cacheTotals struct {
+	lock                      sync.Mutex
+	runs                      int64
+	durationSecondsSum        float64
+	lastDurationSeconds       float64
+	entityLastDurationSeconds map[string]float64
+	entityOrder               []string
+}
+
+// generated counts a completed cache generation run.
+func (ct *cacheTotals) generated(dur time.Duration) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+	ct.runs++
+	ct.durationSecondsSum += dur.Seconds()
+	ct.lastDurationSeconds = dur.Seconds()
+}
+
+// entityGenerated records how long the caches of an entity type took in the last run, across all served realms.
+func (ct *cacheTotals) entityGenerated(entityType string, dur time.Duration) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+	if _, exists := ct.entityLastDurationSeconds[entityType]; !exists {
+		ct.entityOrder = append(ct.entityOrder, entityType)
+	}
+	ct.entityLastDurationSeconds[entityType] = dur.Seconds()
+}
+
+// ExportMetrics writes the cache generation metrics for the metrics exporter.
+func ExportMetrics(w *metricsexport.Writer) {
+	cacheStats.lock.Lock()
+	defer cacheStats.lock.Unlock()
+	w.Summary("aether_cache_generation_duration_seconds", "Duration of the cache generation runs.", cacheStats.durationSecondsSum, cacheStats.runs)
+	w.Gauge("aether_cache_generation_last_duration_seconds", "Duration of the last cache generation run.", cacheStats.lastDurationSeconds)
+	for _, key := range cacheStats.entityOrder {
+		w.Gauge("aether_cache_generation_last_entity_duration_seconds", "Duration of the cache generation of an entity type in the last run, by entity type.", cacheStats.entityLastDurationSeconds[key], metricsexport.L("type", key))
+	}
+}
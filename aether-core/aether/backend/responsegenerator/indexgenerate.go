@@ -87,6 +87,17 @@ func createTruststateIndex(entity *api.Truststate, pageNum int) api.TruststateIn
 	return entityIndex
 }
 
+func createDirectMessageIndex(entity *api.DirectMessage, pageNum int) api.DirectMessageIndex {
+	var entityIndex api.DirectMessageIndex
+	entityIndex.Recipient = entity.Recipient
+	entityIndex.Creation = entity.Creation
+	entityIndex.Fingerprint = entity.GetFingerprint()
+	entityIndex.LastUpdate = entity.LastUpdate
+	entityIndex.PageNumber = pageNum
+	entityIndex.EntityVersion = entity.EntityVersion
+	return entityIndex
+}
+
 // createUnbakedIndexes creates the index variant of every entity in an api.Response, and puts it back inside one single container for all indexes.
 func createUnbakedIndexes(fullData *[]api.Response) *api.Response {
 	fd := *fullData
@@ -132,12 +143,18 @@ func createUnbakedIndexes(fullData *[]api.Response) *api.Response {
 					resp.TruststateIndexes = append(resp.TruststateIndexes, entityIndex)
 				}
 			}
+			if len(fd[i].DirectMessages) > 0 {
+				for j, _ := range fd[i].DirectMessages {
+					entityIndex := createDirectMessageIndex(&fd[i].DirectMessages[j], i)
+					resp.DirectMessageIndexes = append(resp.DirectMessageIndexes, entityIndex)
+				}
+			}
 		}
 	}
 	return &resp
 }
 
-func bakeIndexes(indexPages *[]api.ApiResponse, entityCounts *[]api.EntityCount, filters *[]api.Filter, foldername string, isPOST bool, respType string, entityType string) {
+func bakeIndexes(indexPages *[]api.ApiResponse, entityCounts *[]api.EntityCount, filters *[]api.Filter, foldername string, isPOST bool, respType string, realm api.Fingerprint, entityType string) {
 	// Create directory
 	if respType == "addresses" {
 		return // addresses do not generate indexes.
@@ -147,11 +164,7 @@ func bakeIndexes(indexPages *[]api.ApiResponse, entityCounts *[]api.EntityCount,
 	if isPOST {
 		indexdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/responses/", foldername, "/index")
 	} else {
-		if respType == "addresses" {
-			indexdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/", respType, "/", foldername, "/index")
-		} else {
-			indexdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/c0/", respType, "/", foldername, "/index")
-		}
+		indexdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", cacheTypeDir(respType, realm), "/", foldername, "/index")
 	}
 	toolbox.CreatePath(indexdir)
 	for key, val := range *indexPages {
@@ -23,17 +23,13 @@ import (
 	"time"
 )
 
-func bakeEntityPages(resultPages *[]api.ApiResponse, entityCounts *[]api.EntityCount, filters *[]api.Filter, foldername string, isPOST bool, respType string, entityType string) {
+func bakeEntityPages(resultPages *[]api.ApiResponse, entityCounts *[]api.EntityCount, filters *[]api.Filter, foldername string, isPOST bool, respType string, realm api.Fingerprint, entityType string) {
 	protv := globals.BackendConfig.GetProtURLVersion()
 	var responsedir string
 	if isPOST {
 		responsedir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/responses/", foldername)
 	} else {
-		if respType == "addresses" {
-			responsedir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/", respType, "/", foldername)
-		} else {
-			responsedir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/c0/", respType, "/", foldername)
-		}
+		responsedir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", cacheTypeDir(respType, realm), "/", foldername)
 	}
 	// responsedir := fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/",protv,"/responses/", foldername)
 	toolbox.CreatePath(responsedir)
@@ -37,13 +37,14 @@ func createUnbakedManifestItem(entity api.Provable, pageNo uint64) unbakedManife
 }
 
 type unbakedManifestCarrier struct {
-	BoardManifests      []unbakedManifestItem
-	ThreadManifests     []unbakedManifestItem
-	PostManifests       []unbakedManifestItem
-	VoteManifests       []unbakedManifestItem
-	KeyManifests        []unbakedManifestItem
-	TruststateManifests []unbakedManifestItem
-	AddressManifests    []unbakedManifestItem
+	BoardManifests         []unbakedManifestItem
+	ThreadManifests        []unbakedManifestItem
+	PostManifests          []unbakedManifestItem
+	VoteManifests          []unbakedManifestItem
+	KeyManifests           []unbakedManifestItem
+	TruststateManifests    []unbakedManifestItem
+	DirectMessageManifests []unbakedManifestItem
+	AddressManifests       []unbakedManifestItem
 }
 
 // pgNoExistsInSlice looks whether a certain page number was ever created in this manifest slice. This isn't super efficient, but also not a hot path. Page numbers rarely go above 1000.
@@ -104,6 +105,9 @@ func createUnbakedManifests(fullData *[]api.Response) *unbakedManifestCarrier {
 		for j, _ := range (*fullData)[i].Truststates {
 			umc.TruststateManifests = append(umc.TruststateManifests, createUnbakedManifestItem(&(*fullData)[i].Truststates[j], uint64(i)))
 		}
+		for j, _ := range (*fullData)[i].DirectMessages {
+			umc.DirectMessageManifests = append(umc.DirectMessageManifests, createUnbakedManifestItem(&(*fullData)[i].DirectMessages[j], uint64(i)))
+		}
 		// for j, _ := range (*fullData)[i].Addresses {
 		//  umc.AddressManifests = append(umc.AddressManifests, createUnbakedManifestItem(&(*fullData)[i].Addresses[j], uint64(i)))
 		// }
@@ -111,7 +115,7 @@ func createUnbakedManifests(fullData *[]api.Response) *unbakedManifestCarrier {
 	return &umc
 }
 
-func bakeManifests(manifestPages *[]api.ApiResponse, entityCounts *[]api.EntityCount, filters *[]api.Filter, foldername string, isPOST bool, respType string, entityType string) {
+func bakeManifests(manifestPages *[]api.ApiResponse, entityCounts *[]api.EntityCount, filters *[]api.Filter, foldername string, isPOST bool, respType string, realm api.Fingerprint, entityType string) {
 	if respType == "addresses" {
 		return // addresses do not generate manifests.
 	}
@@ -121,11 +125,7 @@ func bakeManifests(manifestPages *[]api.ApiResponse, entityCounts *[]api.EntityC
 	if isPOST {
 		manifestdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/responses/", foldername, "/manifest")
 	} else {
-		if respType == "addresses" {
-			manifestdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/", respType, "/", foldername, "/manifest")
-		} else {
-			manifestdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", protv, "/c0/", respType, "/", foldername, "/manifest")
-		}
+		manifestdir = fmt.Sprint(globals.BackendConfig.GetCachesDirectory(), "/", cacheTypeDir(respType, realm), "/", foldername, "/manifest")
 	}
 	toolbox.CreatePath(manifestdir)
 	for key, val := range *manifestPages {
@@ -115,7 +115,7 @@ func bakeFinalPOSTApiResponse(
 ) (*api.ApiResponse, error) {
 	if len(*resultPages) > 1 {
 		logging.Logf(2, "This result is still more than one page after the chain addition. We are generating the container %s", dirname)
-		generateContainer(resultPages, indexPages, manifestPages, entityCounts, filters, dirname, true, "", dbReadStartLoc) // this will save to disk, doesn't return anything.
+		generateContainer(resultPages, indexPages, manifestPages, entityCounts, filters, dirname, true, "", api.PublicRealm, dbReadStartLoc) // this will save to disk, doesn't return anything.
 		// Generate container needs to generate its own entity
 		resp := generatePostFaceResponse(resultPages, mergedEntityCounts, filters, dirname, reusedPostResponses)
 		return resp, nil
@@ -159,6 +159,19 @@ func GeneratePOSTResponse(respType string, req api.ApiResponse) ([]byte, error)
 	filter := reconstructFilters(filterset)
 	logging.Logf(3, "Filters reconstructed: %#v", filter)
 	filters := []api.Filter{filter}
+	if len(filterset.Realms) > 0 {
+		// Recording the realm filter also keeps this response out of the reuse tracker, which only takes pure time range responses.
+		rf := api.Filter{Type: "realm"}
+		for _, realm := range filterset.Realms {
+			rf.Values = append(rf.Values, string(realm))
+		}
+		filters = append(filters, rf)
+	}
+	subscribed := filterset.Subs.Partial() && api.SubscriptionBound(respType)
+	if subscribed {
+		// Same as the realm filter, recording these keeps the response out of the reuse tracker.
+		filters = append(filters, filterset.Subs.Filters()...)
+	}
 	// Create a random SHA256 hash as folder name to use in the case the response has more than one page.
 	dirname, err := randomhashgen.GenerateInsecureRandomHash()
 	if err != nil {
@@ -171,11 +184,19 @@ func GeneratePOSTResponse(respType string, req api.ApiResponse) ([]byte, error)
 		// resp = *r
 		resp.Endpoint = "node"
 		resp.Entity = "node"
-	case "boards", "threads", "posts", "votes", "keys", "truststates":
+	case "boards", "threads", "posts", "votes", "keys", "truststates", "directmessages":
 		// Check our post response repo to check if there are any suitable post responses that we can reuse.
 		start := configstore.Timestamp(filterset.TimeStart)
 		end := configstore.Timestamp(filterset.TimeEnd)
 		chain, _, chainEnd, chainCount := globals.BackendTransientConfig.POSTResponseRepo.GetPostResponseChain(start, end, respType)
+		if len(filterset.Realms) > 0 {
+			// Reusable responses only carry the public realm, so a request that asks for more realms has to be read from the database in full.
+			chain, chainEnd, chainCount = &[]configstore.POSTResponseEntry{}, 0, configstore.EntityCount{}
+		}
+		if subscribed {
+			// Reusable responses carry everything, a remote that asks only for its subscriptions gets its own.
+			chain, chainEnd, chainCount = &[]configstore.POSTResponseEntry{}, 0, configstore.EntityCount{}
+		}
 		dbReadStartLoc := api.Timestamp(0)
 		if len(*chain) == 0 {
 			dbReadStartLoc = filterset.TimeStart
@@ -187,11 +208,15 @@ func GeneratePOSTResponse(respType string, req api.ApiResponse) ([]byte, error)
 		// test end
 		logging.Logf(2, "Chain: %#v, Start: %v, End: %v Chain Count: %#v Time: %s", chain, start, chainEnd, chainCount, time.Now())
 		logging.Logf(2, "These are the values being fed to the persistence.Read. RespType: %s, filterset.Fingerprints: %v, filterset.Embeds: %v, dbReadStartLoc: %v, filterset.TimeEnd: %v", respType, filterset.Fingerprints, filterset.Embeds, dbReadStartLoc, filterset.TimeEnd)
-		localData, dbError := persistence.Read(respType, filterset.Fingerprints, filterset.Embeds, dbReadStartLoc, filterset.TimeEnd, false, nil)
+		// Remotes that do not ask for any realms get the public realm only.
+		opts := persistence.NewOptionalReadInputs()
+		opts.Realms = append([]api.Fingerprint{api.PublicRealm}, filterset.Realms...)
+		localData, dbError := persistence.Read(respType, filterset.Fingerprints, filterset.Embeds, dbReadStartLoc, filterset.TimeEnd, false, opts)
 
 		if dbError != nil {
 			return []byte{}, errors.New(fmt.Sprintf("The query coming from the remote caused an error in the local database while trying to respond to this request. Error: %#v\n, Request: %#v\n", dbError, req))
 		}
+		localData.FilterSubscriptions(filterset.Subs)
 		// Generate main data & count the entities resulting. This will go to all three of the response entity pages themselves, the index and the manifest pages.
 		pages := splitEntitiesToPages(&localData)
 		pagesAsApiResponses := convertResponsesToApiResponses(pages)
@@ -18,6 +18,7 @@ import (
 	// "github.com/davecgh/go-spew/spew"
 	"io/ioutil"
 	// "os"
+	"path/filepath"
 	"strconv"
 	// "strings"
 	"time"
@@ -28,6 +29,8 @@ type FilterSet struct {
 	TimeStart    api.Timestamp
 	TimeEnd      api.Timestamp
 	Embeds       []string
+	Realms       []api.Fingerprint // Non-public realms requested, limited to ones we serve.
+	Subs         api.Subscriptions // The boards and the owners of a remote that syncs only a part of the network.
 }
 
 func processFilters(req *api.ApiResponse) FilterSet {
@@ -45,6 +48,26 @@ func processFilters(req *api.ApiResponse) FilterSet {
 				fs.Embeds = append(fs.Embeds, embed)
 			}
 		}
+		// Realms. The public realm is always included, so we only keep the non-public realms that we actually carry. Knowing the RealmId is all a remote needs to read a realm, there's no other check. (See the realms in io/api/apistructs.go.)
+		if filter.Type == "realm" {
+			for _, realm := range filter.Values {
+				r := api.Fingerprint(realm)
+				if r != api.PublicRealm && api.RealmServed(r) {
+					fs.Realms = append(fs.Realms, r)
+				}
+			}
+		}
+		// Subscriptions. A board or an owner filter widens the other, see io/api/subscriptions.go.
+		if filter.Type == "board" {
+			for _, fp := range filter.Values {
+				fs.Subs.Boards = append(fs.Subs.Boards, api.Fingerprint(fp))
+			}
+		}
+		if filter.Type == "owner" {
+			for _, fp := range filter.Values {
+				fs.Subs.Users = append(fs.Subs.Users, api.Fingerprint(fp))
+			}
+		}
 		// If a time filter is given, timeStart is either the timestamp provided by the remote if it's larger than the end date of the last cache, or the end timestamp of the last cache.
 		// In essence, we do not provide anything that is already cached from the live server.
 		if filter.Type == "timestamp" {
@@ -88,6 +111,7 @@ func convertResponsesToApiResponses(r *[]api.Response) *[]api.ApiResponse {
 		resp.ResponseBody.Addresses = (*r)[i].Addresses
 		resp.ResponseBody.Keys = (*r)[i].Keys
 		resp.ResponseBody.Truststates = (*r)[i].Truststates
+		resp.ResponseBody.DirectMessages = (*r)[i].DirectMessages
 		// Indexes
 		resp.ResponseBody.BoardIndexes = (*r)[i].BoardIndexes
 		resp.ResponseBody.ThreadIndexes = (*r)[i].ThreadIndexes
@@ -96,6 +120,7 @@ func convertResponsesToApiResponses(r *[]api.Response) *[]api.ApiResponse {
 		resp.ResponseBody.AddressIndexes = (*r)[i].AddressIndexes
 		resp.ResponseBody.KeyIndexes = (*r)[i].KeyIndexes
 		resp.ResponseBody.TruststateIndexes = (*r)[i].TruststateIndexes
+		resp.ResponseBody.DirectMessageIndexes = (*r)[i].DirectMessageIndexes
 		// Manifests
 		resp.ResponseBody.BoardManifests = (*r)[i].BoardManifests
 		resp.ResponseBody.ThreadManifests = (*r)[i].ThreadManifests
@@ -104,6 +129,7 @@ func convertResponsesToApiResponses(r *[]api.Response) *[]api.ApiResponse {
 		resp.ResponseBody.AddressManifests = (*r)[i].AddressManifests
 		resp.ResponseBody.KeyManifests = (*r)[i].KeyManifests
 		resp.ResponseBody.TruststateManifests = (*r)[i].TruststateManifests
+		resp.ResponseBody.DirectMessageManifests = (*r)[i].DirectMessageManifests
 
 		resp.Pagination.Pages = uint64(len(*r) - 1) // pagination starts from 0
 		resp.Pagination.CurrentPage = uint64(i)
@@ -137,6 +163,9 @@ func findEntityTypeInApiResponse(resp api.ApiResponse, respType string) string {
 	if len(resp.ResponseBody.Truststates) > 0 || len(resp.ResponseBody.TruststateIndexes) > 0 || len(resp.ResponseBody.TruststateManifests) > 0 {
 		return "truststates"
 	}
+	if len(resp.ResponseBody.DirectMessages) > 0 || len(resp.ResponseBody.DirectMessageIndexes) > 0 || len(resp.ResponseBody.DirectMessageManifests) > 0 {
+		return "directmessages"
+	}
 	return respType
 }
 
@@ -204,6 +233,7 @@ func generateContainer(
 	dirname string,
 	isPOST bool,
 	respType string,
+	realm api.Fingerprint,
 	dbReadStartLoc api.Timestamp,
 ) {
 	foldername := ""
@@ -228,13 +258,25 @@ func generateContainer(
 	// fmt.Println(entityType)
 	// Create the index and manifest pages.
 	if indexPages != nil {
-		bakeIndexes(indexPages, entityCounts, &flt, foldername, isPOST, respType, entityType)
+		bakeIndexes(indexPages, entityCounts, &flt, foldername, isPOST, respType, realm, entityType)
 	}
 	if manifestPages != nil {
-		bakeManifests(manifestPages, entityCounts, &flt, foldername, isPOST, respType, entityType)
+		bakeManifests(manifestPages, entityCounts, &flt, foldername, isPOST, respType, realm, entityType)
 	}
 	// Bake the main entity pages.
-	bakeEntityPages(entityPages, entityCounts, &flt, foldername, isPOST, respType, entityType)
+	bakeEntityPages(entityPages, entityCounts, &flt, foldername, isPOST, respType, realm, entityType)
+}
+
+// cacheTypeDir returns the directory, relative to the caches directory, that holds the GET caches of the given type in the given realm. Public realm caches live at their usual place, so that nodes unaware of realms can keep reading them.
+func cacheTypeDir(respType string, realm api.Fingerprint) string {
+	protv := globals.BackendConfig.GetProtURLVersion()
+	if respType == "addresses" {
+		return filepath.Join(protv, respType)
+	}
+	if respType == "directmessages" {
+		return filepath.Join(protv, api.RealmPath(realm), api.DirectMessagesSubprotocol, respType)
+	}
+	return filepath.Join(protv, api.RealmPath(realm), "c0", respType)
 }
 
 func constructResultCache(beg api.Timestamp, end api.Timestamp, url string) api.ResultCache {
@@ -65,6 +65,9 @@ func splitManifestToPages(fullData *unbakedManifestCarrier) *[]api.Response {
 	if len(fullData.TruststateManifests) > 0 {
 		entityTypes = append(entityTypes, "truststatemanifests")
 	}
+	if len(fullData.DirectMessageManifests) > 0 {
+		entityTypes = append(entityTypes, "directmessagemanifests")
+	}
 	if len(fullData.AddressManifests) > 0 {
 		entityTypes = append(entityTypes, "addressmanifests")
 	}
@@ -74,6 +77,7 @@ func splitManifestToPages(fullData *unbakedManifestCarrier) *[]api.Response {
 		len(fullData.VoteManifests) == 0 &&
 		len(fullData.KeyManifests) == 0 &&
 		len(fullData.TruststateManifests) == 0 &&
+		len(fullData.DirectMessageManifests) == 0 &&
 		len(fullData.AddressManifests) == 0 {
 		entityTypes = append(entityTypes, "blankpage")
 		// Why? because we still want to generate a blank manifest page if there is nothing inside, to communicate that this cache is empty.
@@ -199,6 +203,26 @@ func splitManifestToPages(fullData *unbakedManifestCarrier) *[]api.Response {
 				pages = append(pages, page)
 			}
 		}
+		if entityTypes[i] == "directmessagemanifests" {
+			dataSet := fullData.DirectMessageManifests
+			pageSize := globals.BackendConfig.GetEntityPageSizes().DirectMessageManifests
+			numPages := len(dataSet)/pageSize + 1
+			// The division above is floored.
+			for i := 0; i < numPages; i++ {
+				beg := i * pageSize
+				var end int
+				// This is to protect from 'slice bounds out of range'
+				if (i+1)*pageSize > len(dataSet) {
+					end = len(dataSet)
+				} else {
+					end = (i + 1) * pageSize
+				}
+				pageData := dataSet[beg:end]
+				var page api.Response
+				page.DirectMessageManifests = *constructManifestStructure(&pageData)
+				pages = append(pages, page)
+			}
+		}
 		if entityTypes[i] == "addressmanifests" {
 			dataSet := fullData.AddressManifests
 			pageSize := globals.BackendConfig.GetEntityPageSizes().AddressManifests
@@ -252,6 +276,9 @@ func splitEntitiesToPages(fullData *api.Response) *[]api.Response {
 	if len(fullData.Truststates) > 0 {
 		entityTypes = append(entityTypes, "truststates")
 	}
+	if len(fullData.DirectMessages) > 0 {
+		entityTypes = append(entityTypes, "directmessages")
+	}
 	// Indexes
 	if len(fullData.BoardIndexes) > 0 {
 		entityTypes = append(entityTypes, "boardindexes")
@@ -271,6 +298,9 @@ func splitEntitiesToPages(fullData *api.Response) *[]api.Response {
 	if len(fullData.TruststateIndexes) > 0 {
 		entityTypes = append(entityTypes, "truststateindexes")
 	}
+	if len(fullData.DirectMessageIndexes) > 0 {
+		entityTypes = append(entityTypes, "directmessageindexes")
+	}
 	if len(fullData.AddressIndexes) > 0 {
 		entityTypes = append(entityTypes, "addressindexes")
 	}
@@ -418,6 +448,26 @@ func splitEntitiesToPages(fullData *api.Response) *[]api.Response {
 				pages = append(pages, page)
 			}
 		}
+		if entityTypes[i] == "directmessages" {
+			dataSet := fullData.DirectMessages
+			pageSize := globals.BackendConfig.GetEntityPageSizes().DirectMessages
+			numPages := len(dataSet)/pageSize + 1
+			// The division above is floored.
+			for i := 0; i < numPages; i++ {
+				beg := i * pageSize
+				var end int
+				// This is to protect from 'slice bounds out of range'
+				if (i+1)*pageSize > len(dataSet) {
+					end = len(dataSet)
+				} else {
+					end = (i + 1) * pageSize
+				}
+				pageData := dataSet[beg:end]
+				var page api.Response
+				page.DirectMessages = pageData
+				pages = append(pages, page)
+			}
+		}
 		// Index entities
 		if entityTypes[i] == "boardindexes" {
 			dataSet := fullData.BoardIndexes
@@ -559,6 +609,26 @@ func splitEntitiesToPages(fullData *api.Response) *[]api.Response {
 				pages = append(pages, page)
 			}
 		}
+		if entityTypes[i] == "directmessageindexes" {
+			dataSet := fullData.DirectMessageIndexes
+			pageSize := globals.BackendConfig.GetEntityPageSizes().DirectMessageIndexes
+			numPages := len(dataSet)/pageSize + 1
+			// The division above is floored.
+			for i := 0; i < numPages; i++ {
+				beg := i * pageSize
+				var end int
+				// This is to protect from 'slice bounds out of range'
+				if (i+1)*pageSize > len(dataSet) {
+					end = len(dataSet)
+				} else {
+					end = (i + 1) * pageSize
+				}
+				pageData := dataSet[beg:end]
+				var page api.Response
+				page.DirectMessageIndexes = pageData
+				pages = append(pages, page)
+			}
+		}
 	}
 	if len(entityTypes) == 0 {
 		// The result is empty
@@ -13,6 +13,7 @@ import (
 	"aether-core/aether/services/logging"
 	// "aether-core/aether/services/tcpmim"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,6 +34,10 @@ import (
 )
 
 func isReverseConn(host string, port uint16) bool {
+	if len(globals.BackendTransientConfig.ReverseConnData.C1LocalLocalAddr) == 0 && globals.BackendTransientConfig.ReverseConnData.C1LocalLocalPort == 0 {
+		// No reverse open has set up its connection yet. The connections with no address of their own (such as a Unix socket's) would match otherwise.
+		return false
+	}
 	return host == globals.BackendTransientConfig.ReverseConnData.C1LocalLocalAddr && port == globals.BackendTransientConfig.ReverseConnData.C1LocalLocalPort
 }
 
@@ -424,6 +429,18 @@ func StartMimServer() {
 					w.Write(resp)
 				}
 
+			case "/" + protv + "/" + api.DirectMessagesSubprotocol + "/directmessages", "/" + protv + "/" + api.DirectMessagesSubprotocol + "/directmessages/":
+				resp, err := DirectMessagesPOST(r)
+				if err != nil {
+					logging.Log(1, err)
+				}
+				if len(resp) == 0 {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte{})
+				} else {
+					w.Write(resp)
+				}
+
 			case "/" + protv + "/addresses", "/" + protv + "/addresses/":
 				resp, err := AddressesPOST(r)
 				if err != nil {
@@ -474,6 +491,14 @@ func StartMimServer() {
 	}
 	protocol := "tcp4"
 	addr := fmt.Sprint(":", port)
+	tr, err := transport.ForType(globals.BackendConfig.GetExternalIpType())
+	if err != nil {
+		logging.LogCrash(err)
+	}
+	if !transport.IsTCP(globals.BackendConfig.GetExternalIpType()) {
+		protocol = "transport"
+		addr = fmt.Sprint(globals.BackendConfig.GetExternalIp(), " port ", port)
+	}
 	// srv.SetKeepAlivesEnabled(true)
 	if globals.BackendTransientConfig.TLSEnabled {
 		certLoc := filepath.Join(globals.BackendConfig.GetUserDirectory(), "backend", "tls", "cert.pem")
@@ -489,7 +514,7 @@ func StartMimServer() {
 		}
 		srv.TLSConfig = tlsConfig
 		// HSTS header is not set because node IP addresses are dynamic, and us setting HSTS for an address might mean the next user of that IP address might end up having trouble getting people to connect to it through non-TLS.
-		l, err := net.Listen(protocol, addr)
+		l, err := tr.Listen(globals.BackendConfig.GetExternalIp(), port)
 		if err != nil {
 			logging.LogCrash(err)
 		}
@@ -501,7 +526,7 @@ func StartMimServer() {
 			logging.LogCrash(fmt.Sprintf("Server encountered a fatal error. (Heads up, server also exits with error even when it quits normally) Error: %s", srvErr))
 		}
 	} else {
-		l, err := net.Listen(protocol, addr)
+		l, err := tr.Listen(globals.BackendConfig.GetExternalIp(), port)
 		if err != nil {
 			logging.LogCrash(err)
 		}
@@ -535,6 +560,17 @@ func insertLocallySourcedRemoteAddressDetails(r *http.Request, req *api.ApiRespo
 	// LITTLE-TRUSTED ADDRESS ENTRY
 	// Data to keep: Location, Sublocation, Port, LastSuccessfulPing (sublocation is guaranteed to be empty since the connection is coming from an IP, not a static IP)
 	// Delete everything else, they're untrustable.
+	if lt := globals.BackendConfig.GetExternalIpType(); !transport.IsTCP(lt) {
+		// Connections over the other transports don't come from an IP, so the location the remote declares is all we have. It has to be a location of our own transport, since that is the only one the remote could have reached us through.
+		if transport.LocationTypeOf(string(req.Address.Location)) != lt {
+			return errors.New(fmt.Sprintf("The location the remote declares is not one of the transport this node listens on. Location: %s, Location type: %v", req.Address.Location, lt))
+		}
+		req.Address.LocationType = lt
+		req.Address.Sublocation = ""
+		req.Address.LastSuccessfulPing = api.Timestamp(time.Now().Unix())
+		req.Address.Type = 2
+		return nil
+	}
 	host, _, err := net.SplitHostPort(r.RemoteAddr)
 	if globals.BackendConfig.GetExternalVerifyEnabled() {
 		host = extverify.Verifier.GetRemoteIP(r.Header)
@@ -756,6 +792,30 @@ func TruststatesPOST(r *http.Request) ([]byte, error) {
 	return respAsByte, nil
 }
 
+// DirectMessagesPOST serves direct messages only to the remotes that also carry them. A node that doesn't serve dm0 has nothing to give, and one that asks without declaring it would not know what to do with them.
+func DirectMessagesPOST(r *http.Request) ([]byte, error) {
+	req, err := ParsePOSTRequest(r)
+	if err != nil {
+		logging.Log(1, fmt.Sprintf("DirectMessagesPOST POST request parsing failed. Error: %#v\n, Request Header: %#v\n, Request Body: %#v\n", err, r.Header, req))
+		return []byte{}, nil
+	}
+	if !api.SubprotocolShared(req.Address.Protocol, api.DirectMessagesSubprotocol, "directmessage") {
+		return []byte{}, errors.New(fmt.Sprintf("A remote asked for direct messages, but we don't share the %s subprotocol with it. Remote: %v", api.DirectMessagesSubprotocol, r.RemoteAddr))
+	}
+	err2 := SaveRemote(req)
+	if err2 != nil {
+		return []byte{}, err2
+	}
+	respAsByte, err3 := responsegenerator.GeneratePOSTResponse("directmessages", req)
+	if err3 != nil {
+		return respAsByte, err3
+	}
+	if r != nil {
+		r.Body.Close()
+	}
+	return respAsByte, nil
+}
+
 func AddressesPOST(r *http.Request) ([]byte, error) {
 	req, err := ParsePOSTRequest(r)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/tcpmim"
+	"aether-core/aether/services/transport"
 	"bufio"
 	"errors"
 	"fmt"
@@ -51,7 +52,7 @@ func (t *TCPMimServer) Serve() {
 		t.Config = &cfg
 	}
 	addr := t.Config.Host + ":" + strconv.Itoa(int(t.Config.Port))
-	l, err := net.Listen(t.Config.Network, addr)
+	l, err := t.listen()
 	if err != nil {
 		logging.Logf(0, "TCPMimServer: Listener had an error and is exiting. Err: %v", err)
 		return
@@ -76,6 +77,20 @@ func (t *TCPMimServer) Serve() {
 	}
 }
 
+// listen opens the listener of the server. A node on a transport other than TCP takes the reverse opens over that transport, at its own location.
+func (t *TCPMimServer) listen() (net.Listener, error) {
+	lt := globals.BackendConfig.GetExternalIpType()
+	if transport.IsTCP(lt) {
+		return net.Listen(t.Config.Network, t.Config.Host+":"+strconv.Itoa(int(t.Config.Port)))
+	}
+	tr, err := transport.ForType(lt)
+	if err != nil {
+		return nil, err
+	}
+	t.Config.Network = "transport"
+	return tr.Listen(globals.BackendConfig.GetExternalIp(), t.Config.Port)
+}
+
 /*
 MaybeStartSync checks whether we have a slot allowed in our outbound gate. If so, this will claim a slot (lease), and it will start the sync. This requesting outbound lease logic used here also happens in the sync itself. This is fine, because requesting a lease, if one is present, is idempotent. Likewise, returning a lease is idempotent if the lease has already been returned.
 
@@ -15,11 +15,13 @@ package main
 
 import (
 	// pb "aether-core/aether/backend/metrics/proto"
+	"aether-core/aether/backend/swarmtest/scenario"
 	sms "aether-core/aether/backend/swarmtest/simplemetricsserver"
 	"aether-core/aether/services/ports"
 	"aether-core/aether/services/toolbox"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	// "github.com/davecgh/go-spew/spew"
 	"io/ioutil"
@@ -38,13 +40,15 @@ func saveFileToDisk(fileContents []byte, path string, filename string) {
 	ioutil.WriteFile(fmt.Sprint(path, "/", filename), fileContents, 0755)
 }
 
-// We could do proper flag parsing but it feels unnecessary here, tbh. If this test grows in size we could probably do that.
+// We could do proper flag parsing but it feels unnecessary here, tbh. If this test grows in size we could probably do that. The only flag is the scenario, since the pipelines that run the scenarios need to be able to pick one.
 type settingsStruct struct {
 	swarmsize       int // number of nodes to be created and tested against.
 	testdurationsec int // how many seconds the main test will run for. This does not include the time it takes to prime the swarm nodes from the donor nodes.
 	staticnodeloc   string
 	swarmplanloc    string
 	dbsize          string
+	scenarioloc     string // If given, the swarm runs this scenario instead of the swarm plan. The swarm size and the duration come from the scenario.
+	scenariodir     string // The folder the resolved scenario, the node results and the report are saved into. Every run gets its own.
 }
 
 type node struct {
@@ -69,6 +73,8 @@ func setDefaults() {
 		log.Fatal(fmt.Sprintf("Converting the swarm plan to an absolute file path has failed. Error: %s", err))
 	}
 	settings.swarmplanloc = spl
+	flag.StringVar(&settings.scenarioloc, "scenario", "", "The swarm scenario to run. If not given, the swarm runs the swarm plan generated in main.")
+	flag.Parse()
 }
 
 // generateSwarmNames generates the appIdentifier names the swarm nodes will use. This is the main thing that allows swarm nodes to occupy different folders in terms of their db, settings.
@@ -77,7 +83,7 @@ func generateSwarmNames() []node {
 	swarmPorts := ports.GetFreePorts(settings.swarmsize)
 	for i := 0; i < settings.swarmsize; i++ {
 		n := node{}
-		n.appname = fmt.Sprintf("Aether-%d", i)
+		n.appname = scenario.NodeName(i)
 		n.staticServerPort = 17000 + i
 		n.externalPort = swarmPorts[i]
 		nodes = append(nodes, n)
@@ -382,12 +388,160 @@ func startSwarmNodes(nodes []node, killTimeout int) {
 	fmt.Println("All swarm nodes have exited per their kill timeouts.")
 }
 
+/*----------  Scenarios  ----------*/
+
+/*
+In the scenario mode, the coordinator writes a copy of the scenario with the ports of the nodes filled in, and gives that to the nodes. The nodes run their own steps, and save their results into the scenario folder. When a node is killed by the scenario, the coordinator starts it again after the restartAfter of the kill. At the end, the coordinator merges the results of the nodes into scenario-report.json, and exits with 1 if the scenario failed, so that the pipelines can use this as a regression test.
+*/
+
+// loadScenario reads the scenario, and sets the swarm up for it.
+func loadScenario() scenario.Scenario {
+	sc, err := scenario.Load(settings.scenarioloc)
+	if err != nil {
+		log.Fatal(err)
+	}
+	settings.swarmsize = sc.Nodes
+	settings.testdurationsec = int(sc.Duration.Seconds())
+	dir, err2 := filepath.Abs(fmt.Sprintf("Runtime-Generated-Files/Scenario Results/%s %s", sc.Name, time.Now().Format(time.RFC1123)))
+	if err2 != nil {
+		log.Fatal(err2)
+	}
+	toolbox.CreatePath(dir)
+	settings.scenariodir = dir
+	return sc
+}
+
+// writeResolvedScenario saves the scenario with the ports of the nodes, and returns where it saved it.
+func writeResolvedScenario(sc scenario.Scenario, nodes []node) string {
+	sc.Ports = make(map[string]int)
+	for _, n := range nodes {
+		sc.Ports[n.appname] = n.externalPort
+	}
+	scAsByte, err := json.MarshalIndent(sc, "", "    ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	loc := filepath.Join(settings.scenariodir, "scenario.json")
+	err2 := ioutil.WriteFile(loc, scAsByte, 0755)
+	if err2 != nil {
+		log.Fatal(err2)
+	}
+	return loc
+}
+
+// startScenarioNode runs the node until the end of the scenario, restarting it after every kill of the scenario that it has reported.
+func startScenarioNode(n node, sc scenario.Scenario, scenarioLoc string, start int64, wg *sync.WaitGroup, swarmNodeId int) {
+	defer wg.Done()
+	end := start + int64(sc.Duration.Seconds())
+	restarted := make(map[int]bool)
+	for {
+		remaining := end - time.Now().Unix()
+		if remaining <= 0 {
+			return
+		}
+		log.Printf("We're starting the swarm node with the app name %s at the port %d for the scenario.", n.appname, n.externalPort)
+		cmd := exec.Command(
+			"go", "run", "main.go", "orchestrate",
+			fmt.Sprintf("--appname=%s", n.appname),
+			"--logginglevel=1",
+			"--printtostdout",
+			fmt.Sprintf("--port=%d", n.externalPort),
+			"--metricsdebugmode",
+			"--pagesigcheckenabled=false",
+			"--fpcheckenabled=false",
+			"--powcheckenabled=false",
+			"--sigcheckenabled=false",
+			"--allowlocalhostremotes=true",
+			fmt.Sprintf("--killtimeout=%d", remaining),
+			fmt.Sprintf("--swarmscenario=%s", scenarioLoc),
+			fmt.Sprintf("--swarmstart=%d", start),
+			fmt.Sprintf("--swarmreportdir=%s", settings.scenariodir),
+			fmt.Sprintf("--swarmnodeid=%d", swarmNodeId))
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Dir = "../../../../aether-core/aether/backend/"
+		err := cmd.Run()
+		if err != nil {
+			// Not fatal here, the steps this node didn't get to will fail the scenario.
+			log.Printf("The swarm node %s has crashed with an error. Error: %v", n.appname, err)
+			return
+		}
+		nr, err2 := scenario.ReadNodeReport(settings.scenariodir, n.appname)
+		if err2 != nil {
+			log.Printf("The results of the swarm node %s could not be read. Error: %v", n.appname, err2)
+			return
+		}
+		kill, found := unrestartedKill(sc, nr, n.appname, restarted)
+		if !found || kill.RestartAfter.Duration == 0 {
+			// It exited at its kill timeout, or it was killed for good.
+			return
+		}
+		restarted[kill.ID] = true
+		log.Printf("The swarm node %s was killed by the scenario. It will be restarted in %v.", n.appname, kill.RestartAfter)
+		time.Sleep(kill.RestartAfter.Duration)
+	}
+}
+
+// unrestartedKill finds the kill that made the node exit: the one it has reported, and that we haven't restarted it after yet.
+func unrestartedKill(sc scenario.Scenario, nr scenario.NodeReport, appname string, restarted map[int]bool) (scenario.Step, bool) {
+	for _, st := range sc.StepsFor(appname) {
+		if st.Verb == "kill" && nr.Done(st.ID) && !restarted[st.ID] {
+			return st, true
+		}
+	}
+	return scenario.Step{}, false
+}
+
+func startScenarioNodes(nodes []node, sc scenario.Scenario, scenarioLoc string, start int64) {
+	var wg sync.WaitGroup
+	for key, n := range nodes {
+		wg.Add(1)
+		go startScenarioNode(n, sc, scenarioLoc, start, &wg, key)
+	}
+	wg.Wait()
+	fmt.Println("All swarm nodes have exited at the end of the scenario.")
+}
+
+// saveScenarioReport merges the results of the nodes, saves the report, and tells whether the scenario passed.
+func saveScenarioReport(sc scenario.Scenario, nodes []node, start int64) bool {
+	var nrs []scenario.NodeReport
+	for _, n := range nodes {
+		nr, err := scenario.ReadNodeReport(settings.scenariodir, n.appname)
+		if err != nil {
+			log.Printf("The results of the swarm node %s could not be read. Its steps will count as failed. Error: %v", n.appname, err)
+			continue
+		}
+		nrs = append(nrs, nr)
+	}
+	rep := scenario.Merge(sc, nrs, start, time.Now().Unix())
+	repAsByte, err := json.MarshalIndent(rep, "", "    ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	loc := filepath.Join(settings.scenariodir, "scenario-report.json")
+	err2 := ioutil.WriteFile(loc, repAsByte, 0755)
+	if err2 != nil {
+		log.Fatal(err2)
+	}
+	for _, r := range rep.Results {
+		if !r.Passed {
+			fmt.Printf("FAILED: Step %d (%s) on %s: %s\n", r.Step, r.Verb, r.Node, r.Detail)
+		}
+	}
+	fmt.Printf("Scenario '%s': %d steps passed, %d failed. Passed: %v. The report is at: %s\n", rep.Scenario, rep.StepsPassed, rep.StepsFailed, rep.Passed, loc)
+	return rep.Passed
+}
+
 var startTime int64
 
 func main() {
 	start := time.Now()
 	startTime = start.Unix()
 	setDefaults()
+	var sc scenario.Scenario
+	if settings.scenarioloc != "" {
+		sc = loadScenario()
+	}
 	durAstDur := time.Duration(settings.testdurationsec) * time.Second
 	fmt.Printf("Started at: %s with Nodes: %v, DbSize (each): %v, Duration: %s. End~: %s \n", start.Format(time.RFC1123), settings.swarmsize, settings.dbsize, durAstDur, time.Now().Add(durAstDur*12/10).Format(time.RFC1123))
 	go sms.StartListening()
@@ -401,6 +555,19 @@ func main() {
 		// After the donor gives to the swarm node the whole load, the donor is killed so as to make sure that swarm nodes are the only online nodes in the petri dish.
 		serverInstance.Shutdown(nil)
 	}
+	if settings.scenarioloc != "" {
+		// The scenario clock starts after the seeding, so that the seeding time doesn't eat into the steps.
+		scenarioLoc := writeResolvedScenario(sc, nodes)
+		scenarioStart := time.Now().Unix()
+		startScenarioNodes(nodes, sc, scenarioLoc, scenarioStart)
+		collectAndSaveResults(startTime)
+		passed := saveScenarioReport(sc, nodes, scenarioStart)
+		fmt.Printf("It took %d to run this swarm scenario. Time is now %s\n", int(time.Since(start).Seconds()), time.Now().Format(time.RFC1123))
+		if !passed {
+			os.Exit(1)
+		}
+		return
+	}
 	// Here, generate the list of connection requests we want to inject to the swarm nodes. This is where we create the connection mapping we want to test live.
 	generateSwarmSchedules(nodes, "simple")
 	// generateSwarmSchedules(nodes, "reverseopen")
@@ -0,0 +1,160 @@
+// Backend > Swarmtest > Scenario > Report
+// This file holds the results the nodes save as they run their steps, the labels they publish, and the report the coordinator merges out of them at the end.
+
+package scenario
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+/*
+Every node saves its results into <reportdir>/<node>.json after every step, so what's done survives the node being killed. The labels go into <reportdir>/labels/<label>.json. At the end, the coordinator merges the node results into one report. A step passes only if every node that runs it reported a pass. A step no node reported on has failed.
+*/
+
+type StepResult struct {
+	Step    int    `json:"step"`
+	Node    string `json:"node"`
+	Verb    string `json:"verb"`
+	Passed  bool   `json:"passed"`
+	Started int64  `json:"started"`
+	Ended   int64  `json:"ended"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+type NodeReport struct {
+	Node    string       `json:"node"`
+	Results []StepResult `json:"results"`
+}
+
+type Report struct {
+	Scenario    string       `json:"scenario"`
+	Started     int64        `json:"started"`
+	Ended       int64        `json:"ended"`
+	Passed      bool         `json:"passed"`
+	StepsPassed int          `json:"steps_passed"`
+	StepsFailed int          `json:"steps_failed"`
+	Results     []StepResult `json:"results"`
+}
+
+type Label struct {
+	Entity       string   `json:"entity"`
+	Fingerprints []string `json:"fingerprints"`
+}
+
+// Done tells whether the node has already run the step. A node restarted after a kill uses this to pick up where it left.
+func (nr *NodeReport) Done(step int) bool {
+	for _, r := range nr.Results {
+		if r.Step == step {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadNodeReport reads the results the node has saved so far. A node that hasn't saved any yet gets an empty report.
+func ReadNodeReport(dir string, node string) (NodeReport, error) {
+	nr := NodeReport{Node: node}
+	data, err := ioutil.ReadFile(nodeReportPath(dir, node))
+	if os.IsNotExist(err) {
+		return nr, nil
+	}
+	if err != nil {
+		return nr, err
+	}
+	err2 := json.Unmarshal(data, &nr)
+	if err2 != nil {
+		return NodeReport{Node: node}, errors.New(fmt.Sprintf("The results of the node %s could not be parsed. Error: %v", node, err2))
+	}
+	return nr, nil
+}
+
+func (nr *NodeReport) Save(dir string) error {
+	return writeJSON(nodeReportPath(dir, nr.Node), nr)
+}
+
+func SaveLabel(dir string, name string, l Label) error {
+	return writeJSON(labelPath(dir, name), l)
+}
+
+// ReadLabel reads a published label. If the label isn't published yet, it's not an error, the bool is false.
+func ReadLabel(dir string, name string) (Label, bool, error) {
+	var l Label
+	data, err := ioutil.ReadFile(labelPath(dir, name))
+	if os.IsNotExist(err) {
+		return l, false, nil
+	}
+	if err != nil {
+		return l, false, err
+	}
+	err2 := json.Unmarshal(data, &l)
+	if err2 != nil {
+		return l, false, err2
+	}
+	return l, true, nil
+}
+
+// Merge puts the results of the nodes together into the report of the scenario.
+func Merge(s Scenario, nrs []NodeReport, started int64, ended int64) Report {
+	rep := Report{Scenario: s.Name, Started: started, Ended: ended, Passed: true}
+	for _, st := range s.Steps {
+		for _, node := range st.Reporters() {
+			res, found := findResult(nrs, st.ID, node)
+			if !found {
+				res = StepResult{Step: st.ID, Node: node, Verb: st.Verb, Passed: false, Detail: "This step never ran. The node might have crashed, or the scenario might have ended before the step was due."}
+			}
+			if res.Passed {
+				rep.StepsPassed++
+			} else {
+				rep.StepsFailed++
+				rep.Passed = false
+			}
+			rep.Results = append(rep.Results, res)
+		}
+	}
+	return rep
+}
+
+func findResult(nrs []NodeReport, step int, node string) (StepResult, bool) {
+	for _, nr := range nrs {
+		if nr.Node != node {
+			continue
+		}
+		for _, r := range nr.Results {
+			if r.Step == step {
+				return r, true
+			}
+		}
+	}
+	return StepResult{}, false
+}
+
+func nodeReportPath(dir string, node string) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.json", node))
+}
+
+func labelPath(dir string, name string) string {
+	return filepath.Join(dir, "labels", fmt.Sprintf("%s.json", name))
+}
+
+// writeJSON writes into a temp file first and renames it, so that the readers in the other nodes never see a half-written file.
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	err2 := os.MkdirAll(filepath.Dir(path), 0755)
+	if err2 != nil {
+		return err2
+	}
+	tmp := path + ".tmp"
+	err3 := ioutil.WriteFile(tmp, data, 0755)
+	if err3 != nil {
+		return err3
+	}
+	return os.Rename(tmp, path)
+}
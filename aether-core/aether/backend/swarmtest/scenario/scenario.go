@@ -0,0 +1,315 @@
+// Backend > Swarmtest > Scenario
+// This package holds the scenario language of the swarm tests. The swarm coordinator (backend/swarmtest) and the swarm nodes (the orchestrate command) both read the same scenario file, so the types live here where both can import them.
+
+package scenario
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+)
+
+/*
+A scenario is a list of steps, each of which runs on one node of the swarm at a given time after the start of the test. An example:
+
+	{
+	    "name": "posts spread across a healed partition",
+	    "nodes": 3,
+	    "duration": "10m",
+	    "steps": [
+	        {"verb": "connect", "node": "Aether-1", "peer": "Aether-0", "at": "10s", "force": true},
+	        {"verb": "partition", "node": "Aether-2", "peers": ["Aether-0", "Aether-1"], "at": "5s"},
+	        {"verb": "create", "node": "Aether-0", "entity": "post", "count": 50, "label": "fresh", "at": "30s"},
+	        {"verb": "assert", "node": "Aether-1", "at": "40s", "within": "2m", "condition": {"label": "fresh"}},
+	        {"verb": "heal", "node": "Aether-2", "peers": ["Aether-0", "Aether-1"], "at": "3m"},
+	        {"verb": "connect", "node": "Aether-2", "peer": "Aether-1", "at": "3m5s", "force": true},
+	        {"verb": "assert", "node": "Aether-2", "at": "3m10s", "within": "3m", "condition": {"label": "fresh"}}
+	    ]
+	}
+
+Verbs:
+
+- connect: Sync with the peer right away (force), or insert its address so that the node finds it on its own.
+- reverseopen: Ask the peer to sync with us over a reverse connection.
+- cachegen: Generate the caches.
+- create: Create count entities of the given type (board, thread, post, vote, key, truststate), with whatever parent entities they need. If a label is given, the fingerprints of the created entities are published under it, so that the other nodes can check for them.
+- partition / heal: Stop, and start again, syncing with the peers. This applies to both sides, the peers also stop syncing with the node.
+- kill: Shut the node down. The coordinator restarts it after restartAfter. If that's not given, the node stays down until the end.
+- waitfor: Wait until the condition holds, for up to within. The later steps of the node wait with it.
+- assert: Check that the condition holds by the end of within. If within is not given, it's checked once.
+
+A condition is one of:
+
+- {"entity": "post", "atLeast": 100} or {"entity": "post", "exactly": 100}: The number of the entities of the type in the node's database.
+- {"entity": "post", "fingerprints": ["..."]}: All of these entities are in the node's database.
+- {"label": "fresh"}: All of the entities created under this label are in the node's database.
+
+Steps are numbered in the order they appear in the file, and the report uses these numbers. The nodes are named Aether-0 to Aether-(nodes-1).
+*/
+
+var verbs = []string{"connect", "reverseopen", "cachegen", "create", "partition", "heal", "kill", "waitfor", "assert"}
+
+var entities = []string{"board", "thread", "post", "vote", "key", "truststate"}
+
+// Duration reads and writes as a Go duration string, such as "1m30s".
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return errors.New(fmt.Sprintf("A duration has to be a string such as \"1m30s\". Given: %s", string(b)))
+	}
+	dur, err2 := time.ParseDuration(s)
+	if err2 != nil {
+		return err2
+	}
+	d.Duration = dur
+	return nil
+}
+
+type Scenario struct {
+	Name     string   `json:"name"`
+	Nodes    int      `json:"nodes"`
+	Duration Duration `json:"duration"`
+	Steps    []Step   `json:"steps"`
+	// Ports is filled in by the coordinator once it knows which ports the nodes are listening at. Nodes read it to find their peers.
+	Ports map[string]int `json:"ports,omitempty"`
+}
+
+type Step struct {
+	ID           int        `json:"id"`
+	Verb         string     `json:"verb"`
+	Node         string     `json:"node"`
+	At           Duration   `json:"at"`
+	Peer         string     `json:"peer,omitempty"`
+	Peers        []string   `json:"peers,omitempty"`
+	Force        bool       `json:"force,omitempty"`
+	Entity       string     `json:"entity,omitempty"`
+	Count        int        `json:"count,omitempty"`
+	Label        string     `json:"label,omitempty"`
+	RestartAfter Duration   `json:"restartAfter,omitempty"`
+	Within       Duration   `json:"within,omitempty"`
+	Condition    *Condition `json:"condition,omitempty"`
+}
+
+type Condition struct {
+	Entity       string   `json:"entity,omitempty"`
+	AtLeast      *int     `json:"atLeast,omitempty"`
+	Exactly      *int     `json:"exactly,omitempty"`
+	Fingerprints []string `json:"fingerprints,omitempty"`
+	Label        string   `json:"label,omitempty"`
+}
+
+// NodeName is the app name of the nth node of the swarm.
+func NodeName(i int) string {
+	return fmt.Sprintf("Aether-%d", i)
+}
+
+// Load reads, numbers and validates the scenario at the path.
+func Load(path string) (Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Scenario{}, errors.New(fmt.Sprintf("The swarm scenario could not be read. Error: %v", err))
+	}
+	return Parse(data)
+}
+
+// Parse numbers the steps of the scenario in the order they're given, and validates it.
+func Parse(data []byte) (Scenario, error) {
+	var s Scenario
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return Scenario{}, errors.New(fmt.Sprintf("The swarm scenario JSON parsing failed. Error: %v", err))
+	}
+	for i, _ := range s.Steps {
+		s.Steps[i].ID = i
+	}
+	err2 := s.Validate()
+	if err2 != nil {
+		return Scenario{}, err2
+	}
+	return s, nil
+}
+
+// Validate checks the scenario for mistakes that would otherwise only surface halfway through a swarm run, such as an unknown verb, a node that doesn't exist, or a step scheduled while its node is down.
+func (s *Scenario) Validate() error {
+	var errs []string
+	fail := func(st Step, format string, a ...interface{}) {
+		errs = append(errs, fmt.Sprintf("Step %d (%s): %s", st.ID, st.Verb, fmt.Sprintf(format, a...)))
+	}
+	if s.Nodes <= 0 {
+		errs = append(errs, "The scenario needs at least one node.")
+	}
+	if s.Duration.Duration <= 0 {
+		errs = append(errs, "The scenario needs a duration.")
+	}
+	labels := make(map[string]bool)
+	for _, st := range s.Steps {
+		if st.Verb == "create" && len(st.Label) > 0 {
+			if labels[st.Label] {
+				fail(st, "The label %s is used by more than one create step.", st.Label)
+			}
+			labels[st.Label] = true
+		}
+	}
+	for _, st := range s.Steps {
+		if !contains(verbs, st.Verb) {
+			fail(st, "Unknown verb. Known verbs are: %s", strings.Join(verbs, ", "))
+			continue
+		}
+		if !s.isNode(st.Node) {
+			fail(st, "Unknown node: %s", st.Node)
+		}
+		if st.At.Duration < 0 || st.At.Duration > s.Duration.Duration {
+			fail(st, "The step has to be within the duration of the scenario. At: %v", st.At)
+		}
+		switch st.Verb {
+		case "connect", "reverseopen":
+			if !s.isNode(st.Peer) || st.Peer == st.Node {
+				fail(st, "The peer has to be another node of the swarm. Peer: %s", st.Peer)
+			}
+		case "partition", "heal":
+			if len(st.Peers) == 0 {
+				fail(st, "The step needs at least one peer.")
+			}
+			for _, p := range st.Peers {
+				if !s.isNode(p) || p == st.Node {
+					fail(st, "The peers have to be other nodes of the swarm. Peer: %s", p)
+				}
+			}
+		case "create":
+			if !contains(entities, st.Entity) {
+				fail(st, "Unknown entity type: %s. Known types are: %s", st.Entity, strings.Join(entities, ", "))
+			}
+			if st.Count <= 0 {
+				fail(st, "The count has to be at least 1.")
+			}
+		case "kill":
+			if st.RestartAfter.Duration < 0 {
+				fail(st, "restartAfter can't be negative.")
+			}
+		case "waitfor", "assert":
+			if st.Verb == "waitfor" && st.Within.Duration <= 0 {
+				fail(st, "waitfor needs a within duration.")
+			}
+			if st.Within.Duration < 0 {
+				fail(st, "within can't be negative.")
+			}
+			err := validateCondition(st.Condition, labels)
+			if err != nil {
+				fail(st, "%v", err)
+			}
+		}
+	}
+	errs = append(errs, s.validateDowntimes()...)
+	if len(errs) > 0 {
+		return errors.New(fmt.Sprintf("The swarm scenario is invalid. Errors: %s", strings.Join(errs, " ")))
+	}
+	return nil
+}
+
+func validateCondition(c *Condition, labels map[string]bool) error {
+	if c == nil {
+		return errors.New("The step needs a condition.")
+	}
+	kinds := 0
+	if c.AtLeast != nil || c.Exactly != nil {
+		kinds++
+		if c.AtLeast != nil && c.Exactly != nil {
+			return errors.New("A condition can have either atLeast or exactly, not both.")
+		}
+	}
+	if len(c.Fingerprints) > 0 {
+		kinds++
+	}
+	if len(c.Label) > 0 {
+		kinds++
+		if !labels[c.Label] {
+			return errors.New(fmt.Sprintf("No create step publishes the label %s.", c.Label))
+		}
+	}
+	if kinds != 1 {
+		return errors.New("A condition has to have exactly one of: a count (atLeast or exactly), fingerprints, or a label.")
+	}
+	if len(c.Label) == 0 && !contains(entities, c.Entity) {
+		return errors.New(fmt.Sprintf("Unknown entity type: %s. Known types are: %s", c.Entity, strings.Join(entities, ", ")))
+	}
+	return nil
+}
+
+// validateDowntimes makes sure no node has a step to run while it's killed. A node that stays down can't have any steps after its kill.
+func (s *Scenario) validateDowntimes() []string {
+	var errs []string
+	for _, k := range s.Steps {
+		if k.Verb != "kill" {
+			continue
+		}
+		for _, st := range s.StepsFor(k.Node) {
+			if st.ID == k.ID || st.At.Duration < k.At.Duration {
+				continue
+			}
+			if k.RestartAfter.Duration == 0 || st.At.Duration <= k.At.Duration+k.RestartAfter.Duration {
+				errs = append(errs, fmt.Sprintf("Step %d (%s): The node %s is down at this time, it's killed by step %d.", st.ID, st.Verb, k.Node, k.ID))
+			}
+		}
+	}
+	return errs
+}
+
+// StepsFor returns the steps the node runs, in the order of their times. A partition or a heal also runs on its peers, pointed back at the node that the step names.
+func (s *Scenario) StepsFor(node string) []Step {
+	var steps []Step
+	for _, st := range s.Steps {
+		if st.Node == node {
+			steps = append(steps, st)
+			continue
+		}
+		if (st.Verb == "partition" || st.Verb == "heal") && contains(st.Peers, node) {
+			mirrored := st
+			mirrored.Node = node
+			mirrored.Peers = []string{st.Node}
+			steps = append(steps, mirrored)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].At.Duration < steps[j].At.Duration
+	})
+	return steps
+}
+
+// Reporters are the nodes expected to report a result for the step.
+func (st *Step) Reporters() []string {
+	if st.Verb == "partition" || st.Verb == "heal" {
+		return append([]string{st.Node}, st.Peers...)
+	}
+	return []string{st.Node}
+}
+
+func (s *Scenario) isNode(name string) bool {
+	for i := 0; i < s.Nodes; i++ {
+		if NodeName(i) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, item string) bool {
+	for _, val := range list {
+		if val == item {
+			return true
+		}
+	}
+	return false
+}
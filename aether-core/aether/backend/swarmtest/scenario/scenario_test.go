@@ -0,0 +1,157 @@
+package scenario
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+var validScenario = `{
+    "name": "partition and heal",
+    "nodes": 3,
+    "duration": "10m",
+    "steps": [
+        {"verb": "connect", "node": "Aether-1", "peer": "Aether-0", "at": "10s", "force": true},
+        {"verb": "partition", "node": "Aether-2", "peers": ["Aether-0", "Aether-1"], "at": "5s"},
+        {"verb": "create", "node": "Aether-0", "entity": "post", "count": 50, "label": "fresh", "at": "30s"},
+        {"verb": "assert", "node": "Aether-1", "at": "40s", "within": "2m", "condition": {"label": "fresh"}},
+        {"verb": "kill", "node": "Aether-0", "at": "1m", "restartAfter": "30s"},
+        {"verb": "heal", "node": "Aether-2", "peers": ["Aether-0", "Aether-1"], "at": "3m"},
+        {"verb": "waitfor", "node": "Aether-2", "at": "3m10s", "within": "3m", "condition": {"entity": "post", "atLeast": 50}}
+    ]
+}`
+
+func mustParse(t *testing.T, data string) Scenario {
+	s, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("The scenario should have parsed. Error: %v", err)
+	}
+	return s
+}
+
+func expectInvalid(t *testing.T, data string, contains string) {
+	_, err := Parse([]byte(data))
+	if err == nil {
+		t.Fatalf("The scenario should have been rejected.")
+	}
+	if !strings.Contains(err.Error(), contains) {
+		t.Errorf("The error should have mentioned: %s. Error: %v", contains, err)
+	}
+}
+
+// Tests
+
+func TestParse_Success(t *testing.T) {
+	s := mustParse(t, validScenario)
+	if len(s.Steps) != 7 {
+		t.Fatalf("Expected 7 steps, got: %v", len(s.Steps))
+	}
+	for i, st := range s.Steps {
+		if st.ID != i {
+			t.Errorf("The steps should be numbered in order. Expected: %v, Got: %v", i, st.ID)
+		}
+	}
+	if s.Steps[3].Within.Duration != 2*time.Minute || s.Steps[4].RestartAfter.Duration != 30*time.Second {
+		t.Errorf("The durations were not parsed. Within: %v, RestartAfter: %v", s.Steps[3].Within, s.Steps[4].RestartAfter)
+	}
+}
+
+func TestParse_UnknownVerb_Fail(t *testing.T) {
+	expectInvalid(t, `{"nodes": 1, "duration": "1m", "steps": [{"verb": "explode", "node": "Aether-0", "at": "1s"}]}`, "Unknown verb")
+}
+
+func TestParse_UnknownNode_Fail(t *testing.T) {
+	expectInvalid(t, `{"nodes": 2, "duration": "1m", "steps": [{"verb": "connect", "node": "Aether-0", "peer": "Aether-5", "at": "1s"}]}`, "peer has to be another node")
+}
+
+func TestParse_NumericDuration_Fail(t *testing.T) {
+	expectInvalid(t, `{"nodes": 1, "duration": 60, "steps": []}`, "duration has to be a string")
+}
+
+func TestParse_UnpublishedLabel_Fail(t *testing.T) {
+	expectInvalid(t, `{"nodes": 1, "duration": "1m", "steps": [{"verb": "assert", "node": "Aether-0", "at": "1s", "condition": {"label": "nope"}}]}`, "No create step publishes the label")
+}
+
+func TestParse_AmbiguousCondition_Fail(t *testing.T) {
+	expectInvalid(t, `{"nodes": 1, "duration": "1m", "steps": [{"verb": "assert", "node": "Aether-0", "at": "1s", "condition": {"entity": "post", "atLeast": 1, "fingerprints": ["a"]}}]}`, "exactly one of")
+}
+
+func TestParse_StepWhileKilled_Fail(t *testing.T) {
+	expectInvalid(t, `{"nodes": 1, "duration": "5m", "steps": [
+        {"verb": "kill", "node": "Aether-0", "at": "1m", "restartAfter": "1m"},
+        {"verb": "cachegen", "node": "Aether-0", "at": "1m30s"}]}`, "is down at this time")
+}
+
+func TestStepsFor_MirrorsPartitions_Success(t *testing.T) {
+	s := mustParse(t, validScenario)
+	steps := s.StepsFor("Aether-0")
+	var verbsInOrder []string
+	for _, st := range steps {
+		verbsInOrder = append(verbsInOrder, st.Verb)
+	}
+	if strings.Join(verbsInOrder, ",") != "partition,create,kill,heal" {
+		t.Fatalf("Unexpected steps for the node: %v", verbsInOrder)
+	}
+	if steps[0].Node != "Aether-0" || len(steps[0].Peers) != 1 || steps[0].Peers[0] != "Aether-2" {
+		t.Errorf("The partition should have been pointed back at the node that the step names. Step: %#v", steps[0])
+	}
+}
+
+func TestMerge_Success(t *testing.T) {
+	s := mustParse(t, validScenario)
+	var nrs []NodeReport
+	for i := 0; i < s.Nodes; i++ {
+		nr := NodeReport{Node: NodeName(i)}
+		for _, st := range s.StepsFor(NodeName(i)) {
+			nr.Results = append(nr.Results, StepResult{Step: st.ID, Node: NodeName(i), Verb: st.Verb, Passed: true})
+		}
+		nrs = append(nrs, nr)
+	}
+	rep := Merge(s, nrs, 1, 2)
+	if !rep.Passed || rep.StepsFailed != 0 || rep.StepsPassed != 11 {
+		t.Errorf("All steps should have passed. Passed: %v, StepsPassed: %v, StepsFailed: %v", rep.Passed, rep.StepsPassed, rep.StepsFailed)
+	}
+}
+
+func TestMerge_MissingResult_Fail(t *testing.T) {
+	s := mustParse(t, validScenario)
+	nrs := []NodeReport{{Node: "Aether-1", Results: []StepResult{{Step: 0, Node: "Aether-1", Verb: "connect", Passed: true}}}}
+	rep := Merge(s, nrs, 1, 2)
+	if rep.Passed || rep.StepsPassed != 1 || rep.StepsFailed != 10 {
+		t.Errorf("The steps that never ran should have failed. Passed: %v, StepsPassed: %v, StepsFailed: %v", rep.Passed, rep.StepsPassed, rep.StepsFailed)
+	}
+}
+
+func TestNodeReport_SaveAndRead_Success(t *testing.T) {
+	dir, err0 := ioutil.TempDir("", "swarm-scenario-test")
+	if err0 != nil {
+		t.Fatal(err0)
+	}
+	defer os.RemoveAll(dir)
+	nr, err := ReadNodeReport(dir, "Aether-0")
+	if err != nil || len(nr.Results) != 0 {
+		t.Fatalf("A node that hasn't saved anything should get an empty report. Error: %v", err)
+	}
+	nr.Results = append(nr.Results, StepResult{Step: 3, Node: "Aether-0", Verb: "create", Passed: true})
+	err2 := nr.Save(dir)
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	nr2, err3 := ReadNodeReport(dir, "Aether-0")
+	if err3 != nil || !nr2.Done(3) || nr2.Done(4) {
+		t.Errorf("The saved results should have been read back. Error: %v, Report: %#v", err3, nr2)
+	}
+	_, found, err4 := ReadLabel(dir, "fresh")
+	if found || err4 != nil {
+		t.Errorf("An unpublished label should not be found. Error: %v", err4)
+	}
+	SaveLabel(dir, "fresh", Label{Entity: "post", Fingerprints: []string{"a", "b"}})
+	l, found2, err5 := ReadLabel(dir, "fresh")
+	if !found2 || err5 != nil || len(l.Fingerprints) != 2 {
+		t.Errorf("The published label should have been read back. Error: %v, Label: %#v", err5, l)
+	}
+}
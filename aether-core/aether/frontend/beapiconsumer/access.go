@@ -0,0 +1,107 @@
+// Frontend > BackendAPIConsumer > Access
+// This file gets the access token from the backend, and signs every request to the backend with the frontend key.
+
+package beapiconsumer
+
+import (
+	pb "aether-core/aether/protos/beapi"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/nonces"
+	"errors"
+	"fmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"sync"
+	"time"
+)
+
+const requestBackendAccessMethod = "/beapi.BackendAPI/RequestBackendAccess"
+
+var backendAccess = accessTokenHolder{}
+
+type accessTokenHolder struct {
+	lock  sync.Mutex
+	token string
+}
+
+// get returns the access token we hold, and if we don't hold one, requests one from the backend.
+func (h *accessTokenHolder) get(ctx context.Context, cc *grpc.ClientConn) (string, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if len(h.token) > 0 {
+		return h.token, nil
+	}
+	rid := createRequesterId()
+	err := signRequesterId(rid, "")
+	if err != nil {
+		return "", err
+	}
+	resp, err2 := pb.NewBackendAPIClient(cc).RequestBackendAccess(ctx, &pb.AccessRequest{RequesterId: rid})
+	if err2 != nil {
+		return "", err2
+	}
+	if resp.GetStatus().GetStatusCode() != 200 {
+		return "", errors.New(fmt.Sprintf("The backend declined our access request. Code: %v, Reason: %v", resp.GetStatus().GetStatusCode(), resp.GetStatus().GetErrorMessage()))
+	}
+	h.token = resp.GetAccessToken()
+	return h.token, nil
+}
+
+// drop forgets the given token if it is still the one we hold, so that the next request asks for a new one. If another request already got a new token, that one is kept.
+func (h *accessTokenHolder) drop(token string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.token == token {
+		h.token = ""
+	}
+}
+
+func signRequesterId(rid *pb.RequesterId, token string) error {
+	rid.AccessToken = token
+	rid.Timestamp = time.Now().Unix()
+	signedNonce, err := nonces.NewSignedNonce(token, rid.Timestamp, globals.FrontendConfig.GetFrontendKeyPair())
+	if err != nil {
+		return err
+	}
+	rid.Nonce = signedNonce
+	return nil
+}
+
+type requesterIdCarrier interface {
+	GetRequesterId() *pb.RequesterId
+}
+
+type statusCarrier interface {
+	GetStatus() *pb.Status
+}
+
+// authenticate is the interceptor that fills in the access token, timestamp and a fresh signed nonce of every request right before it goes out. If the backend declines the token (it has expired, or the backend has restarted since), it requests a new token and tries once more.
+func authenticate(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	r, ok := req.(requesterIdCarrier)
+	if method == requestBackendAccessMethod || !ok || r.GetRequesterId() == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	for attempt := 0; attempt < 2; attempt++ {
+		token, err := backendAccess.get(ctx, cc)
+		if err != nil {
+			logging.Logf(1, "We could not get access to the backend. Error: %v", err)
+			return err
+		}
+		err2 := signRequesterId(r.GetRequesterId(), token)
+		if err2 != nil {
+			return err2
+		}
+		err3 := invoker(ctx, method, req, reply, cc, opts...)
+		if err3 != nil {
+			return err3
+		}
+		sc, ok := reply.(statusCarrier)
+		if !ok || sc.GetStatus().GetStatusCode() != 401 {
+			return nil
+		}
+		logging.Logf(1, "The backend declined our access token. We'll request a new one. Reason: %v", sc.GetStatus().GetErrorMessage())
+		backendAccess.drop(token)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@
 package beapiconsumer
 
 import (
+	"aether-core/aether/io/api"
 	pb "aether-core/aether/protos/beapi"
 	pbstructs "aether-core/aether/protos/mimapi"
 	"aether-core/aether/services/globals"
@@ -13,12 +14,11 @@ import (
 	// "github.com/davecgh/go-spew/spew"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"time"
 )
 
 func StartBackendAPIConnection() (pb.BackendAPIClient, *grpc.ClientConn) {
 	beAddr := fmt.Sprint(globals.FrontendConfig.GetBackendAPIAddress(), ":", globals.FrontendConfig.GetBackendAPIPort())
-	conn, err := grpc.Dial(beAddr, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(toolbox.MaxInt32)))
+	conn, err := grpc.Dial(beAddr, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(toolbox.MaxInt32)), grpc.WithUnaryInterceptor(authenticate))
 	if err != nil {
 		logging.Logf(1, "Could not connect to the backend API service. Error: %v", err)
 	}
@@ -26,12 +26,10 @@ func StartBackendAPIConnection() (pb.BackendAPIClient, *grpc.ClientConn) {
 	return c, conn
 }
 
+// createRequesterId creates the requester id of a request. The access token, nonce and timestamp are filled in by the authenticate interceptor right before the request goes out, see access.go.
 func createRequesterId() *pb.RequesterId {
 	rid := pb.RequesterId{}
-	rid.AccessToken = "" // todo
-	rid.Nonce = ""       // todo
 	rid.PublicKey = globals.FrontendConfig.GetMarshaledFrontendPublicKey()
-	rid.Timestamp = time.Now().Unix()
 	return &rid
 }
 
@@ -358,6 +356,33 @@ func GetThreadPostsCount(fp string) int {
 	return int(resp.GetCount())
 }
 
+// GetEntityHistory returns the prior versions of an updated thread or post, oldest first. Only one of the two is filled, depending on what the fingerprint belongs to. Not cached.
+func GetEntityHistory(fp string) ([]*pbstructs.Thread, []*pbstructs.Post) {
+	c, conn := StartBackendAPIConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), globals.FrontendConfig.GetGRPCServiceTimeout())
+	defer cancel()
+	req := pb.EntityHistoryRequest{
+		RequesterId: createRequesterId(),
+		Fingerprint: fp}
+	resp, err := c.GetEntityHistory(ctx, &req)
+	if err != nil {
+		logging.Logf(1, "GetEntityHistory encountered an error. Error: %v", err)
+	}
+	return resp.GetThreads(), resp.GetPosts()
+}
+
+// LookupBoard returns the board with the given fingerprint from the backend, if the backend has it.
+func LookupBoard(fingerprint api.Fingerprint) (api.Board, bool) {
+	bs := GetBoards(0, 0, []string{string(fingerprint)}, true, true)
+	if len(bs) == 0 {
+		return api.Board{}, false
+	}
+	board := api.Board{}
+	board.FillFromProtobuf(*bs[0])
+	return board, true
+}
+
 func GetBoardsByKeyFingerprint(ownerfp string, limit, offset int) []*pbstructs.Board {
 	c, conn := StartBackendAPIConnection()
 	defer conn.Close()
@@ -391,6 +416,7 @@ func GetBoardsByName(boardName string) []*pbstructs.Board {
 	ctx, cancel := context.WithTimeout(context.Background(), globals.FrontendConfig.GetGRPCServiceTimeout())
 	defer cancel()
 	req := pb.BoardsRequest{
+		RequesterId: createRequesterId(),
 		Filters: &pb.Filters{
 			GraphFilters: &pb.GraphFilters{
 				NoDescendants: true,
@@ -523,6 +549,7 @@ func GetKeysByName(keyName string) []*pbstructs.Key {
 	ctx, cancel := context.WithTimeout(context.Background(), globals.FrontendConfig.GetGRPCServiceTimeout())
 	defer cancel()
 	req := pb.KeysRequest{
+		RequesterId: createRequesterId(),
 		Filters: &pb.Filters{
 			GraphFilters: &pb.GraphFilters{
 				NoDescendants: true,
@@ -568,6 +595,36 @@ func GetTruststatesByKeyFingerprint(ownerfp string, limit, offset int) []*pbstru
 	return []*pbstructs.Truststate{}
 }
 
+// GetDirectMessages returns the direct messages sent to the recipient, or sent by the owner, within the time range. Give one of the two. Not cached, these are read once per refresh for the local user only.
+func GetDirectMessages(start, end int64, recipientfp, ownerfp string) []*pbstructs.DirectMessage {
+	c, conn := StartBackendAPIConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), globals.FrontendConfig.GetGRPCServiceTimeout())
+	defer cancel()
+	req := pb.DirectMessagesRequest{
+		RequesterId: createRequesterId(),
+		Filters: &pb.Filters{
+			LastRefTimeRange: &pb.TimeRange{
+				Start: start,
+				End:   end,
+			},
+			GraphFilters: &pb.GraphFilters{
+				Target: recipientfp,
+				Owner:  ownerfp,
+			},
+		},
+	}
+	resp, err := c.GetDirectMessages(ctx, &req)
+	if err != nil {
+		logging.Logf(1, "GetDirectMessages encountered an error. Error: %v", err)
+	}
+	r := resp.GetDirectMessages()
+	if r != nil {
+		return validateDirectMessages(r)
+	}
+	return []*pbstructs.DirectMessage{}
+}
+
 /*----------  Backend minted content intake  ----------*/
 
 func SendMintedContent(req *pb.MintedContentPayload) (statusCode int) {
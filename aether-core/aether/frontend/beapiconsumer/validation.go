@@ -64,6 +64,16 @@ func validateTruststates(eSet []*pbstructs.Truststate) []*pbstructs.Truststate {
 	return valids
 }
 
+func validateDirectMessages(eSet []*pbstructs.DirectMessage) []*pbstructs.DirectMessage {
+	valids := []*pbstructs.DirectMessage{}
+	for k, _ := range eSet {
+		if directMessageValid(eSet[k]) {
+			valids = append(valids, eSet[k])
+		}
+	}
+	return valids
+}
+
 /*
 TODO
 
@@ -99,3 +109,7 @@ func keyValid(e *pbstructs.Key) bool {
 func truststateValid(e *pbstructs.Truststate) bool {
 	return true
 }
+
+func directMessageValid(e *pbstructs.DirectMessage) bool {
+	return true
+}
@@ -6,6 +6,7 @@ package clapiconsumer
 import (
 	"aether-core/aether/frontend/festructs"
 	"aether-core/aether/frontend/kvstore"
+	"aether-core/aether/frontend/search"
 	"aether-core/aether/io/api"
 	pb "aether-core/aether/protos/clapi"
 	"aether-core/aether/protos/feobjects"
@@ -18,6 +19,7 @@ import (
 	"fmt"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"sort"
 	// "sync"
 	// "time"
 )
@@ -244,10 +246,6 @@ func SendHomeView() {
 
 func SendPopularView() {
 	logging.Logf(1, "SendPopularView is called")
-	c, conn := StartClientAPIConnection()
-	defer conn.Close()
-	ctx, cancel := context.WithTimeout(context.Background(), globals.FrontendConfig.GetGRPCServiceTimeout())
-	defer cancel()
 	hvc := festructs.PopularViewCarrier{}
 	logging.Logf(3, "Single read happens in SendPopularView>One")
 	err := globals.KvInstance.One("Id", 1, &hvc)
@@ -255,9 +253,18 @@ func SendPopularView() {
 		logging.Logf(1, "Popular view fetch in SendPopularView encountered an error. Error: %v", err)
 		return
 	}
+	SendPopularViewThreads(hvc.Threads)
+}
+
+// SendPopularViewThreads sends the given threads as the popular view. This is for a popular view in a ranking other than the saved one.
+func SendPopularViewThreads(threads festructs.CThreadBatch) {
+	c, conn := StartClientAPIConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), globals.FrontendConfig.GetGRPCServiceTimeout())
+	defer cancel()
 	thr := []*feobjects.CompiledThreadEntity{}
-	for k, _ := range hvc.Threads {
-		thr = append(thr, hvc.Threads[k].Protobuf())
+	for k, _ := range threads {
+		thr = append(thr, threads[k].Protobuf())
 	}
 	hvp := pb.PopularViewPayload{Threads: thr}
 	_, err2 := c.SendPopularView(ctx, &hvp)
@@ -386,7 +393,28 @@ func SendExternalContentAutoloadDisabledStatus() {
 
 /*----------  Send search results  ----------*/
 
-func SendSearchResult(searchType, searchQuery string) {
+// fillSearchPage adds the paging data and the highlighted fragments of the search results to the response.
+func fillSearchPage(resp *pb.SearchResultPayload, sr *search.SearchResults) {
+	resp.Offset = int32(sr.Offset)
+	resp.Limit = int32(sr.Limit)
+	resp.Total = sr.Total
+	for k, _ := range sr.Results {
+		fields := []string{}
+		for field, _ := range sr.Results[k].Fragments {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			resp.Highlights = append(resp.Highlights, &pb.SearchHighlight{
+				Fingerprint: sr.Results[k].Id.Fingerprint,
+				Field:       field,
+				Fragments:   sr.Results[k].Fragments[field],
+			})
+		}
+	}
+}
+
+func SendSearchResult(searchType, searchQuery string, offset, limit int) {
 	logging.Logf(1, "SendSearchResult is called")
 	c, conn := StartClientAPIConnection()
 	defer conn.Close()
@@ -400,10 +428,12 @@ func SendSearchResult(searchType, searchQuery string) {
 	==============================================*/
 	switch searchType {
 	case "Board":
-		r, scoreMap, err := kvstore.SearchBoards(searchQuery)
+		r, sr, err := kvstore.SearchBoards(searchQuery, offset, limit)
 		if err != nil {
 			logging.Logf(1, "This search errored out. Type: %v, Query: %v, Error: %v", searchType, searchQuery, err)
 		}
+		fillSearchPage(&resp, &sr)
+		scoreMap := sr.ScoreMap()
 		resp.Boards = r.Protobuf()
 		for k, _ := range resp.Boards {
 			subbed, notify, lastseen := globals.FrontendConfig.ContentRelations.IsSubbedBoard(resp.Boards[k].Fingerprint)
@@ -415,10 +445,12 @@ func SendSearchResult(searchType, searchQuery string) {
 			resp.Boards[k].ViewMeta_SearchScore = scoreMap[resp.Boards[k].Fingerprint]
 		}
 	case "Content": // Content = Thread + Post
-		posts, threads, scoreMap, err := kvstore.SearchContent(searchQuery)
+		posts, threads, sr, err := kvstore.SearchContent(searchQuery, offset, limit)
 		if err != nil {
 			logging.Logf(1, "This search errored out. Type: %v, Query: %v, Error: %v", searchType, searchQuery, err)
 		}
+		fillSearchPage(&resp, &sr)
+		scoreMap := sr.ScoreMap()
 		resp.Threads = threads.Protobuf()
 		resp.Posts = posts.Protobuf()
 		// Add whitelist data and board name, search score to the threads
@@ -459,10 +491,12 @@ func SendSearchResult(searchType, searchQuery string) {
 			resp.Posts[k].ViewMeta_SearchScore = scoreMap[resp.Posts[k].Fingerprint]
 		}
 	case "User":
-		r, scoreMap, err := kvstore.SearchUsers(searchQuery)
+		r, sr, err := kvstore.SearchUsers(searchQuery, offset, limit)
 		if err != nil {
 			logging.Logf(1, "This search errored out. Type: %v, Query: %v, Error: %v", searchType, searchQuery, err)
 		}
+		fillSearchPage(&resp, &sr)
+		scoreMap := sr.ScoreMap()
 		resp.Users = r.Protobuf()
 		// Add whitelist data and scores to the posts
 		for k, _ := range resp.Users {
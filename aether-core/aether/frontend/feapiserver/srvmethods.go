@@ -108,6 +108,25 @@ func (s *server) GetBoardAndThreads(ctx context.Context, req *pb.BoardAndThreads
 	resp.Board.Notify = notify
 	resp.Board.LastSeen = lastseen
 
+	// Pick the ranking. The one asked for, if any, or the one of the board. Sort by new is the older way of asking for the new ranking.
+	sortName := req.GetSort()
+	if len(sortName) == 0 && req.GetSortThreadsByNew() {
+		sortName = "new"
+	}
+	if len(sortName) > 0 && !festructs.IsValidRanking(sortName) {
+		logging.Logf(1, "The ranking asked for in GetBoardAndThreads is unknown, falling back to the ranking of the board. Ranking: %v", sortName)
+		sortName = ""
+	}
+	if len(sortName) == 0 {
+		sortName = festructs.BoardRankingName(fp)
+	}
+	ranker, valid := festructs.GetRanker(sortName)
+	if !valid {
+		sortName = festructs.DefaultRanking
+		ranker, _ = festructs.GetRanker(sortName)
+	}
+	resp.Sort = sortName
+
 	threads := festructs.CThreadBatch{}
 	for k1, _ := range bc.Threads {
 		// Filter out the moddeletes / modapprovals based on the ruleset.
@@ -123,13 +142,14 @@ func (s *server) GetBoardAndThreads(ctx context.Context, req *pb.BoardAndThreads
 		}
 	}
 
-	// If sort by new, we sort it here. Default sort (the one saved to disk) is popular sort.
-	if req.GetSortThreadsByNew() {
-		threads.SortByCreation()
-	}
+	// The order saved to disk is the ranking of the board as of the last refresh. We rank again, since the ranking asked for might be a different one, and the scores change with time.
+	threads.Rank(ranker, time.Now().Unix())
 	// Convert all threads to protos
 	tprotos := []*feobjects.CompiledThreadEntity{}
 	for k, _ := range threads {
+		if threads[k].Unranked {
+			continue
+		}
 		tprotos = append(tprotos, threads[k].Protobuf())
 	}
 	resp.Threads = tprotos
@@ -198,14 +218,27 @@ func (s *server) SetClientAPIServerPort(ctx context.Context, req *pb.SetClientAP
 
 func (s *server) SetBoardSignal(ctx context.Context, req *pb.BoardSignalRequest) (*pb.BoardSignalResponse, error) {
 	// logging.Logf(1, "We've received a set board signal request.")
-	cr := globals.FrontendConfig.GetContentRelations()
-	committed := cr.SetBoardSignal(req.Fingerprint, req.Subscribed, req.Notify, req.LastSeen, req.LastSeenOnly)
-	globals.FrontendConfig.SetContentRelations(cr)
+	if len(req.Ranking) > 0 && !festructs.IsValidRanking(req.Ranking) {
+		logging.Logf(1, "The ranking in this board signal is unknown. Ranking: %v", req.Ranking)
+		return &pb.BoardSignalResponse{Committed: false}, nil
+	}
+	// We edit the content relations in place, under their own lock, and commit the config after. Getting a copy and setting it back would copy the lock, and drop any change that landed in between.
+	cr := &globals.FrontendConfig.ContentRelations
+	committed := cr.SetBoardSignal(req.Fingerprint, req.Subscribed, req.Notify, req.LastSeen, req.LastSeenOnly, req.Ranking, req.RankingOnly)
+	globals.FrontendConfig.Commit()
 	resp := pb.BoardSignalResponse{Committed: committed}
 	clapiconsumer.DeliverAmbients()
 	return &resp, nil
 }
 
+func (s *server) SetThreadSignal(ctx context.Context, req *pb.ThreadSignalRequest) (*pb.ThreadSignalResponse, error) {
+	cr := &globals.FrontendConfig.ContentRelations
+	committed := cr.SetThreadSignal(req.Fingerprint, req.Subscribed, req.Notify)
+	globals.FrontendConfig.Commit()
+	resp := pb.ThreadSignalResponse{Committed: committed}
+	return &resp, nil
+}
+
 func (s *server) GetUserAndGraph(ctx context.Context, req *pb.UserAndGraphRequest) (*pb.UserAndGraphResponse, error) {
 	fp := req.GetFingerprint()
 	resp := pb.UserAndGraphResponse{}
@@ -254,6 +287,20 @@ func (s *server) SendSignalEvent(ctx context.Context, req *pb.SignalEventPayload
 	return &resp, nil
 }
 
+func (s *server) CancelInflight(ctx context.Context, req *pb.CancelInflightRequest) (*pb.CancelInflightResponse, error) {
+	logging.Logf(1, "We've received an inflight cancellation request. Id: %v", req.GetId())
+	cancelled := inflights.GetInflights().Cancel(req.GetId())
+	resp := pb.CancelInflightResponse{Cancelled: cancelled}
+	return &resp, nil
+}
+
+func (s *server) RetryInflight(ctx context.Context, req *pb.RetryInflightRequest) (*pb.RetryInflightResponse, error) {
+	logging.Logf(1, "We've received an inflight retry request. Id: %v", req.GetId())
+	retried := inflights.GetInflights().Retry(req.GetId())
+	resp := pb.RetryInflightResponse{Retried: retried}
+	return &resp, nil
+}
+
 func (s *server) GetUncompiledEntityByKey(ctx context.Context, req *pb.UncompiledEntityByKeyRequest) (*pb.UncompiledEntityByKeyResponse, error) {
 	logging.Logf(1, "We've received an uncompiled entity by key request. Event: %v", *req)
 	switch req.GetEntityType() {
@@ -315,6 +362,25 @@ func (s *server) GetUncompiledEntityByKey(ctx context.Context, req *pb.Uncompile
 	}
 }
 
+// GetEntityHistory returns all versions of a thread or a post we have, oldest first and the current one last, so the client can show what changed in its edits.
+func (s *server) GetEntityHistory(ctx context.Context, req *pb.EntityHistoryRequest) (*pb.EntityHistoryResponse, error) {
+	fp := req.GetFingerprint()
+	resp := pb.EntityHistoryResponse{EntityType: pb.UncompiledEntityType_UNKNOWN_ENTITY_TYPE}
+	priorThreads, priorPosts := beapiconsumer.GetEntityHistory(fp)
+	if len(priorPosts) == 0 {
+		if current := beapiconsumer.GetThreads(0, 0, []string{fp}, "", true, true); len(current) > 0 {
+			resp.EntityType = pb.UncompiledEntityType_THREAD
+			resp.Threads = append(priorThreads, current[0])
+			return &resp, nil
+		}
+	}
+	if current := beapiconsumer.GetPosts(0, 0, []string{fp}, "", "", true, true); len(current) > 0 {
+		resp.EntityType = pb.UncompiledEntityType_POST
+		resp.Posts = append(priorPosts, current[0])
+	}
+	return &resp, nil
+}
+
 func (s *server) SendInflightsPruneRequest(ctx context.Context, req *pb.InflightsPruneRequest) (*pb.InflightsPruneResponse, error) {
 	inflights := inflights.GetInflights()
 	inflights.Prune()
@@ -370,8 +436,19 @@ func (s *server) RequestHomeView(ctx context.Context, req *pb.HomeViewRequest) (
 }
 
 func (s *server) RequestPopularView(ctx context.Context, req *pb.PopularViewRequest) (*pb.PopularViewResponse, error) {
-	clapiconsumer.SendPopularView()
+	// The ranking asked for applies to this request only, same as in GetBoardAndThreads. The saved view is in the ranking of the FE config, the refresher keeps that one up to date.
+	sortName := req.GetSort()
+	if len(sortName) > 0 && !festructs.IsValidRanking(sortName) {
+		logging.Logf(1, "The ranking asked for in RequestPopularView is unknown, falling back to the saved view. Ranking: %v", sortName)
+		sortName = ""
+	}
 	resp := pb.PopularViewResponse{}
+	if len(sortName) == 0 || sortName == globals.FrontendConfig.GetPopularViewRanking() {
+		clapiconsumer.SendPopularView()
+		return &resp, nil
+	}
+	ranker, _ := festructs.GetRanker(sortName)
+	clapiconsumer.SendPopularViewThreads(refresher.CompilePopularView(ranker, time.Now().Unix()))
 	return &resp, nil
 }
 
@@ -402,6 +479,22 @@ func (s *server) SetNotificationsSignal(ctx context.Context, req *pb.Notificatio
 	return &resp, nil
 }
 
+// RequestInbox returns the conversations of the local user. If the client is showing one of them, it asks for it to be marked read here.
+func (s *server) RequestInbox(ctx context.Context, req *pb.InboxRequest) (*pb.InboxResponse, error) {
+	if peer := req.GetMarkReadPeer(); len(peer) > 0 {
+		festructs.InboxSingleton.MarkRead(peer)
+		festructs.InboxSingleton.Save()
+		festructs.NotificationsSingleton.MarkRead("dm:" + peer)
+		clapiconsumer.SendNotifications()
+	}
+	resp := pb.InboxResponse{}
+	convs := festructs.InboxSingleton.Listify()
+	for key, _ := range convs {
+		resp.Conversations = append(resp.Conversations, convs[key].Protobuf())
+	}
+	return &resp, nil
+}
+
 func (s *server) SetOnboardComplete(ctx context.Context, req *pb.OnboardCompleteRequest) (*pb.OnboardCompleteResponse, error) {
 	globals.FrontendConfig.SetOnboardComplete(req.GetOnboardComplete())
 	clapiconsumer.SendOnboardCompleteStatus()
@@ -581,7 +674,7 @@ func (s *server) SendClientVersion(ctx context.Context, req *pb.ClientVersionPay
 
 func (s *server) SendSearchRequest(ctx context.Context, req *pb.SearchRequestPayload) (*pb.SearchRequestResponse, error) {
 	logging.Logf(1, "The client is sending us a search request. Event: %v", *req)
-	clapiconsumer.SendSearchResult(req.SearchType, req.SearchQuery)
+	clapiconsumer.SendSearchResult(req.SearchType, req.SearchQuery, int(req.Offset), int(req.Limit))
 	// ^ The actual act of computing the search result happens inside this above.
 	resp := pb.SearchRequestResponse{}
 	return &resp, nil
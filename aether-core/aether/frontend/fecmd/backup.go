@@ -0,0 +1,71 @@
+package fecmd
+
+import (
+	"aether-core/aether/frontend/inflights"
+	"aether-core/aether/frontend/kvstore"
+	"aether-core/aether/frontend/search"
+	"aether-core/aether/services/logging"
+	"fmt"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var loggingLevel int
+	var backupDir string
+	var restoreDir string
+	cmdBackup.Flags().IntVarP(&loggingLevel, "logginglevel", "", 0, "Sets the frontend logging level.")
+	cmdBackup.Flags().StringVarP(&backupDir, "file", "", "aetherfe-backup", "The directory to write the backup into. It must not exist yet.")
+	cmdRestore.Flags().IntVarP(&loggingLevel, "logginglevel", "", 0, "Sets the frontend logging level.")
+	cmdRestore.Flags().StringVarP(&restoreDir, "file", "", "aetherfe-backup", "The directory of the backup to restore.")
+	cmdRoot.AddCommand(cmdBackup)
+	cmdRoot.AddCommand(cmdRestore)
+}
+
+var cmdBackup = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up the frontend KV store and its search index.",
+	Long: `Back up the frontend KV store and its search index together, so that they match when restored. The KV store carries the user state of the frontend: notifications, inflights, ambients and so on.
+
+The app has to be closed while this runs. The app also keeps a backup of its own that it refreshes every day, in the backup directory of the frontend.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := EstablishConfigs(cmd)
+		dir := flags.file.value.(string)
+		err := kvstore.OpenForMaintenance()
+		if err != nil {
+			logging.LogCrash(err)
+		}
+		defer search.CloseIndex()
+		defer kvstore.CloseKVStore()
+		err2 := kvstore.Backup(dir)
+		if err2 != nil {
+			logging.LogCrash(err2)
+		}
+		fmt.Printf("The frontend is backed up into %v\n", dir)
+	},
+}
+
+var cmdRestore = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the frontend KV store and its search index from a backup.",
+	Long: `Restore the frontend KV store and its search index from a backup taken with the backup command, or from the backup the app keeps on its own. The current KV store and index are replaced.
+
+The app has to be closed while this runs. Anything that was still waiting to be sent when the backup was taken is put on hold, since it might have been sent since. You can retry those from the app.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := EstablishConfigs(cmd)
+		dir := flags.file.value.(string)
+		err := kvstore.Restore(dir)
+		if err != nil {
+			logging.LogCrash(err)
+		}
+		err2 := kvstore.OpenForMaintenance()
+		if err2 != nil {
+			logging.LogCrash(err2)
+		}
+		defer search.CloseIndex()
+		defer kvstore.CloseKVStore()
+		held := inflights.HoldPendingAfterRestore()
+		fmt.Printf("The frontend is restored from %v. Items put on hold: %v\n", dir, held)
+	},
+}
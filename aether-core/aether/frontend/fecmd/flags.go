@@ -17,6 +17,7 @@ type flags struct {
 	clientIp     flag // string
 	clientPort   flag // int
 	isDev        flag // bool
+	file         flag // string
 
 	// add more flags here
 }
@@ -52,6 +53,13 @@ func renderFlags(cmd *cobra.Command) flags {
 	fl.isDev.value = flg4
 	fl.isDev.changed = cmd.Flags().Changed("isdev")
 
+	flg5, err5 := cmd.Flags().GetString("file")
+	if err5 != nil && !strings.Contains(err5.Error(), "flag accessed but not defined") {
+		logging.LogCrash(err5)
+	}
+	fl.file.value = flg5
+	fl.file.changed = cmd.Flags().Changed("file")
+
 	// add more flags here
 
 	return fl
@@ -0,0 +1,17 @@
+package fecmd
+
+import (
+	"aether-core/aether/frontend/refresher"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/metricsexport"
+)
+
+// startMetricsExporter registers the collectors of the frontend and starts serving them at the local /metrics endpoint. This only runs if the metrics exporter is enabled in the config.
+func startMetricsExporter() {
+	metricsexport.Register(exportFrontendMetrics)
+	go metricsexport.StartServer(globals.FrontendConfig.GetMetricsExporterAddress())
+}
+
+func exportFrontendMetrics(w *metricsexport.Writer) {
+	w.Gauge("aether_frontend_last_refresh_duration_seconds", "Duration of the last refresh of the compiled frontend data.", refresher.LastRefreshDuration.Seconds())
+}
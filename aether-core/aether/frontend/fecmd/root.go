@@ -1,6 +1,7 @@
 package fecmd
 
 import (
+	"aether-core/aether/services/ca"
 	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
@@ -50,6 +51,7 @@ func EstablishConfigs(cmd *cobra.Command) flags {
 	}
 	fecfg.Cycle()
 	globals.FrontendConfig = fecfg
+	ca.SetTrustedKeys(globals.FrontendConfig.GetTrustedCAKeys())
 	if cmd == nil {
 		return flgs
 	}
@@ -0,0 +1,46 @@
+package fecmd
+
+import (
+	"aether-core/aether/services/ca"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"encoding/json"
+	"fmt"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+)
+
+func init() {
+	var loggingLevel int
+	var file string
+	cmdRotateCA.Flags().IntVarP(&loggingLevel, "logginglevel", "", 0, "Sets the frontend logging level.")
+	cmdRotateCA.Flags().StringVarP(&file, "file", "", "rotation.json", "The file of the rotation statement.")
+	cmdRoot.AddCommand(cmdRotateCA)
+}
+
+var cmdRotateCA = &cobra.Command{
+	Use:   "rotateca",
+	Short: "Apply a CA-signed rotation statement that introduces or retires a trusted CA key.",
+	Long: `Apply a CA-signed rotation statement that introduces or retires a trusted CA key.
+
+The statement has to be signed by a CA key that this frontend trusts, both at the time of the statement and now. The backend has its own copy of the trusted CA keys, so apply the statement there as well with the rotateca command of the backend.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := EstablishConfigs(cmd)
+		file := flags.file.value.(string)
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			logging.LogCrash(fmt.Sprintf("The rotation statement could not be read. File: %v, Error: %v", file, err))
+		}
+		var stmt ca.RotationStatement
+		err2 := json.Unmarshal(data, &stmt)
+		if err2 != nil {
+			logging.LogCrash(fmt.Sprintf("The rotation statement could not be parsed. File: %v, Error: %v", file, err2))
+		}
+		err3 := globals.FrontendConfig.ApplyCARotation(stmt)
+		if err3 != nil {
+			logging.LogCrash(err3)
+		}
+		fmt.Printf("The rotation statement is applied. Trusted CA keys: %#v\n", globals.FrontendConfig.GetTrustedCAKeys())
+	},
+}
@@ -1,15 +1,16 @@
 package fecmd
 
 import (
-	// "aether-core/aether/frontend/beapiconsumer"
+	"aether-core/aether/frontend/beapiconsumer"
 	"aether-core/aether/frontend/besupervisor"
 	// "aether-core/aether/frontend/clapiconsumer"
 	"aether-core/aether/frontend/feapiserver"
 	// "aether-core/aether/protos/clapi"
 	"aether-core/aether/frontend/festructs"
-	// "aether-core/aether/frontend/inflights"
+	"aether-core/aether/frontend/inflights"
 	"aether-core/aether/frontend/kvstore"
 	"aether-core/aether/frontend/search"
+	"aether-core/aether/io/api"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/ports"
@@ -43,14 +44,25 @@ var cmdRun = &cobra.Command{
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		EstablishConfigs(cmd)
+		// If the search index is lost, bring back the last automatic backup before the KV store gets rebuilt from scratch.
+		restored := kvstore.RestoreIfIndexLost()
 		// Start frontend kvstore
 		kvstore.OpenKVStore()
 		search.OpenIndex()
 		defer search.CloseIndex()
 		defer kvstore.CloseKVStore()
 		kvstore.CheckKVStoreReady()
+		if restored {
+			inflights.HoldPendingAfterRestore()
+		}
+		if globals.FrontendConfig.GetMetricsExporterEnabled() {
+			startMetricsExporter()
+		}
 		// Start notifications subsystem
 		festructs.InstantiateNotificationsSingleton()
+		festructs.InstantiateInboxSingleton()
+		// The boards' PoW requirements for the threads and posts we mint come from the backend.
+		api.BoardLookup = beapiconsumer.LookupBoard
 		// start frontend server
 		gotValidPort := make(chan bool)
 		go feapiserver.StartFrontendServer(gotValidPort)
@@ -146,4 +158,19 @@ func startSchedules() {
 		// Also - prune the notifications carrier while you're at it
 		festructs.NotificationsSingleton.Prune()
 	}, 1*time.Hour, time.Duration(0), nil)
+
+	// Compact the KV store if it needs it, then refresh the automatic backup, every day. The first one waits a while, so as to not slow down the start.
+	globals.FrontendTransientConfig.StopKvStoreMaintenanceCycle = scheduling.ScheduleRepeat(func() {
+		if kvstore.CompactionNeeded() {
+			// The compaction holds off the refresher itself, but it can't reach the inflights from where it is. The queue writes into the KV store at every step, so it's held off here.
+			err := inflights.GetInflights().Hold(kvstore.CompactKVStore)
+			if err != nil {
+				logging.Logf(1, "KV store compaction did not complete. Error: %v", err)
+			}
+		}
+		err := kvstore.BackupAutomatically()
+		if err != nil {
+			logging.Logf(1, "The automatic backup of the frontend failed. Error: %v", err)
+		}
+	}, 24*time.Hour, 1*time.Hour, nil)
 }
@@ -357,18 +357,19 @@ func (c *BoardCarrier) ConstructAmbientBoards(hasNewThreads bool, nowts int64) [
 =            Board Carrier query methods            =
 ===================================================*/
 
-// GetTopThreadsForView gets top threads up to the asked number, and filters out the blocked threads.
-func (c *BoardCarrier) GetTopThreadsForView(num int) *[]CompiledThread {
-	foundCount := 0
+// GetTopThreadsForView gets top threads in the given ranking up to the asked number, and filters out the blocked and the unranked threads.
+func (c *BoardCarrier) GetTopThreadsForView(num int, r ThreadRanker, nowts int64) *[]CompiledThread {
+	ranked := append(CThreadBatch{}, c.Threads...)
+	ranked.Rank(r, nowts)
 	foundThr := []CompiledThread{}
-	for k, _ := range c.Threads {
-		if foundCount > num {
+	for k, _ := range ranked {
+		if len(foundThr) >= num {
 			break
 		}
-		if c.Threads[k].CompiledContentSignals.ModBlocked {
+		if ranked[k].CompiledContentSignals.ModBlocked || ranked[k].Unranked {
 			continue
 		}
-		foundThr = append(foundThr, c.Threads[k])
+		foundThr = append(foundThr, ranked[k])
 	}
 	return &foundThr
 }
@@ -487,7 +488,11 @@ func (c *ThreadCarrier) refreshPosts(boardSpecificUserHeaders CUserBatch, bc *Bo
 	// b := CPostBatch(postsDelta)
 	// b.IndexForSearch()
 
-	NotificationsSingleton.InsertPosts(postsDelta)
+	thr := CompiledThread{Fingerprint: c.Fingerprint, Board: c.ParentFingerprint}
+	if i := c.Threads.Find(c.Fingerprint); i != -1 {
+		thr = c.Threads[i]
+	}
+	NotificationsSingleton.InsertPosts(postsDelta, thr)
 }
 
 func (c *ThreadCarrier) generateSignalsTablesForPostsInThread() {
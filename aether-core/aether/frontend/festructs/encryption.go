@@ -0,0 +1,140 @@
+// Frontend > FeStructs > Encryption
+// This file opens the encrypted content of threads and posts in encrypted boards at compile time, if the local user holds the board key.
+
+package festructs
+
+import (
+	"aether-core/aether/frontend/beapiconsumer"
+	pbstructs "aether-core/aether/protos/mimapi"
+	"aether-core/aether/services/encryption"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/metaparse"
+	"encoding/json"
+	"sync"
+)
+
+const (
+	LockedThreadName = "Encrypted thread"
+	LockedBody       = "This content is encrypted. You don't hold the key for this board."
+)
+
+// boardKeyring keeps the board keys that the local user could unwrap, so that we don't have to unwrap them again for every thread and post. It is filled in as boards get compiled, and on demand when a thread or post of a board we haven't seen yet comes in.
+var boardKeyring = boardKeyCache{boards: make(map[string]boardKeys)}
+
+type boardKeys struct {
+	lastUpdate int64
+	keys       map[string]*encryption.BoardKey // KeyId > key
+}
+
+type boardKeyCache struct {
+	lock   sync.Mutex
+	boards map[string]boardKeys
+}
+
+// learn unwraps whatever keys of the board the local user holds. Boards that aren't encrypted or that we hold no key for are remembered too, so that we don't keep asking the backend for them.
+func (c *boardKeyCache) learn(rp *pbstructs.Board) {
+	if rp == nil {
+		return
+	}
+	fp := rp.GetProvable().GetFingerprint()
+	lu := rp.GetUpdateable().GetLastUpdate()
+	c.lock.Lock()
+	existing, ok := c.boards[fp]
+	c.lock.Unlock()
+	if ok && existing.lastUpdate >= lu && lu != 0 {
+		return
+	}
+	bk := boardKeys{lastUpdate: lu, keys: make(map[string]*encryption.BoardKey)}
+	m, err := metaparse.ReadMeta("Board", rp.GetMeta())
+	if err != nil {
+		logging.Logf(1, "The meta of this board could not be parsed while looking for board keys. Board: %v, Error: %v", fp, err)
+	}
+	if bm, isBm := m.(*metaparse.BoardMeta); isBm && encryption.IsEncryptedBoard(bm) {
+		for k, _ := range bm.Keyrings {
+			key, err := encryption.FindBoardKey(bm, bm.Keyrings[k].KeyId, rp.GetOwnerPublicKey(), *globals.FrontendConfig.GetUserKeyPair())
+			if err != nil {
+				continue
+			}
+			bk.keys[bm.Keyrings[k].KeyId] = key
+		}
+	}
+	c.lock.Lock()
+	c.boards[fp] = bk
+	c.lock.Unlock()
+}
+
+func (c *boardKeyCache) find(boardfp, keyId string) *encryption.BoardKey {
+	c.lock.Lock()
+	bk, ok := c.boards[boardfp]
+	c.lock.Unlock()
+	if !ok {
+		boards := beapiconsumer.GetBoards(0, 0, []string{boardfp}, true, false)
+		if len(boards) == 0 {
+			return nil
+		}
+		c.learn(boards[0])
+		c.lock.Lock()
+		bk = c.boards[boardfp]
+		c.lock.Unlock()
+	}
+	return bk.keys[keyId]
+}
+
+// openEncrContent attempts to open the sealed content of an entity in the given board into the payload. Returns false if we don't hold the key, or the content is malformed.
+func openEncrContent(boardfp, sealed string, payload interface{}) bool {
+	keyId, err := encryption.SealedKeyId(sealed)
+	if err != nil {
+		logging.Logf(2, "This encrypted content is malformed. Board: %v, Error: %v", boardfp, err)
+		return false
+	}
+	key := boardKeyring.find(boardfp, keyId)
+	if key == nil {
+		return false
+	}
+	plain, err2 := encryption.Open(sealed, key)
+	if err2 != nil {
+		logging.Logf(1, "This encrypted content could not be opened with the board key that it claims to be sealed with. Board: %v, KeyId: %v, Error: %v", boardfp, keyId, err2)
+		return false
+	}
+	err3 := json.Unmarshal(plain, payload)
+	if err3 != nil {
+		logging.Logf(1, "The decrypted content is malformed. Board: %v, Error: %v", boardfp, err3)
+		return false
+	}
+	return true
+}
+
+// openEncrContent fills in the thread's name, body and link from its encrypted content, or the locked placeholders.
+func (c *CompiledThread) openEncrContent() {
+	if len(c.EncrContent) == 0 {
+		return
+	}
+	tc := encryption.ThreadContent{}
+	if !openEncrContent(c.Board, c.EncrContent, &tc) {
+		c.Locked = true
+		c.Name = LockedThreadName
+		c.Body = LockedBody
+		c.Link = ""
+		return
+	}
+	c.Locked = false
+	c.Name = tc.Name
+	c.Body = tc.Body
+	c.Link = tc.Link
+}
+
+// openEncrContent fills in the post's body from its encrypted content, or the locked placeholder.
+func (c *CompiledPost) openEncrContent() {
+	if len(c.EncrContent) == 0 {
+		return
+	}
+	pc := encryption.PostContent{}
+	if !openEncrContent(c.Board, c.EncrContent, &pc) {
+		c.Locked = true
+		c.Body = LockedBody
+		return
+	}
+	c.Locked = false
+	c.Body = pc.Body
+}
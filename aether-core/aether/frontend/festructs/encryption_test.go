@@ -0,0 +1,111 @@
+package festructs
+
+// These test that a member can open the threads of an encrypted board once the owner grants them the board key, and not before.
+
+import (
+	pbstructs "aether-core/aether/protos/mimapi"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/create"
+	"aether-core/aether/services/encryption"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/metaparse"
+	"aether-core/aether/services/signaturing"
+	"encoding/json"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+)
+
+// Infrastructure
+
+// actAs makes the given key the local user's.
+func actAs(key *ed25519.PrivateKey) {
+	globals.FrontendConfig = &configstore.FrontendConfig{
+		Initialised: true,
+		UserKeyPair: signaturing.MarshalPrivateKey(*key),
+	}
+}
+
+func newKey(t *testing.T) *ed25519.PrivateKey {
+	key, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("The key pair could not be created. Error: %v", err)
+	}
+	return key
+}
+
+func pk(key *ed25519.PrivateKey) string {
+	return signaturing.MarshalPublicKey(key.Public().(ed25519.PublicKey))
+}
+
+// sealThread seals the thread content with the newest key of the board, as the owner.
+func sealThread(t *testing.T, meta string, owner *ed25519.PrivateKey, tc encryption.ThreadContent) string {
+	m, err := metaparse.ReadMeta("Board", meta)
+	if err != nil {
+		t.Fatalf("The board meta could not be read. Error: %v", err)
+	}
+	key, err2 := encryption.FindBoardKey(m.(*metaparse.BoardMeta), "", pk(owner), *owner)
+	if err2 != nil {
+		t.Fatalf("The owner could not find the board key. Error: %v", err2)
+	}
+	plain, _ := json.Marshal(tc)
+	sealed, err3 := encryption.Seal(plain, key)
+	if err3 != nil {
+		t.Fatalf("The thread could not be sealed. Error: %v", err3)
+	}
+	return sealed
+}
+
+func board(fp, meta string, owner *ed25519.PrivateKey, lastUpdate int64) *pbstructs.Board {
+	return &pbstructs.Board{
+		Provable:       &pbstructs.Provable{Fingerprint: fp},
+		OwnerPublicKey: pk(owner),
+		Meta:           meta,
+		Updateable:     &pbstructs.Updateable{LastUpdate: lastUpdate},
+	}
+}
+
+// Tests
+
+func TestBoardKey_IssueGrantCompile_Success(t *testing.T) {
+	priorConfig := globals.FrontendConfig
+	defer func() { globals.FrontendConfig = priorConfig }()
+	owner, member := newKey(t), newKey(t)
+	// The owner encrypts the board, and posts a thread in it.
+	actAs(owner)
+	issued, err := create.IssueBoardKey("", []string{})
+	if err != nil {
+		t.Fatalf("The board key could not be issued. Error: %v", err)
+	}
+	sealed := sealThread(t, issued, owner, encryption.ThreadContent{Name: "secret name", Body: "secret body"})
+	// The member compiles the thread before they're granted the key. It stays locked.
+	actAs(member)
+	boardKeyring.learn(board("encrypted board", issued, owner, 1))
+	thread := CompiledThread{Board: "encrypted board", EncrContent: sealed}
+	thread.openEncrContent()
+	if !thread.Locked || thread.Name != LockedThreadName {
+		t.Errorf("A member without the board key should not be able to open the thread. Thread: %#v", thread)
+	}
+	// The owner grants the member the key. The thread sealed before the grant opens too.
+	actAs(owner)
+	granted, err2 := create.GrantBoardKey(issued, []string{pk(member)})
+	if err2 != nil {
+		t.Fatalf("The board key could not be granted. Error: %v", err2)
+	}
+	actAs(member)
+	boardKeyring.learn(board("encrypted board", granted, owner, 2))
+	thread = CompiledThread{Board: "encrypted board", EncrContent: sealed}
+	thread.openEncrContent()
+	if thread.Locked || thread.Name != "secret name" || thread.Body != "secret body" {
+		t.Errorf("The member should be able to open the thread after the grant. Thread: %#v", thread)
+	}
+}
+
+func TestBoardKey_GrantUnencrypted_Fail(t *testing.T) {
+	priorConfig := globals.FrontendConfig
+	defer func() { globals.FrontendConfig = priorConfig }()
+	owner, member := newKey(t), newKey(t)
+	actAs(owner)
+	if _, err := create.GrantBoardKey("", []string{pk(member)}); err == nil {
+		t.Errorf("Granting the key of a board that isn't encrypted should fail.")
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"aether-core/aether/services/ca"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/metaparse"
 	// "github.com/willf/bloom"
 	pbstructs "aether-core/aether/protos/mimapi"
-	"math"
 	"sort"
 	"sync"
 	"time"
@@ -63,7 +63,7 @@ func CommitSearchIndexes(wg *sync.WaitGroup) {
 	for batchIndex, _ := range bb {
 		ib := search.NewBatch()
 		for k, _ := range bb[batchIndex] {
-			ib.Index(bb[batchIndex][k].SearchId(), bb[batchIndex][k])
+			ib.Index(bb[batchIndex][k].SearchId(), bb[batchIndex][k].SearchDocument())
 		}
 		err := search.CommitBatch(ib)
 		if err != nil {
@@ -75,7 +75,7 @@ func CommitSearchIndexes(wg *sync.WaitGroup) {
 	for batchIndex, _ := range tb {
 		ib := search.NewBatch()
 		for k, _ := range tb[batchIndex] {
-			ib.Index(tb[batchIndex][k].SearchId(), tb[batchIndex][k])
+			ib.Index(tb[batchIndex][k].SearchId(), tb[batchIndex][k].SearchDocument())
 		}
 		err := search.CommitBatch(ib)
 		if err != nil {
@@ -87,7 +87,7 @@ func CommitSearchIndexes(wg *sync.WaitGroup) {
 	for batchIndex, _ := range pb {
 		ib := search.NewBatch()
 		for k, _ := range pb[batchIndex] {
-			ib.Index(pb[batchIndex][k].SearchId(), pb[batchIndex][k])
+			ib.Index(pb[batchIndex][k].SearchId(), pb[batchIndex][k].SearchDocument())
 		}
 		err := search.CommitBatch(ib)
 		if err != nil {
@@ -99,7 +99,7 @@ func CommitSearchIndexes(wg *sync.WaitGroup) {
 	for batchIndex, _ := range ub {
 		ib := search.NewBatch()
 		for k, _ := range ub[batchIndex] {
-			ib.Index(ub[batchIndex][k].SearchId(), ub[batchIndex][k])
+			ib.Index(ub[batchIndex][k].SearchId(), ub[batchIndex][k].SearchDocument())
 		}
 		err := search.CommitBatch(ib)
 		if err != nil {
@@ -115,6 +115,30 @@ func CommitSearchIndexes(wg *sync.WaitGroup) {
 	CUserIndexCache = CUserBatch{}
 }
 
+/*
+	Search documents are what goes into the search index for an entity: the entity itself, and its type, so that searches can be limited to a type in the index. The entity is embedded, so its fields are indexed at the same paths as if it was indexed by itself.
+*/
+
+type boardSearchDocument struct {
+	CompiledBoard
+	EntityType string
+}
+
+type threadSearchDocument struct {
+	CompiledThread
+	EntityType string
+}
+
+type postSearchDocument struct {
+	CompiledPost
+	EntityType string
+}
+
+type userSearchDocument struct {
+	CompiledUser
+	EntityType string
+}
+
 // Compiled types
 
 type CompiledPost struct {
@@ -129,6 +153,10 @@ type CompiledPost struct {
 	Creation               int64
 	LastUpdate             int64
 	Meta                   string
+	EncrContent            string
+	Locked                 bool // Encrypted, and we don't hold the board key.
+	/*----------  From the meta  ----------*/
+	ContentWarnings []string
 }
 
 // BleveType satisfies the bleve Classifier interface so that Bleve knows how to parse this to index for search.
@@ -136,6 +164,10 @@ func (c CompiledPost) BleveType() string {
 	return "post"
 }
 
+func (c CompiledPost) SearchDocument() postSearchDocument {
+	return postSearchDocument{c, c.BleveType()}
+}
+
 // SearchId gives the Id on which we'll save this entity. This has enough data to find what we want in a fast manner, and serve it to the user.
 func (c CompiledPost) SearchId() string {
 	// A path that defines a post is board, thread, parent, self fp. No user fp.
@@ -157,7 +189,7 @@ func (c *CompiledPost) DeleteFromSearchIndex() {
 }
 
 func NewCPost(rp *pbstructs.Post) CompiledPost {
-	return CompiledPost{
+	c := CompiledPost{
 		Fingerprint: rp.GetProvable().GetFingerprint(),
 		Board:       rp.GetBoard(),
 		Thread:      rp.GetThread(),
@@ -165,6 +197,7 @@ func NewCPost(rp *pbstructs.Post) CompiledPost {
 		SelfCreated: rp.GetOwnerPublicKey() == globals.FrontendConfig.GetMarshaledUserPublicKey(),
 		Body:        rp.GetBody(),
 		Meta:        rp.GetMeta(),
+		EncrContent: rp.GetEncrContent(),
 		// Half-baked ones
 		Owner: CompiledUser{
 			Fingerprint: rp.GetOwner(),
@@ -172,6 +205,9 @@ func NewCPost(rp *pbstructs.Post) CompiledPost {
 		Creation:   rp.GetProvable().GetCreation(),
 		LastUpdate: rp.GetUpdateable().GetLastUpdate(),
 	}
+	c.openEncrContent()
+	c.readMeta()
+	return c
 	// Needs: Compiledcontentsignals, owner, bymod, byop, blocked, approved flags
 }
 
@@ -215,6 +251,10 @@ func (c *CompiledPost) Insert(ce CompiledPost) {
 }
 
 func (c *CompiledPost) Refresh(catds *CATDBatch, cfgs *CFGBatch, cmas *CMABatch, boardSpecificUserHeaders CUserBatch, nowts int64, bc *BoardCarrier, tc *ThreadCarrier) {
+	if c.Locked {
+		// We might have been given the board key since.
+		c.openEncrContent()
+	}
 	c.RefreshUserHeader(boardSpecificUserHeaders)
 	c.RefreshContentSignals(catds, cfgs, cmas, nowts)
 	c.RefreshExogenousContentSignals(bc, tc)
@@ -434,13 +474,24 @@ type CompiledThread struct {
 	Meta                   string
 	PostsCount             int
 	Score                  float64
+	Unranked               bool // Left out by the ranking, e.g. older than the window of a top ranking.
 	ViewMeta_BoardName     string
+	EncrContent            string
+	Locked                 bool // Encrypted, and we don't hold the board key.
+	/*----------  From the meta  ----------*/
+	FlairId         string
+	Flair           metaparse.BoardFlair // Resolved from the board's flairs, empty if the board doesn't define it.
+	ContentWarnings []string
 }
 
 func (c CompiledThread) BleveType() string {
 	return "thread"
 }
 
+func (c CompiledThread) SearchDocument() threadSearchDocument {
+	return threadSearchDocument{c, c.BleveType()}
+}
+
 func (c CompiledThread) SearchId() string {
 	// A path that defines a thread is board, threadfp. No user fp.
 	bid, err := search.MakeSearchId("Thread", c.Board, "", "", c.Fingerprint, "")
@@ -461,7 +512,7 @@ func (c *CompiledThread) DeleteFromSearchIndex() {
 }
 
 func NewCThread(rp *pbstructs.Thread) CompiledThread {
-	return CompiledThread{
+	c := CompiledThread{
 		Fingerprint: rp.GetProvable().GetFingerprint(),
 		Board:       rp.GetBoard(),
 		SelfCreated: rp.GetOwnerPublicKey() == globals.FrontendConfig.GetMarshaledUserPublicKey(),
@@ -469,6 +520,7 @@ func NewCThread(rp *pbstructs.Thread) CompiledThread {
 		Body:        rp.GetBody(),
 		Link:        rp.GetLink(),
 		Meta:        rp.GetMeta(),
+		EncrContent: rp.GetEncrContent(),
 		// Half-baked ones
 		Owner: CompiledUser{
 			Fingerprint: rp.GetOwner(),
@@ -476,6 +528,9 @@ func NewCThread(rp *pbstructs.Thread) CompiledThread {
 		Creation:   rp.GetProvable().GetCreation(),
 		LastUpdate: rp.GetUpdateable().GetLastUpdate(),
 	}
+	c.openEncrContent()
+	c.readMeta()
+	return c
 	// Needs: Compiledcontentsignals, owner, bymod, byop, blocked, approved flags
 }
 
@@ -519,29 +574,23 @@ func (c *CompiledThread) Insert(ce CompiledThread) {
 	}
 }
 
-// CalcScore calculates the rank score for this thread.
-func (c *CompiledThread) CalcScore() {
-	// We need: upvotes, downvotes, current timestamp, creation
-	voteScore := c.CompiledContentSignals.Upvotes - c.CompiledContentSignals.Downvotes
-	orderOfMagnitude := math.Log10(math.Max(1, math.Abs(float64(voteScore))))
-	sign := 0
-	if voteScore > 0 {
-		sign = 1
-	}
-	if voteScore < 0 {
-		sign = -1
-	}
-	sec := c.Creation - 1533081600 // > Here we go again, Gordon Freeman
-	score := (float64(sign) * orderOfMagnitude) + (float64(sec) / 42300)
-	// > Approximate half life of Sodium-24
+// CalcScore calculates the rank score for this thread with the given ranking.
+func (c *CompiledThread) CalcScore(r ThreadRanker, nowts int64) {
+	score, ranked := r.Rank(c, nowts)
 	c.Score = score
+	c.Unranked = !ranked
 }
 
 func (c *CompiledThread) Refresh(catds *CATDBatch, cfgs *CFGBatch, cmas *CMABatch, boardSpecificUserHeaders CUserBatch, nowts int64, bc *BoardCarrier) {
+	if c.Locked {
+		// We might have been given the board key since.
+		c.openEncrContent()
+	}
 	c.RefreshUserHeader(boardSpecificUserHeaders)
 	c.RefreshContentSignals(catds, cfgs, cmas, nowts)
 	c.RefreshExogenousContentSignals(bc)
-	c.CalcScore()
+	c.resolveFlair(bc)
+	c.CalcScore(BoardRanker(c.Board), nowts)
 }
 
 // RefreshExogenousContentSignals is where we compile and calculate the content signals that depend on external entitites.
@@ -697,13 +746,24 @@ func (batch *CThreadBatch) Refresh(catds *CATDBatch, cfgs *CFGBatch, cmas *CMABa
 	}
 }
 
-// Sort sorts the threads in the batch according to their score.
+// Sort sorts the threads in the batch according to their score. The unranked threads go to the end.
 func (batch *CThreadBatch) SortByScore() {
-	sort.Slice((*batch), func(i, j int) bool {
+	sort.SliceStable((*batch), func(i, j int) bool {
+		if (*batch)[i].Unranked != (*batch)[j].Unranked {
+			return !(*batch)[i].Unranked
+		}
 		return (*batch)[i].Score > (*batch)[j].Score
 	})
 }
 
+// Rank scores the threads in the batch with the given ranking, and sorts them.
+func (batch *CThreadBatch) Rank(r ThreadRanker, nowts int64) {
+	for k, _ := range *batch {
+		(*batch)[k].CalcScore(r, nowts)
+	}
+	batch.SortByScore()
+}
+
 func (batch *CThreadBatch) SortByCreation() {
 	sort.Slice((*batch), func(i, j int) bool {
 		return (*batch)[i].Creation > (*batch)[j].Creation
@@ -730,12 +790,19 @@ type CompiledUser struct {
 	LastRefreshed       int64
 	Meta                string
 	CompiledUserSignals CompiledUserSignals
+	/*----------  From the meta  ----------*/
+	AvatarHash string
+	Links      []metaparse.ProfileLink
 }
 
 func (c CompiledUser) BleveType() string {
 	return "user"
 }
 
+func (c CompiledUser) SearchDocument() userSearchDocument {
+	return userSearchDocument{c, c.BleveType()}
+}
+
 func (c CompiledUser) SearchId() string {
 	// A path that defines a board is fingerprint. no board, thread, post, parent, userfp.
 	bid, err := search.MakeSearchId("User", "", "", "", c.Fingerprint, "")
@@ -756,7 +823,7 @@ func (c *CompiledUser) DeleteFromSearchIndex() {
 }
 
 func NewCUser(u *pbstructs.Key, nowts int64) CompiledUser {
-	return CompiledUser{
+	c := CompiledUser{
 		Fingerprint:      u.GetProvable().GetFingerprint(),
 		NonCanonicalName: u.GetName(),
 		Info:             u.GetInfo(),
@@ -766,6 +833,8 @@ func NewCUser(u *pbstructs.Key, nowts int64) CompiledUser {
 		Meta:             u.GetMeta(),
 		LastRefreshed:    nowts,
 	}
+	c.readMeta()
+	return c
 	// needs: compiledusersignals
 }
 
@@ -926,12 +995,20 @@ type CompiledBoard struct {
 	// ^ This carries entitlements specific to this specific board for users. If a person is a mod of this board, this is where his or her modship flag gets stored in.
 	ThreadsCount int
 	UserCount    int
+	/*----------  From the meta  ----------*/
+	Rules    string
+	Flairs   []metaparse.BoardFlair
+	IconHash string
 }
 
 func (c CompiledBoard) BleveType() string {
 	return "board"
 }
 
+func (c CompiledBoard) SearchDocument() boardSearchDocument {
+	return boardSearchDocument{c, c.BleveType()}
+}
+
 func (c CompiledBoard) SearchId() string {
 	// A path that defines a board is fingerprint. no board, thread, post, parent, userfp.
 	bid, err := search.MakeSearchId("Board", "", "", "", c.Fingerprint, "")
@@ -952,6 +1029,7 @@ func (c *CompiledBoard) DeleteFromSearchIndex() {
 }
 
 func NewCBoard(rp *pbstructs.Board) CompiledBoard {
+	boardKeyring.learn(rp)
 	cb := CompiledBoard{
 		Fingerprint: rp.GetProvable().GetFingerprint(),
 		SelfCreated: rp.GetOwnerPublicKey() == globals.FrontendConfig.GetMarshaledUserPublicKey(),
@@ -970,6 +1048,7 @@ func NewCBoard(rp *pbstructs.Board) CompiledBoard {
 			cb.BoardOwners = append(cb.BoardOwners, bo[k].GetKeyFingerprint())
 		}
 	}
+	cb.readMeta()
 	return cb
 	// Needs: Compiledcontentsignals, owner, bymod, byop, blocked, approved flags
 }
@@ -1454,7 +1533,7 @@ func parseFollowerCount(targetfp string, cpt CompiledPT) int {
 
 func isF451Mod(targetfp string, cf451 CompiledF451) bool {
 	for k, _ := range cf451.F451s {
-		if cf451.F451s[k].TargetFingerprint == targetfp && ca.IsTrustedCAKeyByFp(cf451.F451s[k].SourceFingerprint) {
+		if cf451.F451s[k].TargetFingerprint == targetfp && ca.IsTrustedCAKeyByFpAt(cf451.F451s[k].SourceFingerprint, cf451.F451s[k].Creation) {
 			return true
 		}
 	}
@@ -1475,8 +1554,8 @@ func parseCanonicalName(ccn CompiledCN) (cname, cnamesource string) {
 	highestPrioritySoFar := 0
 	highestPrioritySet := false
 	for k, _ := range ccn.CNs {
-		isCaKey, priority := ca.IsTrustedCAKeyByFpWithPriority(
-			ccn.CNs[k].SourceFingerprint)
+		isCaKey, priority := ca.IsTrustedCAKeyByFpWithPriorityAt(
+			ccn.CNs[k].SourceFingerprint, ccn.CNs[k].Creation)
 		if isCaKey {
 			if !highestPrioritySet {
 				highestPrioritySet = true
@@ -1513,6 +1592,8 @@ type CompiledContentSignals struct {
 	SelfATDFingerprint string
 	SelfATDCreation    int64
 	SelfATDLastUpdate  int64
+	RecentUpvotes      float64 // Decayed, as of RecentUpvotesAt. See CompiledATD.
+	RecentUpvotesAt    int64
 	// ^ In aggregate types such as ATDs, we have to carry over the creation, lastupdate and fingerprint to the client, because the client needs those information to be able to edit the signal. In other types we carry those information in the signal entity itself, since they are not aggregated, the client can figure out what to do based on determining which one is self.
 	// FG
 	Reports      []ExplainedSignal
@@ -1554,6 +1635,8 @@ func (s *CompiledContentSignals) Insert(
 		catd := (*catds)[i]
 		s.Upvotes = catd.UpvotesCount
 		s.Downvotes = catd.DownvotesCount
+		s.RecentUpvotes = catd.RecentUpvotes
+		s.RecentUpvotesAt = catd.RecentUpvotesAt
 		if catd.SelfVoted {
 			if catd.SelfVoteDirection == Signal_Upvote {
 				s.SelfUpvoted = true
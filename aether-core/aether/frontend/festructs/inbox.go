@@ -0,0 +1,244 @@
+// Frontend > FEStructs > Inbox
+// This file compiles the direct messages of the local user into conversations, one per user it talks to. The messages are opened here, so this is the only place the plaintext of a direct message lives outside of the client.
+
+package festructs
+
+import (
+	"aether-core/aether/frontend/beapiconsumer"
+	pbstructs "aether-core/aether/protos/mimapi"
+	"aether-core/aether/services/encryption"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+  The backend keeps the direct messages as it received them, encrypted. At every refresh, we ask it for the ones sent to the local user and the ones sent by it since the last time we asked, open them, and file them under the conversation with the other end. The messages we receive also raise a notification.
+
+  If the local user changes, the inbox of the prior one is thrown away, the new user can't open those messages anyway.
+
+  A message carries the public key of its sender, but what it's filed and shown under is the fingerprint of the sender. So we open it with the key we know that fingerprint by, not with the one it carries: otherwise, anyone could send a message under someone else's fingerprint with their own key. A message whose key isn't the one we know, or whose sender's key we don't have, is kept as unreadable.
+*/
+
+const (
+	UndecryptableDirectMessageBody = "This message could not be decrypted."
+	UnverifiableDirectMessageBody  = "This message could not be verified to come from its sender."
+)
+
+type InboxCarrier struct {
+	lock           sync.Mutex
+	instantiated   bool
+	Id             int    `storm:"id"` // always 1, this is a singleton.
+	LocalUser      string // The user the inbox belongs to.
+	LastReferenced int64
+	Conversations  map[string]Conversation // [Peer fingerprint]Conversation
+}
+
+var InboxSingleton InboxCarrier
+
+type Conversation struct {
+	Peer         string
+	PeerUsername CUserUsername
+	Messages     []CompiledDirectMessage // Oldest first.
+	LastUpdate   int64
+	Unread       int
+}
+
+type CompiledDirectMessage struct {
+	Fingerprint string
+	Owner       string
+	Recipient   string
+	Body        string
+	Creation    int64
+	SelfCreated bool
+	Unreadable  bool
+}
+
+/*----------  Instantiation  ----------*/
+
+func InstantiateInboxSingleton() {
+	if InboxSingleton.instantiated {
+		return
+	}
+	logging.Logf(3, "Single read happens in InstantiateInboxSingleton>One")
+	err := globals.KvInstance.One("Id", 1, &InboxSingleton)
+	if err != nil {
+		logging.Logf(1, "Fetching data from KvStore of instantiating the InboxCarrier had an error. Error: %v", err)
+		InboxSingleton = NewInboxCarrier("")
+	}
+	InboxSingleton.instantiated = true
+}
+
+func NewInboxCarrier(localUserFp string) InboxCarrier {
+	return InboxCarrier{
+		Id:            1,
+		LocalUser:     localUserFp,
+		Conversations: make(map[string]Conversation),
+	}
+}
+
+func (ic *InboxCarrier) save() {
+	logging.Logf(3, "Save happens in InboxCarrier>Save")
+	globals.KvInstance.Save(ic)
+}
+
+func (ic *InboxCarrier) Save() {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	ic.save()
+}
+
+/*----------  Refresh  ----------*/
+
+// Refresh pulls the direct messages that came in since the last refresh into the inbox.
+func (ic *InboxCarrier) Refresh(localUserFp string, nowts int64) {
+	if len(localUserFp) == 0 {
+		return
+	}
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	if ic.LocalUser != localUserFp {
+		*ic = NewInboxCarrier(localUserFp)
+		ic.instantiated = true
+	}
+	received := beapiconsumer.GetDirectMessages(ic.LastReferenced, nowts, localUserFp, "")
+	sent := beapiconsumer.GetDirectMessages(ic.LastReferenced, nowts, "", localUserFp)
+	ownPriv := *globals.FrontendConfig.GetUserKeyPair()
+	ownPk := globals.FrontendConfig.GetMarshaledUserPublicKey()
+	peerPks := make(map[string]string) // [Peer fingerprint]Public key
+	pkOf := func(peer string) string {
+		if _, ok := peerPks[peer]; !ok {
+			peerPks[peer] = peerPk(peer)
+		}
+		return peerPks[peer]
+	}
+	for k, _ := range received {
+		peer := received[k].GetOwner()
+		cdm := openReceivedDirectMessage(received[k], pkOf(peer), ownPriv)
+		cdm.SelfCreated = received[k].GetOwnerPublicKey() == ownPk
+		if ic.insert(peer, cdm) && !cdm.SelfCreated {
+			NotificationsSingleton.InsertDirectMessage(cdm, ic.Conversations[peer].PeerUsername)
+		}
+	}
+	for k, _ := range sent {
+		peer := sent[k].GetRecipient()
+		cdm := openDirectMessage(sent[k], pkOf(peer), ownPriv)
+		cdm.SelfCreated = sent[k].GetOwnerPublicKey() == ownPk
+		ic.insert(peer, cdm)
+	}
+	ic.LastReferenced = nowts
+}
+
+// insert files the message under the conversation with the peer. Returns false if we have it already.
+func (ic *InboxCarrier) insert(peer string, cdm CompiledDirectMessage) bool {
+	conv := ic.Conversations[peer]
+	for k, _ := range conv.Messages {
+		if conv.Messages[k].Fingerprint == cdm.Fingerprint {
+			return false
+		}
+	}
+	conv.Peer = peer
+	conv.PeerUsername = peerUsername(peer)
+	conv.Messages = append(conv.Messages, cdm)
+	sort.SliceStable(conv.Messages, func(i, j int) bool {
+		return conv.Messages[i].Creation < conv.Messages[j].Creation
+	})
+	conv.LastUpdate = time.Now().Unix()
+	if !cdm.SelfCreated {
+		conv.Unread++
+	}
+	ic.Conversations[peer] = conv
+	return true
+}
+
+// MarkRead marks the conversation with the peer read.
+func (ic *InboxCarrier) MarkRead(peer string) {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	conv, ok := ic.Conversations[peer]
+	if !ok {
+		return
+	}
+	conv.Unread = 0
+	ic.Conversations[peer] = conv
+}
+
+// Listify returns the conversations, the most recently active first.
+func (ic *InboxCarrier) Listify() []Conversation {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	convs := []Conversation{}
+	for k, _ := range ic.Conversations {
+		convs = append(convs, ic.Conversations[k])
+	}
+	sort.Slice(convs, func(i, j int) bool {
+		if convs[i].LastUpdate != convs[j].LastUpdate {
+			return convs[i].LastUpdate > convs[j].LastUpdate
+		}
+		return convs[i].Peer < convs[j].Peer
+	})
+	return convs
+}
+
+/*----------  Helpers  ----------*/
+
+func newCompiledDirectMessage(dm *pbstructs.DirectMessage) CompiledDirectMessage {
+	return CompiledDirectMessage{
+		Fingerprint: dm.GetProvable().GetFingerprint(),
+		Owner:       dm.GetOwner(),
+		Recipient:   dm.GetRecipient(),
+		Creation:    dm.GetProvable().GetCreation(),
+	}
+}
+
+// openReceivedDirectMessage opens a message sent to us with the key we know its sender by. See the top of the file for why.
+func openReceivedDirectMessage(dm *pbstructs.DirectMessage, senderPk string, ownPriv []byte) CompiledDirectMessage {
+	if len(senderPk) == 0 || senderPk != dm.GetOwnerPublicKey() {
+		cdm := newCompiledDirectMessage(dm)
+		logging.Logf(1, "A direct message could not be verified to come from its sender. Fingerprint: %v, Sender: %v, Sender key known: %v", cdm.Fingerprint, cdm.Owner, len(senderPk) > 0)
+		cdm.Body = UnverifiableDirectMessageBody
+		cdm.Unreadable = true
+		return cdm
+	}
+	return openDirectMessage(dm, senderPk, ownPriv)
+}
+
+// openDirectMessage opens the message with the public key of the other end: the sender's for the ones we receive, the recipient's for the ones we send.
+func openDirectMessage(dm *pbstructs.DirectMessage, pk string, ownPriv []byte) CompiledDirectMessage {
+	cdm := newCompiledDirectMessage(dm)
+	plaintext, err := encryption.OpenDirectMessage(dm.GetBody(), pk, ownPriv)
+	if err != nil {
+		logging.Logf(1, "A direct message could not be opened. Fingerprint: %v, Error: %v", cdm.Fingerprint, err)
+		cdm.Body = UndecryptableDirectMessageBody
+		cdm.Unreadable = true
+		return cdm
+	}
+	cdm.Body = string(plaintext)
+	return cdm
+}
+
+// peerPk returns the public key of the user. Empty if we don't have its key.
+func peerPk(fp string) string {
+	ks := beapiconsumer.GetKeys(0, 0, []string{fp}, true, false)
+	if len(ks) == 0 {
+		return ""
+	}
+	return ks[0].GetKey()
+}
+
+// peerUsername returns the username of the user from its compiled header. If it's not compiled yet, the fingerprint stands in.
+func peerUsername(fp string) CUserUsername {
+	un := CUserUsername{SourceCUser: fp}
+	uhc := UserHeaderCarrier{}
+	logging.Logf(3, "Single read happens in peerUsername>One")
+	err := globals.KvInstance.One("Fingerprint", fp, &uhc)
+	if err != nil {
+		return un
+	}
+	if i := uhc.Users.Find(fp); i != -1 {
+		return uhc.Users[i].GetUsername()
+	}
+	return un
+}
@@ -0,0 +1,86 @@
+package festructs
+
+// These test opening the direct messages, and that a message is only read as coming from the sender it names if it carries that sender's key.
+
+import (
+	pbstructs "aether-core/aether/protos/mimapi"
+	"aether-core/aether/services/encryption"
+	"aether-core/aether/services/signaturing"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+)
+
+// Infrastructure
+
+type dmUser struct {
+	priv ed25519.PrivateKey
+	pk   string
+}
+
+func newDmUser(t *testing.T) dmUser {
+	priv, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("The key pair could not be created. Error: %v", err)
+	}
+	return dmUser{priv: *priv, pk: signaturing.MarshalPublicKey(priv.Public().(ed25519.PublicKey))}
+}
+
+// sealedDm is a message sealed by the sealer to the recipient, which names the given owner and carries the given owner key.
+func sealedDm(t *testing.T, sealer, recipient dmUser, owner, ownerPk, body string) *pbstructs.DirectMessage {
+	sealed, err := encryption.SealDirectMessage([]byte(body), sealer.priv, recipient.pk)
+	if err != nil {
+		t.Fatalf("The message could not be sealed. Error: %v", err)
+	}
+	return &pbstructs.DirectMessage{
+		Provable:       &pbstructs.Provable{Fingerprint: "dm " + body, Creation: 5},
+		Owner:          owner,
+		OwnerPublicKey: ownerPk,
+		Recipient:      "me",
+		Body:           sealed,
+	}
+}
+
+// Tests
+
+func TestOpenReceivedDirectMessage_Success(t *testing.T) {
+	me, alice := newDmUser(t), newDmUser(t)
+	dm := sealedDm(t, alice, me, "alice", alice.pk, "hi")
+	cdm := openReceivedDirectMessage(dm, alice.pk, me.priv)
+	if cdm.Unreadable || cdm.Body != "hi" {
+		t.Errorf("The message should have been opened. Got: %#v", cdm)
+	}
+	if cdm.Fingerprint != "dm hi" || cdm.Owner != "alice" || cdm.Recipient != "me" || cdm.Creation != 5 {
+		t.Errorf("The message header should have been carried over. Got: %#v", cdm)
+	}
+}
+
+func TestOpenReceivedDirectMessage_Fail(t *testing.T) {
+	me, alice, mallory := newDmUser(t), newDmUser(t), newDmUser(t)
+	cases := []struct {
+		name     string
+		dm       *pbstructs.DirectMessage
+		knownPk  string
+		expected string
+	}{
+		// Mallory sends a message that names Alice as its sender, with Mallory's own key.
+		{"someone else's key", sealedDm(t, mallory, me, "alice", mallory.pk, "spoofed"), alice.pk, UnverifiableDirectMessageBody},
+		{"sender's key not known", sealedDm(t, alice, me, "alice", alice.pk, "unknown sender"), "", UnverifiableDirectMessageBody},
+		// The key is right, but Mallory sealed it.
+		{"sealed by someone else", sealedDm(t, mallory, me, "alice", alice.pk, "resealed"), alice.pk, UndecryptableDirectMessageBody},
+	}
+	for _, c := range cases {
+		cdm := openReceivedDirectMessage(c.dm, c.knownPk, me.priv)
+		if !cdm.Unreadable || cdm.Body != c.expected {
+			t.Errorf("The message should have been kept as unreadable. Case: %v, Expected: %q, Got: %#v", c.name, c.expected, cdm)
+		}
+	}
+}
+
+func TestOpenDirectMessage_Sent_Success(t *testing.T) {
+	me, alice := newDmUser(t), newDmUser(t)
+	// The messages we send are opened with the recipient's key.
+	dm := sealedDm(t, me, alice, "me", me.pk, "hello alice")
+	if cdm := openDirectMessage(dm, alice.pk, me.priv); cdm.Unreadable || cdm.Body != "hello alice" {
+		t.Errorf("Our own message should have been opened. Got: %#v", cdm)
+	}
+}
@@ -0,0 +1,74 @@
+// Frontend > FeStructs > Meta
+// This file reads the structured meta of the raw entities into their compiled counterparts.
+
+package festructs
+
+import (
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/metaparse"
+)
+
+// readMeta parses the meta of the given entity type. Meta that we can't parse is treated as empty: this is what the entities created before the meta schemas might have.
+func readMeta(entityType, fp, meta string) metaparse.MetaStruct {
+	m, err := metaparse.ReadMeta(entityType, meta)
+	if err != nil {
+		logging.Logf(2, "The meta of this entity could not be parsed, ignoring it. Type: %v, Fingerprint: %v, Error: %v", entityType, fp, err)
+		return nil
+	}
+	return m
+}
+
+func (c *CompiledBoard) readMeta() {
+	bm, ok := readMeta("Board", c.Fingerprint, c.Meta).(*metaparse.BoardMeta)
+	if !ok {
+		return
+	}
+	c.Rules = bm.Rules
+	c.Flairs = bm.Flairs
+	c.IconHash = bm.IconHash
+}
+
+func (c *CompiledThread) readMeta() {
+	tm, ok := readMeta("Thread", c.Fingerprint, c.Meta).(*metaparse.ThreadMeta)
+	if !ok {
+		return
+	}
+	c.FlairId = tm.Flair
+	c.ContentWarnings = tm.ContentWarnings
+}
+
+func (c *CompiledPost) readMeta() {
+	pm, ok := readMeta("Post", c.Fingerprint, c.Meta).(*metaparse.PostMeta)
+	if !ok {
+		return
+	}
+	c.ContentWarnings = pm.ContentWarnings
+}
+
+func (c *CompiledUser) readMeta() {
+	km, ok := readMeta("Key", c.Fingerprint, c.Meta).(*metaparse.KeyMeta)
+	if !ok {
+		return
+	}
+	c.AvatarHash = km.AvatarHash
+	c.Links = km.Links
+}
+
+// resolveFlair finds the flair the thread refers to among the flairs its board defines. The board can remove or change a flair after the thread picks it, so this runs at every refresh, not only when the thread comes in.
+func (c *CompiledThread) resolveFlair(bc *BoardCarrier) {
+	c.Flair = metaparse.BoardFlair{}
+	if len(c.FlairId) == 0 {
+		return
+	}
+	for k, _ := range bc.Boards {
+		if bc.Boards[k].Fingerprint != bc.Fingerprint {
+			continue
+		}
+		for j, _ := range bc.Boards[k].Flairs {
+			if bc.Boards[k].Flairs[j].Id == c.FlairId {
+				c.Flair = bc.Boards[k].Flairs[j]
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package festructs
+
+// These test that the structured meta of the raw entities ends up in the compiled entities.
+
+import (
+	"aether-core/aether/services/metaparse"
+	"reflect"
+	"testing"
+)
+
+// Infrastructure
+
+func flairBoardCarrier(boardfp string, flairs ...metaparse.BoardFlair) *BoardCarrier {
+	bc := BoardCarrier{}
+	bc.Fingerprint = boardfp
+	// Another board, with a flair of the same id, which shouldn't be picked up.
+	bc.Boards = append(bc.Boards, CompiledBoard{Fingerprint: "other board", Flairs: []metaparse.BoardFlair{{Id: "q", Name: "Elsewhere"}}})
+	bc.Boards = append(bc.Boards, CompiledBoard{Fingerprint: boardfp, Flairs: flairs})
+	return &bc
+}
+
+// Tests
+
+func TestReadMeta_Board_Success(t *testing.T) {
+	c := CompiledBoard{Meta: `{"v":1,"rules":"be nice","flairs":[{"id":"q","name":"Question"}],"icon_hash":"abc"}`}
+	c.readMeta()
+	if c.Rules != "be nice" || c.IconHash != "abc" || !reflect.DeepEqual(c.Flairs, []metaparse.BoardFlair{{Id: "q", Name: "Question"}}) {
+		t.Errorf("The board meta was not compiled in. Board: %#v", c)
+	}
+}
+
+func TestReadMeta_Content_Success(t *testing.T) {
+	th := CompiledThread{Meta: `{"v":1,"flair":"q","cw":["nsfw"]}`}
+	th.readMeta()
+	if th.FlairId != "q" || !reflect.DeepEqual(th.ContentWarnings, []string{"nsfw"}) {
+		t.Errorf("The thread meta was not compiled in. Thread: %#v", th)
+	}
+	p := CompiledPost{Meta: `{"v":1,"cw":["spoilers"]}`}
+	p.readMeta()
+	if !reflect.DeepEqual(p.ContentWarnings, []string{"spoilers"}) {
+		t.Errorf("The post meta was not compiled in. Post: %#v", p)
+	}
+	u := CompiledUser{Meta: `{"v":1,"avatar_hash":"def","links":[{"label":"Site","url":"https://example.com"}]}`}
+	u.readMeta()
+	if u.AvatarHash != "def" || !reflect.DeepEqual(u.Links, []metaparse.ProfileLink{{Label: "Site", Url: "https://example.com"}}) {
+		t.Errorf("The key meta was not compiled in. User: %#v", u)
+	}
+}
+
+func TestReadMeta_Unparseable_Success(t *testing.T) {
+	// Meta from before the schemas is ignored, and doesn't stand in the way of the rest of the entity.
+	th := CompiledThread{Name: "thread name", Meta: "some text"}
+	th.readMeta()
+	if th.FlairId != "" || th.ContentWarnings != nil || th.Name != "thread name" {
+		t.Errorf("Meta that can't be parsed should be treated as empty. Thread: %#v", th)
+	}
+}
+
+func TestResolveFlair_Success(t *testing.T) {
+	question := metaparse.BoardFlair{Id: "q", Name: "Question", Colour: "#00ff00"}
+	cases := []struct {
+		name     string
+		flairId  string
+		bc       *BoardCarrier
+		expected metaparse.BoardFlair
+	}{
+		{"defined", "q", flairBoardCarrier("board", question), question},
+		{"not defined by the board", "x", flairBoardCarrier("board", question), metaparse.BoardFlair{}},
+		{"removed by the board", "q", flairBoardCarrier("board"), metaparse.BoardFlair{}},
+		{"no flair", "", flairBoardCarrier("board", question), metaparse.BoardFlair{}},
+	}
+	for _, c := range cases {
+		th := CompiledThread{FlairId: c.flairId}
+		// As if it was resolved at a prior refresh, which the board might have changed since.
+		th.Flair = metaparse.BoardFlair{Id: "stale", Name: "Stale"}
+		th.resolveFlair(c.bc)
+		if th.Flair != c.expected {
+			t.Errorf("The flair was resolved wrong. Case: %v, Expected: %#v, Got: %#v", c.name, c.expected, th.Flair)
+		}
+	}
+}
@@ -7,16 +7,24 @@ import (
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 /*
   The operating principle here is that we have a notifications container for each of the entities that are self created. This is nice, because these containers are automatically created.
 
   When we are compiling the posts, we get the delta, and we stick that delta into the notifications system. This system gets the self posts, creates the buckets for it, and of the stuff that ends up being actually responses, puts them into the appropriate buckets.
+
+  Posts that aren't responses to self can still raise a notification, if they mention the local user (@ and the canonical name or the fingerprint), if they're in a thread the user follows, or if they contain one of the keywords in the config and they're in a board the user is subscribed to. These go into containers of their own, keyed by the kind and the thread, e.g. "mention:<threadfp>", and the container carries its type. A post raises at most one notification, the first of: reply to self post, reply to self thread, mention, followed thread, keyword.
+
+  Direct messages sent to the local user go into a container per sender, "dm:<senderfp>". The messages take the place of the response posts in the buckets.
 */
 
 type NotificationsCarrier struct {
@@ -35,6 +43,8 @@ type NotificationsContainer struct {
 	LastUpdate           int64
 	Muted                bool
 	NotificationsBuckets []NotificationsBucket
+	Type                 int    // 0 for the containers of self entities, where the type is a reply to the thread or the post in it.
+	Keyword              string // Only for KEYWORD_IN_SUBSCRIBED_BOARD.
 }
 
 type NotificationsBucket struct {
@@ -53,9 +63,13 @@ func NewNotificationsBucket(now int64) NotificationsBucket {
 }
 
 func (c *NotificationsContainer) Insert(ce CompiledPost, now int64) {
+	c.insert(ce.Fingerprint, ce.Owner.GetUsername(), now)
+}
+
+func (c *NotificationsContainer) insert(fp string, postUsernameStruct CUserUsername, now int64) {
 	// Check if post exists anywhere. We might have raised a notification for it already.
 	for k, _ := range c.NotificationsBuckets {
-		if c.NotificationsBuckets[k].ResponsePosts[ce.Fingerprint] != 0 {
+		if c.NotificationsBuckets[k].ResponsePosts[fp] != 0 {
 			return
 		}
 	}
@@ -73,18 +87,17 @@ func (c *NotificationsContainer) Insert(ce CompiledPost, now int64) {
 			latestNonReadNBIndex = k
 		}
 	}
-	postUsernameStruct := ce.Owner.GetUsername()
 	if latestNonReadNBIndex != -1 {
 		// We have a not-yet-read notification bucket we can insert into
 		c.NotificationsBuckets[latestNonReadNBIndex].LastUpdate = now
-		c.NotificationsBuckets[latestNonReadNBIndex].ResponsePosts[ce.Fingerprint] = now
-		c.NotificationsBuckets[latestNonReadNBIndex].ResponsePostsUsers[ce.Fingerprint] = postUsernameStruct
+		c.NotificationsBuckets[latestNonReadNBIndex].ResponsePosts[fp] = now
+		c.NotificationsBuckets[latestNonReadNBIndex].ResponsePostsUsers[fp] = postUsernameStruct
 		return
 	}
 	// We have no notification bucket to house this. Create a new one.
 	nb := NewNotificationsBucket(now)
-	nb.ResponsePosts[ce.Fingerprint] = now
-	nb.ResponsePostsUsers[ce.Fingerprint] = postUsernameStruct
+	nb.ResponsePosts[fp] = now
+	nb.ResponsePostsUsers[fp] = postUsernameStruct
 	c.NotificationsBuckets = append(c.NotificationsBuckets, nb)
 }
 
@@ -145,6 +158,7 @@ func (nc *NotificationsCarrier) MarkSeen() {
 	nc.LastSeen = time.Now().Unix()
 }
 
+// MarkRead marks the notifications of the entity read. If it's a thread, the mentions, followed thread posts and keywords in it are also marked read.
 func (nc *NotificationsCarrier) MarkRead(fp string) {
 	nc.lock.Lock()
 	defer nc.lock.Unlock()
@@ -153,6 +167,14 @@ func (nc *NotificationsCarrier) MarkRead(fp string) {
 		container.NotificationsBuckets[k].Read = true
 	}
 	nc.Containers[fp] = container
+	for key, _ := range nc.Containers {
+		if nc.Containers[key].Type == 0 || nc.Containers[key].Thread.Fingerprint != fp {
+			continue
+		}
+		for k, _ := range nc.Containers[key].NotificationsBuckets {
+			nc.Containers[key].NotificationsBuckets[k].Read = true
+		}
+	}
 }
 
 func (nc *NotificationsCarrier) markAllAsRead() {
@@ -183,7 +205,8 @@ func (nc *NotificationsCarrier) Prune() {
 
 /*----------  Insertion and mark read/unread  ----------*/
 
-func (nc *NotificationsCarrier) InsertPosts(posts []CompiledPost) {
+// InsertPosts raises the notifications for the posts, which are all in the given thread.
+func (nc *NotificationsCarrier) InsertPosts(posts []CompiledPost, thread CompiledThread) {
 	nc.lock.Lock()
 	defer nc.lock.Unlock()
 	var nonSelfPosts []CompiledPost
@@ -200,6 +223,16 @@ func (nc *NotificationsCarrier) InsertPosts(posts []CompiledPost) {
 		nonSelfPosts = append(nonSelfPosts, posts[k])
 	}
 	// ^ Be mindful that we're removing self posts from the lists to be checked. That means responding to yourself will not raise a notification. Neat.
+	if len(nonSelfPosts) == 0 {
+		return
+	}
+	mentionNames := localUserMentionNames()
+	_, followedThreadNotify := globals.FrontendConfig.ContentRelations.IsSubbedThread(thread.Fingerprint)
+	_, subbedBoardNotify, _ := globals.FrontendConfig.ContentRelations.IsSubbedBoard(thread.Board)
+	var keywords []string
+	if subbedBoardNotify {
+		keywords = globals.FrontendConfig.GetNotificationKeywords()
+	}
 	// If not a self post, check if its parent matches a known self thread or post.
 	for k, _ := range nonSelfPosts {
 		if nc.responseToSelfPost(&nonSelfPosts[k]) {
@@ -218,7 +251,128 @@ func (nc *NotificationsCarrier) InsertPosts(posts []CompiledPost) {
 			continue
 		}
 		// ^ Be mindful of the order. We are inserting the notification into the closest parent - if this is a response to a self post that was response to a self thread, it will be shown as a notification that says it's a response to the self post.
+		if mentionsAny(nonSelfPosts[k].Body, mentionNames) {
+			nc.insertIntoThreadContainer("mention:"+thread.Fingerprint, MENTION, "", thread, nonSelfPosts[k], now)
+			continue
+		}
+		if followedThreadNotify {
+			nc.insertIntoThreadContainer("followed:"+thread.Fingerprint, POST_IN_FOLLOWED_THREAD, "", thread, nonSelfPosts[k], now)
+			continue
+		}
+		if kw := matchingKeyword(nonSelfPosts[k].Body, keywords); len(kw) > 0 {
+			nc.insertIntoThreadContainer("keyword:"+thread.Fingerprint+":"+strings.ToLower(kw), KEYWORD_IN_SUBSCRIBED_BOARD, kw, thread, nonSelfPosts[k], now)
+			continue
+		}
+	}
+}
+
+// insertIntoThreadContainer inserts the post into the container of a thread that is not a self thread, e.g. the container for the mentions in that thread.
+func (nc *NotificationsCarrier) insertIntoThreadContainer(key string, nType int, keyword string, thread CompiledThread, ce CompiledPost, now int64) {
+	nContainer := nc.Containers[key]
+	nContainer.Type = nType
+	nContainer.Keyword = keyword
+	nContainer.Thread = thread
+	nContainer.LastUpdate = now
+	nContainer.Insert(ce, now)
+	nc.Containers[key] = nContainer
+}
+
+// InsertDirectMessage raises the notification for a direct message the local user received.
+func (nc *NotificationsCarrier) InsertDirectMessage(dm CompiledDirectMessage, sender CUserUsername) {
+	nc.lock.Lock()
+	defer nc.lock.Unlock()
+	now := time.Now().Unix()
+	key := "dm:" + dm.Owner
+	nContainer := nc.Containers[key]
+	nContainer.Type = DIRECT_MESSAGE
+	nContainer.LastUpdate = now
+	nContainer.insert(dm.Fingerprint, sender, now)
+	nc.Containers[key] = nContainer
+}
+
+/*----------  Mentions and keywords  ----------*/
+
+// localUserMentionNames returns the names a mention of the local user can use: its fingerprint, and its canonical name if it has one. Empty if there is no local user.
+func localUserMentionNames() []string {
+	names := []string{}
+	alu := globals.FrontendConfig.GetDehydratedLocalUserKeyEntity()
+	if len(alu) == 0 {
+		return names
+	}
+	var key struct {
+		Fingerprint string `json:"fingerprint"`
+	}
+	err := json.Unmarshal([]byte(alu), &key)
+	if err != nil || len(key.Fingerprint) == 0 {
+		logging.Logf(1, "We could not read the fingerprint of the local user for mentions. Error: %v", err)
+		return names
 	}
+	names = append(names, key.Fingerprint)
+	uhc := UserHeaderCarrier{}
+	logging.Logf(3, "Single read happens in localUserMentionNames>One")
+	err2 := globals.KvInstance.One("Fingerprint", key.Fingerprint, &uhc)
+	if err2 != nil {
+		// Not compiled yet. The fingerprint still works.
+		return names
+	}
+	if i := uhc.Users.Find(key.Fingerprint); i != -1 {
+		if cn := uhc.Users[i].CompiledUserSignals.CanonicalName; len(cn) > 0 {
+			names = append(names, cn)
+		}
+	}
+	return names
+}
+
+// mentionsAny returns whether the text mentions any of the names, as in @name.
+func mentionsAny(text string, names []string) bool {
+	lowerText := strings.ToLower(text)
+	for k, _ := range names {
+		if containsWord(lowerText, "@"+strings.ToLower(names[k])) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchingKeyword returns the first of the keywords in the text. Blank if none.
+func matchingKeyword(text string, keywords []string) string {
+	if len(keywords) == 0 {
+		return ""
+	}
+	lowerText := strings.ToLower(text)
+	for k, _ := range keywords {
+		if containsWord(lowerText, strings.ToLower(keywords[k])) {
+			return keywords[k]
+		}
+	}
+	return ""
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
+
+// containsWord returns whether the text contains the word as a word of its own, not as a part of a longer one. 'rust' is in 'I like rust.', but not in 'trust'.
+func containsWord(text, word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], word)
+		if i == -1 {
+			return false
+		}
+		start := offset + i
+		end := start + len(word)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+	return false
 }
 
 // Heads up, for this to actually be useful, the insert thread needs to happen before insert posts, so that the posts will be able to check for existence of this self thread.
@@ -262,13 +416,18 @@ func (nc *NotificationsCarrier) responseToSelfThread(ce *CompiledPost) bool {
 }
 
 const (
-	REPLY_TO_THREAD = 1
-	REPLY_TO_POST   = 2
+	REPLY_TO_THREAD             = 1
+	REPLY_TO_POST               = 2
+	MENTION                     = 3
+	POST_IN_FOLLOWED_THREAD     = 4
+	KEYWORD_IN_SUBSCRIBED_BOARD = 5
+	DIRECT_MESSAGE              = 6
 )
 
 /*----------  Listification to send to client  ----------*/
 type CompiledNotification struct {
-	Type                    int // REPLY_TO_THREAD, REPLY_TO_POST
+	Type                    int // REPLY_TO_THREAD, REPLY_TO_POST, MENTION, POST_IN_FOLLOWED_THREAD, KEYWORD_IN_SUBSCRIBED_BOARD, DIRECT_MESSAGE
+	Keyword                 string
 	Text                    string
 	ResponsePosts           []string
 	ResponsePostsUsers      map[string]CUserUsername
@@ -286,6 +445,14 @@ func (c *CompiledNotification) GenerateText() {
 	case REPLY_TO_THREAD:
 		c.generateReplyToThreadText()
 		// c.Text = generateReplyToThrea3dText(len(c.ResponsePosts), c.ParentThread)
+	case MENTION:
+		c.generateMentionText()
+	case POST_IN_FOLLOWED_THREAD:
+		c.generatePostInFollowedThreadText()
+	case KEYWORD_IN_SUBSCRIBED_BOARD:
+		c.generateKeywordText()
+	case DIRECT_MESSAGE:
+		c.generateDirectMessageText()
 	default:
 		logging.Logf(1, "Compiled notification has an unknown type. CompiledNotification: %v", c)
 	}
@@ -324,6 +491,49 @@ func (c *CompiledNotification) generateReplyToThreadText() {
 	c.Text = fmt.Sprintf("%d replies to thread “%s”", respCount, shortenedThrName)
 }
 
+func shortenedThreadName(thr CompiledThread) string {
+	if len([]rune(thr.Name)) < 48 {
+		return thr.Name
+	}
+	return fmt.Sprintf("%s…", string([]rune(thr.Name)[0:48]))
+}
+
+func (c *CompiledNotification) generateMentionText() {
+	var respCount = len(c.ResponsePosts)
+	if respCount == 1 {
+		c.Text = fmt.Sprintf("mentioned you in thread “%s”", shortenedThreadName(c.ParentThread))
+		return
+	}
+	c.Text = fmt.Sprintf("%d mentions of you in thread “%s”", respCount, shortenedThreadName(c.ParentThread))
+}
+
+func (c *CompiledNotification) generatePostInFollowedThreadText() {
+	var respCount = len(c.ResponsePosts)
+	if respCount == 1 {
+		c.Text = fmt.Sprintf("posted in followed thread “%s”", shortenedThreadName(c.ParentThread))
+		return
+	}
+	c.Text = fmt.Sprintf("%d new posts in followed thread “%s”", respCount, shortenedThreadName(c.ParentThread))
+}
+
+func (c *CompiledNotification) generateKeywordText() {
+	var respCount = len(c.ResponsePosts)
+	if respCount == 1 {
+		c.Text = fmt.Sprintf("mentioned “%s” in thread “%s”", c.Keyword, shortenedThreadName(c.ParentThread))
+		return
+	}
+	c.Text = fmt.Sprintf("%d posts mention “%s” in thread “%s”", respCount, c.Keyword, shortenedThreadName(c.ParentThread))
+}
+
+func (c *CompiledNotification) generateDirectMessageText() {
+	var respCount = len(c.ResponsePosts)
+	if respCount == 1 {
+		c.Text = "sent you a direct message"
+		return
+	}
+	c.Text = fmt.Sprintf("sent you %d direct messages", respCount)
+}
+
 type CNotificationsList []CompiledNotification
 
 // Listify is the logic that runs every time there is a need to send the client the notifications that we have now.
@@ -374,6 +584,10 @@ func (nc *NotificationsCarrier) Listify() (CNotificationsList, int64) {
 		if thr := len(nc.Containers[k].Thread.Fingerprint); thr > 0 {
 			nType = REPLY_TO_THREAD
 		}
+		if nc.Containers[k].Type != 0 {
+			// Not a container of a self entity, it knows its own type.
+			nType = nc.Containers[k].Type
+		}
 		// For every bucket in container
 		for k2, _ := range nc.Containers[k].NotificationsBuckets {
 			if len(nc.Containers[k].NotificationsBuckets[k2].ResponsePosts) == 0 {
@@ -396,6 +610,7 @@ func (nc *NotificationsCarrier) Listify() (CNotificationsList, int64) {
 			// Create the compiled notification object
 			cn := CompiledNotification{
 				Type:                    nType,
+				Keyword:                 nc.Containers[k].Keyword,
 				ResponsePosts:           rpFps,
 				ResponsePostsUsers:      rpUsers,
 				ParentPost:              nc.Containers[k].Post,
@@ -0,0 +1,91 @@
+package festructs
+
+// These test the word matching the mention and keyword notifications are based on.
+
+import (
+	"testing"
+)
+
+// Tests
+
+func TestContainsWord_Success(t *testing.T) {
+	cases := []struct {
+		text  string
+		word  string
+		found bool
+	}{
+		{"rust", "rust", true},
+		{"i like rust.", "rust", true},
+		{"rust is nice", "rust", true},
+		{"(rust)", "rust", true},
+		{"rust, go and c", "go", true},
+		{"trust", "rust", false},
+		{"rusty", "rust", false},
+		{"rust_lang", "rust", false},
+		{"rust-lang", "rust", false},
+		{"rust2", "rust", false},
+		// The first match is part of a longer word, the second isn't.
+		{"trust rust", "rust", true},
+		{"trust rusty", "rust", false},
+		{"çrust rust", "rust", true},
+		{"çrust", "rust", false},
+		{"", "rust", false},
+		{"rust", "", false},
+		// The matching is case sensitive, the callers lower both sides.
+		{"Rust", "rust", false},
+	}
+	for _, c := range cases {
+		if found := containsWord(c.text, c.word); found != c.found {
+			t.Errorf("The word was matched wrong. Text: %q, Word: %q, Expected: %v, Got: %v", c.text, c.word, c.found, found)
+		}
+	}
+}
+
+func TestMentionsAny_Success(t *testing.T) {
+	names := []string{"Alice", "bob_2"}
+	cases := []struct {
+		text      string
+		mentioned bool
+	}{
+		{"@alice hi", true},
+		{"hi @ALICE!", true},
+		{"cc @Bob_2", true},
+		{"hi alice", false},
+		{"@alicebob", false},
+		{"@bob", false},
+		// An email address isn't a mention.
+		{"mail@alice", false},
+		{"@bob_2x", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if mentioned := mentionsAny(c.text, names); mentioned != c.mentioned {
+			t.Errorf("The mention was matched wrong. Text: %q, Names: %#v, Expected: %v, Got: %v", c.text, names, c.mentioned, mentioned)
+		}
+	}
+	if mentionsAny("@alice", []string{}) {
+		t.Errorf("No name should be mentioned when there are no names.")
+	}
+}
+
+func TestMatchingKeyword_Success(t *testing.T) {
+	keywords := []string{"Golang", "rust"}
+	cases := []struct {
+		text    string
+		keyword string
+	}{
+		{"I write golang.", "Golang"},
+		{"RUST and GOLANG", "Golang"},
+		{"trust me", ""},
+		{"rust", "rust"},
+		{"", ""},
+	}
+	for _, c := range cases {
+		if keyword := matchingKeyword(c.text, keywords); keyword != c.keyword {
+			t.Errorf("The keyword was matched wrong. Text: %q, Expected: %q, Got: %q", c.text, c.keyword, keyword)
+		}
+	}
+	if keyword := matchingKeyword("golang", nil); keyword != "" {
+		t.Errorf("No keyword should match when there are no keywords. Got: %q", keyword)
+	}
+}
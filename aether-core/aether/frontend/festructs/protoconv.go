@@ -6,6 +6,7 @@ package festructs
 
 import (
 	pb "aether-core/aether/protos/feobjects"
+	"aether-core/aether/services/metaparse"
 )
 
 func (e *CompiledBoard) Protobuf() *pb.CompiledBoardEntity {
@@ -22,6 +23,9 @@ func (e *CompiledBoard) Protobuf() *pb.CompiledBoardEntity {
 		Meta:                   e.Meta,
 		ThreadsCount:           int32(e.ThreadsCount),
 		UserCount:              int32(e.UserCount),
+		Rules:                  e.Rules,
+		Flairs:                 BoardFlairSliceToProtobuf(e.Flairs),
+		IconHash:               e.IconHash,
 	}
 }
 
@@ -41,6 +45,8 @@ func (e *CompiledThread) Protobuf() *pb.CompiledThreadEntity {
 		PostsCount:             int32(e.PostsCount),
 		Score:                  e.Score,
 		ViewMeta_BoardName:     e.ViewMeta_BoardName,
+		Flair:                  BoardFlairToProtobuf(e.Flair),
+		ContentWarnings:        e.ContentWarnings,
 	}
 }
 
@@ -57,6 +63,7 @@ func (e *CompiledPost) Protobuf() *pb.CompiledPostEntity {
 		Creation:               e.Creation,
 		LastUpdate:             e.LastUpdate,
 		Meta:                   e.Meta,
+		ContentWarnings:        e.ContentWarnings,
 	}
 }
 
@@ -136,9 +143,41 @@ func (e *CompiledUser) Protobuf() *pb.CompiledUserEntity {
 		LastRefreshed:       e.LastRefreshed,
 		Meta:                e.Meta,
 		CompiledUserSignals: e.CompiledUserSignals.Protobuf(),
+		AvatarHash:          e.AvatarHash,
+		Links:               ProfileLinkSliceToProtobuf(e.Links),
 	}
 }
 
+func BoardFlairToProtobuf(f metaparse.BoardFlair) *pb.BoardFlairEntity {
+	if len(f.Id) == 0 {
+		return nil
+	}
+	return &pb.BoardFlairEntity{
+		Id:     f.Id,
+		Name:   f.Name,
+		Colour: f.Colour,
+	}
+}
+
+func BoardFlairSliceToProtobuf(fs []metaparse.BoardFlair) []*pb.BoardFlairEntity {
+	pbfs := []*pb.BoardFlairEntity{}
+	for key, _ := range fs {
+		pbfs = append(pbfs, BoardFlairToProtobuf(fs[key]))
+	}
+	return pbfs
+}
+
+func ProfileLinkSliceToProtobuf(ls []metaparse.ProfileLink) []*pb.ProfileLinkEntity {
+	pbls := []*pb.ProfileLinkEntity{}
+	for key, _ := range ls {
+		pbls = append(pbls, &pb.ProfileLinkEntity{
+			Label: ls[key].Label,
+			Url:   ls[key].Url,
+		})
+	}
+	return pbls
+}
+
 func (e *AmbientBoard) Protobuf() *pb.AmbientBoardEntity {
 	abe := pb.AmbientBoardEntity{
 		Fingerprint:          e.Fingerprint,
@@ -163,6 +202,7 @@ func (e *AmbientBoardBatch) Protobuf() []*pb.AmbientBoardEntity {
 func (e *CompiledNotification) Protobuf() *pb.CompiledNotification {
 	cnProto := pb.CompiledNotification{
 		Type:                    pb.NotificationType(int32(e.Type)),
+		Keyword:                 e.Keyword,
 		Text:                    e.Text,
 		ResponsePosts:           e.ResponsePosts,
 		ParentThread:            e.ParentThread.Protobuf(),
@@ -171,8 +211,8 @@ func (e *CompiledNotification) Protobuf() *pb.CompiledNotification {
 		NewestResponseTimestamp: e.NewestResponseTimestamp,
 		Read:                    e.Read,
 	}
-	if len(e.ResponsePostsUsers) == 1 {
-		// We only send this if there is only one user. If there are multiple, we do not send that data.
+	if len(e.ResponsePostsUsers) == 1 || (e.Type == DIRECT_MESSAGE && len(e.ResponsePosts) > 0) {
+		// We only send this if there is only one user. If there are multiple, we do not send that data. The direct messages in a notification all come from the same user.
 		u := e.ResponsePostsUsers[e.ResponsePosts[0]]
 		unp := []*pb.CUserUsername{u.Protobuf()}
 		cnProto.ResponsePostsUsers = unp
@@ -197,6 +237,32 @@ func (e *CNotificationsList) Protobuf() []*pb.CompiledNotification {
 	return cns
 }
 
+func (e *CompiledDirectMessage) Protobuf() *pb.CompiledDirectMessageEntity {
+	proto := pb.CompiledDirectMessageEntity{
+		Fingerprint: e.Fingerprint,
+		Owner:       e.Owner,
+		Recipient:   e.Recipient,
+		Body:        e.Body,
+		Creation:    e.Creation,
+		SelfCreated: e.SelfCreated,
+		Unreadable:  e.Unreadable,
+	}
+	return &proto
+}
+
+func (e *Conversation) Protobuf() *pb.CompiledConversationEntity {
+	proto := pb.CompiledConversationEntity{
+		Peer:         e.Peer,
+		PeerUsername: e.PeerUsername.Protobuf(),
+		LastUpdate:   e.LastUpdate,
+		Unread:       int32(e.Unread),
+	}
+	for key, _ := range e.Messages {
+		proto.Messages = append(proto.Messages, e.Messages[key].Protobuf())
+	}
+	return &proto
+}
+
 func (e *ReportsTabEntry) Protobuf() *pb.ReportsTabEntry {
 	proto := pb.ReportsTabEntry{
 		Fingerprint:   e.Fingerprint,
@@ -0,0 +1,202 @@
+// Frontend > FEStructs > Ranking
+// This file contains the ranking algorithms that decide the order of the threads in boards and in views.
+
+package festructs
+
+import (
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"math"
+	"sync"
+)
+
+/*
+How this works:
+
+A ranking gives every thread a score, and the threads are sorted by it, highest first. A ranking can also leave a thread out, e.g. a top ranking with a time window leaves out the threads that are older than the window. Those are marked unranked, and they're sorted to the end, and left out of the views.
+
+The ranking of a board is the one the user picked for it through the board signals, or if there's none, the default one in the FE config. The popular view has its own in the FE config. The home view uses the default one, since it mixes threads from many boards.
+
+Rankings are looked up by name. Available ones:
+
+- hot: Votes, with the newer threads weighted higher. This is what we always had.
+- new: The newest first.
+- top:day, top:week, top:month, top:year, top:all: The most upvoted (net) within the time window.
+- controversial: Both the upvotes and the downvotes are high, and close to each other.
+- wilson: The lower bound of the Wilson score interval of the upvote ratio. This is the confidence adjusted 'best', so that a thread with 1 upvote doesn't beat a thread with 100 upvotes and 1 downvote.
+- rising: The threads that get upvotes fastest right now, from the recent upvotes in the compiled ATDs.
+*/
+
+// ThreadRanker is a ranking algorithm for threads.
+type ThreadRanker interface {
+	// Rank returns the score of the thread at the given time, and whether the thread is in the ranking at all.
+	Rank(c *CompiledThread, nowts int64) (score float64, ranked bool)
+}
+
+const (
+	DefaultRanking = "hot"
+	day            = 86400
+)
+
+var (
+	rankersLock sync.Mutex
+	rankers     = map[string]ThreadRanker{
+		"hot":           hotRanker{},
+		"new":           newRanker{},
+		"top:day":       topRanker{window: day},
+		"top:week":      topRanker{window: 7 * day},
+		"top:month":     topRanker{window: 30 * day},
+		"top:year":      topRanker{window: 365 * day},
+		"top:all":       topRanker{},
+		"controversial": controversialRanker{},
+		"wilson":        wilsonRanker{},
+		"rising":        risingRanker{},
+	}
+)
+
+// RegisterRanker makes a ranking available under the given name. A ranking with the same name is replaced.
+func RegisterRanker(name string, r ThreadRanker) {
+	rankersLock.Lock()
+	defer rankersLock.Unlock()
+	rankers[name] = r
+}
+
+// GetRanker returns the ranking with the given name.
+func GetRanker(name string) (ThreadRanker, bool) {
+	rankersLock.Lock()
+	defer rankersLock.Unlock()
+	r, ok := rankers[name]
+	return r, ok
+}
+
+// IsValidRanking returns whether there is a ranking with the given name.
+func IsValidRanking(name string) bool {
+	_, ok := GetRanker(name)
+	return ok
+}
+
+// getRankerOrDefault returns the ranking with the given name, or the default one if there's no such ranking.
+func getRankerOrDefault(name string) ThreadRanker {
+	if r, ok := GetRanker(name); ok {
+		return r
+	}
+	logging.Logf(1, "This ranking is unknown, falling back to the default one. Ranking: %v, Default: %v", name, DefaultRanking)
+	r, _ := GetRanker(DefaultRanking)
+	return r
+}
+
+// DefaultRankingName returns the name of the ranking for boards that don't have one of their own.
+func DefaultRankingName() string {
+	return globals.FrontendConfig.GetDefaultThreadRanking()
+}
+
+// BoardRankingName returns the name of the ranking of the board.
+func BoardRankingName(boardfp string) string {
+	if name := globals.FrontendConfig.ContentRelations.GetBoardRanking(boardfp); len(name) > 0 {
+		return name
+	}
+	return DefaultRankingName()
+}
+
+// DefaultRanker returns the ranking for boards that don't have one of their own, and for the home view.
+func DefaultRanker() ThreadRanker {
+	return getRankerOrDefault(DefaultRankingName())
+}
+
+// BoardRanker returns the ranking of the board.
+func BoardRanker(boardfp string) ThreadRanker {
+	return getRankerOrDefault(BoardRankingName(boardfp))
+}
+
+// PopularViewRanker returns the ranking of the popular view.
+func PopularViewRanker() ThreadRanker {
+	return getRankerOrDefault(globals.FrontendConfig.GetPopularViewRanking())
+}
+
+/*----------  Rankings  ----------*/
+
+type hotRanker struct{}
+
+func (r hotRanker) Rank(c *CompiledThread, nowts int64) (float64, bool) {
+	// We need: upvotes, downvotes, current timestamp, creation
+	voteScore := c.CompiledContentSignals.Upvotes - c.CompiledContentSignals.Downvotes
+	orderOfMagnitude := math.Log10(math.Max(1, math.Abs(float64(voteScore))))
+	sign := 0
+	if voteScore > 0 {
+		sign = 1
+	}
+	if voteScore < 0 {
+		sign = -1
+	}
+	sec := c.Creation - 1533081600 // > Here we go again, Gordon Freeman
+	score := (float64(sign) * orderOfMagnitude) + (float64(sec) / 42300)
+	// > Approximate half life of Sodium-24
+	return score, true
+}
+
+type newRanker struct{}
+
+func (r newRanker) Rank(c *CompiledThread, nowts int64) (float64, bool) {
+	return float64(c.Creation), true
+}
+
+type topRanker struct {
+	window int64 // Seconds. 0: all time.
+}
+
+func (r topRanker) Rank(c *CompiledThread, nowts int64) (float64, bool) {
+	if r.window != 0 && nowts-c.Creation > r.window {
+		return 0, false
+	}
+	return float64(c.CompiledContentSignals.Upvotes - c.CompiledContentSignals.Downvotes), true
+}
+
+type controversialRanker struct{}
+
+func (r controversialRanker) Rank(c *CompiledThread, nowts int64) (float64, bool) {
+	ups := float64(c.CompiledContentSignals.Upvotes)
+	downs := float64(c.CompiledContentSignals.Downvotes)
+	if ups <= 0 || downs <= 0 {
+		return 0, true
+	}
+	// The more votes the better, and the closer the two sides the better.
+	balance := math.Min(ups, downs) / math.Max(ups, downs)
+	return math.Pow(ups+downs, balance), true
+}
+
+type wilsonRanker struct{}
+
+// wilsonZ is the z-score for 95% confidence.
+const wilsonZ = 1.96
+
+func (r wilsonRanker) Rank(c *CompiledThread, nowts int64) (float64, bool) {
+	ups := float64(c.CompiledContentSignals.Upvotes)
+	n := ups + float64(c.CompiledContentSignals.Downvotes)
+	if n <= 0 {
+		return 0, true
+	}
+	p := ups / n
+	z2 := wilsonZ * wilsonZ
+	lowerBound := (p + z2/(2*n) - wilsonZ*math.Sqrt((p*(1-p)+z2/(4*n))/n)) / (1 + z2/n)
+	return lowerBound, true
+}
+
+type risingRanker struct{}
+
+func (r risingRanker) Rank(c *CompiledThread, nowts int64) (float64, bool) {
+	cs := &c.CompiledContentSignals
+	return decayedVotes(cs.RecentUpvotes, cs.RecentUpvotesAt, nowts), true
+}
+
+/*----------  Vote velocity  ----------*/
+
+// recentVotesHalfLife is how long it takes for a vote to count half as much in the recent votes, in seconds.
+const recentVotesHalfLife = 6 * 3600
+
+// decayedVotes returns the recent votes, counted as of 'at', as of nowts.
+func decayedVotes(votes float64, at, nowts int64) float64 {
+	if nowts <= at {
+		return votes
+	}
+	return votes * math.Pow(0.5, float64(nowts-at)/recentVotesHalfLife)
+}
@@ -0,0 +1,207 @@
+package festructs
+
+// These test the thread rankings, and the recent upvotes in the compiled ATDs that the rising ranking is based on.
+
+import (
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"github.com/willf/bloom"
+	"math"
+	"os"
+	"testing"
+)
+
+// Infrastructure
+
+const rankingNow = int64(1600000000)
+
+// TestMain gives the tests an FE config, since the rankings and the compiled ATDs log.
+func TestMain(m *testing.M) {
+	globals.FrontendConfig = &configstore.FrontendConfig{Initialised: true}
+	os.Exit(m.Run())
+}
+
+func thread(creation int64, ups, downs int) *CompiledThread {
+	c := &CompiledThread{}
+	c.Creation = creation
+	c.CompiledContentSignals.Upvotes = ups
+	c.CompiledContentSignals.Downvotes = downs
+	return c
+}
+
+func score(t *testing.T, r ThreadRanker, c *CompiledThread) float64 {
+	s, ranked := r.Rank(c, rankingNow)
+	if !ranked {
+		t.Fatalf("The thread should be in the ranking. Thread: %#v", c)
+	}
+	return s
+}
+
+// newTestCATD doesn't go through NewCATD, since that one reads the bloom filter sizes from the FE config.
+func newTestCATD(targetfp string) *CompiledATD {
+	return &CompiledATD{
+		TargetFingerprint: targetfp,
+		UpvotesBloom:      *bloom.NewWithEstimates(1000, 0.0001),
+		DownvotesBloom:    *bloom.NewWithEstimates(1000, 0.0001),
+	}
+}
+
+func atd(source string, direction int, creation, lastUpdate int64) AddsToDiscussionSignal {
+	s := AddsToDiscussionSignal{}
+	s.Fingerprint = "vote of " + source
+	s.SourceFingerprint = source
+	s.TargetFingerprint = "target"
+	s.Type = direction
+	s.Creation = creation
+	s.LastUpdate = lastUpdate
+	return s
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// Tests
+
+func TestHotRanker_Success(t *testing.T) {
+	r := hotRanker{}
+	if score(t, r, thread(rankingNow, 10, 0)) <= score(t, r, thread(rankingNow, 1, 0)) {
+		t.Errorf("A thread with more votes should be hotter.")
+	}
+	if score(t, r, thread(rankingNow, 0, 10)) >= score(t, r, thread(rankingNow, 0, 0)) {
+		t.Errorf("A thread with net downvotes should be less hot than one with no votes.")
+	}
+	if score(t, r, thread(rankingNow, 1, 0)) <= score(t, r, thread(rankingNow-day, 1, 0)) {
+		t.Errorf("Of two threads with the same votes, the newer one should be hotter.")
+	}
+	// An order of magnitude of votes is worth 42300 seconds.
+	if score(t, r, thread(rankingNow-40000, 100, 0)) <= score(t, r, thread(rankingNow, 10, 0)) {
+		t.Errorf("Ten times the votes should outweigh a little less than half a day of age.")
+	}
+}
+
+func TestNewRanker_Success(t *testing.T) {
+	r := newRanker{}
+	if score(t, r, thread(rankingNow, 0, 5)) <= score(t, r, thread(rankingNow-1, 100, 0)) {
+		t.Errorf("The newer thread should come first, regardless of votes.")
+	}
+}
+
+func TestTopRanker_Success(t *testing.T) {
+	cases := []struct {
+		window int64
+		age    int64
+		ranked bool
+	}{
+		{day, 0, true},
+		{day, day, true},
+		{day, day + 1, false},
+		{7 * day, 3 * day, true},
+		{7 * day, 8 * day, false},
+		{0, 1000 * day, true},
+	}
+	for _, c := range cases {
+		s, ranked := topRanker{window: c.window}.Rank(thread(rankingNow-c.age, 5, 2), rankingNow)
+		if ranked != c.ranked {
+			t.Errorf("The thread should be in the window or out of it. Window: %v, Age: %v, Expected ranked: %v", c.window, c.age, c.ranked)
+		}
+		if ranked && s != 3 {
+			t.Errorf("The score should be the net votes. Expected: 3, Got: %v", s)
+		}
+	}
+}
+
+func TestControversialRanker_Success(t *testing.T) {
+	r := controversialRanker{}
+	if s := score(t, r, thread(rankingNow, 10, 0)); s != 0 {
+		t.Errorf("A thread without downvotes should not be controversial. Score: %v", s)
+	}
+	if score(t, r, thread(rankingNow, 10, 10)) <= score(t, r, thread(rankingNow, 10, 2)) {
+		t.Errorf("A thread with balanced votes should be more controversial than a one sided one.")
+	}
+	if score(t, r, thread(rankingNow, 50, 50)) <= score(t, r, thread(rankingNow, 10, 10)) {
+		t.Errorf("Of two balanced threads, the one with more votes should be more controversial.")
+	}
+}
+
+func TestWilsonRanker_Success(t *testing.T) {
+	r := wilsonRanker{}
+	if s := score(t, r, thread(rankingNow, 0, 0)); s != 0 {
+		t.Errorf("A thread without votes should score 0. Score: %v", s)
+	}
+	if score(t, r, thread(rankingNow, 100, 1)) <= score(t, r, thread(rankingNow, 1, 0)) {
+		t.Errorf("100 upvotes and 1 downvote should beat a single upvote.")
+	}
+	if score(t, r, thread(rankingNow, 10, 0)) <= score(t, r, thread(rankingNow, 10, 5)) {
+		t.Errorf("Of two threads with the same upvotes, the one with fewer downvotes should be better.")
+	}
+	if s := score(t, r, thread(rankingNow, 1000, 0)); s <= 0 || s >= 1 {
+		t.Errorf("The score should be a ratio between 0 and 1. Score: %v", s)
+	}
+}
+
+func TestRisingRanker_Success(t *testing.T) {
+	r := risingRanker{}
+	c := thread(rankingNow-day, 0, 0)
+	c.CompiledContentSignals.RecentUpvotes = 8
+	c.CompiledContentSignals.RecentUpvotesAt = rankingNow - 2*recentVotesHalfLife
+	if s := score(t, r, c); !closeTo(s, 2) {
+		t.Errorf("The recent upvotes should halve every half life. Expected: 2, Got: %v", s)
+	}
+	c.CompiledContentSignals.RecentUpvotesAt = rankingNow + 100
+	if s := score(t, r, c); s != 8 {
+		t.Errorf("The recent upvotes should not grow when counted as of a later time. Expected: 8, Got: %v", s)
+	}
+}
+
+func TestGetRankerOrDefault_Success(t *testing.T) {
+	for _, name := range []string{"hot", "new", "top:day", "top:week", "top:month", "top:year", "top:all", "controversial", "wilson", "rising"} {
+		if !IsValidRanking(name) {
+			t.Errorf("This ranking should be available. Ranking: %v", name)
+		}
+	}
+	if IsValidRanking("not a ranking") {
+		t.Errorf("An unknown ranking should not be valid.")
+	}
+	if _, ok := getRankerOrDefault("not a ranking").(hotRanker); !ok {
+		t.Errorf("An unknown ranking should fall back to the default one.")
+	}
+	if _, ok := getRankerOrDefault("wilson").(wilsonRanker); !ok {
+		t.Errorf("A known ranking should be returned as is.")
+	}
+}
+
+func TestRecentUpvotes_AnyOrder_Success(t *testing.T) {
+	inOrder, outOfOrder := newTestCATD("target"), newTestCATD("target")
+	inOrder.Insert(atd("a", Signal_Upvote, rankingNow-recentVotesHalfLife, 0))
+	inOrder.Insert(atd("b", Signal_Upvote, rankingNow, 0))
+	outOfOrder.Insert(atd("b", Signal_Upvote, rankingNow, 0))
+	outOfOrder.Insert(atd("a", Signal_Upvote, rankingNow-recentVotesHalfLife, 0))
+	if !closeTo(inOrder.RecentUpvotes, 1.5) || !closeTo(outOfOrder.RecentUpvotes, 1.5) {
+		t.Errorf("The recent upvotes should not depend on the order the votes arrive in. In order: %v, Out of order: %v", inOrder.RecentUpvotes, outOfOrder.RecentUpvotes)
+	}
+}
+
+func TestRecentUpvotes_UpvoteToDownvote_Success(t *testing.T) {
+	c := newTestCATD("target")
+	c.Insert(atd("a", Signal_Upvote, rankingNow-recentVotesHalfLife, 0))
+	c.Insert(atd("b", Signal_Upvote, rankingNow, 0))
+	c.Insert(atd("a", Signal_Downvote, rankingNow-recentVotesHalfLife, rankingNow+100))
+	if c.UpvotesCount != 1 || c.DownvotesCount != 1 {
+		t.Errorf("The flip should move the vote over. U: %v, D: %v", c.UpvotesCount, c.DownvotesCount)
+	}
+	if !closeTo(c.RecentUpvotes, 1) {
+		t.Errorf("The flipped upvote should be taken back out of the recent upvotes. Expected: 1, Got: %v", c.RecentUpvotes)
+	}
+}
+
+func TestRecentUpvotes_FlipFalsePositive_Success(t *testing.T) {
+	c := newTestCATD("target")
+	// As if the bloom filter said the source upvoted before, but the upvote never made it into the recent upvotes.
+	c.UpvotesBloom.AddString("a")
+	c.UpvotesCount = 1
+	c.Insert(atd("a", Signal_Downvote, rankingNow, rankingNow+100))
+	if c.RecentUpvotes != 0 {
+		t.Errorf("The recent upvotes should not fall below 0. Got: %v", c.RecentUpvotes)
+	}
+}
@@ -0,0 +1,100 @@
+// Frontend > FeStructs > Retention
+// This file decides which carriers have fallen out of the retention window of the frontend KV store.
+
+package festructs
+
+// lastActivity is the newest creation or update timestamp among the given ones.
+func lastActivity(ts ...int64) int64 {
+	var latest int64
+	for _, t := range ts {
+		if t > latest {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// LastActivity is the last time anything in this carrier was created or updated. If the carrier has nothing in it yet, it is the time the carrier was created.
+func (c *EntityCarrier) LastActivity() int64 {
+	latest := c.LastRefreshed
+	for k, _ := range c.Boards {
+		latest = lastActivity(latest, c.Boards[k].Creation, c.Boards[k].LastUpdate)
+	}
+	for k, _ := range c.Threads {
+		latest = lastActivity(latest, c.Threads[k].Creation, c.Threads[k].LastUpdate)
+	}
+	for k, _ := range c.Posts {
+		latest = lastActivity(latest, c.Posts[k].Creation, c.Posts[k].LastUpdate)
+	}
+	return latest
+}
+
+func (c *EntityCarrier) hasSelfCreated() bool {
+	for k, _ := range c.Boards {
+		if c.Boards[k].SelfCreated {
+			return true
+		}
+	}
+	for k, _ := range c.Threads {
+		if c.Threads[k].SelfCreated {
+			return true
+		}
+	}
+	for k, _ := range c.Posts {
+		if c.Posts[k].SelfCreated {
+			return true
+		}
+	}
+	return false
+}
+
+// Stale tells whether the carrier has seen no activity since the cutoff. Carriers with anything the local user created in them are never stale, we don't want the user's own content to disappear from under them.
+func (c *EntityCarrier) Stale(cutoff int64) bool {
+	return c.LastActivity() < cutoff && !c.hasSelfCreated()
+}
+
+// DeleteFromSearchIndex removes everything this carrier holds from the search index.
+func (c *EntityCarrier) DeleteFromSearchIndex() {
+	for k, _ := range c.Boards {
+		c.Boards[k].DeleteFromSearchIndex()
+	}
+	for k, _ := range c.Threads {
+		c.Threads[k].DeleteFromSearchIndex()
+	}
+	for k, _ := range c.Posts {
+		c.Posts[k].DeleteFromSearchIndex()
+	}
+}
+
+// RemoveThreads drops the given threads from the thread list of the carrier. Returns true if anything was removed.
+func (c *EntityCarrier) RemoveThreads(fps map[string]bool) bool {
+	kept := c.Threads[:0]
+	for k, _ := range c.Threads {
+		if fps[c.Threads[k].Fingerprint] {
+			continue
+		}
+		kept = append(kept, c.Threads[k])
+	}
+	removed := len(kept) != len(c.Threads)
+	c.Threads = kept
+	return removed
+}
+
+func (c *UserHeaderCarrier) LastActivity() int64 {
+	latest := c.LastRefreshed
+	for k, _ := range c.Users {
+		latest = lastActivity(latest, c.Users[k].Creation, c.Users[k].LastUpdate)
+	}
+	return latest
+}
+
+// Stale tells whether the user has seen no activity since the cutoff. The header of the local user is never stale.
+func (c *UserHeaderCarrier) Stale(cutoff int64, localUserFp string) bool {
+	return c.LastActivity() < cutoff && c.Fingerprint != localUserFp
+}
+
+func (c *UserHeaderCarrier) DeleteFromSearchIndex() {
+	for k, _ := range c.Users {
+		c.Users[k].DeleteFromSearchIndex()
+	}
+}
@@ -74,7 +74,7 @@ func GetCNs(targetfp, domainfp string, startts, nowts int64) []CanonicalNameSign
 	for k, _ := range rawSignals {
 		tsmeta, err := metaparse.ReadMeta("Truststate", rawSignals[k].GetMeta())
 		if err != nil {
-			logging.Logf(2, "We failed to parse this Meta field. Raw Meta field: %v, Entity: %v Error: %v", targetfp, err)
+			logging.Logf(2, "We failed to parse this Meta field. Raw Meta field: %v, Entity: %v Error: %v", rawSignals[k].GetMeta(), targetfp, err)
 		}
 		cname := ""
 		if tsmeta != nil {
@@ -27,6 +27,9 @@ type CompiledATD struct {
 	SelfCreation      int64
 	SelfLastUpdate    int64
 	LastRefreshed     int64
+	// The upvotes, decayed by how long ago they were cast, as of RecentUpvotesAt. This is how fast the entity gets upvotes, which the rising ranking needs.
+	RecentUpvotes   float64
+	RecentUpvotesAt int64
 }
 
 func NewCATD(targetfp string, nowts int64) *CompiledATD {
@@ -80,6 +83,8 @@ func (c *CompiledATD) Insert(atd AddsToDiscussionSignal) {
 		c.UpvotesCount--
 		c.DownvotesCount++
 		c.DownvotesBloom.AddString(atd.SourceFingerprint)
+		// The upvote we're taking back was counted when it was cast. We don't keep the time of it, but the vote is an edit of the upvote, so its creation is the best we have.
+		c.removeRecentUpvote(atd.Creation)
 	}
 	if inDownvotesBloom {
 		if atd.Type == Signal_Downvote {
@@ -90,12 +95,14 @@ func (c *CompiledATD) Insert(atd AddsToDiscussionSignal) {
 		c.DownvotesCount--
 		c.UpvotesCount++
 		c.UpvotesBloom.AddString(atd.SourceFingerprint)
+		c.addRecentUpvote(max(atd.Creation, atd.LastUpdate))
 	}
 	// None matches.
 	if !inUpvotesBloom && !inDownvotesBloom {
 		if atd.Type == Signal_Upvote {
 			c.UpvotesBloom.AddString(atd.SourceFingerprint)
 			c.UpvotesCount++
+			c.addRecentUpvote(max(atd.Creation, atd.LastUpdate))
 		}
 		if atd.Type == Signal_Downvote {
 			c.DownvotesBloom.AddString(atd.SourceFingerprint)
@@ -131,6 +138,30 @@ func (c *CompiledATD) Insert(atd AddsToDiscussionSignal) {
 	logging.Logf(2, "           Upvotes and downvotes counts: U: %v, D: %v ATD Target: %#v", c.UpvotesCount, c.DownvotesCount, c.TargetFingerprint)
 }
 
+// addRecentUpvote adds an upvote cast at the given time into the recent upvotes. The upvotes can arrive in any order, the result is the same.
+func (c *CompiledATD) addRecentUpvote(ts int64) {
+	if ts > c.RecentUpvotesAt {
+		c.RecentUpvotes = decayedVotes(c.RecentUpvotes, c.RecentUpvotesAt, ts) + 1
+		c.RecentUpvotesAt = ts
+		return
+	}
+	c.RecentUpvotes += decayedVotes(1, ts, c.RecentUpvotesAt)
+}
+
+// removeRecentUpvote takes an upvote cast at the given time back out of the recent upvotes. This is the reverse of addRecentUpvote, for when an upvote flips to a downvote.
+func (c *CompiledATD) removeRecentUpvote(ts int64) {
+	if ts > c.RecentUpvotesAt {
+		c.RecentUpvotes = decayedVotes(c.RecentUpvotes, c.RecentUpvotesAt, ts) - 1
+		c.RecentUpvotesAt = ts
+	} else {
+		c.RecentUpvotes -= decayedVotes(1, ts, c.RecentUpvotesAt)
+	}
+	// Same as the counts, this can fall below 0 if the bloom filter gave us a false positive.
+	if c.RecentUpvotes < 0 {
+		c.RecentUpvotes = 0
+	}
+}
+
 // Compiled Follows Guidelines
 type CompiledFG struct {
 	TargetFingerprint string
@@ -72,7 +72,7 @@ func GetFGs(parentfp, parenttype, targetfp string, startts, nowts int64, noDesce
 	for k, _ := range rawSignals {
 		vmeta, err := metaparse.ReadMeta("Vote", rawSignals[k].GetMeta())
 		if err != nil {
-			logging.Logf(2, "We failed to parse this Meta field. Raw Meta field: %v, Entity: %v Error: %v", targetfp, err)
+			logging.Logf(2, "We failed to parse this Meta field. Raw Meta field: %v, Entity: %v Error: %v", rawSignals[k].GetMeta(), targetfp, err)
 		}
 		fgreason := ""
 		if vmeta != nil {
@@ -102,7 +102,7 @@ func GetMAs(parentfp, parenttype, targetfp string, startts, nowts int64, noDesce
 	for k, _ := range rawSignals {
 		vmeta, err := metaparse.ReadMeta("Vote", rawSignals[k].GetMeta())
 		if err != nil {
-			logging.Logf(2, "We failed to parse this Meta field. Raw Meta field: %v, Entity: %v Error: %v", targetfp, err)
+			logging.Logf(2, "We failed to parse this Meta field. Raw Meta field: %v, Entity: %v Error: %v", rawSignals[k].GetMeta(), targetfp, err)
 		}
 		mareason := ""
 		if vmeta != nil {
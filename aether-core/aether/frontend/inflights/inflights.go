@@ -12,6 +12,8 @@ import (
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/metaparse"
+	"aether-core/aether/services/proofofwork"
+	"aether-core/aether/services/randomhashgen"
 	"sync"
 	"time"
 )
@@ -25,22 +27,28 @@ var Inflights *inflights
 type inflights struct {
 	lock sync.Mutex
 	// ingestLock          sync.Mutex
-	ingestRanOnce       bool
-	ingestRunning       bool
-	ID                  int
-	InflightBoards      []InflightBoard
-	InflightThreads     []InflightThread
-	InflightPosts       []InflightPost
-	InflightVotes       []InflightVote
-	InflightKeys        []InflightKey
-	InflightTruststates []InflightTruststate
+	ingestRanOnce          bool
+	ingestRunning          bool
+	mintLock               sync.Mutex
+	currentMint            *inflightMint
+	wake                   chan struct{} // Cuts short the wait for a retry.
+	ID                     int
+	LastSequence           int64
+	InflightBoards         []InflightBoard
+	InflightThreads        []InflightThread
+	InflightPosts          []InflightPost
+	InflightVotes          []InflightVote
+	InflightKeys           []InflightKey
+	InflightTruststates    []InflightTruststate
+	InflightDirectMessages []InflightDirectMessage
 	/*----------  Complete entries  ----------*/
-	FulfilledBoards      []InflightBoard
-	FulfilledThreads     []InflightThread
-	FulfilledPosts       []InflightPost
-	FulfilledVotes       []InflightVote
-	FulfilledKeys        []InflightKey
-	FulfilledTruststates []InflightTruststate
+	FulfilledBoards         []InflightBoard
+	FulfilledThreads        []InflightThread
+	FulfilledPosts          []InflightPost
+	FulfilledVotes          []InflightVote
+	FulfilledKeys           []InflightKey
+	FulfilledTruststates    []InflightTruststate
+	FulfilledDirectMessages []InflightDirectMessage
 }
 
 /*----------  Protobuf conversions  ----------*/
@@ -67,16 +75,25 @@ func (o *inflights) Protobuf() *clapi.Inflights {
 	for k, _ := range o.InflightTruststates {
 		opb.Truststates = append(opb.Truststates, o.InflightTruststates[k].Protobuf())
 	}
+	for k, _ := range o.InflightDirectMessages {
+		opb.DirectMessages = append(opb.DirectMessages, o.InflightDirectMessages[k].Protobuf())
+	}
 	return &opb
 }
 
 func (o *InflightStatus) Protobuf() *clapi.InflightStatus {
 	return &clapi.InflightStatus{
-		CompletionPercent:   int32(o.CompletionPercent),
-		StatusText:          o.StatusText,
-		RequestedTimestamp:  o.RequestedTimestamp,
-		LastActionTimestamp: o.LastActionTimestamp,
-		EventType:           o.EventType,
+		CompletionPercent:    int32(o.CompletionPercent),
+		StatusText:           o.StatusText,
+		RequestedTimestamp:   o.RequestedTimestamp,
+		LastActionTimestamp:  o.LastActionTimestamp,
+		EventType:            o.EventType,
+		Id:                   o.Id,
+		HashesTried:          o.HashesTried,
+		EstimatedSecondsLeft: o.EstimatedSecondsLeft,
+		Attempts:             int32(o.Attempts),
+		NextAttemptTimestamp: o.NextAttemptTimestamp,
+		FailureReason:        o.FailureReason,
 	}
 }
 
@@ -122,6 +139,13 @@ func (o *InflightTruststate) Protobuf() *clapi.InflightTruststate {
 	}
 }
 
+func (o *InflightDirectMessage) Protobuf() *clapi.InflightDirectMessage {
+	return &clapi.InflightDirectMessage{
+		Status: o.Status.Protobuf(),
+		Entity: &o.Entity,
+	}
+}
+
 /*----------  Status header  ----------*/
 
 type InflightStatus struct {
@@ -130,6 +154,15 @@ type InflightStatus struct {
 	RequestedTimestamp  int64 // We grab the oldest requested to start the process
 	LastActionTimestamp int64
 	EventType           string
+	Id                  string // Random, so that the client can point at this item to cancel or retry it.
+	Sequence            int64  // Order of insertion into the queue. 0 for the items from before we kept it, which are ordered by their requested timestamp.
+	/*----------  Minting progress  ----------*/
+	HashesTried          int64
+	EstimatedSecondsLeft int64 // -1 if the minter can't tell yet.
+	/*----------  Retries  ----------*/
+	Attempts             int    // Failed attempts at sending to the backend.
+	NextAttemptTimestamp int64  // If waiting for a retry, when it is due.
+	FailureReason        string // Why the last attempt failed. Kept until it succeeds.
 }
 
 func (s *InflightStatus) Fulfilled() bool {
@@ -149,12 +182,13 @@ const (
 	STATUS_COMPLETE                  = "Successful."
 	STATUS_REMOTE_COMPLETE           = "The entity is communicated to the network and its availability is verified"
 	STATUS_FAILED                    = "The insertion for this entity has failed"
+	STATUS_CANCELLED                 = "The insertion for this entity was cancelled"
 )
 
 var statusesOrdered = []string{
 	STATUS_WAITING,
 	STATUS_MINTING,
-	// STATUS_ADDING_TO_BACKEND,
+	STATUS_ADDING_TO_BACKEND,
 	STATUS_RECOMPILING_FRONTEND,
 	// STATUS_WAITING_TO_SERVE,
 	// STATUS_WAITING_TO_FIND_IN_REMOTE,
@@ -163,7 +197,12 @@ var statusesOrdered = []string{
 }
 
 func NewInflightStatus(status string, etype string) InflightStatus {
+	id, err := randomhashgen.GenerateInsecureRandomHash()
+	if err != nil {
+		logging.Logf(1, "Inflight status id could not be generated. Error: %v", err)
+	}
 	st := InflightStatus{
+		Id:                  id,
 		StatusText:          status,
 		RequestedTimestamp:  time.Now().Unix(),
 		LastActionTimestamp: time.Now().Unix(),
@@ -180,7 +219,7 @@ func (o *InflightStatus) Update(status string) {
 }
 
 func (o *InflightStatus) setCompletionPercent() {
-	if o.StatusText == STATUS_FAILED {
+	if o.StatusText == STATUS_FAILED || o.StatusText == STATUS_CANCELLED {
 		o.CompletionPercent = -1
 		return
 	}
@@ -199,36 +238,158 @@ func (o *InflightStatus) setCompletionPercent() {
 	o.CompletionPercent = clp
 }
 
+/*----------  Minting progress & cancellation  ----------*/
+
+// inflightMint is the proof-of-work minting the ingestor is running right now. There is only ever one, because the ingestor consumes the queue one item at a time.
+type inflightMint struct {
+	id        string
+	cancel    chan struct{}
+	cancelled bool
+}
+
+// startMint registers the minting of the given inflight, and returns the control the minter will report its progress to and check for cancellation.
+func (o *inflights) startMint(st *InflightStatus) *proofofwork.MintControl {
+	m := inflightMint{id: st.Id, cancel: make(chan struct{})}
+	o.mintLock.Lock()
+	o.currentMint = &m
+	o.mintLock.Unlock()
+	st.HashesTried = 0
+	st.EstimatedSecondsLeft = -1
+	return &proofofwork.MintControl{
+		Cancel: m.cancel,
+		Progress: func(p proofofwork.MintProgress) {
+			// This is called from the minter, while the client can be reading the statuses.
+			o.lock.Lock()
+			st.HashesTried = p.HashesTried
+			st.EstimatedSecondsLeft = p.EstimatedSecondsLeft
+			st.LastActionTimestamp = time.Now().Unix()
+			o.lock.Unlock()
+			o.PushChangesToClient()
+		},
+	}
+}
+
+// endMint unregisters the current minting, and tells whether it was cancelled while it was running.
+func (o *inflights) endMint() bool {
+	o.mintLock.Lock()
+	defer o.mintLock.Unlock()
+	if o.currentMint == nil {
+		return false
+	}
+	cancelled := o.currentMint.cancelled
+	o.currentMint = nil
+	return cancelled
+}
+
+// Cancel stops the inflight with the given id. If it is being minted right now, the minter is stopped and the ingestor marks it cancelled. If it is still waiting, it is marked cancelled right away, so the ingestor never picks it up. Items past minting cannot be cancelled, since they're already out of our hands.
+func (o *inflights) Cancel(id string) bool {
+	if len(id) == 0 {
+		return false
+	}
+	o.mintLock.Lock()
+	if m := o.currentMint; m != nil && m.id == id {
+		if !m.cancelled {
+			m.cancelled = true
+			close(m.cancel)
+		}
+		o.mintLock.Unlock()
+		return true
+	}
+	o.mintLock.Unlock()
+	o.lock.Lock()
+	st := o.findWaiting(id)
+	if st != nil {
+		st.Update(STATUS_CANCELLED)
+		o.commit()
+	}
+	o.lock.Unlock()
+	if st == nil {
+		return false
+	}
+	o.PushChangesToClient()
+	return true
+}
+
+// findWaiting returns the status of the not yet minted inflight with the given id, if any.
+func (o *inflights) findWaiting(id string) *InflightStatus {
+	var found *InflightStatus
+	o.each(func(st *InflightStatus, minted bool) {
+		if st.Id == id && (st.StatusText == STATUS_WAITING || st.StatusText == STATUS_MINTING) {
+			found = st
+		}
+	})
+	return found
+}
+
+// each calls f with the status of every inflight item, and whether the item is minted already.
+func (o *inflights) each(f func(st *InflightStatus, minted bool)) {
+	for k, _ := range o.InflightBoards {
+		f(o.InflightBoards[k].Status, o.InflightBoards[k].Minted != nil)
+	}
+	for k, _ := range o.InflightThreads {
+		f(o.InflightThreads[k].Status, o.InflightThreads[k].Minted != nil)
+	}
+	for k, _ := range o.InflightPosts {
+		f(o.InflightPosts[k].Status, o.InflightPosts[k].Minted != nil)
+	}
+	for k, _ := range o.InflightVotes {
+		f(o.InflightVotes[k].Status, o.InflightVotes[k].Minted != nil)
+	}
+	for k, _ := range o.InflightKeys {
+		f(o.InflightKeys[k].Status, o.InflightKeys[k].Minted != nil)
+	}
+	for k, _ := range o.InflightTruststates {
+		f(o.InflightTruststates[k].Status, o.InflightTruststates[k].Minted != nil)
+	}
+	for k, _ := range o.InflightDirectMessages {
+		f(o.InflightDirectMessages[k].Status, o.InflightDirectMessages[k].Minted != nil)
+	}
+}
+
 /*----------  Inflight types  ----------*/
 
 type InflightBoard struct {
-	Status *InflightStatus
-	Entity beObj.Board
+	Status   *InflightStatus
+	Entity   beObj.Board
+	BoardKey *feapi.BoardKeyRequest // If the board key is being issued or granted with this create or update.
+	BoardPoW *feapi.BoardPoWRequest // If the board's own PoW requirements are being set or removed with this create or update.
+	Minted   *beObj.Board           // Kept so that we can resend it without minting again.
 }
 
 type InflightThread struct {
 	Status *InflightStatus
 	Entity beObj.Thread
+	Minted *beObj.Thread // Kept so that we can resend it without minting again.
 }
 
 type InflightPost struct {
 	Status *InflightStatus
 	Entity beObj.Post
+	Minted *beObj.Post // Kept so that we can resend it without minting again.
 }
 
 type InflightVote struct {
 	Status *InflightStatus
 	Entity beObj.Vote
+	Minted *beObj.Vote // Kept so that we can resend it without minting again.
 }
 
 type InflightKey struct {
 	Status *InflightStatus
 	Entity beObj.Key
+	Minted *beObj.Key // Kept so that we can resend it without minting again.
 }
 
 type InflightTruststate struct {
 	Status *InflightStatus
 	Entity beObj.Truststate
+	Minted *beObj.Truststate // Kept so that we can resend it without minting again.
+}
+
+type InflightDirectMessage struct {
+	Status *InflightStatus
+	Entity beObj.DirectMessage // Body is the plaintext until minted. It never leaves this machine unencrypted.
+	Minted *beObj.DirectMessage
 }
 
 /*----------  Read from and write to KvStore  ----------*/
@@ -243,6 +404,7 @@ func GetInflights() *inflights {
 	if err != nil && err.Error() != "not found" {
 		logging.Logf(1, "An error occurred while getting the inflights from KvInstance. Error: %v", err)
 	}
+	o.wake = make(chan struct{}, 1)
 	if !o.ingestRanOnce {
 		go o.Ingest()
 	}
@@ -262,6 +424,13 @@ func (o *inflights) ManualSaveToKvStore() {
 	o.commit()
 }
 
+// Hold runs f with the queue held off: nothing is inserted, cancelled, retried or saved into the KV store until it returns. The minting that is running keeps going, its progress and its result wait until the hold is over. This is for the KV store maintenance that swaps the KV store out from under us.
+func (o *inflights) Hold(f func() error) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return f()
+}
+
 /*----------  Insert & get next from the stack  ----------*/
 // TODO: This is where we add preempts for changing a vote
 
@@ -273,6 +442,7 @@ func (o *inflights) Insert(input interface{}) {
 		// CREATE or UPDATE for Boards, Threads, Posts, Keys
 		if i.GetBoardData() != nil {
 			ifObj := createInflightBoard(&i)
+			o.sequence(ifObj.Status)
 			o.InflightBoards = append(o.InflightBoards, ifObj)
 			o.commit()
 			go o.Ingest()
@@ -280,6 +450,7 @@ func (o *inflights) Insert(input interface{}) {
 		}
 		if i.GetThreadData() != nil {
 			ifObj := createInflightThread(&i)
+			o.sequence(ifObj.Status)
 			o.InflightThreads = append(o.InflightThreads, ifObj)
 			o.commit()
 			go o.Ingest()
@@ -287,6 +458,7 @@ func (o *inflights) Insert(input interface{}) {
 		}
 		if i.GetPostData() != nil {
 			ifObj := createInflightPost(&i)
+			o.sequence(ifObj.Status)
 			o.InflightPosts = append(o.InflightPosts, ifObj)
 			o.commit()
 			go o.Ingest()
@@ -294,11 +466,20 @@ func (o *inflights) Insert(input interface{}) {
 		}
 		if i.GetKeyData() != nil {
 			ifObj := createInflightKey(&i)
+			o.sequence(ifObj.Status)
 			o.InflightKeys = append(o.InflightKeys, ifObj)
 			o.commit()
 			go o.Ingest()
 			return
 		}
+		if i.GetDirectMessageData() != nil {
+			ifObj := createInflightDirectMessage(&i)
+			o.sequence(ifObj.Status)
+			o.InflightDirectMessages = append(o.InflightDirectMessages, ifObj)
+			o.commit()
+			go o.Ingest()
+			return
+		}
 	case feapi.SignalEventPayload:
 		// CREATE or UPDATE for Votes, Truststates
 		if targetType := i.GetSignalTargetType(); targetType == feapi.SignalTargetType_CONTENT {
@@ -309,6 +490,7 @@ func (o *inflights) Insert(input interface{}) {
 				return
 			}
 			ifObj := createInflightVote(&i)
+			o.sequence(ifObj.Status)
 			o.InflightVotes = append(o.InflightVotes, ifObj)
 			o.cleanRepeatVotes()
 			o.commit()
@@ -317,6 +499,7 @@ func (o *inflights) Insert(input interface{}) {
 		}
 		if targetType := i.GetSignalTargetType(); targetType == feapi.SignalTargetType_USER {
 			ifObj := createInflightTruststate(&i)
+			o.sequence(ifObj.Status)
 			o.InflightTruststates = append(o.InflightTruststates, ifObj)
 			o.cleanRepeatTruststates()
 			o.commit()
@@ -328,6 +511,12 @@ func (o *inflights) Insert(input interface{}) {
 	}
 }
 
+// sequence gives the item its place in the queue.
+func (o *inflights) sequence(st *InflightStatus) {
+	o.LastSequence++
+	st.Sequence = o.LastSequence
+}
+
 func saveModIgnore(boardfp, threadfp, targetfp string) {
 	logging.Logf(1, "Save MODIGNORE Runs for: BoardFp: %v, ThreadFp: %v, TargetFp: %v", boardfp, threadfp, targetfp)
 	if len(threadfp) == 0 {
@@ -566,6 +755,8 @@ func createInflightBoard(i *feapi.ContentEventPayload) InflightBoard {
 			Meta:        i.GetBoardData().GetMeta(),
 			// TODO FUTURE: Add board mods here after adding the UI for it.
 		},
+		BoardKey: i.GetBoardKeyData(),
+		BoardPoW: i.GetBoardPoWData(),
 	}
 }
 
@@ -633,6 +824,24 @@ func createInflightKey(i *feapi.ContentEventPayload) InflightKey {
 	}
 }
 
+func createInflightDirectMessage(i *feapi.ContentEventPayload) InflightDirectMessage {
+	ifs := NewInflightStatus(STATUS_WAITING, i.GetEvent().GetEventType().String())
+	return InflightDirectMessage{
+		Status: &ifs,
+		Entity: beObj.DirectMessage{
+			/*----------  Identity fields  ----------*/
+			Provable: &beObj.Provable{
+				Creation: time.Now().Unix(),
+			},
+			Owner: i.GetEvent().GetOwnerFingerprint(),
+			/*----------  Data fields  ----------*/
+			Recipient: i.GetDirectMessageData().GetRecipient(),
+			Body:      i.GetDirectMessageData().GetBody(),
+			Meta:      i.GetDirectMessageData().GetMeta(),
+		},
+	}
+}
+
 /*----------  Signal events  ----------*/
 
 func createInflightVote(i *feapi.SignalEventPayload) InflightVote {
@@ -787,6 +996,15 @@ func (o *inflights) Prune() {
 	}
 	o.InflightTruststates = newInflightTruststates
 
+	newInflightDirectMessages := []InflightDirectMessage{}
+	for k, _ := range o.InflightDirectMessages {
+		if o.InflightDirectMessages[k].Status.Fulfilled() {
+			continue
+		}
+		newInflightDirectMessages = append(newInflightDirectMessages, o.InflightDirectMessages[k])
+	}
+	o.InflightDirectMessages = newInflightDirectMessages
+
 	o.commit()
 }
 
@@ -11,11 +11,15 @@ import (
 	"aether-core/aether/frontend/refresher"
 	"aether-core/aether/io/api"
 	"aether-core/aether/protos/beapi"
+	"aether-core/aether/protos/feapi"
 	pbstructs "aether-core/aether/protos/mimapi"
 	"aether-core/aether/services/create"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/metaparse"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 )
 
@@ -24,6 +28,8 @@ Ingest reads through all of the inflights list and mints them. When this is call
 
 Ingest runs when the app is opened, to get rid of anything that might be waiting in the queue when the app was closed the last time. It also runs every time when an entity is added to the ingest queue.
 
+The queue is consumed strictly in the order the items came in, so that dependent items (e.g. a thread, then its first post) reach the backend in order. If the item at the head is waiting for a retry, everything behind it waits too.
+
 (Committing to kvstore on its own does not automatically trigger an ingest because we're doing it in quite a few places.)
 */
 func (o *inflights) Ingest() {
@@ -43,7 +49,12 @@ func (o *inflights) Ingest() {
 	defer logging.Logf(1, "Ingest is done.")
 IngestorLoop:
 	for {
-		switch e := o.getNextItem().(type) {
+		next, st := o.getNextItem()
+		if st != nil && !st.due(time.Now().Unix()) {
+			o.waitForRetry(st)
+			continue
+		}
+		switch e := next.(type) {
 		case *InflightBoard:
 			switch e.Status.EventType {
 			case "CREATE":
@@ -98,6 +109,15 @@ IngestorLoop:
 			default:
 				logging.Logf(1, "The event type of this inflight entity could not be determined by the ingestor. Entity: %#v", e)
 			}
+		case *InflightDirectMessage:
+			// Direct messages are immutable, there is no update.
+			switch e.Status.EventType {
+			case "CREATE":
+				e.ingestCreate(o)
+			default:
+				e.Status.Fail(fmt.Sprintf("Direct messages can only be created. Event type: %v", e.Status.EventType))
+				o.PushChangesToClient()
+			}
 		case nil:
 			logging.Logf(1, "We've reached the end of the ingest queue. Breaking out of the for loop.")
 			break IngestorLoop // If we receive something empty, we just break this.
@@ -109,45 +129,44 @@ IngestorLoop:
 	}
 }
 
-func (o *inflights) getNextItem() interface{} {
+func (o *inflights) getNextItem() (interface{}, *InflightStatus) {
 	// o.lock.Lock()
 	// defer o.lock.Unlock()
 	// This is the api used by the minter and it will pull the next item in the queue. it is going to be a pointer, and it won't remove the item from the list. the minter needs to set the state of the next item to 'minting'
-	now := time.Now().Unix()
 	var oldestEntity interface{}
-	oldestTs := now
-	for k, _ := range o.InflightBoards {
-		if e := o.InflightBoards[k]; e.Status.RequestedTimestamp <= oldestTs && (e.Status.StatusText == STATUS_WAITING || e.Status.StatusText == STATUS_MINTING) {
-			oldestEntity = interface{}(&o.InflightBoards[k])
+	var oldestStatus *InflightStatus
+	consider := func(e interface{}, st *InflightStatus) {
+		if !st.pending() {
+			return
+		}
+		if oldestStatus == nil || st.before(oldestStatus) {
+			oldestEntity = e
+			oldestStatus = st
 		}
 	}
+	for k, _ := range o.InflightBoards {
+		consider(&o.InflightBoards[k], o.InflightBoards[k].Status)
+	}
 	for k, _ := range o.InflightThreads {
-		if e := o.InflightThreads[k]; e.Status.RequestedTimestamp <= oldestTs && (e.Status.StatusText == STATUS_WAITING || e.Status.StatusText == STATUS_MINTING) {
-			oldestEntity = interface{}(&o.InflightThreads[k])
-		}
+		consider(&o.InflightThreads[k], o.InflightThreads[k].Status)
 	}
 	for k, _ := range o.InflightPosts {
-		if e := o.InflightPosts[k]; e.Status.RequestedTimestamp <= oldestTs && (e.Status.StatusText == STATUS_WAITING || e.Status.StatusText == STATUS_MINTING) {
-			oldestEntity = interface{}(&o.InflightPosts[k])
-		}
+		consider(&o.InflightPosts[k], o.InflightPosts[k].Status)
 	}
 	for k, _ := range o.InflightVotes {
-		if e := o.InflightVotes[k]; e.Status.RequestedTimestamp <= oldestTs && (e.Status.StatusText == STATUS_WAITING || e.Status.StatusText == STATUS_MINTING) {
-			oldestEntity = interface{}(&o.InflightVotes[k])
-		}
+		consider(&o.InflightVotes[k], o.InflightVotes[k].Status)
 	}
 	for k, _ := range o.InflightKeys {
-		if e := o.InflightKeys[k]; e.Status.RequestedTimestamp <= oldestTs && (e.Status.StatusText == STATUS_WAITING || e.Status.StatusText == STATUS_MINTING) {
-			oldestEntity = interface{}(&o.InflightKeys[k])
-		}
+		consider(&o.InflightKeys[k], o.InflightKeys[k].Status)
 	}
 	for k, _ := range o.InflightTruststates {
-		if e := o.InflightTruststates[k]; e.Status.RequestedTimestamp <= oldestTs && (e.Status.StatusText == STATUS_WAITING || e.Status.StatusText == STATUS_MINTING) {
-			oldestEntity = interface{}(&o.InflightTruststates[k])
-		}
+		consider(&o.InflightTruststates[k], o.InflightTruststates[k].Status)
+	}
+	for k, _ := range o.InflightDirectMessages {
+		consider(&o.InflightDirectMessages[k], o.InflightDirectMessages[k].Status)
 	}
 	logging.Logf(1, "Returned oldest entity is: %#v", oldestEntity)
-	return oldestEntity
+	return oldestEntity, oldestStatus
 }
 
 /*----------  Specific ingest functions  ----------*/
@@ -162,40 +181,55 @@ func (o *InflightBoard) ingestCreate(ifl *inflights) {
 	case STATUS_WAITING, STATUS_MINTING:
 		o.Status.Update(STATUS_MINTING)
 		ifl.PushChangesToClient()
+		meta, err0 := o.applyBoardKey(o.Entity.GetMeta())
+		if err0 != nil {
+			o.Status.Fail(fmt.Sprintf("The board key could not be issued for the new board. Error: %v", err0))
+			ifl.PushChangesToClient()
+			return
+		}
+		meta, err0 = o.applyBoardPoW(meta)
+		if err0 != nil {
+			o.Status.Fail(fmt.Sprintf("The minimum PoW could not be set for the new board. Error: %v", err0))
+			ifl.PushChangesToClient()
+			return
+		}
+		mint := ifl.startMint(o.Status)
 		e, err := create.CreateBoard(
 			o.Entity.GetName(),
 			api.Fingerprint(o.Entity.GetOwner()),
 			GetLocalUserOwnerPk(o.Entity.GetOwner()),
 			[]api.BoardOwner{},
 			o.Entity.GetDescription(),
-			o.Entity.GetMeta(),
-			"")
+			meta,
+			"", mint)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in board creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in board creation encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&e))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := e.Protobuf()
-		eps := []*pbstructs.Board{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(e.Fingerprint)
+		logging.Logf(1, "Board created: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Board{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Board created: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -218,8 +252,7 @@ func (o *InflightBoard) ingestUpdate(ifl *inflights) {
 		fps := []string{fp}
 		e := beapiconsumer.GetBoards(0, 0, fps, true, true)
 		if len(e) == 0 {
-			logging.Logf(1, "We have an entity update request, but the origin entity does not exist in the backend.")
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("We have an entity update request, but the origin entity does not exist in the backend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -231,38 +264,60 @@ func (o *InflightBoard) ingestUpdate(ifl *inflights) {
 		ur.BoardOwnersUpdated = false
 		ur.NewBoardOwners = []api.BoardOwner{}
 		// ^ FUTURE, this is where the board owner insertion can go in.
-		ur.DescriptionUpdated = true
 		/*
 			Heads up, when we eventually end up with multiple fields that can be updated, we need to make it so that these 'updated' fields are set correctly. Otherwise, updating one field and not touching the rest can accidentally wipe out the rest of the fields.
+
+			A board key or a board PoW request can come without a description, in which case it leaves the description alone.
 		*/
+		metaRequested := o.BoardKey != nil || o.BoardPoW != nil
+		ur.DescriptionUpdated = !metaRequested || len(o.Entity.GetDescription()) > 0
 		ur.NewDescription = o.Entity.GetDescription()
+		if metaRequested {
+			meta, err0 := o.applyBoardKey(entity.Meta)
+			if err0 != nil {
+				o.Status.Fail(fmt.Sprintf("The board key request could not be applied to the board. Error: %v", err0))
+				ifl.PushChangesToClient()
+				return
+			}
+			meta, err0 = o.applyBoardPoW(meta)
+			if err0 != nil {
+				o.Status.Fail(fmt.Sprintf("The board PoW request could not be applied to the board. Error: %v", err0))
+				ifl.PushChangesToClient()
+				return
+			}
+			ur.MetaUpdated = true
+			ur.NewMeta = meta
+		}
+		ur.Mint = ifl.startMint(o.Status)
 		err := create.UpdateBoard(ur)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in board creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in board update encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&entity))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := entity.Protobuf()
-		eps := []*pbstructs.Board{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(entity.Fingerprint)
+		logging.Logf(1, "Board updated: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Board{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Board updated: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -275,6 +330,36 @@ func (o *InflightBoard) ingestUpdate(ifl *inflights) {
 	}
 }
 
+// applyBoardKey issues or grants the board key as the board key request asks, and returns the resulting meta of the board. Without a board key request, the meta is returned as is.
+func (o *InflightBoard) applyBoardKey(meta string) (string, error) {
+	if o.BoardKey == nil {
+		return meta, nil
+	}
+	switch o.BoardKey.GetAction() {
+	case feapi.BoardKeyAction_ISSUE_BOARD_KEY:
+		return create.IssueBoardKey(meta, o.BoardKey.GetMemberPublicKeys())
+	case feapi.BoardKeyAction_GRANT_BOARD_KEY:
+		return create.GrantBoardKey(meta, o.BoardKey.GetMemberPublicKeys())
+	}
+	return meta, errors.New(fmt.Sprintf("This board key request has an unknown action. Action: %v", o.BoardKey.GetAction()))
+}
+
+// applyBoardPoW sets or removes the board's own minimum PoW strengths as the board PoW request asks, and returns the resulting meta of the board. Without a board PoW request, the meta is returned as is.
+func (o *InflightBoard) applyBoardPoW(meta string) (string, error) {
+	if o.BoardPoW == nil {
+		return meta, nil
+	}
+	if o.BoardPoW.GetRemove() {
+		return create.SetBoardMinimumPoW(meta, nil)
+	}
+	return create.SetBoardMinimumPoW(meta, &metaparse.BoardPoW{
+		Thread:       int(o.BoardPoW.GetThread()),
+		ThreadUpdate: int(o.BoardPoW.GetThreadUpdate()),
+		Post:         int(o.BoardPoW.GetPost()),
+		PostUpdate:   int(o.BoardPoW.GetPostUpdate()),
+	})
+}
+
 /*----------  Thread  ----------*/
 
 func (o *InflightThread) ingestCreate(ifl *inflights) {
@@ -282,41 +367,58 @@ func (o *InflightThread) ingestCreate(ifl *inflights) {
 	case STATUS_WAITING, STATUS_MINTING:
 		o.Status.Update(STATUS_MINTING)
 		ifl.PushChangesToClient()
-		e, err := create.CreateThread(
-			api.Fingerprint(o.Entity.GetBoard()),
-			o.Entity.GetName(),
-			o.Entity.GetBody(),
-			o.Entity.GetLink(),
-			api.Fingerprint(o.Entity.GetOwner()),
-			GetLocalUserOwnerPk(o.Entity.GetOwner()),
-			o.Entity.GetMeta(),
-			"")
+		mint := ifl.startMint(o.Status)
+		var e api.Thread
+		var err error
+		if board := getEncryptedBoard(o.Entity.GetBoard()); board != nil {
+			e, err = create.CreateEncryptedThread(
+				board,
+				o.Entity.GetName(),
+				o.Entity.GetBody(),
+				o.Entity.GetLink(),
+				api.Fingerprint(o.Entity.GetOwner()),
+				GetLocalUserOwnerPk(o.Entity.GetOwner()),
+				o.Entity.GetMeta(),
+				"", mint)
+		} else {
+			e, err = create.CreateThread(
+				api.Fingerprint(o.Entity.GetBoard()),
+				o.Entity.GetName(),
+				o.Entity.GetBody(),
+				o.Entity.GetLink(),
+				api.Fingerprint(o.Entity.GetOwner()),
+				GetLocalUserOwnerPk(o.Entity.GetOwner()),
+				o.Entity.GetMeta(),
+				"", mint)
+		}
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in board update encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in thread creation encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&e))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := e.Protobuf()
-		eps := []*pbstructs.Thread{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(e.Fingerprint)
+		logging.Logf(1, "Thread created: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Thread{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Thread created: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -341,8 +443,7 @@ func (o *InflightThread) ingestUpdate(ifl *inflights) {
 		fps := []string{fp}
 		e := beapiconsumer.GetThreads(0, 0, fps, "", true, true)
 		if len(e) == 0 {
-			logging.Logf(1, "We have an entity update request, but the origin entity does not exist in the backend.")
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("We have an entity update request, but the origin entity does not exist in the backend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -353,33 +454,39 @@ func (o *InflightThread) ingestUpdate(ifl *inflights) {
 		ur.Entity = &entity
 		ur.BodyUpdated = true
 		ur.NewBody = o.Entity.GetBody()
+		if len(entity.EncrContent) > 0 {
+			ur.Board = getEncryptedBoard(string(entity.Board))
+		}
+		ur.Mint = ifl.startMint(o.Status)
 		err := create.UpdateThread(ur)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in thread update encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in thread update encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&entity))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := entity.Protobuf()
-		eps := []*pbstructs.Thread{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(entity.Fingerprint)
+		logging.Logf(1, "Thread updated: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Thread{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Thread updated: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -399,41 +506,58 @@ func (o *InflightPost) ingestCreate(ifl *inflights) {
 	case STATUS_WAITING, STATUS_MINTING:
 		o.Status.Update(STATUS_MINTING)
 		ifl.PushChangesToClient()
-		e, err := create.CreatePost(
-			api.Fingerprint(o.Entity.GetBoard()),
-			api.Fingerprint(o.Entity.GetThread()),
-			api.Fingerprint(o.Entity.GetParent()),
-			o.Entity.GetBody(),
-			api.Fingerprint(o.Entity.GetOwner()),
-			GetLocalUserOwnerPk(o.Entity.GetOwner()),
-			o.Entity.GetMeta(),
-			"")
+		mint := ifl.startMint(o.Status)
+		var e api.Post
+		var err error
+		if board := getEncryptedBoard(o.Entity.GetBoard()); board != nil {
+			e, err = create.CreateEncryptedPost(
+				board,
+				api.Fingerprint(o.Entity.GetThread()),
+				api.Fingerprint(o.Entity.GetParent()),
+				o.Entity.GetBody(),
+				api.Fingerprint(o.Entity.GetOwner()),
+				GetLocalUserOwnerPk(o.Entity.GetOwner()),
+				o.Entity.GetMeta(),
+				"", mint)
+		} else {
+			e, err = create.CreatePost(
+				api.Fingerprint(o.Entity.GetBoard()),
+				api.Fingerprint(o.Entity.GetThread()),
+				api.Fingerprint(o.Entity.GetParent()),
+				o.Entity.GetBody(),
+				api.Fingerprint(o.Entity.GetOwner()),
+				GetLocalUserOwnerPk(o.Entity.GetOwner()),
+				o.Entity.GetMeta(),
+				"", mint)
+		}
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in board creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in post creation encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&e))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := e.Protobuf()
-		eps := []*pbstructs.Post{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(e.Fingerprint)
+		logging.Logf(1, "Post created: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Post{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Post created: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -456,8 +580,7 @@ func (o *InflightPost) ingestUpdate(ifl *inflights) {
 		fps := []string{fp}
 		e := beapiconsumer.GetPosts(0, 0, fps, "", "", true, true)
 		if len(e) == 0 {
-			logging.Logf(1, "We have an entity update request, but the origin entity does not exist in the backend.")
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("We have an entity update request, but the origin entity does not exist in the backend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -468,33 +591,39 @@ func (o *InflightPost) ingestUpdate(ifl *inflights) {
 		ur.Entity = &entity
 		ur.BodyUpdated = true
 		ur.NewBody = o.Entity.GetBody()
+		if len(entity.EncrContent) > 0 {
+			ur.Board = getEncryptedBoard(string(entity.Board))
+		}
+		ur.Mint = ifl.startMint(o.Status)
 		err := create.UpdatePost(ur)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in Post update encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in post update encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&entity))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := entity.Protobuf()
-		eps := []*pbstructs.Post{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(entity.Fingerprint)
+		logging.Logf(1, "Post updated: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Post{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Post updated: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -514,6 +643,7 @@ func (o *InflightVote) ingestCreate(ifl *inflights) {
 	case STATUS_WAITING, STATUS_MINTING:
 		o.Status.Update(STATUS_MINTING)
 		ifl.PushChangesToClient()
+		mint := ifl.startMint(o.Status)
 		e, err := create.CreateVote(
 			api.Fingerprint(o.Entity.GetBoard()),
 			api.Fingerprint(o.Entity.GetThread()),
@@ -523,33 +653,35 @@ func (o *InflightVote) ingestCreate(ifl *inflights) {
 			int(o.Entity.GetTypeClass()),
 			int(o.Entity.GetType()),
 			o.Entity.GetMeta(),
-			"")
+			"", mint)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in board creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in vote creation encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&e))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := e.Protobuf()
-		eps := []*pbstructs.Vote{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(e.Fingerprint)
+		logging.Logf(1, "Vote created: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Vote{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Vote created: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -572,8 +704,7 @@ func (o *InflightVote) ingestUpdate(ifl *inflights) {
 		fps := []string{fp}
 		e := beapiconsumer.GetVotes(0, 0, fps, "", "", "", -1, -1, false, true, true)
 		if len(e) == 0 {
-			logging.Logf(1, "We have an entity update request, but the origin entity does not exist in the backend.")
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("We have an entity update request, but the origin entity does not exist in the backend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -584,34 +715,36 @@ func (o *InflightVote) ingestUpdate(ifl *inflights) {
 		ur.Entity = &entity
 		ur.TypeUpdated = true
 		ur.NewType = int(o.Entity.GetType())
+		ur.Mint = ifl.startMint(o.Status)
 		err := create.UpdateVote(ur)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in Vote update encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in vote update encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&entity))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := entity.Protobuf()
-		eps := []*pbstructs.Vote{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(entity.Fingerprint)
+		logging.Logf(1, "Vote updated: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-			o.Status.Update(STATUS_COMPLETE)
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Vote{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Vote updated: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -639,7 +772,7 @@ func (o *InflightKey) ingestCreate(ifl *inflights) {
 		   If the FE doesn't have a local key entity, and this is a local key creation request, we need to mint this key, dehydrate it to JSON and stick it to the frontend config. then we send the result to the client, then to the backend.
 		*/
 		if !isLocalKeyRequest(o) {
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("Only the local user's key can be created or updated from this frontend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -652,31 +785,35 @@ func (o *InflightKey) ingestCreate(ifl *inflights) {
 		// We're good. Start minting.
 		o.Status.Update(STATUS_MINTING)
 		ifl.PushChangesToClient()
+		mint := ifl.startMint(o.Status)
 		key, err := create.CreateKey(
 			globals.FrontendConfig.GetMarshaledUserPublicKey(),
 			o.Entity.GetName(),
 			o.Entity.GetInfo(),
 			api.Timestamp(o.Entity.GetExpiry()),
 			o.Entity.GetMeta(),
-			"")
+			"", mint)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in key creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in key creation encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		logging.Logf(1, "Minted key!: %#v", key)
 		err2 := api.Verify(api.Provable(&key))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		/*----------  Special logic for key (insert to feconfig)  ----------*/
 		kJson, err := json.Marshal(key)
 		if err != nil {
-			logging.Logf(1, "The created local user key could not be converted to JSON. Error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("The created local user key could not be converted to JSON. Error: %v", err))
 			ifl.PushChangesToClient()
 			return
 		}
@@ -685,6 +822,7 @@ func (o *InflightKey) ingestCreate(ifl *inflights) {
 		kp := key.Protobuf()
 		eps := []*pbstructs.Key{}
 		eps = append(eps, &kp)
+		o.Minted = &kp
 		// Stick it to the key refresher and have it compile this (so that we can get the canonical name and other compiled properties, if any)
 		observableUniverse := make(map[string]bool)
 		observableUniverse[string(key.Fingerprint)] = true
@@ -693,14 +831,14 @@ func (o *InflightKey) ingestCreate(ifl *inflights) {
 		// Map the object's fingerprint to the request object, so when the BE sends us status updates for this object, we can find it.
 		o.Entity.Provable.Fingerprint = string(key.Fingerprint)
 		// The object was minted. Send to backend.
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		// ^ Past this point, a crash or a backend failure does not cost us the minting: we resume from the minted entity, which is saved with the inflight.
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Key{o.Minted}) {
+			return
 		}
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
@@ -718,7 +856,7 @@ func (o *InflightKey) ingestUpdate(ifl *inflights) {
 	switch o.Status.StatusText {
 	case STATUS_WAITING, STATUS_MINTING:
 		if !isLocalKeyRequest(o) {
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("Only the local user's key can be created or updated from this frontend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -728,7 +866,8 @@ func (o *InflightKey) ingestUpdate(ifl *inflights) {
 		// Pull the original entity from the frontend config
 		alu := globals.FrontendConfig.GetDehydratedLocalUserKeyEntity()
 		if len(alu) == 0 {
-			logging.Logf(1, "We have key update request, but the origin key does not exist in the frontend.")
+			o.Status.Fail("We have key update request, but the origin key does not exist in the frontend.")
+			ifl.PushChangesToClient()
 			return
 		}
 		var key api.Key
@@ -740,25 +879,29 @@ func (o *InflightKey) ingestUpdate(ifl *inflights) {
 		ur.NewInfo = o.Entity.GetInfo()
 		ur.ExpiryUpdated = false
 		ur.NewExpiry = api.Timestamp(0)
+		ur.Mint = ifl.startMint(o.Status)
 		err := create.UpdateKey(ur)
 
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in key creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in key update encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&key))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		/*----------  Special logic for key (insert to feconfig)  ----------*/
 		kJson, err := json.Marshal(key)
 		if err != nil {
-			logging.Logf(1, "The created local user key could not be converted to JSON. Error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("The created local user key could not be converted to JSON. Error: %v", err))
 			ifl.PushChangesToClient()
 			return
 		}
@@ -767,19 +910,19 @@ func (o *InflightKey) ingestUpdate(ifl *inflights) {
 		kp := key.Protobuf()
 		eps := []*pbstructs.Key{}
 		eps = append(eps, &kp)
+		o.Minted = &kp
 		observableUniverse := make(map[string]bool)
 		observableUniverse[string(key.Fingerprint)] = true
 		refresher.RefreshGlobalUserHeaders(eps, time.Now().Unix(), observableUniverse)
 		clapiconsumer.PushLocalUserAmbient()
 		o.Entity.Provable.Fingerprint = string(key.Fingerprint)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Key{o.Minted}) {
+			return
 		}
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
@@ -800,6 +943,7 @@ func (o *InflightTruststate) ingestCreate(ifl *inflights) {
 	case STATUS_WAITING, STATUS_MINTING:
 		o.Status.Update(STATUS_MINTING)
 		ifl.PushChangesToClient()
+		mint := ifl.startMint(o.Status)
 		e, err := create.CreateTruststate(
 			api.Fingerprint(o.Entity.GetTarget()),
 			api.Fingerprint(o.Entity.GetOwner()),
@@ -809,33 +953,35 @@ func (o *InflightTruststate) ingestCreate(ifl *inflights) {
 			api.Fingerprint(o.Entity.GetDomain()),
 			api.Timestamp(o.Entity.GetExpiry()),
 			o.Entity.GetMeta(),
-			"")
+			"", mint)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in board creation encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in truststate creation encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&e))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := e.Protobuf()
-		eps := []*pbstructs.Truststate{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(e.Fingerprint)
+		logging.Logf(1, "Truststate created: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
-			ifl.PushChangesToClient()
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Truststate{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Truststate created: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -858,8 +1004,7 @@ func (o *InflightTruststate) ingestUpdate(ifl *inflights) {
 		fps := []string{fp}
 		e := beapiconsumer.GetTruststates(0, 0, fps, -1, -1, "", "", true, true)
 		if len(e) == 0 {
-			logging.Logf(1, "We have an entity update request, but the origin entity does not exist in the backend.")
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail("We have an entity update request, but the origin entity does not exist in the backend.")
 			ifl.PushChangesToClient()
 			return
 		}
@@ -872,33 +1017,98 @@ func (o *InflightTruststate) ingestUpdate(ifl *inflights) {
 		ur.NewType = int(o.Entity.GetType())
 		ur.ExpiryUpdated = false
 		ur.NewExpiry = api.Timestamp(0)
+		ur.Mint = ifl.startMint(o.Status)
 		err := create.UpdateTruststate(ur)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
 		if err != nil {
-			logging.Logf(1, "Minting in Truststate update encountered an error: %v", err)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Minting in truststate update encountered an error: %v", err))
 			ifl.PushChangesToClient()
+			return
 		}
 		err2 := api.Verify(api.Provable(&entity))
 		if err2 != nil {
-			logging.Logf(1, "Verification after minting failed. Error: %v", err2)
-			o.Status.Update(STATUS_FAILED)
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
 			ifl.PushChangesToClient()
 			return
 		}
 		ep := entity.Protobuf()
-		eps := []*pbstructs.Truststate{}
-		eps = append(eps, &ep)
+		o.Minted = &ep
 		o.Entity.Provable.Fingerprint = string(entity.Fingerprint)
+		logging.Logf(1, "Truststate updated: %#v", e)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
 		/*----------  Send to backend  ----------*/
-		statusCode := SendToBackend(eps)
-		if statusCode == 200 {
-			logging.Logf(1, "Successfully inserted into the backend database!")
-		} else {
-			logging.Logf(1, "Insert to database request failed. Status code provided by the database: %v", statusCode)
-			o.Status.Update(STATUS_FAILED)
+		if !ifl.sendToBackend(o.Status, []*pbstructs.Truststate{o.Minted}) {
+			return
+		}
+		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
+		ifl.PushChangesToClient()
+		fallthrough
+	case STATUS_RECOMPILING_FRONTEND:
+		refresher.Refresh()
+		o.Status.Update(STATUS_COMPLETE)
+		ifl.PushChangesToClient()
+	default:
+		return
+	}
+}
+
+/*----------  Direct message  ----------*/
+
+func (o *InflightDirectMessage) ingestCreate(ifl *inflights) {
+	switch o.Status.StatusText {
+	case STATUS_WAITING, STATUS_MINTING:
+		recipientPk := getRecipientPk(o.Entity.GetRecipient())
+		if len(recipientPk) == 0 {
+			o.Status.Fail(fmt.Sprintf("The key of the recipient could not be found, so the message can't be encrypted to it. Recipient: %v", o.Entity.GetRecipient()))
 			ifl.PushChangesToClient()
+			return
+		}
+		o.Status.Update(STATUS_MINTING)
+		ifl.PushChangesToClient()
+		mint := ifl.startMint(o.Status)
+		e, err := create.CreateDirectMessage(
+			api.Fingerprint(o.Entity.GetRecipient()),
+			recipientPk,
+			o.Entity.GetBody(),
+			api.Fingerprint(o.Entity.GetOwner()),
+			GetLocalUserOwnerPk(o.Entity.GetOwner()),
+			o.Entity.GetMeta(),
+			"", mint)
+		if ifl.endMint() {
+			o.Status.Update(STATUS_CANCELLED)
+			ifl.PushChangesToClient()
+			return
+		}
+		if err != nil {
+			o.Status.Fail(fmt.Sprintf("Minting in direct message creation encountered an error: %v", err))
+			ifl.PushChangesToClient()
+			return
+		}
+		err2 := api.Verify(api.Provable(&e))
+		if err2 != nil {
+			o.Status.Fail(fmt.Sprintf("Verification after minting failed. Error: %v", err2))
+			ifl.PushChangesToClient()
+			return
+		}
+		ep := e.Protobuf()
+		o.Minted = &ep
+		o.Entity.Provable.Fingerprint = string(e.Fingerprint)
+		logging.Logf(1, "Direct message created. Fingerprint: %v", e.Fingerprint)
+		o.Status.Update(STATUS_ADDING_TO_BACKEND)
+		ifl.ManualSaveToKvStore()
+		fallthrough
+	case STATUS_ADDING_TO_BACKEND:
+		/*----------  Send to backend  ----------*/
+		if !ifl.sendToBackend(o.Status, []*pbstructs.DirectMessage{o.Minted}) {
+			return
 		}
-		logging.Logf(1, "Truststate updated: %#v", e)
 		o.Status.Update(STATUS_RECOMPILING_FRONTEND)
 		ifl.PushChangesToClient()
 		fallthrough
@@ -911,6 +1121,15 @@ func (o *InflightTruststate) ingestUpdate(ifl *inflights) {
 	}
 }
 
+// getRecipientPk returns the public key of the user the direct message is going to. Empty if we don't have the key of that user.
+func getRecipientPk(recipientfp string) string {
+	ks := beapiconsumer.GetKeys(0, 0, []string{recipientfp}, true, true)
+	if len(ks) == 0 {
+		return ""
+	}
+	return ks[0].GetKey()
+}
+
 /*----------  Specific methods special to the key creation  ----------*/
 
 /*
@@ -967,6 +1186,20 @@ func localKeyExists(o *InflightKey) bool {
 	return false
 }
 
+// getEncryptedBoard returns the board if it is encrypted, so that threads and posts in it are created encrypted as well. Returns nil for regular boards.
+func getEncryptedBoard(boardfp string) *api.Board {
+	bs := beapiconsumer.GetBoards(0, 0, []string{boardfp}, true, true)
+	if len(bs) == 0 {
+		return nil
+	}
+	board := api.Board{}
+	board.FillFromProtobuf(*bs[0])
+	if !create.IsEncryptedBoard(&board) {
+		return nil
+	}
+	return &board
+}
+
 func GetLocalUserOwnerPk(localUserFp string) string {
 	alu := globals.FrontendConfig.GetDehydratedLocalUserKeyEntity()
 	if len(alu) == 0 {
@@ -1000,6 +1233,8 @@ func SendToBackend(e interface{}) int {
 		payload.Keys = et
 	case []*pbstructs.Truststate:
 		payload.Truststates = et
+	case []*pbstructs.DirectMessage:
+		payload.DirectMessages = et
 	case []*pbstructs.Address:
 		payload.Addresses = et
 	}
@@ -0,0 +1,197 @@
+// Frontend > Inflights > Retry
+// This file keeps the queue going when the backend can't take an entity right away: transient failures are retried with exponential backoff, and the user can ask for a retry by hand.
+
+package inflights
+
+import (
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"fmt"
+	"time"
+)
+
+const (
+	maxSendAttempts  = 10
+	retryBackoffBase = 2 * time.Second
+	retryBackoffMax  = 5 * time.Minute
+	// ^ With these, we give up about 20 minutes after the first failure.
+)
+
+/*----------  Status helpers  ----------*/
+
+// pending tells whether the ingestor still has work to do on the item.
+func (o *InflightStatus) pending() bool {
+	switch o.StatusText {
+	case STATUS_WAITING, STATUS_MINTING, STATUS_ADDING_TO_BACKEND, STATUS_RECOMPILING_FRONTEND:
+		return true
+	}
+	return false
+}
+
+// before tells whether the item came into the queue before the other one.
+func (o *InflightStatus) before(other *InflightStatus) bool {
+	if o.Sequence != other.Sequence {
+		return o.Sequence < other.Sequence
+	}
+	return o.RequestedTimestamp < other.RequestedTimestamp
+}
+
+func (o *InflightStatus) due(nowts int64) bool {
+	return o.NextAttemptTimestamp <= nowts
+}
+
+// Fail marks the item failed for good, with the reason. The user can still retry it by hand.
+func (o *InflightStatus) Fail(reason string) {
+	logging.Logf(1, "Inflight failed. Id: %v, Reason: %v", o.Id, reason)
+	o.FailureReason = reason
+	o.NextAttemptTimestamp = 0
+	o.Update(STATUS_FAILED)
+}
+
+// scheduleRetry keeps the item in its current step, to be tried again after the backoff. If it is out of attempts, it fails instead.
+func (o *InflightStatus) scheduleRetry(reason string) {
+	o.Attempts++
+	if o.Attempts >= maxSendAttempts {
+		o.Fail(fmt.Sprintf("%v (Gave up after %v attempts.)", reason, o.Attempts))
+		return
+	}
+	backoff := retryBackoffBase << uint(o.Attempts-1)
+	if backoff > retryBackoffMax {
+		backoff = retryBackoffMax
+	}
+	logging.Logf(1, "Inflight will be retried in %v. Id: %v, Attempt: %v, Reason: %v", backoff, o.Id, o.Attempts, reason)
+	o.FailureReason = reason
+	o.NextAttemptTimestamp = time.Now().Add(backoff).Unix()
+	o.LastActionTimestamp = time.Now().Unix()
+}
+
+/*----------  Sending to the backend  ----------*/
+
+// transientBackendError tells whether the status code the backend gave us is worth retrying. 0 means we couldn't reach the backend at all. A 400 means the backend refused the entity itself, which won't change no matter how many times we send it.
+func transientBackendError(statusCode int) bool {
+	switch {
+	case statusCode == 0:
+		return true
+	case statusCode == 401: // Our access token expired, we'll get a new one.
+		return true
+	case statusCode == 429:
+		return true
+	case statusCode >= 500:
+		return true
+	}
+	return false
+}
+
+// sendToBackend sends the minted entity to the backend. If it fails, the item is either scheduled for a retry or failed, depending on what went wrong. Returns true if the backend took it.
+func (o *inflights) sendToBackend(st *InflightStatus, payload interface{}) bool {
+	statusCode := SendToBackend(payload)
+	if statusCode == 200 {
+		logging.Logf(1, "Successfully inserted into the backend database!")
+		st.Attempts = 0
+		st.NextAttemptTimestamp = 0
+		st.FailureReason = ""
+		return true
+	}
+	reason := fmt.Sprintf("Insert to database request failed. Status code provided by the database: %v", statusCode)
+	if transientBackendError(statusCode) {
+		st.scheduleRetry(reason)
+	} else {
+		st.Fail(reason)
+	}
+	o.ManualSaveToKvStore()
+	o.PushChangesToClient()
+	return false
+}
+
+/*----------  Waiting  ----------*/
+
+// waitForRetry blocks the ingestor until the item at the head of the queue is due, or until someone asks for a retry.
+func (o *inflights) waitForRetry(st *InflightStatus) {
+	wait := time.Duration(st.NextAttemptTimestamp-time.Now().Unix()) * time.Second
+	if wait <= 0 {
+		return
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-o.wake:
+	}
+}
+
+func (o *inflights) wakeUp() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+		// Already woken up.
+	}
+}
+
+/*----------  Manual retry  ----------*/
+
+// Retry puts a failed item back into the queue at its original place, or makes an item that is waiting for a retry try again now. A minted item resumes from sending to the backend, the rest are minted again.
+func (o *inflights) Retry(id string) bool {
+	if len(id) == 0 {
+		return false
+	}
+	o.lock.Lock()
+	retried := false
+	o.each(func(st *InflightStatus, minted bool) {
+		if st.Id != id {
+			return
+		}
+		switch {
+		case st.StatusText == STATUS_FAILED:
+			if minted {
+				st.Update(STATUS_ADDING_TO_BACKEND)
+			} else {
+				st.Update(STATUS_WAITING)
+			}
+		case st.pending() && !st.due(time.Now().Unix()):
+			st.LastActionTimestamp = time.Now().Unix()
+		default:
+			return
+		}
+		st.Attempts = 0
+		st.NextAttemptTimestamp = 0
+		retried = true
+	})
+	if retried {
+		o.commit()
+	}
+	o.lock.Unlock()
+	if !retried {
+		return false
+	}
+	o.wakeUp()
+	go o.Ingest()
+	o.PushChangesToClient()
+	return true
+}
+
+/*----------  After a restore  ----------*/
+
+/*
+HoldPendingAfterRestore fails everything that was still pending in a KV store that was just restored from a backup. We don't know whether these were sent after the backup was taken, and sending them again would post them twice. The user can look and retry them by hand.
+
+This has to run before the inflights are read for the first time, since that starts the ingest. Returns the number of items held.
+*/
+func HoldPendingAfterRestore() int {
+	o := inflights{}
+	err := globals.KvInstance.One("ID", 1, &o)
+	if err != nil {
+		return 0
+	}
+	held := 0
+	o.each(func(st *InflightStatus, minted bool) {
+		if !st.pending() {
+			return
+		}
+		st.Fail("This was waiting to be sent when the backup it is restored from was taken. It might have been sent since, please check before you retry it.")
+		held++
+	})
+	if held > 0 {
+		o.commit()
+	}
+	return held
+}
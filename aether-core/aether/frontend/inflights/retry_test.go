@@ -0,0 +1,289 @@
+package inflights
+
+// These test the retries of the items the backend couldn't take, the retries by hand, holding the pending items of a restored KV store, and holding off the queue for the KV store maintenance.
+
+import (
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"github.com/asdine/storm"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+// TestMain gives the tests an FE config and a KV store of their own. The client the changes are pushed to is not there: its port is free, so the pushes fail right away.
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "inflights-test")
+	if err != nil {
+		panic(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	freePort := uint16(l.Addr().(*net.TCPAddr).Port)
+	l.Close()
+	globals.FrontendConfig = &configstore.FrontendConfig{
+		Initialised:        true,
+		ClientAPIAddress:   "127.0.0.1",
+		ClientPort:         freePort,
+		GRPCServiceTimeout: 1 * time.Second,
+	}
+	globals.FrontendTransientConfig = &configstore.Ftc
+	kv, err := storm.Open(filepath.Join(dir, "KVStore.kv"))
+	if err != nil {
+		panic(err)
+	}
+	globals.KvInstance = kv
+	exitVal := m.Run()
+	kv.Close()
+	os.RemoveAll(dir)
+	os.Exit(exitVal)
+}
+
+// newTestInflights returns a queue whose ingestor counts as running already, so that a retry doesn't start one that would mint and send for real.
+func newTestInflights() *inflights {
+	return &inflights{wake: make(chan struct{}, 1), ingestRunning: true}
+}
+
+func status(id, statusText string, sequence int64) *InflightStatus {
+	st := NewInflightStatus(statusText, "CREATE")
+	st.Id = id
+	st.Sequence = sequence
+	return &st
+}
+
+func readStored(t *testing.T) *inflights {
+	o := inflights{}
+	if err := globals.KvInstance.One("ID", 1, &o); err != nil {
+		t.Fatalf("The inflights could not be read back from the KV store. Error: %v", err)
+	}
+	return &o
+}
+
+// Tests
+
+func TestTransientBackendError_Success(t *testing.T) {
+	cases := []struct {
+		statusCode int
+		transient  bool
+	}{
+		{0, true},
+		{401, true},
+		{429, true},
+		{500, true},
+		{503, true},
+		{400, false},
+		{403, false},
+		{404, false},
+	}
+	for _, c := range cases {
+		if transient := transientBackendError(c.statusCode); transient != c.transient {
+			t.Errorf("The backend error was classified wrong. Status code: %v, Expected transient: %v, Got: %v", c.statusCode, c.transient, transient)
+		}
+	}
+}
+
+func TestScheduleRetry_Backoff_Success(t *testing.T) {
+	cases := []struct {
+		attempt int
+		backoff time.Duration
+	}{
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 8 * time.Second},
+		{7, 128 * time.Second},
+		{8, 256 * time.Second},
+		// Capped from here on.
+		{9, retryBackoffMax},
+	}
+	for _, c := range cases {
+		st := status("backoff", STATUS_ADDING_TO_BACKEND, 1)
+		st.Attempts = c.attempt - 1
+		before := time.Now().Unix()
+		st.scheduleRetry("backend is down")
+		after := time.Now().Unix()
+		if st.Attempts != c.attempt {
+			t.Errorf("The attempt should have been counted. Expected: %v, Got: %v", c.attempt, st.Attempts)
+		}
+		if st.StatusText != STATUS_ADDING_TO_BACKEND || !st.pending() {
+			t.Errorf("An item waiting for a retry should stay in its step. Attempt: %v, Status: %v", c.attempt, st.StatusText)
+		}
+		if st.NextAttemptTimestamp < before+int64(c.backoff/time.Second) || st.NextAttemptTimestamp > after+int64(c.backoff/time.Second) {
+			t.Errorf("The retry was scheduled at the wrong time. Attempt: %v, Expected backoff: %v, Got: %vs", c.attempt, c.backoff, st.NextAttemptTimestamp-before)
+		}
+		if st.due(before) {
+			t.Errorf("An item waiting for a retry should not be due right away. Attempt: %v", c.attempt)
+		}
+		if st.FailureReason != "backend is down" {
+			t.Errorf("The failure reason should be kept. Got: %v", st.FailureReason)
+		}
+	}
+}
+
+func TestScheduleRetry_OutOfAttempts_Fail(t *testing.T) {
+	st := status("out of attempts", STATUS_ADDING_TO_BACKEND, 1)
+	st.Attempts = maxSendAttempts - 1
+	st.scheduleRetry("backend is down")
+	if st.StatusText != STATUS_FAILED || st.CompletionPercent != -1 || st.pending() {
+		t.Errorf("An item out of attempts should fail. Status: %#v", st)
+	}
+	if !strings.Contains(st.FailureReason, "backend is down") || !strings.Contains(st.FailureReason, "Gave up after 10 attempts") {
+		t.Errorf("The failure reason should say why and that we gave up. Got: %v", st.FailureReason)
+	}
+	if st.NextAttemptTimestamp != 0 {
+		t.Errorf("A failed item should not be waiting for a retry. Next attempt: %v", st.NextAttemptTimestamp)
+	}
+}
+
+func TestGetNextItem_Order_Success(t *testing.T) {
+	o := newTestInflights()
+	// The thread came in first, then its first post. The post is a type the ingestor looks at later, but it should still come after the thread. A board that came in even earlier but failed is skipped.
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("post", STATUS_WAITING, 3)})
+	o.InflightThreads = append(o.InflightThreads, InflightThread{Status: status("thread", STATUS_WAITING, 2)})
+	o.InflightBoards = append(o.InflightBoards, InflightBoard{Status: status("board", STATUS_FAILED, 1)})
+	// Items from before we kept the sequence go by their requested timestamp.
+	o.InflightVotes = append(o.InflightVotes, InflightVote{Status: status("vote", STATUS_WAITING, 0)})
+	o.InflightVotes[0].Status.RequestedTimestamp = 1
+	order := []string{}
+	for {
+		_, st := o.getNextItem()
+		if st == nil {
+			break
+		}
+		order = append(order, st.Id)
+		st.Update(STATUS_COMPLETE)
+	}
+	if strings.Join(order, ",") != "vote,thread,post" {
+		t.Errorf("The items came out of the queue in the wrong order. Got: %v", order)
+	}
+}
+
+func TestRetry_Failed_Success(t *testing.T) {
+	o := newTestInflights()
+	minted := InflightThread{Status: status("minted", STATUS_FAILED, 1)}
+	minted.Minted = &minted.Entity
+	o.InflightThreads = append(o.InflightThreads, minted)
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("not minted", STATUS_FAILED, 2)})
+	o.InflightThreads[0].Status.Attempts = maxSendAttempts
+	o.InflightThreads[0].Status.FailureReason = "backend is down"
+	if !o.Retry("minted") {
+		t.Fatalf("The failed item should have been retried.")
+	}
+	if st := o.InflightThreads[0].Status; st.StatusText != STATUS_ADDING_TO_BACKEND || st.Attempts != 0 || !st.due(time.Now().Unix()) {
+		t.Errorf("A minted item should be sent again right away, with its attempts reset. Status: %#v", st)
+	}
+	if !o.Retry("not minted") {
+		t.Fatalf("The failed item should have been retried.")
+	}
+	if st := o.InflightPosts[0].Status; st.StatusText != STATUS_WAITING {
+		t.Errorf("An item that isn't minted should be minted again. Status: %#v", st)
+	}
+	// The retry is saved, so that it survives a restart.
+	stored := readStored(t)
+	if len(stored.InflightThreads) != 1 || stored.InflightThreads[0].Status.StatusText != STATUS_ADDING_TO_BACKEND {
+		t.Errorf("The retried item should have been saved to the KV store. Stored: %#v", stored.InflightThreads)
+	}
+}
+
+func TestRetry_WaitingForRetry_Success(t *testing.T) {
+	o := newTestInflights()
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("waiting", STATUS_ADDING_TO_BACKEND, 1)})
+	o.InflightPosts[0].Status.scheduleRetry("backend is down")
+	if !o.Retry("waiting") {
+		t.Fatalf("The item waiting for a retry should have been retried.")
+	}
+	st := o.InflightPosts[0].Status
+	if !st.due(time.Now().Unix()) || st.Attempts != 0 || st.StatusText != STATUS_ADDING_TO_BACKEND {
+		t.Errorf("The item should be due right away, in the same step. Status: %#v", st)
+	}
+	select {
+	case <-o.wake:
+	default:
+		t.Errorf("The ingestor waiting for the retry should have been woken up.")
+	}
+}
+
+func TestRetry_Fail(t *testing.T) {
+	o := newTestInflights()
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("complete", STATUS_COMPLETE, 1)})
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("due", STATUS_WAITING, 2)})
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("cancelled", STATUS_CANCELLED, 3)})
+	for _, id := range []string{"", "not there", "complete", "due", "cancelled"} {
+		if o.Retry(id) {
+			t.Errorf("This item should not have been retried. Id: %q", id)
+		}
+	}
+	if o.InflightPosts[0].Status.StatusText != STATUS_COMPLETE || o.InflightPosts[2].Status.StatusText != STATUS_CANCELLED {
+		t.Errorf("The items that weren't retried should have been left as they were.")
+	}
+}
+
+func TestHoldPendingAfterRestore_Success(t *testing.T) {
+	o := newTestInflights()
+	o.InflightThreads = append(o.InflightThreads, InflightThread{Status: status("minting", STATUS_MINTING, 1)})
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("sending", STATUS_ADDING_TO_BACKEND, 2)})
+	o.InflightPosts = append(o.InflightPosts, InflightPost{Status: status("complete", STATUS_COMPLETE, 3)})
+	o.InflightVotes = append(o.InflightVotes, InflightVote{Status: status("failed", STATUS_FAILED, 4)})
+	o.InflightVotes[0].Status.FailureReason = "backend refused it"
+	o.commit()
+	if held := HoldPendingAfterRestore(); held != 2 {
+		t.Errorf("The pending items should have been held. Expected: 2, Got: %v", held)
+	}
+	stored := readStored(t)
+	for _, st := range []*InflightStatus{stored.InflightThreads[0].Status, stored.InflightPosts[0].Status} {
+		if st.StatusText != STATUS_FAILED || !strings.Contains(st.FailureReason, "backup") {
+			t.Errorf("A pending item should be failed, saying why. Status: %#v", st)
+		}
+	}
+	if st := stored.InflightPosts[1].Status; st.StatusText != STATUS_COMPLETE {
+		t.Errorf("A complete item should have been left as it was. Status: %#v", st)
+	}
+	if st := stored.InflightVotes[0].Status; st.FailureReason != "backend refused it" {
+		t.Errorf("An item that failed before should keep its reason. Status: %#v", st)
+	}
+	// Nothing is pending anymore.
+	if held := HoldPendingAfterRestore(); held != 0 {
+		t.Errorf("Nothing should have been held the second time. Got: %v", held)
+	}
+}
+
+func TestHoldPendingAfterRestore_NoInflights_Success(t *testing.T) {
+	if err := globals.KvInstance.Drop(&inflights{}); err != nil {
+		t.Fatalf("The inflights could not be cleared from the KV store. Error: %v", err)
+	}
+	if held := HoldPendingAfterRestore(); held != 0 {
+		t.Errorf("Nothing should have been held without inflights. Got: %v", held)
+	}
+}
+
+func TestHold_Success(t *testing.T) {
+	o := newTestInflights()
+	saved := make(chan struct{})
+	err := o.Hold(func() error {
+		go func() {
+			o.ManualSaveToKvStore()
+			close(saved)
+		}()
+		select {
+		case <-saved:
+			t.Errorf("The queue should not save into the KV store while it is held off.")
+		case <-time.After(200 * time.Millisecond):
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("The hold should return what the held function returns. Got: %v", err)
+	}
+	select {
+	case <-saved:
+	case <-time.After(5 * time.Second):
+		t.Errorf("The queue should save into the KV store once the hold is over.")
+	}
+}
@@ -0,0 +1,271 @@
+// Frontend > KeyValueStore > Backup
+// This file takes backups of the KV store together with the search index, and restores them. The two go together: a KV store without a matching index gets deleted at the next start (see OpenKVStore), and it takes the user state in it (notifications, inflights, ambients) along.
+
+package kvstore
+
+import (
+	"aether-core/aether/frontend/search"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/toolbox"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/asdine/storm"
+	"github.com/coreos/bbolt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	backupManifestFilename = "backup.json"
+	backupKVStoreFilename  = "KVStore.kv"
+	backupIndexDirname     = "searchindex"
+)
+
+type BackupManifest struct {
+	Created     int64
+	KVStoreSize int64
+}
+
+// AutomaticBackupLocation is where the app keeps the backup it takes on its own. There is only ever one, every new one replaces the last.
+func AutomaticBackupLocation() string {
+	return filepath.Join(globals.FrontendConfig.GetUserDirectory(), "frontend", "backup")
+}
+
+/*
+Backup writes a snapshot of the open KV store and the open search index into the given directory, which must not exist yet.
+
+The refresher is the only thing that writes into the index, so holding it off makes the two match. The KV store is copied from a single read transaction, so the rest of the app can keep writing to it while this runs.
+
+The backup is put together in a temporary directory next to the destination and moved into place at the end, so a backup that is there is always complete.
+*/
+func Backup(dir string) error {
+	if toolbox.FileExists(dir) {
+		return errors.New(fmt.Sprintf("The backup destination already exists. Destination: %v", dir))
+	}
+	globals.FrontendTransientConfig.RefresherMutex.Lock()
+	defer globals.FrontendTransientConfig.RefresherMutex.Unlock()
+	start := time.Now()
+	tmpdir := dir + ".partial"
+	toolbox.DeleteFromDisk(tmpdir)
+	toolbox.CreatePath(tmpdir)
+	kvbackup := filepath.Join(tmpdir, backupKVStoreFilename)
+	err := globals.KvInstance.Bolt.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(kvbackup, 0600)
+	})
+	if err != nil {
+		toolbox.DeleteFromDisk(tmpdir)
+		return errors.New(fmt.Sprintf("The KV store could not be backed up. Error: %v", err))
+	}
+	err2 := search.Snapshot(filepath.Join(tmpdir, backupIndexDirname))
+	if err2 != nil {
+		toolbox.DeleteFromDisk(tmpdir)
+		return errors.New(fmt.Sprintf("The search index could not be backed up. Error: %v", err2))
+	}
+	manifest := BackupManifest{Created: time.Now().Unix()}
+	if fi, err := os.Stat(kvbackup); err == nil {
+		manifest.KVStoreSize = fi.Size()
+	}
+	mb, _ := json.MarshalIndent(manifest, "", "  ")
+	err3 := ioutil.WriteFile(filepath.Join(tmpdir, backupManifestFilename), mb, 0600)
+	if err3 != nil {
+		toolbox.DeleteFromDisk(tmpdir)
+		return err3
+	}
+	err4 := os.Rename(tmpdir, dir)
+	if err4 != nil {
+		toolbox.DeleteFromDisk(tmpdir)
+		return err4
+	}
+	logging.Logf(1, "The frontend is backed up. Destination: %v, Took: %v", dir, time.Since(start))
+	return nil
+}
+
+// BackupAutomatically replaces the automatic backup with a new one.
+func BackupAutomatically() error {
+	loc := AutomaticBackupLocation()
+	newloc := loc + ".new"
+	toolbox.DeleteFromDisk(newloc)
+	err := Backup(newloc)
+	if err != nil {
+		return err
+	}
+	toolbox.DeleteFromDisk(loc)
+	return os.Rename(newloc, loc)
+}
+
+// ReadBackupManifest checks that the directory has a complete backup in it, and returns its manifest.
+func ReadBackupManifest(dir string) (BackupManifest, error) {
+	manifest := BackupManifest{}
+	mb, err := ioutil.ReadFile(filepath.Join(dir, backupManifestFilename))
+	if err != nil {
+		return manifest, errors.New(fmt.Sprintf("This is not a frontend backup, or it is incomplete. Directory: %v, Error: %v", dir, err))
+	}
+	err2 := json.Unmarshal(mb, &manifest)
+	if err2 != nil {
+		return manifest, errors.New(fmt.Sprintf("The manifest of the backup could not be read. Directory: %v, Error: %v", dir, err2))
+	}
+	if !toolbox.FileExists(filepath.Join(dir, backupKVStoreFilename)) || !toolbox.FileExists(filepath.Join(dir, backupIndexDirname)) {
+		return manifest, errors.New(fmt.Sprintf("The backup is missing the KV store or the search index. Directory: %v", dir))
+	}
+	return manifest, nil
+}
+
+/*
+Restore replaces the KV store and the search index with the ones in the backup. The KV store and the index must be closed: this is for when the app is not running, or before they're opened at start.
+
+The current KV store and index are kept aside until the backup is in place, and put back if anything goes wrong on the way.
+*/
+func Restore(dir string) error {
+	_, err := ReadBackupManifest(dir)
+	if err != nil {
+		return err
+	}
+	kvloc := kvStoreLocation()
+	iloc := search.IndexLocation()
+	if toolbox.FileExists(kvloc) {
+		// Make sure nothing has the KV store open. The app holds it for as long as it runs.
+		db, err := bolt.Open(kvloc, 0600, &bolt.Options{Timeout: lockTimeout})
+		if err != nil {
+			return errors.New(fmt.Sprintf("The KV store is in use. If the app is running, please close it first. Error: %v", err))
+		}
+		db.Close()
+	}
+	toolbox.CreatePath(filepath.Dir(kvloc))
+	kvaside := kvloc + ".before-restore"
+	iaside := iloc + ".before-restore"
+	toolbox.DeleteFromDisk(kvaside)
+	toolbox.DeleteFromDisk(iaside)
+	if toolbox.FileExists(kvloc) {
+		if err := os.Rename(kvloc, kvaside); err != nil {
+			return errors.New(fmt.Sprintf("The current KV store could not be moved aside. Error: %v", err))
+		}
+	}
+	if toolbox.FileExists(iloc) {
+		if err := os.Rename(iloc, iaside); err != nil {
+			putBack(kvaside, kvloc)
+			return errors.New(fmt.Sprintf("The current search index could not be moved aside. Error: %v", err))
+		}
+	}
+	err2 := copyFile(filepath.Join(dir, backupKVStoreFilename), kvloc)
+	if err2 == nil {
+		err2 = copyDir(filepath.Join(dir, backupIndexDirname), iloc)
+	}
+	if err2 != nil {
+		toolbox.DeleteFromDisk(kvloc)
+		toolbox.DeleteFromDisk(iloc)
+		putBack(kvaside, kvloc)
+		putBack(iaside, iloc)
+		return errors.New(fmt.Sprintf("The backup could not be restored. The KV store and the search index are left as they were. Error: %v", err2))
+	}
+	toolbox.DeleteFromDisk(kvaside)
+	toolbox.DeleteFromDisk(iaside)
+	logging.Logf(1, "The frontend is restored from the backup. Source: %v", dir)
+	return nil
+}
+
+func putBack(aside, loc string) {
+	if !toolbox.FileExists(aside) {
+		return
+	}
+	err := os.Rename(aside, loc)
+	if err != nil {
+		logging.Logf(1, "We could not put this back into its place after a failed restore. You can find it at: %v, Error: %v", aside, err)
+	}
+}
+
+/*
+RestoreIfIndexLost restores the automatic backup if the search index is gone but the KV store is still there. Without this, OpenKVStore deletes the KV store so that it can be rebuilt along with the index, and all the user state in it goes with it. With this, we only lose what happened since the last automatic backup.
+
+Returns true if the backup is restored. This has to run before the KV store is opened.
+*/
+func RestoreIfIndexLost() bool {
+	if search.IndexExists() || !kvStoreExists() {
+		return false
+	}
+	loc := AutomaticBackupLocation()
+	if _, err := ReadBackupManifest(loc); err != nil {
+		logging.Logf(1, "The search index is missing, and there is no automatic backup to restore. Error: %v", err)
+		return false
+	}
+	logging.Logf(1, "The search index is missing. Restoring the automatic backup.")
+	err := Restore(loc)
+	if err != nil {
+		logging.Logf(1, "The automatic backup could not be restored. Error: %v", err)
+		return false
+	}
+	// The backup can be from before a mapping change, in which case its index isn't good either.
+	return search.IndexExists()
+}
+
+/*----------  Opening for maintenance  ----------*/
+
+// OpenForMaintenance opens the KV store and the search index for the commands that work on them while the app is not running. Unlike OpenKVStore, this never deletes or creates anything. It fails if the app is running, since the app keeps both locked.
+func OpenForMaintenance() error {
+	kvloc := kvStoreLocation()
+	if !toolbox.FileExists(kvloc) || !toolbox.FileExists(search.IndexLocation()) {
+		return errors.New("There is no KV store and search index to open. Has the frontend ever run with this user directory?")
+	}
+	// The KV store goes first: it fails in a few seconds if the app holds the lock, the index would wait forever.
+	kv, err := storm.Open(kvloc, storm.BoltOptions(0600, &bolt.Options{Timeout: lockTimeout}))
+	if err != nil {
+		return errors.New(fmt.Sprintf("The KV store could not be opened. If the app is running, please close it first. Error: %v", err))
+	}
+	globals.KvInstance = kv
+	search.OpenIndex()
+	return nil
+}
+
+/*----------  File utilities  ----------*/
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err2 := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err2 != nil {
+		return err2
+	}
+	_, err3 := io.Copy(out, in)
+	if err3 != nil {
+		out.Close()
+		return err3
+	}
+	err4 := out.Sync()
+	if err4 != nil {
+		out.Close()
+		return err4
+	}
+	return out.Close()
+}
+
+// copyDir copies a directory, along with the directories in it. The search index we snapshot is flat, but an index written by another version of bleve might not be.
+func copyDir(src, dst string) error {
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	toolbox.CreatePath(dst)
+	for _, e := range entries {
+		if e.IsDir() {
+			if err := copyDir(filepath.Join(src, e.Name()), filepath.Join(dst, e.Name())); err != nil {
+				return err
+			}
+			continue
+		}
+		if !e.Mode().IsRegular() {
+			// Symlinks and the like. Nothing we write into a backup.
+			continue
+		}
+		if err := copyFile(filepath.Join(src, e.Name()), filepath.Join(dst, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package kvstore
+
+// These test the backups of the KV store and the search index, restoring them, keeping the automatic one, and compacting the KV store.
+
+import (
+	"aether-core/aether/frontend/search"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/toolbox"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Infrastructure
+
+type testRecord struct {
+	ID   int
+	Body string
+}
+
+// TestMain gives the tests an FE config of their own, whose user directory the tests open their KV store and search index in.
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "kvstore-test")
+	if err != nil {
+		panic(err)
+	}
+	globals.FrontendConfig = &configstore.FrontendConfig{Initialised: true, UserDirectory: dir}
+	globals.FrontendTransientConfig = &configstore.Ftc
+	exitVal := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(exitVal)
+}
+
+// open starts each test with a fresh KV store and index, as if the app ran for the first time. The returned function closes them.
+func open(t *testing.T) func() {
+	toolbox.DeleteFromDisk(filepath.Join(globals.FrontendConfig.GetUserDirectory(), "frontend"))
+	OpenKVStore()
+	search.OpenIndex()
+	return func() {
+		CloseKVStore()
+		search.CloseIndex()
+	}
+}
+
+func save(t *testing.T, records ...testRecord) {
+	for k, _ := range records {
+		if err := globals.KvInstance.Save(&records[k]); err != nil {
+			t.Fatalf("The record could not be saved. Error: %v", err)
+		}
+	}
+}
+
+// has tells whether the record is in the open KV store.
+func has(id int) bool {
+	var r testRecord
+	return globals.KvInstance.One("ID", id, &r) == nil
+}
+
+func searchFinds(t *testing.T, text string) bool {
+	results, err := search.Search(text, nil, 0, 10)
+	if err != nil {
+		t.Fatalf("The search failed. Error: %v", err)
+	}
+	return results.Total > 0
+}
+
+// indexBoard indexes a board by the given name. The index only takes the types it has mappings for, _type is where it reads the type of a map from.
+func indexBoard(t *testing.T, name string) {
+	id, _ := search.MakeSearchId("board", "", "", "", name, "")
+	if err := search.Index(id, map[string]interface{}{"_type": "board", "EntityType": "board", "Name": name}); err != nil {
+		t.Fatalf("The board could not be indexed. Error: %v", err)
+	}
+}
+
+func backupDir(name string) string {
+	return filepath.Join(globals.FrontendConfig.GetUserDirectory(), "backups", name)
+}
+
+// Tests
+
+func TestBackupRestore_Success(t *testing.T) {
+	closeAll := open(t)
+	save(t, testRecord{ID: 1, Body: "before the backup"})
+	indexBoard(t, "beforebackup")
+	dir := backupDir("restore")
+	toolbox.DeleteFromDisk(dir)
+	if err := Backup(dir); err != nil {
+		closeAll()
+		t.Fatalf("The backup failed. Error: %v", err)
+	}
+	if _, err := ReadBackupManifest(dir); err != nil {
+		t.Errorf("The backup should be complete. Error: %v", err)
+	}
+	if toolbox.FileExists(dir + ".partial") {
+		t.Errorf("The partial backup should have been moved into place.")
+	}
+	save(t, testRecord{ID: 2, Body: "after the backup"})
+	indexBoard(t, "afterbackup")
+	closeAll()
+	if err := Restore(dir); err != nil {
+		t.Fatalf("The restore failed. Error: %v", err)
+	}
+	// Opened as the app does at start. Had the index not come back along with the KV store, this would delete the KV store.
+	OpenKVStore()
+	search.OpenIndex()
+	defer CloseKVStore()
+	defer search.CloseIndex()
+	if !has(1) || has(2) {
+		t.Errorf("The KV store should be as it was at the backup. Has 1: %v, Has 2: %v", has(1), has(2))
+	}
+	if !searchFinds(t, "beforebackup") || searchFinds(t, "afterbackup") {
+		t.Errorf("The search index should be as it was at the backup.")
+	}
+	for _, aside := range []string{kvStoreLocation() + ".before-restore", search.IndexLocation() + ".before-restore"} {
+		if toolbox.FileExists(aside) {
+			t.Errorf("What was moved aside for the restore should have been deleted. Location: %v", aside)
+		}
+	}
+}
+
+func TestBackup_DestinationExists_Fail(t *testing.T) {
+	defer open(t)()
+	dir := backupDir("exists")
+	toolbox.CreatePath(dir)
+	if err := Backup(dir); err == nil {
+		t.Errorf("A backup should not overwrite what's already there.")
+	}
+}
+
+func TestRestore_NotABackup_Fail(t *testing.T) {
+	closeAll := open(t)
+	save(t, testRecord{ID: 1, Body: "current"})
+	closeAll()
+	incomplete := backupDir("incomplete")
+	toolbox.DeleteFromDisk(incomplete)
+	toolbox.CreatePath(incomplete)
+	ioutil.WriteFile(filepath.Join(incomplete, backupManifestFilename), []byte(`{"Created":1}`), 0600)
+	for _, dir := range []string{backupDir("not there"), incomplete} {
+		if err := Restore(dir); err == nil {
+			t.Errorf("This should not have been restored. Directory: %v", dir)
+		}
+	}
+	OpenKVStore()
+	defer CloseKVStore()
+	if !has(1) {
+		t.Errorf("The current KV store should have been left alone.")
+	}
+}
+
+func TestRestore_InUse_Fail(t *testing.T) {
+	closeAll := open(t)
+	dir := backupDir("in use")
+	toolbox.DeleteFromDisk(dir)
+	if err := Backup(dir); err != nil {
+		closeAll()
+		t.Fatalf("The backup failed. Error: %v", err)
+	}
+	save(t, testRecord{ID: 1, Body: "current"})
+	err := Restore(dir)
+	if err == nil || !strings.Contains(err.Error(), "in use") {
+		t.Errorf("The KV store the app holds should not be restored over. Error: %v", err)
+	}
+	if !has(1) {
+		t.Errorf("The open KV store should have been left alone.")
+	}
+	closeAll()
+}
+
+func TestBackupAutomatically_Retention_Success(t *testing.T) {
+	defer open(t)()
+	save(t, testRecord{ID: 1, Body: "first"})
+	if err := BackupAutomatically(); err != nil {
+		t.Fatalf("The first automatic backup failed. Error: %v", err)
+	}
+	first, _ := ReadBackupManifest(AutomaticBackupLocation())
+	save(t, testRecord{ID: 2, Body: "second"})
+	if err := BackupAutomatically(); err != nil {
+		t.Fatalf("The second automatic backup failed. Error: %v", err)
+	}
+	second, err := ReadBackupManifest(AutomaticBackupLocation())
+	if err != nil {
+		t.Fatalf("The automatic backup should be complete. Error: %v", err)
+	}
+	if second.KVStoreSize < first.KVStoreSize {
+		t.Errorf("The automatic backup should be the latest one. First: %#v, Second: %#v", first, second)
+	}
+	// Only the one backup is kept.
+	entries, _ := ioutil.ReadDir(filepath.Dir(AutomaticBackupLocation()))
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), filepath.Base(AutomaticBackupLocation())+".") {
+			t.Errorf("Only the latest automatic backup should be kept. Found: %v", e.Name())
+		}
+	}
+}
+
+func TestCopyDir_Nested_Success(t *testing.T) {
+	src := backupDir("copy src")
+	dst := backupDir("copy dst")
+	toolbox.DeleteFromDisk(src)
+	toolbox.DeleteFromDisk(dst)
+	toolbox.CreatePath(filepath.Join(src, "a", "b"))
+	files := map[string]string{
+		"top":                            "top level",
+		filepath.Join("a", "middle"):     "one level down",
+		filepath.Join("a", "b", "deep"):  "two levels down",
+		filepath.Join("a", "b", "empty"): "",
+	}
+	for name, content := range files {
+		ioutil.WriteFile(filepath.Join(src, name), []byte(content), 0600)
+	}
+	if err := copyDir(src, dst); err != nil {
+		t.Fatalf("The directory could not be copied. Error: %v", err)
+	}
+	for name, content := range files {
+		got, err := ioutil.ReadFile(filepath.Join(dst, name))
+		if err != nil || string(got) != content {
+			t.Errorf("The file was not copied. File: %v, Expected: %q, Got: %q, Error: %v", name, content, got, err)
+		}
+	}
+}
+
+func TestCompactKVStore_Success(t *testing.T) {
+	defer open(t)()
+	big := strings.Repeat("a", 64*1024)
+	kept := testRecord{ID: 1, Body: "kept"}
+	save(t, kept)
+	// Enough to make the file worth compacting once it is deleted.
+	for i := 2; i < 2+(compactionMinFileSize/len(big))+64; i++ {
+		save(t, testRecord{ID: i, Body: big})
+	}
+	for i := 2; i < 2+(compactionMinFileSize/len(big))+64; i++ {
+		if err := globals.KvInstance.DeleteStruct(&testRecord{ID: i}); err != nil {
+			t.Fatalf("The record could not be deleted. Error: %v", err)
+		}
+	}
+	before, _ := os.Stat(kvStoreLocation())
+	if !CompactionNeeded() {
+		t.Fatalf("The KV store should need compaction. Size: %v", before.Size())
+	}
+	if err := CompactKVStore(); err != nil {
+		t.Fatalf("The compaction failed. Error: %v", err)
+	}
+	after, _ := os.Stat(kvStoreLocation())
+	if after.Size() >= before.Size()/2 {
+		t.Errorf("The KV store should have shrunk. Before: %v, After: %v", before.Size(), after.Size())
+	}
+	if CompactionNeeded() {
+		t.Errorf("The KV store should not need compaction right after one.")
+	}
+	// The swapped in KV store is the open one, and has what was kept, found through the storm index as well.
+	var r testRecord
+	if err := globals.KvInstance.One("Body", "kept", &r); err != nil || r != kept {
+		t.Errorf("The kept record should be in the compacted KV store. Got: %#v, Error: %v", r, err)
+	}
+	save(t, testRecord{ID: 3, Body: "after the compaction"})
+	if !has(3) {
+		t.Errorf("The compacted KV store should take writes.")
+	}
+	if toolbox.FileExists(kvStoreLocation() + ".compacting") {
+		t.Errorf("The compaction copy should have been moved into place.")
+	}
+}
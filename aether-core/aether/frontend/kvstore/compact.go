@@ -0,0 +1,143 @@
+// Frontend > KeyValueStore > Compaction
+// This file shrinks the KV store file back down. Bolt never gives the space of the deleted data back to the disk, it only reuses it, so without this the file stays as large as it ever was.
+
+package kvstore
+
+import (
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/toolbox"
+	"errors"
+	"fmt"
+	"github.com/asdine/storm"
+	"github.com/coreos/bbolt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// The KV store is compacted only if at least this much of it is free space. Compaction rewrites the whole file, it's not worth it for a few pages.
+	compactionMinFreeRatio = 0.25
+	compactionMinFileSize  = 16 * 1024 * 1024
+	lockTimeout            = 5 * time.Second
+)
+
+func kvStoreLocation() string {
+	return filepath.Join(globals.FrontendConfig.GetUserDirectory(), "frontend", "KVStore.kv")
+}
+
+// CompactionNeeded tells whether enough of the KV store is free space to make compacting it worthwhile.
+func CompactionNeeded() bool {
+	fi, err := os.Stat(kvStoreLocation())
+	if err != nil || fi.Size() < compactionMinFileSize {
+		return false
+	}
+	free := globals.KvInstance.Bolt.Stats().FreeAlloc
+	return float64(free)/float64(fi.Size()) >= compactionMinFreeRatio
+}
+
+/*
+CompactKVStore rewrites the KV store into a new file with no free space in it, and swaps the open KV store over to it.
+
+This runs while the app is running. The refresher is held off for the duration, since it is the heaviest writer. The inflights queue writes into the KV store at every step of every item, so the caller has to hold it off as well (see inflights.Hold), we can't reach it from here. The copy is taken from a read transaction, so the remaining writers (notifications, the client) can keep writing while it is being made. If anything was written by the time we're ready to swap, the copy is thrown away and we try again next time, so nothing is lost. The KV store is closed for the moment of the swap itself, the reads and writes of the remaining writers that land in that moment fail like they would at shutdown.
+*/
+func CompactKVStore() error {
+	globals.FrontendTransientConfig.RefresherMutex.Lock()
+	defer globals.FrontendTransientConfig.RefresherMutex.Unlock()
+	start := time.Now()
+	kvloc := kvStoreLocation()
+	tmploc := kvloc + ".compacting"
+	toolbox.DeleteFromDisk(tmploc)
+	copiedTxId, err := copyBolt(globals.KvInstance.Bolt, tmploc)
+	if err != nil {
+		toolbox.DeleteFromDisk(tmploc)
+		return errors.New(fmt.Sprintf("The KV store could not be copied for compaction. Error: %v", err))
+	}
+	globals.KvInstance.Close()
+	currentTxId, err2 := lastTxId(kvloc)
+	if err2 != nil || currentTxId != copiedTxId {
+		// Something was written after we took the copy. Keep the original.
+		toolbox.DeleteFromDisk(tmploc)
+		reopenKVStore(kvloc)
+		if err2 != nil {
+			return errors.New(fmt.Sprintf("The KV store could not be checked for changes during compaction. Error: %v", err2))
+		}
+		return errors.New("The KV store changed while it was being compacted. It will be compacted the next time.")
+	}
+	before, _ := os.Stat(kvloc)
+	err3 := os.Rename(tmploc, kvloc)
+	if err3 != nil {
+		toolbox.DeleteFromDisk(tmploc)
+		reopenKVStore(kvloc)
+		return errors.New(fmt.Sprintf("The compacted KV store could not be moved into place. Error: %v", err3))
+	}
+	reopenKVStore(kvloc)
+	after, _ := os.Stat(kvloc)
+	if before != nil && after != nil {
+		logging.Logf(1, "The KV store is compacted. Before: %v bytes, After: %v bytes, Took: %v", before.Size(), after.Size(), time.Since(start))
+	}
+	return nil
+}
+
+func reopenKVStore(kvloc string) {
+	kv, err := storm.Open(kvloc)
+	if err != nil {
+		logging.LogCrashf("Frontend KV store could not be reopened. Error was: %v", err)
+	}
+	globals.KvInstance = kv
+}
+
+// copyBolt copies all buckets of the source into a new database at the destination path, and returns the transaction id of the source the copy was taken at.
+func copyBolt(src *bolt.DB, dstloc string) (uint64, error) {
+	dst, err := bolt.Open(dstloc, 0600, &bolt.Options{Timeout: lockTimeout})
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+	var txId uint64
+	err2 := src.View(func(stx *bolt.Tx) error {
+		txId = uint64(stx.ID())
+		return stx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			// One transaction per top level bucket, so that a large KV store does not have to fit into a single transaction.
+			return dst.Update(func(dtx *bolt.Tx) error {
+				nb, err := dtx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, nb)
+			})
+		})
+	})
+	return txId, err2
+}
+
+// copyBucket copies the keys and the nested buckets. The bucket sequences aren't copied: storm keeps its increments in its own metadata, not in them.
+func copyBucket(src, dst *bolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if v == nil {
+			// A nil value means this is a nested bucket.
+			nb, err := dst.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return copyBucket(src.Bucket(k), nb)
+		}
+		return dst.Put(k, v)
+	})
+}
+
+// lastTxId reads the id of the last committed transaction of a closed database.
+func lastTxId(loc string) (uint64, error) {
+	db, err := bolt.Open(loc, 0600, &bolt.Options{ReadOnly: true, Timeout: lockTimeout})
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	var txId uint64
+	err2 := db.View(func(tx *bolt.Tx) error {
+		txId = uint64(tx.ID())
+		return nil
+	})
+	return txId, err2
+}
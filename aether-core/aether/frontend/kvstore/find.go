@@ -59,12 +59,12 @@ func findPosts(searchResults search.SearchResults) []festructs.CompiledPost {
 	return posts
 }
 
-func SearchPosts(searchText string) (festructs.CPostBatch, search.ScoreMap, error) {
-	resp, err := search.Search(searchText)
+func SearchPosts(searchText string, offset, limit int) (festructs.CPostBatch, search.SearchResults, error) {
+	resp, err := search.Search(searchText, []string{"post"}, offset, limit)
 	if err != nil {
-		return []festructs.CompiledPost{}, make(search.ScoreMap), err
+		return []festructs.CompiledPost{}, resp, err
 	}
-	return festructs.CPostBatch(findPosts(resp)), makeScoreMap(resp), nil
+	return festructs.CPostBatch(findPosts(resp)), resp, nil
 }
 
 /*----------  Search & find threads  ----------*/
@@ -95,12 +95,12 @@ func findThreads(searchResults search.SearchResults) []festructs.CompiledThread
 	return threads
 }
 
-func SearchThreads(searchText string) (festructs.CThreadBatch, search.ScoreMap, error) {
-	resp, err := search.Search(searchText)
+func SearchThreads(searchText string, offset, limit int) (festructs.CThreadBatch, search.SearchResults, error) {
+	resp, err := search.Search(searchText, []string{"thread"}, offset, limit)
 	if err != nil {
-		return []festructs.CompiledThread{}, make(search.ScoreMap), err
+		return []festructs.CompiledThread{}, resp, err
 	}
-	return festructs.CThreadBatch(findThreads(resp)), makeScoreMap(resp), nil
+	return festructs.CThreadBatch(findThreads(resp)), resp, nil
 }
 
 /*----------  Search & find boards  ----------*/
@@ -132,14 +132,14 @@ func findBoards(searchResults search.SearchResults) []festructs.CompiledBoard {
 	return boards
 }
 
-func SearchBoards(searchText string) (festructs.CBoardBatch, search.ScoreMap, error) {
-	resp, err := search.Search(searchText)
+func SearchBoards(searchText string, offset, limit int) (festructs.CBoardBatch, search.SearchResults, error) {
+	resp, err := search.Search(searchText, []string{"board"}, offset, limit)
 	if err != nil {
-		return []festructs.CompiledBoard{}, make(search.ScoreMap), err
+		return []festructs.CompiledBoard{}, resp, err
 	}
 	b := festructs.CBoardBatch(findBoards(resp))
 	b.SortByThreadsCount()
-	return b, makeScoreMap(resp), nil
+	return b, resp, nil
 }
 
 /*----------  Search & find users  ----------*/
@@ -171,12 +171,12 @@ func findUsers(searchResults search.SearchResults) []festructs.CompiledUser {
 	return users
 }
 
-func SearchUsers(searchText string) (festructs.CUserBatch, search.ScoreMap, error) {
-	resp, err := search.Search(searchText)
+func SearchUsers(searchText string, offset, limit int) (festructs.CUserBatch, search.SearchResults, error) {
+	resp, err := search.Search(searchText, []string{"user"}, offset, limit)
 	if err != nil {
-		return []festructs.CompiledUser{}, make(search.ScoreMap), err
+		return []festructs.CompiledUser{}, resp, err
 	}
-	return festructs.CUserBatch(findUsers(resp)), makeScoreMap(resp), nil
+	return festructs.CUserBatch(findUsers(resp)), resp, nil
 }
 
 /*----------  Search & find content (posts + threads)  ----------*/
@@ -209,23 +209,13 @@ func findContent(searchResults search.SearchResults) ([]festructs.CompiledPost,
 	return posts, threads
 }
 
-func SearchContent(searchText string) (festructs.CPostBatch, festructs.CThreadBatch, search.ScoreMap, error) {
-	resp, err := search.Search(searchText)
+func SearchContent(searchText string, offset, limit int) (festructs.CPostBatch, festructs.CThreadBatch, search.SearchResults, error) {
+	resp, err := search.Search(searchText, []string{"post", "thread"}, offset, limit)
 	if err != nil {
-		return festructs.CPostBatch{}, festructs.CThreadBatch{}, make(search.ScoreMap), err
+		return festructs.CPostBatch{}, festructs.CThreadBatch{}, resp, err
 	}
 	posts, threads := findContent(resp)
-	return festructs.CPostBatch(posts), festructs.CThreadBatch(threads), makeScoreMap(resp), nil
-}
-
-/*----------  Make score map  ----------*/
-
-func makeScoreMap(sr search.SearchResults) search.ScoreMap {
-	sm := make(search.ScoreMap)
-	for k, _ := range sr.Results {
-		sm[sr.Results[k].Id.Fingerprint] = sr.Results[k].Score
-	}
-	return sm
+	return festructs.CPostBatch(posts), festructs.CThreadBatch(threads), resp, nil
 }
 
 /*=======================================
@@ -73,6 +73,8 @@ func Refresh() {
 	ambientBoards := festructs.GetCurrentAmbients()
 	RefreshBoards(nowts, ambientBoards, observableUniverse["Boards"])
 	ambientBoards.Save() // Save the updated ambients (update happens inside refresh boards)
+	// After the user headers, so that the conversations have the usernames.
+	festructs.InboxSingleton.Refresh(localUserFingerprint(), nowts)
 	GenerateHomeView()
 	GeneratePopularView()
 	GenerateNewView()
@@ -95,54 +97,81 @@ func PrepNewGlobalStatistics() {
 }
 
 // DeleteStaleData deletes the data that we've ceased updating. This does not mean the data is deleted from the backend store, it just means that the cache copy we keep on the frontend is. So if the user wants to see the same thing again, the click will cause a cache miss, it will be pulled and compiled from the backend again (if it's still extant there) and served to the user.
+// A carrier is stale when nothing in it has been created or updated within the retention window. Boards the user is subscribed to, anything with the user's own content in it, and the local user's header are kept regardless. The search index entries of the carriers go with them.
 func DeleteStaleData(nowts int64) {
 	logging.Logf(1, "Starting deletion of stale data.")
 	cutoff := toolbox.CnvToCutoffDays(globals.FrontendConfig.GetKvStoreRetentionDays())
-	// Delete stale boards
+	// Only the carriers created before the cutoff can be stale, the rest haven't been with us long enough.
 	query := globals.KvInstance.Select(q.Lte("LastRefreshed", cutoff))
-
-	/*==================================================
-	=            Deletion from search index            =
-	==================================================*/
+	// Threads first, so that the boards they belong to can drop them from their thread lists.
+	droppedThreads := make(map[string]map[string]bool) // Board fp > thread fps
+	tcs := []festructs.ThreadCarrier{}
+	query.Find(&tcs)
+	for k, _ := range tcs {
+		if !tcs[k].Stale(cutoff) {
+			continue
+		}
+		tcs[k].DeleteFromSearchIndex()
+		err := globals.KvInstance.DeleteStruct(&tcs[k])
+		if err != nil {
+			logging.Logf(1, "Deletion of a stale thread errored out. Fingerprint: %v, Err: %v", tcs[k].Fingerprint, err)
+			continue
+		}
+		if droppedThreads[tcs[k].ParentFingerprint] == nil {
+			droppedThreads[tcs[k].ParentFingerprint] = make(map[string]bool)
+		}
+		droppedThreads[tcs[k].ParentFingerprint][tcs[k].Fingerprint] = true
+	}
 	bcs := []festructs.BoardCarrier{}
 	query.Find(&bcs)
-	for i, _ := range bcs {
-		for j, _ := range bcs[i].Boards {
-			bcs[i].Boards[j].DeleteFromSearchIndex()
+	for k, _ := range bcs {
+		subbed, _, _ := globals.FrontendConfig.ContentRelations.IsSubbedBoard(bcs[k].Fingerprint)
+		if !bcs[k].Stale(cutoff) || subbed {
+			continue
 		}
+		bcs[k].DeleteFromSearchIndex()
+		err := globals.KvInstance.DeleteStruct(&bcs[k])
+		if err != nil {
+			logging.Logf(1, "Deletion of a stale board errored out. Fingerprint: %v, Err: %v", bcs[k].Fingerprint, err)
+			continue
+		}
+		delete(droppedThreads, bcs[k].Fingerprint)
 	}
-	tcs := []festructs.ThreadCarrier{}
-	query.Find(&tcs)
-	for i, _ := range tcs {
-		for j, _ := range tcs[i].Threads {
-			bcs[i].Threads[j].DeleteFromSearchIndex()
+	// The boards that stay should not list the threads that are gone.
+	for boardfp, threadfps := range droppedThreads {
+		bc := festructs.BoardCarrier{}
+		err := globals.KvInstance.One("Fingerprint", boardfp, &bc)
+		if err != nil {
+			continue
 		}
-		for j, _ := range tcs[i].Posts {
-			bcs[i].Posts[j].DeleteFromSearchIndex()
+		if bc.RemoveThreads(threadfps) {
+			bc.Save()
 		}
 	}
+	localUserFp := localUserFingerprint()
 	uhcs := []festructs.UserHeaderCarrier{}
 	query.Find(&uhcs)
-	for i, _ := range uhcs {
-		for j, _ := range uhcs[i].Users {
-			uhcs[i].Users[j].DeleteFromSearchIndex()
+	for k, _ := range uhcs {
+		if !uhcs[k].Stale(cutoff, localUserFp) {
+			continue
+		}
+		uhcs[k].DeleteFromSearchIndex()
+		err := globals.KvInstance.DeleteStruct(&uhcs[k])
+		if err != nil {
+			logging.Logf(1, "Deletion of a stale user header errored out. Fingerprint: %v, Err: %v", uhcs[k].Fingerprint, err)
 		}
 	}
-	/*=====  End of Deletion from search index  ======*/
+	logging.Logf(1, "Stale data deletion is complete.")
+}
 
-	err := query.Delete(new(festructs.BoardCarrier))
-	if err != nil && !strings.Contains(err.Error(), "not found") {
-		logging.Logf(1, "Deletion of stale boards errored out. Err: %v", err)
-	}
-	err2 := query.Delete(new(festructs.ThreadCarrier))
-	if err2 != nil && !strings.Contains(err.Error(), "not found") {
-		logging.Logf(1, "Deletion of stale threads errored out. Err: %v", err2)
-	}
-	err3 := query.Delete(new(festructs.UserHeaderCarrier))
-	if err3 != nil && !strings.Contains(err.Error(), "not found") {
-		logging.Logf(1, "Deletion of stale user headers errored out. Err: %v", err3)
+func localUserFingerprint() string {
+	alu := globals.FrontendConfig.GetDehydratedLocalUserKeyEntity()
+	if len(alu) == 0 {
+		return ""
 	}
-	logging.Logf(1, "Stale data deletion is complete.")
+	var key api.Key
+	json.Unmarshal([]byte(alu), &key)
+	return string(key.Fingerprint)
 }
 
 func RefreshGlobalUserHeaders(newUserEntities []*pbstructs.Key, nowts int64, observableUniverse map[string]bool) {
@@ -277,6 +306,7 @@ func postRefresh() {
 	clapiconsumer.SendNewView()
 	clapiconsumer.SendNotifications()
 	festructs.NotificationsSingleton.Save()
+	festructs.InboxSingleton.Save()
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go festructs.CommitSearchIndexes(&wg)
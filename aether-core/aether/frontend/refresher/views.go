@@ -21,10 +21,11 @@ import (
 	"time"
 )
 
-// GenerateHomeView gets the top 10 most popular items in the communities you subscribe to, and sort them by rank.
+// GenerateHomeView gets the top 10 most popular items in the communities you subscribe to, and sort them by rank. The ranking is the default one, since the boards in it can each have a different one.
 func GenerateHomeView() {
 	logging.Logf(1, "Home view generator is running")
 	start := time.Now()
+	ranker := festructs.DefaultRanker()
 	// get subscribed boards fingerprints
 	sbs := globals.FrontendConfig.ContentRelations.GetAllSubbedBoards()
 	// Get the underlying compiled boards
@@ -40,7 +41,7 @@ func GenerateHomeView() {
 	for k, _ := range boardCarriers {
 		// thrlen := min(len(boardCarriers[k].Threads), 10)
 		// boardThreads := boardCarriers[k].Threads[0:thrlen]
-		boardThreads := *(boardCarriers[k].GetTopThreadsForView(10))
+		boardThreads := *(boardCarriers[k].GetTopThreadsForView(10, ranker, start.Unix()))
 		for j, _ := range boardThreads {
 			boardThreads[j].ViewMeta_BoardName = boardCarriers[k].Boards[0].Name
 		}
@@ -62,10 +63,35 @@ we also need to care about notify - it controls what gets into the home view.
 
 */
 
-// GeneratePopularView gets the top 10 most popular items in each of the whitelisted communities and sorts them by rank.
+// GeneratePopularView gets the top 10 most popular items in each of the whitelisted communities and sorts them by rank. The ranking is the popular view ranking in the FE config.
 func GeneratePopularView() {
 	logging.Logf(1, "Popular view generator is running")
 	start := time.Now()
+	thrs := CompilePopularView(festructs.PopularViewRanker(), start.Unix())
+	existingPopularView := festructs.PopularViewCarrier{}
+	logging.Logf(3, "Save happens in GeneratePopularView>Save")
+	err := globals.KvInstance.One("Id", 1, &existingPopularView)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		logging.Logf(1, "Popular view fetch in new popular view creation encountered an error. Error: %v", err)
+		return
+	}
+	if len(thrs) > 0 || len(existingPopularView.Threads) == 0 {
+		logging.Logf(3, "Save happens in GeneratePopularView>Save")
+		globals.KvInstance.Save(&festructs.PopularViewCarrier{
+			Id:      1,
+			Threads: thrs,
+		})
+	} else {
+		logging.Logf(1, "Popular view produced zero threads and thus bailed on updating. This is something that should be looked at.") // TODO FUTURE
+	}
+
+	elapsed := time.Since(start)
+	logging.Logf(1, "Popular items count: %v", len(thrs))
+	logging.Logf(1, "Popular view generator took %v seconds.", elapsed.Seconds())
+}
+
+// CompilePopularView is the popular view in the given ranking. GeneratePopularView saves it in the ranking of the FE config, a request for another ranking gets it compiled without saving.
+func CompilePopularView(ranker festructs.ThreadRanker, nowts int64) festructs.CThreadBatch {
 	boardCarriers := []festructs.BoardCarrier{}
 	// // check if sfwlist is disabled
 	// if globals.FrontendConfig.ContentRelations.SFWList.GetSFWListDisabled() {
@@ -95,33 +121,14 @@ func GeneratePopularView() {
 		// thrlen := min(len(boardCarriers[k].Threads), 10)
 		// boardThreads := boardCarriers[k].Threads[0:thrlen]
 		// thrs = append(thrs, boardThreads...)
-		boardThreads := *(boardCarriers[k].GetTopThreadsForView(10))
+		boardThreads := *(boardCarriers[k].GetTopThreadsForView(10, ranker, nowts))
 		for j, _ := range boardThreads {
 			boardThreads[j].ViewMeta_BoardName = boardCarriers[k].Boards[0].Name
 		}
 		thrs = append(thrs, boardThreads...)
 	}
 	thrs.SortByScore()
-	existingPopularView := festructs.PopularViewCarrier{}
-	logging.Logf(3, "Save happens in GeneratePopularView>Save")
-	err := globals.KvInstance.One("Id", 1, &existingPopularView)
-	if err != nil && !strings.Contains(err.Error(), "not found") {
-		logging.Logf(1, "Popular view fetch in new popular view creation encountered an error. Error: %v", err)
-		return
-	}
-	if len(thrs) > 0 || len(existingPopularView.Threads) == 0 {
-		logging.Logf(3, "Save happens in GeneratePopularView>Save")
-		globals.KvInstance.Save(&festructs.PopularViewCarrier{
-			Id:      1,
-			Threads: thrs,
-		})
-	} else {
-		logging.Logf(1, "Popular view produced zero threads and thus bailed on updating. This is something that should be looked at.") // TODO FUTURE
-	}
-
-	elapsed := time.Since(start)
-	logging.Logf(1, "Popular items count: %v", len(thrs))
-	logging.Logf(1, "Popular view generator took %v seconds.", elapsed.Seconds())
+	return thrs
 }
 
 // GenerateNewView gets the top 10 newest items in each of the whitelisted communities and sorts them by rank.
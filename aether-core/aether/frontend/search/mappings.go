@@ -50,11 +50,29 @@ func makeFieldMapping(maptype, analyser string) *bleveMapping.FieldMapping {
 	}
 }
 
+// highlighted stores the field in the index, so that search results can show the highlighted fragments of it.
+func highlighted(fm *bleveMapping.FieldMapping) *bleveMapping.FieldMapping {
+	fm.Store = true
+	fm.IncludeTermVectors = true
+	return fm
+}
+
+// generateOwnerMapping maps the owner of an entity, so that it can be searched by its author.
+func generateOwnerMapping() *bleveMapping.DocumentMapping {
+	mapping := bleve.NewDocumentStaticMapping()
+	mapping.AddFieldMappingsAt("Fingerprint", makeFieldMapping("text", "keyword"))
+	return mapping
+}
+
 /*
+	All: Index:
+	- EntityType (keyword). This is not a field of the entity, it's added when it's indexed, so that queries can be limited to types.
+
 	Board: Index:
 	- Fingerprint (simple analyser)
 	- Name (simple)
 	- Description (standard analyser)
+	- Owner.Fingerprint (keyword)
 	- Creation
 	- LastUpdate
 	- ThreadsCount
@@ -63,9 +81,11 @@ func makeFieldMapping(maptype, analyser string) *bleveMapping.FieldMapping {
 func generateBoardMapping() *bleveMapping.DocumentMapping {
 	mapping := bleve.NewDocumentStaticMapping()
 	mapping.AddFieldMappingsAt("Fingerprint", makeFieldMapping("text", "keyword"))
-	mapping.AddFieldMappingsAt("Name", makeFieldMapping("text", "simple"))
-	mapping.AddFieldMappingsAt("Description", makeFieldMapping("text", "standard"))
-	// mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
+	mapping.AddFieldMappingsAt("EntityType", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("Name", highlighted(makeFieldMapping("text", "simple")))
+	mapping.AddFieldMappingsAt("Description", highlighted(makeFieldMapping("text", "standard")))
+	mapping.AddSubDocumentMapping("Owner", generateOwnerMapping())
+	mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("LastUpdate", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("ThreadsCount", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("UserCount", makeFieldMapping("numeric", ""))
@@ -79,6 +99,7 @@ func generateBoardMapping() *bleveMapping.DocumentMapping {
 	- Name (simple)
 	- Body (standard analyser)
 	- Link (simple)
+	- Owner.Fingerprint (keyword)
 	- Creation
 	- LastUpdate
 	- PostsCount
@@ -88,11 +109,13 @@ func generateThreadMapping() *bleveMapping.DocumentMapping {
 	mapping := bleve.NewDocumentStaticMapping()
 
 	mapping.AddFieldMappingsAt("Fingerprint", makeFieldMapping("text", "keyword"))
-	// mapping.AddFieldMappingsAt("Board", makeFieldMapping("text", "keyword"))
-	mapping.AddFieldMappingsAt("Name", makeFieldMapping("text", "simple"))
-	mapping.AddFieldMappingsAt("Body", makeFieldMapping("text", "standard"))
+	mapping.AddFieldMappingsAt("EntityType", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("Board", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("Name", highlighted(makeFieldMapping("text", "simple")))
+	mapping.AddFieldMappingsAt("Body", highlighted(makeFieldMapping("text", "standard")))
 	mapping.AddFieldMappingsAt("Link", makeFieldMapping("text", "keyword"))
-	// mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
+	mapping.AddSubDocumentMapping("Owner", generateOwnerMapping())
+	mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("LastUpdate", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("PostsCount", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("Score", makeFieldMapping("numeric", ""))
@@ -106,6 +129,7 @@ func generateThreadMapping() *bleveMapping.DocumentMapping {
 	- Thread (simple)
 	- Parent (simple)
 	- Body (standard analyser)
+	- Owner.Fingerprint (keyword)
 	- Creation
 	- LastUpdate
 */
@@ -113,11 +137,13 @@ func generatePostMapping() *bleveMapping.DocumentMapping {
 	mapping := bleve.NewDocumentStaticMapping()
 
 	mapping.AddFieldMappingsAt("Fingerprint", makeFieldMapping("text", "keyword"))
-	// mapping.AddFieldMappingsAt("Board", makeFieldMapping("text", "keyword"))
-	// mapping.AddFieldMappingsAt("Thread", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("EntityType", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("Board", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("Thread", makeFieldMapping("text", "keyword"))
 	// mapping.AddFieldMappingsAt("Parent", makeFieldMapping("text", "keyword"))
-	mapping.AddFieldMappingsAt("Body", makeFieldMapping("text", "standard"))
-	// mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
+	mapping.AddFieldMappingsAt("Body", highlighted(makeFieldMapping("text", "standard")))
+	mapping.AddSubDocumentMapping("Owner", generateOwnerMapping())
+	mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("LastUpdate", makeFieldMapping("numeric", ""))
 	return mapping
 }
@@ -134,9 +160,10 @@ func generatePostMapping() *bleveMapping.DocumentMapping {
 func generateUserMapping() *bleveMapping.DocumentMapping {
 	mapping := bleve.NewDocumentStaticMapping()
 	mapping.AddFieldMappingsAt("Fingerprint", makeFieldMapping("text", "keyword"))
-	mapping.AddFieldMappingsAt("NonCanonicalName", makeFieldMapping("text", "simple"))
-	mapping.AddFieldMappingsAt("Info", makeFieldMapping("text", "standard"))
-	// mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
+	mapping.AddFieldMappingsAt("EntityType", makeFieldMapping("text", "keyword"))
+	mapping.AddFieldMappingsAt("NonCanonicalName", highlighted(makeFieldMapping("text", "simple")))
+	mapping.AddFieldMappingsAt("Info", highlighted(makeFieldMapping("text", "standard")))
+	mapping.AddFieldMappingsAt("Creation", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("LastUpdate", makeFieldMapping("numeric", ""))
 	// mapping.AddFieldMappingsAt("LastRefreshed", makeFieldMapping("numeric", ""))
 
@@ -0,0 +1,224 @@
+// Frontend > Search > Query
+// This file parses the search query language the user types into the search box, and it maps it to an index query.
+
+package search
+
+import (
+	"errors"
+	"fmt"
+	"github.com/blevesearch/bleve"
+	bleveQuery "github.com/blevesearch/bleve/search/query"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+/*
+How this works:
+
+A query is a list of parts separated by spaces. Every part narrows the results down, so a result has to match all of them.
+
+- word: Matched loosely against the text of the entity, like the search box always did.
+- "exact phrase": Matched as a phrase against the text of the entity.
+- in:board:<fp>: Only the content in this board.
+- in:thread:<fp>: Only the posts in this thread.
+- by:<fp>: Only the content created by this user.
+- after:<date>, before:<date>: Only the entities created on or after, or before, this date. The date is either 2006-01-02 (UTC), or a unix timestamp.
+- type:<type>: Only the entities of this type. One of board, thread, post, user. This can be given more than once, in which case an entity of any of those types matches.
+
+A part that looks like a filter, but isn't one of the above (e.g. a link) is a word.
+*/
+
+var entityTypes = []string{"board", "thread", "post", "user"}
+
+// Query is a parsed search query.
+type Query struct {
+	Words   []string
+	Phrases []string
+	Board   string
+	Thread  string
+	User    string
+	After   int64 // Unix timestamp, inclusive. 0: no lower bound.
+	Before  int64 // Unix timestamp, exclusive. 0: no upper bound.
+	Types   []string
+}
+
+// tokenise splits the query text into its parts. A phrase is a single part, with its quotes.
+func tokenise(text string) []string {
+	tokens := []string{}
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range text {
+		switch {
+		case r == '"':
+			current.WriteRune(r)
+			if inQuotes {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+			inQuotes = !inQuotes
+		case unicode.IsSpace(r) && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+func parseDate(val string) (int64, error) {
+	if ts, err := strconv.ParseInt(val, 10, 64); err == nil {
+		return ts, nil
+	}
+	t, err := time.Parse("2006-01-02", val)
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("This date could not be parsed. Use either 2006-01-02, or a unix timestamp. Date: %s", val))
+	}
+	return t.Unix(), nil
+}
+
+func isEntityType(val string) bool {
+	for _, t := range entityTypes {
+		if t == val {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseQuery parses the text of a search query.
+func ParseQuery(text string) (Query, error) {
+	q := Query{}
+	for _, token := range tokenise(text) {
+		if strings.HasPrefix(token, `"`) {
+			phrase := strings.TrimSpace(strings.Trim(token, `"`))
+			if len(phrase) > 0 {
+				q.Phrases = append(q.Phrases, phrase)
+			}
+			continue
+		}
+		op, val := "", ""
+		if i := strings.Index(token, ":"); i > 0 {
+			op, val = strings.ToLower(token[:i]), token[i+1:]
+		}
+		switch op {
+		case "in":
+			switch {
+			case strings.HasPrefix(val, "board:") && len(val) > len("board:"):
+				q.Board = val[len("board:"):]
+			case strings.HasPrefix(val, "thread:") && len(val) > len("thread:"):
+				q.Thread = val[len("thread:"):]
+			default:
+				return q, errors.New(fmt.Sprintf("This filter could not be parsed. Use in:board:<fp> or in:thread:<fp>. Filter: %s", token))
+			}
+		case "by":
+			if len(val) == 0 {
+				return q, errors.New(fmt.Sprintf("This filter has no user fingerprint. Filter: %s", token))
+			}
+			q.User = val
+		case "after", "before":
+			ts, err := parseDate(val)
+			if err != nil {
+				return q, err
+			}
+			if op == "after" {
+				q.After = ts
+			} else {
+				q.Before = ts
+			}
+		case "type":
+			t := strings.ToLower(val)
+			if !isEntityType(t) {
+				return q, errors.New(fmt.Sprintf("This entity type is not one of %v. Filter: %s", entityTypes, token))
+			}
+			q.Types = append(q.Types, t)
+		default:
+			q.Words = append(q.Words, token)
+		}
+	}
+	return q, nil
+}
+
+// restrictTypes returns the entity types that are both in the query and allowed. No types in the query means all allowed types.
+func (q *Query) restrictTypes(allowed []string) []string {
+	if len(q.Types) == 0 {
+		return allowed
+	}
+	if len(allowed) == 0 {
+		return q.Types
+	}
+	types := []string{}
+	for _, t := range q.Types {
+		for _, a := range allowed {
+			if t == a {
+				types = append(types, t)
+			}
+		}
+	}
+	return types
+}
+
+// BleveQuery maps the query to an index query. The allowed types are the entity types the caller is looking for, blank for all.
+func (q *Query) BleveQuery(allowed []string) bleveQuery.Query {
+	types := q.restrictTypes(allowed)
+	if len(q.Types) > 0 && len(allowed) > 0 && len(types) == 0 {
+		// The query asks for types the caller isn't looking for.
+		return bleve.NewMatchNoneQuery()
+	}
+	conj := bleve.NewConjunctionQuery()
+	if len(q.Words) > 0 {
+		conj.AddQuery(bleve.NewMatchQuery(strings.Join(q.Words, " ")))
+	}
+	for _, phrase := range q.Phrases {
+		conj.AddQuery(bleve.NewMatchPhraseQuery(phrase))
+	}
+	if len(conj.Conjuncts) == 0 && len(q.Board) == 0 && len(q.Thread) == 0 && len(q.User) == 0 && q.After == 0 && q.Before == 0 {
+		// Nothing to look for. An empty search box gives no results, not all of them.
+		return bleve.NewMatchNoneQuery()
+	}
+	if len(q.Board) > 0 {
+		conj.AddQuery(termQuery("Board", q.Board))
+	}
+	if len(q.Thread) > 0 {
+		conj.AddQuery(termQuery("Thread", q.Thread))
+	}
+	if len(q.User) > 0 {
+		conj.AddQuery(termQuery("Owner.Fingerprint", q.User))
+	}
+	if q.After != 0 || q.Before != 0 {
+		var min, max *float64
+		if q.After != 0 {
+			after := float64(q.After)
+			min = &after
+		}
+		if q.Before != 0 {
+			before := float64(q.Before)
+			max = &before
+		}
+		inclusive, exclusive := true, false
+		rq := bleve.NewNumericRangeInclusiveQuery(min, max, &inclusive, &exclusive)
+		rq.SetField("Creation")
+		conj.AddQuery(rq)
+	}
+	if len(types) > 0 {
+		disj := bleve.NewDisjunctionQuery()
+		for _, t := range types {
+			disj.AddQuery(termQuery("EntityType", t))
+		}
+		conj.AddQuery(disj)
+	}
+	return conj
+}
+
+func termQuery(field, term string) *bleveQuery.TermQuery {
+	tq := bleve.NewTermQuery(term)
+	tq.SetField(field)
+	return tq
+}
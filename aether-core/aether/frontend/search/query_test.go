@@ -0,0 +1,110 @@
+package search
+
+// These test the parser of the search query language.
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Tests
+
+func TestTokenise_Success(t *testing.T) {
+	cases := []struct {
+		text   string
+		tokens []string
+	}{
+		{"", []string{}},
+		{"   ", []string{}},
+		{"one", []string{"one"}},
+		{"one  two\tthree", []string{"one", "two", "three"}},
+		{`"a phrase" word`, []string{`"a phrase"`, "word"}},
+		{`word"a phrase"`, []string{`word"a phrase"`}},
+		{`"a  phrase with   spaces"`, []string{`"a  phrase with   spaces"`}},
+		// A quote that isn't closed runs to the end of the query.
+		{`"unclosed phrase`, []string{`"unclosed phrase`}},
+		{`in:board:abc "x y" by:def`, []string{"in:board:abc", `"x y"`, "by:def"}},
+	}
+	for _, c := range cases {
+		if tokens := tokenise(c.text); !reflect.DeepEqual(tokens, c.tokens) {
+			t.Errorf("The query was split wrong. Query: %q, Expected: %#v, Got: %#v", c.text, c.tokens, tokens)
+		}
+	}
+}
+
+func TestParseQuery_Success(t *testing.T) {
+	day := time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC).Unix()
+	cases := []struct {
+		text  string
+		query Query
+	}{
+		{"", Query{}},
+		{"hello world", Query{Words: []string{"hello", "world"}}},
+		{`"hello world" again`, Query{Words: []string{"again"}, Phrases: []string{"hello world"}}},
+		// An empty phrase is dropped.
+		{`"" "  "`, Query{}},
+		{"in:board:abc", Query{Board: "abc"}},
+		{"in:thread:def", Query{Thread: "def"}},
+		{"IN:board:abc", Query{Board: "abc"}},
+		{"by:user1", Query{User: "user1"}},
+		{"after:2018-05-01", Query{After: day}},
+		{"before:1525132800", Query{Before: 1525132800}},
+		{"type:thread type:POST", Query{Types: []string{"thread", "post"}}},
+		// A part that looks like a filter but isn't one is a word.
+		{"https://getaether.net", Query{Words: []string{"https://getaether.net"}}},
+		{":colon", Query{Words: []string{":colon"}}},
+		{
+			`cats "big dogs" in:board:b1 by:u1 after:2018-05-01 type:post`,
+			Query{Words: []string{"cats"}, Phrases: []string{"big dogs"}, Board: "b1", User: "u1", After: day, Types: []string{"post"}},
+		},
+	}
+	for _, c := range cases {
+		q, err := ParseQuery(c.text)
+		if err != nil {
+			t.Errorf("The query should parse. Query: %q, Error: %v", c.text, err)
+			continue
+		}
+		if !reflect.DeepEqual(q, c.query) {
+			t.Errorf("The query was parsed wrong. Query: %q, Expected: %#v, Got: %#v", c.text, c.query, q)
+		}
+	}
+}
+
+func TestParseQuery_Fail(t *testing.T) {
+	cases := []string{
+		"in:board:",
+		"in:thread:",
+		"in:forum:abc",
+		"by:",
+		"after:yesterday",
+		"before:2018-13-01",
+		"type:comment",
+		"word type:",
+	}
+	for _, text := range cases {
+		if _, err := ParseQuery(text); err == nil {
+			t.Errorf("The query should not parse. Query: %q", text)
+		}
+	}
+}
+
+func TestRestrictTypes_Success(t *testing.T) {
+	cases := []struct {
+		types   []string
+		allowed []string
+		result  []string
+	}{
+		{nil, nil, nil},
+		{nil, []string{"board"}, []string{"board"}},
+		{[]string{"post"}, nil, []string{"post"}},
+		{[]string{"post", "thread"}, []string{"thread", "board"}, []string{"thread"}},
+		{[]string{"user"}, []string{"board"}, []string{}},
+	}
+	for _, c := range cases {
+		q := Query{Types: c.types}
+		if result := q.restrictTypes(c.allowed); !reflect.DeepEqual(result, c.result) {
+			t.Errorf("The types were restricted wrong. Types: %#v, Allowed: %#v, Expected: %#v, Got: %#v", c.types, c.allowed, c.result, result)
+		}
+	}
+}
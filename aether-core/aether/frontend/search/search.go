@@ -6,12 +6,14 @@ package search
 import (
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/toolbox"
 	"github.com/blevesearch/bleve"
 	// bleveMapping "github.com/blevesearch/bleve/mapping"
 	// "github.com/davecgh/go-spew/spew"
 	"github.com/json-iterator/go"
 	"os"
 	"path/filepath"
+	"strconv"
 )
 
 var (
@@ -53,17 +55,30 @@ func (o *SearchId) GetUserFp() string {
 }
 
 type SearchResult struct {
-	Id    SearchId
-	Score float64
+	Id        SearchId
+	Score     float64
+	Fragments map[string][]string // Field name > highlighted fragments of it.
 }
 
 type SearchResults struct {
 	Query   string
 	Results []SearchResult
+	Offset  int
+	Limit   int
+	Total   uint64 // The count of all matches, not only the ones in this page.
 }
 
 type ScoreMap map[string]float64
 
+// ScoreMap maps the fingerprints of the results to their scores.
+func (srs *SearchResults) ScoreMap() ScoreMap {
+	sm := make(ScoreMap)
+	for k, _ := range srs.Results {
+		sm[srs.Results[k].Id.Fingerprint] = srs.Results[k].Score
+	}
+	return sm
+}
+
 func MakeSearchId(entityType, boardfp, threadfp, parentfp, fingerprint, userfp string) (string, error) {
 	idStruct := SearchId{
 		EntityType:  entityType, // Board, Thread, Post, User
@@ -80,13 +95,35 @@ func MakeSearchId(entityType, boardfp, threadfp, parentfp, fingerprint, userfp s
 	return string(idByte), nil
 }
 
+// mappingVersion is the version of the mappings in mappings.go. If an index was built with another version, it is deleted and rebuilt, since the fields the queries look at might not be in it.
+const (
+	mappingVersion    = 2
+	mappingVersionKey = "MappingVersion"
+)
+
 func IndexExists() bool {
 	idir := filepath.Join(globals.FrontendConfig.GetUserDirectory(), "frontend")
 	iloc := filepath.Join(idir, "searchindex")
-	if _, err := os.Stat(iloc); !os.IsNotExist(err) {
-		return true
+	if _, err := os.Stat(iloc); os.IsNotExist(err) {
+		return false
+	}
+	if !indexIsCurrent(iloc) {
+		// Deleting the index makes the KV store rebuild, see OpenKVStore. That reindexes everything with the current mappings.
+		logging.Logf(1, "The search index was built with older mappings. It will be rebuilt.")
+		toolbox.DeleteFromDisk(iloc)
+		return false
+	}
+	return true
+}
+
+func indexIsCurrent(iloc string) bool {
+	idx, err := bleve.Open(iloc)
+	if err != nil {
+		return false
 	}
-	return false
+	defer idx.Close()
+	v, err2 := idx.GetInternal([]byte(mappingVersionKey))
+	return err2 == nil && string(v) == strconv.Itoa(mappingVersion)
 }
 
 func OpenIndex() {
@@ -109,6 +146,10 @@ func createNewIndex(iloc string) bleve.Index {
 	if err != nil {
 		logging.LogCrash(err)
 	}
+	err2 := index.SetInternal([]byte(mappingVersionKey), []byte(strconv.Itoa(mappingVersion)))
+	if err2 != nil {
+		logging.LogCrash(err2)
+	}
 	return index
 }
 
@@ -134,23 +175,37 @@ func CommitBatch(b *bleve.Batch) error {
 
 /*----------  Search  ----------*/
 
+const (
+	defaultSearchLimit = 10000
+	maxSearchLimit     = 10000
+)
+
 /*
 Search returns IDs (locators) for the actual results. It does not return the results themselves. You still need to find those records from the KVStore yourself using the ID. The ID is a struct that carries enough information to find pretty much anything fast, though.
 
-HEADS UP: Search normally does have a type called entityType string that it receives, but so far, our search does not actually care about the entity type we want. It just returns the whole results, and then we filter it at a higher level of the stack.
-
-Whenever we become sophisticated enough to do the scanning on the entity type, it should be added back.
+The search text is in the query language in query.go. The entity types are the ones the caller is looking for (board, thread, post, user), blank for all. They're applied in the index query along with the type filters in the search text, so a page of results only has the entity types asked for. Offset and limit page the results, a limit of 0 is the default limit.
 */
-func Search(searchText string) (SearchResults, error) {
-	query := bleve.NewMatchQuery(searchText)
-	search := bleve.NewSearchRequest(query)
-	search.Size = 10000
-	results, err := index.Search(search)
+func Search(searchText string, entityTypes []string, offset, limit int) (SearchResults, error) {
+	if limit <= 0 || limit > maxSearchLimit {
+		limit = defaultSearchLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	srs := SearchResults{Query: searchText, Offset: offset, Limit: limit}
+	q, err := ParseQuery(searchText)
 	if err != nil {
-		logging.Logf(1, "Search encountered an error. Err: %v", err)
-		return SearchResults{}, err
+		logging.Logf(1, "The search query could not be parsed. Query: %v, Err: %v", searchText, err)
+		return srs, err
+	}
+	search := bleve.NewSearchRequestOptions(q.BleveQuery(entityTypes), limit, offset, false)
+	search.Highlight = bleve.NewHighlight()
+	results, err2 := index.Search(search)
+	if err2 != nil {
+		logging.Logf(1, "Search encountered an error. Err: %v", err2)
+		return srs, err2
 	}
-	srs := SearchResults{Query: searchText}
+	srs.Total = results.Total
 	for k, _ := range results.Hits {
 		resultId := SearchId{}
 		err := json.Unmarshal([]byte(results.Hits[k].ID), &resultId)
@@ -159,8 +214,9 @@ func Search(searchText string) (SearchResults, error) {
 			return srs, err
 		}
 		sr := SearchResult{
-			Id:    resultId,
-			Score: results.Hits[k].Score,
+			Id:        resultId,
+			Score:     results.Hits[k].Score,
+			Fragments: results.Hits[k].Fragments,
 		}
 		srs.Results = append(srs.Results, sr)
 	}
@@ -0,0 +1,76 @@
+// Frontend > Search > Snapshot
+// This file makes point-in-time copies of the search index, for the backups of the frontend.
+
+package search
+
+import (
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/toolbox"
+	"errors"
+	"fmt"
+	"github.com/blevesearch/bleve/index/store/boltdb"
+	"io/ioutil"
+	"path/filepath"
+)
+
+const (
+	indexMetaFilename  = "index_meta.json"
+	indexStoreFilename = "store"
+	snapshotBatchItems = 10000
+)
+
+func IndexLocation() string {
+	return filepath.Join(globals.FrontendConfig.GetUserDirectory(), "frontend", "searchindex")
+}
+
+/*
+Snapshot copies the open index into the given directory, which will be a regular index that can be opened in its place.
+
+The copy comes from a single read transaction of the index store, so it is consistent in itself, and the index can keep taking writes while this runs. The writes that land after the snapshot starts aren't in the copy, though: if the copy needs to match something else, like the KV store, stop the writers before you call this.
+*/
+func Snapshot(dir string) error {
+	meta, err := ioutil.ReadFile(filepath.Join(IndexLocation(), indexMetaFilename))
+	if err != nil {
+		return errors.New(fmt.Sprintf("The search index metadata could not be read. Error: %v", err))
+	}
+	_, src, err2 := index.Advanced()
+	if err2 != nil {
+		return errors.New(fmt.Sprintf("The search index store could not be accessed. Error: %v", err2))
+	}
+	r, err3 := src.Reader()
+	if err3 != nil {
+		return errors.New(fmt.Sprintf("The search index store could not be read. Error: %v", err3))
+	}
+	defer r.Close()
+	toolbox.CreatePath(dir)
+	err4 := ioutil.WriteFile(filepath.Join(dir, indexMetaFilename), meta, 0600)
+	if err4 != nil {
+		return err4
+	}
+	dst, err5 := boltdb.New(nil, map[string]interface{}{"path": filepath.Join(dir, indexStoreFilename)})
+	if err5 != nil {
+		return errors.New(fmt.Sprintf("The search index snapshot could not be created. Error: %v", err5))
+	}
+	defer dst.Close()
+	w, err6 := dst.Writer()
+	if err6 != nil {
+		return err6
+	}
+	defer w.Close()
+	it := r.PrefixIterator([]byte{})
+	defer it.Close()
+	batch := w.NewBatch()
+	count := 0
+	for k, v, valid := it.Current(); valid; k, v, valid = it.Current() {
+		batch.Set(k, v) // Set copies both.
+		count++
+		if count%snapshotBatchItems == 0 {
+			if err := w.ExecuteBatch(batch); err != nil {
+				return err
+			}
+			batch = w.NewBatch()
+		}
+		it.Next()
+	}
+	return w.ExecuteBatch(batch)
+}
@@ -6,8 +6,11 @@ package api
 import (
 	"database/sql/driver"
 	// "fmt"
+	"aether-core/aether/services/fingerprinting"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/proofofwork"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	"fmt"
 	"golang.org/x/crypto/ed25519"
 	// "github.com/davecgh/go-spew/spew"
@@ -19,7 +22,7 @@ import (
 	"time"
 )
 
-// Structs for the entity types. There are 7 types. Board, Thread, Post, Vote, Key, Address, Truststate.
+// Structs for the entity types. There are 8 types. Board, Thread, Post, Vote, Key, Address, Truststate, DirectMessage.
 
 // Low-level types
 
@@ -126,6 +129,7 @@ type Protocol struct {
 	VersionMajor uint8         `json:"version_major"`
 	VersionMinor uint16        `json:"version_minor"`
 	Subprotocols []Subprotocol `json:"subprotocols"`
+	Realms       []Fingerprint `json:"realms,omitempty"` // Tags of the realms carried in addition to the public realm. (See RealmTag.)
 }
 
 type Client struct {
@@ -152,7 +156,7 @@ type Board struct { // Mutables: BoardOwners, Description, Meta
 	UpdateableFieldSet
 }
 
-type Thread struct { // Mutables: Body, Meta
+type Thread struct { // Mutables: Body, Meta, EncrContent
 	ProvableFieldSet
 	Board          Fingerprint `json:"board"`
 	Name           string      `json:"name"`
@@ -167,7 +171,7 @@ type Thread struct { // Mutables: Body, Meta
 	UpdateableFieldSet
 }
 
-type Post struct { // Mutables: Body, Meta
+type Post struct { // Mutables: Body, Meta, EncrContent
 	ProvableFieldSet
 	Board          Fingerprint `json:"board"`
 	Thread         Fingerprint `json:"thread"`
@@ -228,6 +232,20 @@ type Truststate struct { // Mutables: Type, Expiry, Meta (Domain is immutable)
 	UpdateableFieldSet
 }
 
+// DirectMessage is a message from one user to another. The body is sealed to the recipient's key (see services/encryption), so only the two ends of the conversation can read it. The nodes that relay it can still verify it, since the ciphertext is covered by the fingerprint, PoW and signature like any other field. Direct messages live in their own subprotocol (dm0), so that the nodes that don't support them don't get them.
+type DirectMessage struct { // Mutables: None
+	ProvableFieldSet
+	Recipient      Fingerprint `json:"recipient"` // Fingerprint of the recipient's key.
+	Body           string      `json:"body"`      // Ciphertext.
+	Owner          Fingerprint `json:"owner"`
+	OwnerPublicKey string      `json:"owner_publickey"`
+	EntityVersion  int         `json:"entity_version"`
+	Meta           string      `json:"meta"`
+	RealmId        Fingerprint `json:"realm_id"`
+	EncrContent    string      `json:"encrcontent"`
+	UpdateableFieldSet
+}
+
 type Address struct { // Mutables: None
 	Location           Location    `json:"location"`
 	Sublocation        Location    `json:"sublocation"`
@@ -308,6 +326,16 @@ type TruststateIndex struct {
 	PageNumber    int         `json:"page_number"`
 }
 
+type DirectMessageIndex struct {
+	Fingerprint   Fingerprint `json:"fingerprint"`
+	Owner         Fingerprint `json:",omitempty"`
+	Recipient     Fingerprint `json:"recipient"`
+	Creation      Timestamp   `json:"creation"`
+	LastUpdate    Timestamp   `json:"last_update"`
+	EntityVersion int         `json:"entity_version"`
+	PageNumber    int         `json:"page_number"`
+}
+
 // Index interfaces
 
 type ProvableIndex interface {
@@ -322,40 +350,44 @@ type ProvableIndex interface {
 
 // Fingerprint accessors
 
-func (entity *BoardIndex) GetFingerprint() Fingerprint      { return entity.Fingerprint }
-func (entity *ThreadIndex) GetFingerprint() Fingerprint     { return entity.Fingerprint }
-func (entity *PostIndex) GetFingerprint() Fingerprint       { return entity.Fingerprint }
-func (entity *VoteIndex) GetFingerprint() Fingerprint       { return entity.Fingerprint }
-func (entity *KeyIndex) GetFingerprint() Fingerprint        { return entity.Fingerprint }
-func (entity *TruststateIndex) GetFingerprint() Fingerprint { return entity.Fingerprint }
+func (entity *BoardIndex) GetFingerprint() Fingerprint         { return entity.Fingerprint }
+func (entity *ThreadIndex) GetFingerprint() Fingerprint        { return entity.Fingerprint }
+func (entity *PostIndex) GetFingerprint() Fingerprint          { return entity.Fingerprint }
+func (entity *VoteIndex) GetFingerprint() Fingerprint          { return entity.Fingerprint }
+func (entity *KeyIndex) GetFingerprint() Fingerprint           { return entity.Fingerprint }
+func (entity *TruststateIndex) GetFingerprint() Fingerprint    { return entity.Fingerprint }
+func (entity *DirectMessageIndex) GetFingerprint() Fingerprint { return entity.Fingerprint }
 
 // LastUpdate accessors
 
-func (entity *BoardIndex) GetLastUpdate() Timestamp      { return entity.LastUpdate }
-func (entity *ThreadIndex) GetLastUpdate() Timestamp     { return entity.LastUpdate }
-func (entity *PostIndex) GetLastUpdate() Timestamp       { return entity.LastUpdate }
-func (entity *VoteIndex) GetLastUpdate() Timestamp       { return entity.LastUpdate }
-func (entity *KeyIndex) GetLastUpdate() Timestamp        { return entity.LastUpdate }
-func (entity *TruststateIndex) GetLastUpdate() Timestamp { return entity.LastUpdate }
+func (entity *BoardIndex) GetLastUpdate() Timestamp         { return entity.LastUpdate }
+func (entity *ThreadIndex) GetLastUpdate() Timestamp        { return entity.LastUpdate }
+func (entity *PostIndex) GetLastUpdate() Timestamp          { return entity.LastUpdate }
+func (entity *VoteIndex) GetLastUpdate() Timestamp          { return entity.LastUpdate }
+func (entity *KeyIndex) GetLastUpdate() Timestamp           { return entity.LastUpdate }
+func (entity *TruststateIndex) GetLastUpdate() Timestamp    { return entity.LastUpdate }
+func (entity *DirectMessageIndex) GetLastUpdate() Timestamp { return entity.LastUpdate }
 
 // Creation accessors
 
-func (entity *BoardIndex) GetCreation() Timestamp      { return entity.Creation }
-func (entity *ThreadIndex) GetCreation() Timestamp     { return entity.Creation }
-func (entity *PostIndex) GetCreation() Timestamp       { return entity.Creation }
-func (entity *VoteIndex) GetCreation() Timestamp       { return entity.Creation }
-func (entity *KeyIndex) GetCreation() Timestamp        { return entity.Creation }
-func (entity *TruststateIndex) GetCreation() Timestamp { return entity.Creation }
+func (entity *BoardIndex) GetCreation() Timestamp         { return entity.Creation }
+func (entity *ThreadIndex) GetCreation() Timestamp        { return entity.Creation }
+func (entity *PostIndex) GetCreation() Timestamp          { return entity.Creation }
+func (entity *VoteIndex) GetCreation() Timestamp          { return entity.Creation }
+func (entity *KeyIndex) GetCreation() Timestamp           { return entity.Creation }
+func (entity *TruststateIndex) GetCreation() Timestamp    { return entity.Creation }
+func (entity *DirectMessageIndex) GetCreation() Timestamp { return entity.Creation }
 
 // EntityType accessors
 
-func (entity *BoardIndex) GetEntityType() string      { return "board" }
-func (entity *ThreadIndex) GetEntityType() string     { return "thread" }
-func (entity *PostIndex) GetEntityType() string       { return "post" }
-func (entity *VoteIndex) GetEntityType() string       { return "vote" }
-func (entity *KeyIndex) GetEntityType() string        { return "key" }
-func (entity *TruststateIndex) GetEntityType() string { return "truststate" }
-func (entity *AddressIndex) GetEntityType() string    { return "address" }
+func (entity *BoardIndex) GetEntityType() string         { return "board" }
+func (entity *ThreadIndex) GetEntityType() string        { return "thread" }
+func (entity *PostIndex) GetEntityType() string          { return "post" }
+func (entity *VoteIndex) GetEntityType() string          { return "vote" }
+func (entity *KeyIndex) GetEntityType() string           { return "key" }
+func (entity *TruststateIndex) GetEntityType() string    { return "truststate" }
+func (entity *DirectMessageIndex) GetEntityType() string { return "directmessage" }
+func (entity *AddressIndex) GetEntityType() string       { return "address" }
 
 // LastModified accessors (LM: the larger of creation / lastupdate)
 
@@ -367,30 +399,33 @@ func glmIndex(entity ProvableIndex) Timestamp {
 	}
 }
 
-func (entity *BoardIndex) GetLastModified() Timestamp      { return glmIndex(entity) }
-func (entity *ThreadIndex) GetLastModified() Timestamp     { return glmIndex(entity) }
-func (entity *PostIndex) GetLastModified() Timestamp       { return glmIndex(entity) }
-func (entity *VoteIndex) GetLastModified() Timestamp       { return glmIndex(entity) }
-func (entity *KeyIndex) GetLastModified() Timestamp        { return glmIndex(entity) }
-func (entity *TruststateIndex) GetLastModified() Timestamp { return glmIndex(entity) }
+func (entity *BoardIndex) GetLastModified() Timestamp         { return glmIndex(entity) }
+func (entity *ThreadIndex) GetLastModified() Timestamp        { return glmIndex(entity) }
+func (entity *PostIndex) GetLastModified() Timestamp          { return glmIndex(entity) }
+func (entity *VoteIndex) GetLastModified() Timestamp          { return glmIndex(entity) }
+func (entity *KeyIndex) GetLastModified() Timestamp           { return glmIndex(entity) }
+func (entity *TruststateIndex) GetLastModified() Timestamp    { return glmIndex(entity) }
+func (entity *DirectMessageIndex) GetLastModified() Timestamp { return glmIndex(entity) }
 
 // IsIndex accessors
 
-func (entity *BoardIndex) IsIndex() bool      { return true }
-func (entity *ThreadIndex) IsIndex() bool     { return true }
-func (entity *PostIndex) IsIndex() bool       { return true }
-func (entity *VoteIndex) IsIndex() bool       { return true }
-func (entity *KeyIndex) IsIndex() bool        { return true }
-func (entity *TruststateIndex) IsIndex() bool { return true }
+func (entity *BoardIndex) IsIndex() bool         { return true }
+func (entity *ThreadIndex) IsIndex() bool        { return true }
+func (entity *PostIndex) IsIndex() bool          { return true }
+func (entity *VoteIndex) IsIndex() bool          { return true }
+func (entity *KeyIndex) IsIndex() bool           { return true }
+func (entity *TruststateIndex) IsIndex() bool    { return true }
+func (entity *DirectMessageIndex) IsIndex() bool { return true }
 
 // GetOwner accessors
 
-func (entity *BoardIndex) GetOwner() Fingerprint      { return entity.Owner }
-func (entity *ThreadIndex) GetOwner() Fingerprint     { return entity.Owner }
-func (entity *PostIndex) GetOwner() Fingerprint       { return entity.Owner }
-func (entity *VoteIndex) GetOwner() Fingerprint       { return entity.Owner }
-func (entity *KeyIndex) GetOwner() Fingerprint        { return entity.Fingerprint }
-func (entity *TruststateIndex) GetOwner() Fingerprint { return entity.Owner }
+func (entity *BoardIndex) GetOwner() Fingerprint         { return entity.Owner }
+func (entity *ThreadIndex) GetOwner() Fingerprint        { return entity.Owner }
+func (entity *PostIndex) GetOwner() Fingerprint          { return entity.Owner }
+func (entity *VoteIndex) GetOwner() Fingerprint          { return entity.Owner }
+func (entity *KeyIndex) GetOwner() Fingerprint           { return entity.Fingerprint }
+func (entity *TruststateIndex) GetOwner() Fingerprint    { return entity.Owner }
+func (entity *DirectMessageIndex) GetOwner() Fingerprint { return entity.Owner }
 
 // Response types
 
@@ -431,6 +466,8 @@ type Answer struct { // Bodies of API Endpoint responses from remote. This will
 	Truststates []Truststate `json:"truststates,omitempty"`
 	Addresses   []Address    `json:"addresses,omitempty"`
 
+	DirectMessages []DirectMessage `json:"directmessages,omitempty"`
+
 	BoardIndexes      []BoardIndex      `json:"boards_index,omitempty"`
 	ThreadIndexes     []ThreadIndex     `json:"threads_index,omitempty"`
 	PostIndexes       []PostIndex       `json:"posts_index,omitempty"`
@@ -439,6 +476,8 @@ type Answer struct { // Bodies of API Endpoint responses from remote. This will
 	TruststateIndexes []TruststateIndex `json:"truststates_index,omitempty"`
 	AddressIndexes    []AddressIndex    `json:"addresses_index,omitempty"`
 
+	DirectMessageIndexes []DirectMessageIndex `json:"directmessages_index,omitempty"`
+
 	BoardManifests      []PageManifest `json:"boards_manifest,omitempty"`
 	ThreadManifests     []PageManifest `json:"threads_manifest,omitempty"`
 	PostManifests       []PageManifest `json:"posts_manifest,omitempty"`
@@ -446,6 +485,8 @@ type Answer struct { // Bodies of API Endpoint responses from remote. This will
 	KeyManifests        []PageManifest `json:"keys_manifest,omitempty"`
 	TruststateManifests []PageManifest `json:"truststates_manifest,omitempty"`
 	AddressManifests    []PageManifest `json:"addresses_manifest,omitempty"`
+
+	DirectMessageManifests []PageManifest `json:"directmessages_manifest,omitempty"`
 }
 
 // Manifest type
@@ -501,6 +542,9 @@ func (r *ApiResponse) GetProvables() *[]Provable {
 	for key, _ := range r.ResponseBody.Truststates {
 		p = append(p, Provable(&r.ResponseBody.Truststates[key]))
 	}
+	for key, _ := range r.ResponseBody.DirectMessages {
+		p = append(p, Provable(&r.ResponseBody.DirectMessages[key]))
+	}
 	return &p
 }
 
@@ -557,9 +601,11 @@ func (r *ApiResponse) Verify() []error {
 	provables := r.GetProvables()
 	for _, e := range *provables { // provable is an interface, so pointer..
 		err := Verify(e)
-		if err != nil && !strings.Contains(err.Error(), "This entity is in a badlist") {
+		if err != nil && !strings.Contains(err.Error(), "This entity is in a badlist") && !strings.Contains(err.Error(), "This entity is in a realm that this node does not carry") {
 			/*
 				We do not count badlist errors as verification errors for the purposes of cutting the connection. The other types of errors will still count for malformed objects threshold, though.
+
+				Same for realm errors: the remote can legitimately carry realms that we don't, and a cache page it serves can mix those. That's not malformed data, it's just data that isn't for us.
 			*/
 			errs = append(errs, err)
 			continue
@@ -586,9 +632,15 @@ func (r *ApiResponse) Prefill() {
 	for _, val := range subprotsAsShims {
 		subprotsSupported = append(subprotsSupported, Subprotocol(val))
 	}
+	// Understanding the filters is a matter of the code, not of what the user chose to serve, so it's always declared.
+	subprotsSupported = append(subprotsSupported, Subprotocol{SubscriptionFiltersSubprotocol, 1, 0, SubscriptionFilters})
 	r.NodePublicKey = globals.BackendConfig.GetMarshaledBackendPublicKey()
 	addr := Address{}
 	addr.LocationType = globals.BackendConfig.GetExternalIpType()
+	if !transport.IsTCP(addr.LocationType) {
+		// The remote can't see where we are from the connection in the transports other than TCP, so we tell it.
+		addr.Location = Location(globals.BackendConfig.GetExternalIp())
+	}
 	addr.Type = globals.BackendConfig.GetNodeType()
 	if !globals.BackendConfig.GetRenderNonconnectible() {
 		addr.Port = uint16(globals.BackendConfig.GetExternalPort())
@@ -596,6 +648,7 @@ func (r *ApiResponse) Prefill() {
 	addr.Protocol.VersionMajor = globals.BackendConfig.GetProtocolVersionMajor()
 	addr.Protocol.VersionMinor = globals.BackendConfig.GetProtocolVersionMinor()
 	addr.Protocol.Subprotocols = subprotsSupported
+	addr.Protocol.Realms = RealmTags(AdvertisedRealms(), r.NodePublicKey)
 	addr.Client.VersionMajor = globals.BackendConfig.GetClientVersionMajor()
 	addr.Client.VersionMinor = globals.BackendConfig.GetClientVersionMinor()
 	addr.Client.VersionPatch = globals.BackendConfig.GetClientVersionPatch()
@@ -617,7 +670,7 @@ type Fingerprintable interface {
 
 type PoWAble interface {
 	GetProofOfWork() ProofOfWork // Field accessor
-	CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error
+	CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error
 	VerifyPoW(pubKey string) (bool, error)
 }
 
@@ -651,6 +704,135 @@ type Shardable interface {
 	GetRealmId() Fingerprint
 }
 
+// Realms
+
+/*
+	Every provable entity belongs to a realm, declared by its RealmId. Entities with an empty RealmId belong to the public realm, which every node carries. Other realms are private or semi-private shards of the network: a node carries them only if its operator has configured it to, and a node will not request, accept, or serve entities from a realm it does not carry.
+
+	Heads up: realms are not access-controlled beyond this. There is no membership list, and no authentication of the remote asking for a realm. What keeps a non-member out is that it doesn't know the RealmId: the caches of a realm live under a path that has the RealmId in it, and a POST request has to name the RealmId to get anything from it. So the RealmId is the credential of the realm. Anyone who has it can read the realm, and anyone who is a member can hand it out. Treat it as a secret, and start a new realm if it leaks.
+
+	That's also why we never send our RealmIds in the clear. A node declares its realms in its Address.Protocol as tags, each the hash of the RealmId and the public key of the node. A remote that carries the same realm can compute the same tag from the public key it sees, and find out that we share the realm. A remote that doesn't carry it can't get the RealmId back from the tag, and because the tags differ from node to node, it can't tell that two nodes are in the same realm either. Only after both sides know they share a realm do we name it in a request.
+*/
+
+// PublicRealm is the realm of entities with an empty RealmId.
+const PublicRealm = Fingerprint("")
+
+// AdvertisedRealms returns the non-public realms this node carries. These are the RealmIds: don't send them to a remote as is, send their tags. (See RealmTags.)
+func AdvertisedRealms() []Fingerprint {
+	realms := []Fingerprint{}
+	if isFrontend() {
+		return realms
+	}
+	for _, val := range globals.BackendConfig.GetServedRealms() {
+		realms = append(realms, Fingerprint(val))
+	}
+	return realms
+}
+
+// ServedRealms returns all realms this node carries, including the public realm.
+func ServedRealms() []Fingerprint {
+	return append([]Fingerprint{PublicRealm}, AdvertisedRealms()...)
+}
+
+// RealmServed returns whether this node carries the given realm. The frontend has no realm configuration of its own, it sees whatever its backend carries.
+func RealmServed(realm Fingerprint) bool {
+	if realm == PublicRealm || isFrontend() {
+		return true
+	}
+	return realmIn(realm, AdvertisedRealms())
+}
+
+// RealmTag returns the tag under which the node with the given public key declares the given realm.
+func RealmTag(realm Fingerprint, nodePublicKey string) Fingerprint {
+	return Fingerprint(fingerprinting.Create(fmt.Sprint("realm:", realm, ":", nodePublicKey)))
+}
+
+// RealmTags returns the tags of the given realms for the node with the given public key.
+func RealmTags(realms []Fingerprint, nodePublicKey string) []Fingerprint {
+	tags := []Fingerprint{}
+	for _, realm := range realms {
+		tags = append(tags, RealmTag(realm, nodePublicKey))
+	}
+	return tags
+}
+
+// SharedRealms returns the non-public realms that both this node and the remote with the given protocol and public key carry. The remote declares its realms as tags, so we check which of our own realms it has the tags of.
+func SharedRealms(remote Protocol, remotePublicKey string) []Fingerprint {
+	shared := []Fingerprint{}
+	if len(remotePublicKey) == 0 {
+		return shared
+	}
+	for _, realm := range AdvertisedRealms() {
+		if realm != PublicRealm && realmIn(RealmTag(realm, remotePublicKey), remote.Realms) && !realmIn(realm, shared) {
+			shared = append(shared, realm)
+		}
+	}
+	return shared
+}
+
+// RealmPath returns the path, relative to the protocol version root, under which the caches of the given realm live. The public realm lives at the root, which is where all caches used to be before realms.
+func RealmPath(realm Fingerprint) string {
+	if realm == PublicRealm {
+		return ""
+	}
+	return fmt.Sprint("realms/", realm)
+}
+
+func realmIn(realm Fingerprint, realms []Fingerprint) bool {
+	for key, _ := range realms {
+		if realms[key] == realm {
+			return true
+		}
+	}
+	return false
+}
+
+// Subprotocols
+
+// DirectMessagesSubprotocol is the subprotocol that carries direct messages. It's separate from c0 so that a node can relay boards without relaying the messages between users, and the other way around.
+const DirectMessagesSubprotocol = "dm0"
+
+// SubscriptionFiltersSubprotocol is how a node declares that it understands the board and owner filters of the subscriptions in its POST requests. It carries no entities, the filters are listed in its place. The nodes that don't know it keep it like any other subprotocol and otherwise ignore it, which a raise of the protocol version would not give us: they only accept pages of 1.0.
+const SubscriptionFiltersSubprotocol = "sf0"
+
+// SubscriptionFilters are the filters that SubscriptionFiltersSubprotocol declares.
+var SubscriptionFilters = []string{"boardfilter", "ownerfilter"}
+
+// SubprotocolServed returns whether this node serves the given entity within the given subprotocol. The frontend has no subprotocol configuration of its own, it sees whatever its backend serves.
+func SubprotocolServed(name string, entity string) bool {
+	if isFrontend() {
+		return true
+	}
+	for _, val := range globals.BackendConfig.GetServingSubprotocols() {
+		if val.Name == name && entityIn(entity, val.SupportedEntities) {
+			return true
+		}
+	}
+	return false
+}
+
+// SubprotocolShared returns whether both this node and the remote with the given protocol serve the given entity within the given subprotocol.
+func SubprotocolShared(remote Protocol, name string, entity string) bool {
+	if !SubprotocolServed(name, entity) {
+		return false
+	}
+	for _, val := range remote.Subprotocols {
+		if val.Name == name && entityIn(entity, val.SupportedEntities) {
+			return true
+		}
+	}
+	return false
+}
+
+func entityIn(entity string, entities []string) bool {
+	for key, _ := range entities {
+		if entities[key] == entity {
+			return true
+		}
+	}
+	return false
+}
+
 type Provable interface {
 	Verifiable
 	Shardable
@@ -665,7 +847,7 @@ type Provable interface {
 type Updateable interface {
 	GetUpdateProofOfWork() ProofOfWork // Field accessor
 	GetUpdateSignature() Signature     // Field accessor
-	CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error
+	CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error
 	CreateUpdateSignature(keyPair *ed25519.PrivateKey) error
 }
 
@@ -677,51 +859,56 @@ type Versionable interface {
 
 // Version accessors
 
-func (entity *Board) GetVersion() int       { return entity.EntityVersion }
-func (entity *Thread) GetVersion() int      { return entity.EntityVersion }
-func (entity *Post) GetVersion() int        { return entity.EntityVersion }
-func (entity *Vote) GetVersion() int        { return entity.EntityVersion }
-func (entity *Key) GetVersion() int         { return entity.EntityVersion }
-func (entity *Truststate) GetVersion() int  { return entity.EntityVersion }
-func (entity *Address) GetVersion() int     { return entity.EntityVersion }
-func (entity *ApiResponse) GetVersion() int { return entity.EntityVersion }
+func (entity *Board) GetVersion() int         { return entity.EntityVersion }
+func (entity *Thread) GetVersion() int        { return entity.EntityVersion }
+func (entity *Post) GetVersion() int          { return entity.EntityVersion }
+func (entity *Vote) GetVersion() int          { return entity.EntityVersion }
+func (entity *Key) GetVersion() int           { return entity.EntityVersion }
+func (entity *Truststate) GetVersion() int    { return entity.EntityVersion }
+func (entity *DirectMessage) GetVersion() int { return entity.EntityVersion }
+func (entity *Address) GetVersion() int       { return entity.EntityVersion }
+func (entity *ApiResponse) GetVersion() int   { return entity.EntityVersion }
 
 // Fingerprint accessors
 
-func (entity *Board) GetFingerprint() Fingerprint      { return entity.Fingerprint }
-func (entity *Thread) GetFingerprint() Fingerprint     { return entity.Fingerprint }
-func (entity *Post) GetFingerprint() Fingerprint       { return entity.Fingerprint }
-func (entity *Vote) GetFingerprint() Fingerprint       { return entity.Fingerprint }
-func (entity *Key) GetFingerprint() Fingerprint        { return entity.Fingerprint }
-func (entity *Truststate) GetFingerprint() Fingerprint { return entity.Fingerprint }
+func (entity *Board) GetFingerprint() Fingerprint         { return entity.Fingerprint }
+func (entity *Thread) GetFingerprint() Fingerprint        { return entity.Fingerprint }
+func (entity *Post) GetFingerprint() Fingerprint          { return entity.Fingerprint }
+func (entity *Vote) GetFingerprint() Fingerprint          { return entity.Fingerprint }
+func (entity *Key) GetFingerprint() Fingerprint           { return entity.Fingerprint }
+func (entity *Truststate) GetFingerprint() Fingerprint    { return entity.Fingerprint }
+func (entity *DirectMessage) GetFingerprint() Fingerprint { return entity.Fingerprint }
 
 // LastUpdate accessors
 
-func (entity *Board) GetLastUpdate() Timestamp      { return entity.LastUpdate }
-func (entity *Thread) GetLastUpdate() Timestamp     { return entity.LastUpdate }
-func (entity *Post) GetLastUpdate() Timestamp       { return entity.LastUpdate }
-func (entity *Vote) GetLastUpdate() Timestamp       { return entity.LastUpdate }
-func (entity *Key) GetLastUpdate() Timestamp        { return entity.LastUpdate }
-func (entity *Truststate) GetLastUpdate() Timestamp { return entity.LastUpdate }
+func (entity *Board) GetLastUpdate() Timestamp         { return entity.LastUpdate }
+func (entity *Thread) GetLastUpdate() Timestamp        { return entity.LastUpdate }
+func (entity *Post) GetLastUpdate() Timestamp          { return entity.LastUpdate }
+func (entity *Vote) GetLastUpdate() Timestamp          { return entity.LastUpdate }
+func (entity *Key) GetLastUpdate() Timestamp           { return entity.LastUpdate }
+func (entity *Truststate) GetLastUpdate() Timestamp    { return entity.LastUpdate }
+func (entity *DirectMessage) GetLastUpdate() Timestamp { return entity.LastUpdate }
 
 // Creation accessors
 
-func (entity *Board) GetCreation() Timestamp      { return entity.Creation }
-func (entity *Thread) GetCreation() Timestamp     { return entity.Creation }
-func (entity *Post) GetCreation() Timestamp       { return entity.Creation }
-func (entity *Vote) GetCreation() Timestamp       { return entity.Creation }
-func (entity *Key) GetCreation() Timestamp        { return entity.Creation }
-func (entity *Truststate) GetCreation() Timestamp { return entity.Creation }
+func (entity *Board) GetCreation() Timestamp         { return entity.Creation }
+func (entity *Thread) GetCreation() Timestamp        { return entity.Creation }
+func (entity *Post) GetCreation() Timestamp          { return entity.Creation }
+func (entity *Vote) GetCreation() Timestamp          { return entity.Creation }
+func (entity *Key) GetCreation() Timestamp           { return entity.Creation }
+func (entity *Truststate) GetCreation() Timestamp    { return entity.Creation }
+func (entity *DirectMessage) GetCreation() Timestamp { return entity.Creation }
 
 // EntityType accessors
 
-func (entity *Board) GetEntityType() string      { return "board" }
-func (entity *Thread) GetEntityType() string     { return "thread" }
-func (entity *Post) GetEntityType() string       { return "post" }
-func (entity *Vote) GetEntityType() string       { return "vote" }
-func (entity *Key) GetEntityType() string        { return "key" }
-func (entity *Truststate) GetEntityType() string { return "truststate" }
-func (entity *Address) GetEntityType() string    { return "address" }
+func (entity *Board) GetEntityType() string         { return "board" }
+func (entity *Thread) GetEntityType() string        { return "thread" }
+func (entity *Post) GetEntityType() string          { return "post" }
+func (entity *Vote) GetEntityType() string          { return "vote" }
+func (entity *Key) GetEntityType() string           { return "key" }
+func (entity *Truststate) GetEntityType() string    { return "truststate" }
+func (entity *DirectMessage) GetEntityType() string { return "directmessage" }
+func (entity *Address) GetEntityType() string       { return "address" }
 
 // LastModified accessors (LM: the larger of creation / lastupdate)
 
@@ -734,21 +921,23 @@ func glm(entity Provable) Timestamp {
 	}
 }
 
-func (entity *Board) GetLastModified() Timestamp      { return glm(entity) }
-func (entity *Thread) GetLastModified() Timestamp     { return glm(entity) }
-func (entity *Post) GetLastModified() Timestamp       { return glm(entity) }
-func (entity *Vote) GetLastModified() Timestamp       { return glm(entity) }
-func (entity *Key) GetLastModified() Timestamp        { return glm(entity) }
-func (entity *Truststate) GetLastModified() Timestamp { return glm(entity) }
+func (entity *Board) GetLastModified() Timestamp         { return glm(entity) }
+func (entity *Thread) GetLastModified() Timestamp        { return glm(entity) }
+func (entity *Post) GetLastModified() Timestamp          { return glm(entity) }
+func (entity *Vote) GetLastModified() Timestamp          { return glm(entity) }
+func (entity *Key) GetLastModified() Timestamp           { return glm(entity) }
+func (entity *Truststate) GetLastModified() Timestamp    { return glm(entity) }
+func (entity *DirectMessage) GetLastModified() Timestamp { return glm(entity) }
 
 // Signature accessors
 
-func (entity *Board) GetSignature() Signature      { return entity.Signature }
-func (entity *Thread) GetSignature() Signature     { return entity.Signature }
-func (entity *Post) GetSignature() Signature       { return entity.Signature }
-func (entity *Vote) GetSignature() Signature       { return entity.Signature }
-func (entity *Key) GetSignature() Signature        { return entity.Signature }
-func (entity *Truststate) GetSignature() Signature { return entity.Signature }
+func (entity *Board) GetSignature() Signature         { return entity.Signature }
+func (entity *Thread) GetSignature() Signature        { return entity.Signature }
+func (entity *Post) GetSignature() Signature          { return entity.Signature }
+func (entity *Vote) GetSignature() Signature          { return entity.Signature }
+func (entity *Key) GetSignature() Signature           { return entity.Signature }
+func (entity *Truststate) GetSignature() Signature    { return entity.Signature }
+func (entity *DirectMessage) GetSignature() Signature { return entity.Signature }
 
 // OwnerPublicKey accessors
 
@@ -758,52 +947,58 @@ func (entity *Post) GetOwnerPublicKey() string   { return entity.OwnerPublicKey
 func (entity *Vote) GetOwnerPublicKey() string   { return entity.OwnerPublicKey }
 
 // Heads up, this is slightly different in Key below.
-func (entity *Key) GetOwnerPublicKey() string        { return entity.Key }
-func (entity *Truststate) GetOwnerPublicKey() string { return entity.OwnerPublicKey }
+func (entity *Key) GetOwnerPublicKey() string           { return entity.Key }
+func (entity *Truststate) GetOwnerPublicKey() string    { return entity.OwnerPublicKey }
+func (entity *DirectMessage) GetOwnerPublicKey() string { return entity.OwnerPublicKey }
 
 // Verifiable accessors / setters
-func (entity *Board) GetVerified() bool      { return entity.Verified }
-func (entity *Thread) GetVerified() bool     { return entity.Verified }
-func (entity *Post) GetVerified() bool       { return entity.Verified }
-func (entity *Vote) GetVerified() bool       { return entity.Verified }
-func (entity *Key) GetVerified() bool        { return entity.Verified }
-func (entity *Truststate) GetVerified() bool { return entity.Verified }
-func (entity *Address) GetVerified() bool    { return entity.Verified }
-
-func (entity *Board) SetVerified(v bool)      { entity.Verified = v }
-func (entity *Thread) SetVerified(v bool)     { entity.Verified = v }
-func (entity *Post) SetVerified(v bool)       { entity.Verified = v }
-func (entity *Vote) SetVerified(v bool)       { entity.Verified = v }
-func (entity *Key) SetVerified(v bool)        { entity.Verified = v }
-func (entity *Truststate) SetVerified(v bool) { entity.Verified = v }
-func (entity *Address) SetVerified(v bool)    { entity.Verified = v }
+func (entity *Board) GetVerified() bool         { return entity.Verified }
+func (entity *Thread) GetVerified() bool        { return entity.Verified }
+func (entity *Post) GetVerified() bool          { return entity.Verified }
+func (entity *Vote) GetVerified() bool          { return entity.Verified }
+func (entity *Key) GetVerified() bool           { return entity.Verified }
+func (entity *Truststate) GetVerified() bool    { return entity.Verified }
+func (entity *DirectMessage) GetVerified() bool { return entity.Verified }
+func (entity *Address) GetVerified() bool       { return entity.Verified }
+
+func (entity *Board) SetVerified(v bool)         { entity.Verified = v }
+func (entity *Thread) SetVerified(v bool)        { entity.Verified = v }
+func (entity *Post) SetVerified(v bool)          { entity.Verified = v }
+func (entity *Vote) SetVerified(v bool)          { entity.Verified = v }
+func (entity *Key) SetVerified(v bool)           { entity.Verified = v }
+func (entity *Truststate) SetVerified(v bool)    { entity.Verified = v }
+func (entity *DirectMessage) SetVerified(v bool) { entity.Verified = v }
+func (entity *Address) SetVerified(v bool)       { entity.Verified = v }
 
 // UpdateSignature accessors
 
-func (entity *Board) GetUpdateSignature() Signature      { return entity.UpdateSignature }
-func (entity *Thread) GetUpdateSignature() Signature     { return entity.UpdateSignature }
-func (entity *Post) GetUpdateSignature() Signature       { return entity.UpdateSignature }
-func (entity *Vote) GetUpdateSignature() Signature       { return entity.UpdateSignature }
-func (entity *Key) GetUpdateSignature() Signature        { return entity.UpdateSignature }
-func (entity *Truststate) GetUpdateSignature() Signature { return entity.UpdateSignature }
+func (entity *Board) GetUpdateSignature() Signature         { return entity.UpdateSignature }
+func (entity *Thread) GetUpdateSignature() Signature        { return entity.UpdateSignature }
+func (entity *Post) GetUpdateSignature() Signature          { return entity.UpdateSignature }
+func (entity *Vote) GetUpdateSignature() Signature          { return entity.UpdateSignature }
+func (entity *Key) GetUpdateSignature() Signature           { return entity.UpdateSignature }
+func (entity *Truststate) GetUpdateSignature() Signature    { return entity.UpdateSignature }
+func (entity *DirectMessage) GetUpdateSignature() Signature { return entity.UpdateSignature }
 
 // ProofOfWork accessors
 
-func (entity *Board) GetProofOfWork() ProofOfWork      { return entity.ProofOfWork }
-func (entity *Thread) GetProofOfWork() ProofOfWork     { return entity.ProofOfWork }
-func (entity *Post) GetProofOfWork() ProofOfWork       { return entity.ProofOfWork }
-func (entity *Vote) GetProofOfWork() ProofOfWork       { return entity.ProofOfWork }
-func (entity *Key) GetProofOfWork() ProofOfWork        { return entity.ProofOfWork }
-func (entity *Truststate) GetProofOfWork() ProofOfWork { return entity.ProofOfWork }
+func (entity *Board) GetProofOfWork() ProofOfWork         { return entity.ProofOfWork }
+func (entity *Thread) GetProofOfWork() ProofOfWork        { return entity.ProofOfWork }
+func (entity *Post) GetProofOfWork() ProofOfWork          { return entity.ProofOfWork }
+func (entity *Vote) GetProofOfWork() ProofOfWork          { return entity.ProofOfWork }
+func (entity *Key) GetProofOfWork() ProofOfWork           { return entity.ProofOfWork }
+func (entity *Truststate) GetProofOfWork() ProofOfWork    { return entity.ProofOfWork }
+func (entity *DirectMessage) GetProofOfWork() ProofOfWork { return entity.ProofOfWork }
 
 // UpdateProofOfWork accessors
 
-func (entity *Board) GetUpdateProofOfWork() ProofOfWork      { return entity.UpdateProofOfWork }
-func (entity *Thread) GetUpdateProofOfWork() ProofOfWork     { return entity.UpdateProofOfWork }
-func (entity *Post) GetUpdateProofOfWork() ProofOfWork       { return entity.UpdateProofOfWork }
-func (entity *Vote) GetUpdateProofOfWork() ProofOfWork       { return entity.UpdateProofOfWork }
-func (entity *Key) GetUpdateProofOfWork() ProofOfWork        { return entity.UpdateProofOfWork }
-func (entity *Truststate) GetUpdateProofOfWork() ProofOfWork { return entity.UpdateProofOfWork }
+func (entity *Board) GetUpdateProofOfWork() ProofOfWork         { return entity.UpdateProofOfWork }
+func (entity *Thread) GetUpdateProofOfWork() ProofOfWork        { return entity.UpdateProofOfWork }
+func (entity *Post) GetUpdateProofOfWork() ProofOfWork          { return entity.UpdateProofOfWork }
+func (entity *Vote) GetUpdateProofOfWork() ProofOfWork          { return entity.UpdateProofOfWork }
+func (entity *Key) GetUpdateProofOfWork() ProofOfWork           { return entity.UpdateProofOfWork }
+func (entity *Truststate) GetUpdateProofOfWork() ProofOfWork    { return entity.UpdateProofOfWork }
+func (entity *DirectMessage) GetUpdateProofOfWork() ProofOfWork { return entity.UpdateProofOfWork }
 
 // Signature accessors
 
@@ -813,26 +1008,29 @@ func (entity *Post) GetOwner() Fingerprint   { return entity.Owner }
 func (entity *Vote) GetOwner() Fingerprint   { return entity.Owner }
 
 // (For below, owner of the entity is itself.)
-func (entity *Key) GetOwner() Fingerprint        { return entity.Fingerprint }
-func (entity *Truststate) GetOwner() Fingerprint { return entity.Owner }
+func (entity *Key) GetOwner() Fingerprint           { return entity.Fingerprint }
+func (entity *Truststate) GetOwner() Fingerprint    { return entity.Owner }
+func (entity *DirectMessage) GetOwner() Fingerprint { return entity.Owner }
 
 // RealmId accessors
 
-func (entity *Board) GetRealmId() Fingerprint      { return entity.RealmId }
-func (entity *Thread) GetRealmId() Fingerprint     { return entity.RealmId }
-func (entity *Post) GetRealmId() Fingerprint       { return entity.RealmId }
-func (entity *Vote) GetRealmId() Fingerprint       { return entity.RealmId }
-func (entity *Key) GetRealmId() Fingerprint        { return entity.RealmId }
-func (entity *Truststate) GetRealmId() Fingerprint { return entity.RealmId }
+func (entity *Board) GetRealmId() Fingerprint         { return entity.RealmId }
+func (entity *Thread) GetRealmId() Fingerprint        { return entity.RealmId }
+func (entity *Post) GetRealmId() Fingerprint          { return entity.RealmId }
+func (entity *Vote) GetRealmId() Fingerprint          { return entity.RealmId }
+func (entity *Key) GetRealmId() Fingerprint           { return entity.RealmId }
+func (entity *Truststate) GetRealmId() Fingerprint    { return entity.RealmId }
+func (entity *DirectMessage) GetRealmId() Fingerprint { return entity.RealmId }
 
 // EncrContent accessors
 
-func (entity *Board) GetEncrContent() string      { return entity.EncrContent }
-func (entity *Thread) GetEncrContent() string     { return entity.EncrContent }
-func (entity *Post) GetEncrContent() string       { return entity.EncrContent }
-func (entity *Vote) GetEncrContent() string       { return entity.EncrContent }
-func (entity *Key) GetEncrContent() string        { return entity.EncrContent }
-func (entity *Truststate) GetEncrContent() string { return entity.EncrContent }
+func (entity *Board) GetEncrContent() string         { return entity.EncrContent }
+func (entity *Thread) GetEncrContent() string        { return entity.EncrContent }
+func (entity *Post) GetEncrContent() string          { return entity.EncrContent }
+func (entity *Vote) GetEncrContent() string          { return entity.EncrContent }
+func (entity *Key) GetEncrContent() string           { return entity.EncrContent }
+func (entity *Truststate) GetEncrContent() string    { return entity.EncrContent }
+func (entity *DirectMessage) GetEncrContent() string { return entity.EncrContent }
 
 // Response styles.
 
@@ -846,6 +1044,9 @@ type Response struct {
 	Addresses   []Address
 	Truststates []Truststate
 
+	DirectMessages       []DirectMessage
+	DirectMessageIndexes []DirectMessageIndex
+
 	BoardIndexes      []BoardIndex
 	ThreadIndexes     []ThreadIndex
 	PostIndexes       []PostIndex
@@ -862,6 +1063,8 @@ type Response struct {
 	TruststateManifests []PageManifest
 	AddressManifests    []PageManifest
 
+	DirectMessageManifests []PageManifest
+
 	CacheLinks                []ResultCache
 	MostRecentSourceTimestamp Timestamp
 }
@@ -891,9 +1094,66 @@ func (r *Response) Empty() bool {
 		len(r.TruststateManifests) == 0 &&
 		len(r.AddressManifests) == 0 &&
 
+		len(r.DirectMessages) == 0 &&
+		len(r.DirectMessageIndexes) == 0 &&
+		len(r.DirectMessageManifests) == 0 &&
+
 		len(r.CacheLinks) == 0
 }
 
+// FilterRealms removes the entities that are not in one of the given realms from the response. Addresses are not realm-bound, they're left intact.
+func (r *Response) FilterRealms(realms []Fingerprint) {
+	boards := []Board{}
+	for key, _ := range r.Boards {
+		if realmIn(r.Boards[key].RealmId, realms) {
+			boards = append(boards, r.Boards[key])
+		}
+	}
+	r.Boards = boards
+	threads := []Thread{}
+	for key, _ := range r.Threads {
+		if realmIn(r.Threads[key].RealmId, realms) {
+			threads = append(threads, r.Threads[key])
+		}
+	}
+	r.Threads = threads
+	posts := []Post{}
+	for key, _ := range r.Posts {
+		if realmIn(r.Posts[key].RealmId, realms) {
+			posts = append(posts, r.Posts[key])
+		}
+	}
+	r.Posts = posts
+	votes := []Vote{}
+	for key, _ := range r.Votes {
+		if realmIn(r.Votes[key].RealmId, realms) {
+			votes = append(votes, r.Votes[key])
+		}
+	}
+	r.Votes = votes
+	keys := []Key{}
+	for key, _ := range r.Keys {
+		if realmIn(r.Keys[key].RealmId, realms) {
+			keys = append(keys, r.Keys[key])
+		}
+	}
+	r.Keys = keys
+	truststates := []Truststate{}
+	for key, _ := range r.Truststates {
+		if realmIn(r.Truststates[key].RealmId, realms) {
+			truststates = append(truststates, r.Truststates[key])
+		}
+	}
+	r.Truststates = truststates
+	dms := []DirectMessage{}
+	for key, _ := range r.DirectMessages {
+		if realmIn(r.DirectMessages[key].RealmId, realms) {
+			dms = append(dms, r.DirectMessages[key])
+		}
+	}
+	r.DirectMessages = dms
+}
+
 func (r *Response) Insert(r2 *Response) {
 	r.Boards = append(r.Boards, r2.Boards...)
 	r.Threads = append(r.Threads, r2.Threads...)
@@ -919,6 +1179,10 @@ func (r *Response) Insert(r2 *Response) {
 	r.TruststateManifests = append(r.TruststateManifests, r2.TruststateManifests...)
 	r.AddressManifests = append(r.AddressManifests, r2.AddressManifests...)
 
+	r.DirectMessages = append(r.DirectMessages, r2.DirectMessages...)
+	r.DirectMessageIndexes = append(r.DirectMessageIndexes, r2.DirectMessageIndexes...)
+	r.DirectMessageManifests = append(r.DirectMessageManifests, r2.DirectMessageManifests...)
+
 	r.CacheLinks = append(r.CacheLinks, r2.CacheLinks...)
 
 	if r.MostRecentSourceTimestamp < r2.MostRecentSourceTimestamp {
@@ -966,6 +1230,12 @@ func (r *Response) IndexOf(item Provable) int {
 				return key
 			}
 		}
+	case *DirectMessage:
+		for key, _ := range r.DirectMessages {
+			if r.DirectMessages[key].Fingerprint == entity.Fingerprint {
+				return key
+			}
+		}
 	}
 	return -1
 }
@@ -999,6 +1269,10 @@ func (r *Response) RemoveByIndex(i int, entityType string) {
 		if len(r.Truststates) > i {
 			r.Truststates = append(r.Truststates[0:i], r.Truststates[i+1:len(r.Truststates)]...)
 		}
+	case "directmessage":
+		if len(r.DirectMessages) > i {
+			r.DirectMessages = append(r.DirectMessages[0:i], r.DirectMessages[i+1:len(r.DirectMessages)]...)
+		}
 	default:
 		logging.LogCrash(fmt.Sprintf("You gave Response.RemoveByIndex an unknown entity type. You gave: %s", entityType))
 	}
@@ -1092,6 +1366,18 @@ func (r *Response) MassRemoveByIndex(idxs []int, entityType string) {
 			}
 		}
 		r.Truststates = retained
+	case "directmessage":
+		if len(r.DirectMessages) == len(idxs) {
+			r.DirectMessages = []DirectMessage{}
+			return
+		}
+		retained := []DirectMessage{}
+		for key, _ := range r.DirectMessages {
+			if !isInIndexSlice(key, idxs) {
+				retained = append(retained, r.DirectMessages[key])
+			}
+		}
+		r.DirectMessages = retained
 	default:
 		logging.LogCrash(fmt.Sprintf("You gave Response.RemoveByIndex an unknown entity type. You gave: %s", entityType))
 	}
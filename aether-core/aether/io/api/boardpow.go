@@ -0,0 +1,181 @@
+// API > Board PoW
+// This file provides the proof of work strengths that boards can declare for their threads and posts in their meta, and the effective strengths that come out of those and the node configs.
+
+package api
+
+import (
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/logging"
+	"aether-core/aether/services/metaparse"
+	"sync"
+	"time"
+)
+
+/*
+How this works:
+
+The minimum PoW strengths in the node configs are the same for every board. A board that is getting flooded can raise its own bar: the board owner puts a BoardPoW into the board meta, which is covered by the board's signature like any other field. From then on, the threads and posts in that board need to have at least that strong a PoW, or they fail the verification, and they're dropped like any other invalid entity.
+
+To verify a thread or a post, we need its board. Boards that pass the verification are remembered here. If we haven't seen the board since the app started, we ask BoardLookup, which the backend points at its database, and the frontend at the backend. If the board can't be found, only the node configs apply. This is the same thing that happens when a thread arrives before its board, which is a normal part of the sync.
+
+The requirement applies to the content created after the Since timestamp of the declaration. The timestamps of a thread or a post are set by its author though, so a thread that claims to be from before the declaration can't be taken at its word, otherwise anyone could backdate their way around the requirement. Content that claims to be from before Since is held to the node configs only if we already have that version of it, which means we had it before we knew of the declaration. Everything else we receive is held to the requirement, regardless of what its timestamp says. ContentLookup is what tells us whether we have it, the backend points it at its database. The cost is that a node that joins after the declaration can only get the older content of the board if it has a strong enough PoW.
+
+If a board can't be found, that is remembered for a short while, so that a flood of threads for a board we don't have doesn't turn into a flood of lookups. A board that passes the verification replaces that right away.
+*/
+
+// BoardLookup finds a board by its fingerprint. This is set at startup by whichever side (backend or frontend) the app is running as. If nil, boards that aren't seen in a verification are unknown.
+var BoardLookup func(boardfp Fingerprint) (Board, bool)
+
+// ContentLookup finds the last update of the thread or post with the given fingerprint, if we have it. Set at startup, same as BoardLookup. If nil, we have nothing.
+var ContentLookup func(fp Fingerprint, isPost bool) (lastUpdate Timestamp, found bool)
+
+// boardPoWCacheDuration is how long a board's declaration is remembered before we look it up again. The board updates that come in through the verification replace it right away, this is for the ones that don't, such as the frontend's.
+const boardPoWCacheDuration = 10 * time.Minute
+
+// boardNotFoundCacheDuration is how long a board that can't be found is remembered as such. This is shorter than the above, since threads arriving before their boards is a normal part of the sync, and the boards follow soon.
+const boardNotFoundCacheDuration = 1 * time.Minute
+
+type boardPoWEntry struct {
+	pow        metaparse.BoardPoW
+	lastUpdate Timestamp
+	cachedAt   time.Time
+	found      bool
+}
+
+var (
+	boardPoWsLock sync.Mutex
+	boardPoWs     = make(map[Fingerprint]boardPoWEntry)
+)
+
+// ReadBoardPoW returns the proof of work declaration in the board's meta. The meta field is free form, so a meta that is not a board meta is not an error - it's just no declaration.
+func ReadBoardPoW(b *Board) metaparse.BoardPoW {
+	m, err := metaparse.ReadMeta("Board", b.Meta)
+	if err != nil || m == nil {
+		return metaparse.BoardPoW{}
+	}
+	bm := m.(*metaparse.BoardMeta)
+	if bm.MinimumPoW == nil {
+		return metaparse.BoardPoW{}
+	}
+	return *bm.MinimumPoW
+}
+
+// RegisterBoardPoW remembers the declaration of the board, unless we already have a newer version of it.
+func RegisterBoardPoW(b *Board) {
+	lu := b.LastUpdate
+	if lu < b.Creation {
+		lu = b.Creation
+	}
+	boardPoWsLock.Lock()
+	defer boardPoWsLock.Unlock()
+	if existing, ok := boardPoWs[b.Fingerprint]; ok && existing.lastUpdate > lu {
+		return
+	}
+	boardPoWs[b.Fingerprint] = boardPoWEntry{
+		pow:        ReadBoardPoW(b),
+		lastUpdate: lu,
+		cachedAt:   time.Now(),
+		found:      true,
+	}
+}
+
+// registerBoardNotFound remembers that the lookup couldn't find the board. If we had the board from before, we keep using what we had until the next lookup.
+func registerBoardNotFound(boardfp Fingerprint) {
+	boardPoWsLock.Lock()
+	defer boardPoWsLock.Unlock()
+	entry := boardPoWs[boardfp]
+	entry.cachedAt = time.Now()
+	boardPoWs[boardfp] = entry
+}
+
+// getBoardPoW returns the declaration of the board with the given fingerprint, looking the board up if we don't have it.
+func getBoardPoW(boardfp Fingerprint) metaparse.BoardPoW {
+	boardPoWsLock.Lock()
+	entry, ok := boardPoWs[boardfp]
+	boardPoWsLock.Unlock()
+	cacheDuration := boardPoWCacheDuration
+	if !entry.found {
+		cacheDuration = boardNotFoundCacheDuration
+	}
+	if ok && time.Since(entry.cachedAt) < cacheDuration {
+		return entry.pow
+	}
+	if BoardLookup == nil {
+		return entry.pow
+	}
+	b, found := BoardLookup(boardfp)
+	if !found {
+		registerBoardNotFound(boardfp)
+		return entry.pow
+	}
+	RegisterBoardPoW(&b)
+	return ReadBoardPoW(&b)
+}
+
+func configPoWStrengths() (thread, threadUpdate, post, postUpdate int) {
+	if isFrontend() {
+		s := globals.FrontendConfig.GetMinimumPoWStrengths()
+		return s.Thread, s.ThreadUpdate, s.Post, s.PostUpdate
+	}
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	return s.Thread, s.ThreadUpdate, s.Post, s.PostUpdate
+}
+
+// isStored returns whether we already have this version of the thread or post. For an update, that's an update at least as new as this one, for a creation, the entity at all.
+func isStored(fp Fingerprint, isPost bool, ts Timestamp, update bool) bool {
+	if ContentLookup == nil || len(fp) == 0 {
+		return false
+	}
+	lastUpdate, found := ContentLookup(fp, isPost)
+	if !found {
+		return false
+	}
+	return !update || lastUpdate >= ts
+}
+
+// effectiveStrength is the stronger of the config strength and the board's declared one, if the declaration applies to this content. See above for why a timestamp before Since alone isn't enough.
+func effectiveStrength(configStrength, declared int, since int64, ts Timestamp, stored func() bool) int {
+	if declared <= configStrength {
+		return configStrength
+	}
+	if int64(ts) < since && stored() {
+		return configStrength
+	}
+	return declared
+}
+
+// ThreadPoWStrength returns the minimum PoW strength that a thread in the given board needs to have. The timestamp is the creation of the thread, or for an update, the last update. The fingerprint can be empty for a thread that is being minted.
+func ThreadPoWStrength(boardfp, fp Fingerprint, ts Timestamp, update bool) int {
+	thread, threadUpdate, _, _ := configPoWStrengths()
+	bp := getBoardPoW(boardfp)
+	stored := func() bool { return isStored(fp, false, ts, update) }
+	if update {
+		return effectiveStrength(threadUpdate, bp.ThreadUpdate, bp.Since, ts, stored)
+	}
+	return effectiveStrength(thread, bp.Thread, bp.Since, ts, stored)
+}
+
+// PostPoWStrength returns the minimum PoW strength that a post in the given board needs to have. Same as above for the timestamp and the fingerprint.
+func PostPoWStrength(boardfp, fp Fingerprint, ts Timestamp, update bool) int {
+	_, _, post, postUpdate := configPoWStrengths()
+	bp := getBoardPoW(boardfp)
+	stored := func() bool { return isStored(fp, true, ts, update) }
+	if update {
+		return effectiveStrength(postUpdate, bp.PostUpdate, bp.Since, ts, stored)
+	}
+	return effectiveStrength(post, bp.Post, bp.Since, ts, stored)
+}
+
+// boardPoWBC checks the declaration in the board meta, if there is one. A board can't ask for a PoW that's stronger than what a node would ever mint.
+func boardPoWBC(b *Board) bool {
+	bp := ReadBoardPoW(b)
+	ok := intBC(int64(bp.Thread), 0, MAX_BOARD_POW_V1) &&
+		intBC(int64(bp.ThreadUpdate), 0, MAX_BOARD_POW_V1) &&
+		intBC(int64(bp.Post), 0, MAX_BOARD_POW_V1) &&
+		intBC(int64(bp.PostUpdate), 0, MAX_BOARD_POW_V1) &&
+		bp.Since >= 0
+	if !ok {
+		logging.Logf(1, "The proof of work declaration of this board is out of bounds. Board: %v, Declaration: %#v", b.Fingerprint, bp)
+	}
+	return ok
+}
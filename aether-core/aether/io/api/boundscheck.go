@@ -5,6 +5,7 @@ package api
 
 import (
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/metaparse"
 	"aether-core/aether/services/toolbox"
 	"errors"
 	"fmt"
@@ -57,6 +58,18 @@ const (
 	MIN_PUBLICKEY_V1 = 32
 	MAX_PUBLICKEY_V1 = 128
 
+	// Structured meta (see services/metaparse). These are checked on top of the overall meta length.
+	MIN_META_VERSION_V1 = 0 // 0: created before the meta schemas.
+
+	MIN_META_HASH_V1 = 0 // Icon and avatar hashes
+	MAX_META_HASH_V1 = 128
+
+	MIN_META_CONTENT_WARNINGS_V1 = 0
+	MAX_META_CONTENT_WARNINGS_V1 = 16
+
+	MIN_META_CONTENT_WARNING_V1 = 1
+	MAX_META_CONTENT_WARNING_V1 = 64
+
 	// Board
 	MIN_BOARD_NAME_V1 = 2
 	MAX_BOARD_NAME_V1 = 128
@@ -67,6 +80,23 @@ const (
 	MIN_BOARD_BOARDOWNERS_V1 = 0
 	MAX_BOARD_BOARDOWNERS_V1 = 128
 
+	MAX_BOARD_POW_V1 = 32 // The strongest PoW a board can require from its threads and posts in its meta. Beyond this, minting would not finish before the bailout.
+
+	MIN_BOARD_RULES_V1 = 0
+	MAX_BOARD_RULES_V1 = 16384
+
+	MIN_BOARD_FLAIRS_V1 = 0
+	MAX_BOARD_FLAIRS_V1 = 64
+
+	MIN_BOARD_FLAIR_ID_V1 = 1
+	MAX_BOARD_FLAIR_ID_V1 = 32
+
+	MIN_BOARD_FLAIR_NAME_V1 = 1
+	MAX_BOARD_FLAIR_NAME_V1 = 64
+
+	MIN_BOARD_FLAIR_COLOUR_V1 = 0 // #rrggbb, or empty for the default colour
+	MAX_BOARD_FLAIR_COLOUR_V1 = 7
+
 	MIN_BOARD_LANGUAGE_V1 = 0 // 3 char ISO 639-3 codes in lowercase
 	// ^ 0 Because in the absence of language data, or when unrecognised, we assume Common Tongue.
 	MAX_BOARD_LANGUAGE_V1 = 3
@@ -99,11 +129,28 @@ const (
 	MIN_KEY_INFO_V1 = 0
 	MAX_KEY_INFO_V1 = 65535
 
+	MIN_KEY_LINKS_V1 = 0
+	MAX_KEY_LINKS_V1 = 16
+
+	MIN_KEY_LINK_LABEL_V1 = 1
+	MAX_KEY_LINK_LABEL_V1 = 64
+
+	MIN_KEY_LINK_URL_V1 = 1
+	MAX_KEY_LINK_URL_V1 = MAX_THREAD_LINK_V1
+
 	// Truststate
 
 	MIN_TRUSTSTATE_TYPE_V1 = MIN_VOTE_TYPE_V1
 	MAX_TRUSTSTATE_TYPE_V1 = MAX_VOTE_TYPE_V1
 
+	// DirectMessage
+
+	MIN_DIRECTMESSAGE_RECIPIENT_V1 = 1 // Unlike owners, recipients can't be anonymous. There is no one to seal the message to.
+	MAX_DIRECTMESSAGE_RECIPIENT_V1 = 64
+
+	MIN_DIRECTMESSAGE_BODY_V1 = 1
+	MAX_DIRECTMESSAGE_BODY_V1 = 131070 // Ciphertext in base64, so roughly the size of a post body.
+
 	// Address
 
 	MIN_ADDRESS_LOCATIONTYPE_V1 = 0
@@ -130,6 +177,9 @@ const (
 	MIN_ADDRESS_PROTOCOL_SUBPROTOCOL_SUPPORTEDENTITIES_NAME_V1 = 1
 	MAX_ADDRESS_PROTOCOL_SUBPROTOCOL_SUPPORTEDENTITIES_NAME_V1 = 32
 
+	MIN_ADDRESS_PROTOCOL_REALMS_V1 = 0
+	MAX_ADDRESS_PROTOCOL_REALMS_V1 = 128
+
 	// ApiResponse
 	MIN_APIRESPONSE_ENTITY_NAME_V1_0 = MIN_ADDRESS_PROTOCOL_SUBPROTOCOL_SUPPORTEDENTITIES_NAME_V1
 	MAX_APIRESPONSE_ENTITY_NAME_V1_0 = MAX_ADDRESS_PROTOCOL_SUBPROTOCOL_SUPPORTEDENTITIES_NAME_V1
@@ -141,7 +191,7 @@ const (
 	MAX_APIRESPONSE_PAGINATION_PAGES_V1_0 = toolbox.MaxInt64
 
 	MIN_APIRESPONSE_FILTER_V1_0 = 0
-	MAX_APIRESPONSE_FILTER_V1_0 = 3
+	MAX_APIRESPONSE_FILTER_V1_0 = 4 // timestamp, realm, board, owner
 
 	MIN_APIRESPONSE_FILTER_TYPE_V1_0 = 0
 	MAX_APIRESPONSE_FILTER_TYPE_V1_0 = toolbox.MaxUint16
@@ -158,6 +208,12 @@ const (
 	MIN_APIRESPONSE_FILTER_VALUES_TIMESTAMP_V1_0 = 2
 	MAX_APIRESPONSE_FILTER_VALUES_TIMESTAMP_V1_0 = 2
 
+	MIN_APIRESPONSE_FILTER_VALUES_REALM_V1_0 = 1
+	MAX_APIRESPONSE_FILTER_VALUES_REALM_V1_0 = MAX_ADDRESS_PROTOCOL_REALMS_V1
+
+	MIN_APIRESPONSE_FILTER_VALUES_SUBSCRIPTION_V1_0 = 1
+	MAX_APIRESPONSE_FILTER_VALUES_SUBSCRIPTION_V1_0 = 1024
+
 	MIN_APIRESPONSE_CACHING_CACHEURL_V1_0 = 0
 	MAX_APIRESPONSE_CACHING_CACHEURL_V1_0 = 128 // 64 char sha256 hash + some additions like POST response timestamp, etc.
 
@@ -229,6 +285,11 @@ func fingerprintBC(item Fingerprint) bool {
 	return stringBC(string(item), 0, 64)
 }
 
+// realmBC checks that the realm id is well-formed, and that it's a realm that we carry. An entity from a realm we don't carry is out of bounds for this node.
+func realmBC(item Fingerprint) bool {
+	return fingerprintBC(item) && RealmServed(item)
+}
+
 func nonceBC(item Nonce) bool {
 	// Min 0 because nonce is only checked when there is a post request.
 	return stringBC(string(item), 0, 64)
@@ -367,7 +428,8 @@ func protocolBC(item *Protocol) bool {
 	}
 	return intBC(int64(item.VersionMajor), 1, toolbox.MaxUint8) &&
 		intBC(int64(item.VersionMinor), 0, toolbox.MaxUint16) &&
-		subprotocolSliceBC(&item.Subprotocols)
+		subprotocolSliceBC(&item.Subprotocols) &&
+		fingerprintSliceBC(&item.Realms, MIN_ADDRESS_PROTOCOL_REALMS_V1, MAX_ADDRESS_PROTOCOL_REALMS_V1)
 }
 
 func clientBC(item *Client) bool {
@@ -389,7 +451,7 @@ func filterBC(item *Filter) bool {
 	if item.Type == "" && len(item.Values) == 0 {
 		return true
 	}
-	allowed := (item.Type == "fingerprint" || item.Type == "embed" || item.Type == "timestamp")
+	allowed := (item.Type == "fingerprint" || item.Type == "embed" || item.Type == "timestamp" || item.Type == "realm" || item.Type == "board" || item.Type == "owner")
 	if !allowed {
 		return false
 	}
@@ -410,6 +472,18 @@ func filterBC(item *Filter) bool {
 		valid = timestampSliceBC(&tss,
 			MIN_APIRESPONSE_FILTER_VALUES_TIMESTAMP_V1_0,
 			MAX_APIRESPONSE_FILTER_VALUES_TIMESTAMP_V1_0)
+	} else if item.Type == "realm" {
+		realms := []Fingerprint{}
+		for _, val := range item.Values {
+			realms = append(realms, Fingerprint(val))
+		}
+		valid = fingerprintSliceBC(&realms,
+			MIN_APIRESPONSE_FILTER_VALUES_REALM_V1_0,
+			MAX_APIRESPONSE_FILTER_VALUES_REALM_V1_0)
+	} else if item.Type == "board" || item.Type == "owner" {
+		valid = stringSliceBC(item.Values,
+			MIN_APIRESPONSE_FILTER_VALUES_SUBSCRIPTION_V1_0, MAX_APIRESPONSE_FILTER_VALUES_SUBSCRIPTION_V1_0,
+			1, 64) // Fingerprints, but in string form
 	}
 	return valid
 }
@@ -520,6 +594,137 @@ func entityCountSliceBC(item *[]EntityCount, minLen, maxLen int) bool {
 	return true
 }
 
+/*----------  Structured meta  ----------*/
+/*
+  Meta that doesn't parse isn't rejected. The entities created before the meta schemas could carry anything in there, and we ignore what we can't read at compile time anyway. What parses has to be within bounds, though.
+*/
+
+func metaVersionBC(v metaparse.Versioned) bool {
+	return intBC(int64(v.Version), MIN_META_VERSION_V1, toolbox.MaxUint16)
+}
+
+func contentWarningsBC(cws []string) bool {
+	return stringSliceBC(cws,
+		MIN_META_CONTENT_WARNINGS_V1, MAX_META_CONTENT_WARNINGS_V1,
+		MIN_META_CONTENT_WARNING_V1, MAX_META_CONTENT_WARNING_V1)
+}
+
+func flairColourBC(colour string) bool {
+	if !stringBC(colour, MIN_BOARD_FLAIR_COLOUR_V1, MAX_BOARD_FLAIR_COLOUR_V1) {
+		return false
+	}
+	if len(colour) == 0 {
+		return true
+	}
+	if len(colour) != 7 || colour[0] != '#' {
+		return false
+	}
+	_, err := strconv.ParseUint(colour[1:], 16, 32)
+	return err == nil
+}
+
+func boardFlairSliceBC(flairs []metaparse.BoardFlair) bool {
+	if !intBC(int64(len(flairs)), MIN_BOARD_FLAIRS_V1, MAX_BOARD_FLAIRS_V1) {
+		return false
+	}
+	for k, _ := range flairs {
+		if !(stringBC(flairs[k].Id, MIN_BOARD_FLAIR_ID_V1, MAX_BOARD_FLAIR_ID_V1) &&
+			stringBC(flairs[k].Name, MIN_BOARD_FLAIR_NAME_V1, MAX_BOARD_FLAIR_NAME_V1) &&
+			flairColourBC(flairs[k].Colour)) {
+			return false
+		}
+	}
+	return true
+}
+
+func profileLinkSliceBC(links []metaparse.ProfileLink) bool {
+	if !intBC(int64(len(links)), MIN_KEY_LINKS_V1, MAX_KEY_LINKS_V1) {
+		return false
+	}
+	for k, _ := range links {
+		if !(stringBC(links[k].Label, MIN_KEY_LINK_LABEL_V1, MAX_KEY_LINK_LABEL_V1) &&
+			stringBC(links[k].Url, MIN_KEY_LINK_URL_V1, MAX_KEY_LINK_URL_V1)) {
+			return false
+		}
+	}
+	return true
+}
+
+func boardMetaBC(item *Board) bool {
+	m, err := metaparse.ReadMeta("Board", item.Meta)
+	if err != nil || m == nil {
+		return true
+	}
+	bm := m.(*metaparse.BoardMeta)
+	ok := metaVersionBC(bm.Versioned) &&
+		stringBC(bm.Rules, MIN_BOARD_RULES_V1, MAX_BOARD_RULES_V1) &&
+		stringBC(bm.IconHash, MIN_META_HASH_V1, MAX_META_HASH_V1) &&
+		boardFlairSliceBC(bm.Flairs)
+	if !ok {
+		logging.Logf(1, "The meta of this board is out of bounds. Board: %v", item.Fingerprint)
+	}
+	return ok
+}
+
+func threadMetaBC(item *Thread) bool {
+	m, err := metaparse.ReadMeta("Thread", item.Meta)
+	if err != nil || m == nil {
+		return true
+	}
+	tm := m.(*metaparse.ThreadMeta)
+	ok := metaVersionBC(tm.Versioned) &&
+		stringBC(tm.Flair, 0, MAX_BOARD_FLAIR_ID_V1) &&
+		contentWarningsBC(tm.ContentWarnings)
+	if !ok {
+		logging.Logf(1, "The meta of this thread is out of bounds. Thread: %v", item.Fingerprint)
+	}
+	return ok
+}
+
+func postMetaBC(item *Post) bool {
+	m, err := metaparse.ReadMeta("Post", item.Meta)
+	if err != nil || m == nil {
+		return true
+	}
+	pm := m.(*metaparse.PostMeta)
+	ok := metaVersionBC(pm.Versioned) &&
+		contentWarningsBC(pm.ContentWarnings)
+	if !ok {
+		logging.Logf(1, "The meta of this post is out of bounds. Post: %v", item.Fingerprint)
+	}
+	return ok
+}
+
+func keyMetaBC(item *Key) bool {
+	m, err := metaparse.ReadMeta("Key", item.Meta)
+	if err != nil || m == nil {
+		return true
+	}
+	km := m.(*metaparse.KeyMeta)
+	ok := metaVersionBC(km.Versioned) &&
+		stringBC(km.AvatarHash, MIN_META_HASH_V1, MAX_META_HASH_V1) &&
+		profileLinkSliceBC(km.Links)
+	if !ok {
+		logging.Logf(1, "The meta of this key is out of bounds. Key: %v", item.Fingerprint)
+	}
+	return ok
+}
+
+// policyBC checks only the parts of the bounds that are this node's policy: the board PoW declaration and the metas. The verification uses it to tell the policy failures apart from the malformed entities.
+func policyBC(e Provable) bool {
+	switch item := e.(type) {
+	case *Board:
+		return boardPoWBC(item) && boardMetaBC(item)
+	case *Thread:
+		return threadMetaBC(item)
+	case *Post:
+		return postMetaBC(item)
+	case *Key:
+		return keyMetaBC(item)
+	}
+	return true
+}
+
 // Version-dependent internal API.
 
 // Order for these: identity sets (Provable / Updateable), body fields are second, and slices are last (since they cost the most.) We want to bail as cheaply as possible.
@@ -533,7 +738,9 @@ func checkBoardBounds_V1(item *Board) bool {
 		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
 		stringBC(item.Language, MIN_BOARD_LANGUAGE_V1, MAX_BOARD_LANGUAGE_V1) &&
 		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
-		fingerprintBC(item.RealmId) &&
+		boardPoWBC(item) &&
+		boardMetaBC(item) &&
+		realmBC(item.RealmId) &&
 		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1) &&
 		boardOwnerSliceBC(&item.BoardOwners)
 }
@@ -553,7 +760,8 @@ func checkThreadBounds_V1(item *Thread) bool {
 		publicKeyBC(item.OwnerPublicKey, item.Owner) &&
 		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
 		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
-		fingerprintBC(item.RealmId) &&
+		threadMetaBC(item) &&
+		realmBC(item.RealmId) &&
 		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1)
 }
 func checkPostBounds_V1(item *Post) bool {
@@ -567,7 +775,8 @@ func checkPostBounds_V1(item *Post) bool {
 		publicKeyBC(item.OwnerPublicKey, item.Owner) &&
 		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
 		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
-		fingerprintBC(item.RealmId) &&
+		postMetaBC(item) &&
+		realmBC(item.RealmId) &&
 		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1)
 }
 func checkVoteBounds_V1(item *Vote) bool {
@@ -582,7 +791,7 @@ func checkVoteBounds_V1(item *Vote) bool {
 		intBC(int64(item.Type), MIN_VOTE_TYPE_V1, MAX_VOTE_TYPE_V1) &&
 		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
 		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
-		fingerprintBC(item.RealmId) &&
+		realmBC(item.RealmId) &&
 		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1)
 }
 func checkKeyBounds_V1(item *Key) bool {
@@ -594,7 +803,8 @@ func checkKeyBounds_V1(item *Key) bool {
 		stringBC(item.Info, MIN_KEY_INFO_V1, MAX_KEY_INFO_V1) &&
 		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
 		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
-		fingerprintBC(item.RealmId) &&
+		keyMetaBC(item) &&
+		realmBC(item.RealmId) &&
 		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1)
 }
 func checkTruststateBounds_V1(item *Truststate) bool {
@@ -609,7 +819,19 @@ func checkTruststateBounds_V1(item *Truststate) bool {
 		timestampBC(item.Expiry) &&
 		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
 		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
-		fingerprintBC(item.RealmId) &&
+		realmBC(item.RealmId) &&
+		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1)
+}
+func checkDirectMessageBounds_V1(item *DirectMessage) bool {
+	return provableBC(&item.ProvableFieldSet) &&
+		updateableBC(&item.UpdateableFieldSet) &&
+		stringBC(string(item.Recipient), MIN_DIRECTMESSAGE_RECIPIENT_V1, MAX_DIRECTMESSAGE_RECIPIENT_V1) &&
+		stringBC(item.Body, MIN_DIRECTMESSAGE_BODY_V1, MAX_DIRECTMESSAGE_BODY_V1) &&
+		fingerprintBC(item.Owner) &&
+		stringBC(item.OwnerPublicKey, MIN_PUBLICKEY_V1, MAX_PUBLICKEY_V1) && // No anonymous direct messages, the recipient needs the sender's key to open it.
+		intBC(int64(item.EntityVersion), MIN_ENTITYVERSION, MAX_ENTITYVERSION) &&
+		stringBC(item.Meta, MIN_META_V1, MAX_META_V1) &&
+		realmBC(item.RealmId) &&
 		stringBC(item.EncrContent, MIN_ENCR_CONTENT_V1, MAX_ENCR_CONTENT_V1)
 }
 func checkAddressBounds_V1(item *Address) bool {
@@ -649,6 +871,7 @@ func checkApiResponseBounds_V1_0(item *ApiResponse) bool {
 		pageManifestSliceBC(&item.ResponseBody.KeyManifests, MIN_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0, MAX_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0) &&
 		pageManifestSliceBC(&item.ResponseBody.TruststateManifests, MIN_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0, MAX_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0) &&
 		pageManifestSliceBC(&item.ResponseBody.AddressManifests, MIN_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0, MAX_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0) &&
+		pageManifestSliceBC(&item.ResponseBody.DirectMessageManifests, MIN_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0, MAX_APIRESONSE_RESPONSEBODY_MANIFEST_PAGES_V1_0) &&
 		entityCountSliceBC(&item.Caching.EntityCounts, 0, MAX_ADDRESS_PROTOCOL_SUBPROTOCOL_V1*MAX_ADDRESS_PROTOCOL_SUBPROTOCOL_SUPPORTEDENTITIES_V1) // 32 subprotocols with 128 entities each is our max.
 	if !bodyOk {
 		logging.Logf(1, "This ApiResponse failed Boundscheck: %#v", item)
@@ -727,6 +950,15 @@ func checkTruststateIndexBounds_V1(item *TruststateIndex) bool {
 			MIN_INDEX_PAGENUMBER_V1, MAX_INDEX_PAGENUMBER_V1)
 }
 
+func checkDirectMessageIndexBounds_V1(item *DirectMessageIndex) bool {
+	return fingerprintBC(item.Fingerprint) &&
+		stringBC(string(item.Recipient), MIN_DIRECTMESSAGE_RECIPIENT_V1, MAX_DIRECTMESSAGE_RECIPIENT_V1) &&
+		timestampBC(item.Creation) &&
+		timestampBC(item.LastUpdate) &&
+		intBC(int64(item.PageNumber),
+			MIN_INDEX_PAGENUMBER_V1, MAX_INDEX_PAGENUMBER_V1)
+}
+
 // High level version-independent API.
 func (item *Board) CheckBounds() (bool, error) {
 	if item.EntityVersion == 1 {
@@ -770,6 +1002,13 @@ func (item *Truststate) CheckBounds() (bool, error) {
 		return false, errors.New(fmt.Sprintf("We do not support this version of this entity for bounds checking. Entity: %#v", item))
 	}
 }
+func (item *DirectMessage) CheckBounds() (bool, error) {
+	if item.EntityVersion == 1 {
+		return checkDirectMessageBounds_V1(item), nil
+	} else {
+		return false, errors.New(fmt.Sprintf("We do not support this version of this entity for bounds checking. Entity: %#v", item))
+	}
+}
 func (item *Address) CheckBounds() (bool, error) {
 	if item.EntityVersion == 1 {
 		return checkAddressBounds_V1(item), nil
@@ -842,13 +1081,22 @@ func checkIndexes(item *Answer) (bool, error) {
 			return false, nil
 		}
 	}
+	for key, _ := range item.DirectMessageIndexes {
+		valid, err := item.DirectMessageIndexes[key].CheckBounds()
+		if err != nil {
+			return false, errors.New(fmt.Sprintf("Check Index encountered a failure. Object: %#v", item.DirectMessageIndexes[key]))
+		}
+		if !valid {
+			return false, nil
+		}
+	}
 	return true, nil
 }
 
 // CheckBounds of the ApiResponse does NOT check the entities contained within the APIResponse (including the address that it comes from at .Address field), only the ApiResponse's own structure, and index forms.
 func (item *ApiResponse) CheckBounds() (bool, error) {
-	if item.Address.Protocol.VersionMajor == 1 &&
-		item.Address.Protocol.VersionMinor == 0 {
+	// Minor versions of the protocol only add to it, so the structure of a 1.x response is that of 1.0.
+	if item.Address.Protocol.VersionMajor == 1 {
 		indexesValid, err := checkIndexes(&item.ResponseBody)
 		if err != nil {
 			return false, errors.New(fmt.Sprintf("ApiResponse bounds checker encountered an error. Error: %#v", err))
@@ -910,6 +1158,14 @@ func (item *TruststateIndex) CheckBounds() (bool, error) {
 	}
 }
 
+func (item *DirectMessageIndex) CheckBounds() (bool, error) {
+	if item.EntityVersion == 1 {
+		return checkDirectMessageIndexBounds_V1(item), nil
+	} else {
+		return false, errors.New(fmt.Sprintf("We do not support this version of this entity for bounds checking. Entity: %#v", item))
+	}
+}
+
 func (item *AddressIndex) CheckBounds() (bool, error) {
 	if item.EntityVersion == 1 {
 		addr := Address(*item)
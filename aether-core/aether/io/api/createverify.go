@@ -10,12 +10,14 @@ import (
 	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/proofofwork"
 	"aether-core/aether/services/randomhashgen"
 	"aether-core/aether/services/signaturing"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"golang.org/x/crypto/ed25519"
+	"strings"
 	// "github.com/davecgh/go-spew/spew"
 )
 
@@ -26,100 +28,118 @@ func isFrontend() bool {
 	return false
 }
 
+// mintControl returns the minting control, if the caller of a PoW creation gave one. It's optional, so that the callers that don't need to watch or cancel the minting don't have to pass nil.
+func mintControl(ctl []*proofofwork.MintControl) *proofofwork.MintControl {
+	if len(ctl) == 0 {
+		return nil
+	}
+	return ctl[0]
+}
+
 // // Create ProofOfWork
-func (b *Board) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (b *Board) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if b.GetVersion() == 1 {
-		return createBoardPoW_V1(b, keyPair, difficulty)
+		return createBoardPoW_V1(b, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", b))
 	}
 }
 
-func (t *Thread) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (t *Thread) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if t.GetVersion() == 1 {
-		return createThreadPoW_V1(t, keyPair, difficulty)
+		return createThreadPoW_V1(t, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", t))
 	}
 }
 
-func (p *Post) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (p *Post) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if p.GetVersion() == 1 {
-		return createPostPoW_V1(p, keyPair, difficulty)
+		return createPostPoW_V1(p, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", p))
 	}
 }
 
-func (v *Vote) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (v *Vote) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if v.GetVersion() == 1 {
-		return createVotePoW_V1(v, keyPair, difficulty)
+		return createVotePoW_V1(v, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", v))
 	}
 }
 
-func (k *Key) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (k *Key) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if k.GetVersion() == 1 {
-		return createKeyPoW_V1(k, keyPair, difficulty)
+		return createKeyPoW_V1(k, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", k))
 	}
 }
 
-func (ts *Truststate) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (ts *Truststate) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if ts.GetVersion() == 1 {
-		return createTruststatePoW_V1(ts, keyPair, difficulty)
+		return createTruststatePoW_V1(ts, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", ts))
 	}
 }
 
+func (dm *DirectMessage) CreatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
+	if dm.GetVersion() == 1 {
+		return createDirectMessagePoW_V1(dm, keyPair, difficulty, mintControl(ctl))
+	} else {
+		return errors.New(fmt.Sprintf("PoW creation of this version of this entity is not supported in this version of the app. Entity: %#v", dm))
+	}
+}
+
 // Create UpdateProofOfWork
 
-func (b *Board) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+// (Direct messages are not updateable, so they have no update PoW or update signature.)
+
+func (b *Board) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if b.GetVersion() == 1 {
-		return createBoardUpdatePoW_V1(b, keyPair, difficulty)
+		return createBoardUpdatePoW_V1(b, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW update creation of this version of this entity is not supported in this version of the app. Entity: %#v", b))
 	}
 }
 
-func (t *Thread) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (t *Thread) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if t.GetVersion() == 1 {
-		return createThreadUpdatePoW_V1(t, keyPair, difficulty)
+		return createThreadUpdatePoW_V1(t, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW update creation of this version of this entity is not supported in this version of the app. Entity: %#v", t))
 	}
 }
 
-func (p *Post) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (p *Post) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if p.GetVersion() == 1 {
-		return createPostUpdatePoW_V1(p, keyPair, difficulty)
+		return createPostUpdatePoW_V1(p, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW update creation of this version of this entity is not supported in this version of the app. Entity: %#v", p))
 	}
 }
 
-func (v *Vote) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (v *Vote) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if v.GetVersion() == 1 {
-		return createVoteUpdatePoW_V1(v, keyPair, difficulty)
+		return createVoteUpdatePoW_V1(v, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW update creation of this version of this entity is not supported in this version of the app. Entity: %#v", v))
 	}
 }
 
-func (k *Key) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (k *Key) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if k.GetVersion() == 1 {
-		return createKeyUpdatePoW_V1(k, keyPair, difficulty)
+		return createKeyUpdatePoW_V1(k, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW update creation of this version of this entity is not supported in this version of the app. Entity: %#v", k))
 	}
 }
 
-func (ts *Truststate) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int) error {
+func (ts *Truststate) CreateUpdatePoW(keyPair *ed25519.PrivateKey, difficulty int, ctl ...*proofofwork.MintControl) error {
 	if ts.GetVersion() == 1 {
-		return createTruststateUpdatePoW_V1(ts, keyPair, difficulty)
+		return createTruststateUpdatePoW_V1(ts, keyPair, difficulty, mintControl(ctl))
 	} else {
 		return errors.New(fmt.Sprintf("PoW update creation of this version of this entity is not supported in this version of the app. Entity: %#v", ts))
 	}
@@ -199,6 +219,18 @@ func (ts *Truststate) VerifyPoW(pubKey string) (bool, error) {
 	}
 }
 
+func (dm *DirectMessage) VerifyPoW(pubKey string) (bool, error) {
+	if !isFrontend() && !globals.BackendTransientConfig.ProofOfWorkCheckEnabled {
+		return true, nil
+	}
+	if dm.GetVersion() == 1 {
+		return verifyDirectMessagePoW_V1(dm, pubKey)
+	} else {
+		logging.Log(1, fmt.Sprintf("PoW verification of this version of this entity is not supported in this version of the app. Entity: %#v", dm))
+		return false, nil
+	}
+}
+
 // Create Fingerprint
 
 func (b *Board) CreateFingerprint() error {
@@ -255,6 +287,15 @@ func (ts *Truststate) CreateFingerprint() error {
 	}
 }
 
+func (dm *DirectMessage) CreateFingerprint() error {
+	if dm.GetVersion() == 1 {
+		createDirectMessageFp_V1(dm)
+		return nil
+	} else {
+		return errors.New(fmt.Sprintf("Fingerprint creation of this version of this entity is not supported in this version of the app. Entity: %#v", dm))
+	}
+}
+
 // Verify Fingerprint
 func (b *Board) VerifyFingerprint() bool {
 	if !isFrontend() && !globals.BackendTransientConfig.FingerprintCheckEnabled {
@@ -328,6 +369,18 @@ func (ts *Truststate) VerifyFingerprint() bool {
 	}
 }
 
+func (dm *DirectMessage) VerifyFingerprint() bool {
+	if !isFrontend() && !globals.BackendTransientConfig.FingerprintCheckEnabled {
+		return true
+	}
+	if dm.GetVersion() == 1 {
+		return verifyDirectMessageFingerprint_V1(dm)
+	} else {
+		logging.Log(1, fmt.Sprintf("Fingerprint verification of this version of this entity is not supported in this version of the app. Entity: %#v", dm))
+		return false
+	}
+}
+
 // Signature
 
 func (b *Board) CreateSignature(keyPair *ed25519.PrivateKey) error {
@@ -378,6 +431,14 @@ func (ts *Truststate) CreateSignature(keyPair *ed25519.PrivateKey) error {
 	}
 }
 
+func (dm *DirectMessage) CreateSignature(keyPair *ed25519.PrivateKey) error {
+	if dm.GetVersion() == 1 {
+		return createDirectMessageSignature_V1(dm, keyPair)
+	} else {
+		return errors.New(fmt.Sprintf("Signature creation of this version of this entity is not supported in this version of the app. Entity: %#v", dm))
+	}
+}
+
 // Create UpdateSignature
 
 func (b *Board) CreateUpdateSignature(keyPair *ed25519.PrivateKey) error {
@@ -532,6 +593,20 @@ func (ts *Truststate) VerifySignature(pubKey string) (bool, error) {
 	}
 }
 
+func (dm *DirectMessage) VerifySignature(pubKey string) (bool, error) {
+	if !isFrontend() && !globals.BackendTransientConfig.SignatureCheckEnabled {
+		// If signature check is disabled with a debug flag, then we unconditionally return true.
+		return true, nil
+	}
+	// Unlike the other entities, Allow Unsigned Entities does not apply here. An unsigned direct message would let anyone write into anyone's inbox under any name.
+	if dm.GetVersion() == 1 {
+		return verifyDirectMessageSignature_V1(dm, pubKey)
+	} else {
+		logging.Log(1, fmt.Sprintf("Signature verification of this version of this entity is not supported in this version of the app. Entity: %#v", dm))
+		return false, nil
+	}
+}
+
 // Api Response Signature Create / Verify
 
 func (ar *ApiResponse) CreateSignature(keyPair *ed25519.PrivateKey) error {
@@ -629,19 +704,18 @@ func (ar *ApiResponse) VerifyNonce() bool {
 func Verify(e interface{}) error {
 	switch entity := e.(type) {
 	case Provable:
-		encrypted := len(entity.GetEncrContent()) > 0
-		if encrypted {
-			return errors.New(fmt.Sprintf("This item appears to be encrypted. Please decrypt before requesting verification. EncrContent: %s, Entity: %#v", entity.GetEncrContent(), entity))
-		}
-		realmed := len(entity.GetRealmId()) > 0
-		if realmed {
-			return errors.New(fmt.Sprintf("This item appears to belong to a realm that is different than the mainnet. Non-mainnet realms are currently not supported, but might be in the future. RealmId: %s, Entity: %#v", entity.GetRealmId(), entity))
+		// Encrypted entities are verified as is. EncrContent is covered by the fingerprint, PoW and signature like any other field, so we don't need to be able to read it to relay it.
+		if !RealmServed(entity.GetRealmId()) {
+			return errors.New(fmt.Sprintf("This entity is in a realm that this node does not carry. RealmId: %s, Entity: %#v", entity.GetRealmId(), entity))
 		}
 		boundsOk, err := entity.CheckBounds()
 		if err != nil {
 			return err
 		}
 		if !boundsOk {
+			if !policyBC(entity) {
+				return errors.New(fmt.Sprintf("%s. Entity: %#v", policyBoundsFailure, entity))
+			}
 			return errors.New(fmt.Sprintf("Field boundaries of this entity is invalid. Entity: %#v", entity))
 		}
 		fpOk := entity.VerifyFingerprint()
@@ -678,6 +752,10 @@ func Verify(e interface{}) error {
 			return errors.New(fmt.Sprintf(
 				"This entity is in a badlist, either directly or indirectly (via its parent being in a badlist) Entity: %#v\n", entity))
 		}
+		if b, isBoard := entity.(*Board); isBoard {
+			// The threads and posts that come after this board need its PoW declaration.
+			RegisterBoardPoW(b)
+		}
 		entity.SetVerified(true)
 		return nil
 
@@ -704,6 +782,19 @@ func Verify(e interface{}) error {
 
 }
 
+/*
+Policy failures are the entities that fail a check this node holds them to by its own choice, not one that every node runs the same way. These are the proof of work strengths, from the node configs or declared by the board, and the bounds of the metas and the board PoW declarations. A remote on an older build that doesn't know of a declaration or of a newer meta field relays these in good faith. They're dropped like any other invalid entity, but they don't get a page rejected.
+*/
+const (
+	policyPoWFailure    = "This proof of work is not strong enough"
+	policyBoundsFailure = "The meta or the proof of work declaration of this entity is out of the bounds this node holds it to"
+)
+
+// IsPolicyFailure tells whether the verification error is a policy failure, see above.
+func IsPolicyFailure(err error) bool {
+	return strings.Contains(err.Error(), policyPoWFailure) || strings.Contains(err.Error(), policyBoundsFailure)
+}
+
 func (e *Board) VerifyEntitlements() bool {
 	return true
 }
@@ -730,13 +821,18 @@ func (e *Key) VerifyEntitlements() bool {
 */
 func (e *Truststate) VerifyEntitlements() bool {
 	if e.TypeClass == 2 || e.TypeClass == 3 {
-		if !ca.IsTrustedCAKeyByPK(e.OwnerPublicKey) {
+		if !ca.IsTrustedCAKeyByPKAt(e.OwnerPublicKey, int64(e.Creation)) {
 			return false
 		}
 	}
 	return true
 }
 
+// Direct messages are not updateable. An update would let the sender rewrite a message that the recipient has already read.
+func (e *DirectMessage) VerifyEntitlements() bool {
+	return e.LastUpdate == 0 && len(e.UpdateProofOfWork) == 0 && len(e.UpdateSignature) == 0
+}
+
 /*======================================
 =            Badlist checks            =
 ======================================*/
@@ -777,6 +873,10 @@ func (e *Truststate) NotInBadlist() bool {
 	return !configstore.BadlistInstance.IsBadTruststate(fp, targetfp, ownerfp)
 }
 
+func (e *DirectMessage) NotInBadlist() bool {
+	return !configstore.BadlistInstance.IsBadKey(string(e.Owner))
+}
+
 func (e *Address) NotInBadlist() bool {
 	loc, subloc, port := string(e.Location), string(e.Sublocation), uint16(e.Port)
 	return !configstore.BadlistInstance.IsBadAddress(loc, subloc, port)
@@ -31,6 +31,7 @@ that pertain to entities:
 		Vote
 		Key
 		Truststate
+		DirectMessage
 
 for versions:
 		v1
@@ -40,7 +41,7 @@ for versions:
 
 // // CreatePoW
 
-func createBoardPoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createBoardPoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *b
 	// Updateable
 	cpI.Fingerprint = ""
@@ -52,7 +53,7 @@ func createBoardPoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty int) er
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -60,7 +61,7 @@ func createBoardPoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty int) er
 	return nil
 }
 
-func createThreadPoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createThreadPoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *t
 	// Updateable
 	cpI.Fingerprint = ""
@@ -72,7 +73,7 @@ func createThreadPoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty int)
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func createThreadPoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty int)
 	return nil
 }
 
-func createPostPoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createPostPoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *p
 	// Updateable
 	cpI.Fingerprint = ""
@@ -92,7 +93,7 @@ func createPostPoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int) erro
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -100,7 +101,7 @@ func createPostPoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int) erro
 	return nil
 }
 
-func createVotePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createVotePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *v
 	// Updateable
 	cpI.Fingerprint = ""
@@ -112,7 +113,7 @@ func createVotePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int) erro
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func createVotePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int) erro
 	return nil
 }
 
-func createKeyPoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createKeyPoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *k
 	// Updateable
 	cpI.Fingerprint = ""
@@ -132,7 +133,7 @@ func createKeyPoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int) error
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func createKeyPoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int) error
 	return nil
 }
 
-func createTruststatePoW_V1(ts *Truststate, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createTruststatePoW_V1(ts *Truststate, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *ts
 	// Updateable
 	cpI.Fingerprint = ""
@@ -152,7 +153,7 @@ func createTruststatePoW_V1(ts *Truststate, keyPair *ed25519.PrivateKey, difficu
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -160,6 +161,26 @@ func createTruststatePoW_V1(ts *Truststate, keyPair *ed25519.PrivateKey, difficu
 	return nil
 }
 
+func createDirectMessagePoW_V1(dm *DirectMessage, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
+	cpI := *dm
+	// Updateable
+	cpI.Fingerprint = ""
+	cpI.LastUpdate = 0
+	cpI.UpdateProofOfWork = ""
+	cpI.UpdateSignature = ""
+	// Remove the existing proof of work if any exists so as to not accidentally take it as an input to the new proof of work about to be calculated.
+	cpI.ProofOfWork = ""
+	// Convert to JSON
+	res, _ := json.Marshal(cpI)
+	// Create PoW
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
+	if err != nil {
+		return err
+	}
+	dm.ProofOfWork = ProofOfWork(pow)
+	return nil
+}
+
 func createApiResponsePoW_V1(ar *ApiResponse, keyPair *ed25519.PrivateKey, difficulty int) error {
 	cpI := *ar
 	// Remove the existing proof of work if any exists so as to not accidentally take it as an input to the new proof of work about to be calculated.
@@ -178,14 +199,14 @@ func createApiResponsePoW_V1(ar *ApiResponse, keyPair *ed25519.PrivateKey, diffi
 
 // // Create UpdatePoW
 
-func createBoardUpdatePoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createBoardUpdatePoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *b
 	// Updateable
 	cpI.UpdateProofOfWork = ""
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -193,14 +214,14 @@ func createBoardUpdatePoW_V1(b *Board, keyPair *ed25519.PrivateKey, difficulty i
 	return nil
 }
 
-func createThreadUpdatePoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createThreadUpdatePoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *t
 	// Updateable
 	cpI.UpdateProofOfWork = ""
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -208,14 +229,14 @@ func createThreadUpdatePoW_V1(t *Thread, keyPair *ed25519.PrivateKey, difficulty
 	return nil
 }
 
-func createPostUpdatePoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createPostUpdatePoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *p
 	// Updateable
 	cpI.UpdateProofOfWork = ""
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -223,14 +244,14 @@ func createPostUpdatePoW_V1(p *Post, keyPair *ed25519.PrivateKey, difficulty int
 	return nil
 }
 
-func createVoteUpdatePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createVoteUpdatePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *v
 	// Updateable
 	cpI.UpdateProofOfWork = ""
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -238,14 +259,14 @@ func createVoteUpdatePoW_V1(v *Vote, keyPair *ed25519.PrivateKey, difficulty int
 	return nil
 }
 
-func createKeyUpdatePoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createKeyUpdatePoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *k
 	// Updateable
 	cpI.UpdateProofOfWork = ""
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -253,14 +274,14 @@ func createKeyUpdatePoW_V1(k *Key, keyPair *ed25519.PrivateKey, difficulty int)
 	return nil
 }
 
-func createTruststateUpdatePoW_V1(ts *Truststate, keyPair *ed25519.PrivateKey, difficulty int) error {
+func createTruststateUpdatePoW_V1(ts *Truststate, keyPair *ed25519.PrivateKey, difficulty int, ctl *proofofwork.MintControl) error {
 	cpI := *ts
 	// Updateable
 	cpI.UpdateProofOfWork = ""
 	// Convert to JSON
 	res, _ := json.Marshal(cpI)
 	// Create PoW
-	pow, err := proofofwork.Create(string(res), difficulty, keyPair)
+	pow, err := proofofwork.CreateControlled(string(res), difficulty, keyPair, ctl)
 	if err != nil {
 		return err
 	}
@@ -322,7 +343,7 @@ func verifyBoardPoW_V1(b *Board, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -340,11 +361,8 @@ func verifyThreadPoW_V1(t *Thread, pubKey string) (bool, error) {
 		// Updateable
 		// Save PoW to be verified
 		pow = string(cpI.UpdateProofOfWork)
-		if isFrontend() {
-			neededStrength = globals.FrontendConfig.GetMinimumPoWStrengths().ThreadUpdate
-		} else {
-			neededStrength = globals.BackendConfig.GetMinimumPoWStrengths().ThreadUpdate
-		}
+		// The board can require a stronger PoW than the node configs.
+		neededStrength = ThreadPoWStrength(cpI.Board, t.Fingerprint, cpI.LastUpdate, true)
 		// Delete PoW so that the PoW will match
 		cpI.UpdateProofOfWork = ""
 	} else {
@@ -356,11 +374,8 @@ func verifyThreadPoW_V1(t *Thread, pubKey string) (bool, error) {
 		cpI.UpdateSignature = ""
 		// Save PoW to be verified
 		pow = string(cpI.ProofOfWork)
-		if isFrontend() {
-			neededStrength = globals.FrontendConfig.GetMinimumPoWStrengths().Thread
-		} else {
-			neededStrength = globals.BackendConfig.GetMinimumPoWStrengths().Thread
-		}
+		// The board can require a stronger PoW than the node configs.
+		neededStrength = ThreadPoWStrength(cpI.Board, t.Fingerprint, cpI.Creation, false)
 		// Delete PoW so that the PoW will match
 		cpI.ProofOfWork = ""
 	}
@@ -382,7 +397,7 @@ func verifyThreadPoW_V1(t *Thread, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -400,11 +415,8 @@ func verifyPostPoW_V1(p *Post, pubKey string) (bool, error) {
 		// Updateable
 		// Save PoW to be verified
 		pow = string(cpI.UpdateProofOfWork)
-		if isFrontend() {
-			neededStrength = globals.FrontendConfig.GetMinimumPoWStrengths().PostUpdate
-		} else {
-			neededStrength = globals.BackendConfig.GetMinimumPoWStrengths().PostUpdate
-		}
+		// The board can require a stronger PoW than the node configs.
+		neededStrength = PostPoWStrength(cpI.Board, p.Fingerprint, cpI.LastUpdate, true)
 		// Delete PoW so that the PoW will match
 		cpI.UpdateProofOfWork = ""
 	} else {
@@ -416,11 +428,8 @@ func verifyPostPoW_V1(p *Post, pubKey string) (bool, error) {
 		cpI.UpdateSignature = ""
 		// Save PoW to be verified
 		pow = string(cpI.ProofOfWork)
-		if isFrontend() {
-			neededStrength = globals.FrontendConfig.GetMinimumPoWStrengths().Post
-		} else {
-			neededStrength = globals.BackendConfig.GetMinimumPoWStrengths().Post
-		}
+		// The board can require a stronger PoW than the node configs.
+		neededStrength = PostPoWStrength(cpI.Board, p.Fingerprint, cpI.Creation, false)
 		// Delete PoW so that the PoW will match
 		cpI.ProofOfWork = ""
 	}
@@ -442,7 +451,7 @@ func verifyPostPoW_V1(p *Post, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -502,7 +511,7 @@ func verifyVotePoW_V1(v *Vote, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -562,7 +571,51 @@ func verifyKeyPoW_V1(k *Key, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
+		}
+	} else {
+		return false, errors.New(fmt.Sprint(
+			"This proof of work is invalid, but no reason given as to why. PoW: ", pow))
+	}
+}
+
+// Direct messages have no update PoW, they're not updateable.
+func verifyDirectMessagePoW_V1(dm *DirectMessage, pubKey string) (bool, error) {
+	cpI := *dm
+	var neededStrength int
+	// Updateable
+	cpI.Fingerprint = ""
+	cpI.LastUpdate = 0
+	cpI.UpdateProofOfWork = ""
+	cpI.UpdateSignature = ""
+	// Save PoW to be verified
+	pow := string(cpI.ProofOfWork)
+	if isFrontend() {
+		neededStrength = globals.FrontendConfig.GetMinimumPoWStrengths().DirectMessage
+	} else {
+		neededStrength = globals.BackendConfig.GetMinimumPoWStrengths().DirectMessage
+	}
+	// Delete PoW so that the PoW will match
+	cpI.ProofOfWork = ""
+	// If needed strength <= 0, no PoW check and we're good.
+	if neededStrength <= 0 {
+		return true, nil
+	}
+	// Convert to JSON
+	res, _ := json.Marshal(cpI)
+	// Verify PoW
+	verifyResult, strength, err := proofofwork.Verify(string(res), pow, pubKey)
+	if err != nil {
+		return false, err
+	}
+	// If the PoW is valid
+	if verifyResult {
+		// Check if satisfies required minimum
+		if strength >= neededStrength {
+			return true, nil
+		} else {
+			return false, errors.New(fmt.Sprint(
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -588,7 +641,7 @@ func verifyTruststatePoW_V1(ts *Truststate, pubKey string) (bool, error) {
 		}
 		// The process below allows for trusted CAs to be able to issue entities with lower PoW. Since this acceptance pass is done at the backend, the frontend verification does not need to care about this.
 		if !isFrontend() && (ts.TypeClass == 2 || ts.TypeClass == 3) &&
-			ca.IsTrustedCAKeyByPK(pubKey) {
+			ca.IsTrustedCAKeyByPKAt(pubKey, int64(ts.Creation)) {
 			// This truststate is using a CA-specific TypeClass.
 			// If it is a CA that we trust, we drop the truststate PoW requirement to minimum.
 			neededStrength = globals.BackendTransientConfig.MinimumTrustedPoWStrength
@@ -611,7 +664,7 @@ func verifyTruststatePoW_V1(ts *Truststate, pubKey string) (bool, error) {
 			neededStrength = globals.BackendConfig.GetMinimumPoWStrengths().Truststate
 		}
 		if !isFrontend() && (ts.TypeClass == 2 || ts.TypeClass == 3) &&
-			ca.IsTrustedCAKeyByPK(pubKey) {
+			ca.IsTrustedCAKeyByPKAt(pubKey, int64(ts.Creation)) {
 			neededStrength = globals.BackendTransientConfig.MinimumTrustedPoWStrength
 		}
 		// Delete PoW so that the PoW will match
@@ -635,7 +688,7 @@ func verifyTruststatePoW_V1(ts *Truststate, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -671,7 +724,7 @@ func verifyApiResponsePoW_V1(ar *ApiResponse, pubKey string) (bool, error) {
 			return true, nil
 		} else {
 			return false, errors.New(fmt.Sprint(
-				"This proof of work is not strong enough. PoW: ", pow))
+				policyPoWFailure, ". PoW: ", pow))
 		}
 	} else {
 		return false, errors.New(fmt.Sprint(
@@ -711,6 +764,7 @@ func createThreadFp_V1(t *Thread) {
 	// Remove ALL mutable fields
 	cpI.Body = ""
 	cpI.Meta = ""
+	cpI.EncrContent = ""
 	// Remove the existing fingerprint if any exists so as to not accidentally take it as an input to the new fingerprint about to be calculated.
 	cpI.Fingerprint = ""
 	// Convert to JSON
@@ -729,6 +783,7 @@ func createPostFp_V1(p *Post) {
 	// Remove ALL mutable fields
 	cpI.Body = ""
 	cpI.Meta = ""
+	cpI.EncrContent = ""
 	// Remove the existing fingerprint if any exists so as to not accidentally take it as an input to the new fingerprint about to be calculated.
 	cpI.Fingerprint = ""
 	// Convert to JSON
@@ -794,6 +849,22 @@ func createTruststateFp_V1(ts *Truststate) {
 	ts.Fingerprint = Fingerprint(fp)
 }
 
+func createDirectMessageFp_V1(dm *DirectMessage) {
+	cpI := *dm
+	// Updateable set
+	cpI.LastUpdate = 0
+	cpI.UpdateProofOfWork = ""
+	cpI.UpdateSignature = ""
+	// No mutable fields, everything else is part of the fingerprint.
+	// Remove the existing fingerprint if any exists so as to not accidentally take it as an input to the new fingerprint about to be calculated.
+	cpI.Fingerprint = ""
+	// Convert to JSON
+	res, _ := json.Marshal(cpI)
+	// Create Fingerprint
+	fp := fingerprinting.Create(string(res))
+	dm.Fingerprint = Fingerprint(fp)
+}
+
 // // Verify Fp
 
 func verifyBoardFingerprint_V1(b *Board) bool {
@@ -834,6 +905,7 @@ func verifyThreadFingerprint_V1(t *Thread) bool {
 	// Remove ALL mutable fields
 	cpI.Body = ""
 	cpI.Meta = ""
+	cpI.EncrContent = ""
 	// Remove the existing fingerprint so that it won't be included as part of the input to be verified.
 	cpI.Fingerprint = ""
 	// Convert to JSON
@@ -857,6 +929,7 @@ func verifyPostFingerprint_V1(p *Post) bool {
 	// Remove ALL mutable fields
 	cpI.Body = ""
 	cpI.Meta = ""
+	cpI.EncrContent = ""
 	// Remove the existing fingerprint so that it won't be included as part of the input to be verified.
 	cpI.Fingerprint = ""
 	// Convert to JSON
@@ -939,6 +1012,26 @@ func verifyTruststateFingerprint_V1(ts *Truststate) bool {
 
 // Signaturing
 
+func verifyDirectMessageFingerprint_V1(dm *DirectMessage) bool {
+	if !isFrontend() && !globals.BackendTransientConfig.FingerprintCheckEnabled {
+		return true
+	}
+	cpI := *dm
+	var fp string
+	fp = string(cpI.Fingerprint)
+	// Updateable set
+	cpI.LastUpdate = 0
+	cpI.UpdateProofOfWork = ""
+	cpI.UpdateSignature = ""
+	// Remove the existing fingerprint so that it won't be included as part of the input to be verified.
+	cpI.Fingerprint = ""
+	// Convert to JSON
+	res, _ := json.Marshal(cpI)
+	// Verify Fingerprint
+	verifyResult := fingerprinting.Verify(string(res), fp)
+	return verifyResult
+}
+
 // // Create Signature
 
 func createBoardSignature_V1(b *Board, keyPair *ed25519.PrivateKey) error {
@@ -1067,6 +1160,27 @@ func createTruststateSignature_V1(ts *Truststate, keyPair *ed25519.PrivateKey) e
 	return nil
 }
 
+func createDirectMessageSignature_V1(dm *DirectMessage, keyPair *ed25519.PrivateKey) error {
+	cpI := *dm
+	// Updateable
+	cpI.Fingerprint = ""
+	cpI.LastUpdate = 0
+	cpI.UpdateProofOfWork = ""
+	cpI.UpdateSignature = ""
+	cpI.ProofOfWork = ""
+	// Remove existing signature if any so it won't end up in the mix accidentally.
+	cpI.Signature = ""
+	// Convert to JSON
+	res, _ := json.Marshal(cpI)
+	// Create signature
+	signature, err := signaturing.Sign(string(res), keyPair)
+	if err != nil {
+		return err
+	}
+	dm.Signature = Signature(signature)
+	return nil
+}
+
 // // Create UpdateSignature
 
 func createBoardUpdateSignature_V1(b *Board, keyPair *ed25519.PrivateKey) error {
@@ -1394,3 +1508,29 @@ func verifyTruststateSignature_V1(ts *Truststate, pubKey string) (bool, error) {
 			"This signature is invalid, but no reason given as to why. Signature: ", signature))
 	}
 }
+
+// Direct messages have no update signature, they're not updateable.
+func verifyDirectMessageSignature_V1(dm *DirectMessage, pubKey string) (bool, error) {
+	cpI := *dm
+	// Updateable
+	cpI.Fingerprint = ""
+	cpI.LastUpdate = 0
+	cpI.UpdateProofOfWork = ""
+	cpI.UpdateSignature = ""
+	// Save signature to be verified
+	signature := string(cpI.Signature)
+	// This happens *after* Signature, so should be empty here.
+	cpI.ProofOfWork = ""
+	cpI.Signature = ""
+	// Convert to JSON
+	res, _ := json.Marshal(cpI)
+	// Verify Signature
+	verifyResult := signaturing.Verify(string(res), signature, pubKey)
+	// If the Signature is valid
+	if verifyResult {
+		return true, nil
+	} else {
+		return false, errors.New(fmt.Sprint(
+			"This signature is invalid, but no reason given as to why. Signature: ", signature))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	"bytes"
 	"encoding/json"
 	"errors"
@@ -21,6 +22,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	// "context"
 	// "github.com/davecgh/go-spew/spew"
@@ -47,12 +49,14 @@ func ExistsInDB(entityType string, fp Fingerprint, lu Timestamp) bool {
 		tableName = "PublicKeys"
 	} else if entityType == "truststate" {
 		tableName = "Truststates"
+	} else if entityType == "directmessage" {
+		tableName = "DirectMessages"
 	} else {
 		logging.Log(1, fmt.Sprintf("ExistsInDB does not support the entity type you provided. You provided: %s", entityType))
 		return false
 	}
 	qStr := fmt.Sprintf("SELECT count(1) FROM %s WHERE (Fingerprint = ? AND LastUpdate = ?)", tableName)
-	rows, err := globals.DbInstance.Queryx(qStr, fp, lu)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(qStr), fp, lu)
 	defer rows.Close() // In case of premature exit.
 	if err != nil {
 		logging.Log(1, fmt.Sprintf("ExistsInDB errored out. Error: %s\n", err))
@@ -76,6 +80,7 @@ func InsertApiResponseToResponse(response Response, apiresp ApiResponse) Respons
 	response.Votes = apiresp.ResponseBody.Votes
 	response.Keys = apiresp.ResponseBody.Keys
 	response.Truststates = apiresp.ResponseBody.Truststates
+	response.DirectMessages = apiresp.ResponseBody.DirectMessages
 	response.Addresses = apiresp.ResponseBody.Addresses
 
 	response.BoardIndexes = apiresp.ResponseBody.BoardIndexes
@@ -84,6 +89,7 @@ func InsertApiResponseToResponse(response Response, apiresp ApiResponse) Respons
 	response.VoteIndexes = apiresp.ResponseBody.VoteIndexes
 	response.KeyIndexes = apiresp.ResponseBody.KeyIndexes
 	response.TruststateIndexes = apiresp.ResponseBody.TruststateIndexes
+	response.DirectMessageIndexes = apiresp.ResponseBody.DirectMessageIndexes
 	response.AddressIndexes = apiresp.ResponseBody.AddressIndexes
 
 	response.BoardManifests = apiresp.ResponseBody.BoardManifests
@@ -92,6 +98,7 @@ func InsertApiResponseToResponse(response Response, apiresp ApiResponse) Respons
 	response.VoteManifests = apiresp.ResponseBody.VoteManifests
 	response.KeyManifests = apiresp.ResponseBody.KeyManifests
 	response.TruststateManifests = apiresp.ResponseBody.TruststateManifests
+	response.DirectMessageManifests = apiresp.ResponseBody.DirectMessageManifests
 	response.AddressManifests = apiresp.ResponseBody.AddressManifests
 
 	response.CacheLinks = apiresp.Results
@@ -126,6 +133,8 @@ func concatResponses(response Response, response2 Response) Response {
 		response.Keys, response2.Keys...)
 	resp.Truststates = append(
 		response.Truststates, response2.Truststates...)
+	resp.DirectMessages = append(
+		response.DirectMessages, response2.DirectMessages...)
 	resp.Addresses = append(
 		response.Addresses, response2.Addresses...)
 
@@ -141,6 +150,8 @@ func concatResponses(response Response, response2 Response) Response {
 		response.KeyIndexes, response2.KeyIndexes...)
 	resp.TruststateIndexes = append(
 		response.TruststateIndexes, response2.TruststateIndexes...)
+	resp.DirectMessageIndexes = append(
+		response.DirectMessageIndexes, response2.DirectMessageIndexes...)
 	resp.AddressIndexes = append(
 		response.AddressIndexes, response2.AddressIndexes...)
 
@@ -156,6 +167,8 @@ func concatResponses(response Response, response2 Response) Response {
 		response.KeyManifests, response2.KeyManifests...)
 	resp.TruststateManifests = append(
 		response.TruststateManifests, response2.TruststateManifests...)
+	resp.DirectMessageManifests = append(
+		response.DirectMessageManifests, response2.DirectMessageManifests...)
 	resp.AddressManifests = append(
 		response.AddressManifests, response2.AddressManifests...)
 
@@ -210,7 +223,77 @@ func generateDialFunc(conn *net.Conn) func(network, address string) (net.Conn, e
 	return d.Dial
 }
 
-// Basic, reusable instances of transport and client.
+// tcpTransport is the transport of the IPv4, IPv6 and URL locations, which is what Fetch and the Mim server used before there were other transports. It lives here and not in the transport package because the dialer it uses, with the proxy and the timeouts, is set up here.
+type tcpTransport struct{}
+
+// Claims is never asked for TCP: it carries every location that no other transport claims.
+func (tt *tcpTransport) Claims(location string) bool {
+	return true
+}
+
+func (tt *tcpTransport) Dial(location string, port uint16) (net.Conn, error) {
+	fetchInit.Do(postInit)
+	return generateDialFunc(nil)("tcp", net.JoinHostPort(location, strconv.Itoa(int(port))))
+}
+
+// Listen listens on all interfaces. The location is where remotes reach us, and that can be the address of a NAT in front of us.
+func (tt *tcpTransport) Listen(location string, port uint16) (net.Listener, error) {
+	return net.Listen("tcp4", fmt.Sprint(":", port))
+}
+
+func init() {
+	tcp := &tcpTransport{}
+	transport.Register(transport.LocationTypeURL, tcp)
+	transport.Register(transport.LocationTypeIPv4, tcp)
+	transport.Register(transport.LocationTypeIPv6, tcp)
+}
+
+// transportClient returns a client that dials the remote through a transport other than TCP. The host in the URL is only a placeholder for these, since the dial goes to the location and port of the remote regardless. So these clients don't keep connections alive, those would be reused for the next request with the same placeholder, which can be for another remote.
+func transportClient(tr transport.Transport, location string, port uint16) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Dial: func(network, address string) (net.Conn, error) {
+				return tr.Dial(location, port)
+			},
+			TLSHandshakeTimeout: t.TLSHandshakeTimeout,
+			TLSClientConfig:     t.TLSClientConfig,
+			DisableKeepAlives:   true,
+		},
+		Timeout: c.Timeout,
+	}
+}
+
+// reverseClients are the clients of the reverse connections that are in use, one per connection. A reverse connection is a single connection, so all requests over it have to go through the one transport that keeps it, and that transport can't be the shared one (t): the syncs run in parallel, and the shared one dials out for all of them.
+var reverseClients = make(map[*net.Conn]*http.Client)
+var reverseClientsLock sync.Mutex
+
+// reverseClient returns the client of the given reverse connection, and creates it on the first request over it.
+func reverseClient(conn *net.Conn) *http.Client {
+	reverseClientsLock.Lock()
+	defer reverseClientsLock.Unlock()
+	if client, ok := reverseClients[conn]; ok {
+		return client
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Dial:                generateDialFunc(conn),
+			TLSHandshakeTimeout: t.TLSHandshakeTimeout,
+			TLSClientConfig:     t.TLSClientConfig,
+		},
+		Timeout: c.Timeout,
+	}
+	reverseClients[conn] = client
+	return client
+}
+
+// releaseReverseClient forgets the client of the given reverse connection. Call it when the connection is closed.
+func releaseReverseClient(conn *net.Conn) {
+	reverseClientsLock.Lock()
+	defer reverseClientsLock.Unlock()
+	delete(reverseClients, conn)
+}
+
+// Basic, reusable instances of transport and client. These are set once in postInit and not changed after, since the fetches run in parallel.
 
 var d *net.Dialer
 var t *http.Transport
@@ -219,6 +302,10 @@ var c *http.Client
 // postInit initiates on the first run of Fetch. These cannot be set in init because init runs before the backendconfig is ready.
 func postInit() {
 	t = &http.Transport{
+		// Whether we go through the proxy is checked at every dial, so that the proxy settings apply without a restart.
+		Dial: func(network, address string) (net.Conn, error) {
+			return generateDialFunc(nil)(network, address)
+		},
 		TLSHandshakeTimeout: globals.BackendConfig.GetTLSHandshakeTimeout(),
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: true, // Is that not insecure? Not in this specific case. We're not using TLS as a means of identifying the remote, just for encrypting the pipe. See note at tlscerts library.
@@ -232,24 +319,30 @@ func postInit() {
 		Timeout:   globals.BackendConfig.GetTCPConnectTimeout(),
 		KeepAlive: 30 * time.Second,
 	}
-	fetchRanBefore = true
 	protv = globals.BackendConfig.GetProtURLVersion()
 }
 
-var fetchRanBefore bool
+var fetchInit sync.Once
 var protv string
 
 // Fetch is the most basic access method. It returns bytes. This should almost never be called directly outside this package.
 func Fetch(host string, subhost string, port uint16, location string, method string, postBody []byte, reverseConn *net.Conn) ([]byte, error) {
-	if !fetchRanBefore {
-		postInit()
-	}
+	fetchInit.Do(postInit)
 	if reverseConn != nil {
 		// If this is a reverse connection, it's operating outside a dialer, a transport, or a client. This means we have to manually handle the timeouts.
 		// This timeout is overly generous, because it's not about how much time a remote needs, it's more about if something goes wrong, it will automatically be able to clear the connection and not have it stuck in limbo forever.
 		(*reverseConn).SetDeadline(time.Now().Add(10 * time.Minute))
 	}
-	t.Dial = generateDialFunc(reverseConn)
+	client := c
+	lt, tr, err := transport.For(host)
+	if err != nil {
+		return []byte{}, err
+	}
+	if reverseConn != nil {
+		client = reverseClient(reverseConn)
+	} else if !transport.IsTCP(lt) {
+		client = transportClient(tr, host, port)
+	}
 	var prot string
 	if globals.BackendTransientConfig.TLSEnabled {
 		prot = "https://"
@@ -261,6 +354,10 @@ func Fetch(host string, subhost string, port uint16, location string, method str
 	if toolbox.IsIPv6String(hostAsString) {
 		hostAsString = fmt.Sprintf("[%s]", hostAsString)
 	}
+	if !transport.IsTCP(lt) && reverseConn == nil {
+		// The location of the other transports can't be in a URL. The client of the transport dials the right place regardless of the host, see transportClient.
+		hostAsString = "localhost"
+	}
 	var fullLink string
 	if len(subhost) > 0 {
 		fullLink = fmt.Sprint(
@@ -276,12 +373,11 @@ func Fetch(host string, subhost string, port uint16, location string, method str
 	// if strings.Contains(fullLink, "127.0.0.1") {
 	// 	logging.Log(1, fmt.Sprintf("Fetch is being called for the URL: %s", fullLink))
 	// }
-	var err error
 	var resp *http.Response
 	if method == "GET" {
-		resp, err = c.Get(fullLink)
+		resp, err = client.Get(fullLink)
 	} else if method == "POST" {
-		resp, err = c.Post(fullLink, "application/json", bytes.NewReader(postBody))
+		resp, err = client.Post(fullLink, "application/json", bytes.NewReader(postBody))
 	} else {
 		defer resp.Body.Close()
 		return []byte{}, errors.New("Unsupported HTTP method. Available methods are: GET, POST")
@@ -481,12 +577,33 @@ func GetPageRaw(host string, subhost string, port uint16, location string, metho
 	// if method == "POST" {
 	// 	apiresp.Dump() // let's see
 	// }
+	invalidEntities, err3 := verifyPage(&apiresp)
+	if (invalidEntities > 0 || err3 != nil) && ReportInvalidPage != nil {
+		ReportInvalidPage(host, subhost, port, invalidEntities, err3 != nil)
+	}
+	if err3 != nil {
+		return ApiResponse{}, err3
+	}
+	return apiresp, nil
+}
+
+// ReportInvalidPage is told about the pages from a remote that had entities failing the verification, or that were rejected as a whole. The backend sets this at startup to keep the reputation of the remotes. If nil, nothing is reported.
+var ReportInvalidPage func(host string, subhost string, port uint16, invalidEntities int, rejected bool)
+
+// VerifyPage runs the checks that every page that arrives from a remote goes through: the page signature, and the verification of the entities in it. This is used both for the pages fetched over the network and the pages that arrive in an offline bundle, so that both are checked the same way.
+func VerifyPage(apiresp *ApiResponse) error {
+	_, err := verifyPage(apiresp)
+	return err
+}
+
+// verifyPage is VerifyPage, but it also returns how many of the entities in the page failed the verification.
+func verifyPage(apiresp *ApiResponse) (int, error) {
 	pageVerified, err := apiresp.VerifySignature() // If signature check is disabled, this will always return true.
 	if err != nil {
-		return ApiResponse{}, errors.New(fmt.Sprintf("Page signature verification failed with an error. Error: %s", err))
+		return 0, errors.New(fmt.Sprintf("Page signature verification failed with an error. Error: %s", err))
 	}
 	if !pageVerified {
-		return ApiResponse{}, errors.New("Page signature verification failed. The signature does not match.")
+		return 0, errors.New("Page signature verification failed. The signature does not match.")
 	}
 	if len(apiresp.NodePublicKey) > 0 {
 		apiresp.NodeId = Fingerprint(fingerprinting.Create(apiresp.NodePublicKey))
@@ -498,17 +615,24 @@ func GetPageRaw(host string, subhost string, port uint16, location string, metho
 	}
 	errs := apiresp.Verify()
 	if len(errs) == 1 && strings.Contains(errs[0].Error(), "This ApiResponse failed the boundary check") {
-		return ApiResponse{}, errs[0]
+		return 0, errs[0]
+	}
+	// The policy failures count towards the invalid entities, but not towards rejecting the page. See IsPolicyFailure.
+	malformed := 0
+	for _, err := range errs {
+		if !IsPolicyFailure(err) {
+			malformed++
+		}
 	}
-	if len(errs) >= 3 {
+	if malformed >= 3 {
 		errStrs := []string{}
 		for _, err := range errs {
 			errStrs = append(errStrs, err.Error())
 		}
 		logging.Log(1, fmt.Sprintf("This page has 3 or more entities who has failed verification. Errors: %#v", errStrs))
-		return ApiResponse{}, errors.New(fmt.Sprintf("This page has 3 or more entities who has failed verification"))
+		return len(errs), errors.New(fmt.Sprintf("This page has 3 or more entities who has failed verification"))
 	}
-	return apiresp, nil
+	return len(errs), nil
 }
 
 // GetPage gets a page from a cache. This returns the data on the provided page.
@@ -532,7 +656,7 @@ func GetPage(host string, subhost string, port uint16, location string, method s
 }
 
 func countManifests(resp Response) {
-	b, t, p, v, k, ts, a := 0, 0, 0, 0, 0, 0, 0
+	b, t, p, v, k, ts, dm, a := 0, 0, 0, 0, 0, 0, 0, 0
 	for _, val := range resp.BoardManifests {
 		b = b + len(val.Entities)
 	}
@@ -551,10 +675,13 @@ func countManifests(resp Response) {
 	for _, val := range resp.TruststateManifests {
 		ts = ts + len(val.Entities)
 	}
+	for _, val := range resp.DirectMessageManifests {
+		dm = dm + len(val.Entities)
+	}
 	for _, val := range resp.AddressManifests {
 		a = a + len(val.Entities)
 	}
-	logging.Logf(2, "generateHitlist manifestResponse result returned these: \nB: %v, T: %v, P: %v, V: %v, K: %v, TS: %v, A: %v", b, t, p, v, k, ts, a)
+	logging.Logf(2, "generateHitlist manifestResponse result returned these: \nB: %v, T: %v, P: %v, V: %v, K: %v, TS: %v, DM: %v, A: %v", b, t, p, v, k, ts, dm, a)
 }
 
 func generateHitlist(host string, subhost string, port uint16, location string, reverseConn *net.Conn) (map[int]bool, error) {
@@ -628,6 +755,16 @@ TruststateLoop:
 			}
 		}
 	}
+DirectMessageLoop:
+	for key, _ := range manifestResponse.DirectMessageManifests {
+		for _, val := range manifestResponse.DirectMessageManifests[key].Entities {
+			if !ExistsInDB("directmessage", val.Fingerprint, val.LastUpdate) {
+				// Grab the whole page and insert into to-be-fetched queue, DB will remove useless stuff.
+				allPgs[int(manifestResponse.DirectMessageManifests[key].Page)] = true
+				continue DirectMessageLoop
+			}
+		}
+	}
 	elapsed := time.Since(start)
 	logging.Logf(2, "GenerateHitlist V1 time spent: %#v\n", elapsed.String())
 	return allPgs, nil
@@ -700,13 +837,14 @@ func GetCache(host string, subhost string, port uint16, location string, isAddr
 // mapEndpointToEndpointAddress generates the address that needs to be called for the endpoint that is being requested.
 func mapEndpointToEndpointAddress(endpoint string) string {
 	endpointsMap := map[string]string{
-		"boards":      "c0/boards",
-		"threads":     "c0/threads",
-		"posts":       "c0/posts",
-		"votes":       "c0/votes",
-		"addresses":   "addresses", // Addresses is a mim entity, not a c0 entity.
-		"keys":        "c0/keys",
-		"truststates": "c0/truststates"}
+		"boards":         "c0/boards",
+		"threads":        "c0/threads",
+		"posts":          "c0/posts",
+		"votes":          "c0/votes",
+		"addresses":      "addresses", // Addresses is a mim entity, not a c0 entity.
+		"keys":           "c0/keys",
+		"truststates":    "c0/truststates",
+		"directmessages": "dm0/directmessages"} // Direct messages are in their own subprotocol.
 	epAddress := endpointsMap[endpoint]
 	// If we don't know which endpoint this is, attempt to call it directly.
 	if epAddress == "" {
@@ -748,9 +886,18 @@ func GetManifestGatedCache(host string, subhost string, port uint16, location st
 
 // GetGETEndpoint returns an entire endpoint from the remote node.
 func GetGETEndpoint(host string, subhost string, port uint16, endpoint string, lastCheckin Timestamp, reverseConn *net.Conn) (Response, error) {
+	return GetRealmGETEndpoint(host, subhost, port, endpoint, PublicRealm, lastCheckin, reverseConn)
+}
+
+// GetRealmGETEndpoint returns an entire endpoint of the given realm from the remote node. The caches of the public realm are at the root, the caches of every other realm live in their own tree. (See RealmPath.)
+func GetRealmGETEndpoint(host string, subhost string, port uint16, endpoint string, realm Fingerprint, lastCheckin Timestamp, reverseConn *net.Conn) (Response, error) {
 	// This is where the mapping for an endpoint to its respective subprotocol folder is mapped. Below this level, you have to supply your own subprotocol string.
-	logging.Log(2, fmt.Sprintf("GetGETEndpoint was called for the endpoint: %s", endpoint))
+	logging.Log(2, fmt.Sprintf("GetGETEndpoint was called for the endpoint: %s, realm: %s", endpoint, realm))
 	epAddress := mapEndpointToEndpointAddress(endpoint)
+	if realm != PublicRealm && endpoint != "addresses" {
+		// Addresses are not realm-bound, they always come from the root.
+		epAddress = fmt.Sprint(RealmPath(realm), "/", epAddress)
+	}
 	var response Response
 	// Get raw page, because we need to access index links.
 	result, err := getIndexOfEndpoint(host, subhost, port, epAddress, reverseConn)
@@ -821,8 +968,9 @@ func GetGETEndpoint(host string, subhost string, port uint16, endpoint string, l
 	addressCount := len(response.Addresses)
 	keysCount := len(response.Keys)
 	truststatesCount := len(response.Truststates)
+	directMessagesCount := len(response.DirectMessages)
 	// logging.Log(1, fmt.Sprintf("Response for the endpoint %s was %#v\n", endpoint, response))
-	logging.Log(2, fmt.Sprintf("GetGETEndpoint returned for the endpoint: %s. Number of items: Boards: %d, Threads: %d, Posts: %d, Votes: %d, Addresses: %d, Keys: %d, Truststates: %d", endpoint, boardCount, threadCount, postCount, voteCount, addressCount, keysCount, truststatesCount))
+	logging.Log(2, fmt.Sprintf("GetGETEndpoint returned for the endpoint: %s. Number of items: Boards: %d, Threads: %d, Posts: %d, Votes: %d, Addresses: %d, Keys: %d, Truststates: %d, Direct messages: %d", endpoint, boardCount, threadCount, postCount, voteCount, addressCount, keysCount, truststatesCount, directMessagesCount))
 
 	return response, nil
 }
@@ -858,16 +1006,18 @@ func GetGETEndpoint(host string, subhost string, port uint16, endpoint string, l
 
 */
 
-func GetPOSTEndpoint(host string, subhost string, port uint16, endpoint string, lastCheckin Timestamp, reverseConn *net.Conn) (Response, time.Duration, error) {
+// GetPOSTEndpoint makes a POST request to the endpoint of the remote. If realms are given, the request asks for the entities in those realms in addition to the public realm. Only give realms that the remote declares it carries, older remotes don't understand the realm filter. If partial subscriptions are given, the request asks only for the threads, posts and votes they cover. Only give those to the remotes that understand the subscription filters (SupportsSubscriptionFilters).
+func GetPOSTEndpoint(host string, subhost string, port uint16, endpoint string, lastCheckin Timestamp, realms []Fingerprint, subs Subscriptions, reverseConn *net.Conn) (Response, time.Duration, error) {
 	// But before anything, we need to create the mapping for the endpoint URLs.
 	endpointsMap := map[string]string{
-		"boards":      "c0/boards",
-		"threads":     "c0/threads",
-		"posts":       "c0/posts",
-		"votes":       "c0/votes",
-		"keys":        "c0/keys",
-		"truststates": "c0/truststates",
-		"addresses":   "addresses",
+		"boards":         "c0/boards",
+		"threads":        "c0/threads",
+		"posts":          "c0/posts",
+		"votes":          "c0/votes",
+		"keys":           "c0/keys",
+		"truststates":    "c0/truststates",
+		"directmessages": "dm0/directmessages",
+		"addresses":      "addresses",
 	}
 	apiReq := ApiResponse{}
 	apiReq.Prefill()
@@ -876,6 +1026,17 @@ func GetPOSTEndpoint(host string, subhost string, port uint16, endpoint string,
 	f.Type = "timestamp"
 	f.Values = []string{strconv.Itoa(int(lastCheckin)), strconv.Itoa(0)}
 	apiReq.Filters = []Filter{f}
+	if len(realms) > 0 && endpoint != "addresses" {
+		rf := Filter{}
+		rf.Type = "realm"
+		for _, val := range realms {
+			rf.Values = append(rf.Values, string(val))
+		}
+		apiReq.Filters = append(apiReq.Filters, rf)
+	}
+	if subs.Partial() && SubscriptionBound(endpoint) {
+		apiReq.Filters = append(apiReq.Filters, subs.Filters()...)
+	}
 	signingErr := apiReq.CreateSignature(globals.BackendConfig.GetBackendKeyPair())
 	if signingErr != nil {
 		return Response{}, 0, signingErr
@@ -1120,6 +1281,14 @@ func checkForEntityInAnswer(a Answer, fp Fingerprint, t string) interface{} {
 				return entity
 			}
 		}
+	case "directmessages":
+		var entities []DirectMessage
+		entities = append(entities, a.DirectMessages...)
+		for _, entity := range entities {
+			if entity.Fingerprint == fp {
+				return entity
+			}
+		}
 	}
 	return nil
 }
@@ -1392,6 +1561,30 @@ CacheIterator: // Naming the for loop CacheIterator.
 					break CacheIterator
 				}
 			}
+		case "directmessages":
+			entities := cIndex.DirectMessageIndexes
+			// For each of those entities,
+			for _, entityIndex := range entities {
+				// Check if this is what we want.
+				if entityIndex.Fingerprint == q.Fingerprint {
+					// If so, pull the result from cache.
+					obj, err := pullFullEntityFromCache(cacheLocation, entityIndex.PageNumber, q.Fingerprint, q.EntityType, host, subhost, port, reverseConn)
+					if err != nil {
+						return r, errors.New(
+							fmt.Sprint(
+								"Could not pull entity from cache. The item is indexed as available in the remote node, but the actual body of the item is not available.",
+								", Error: ", err,
+								", Host: ", host,
+								", Subhost: ", subhost,
+								", Port: ", port,
+								", QueryData: ", q))
+					}
+					// And put into the proper part of the response.
+					r.DirectMessages = append(r.DirectMessages, obj.(DirectMessage))
+					// And finally, break the for loop, so it won't look at other caches when it's done.
+					break CacheIterator
+				}
+			}
 		}
 	}
 	return r, nil
@@ -1425,6 +1618,8 @@ func SendReverseOpenStatusSuccessful(reverseConn *net.Conn) {
 
 func sendReverseOpenStatusMessage(methodUrlEndpoint string, reverseConn *net.Conn) {
 	logging.Logf(1, "SendReverseOpenStatus: Sending message... Message: '%v', ", methodUrlEndpoint)
+	// This is the last request over the connection.
+	defer releaseReverseClient(reverseConn)
 	loc := "revconn/" + methodUrlEndpoint
 	_, err := Fetch("", "", 0, loc, "GET", []byte(""), reverseConn)
 	if err != nil && !strings.Contains(err.Error(), "Non-200 status code returned from Fetch") {
@@ -195,6 +195,24 @@ func (e *Truststate) Protobuf() pb.Truststate {
 	return pb.Truststate{}
 }
 
+func (e *DirectMessage) Protobuf() pb.DirectMessage {
+	if e.GetVersion() == 1 {
+		return pb.DirectMessage{
+			Provable:       e.ProvableFieldSet.Protobuf(),
+			Recipient:      e.Recipient.Protobuf(),
+			Body:           e.Body,
+			Owner:          e.Owner.Protobuf(),
+			OwnerPublicKey: e.OwnerPublicKey,
+			EntityVersion:  int32(e.EntityVersion),
+			Meta:           e.Meta,
+			RealmId:        e.RealmId.Protobuf(),
+			EncrContent:    e.EncrContent,
+			Updateable:     e.UpdateableFieldSet.Protobuf(),
+		}
+	}
+	return pb.DirectMessage{}
+}
+
 // Protobuf > API object conversions
 
 //////////////////////////////////
@@ -392,3 +410,22 @@ func (e *Truststate) FillFromProtobuf(v pb.Truststate) {
 		e.UpdateableFieldSet = u
 	}
 }
+
+func (e *DirectMessage) FillFromProtobuf(v pb.DirectMessage) {
+	if v.GetEntityVersion() == 1 {
+		pv := ProvableFieldSet{}
+		pv.FillFromProtobuf(*v.GetProvable())
+		e.ProvableFieldSet = pv
+		e.Recipient = Fingerprint(v.GetRecipient())
+		e.Body = v.GetBody()
+		e.Owner = Fingerprint(v.GetOwner())
+		e.OwnerPublicKey = v.GetOwnerPublicKey()
+		e.EntityVersion = int(v.GetEntityVersion())
+		e.Meta = v.GetMeta()
+		e.RealmId = Fingerprint(v.GetRealmId())
+		e.EncrContent = v.GetEncrContent()
+		u := UpdateableFieldSet{}
+		u.FillFromProtobuf(*v.GetUpdateable())
+		e.UpdateableFieldSet = u
+	}
+}
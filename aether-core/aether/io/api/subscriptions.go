@@ -0,0 +1,147 @@
+// API > Subscriptions
+// This file provides the subscriptions of a node that syncs only a part of the network: the boards, and the users, whose threads, posts and votes it takes in.
+
+package api
+
+import (
+	"aether-core/aether/services/globals"
+)
+
+/*
+How this works:
+
+A node with no subscriptions syncs the whole network. A node with subscriptions takes in only the threads, posts and votes that are in one of its boards, or that are by one of the users it follows. Everything else (boards, keys, truststates, direct messages, addresses) is synced in full. Those are small, and the node needs them to verify and to show what it's subscribed to.
+
+The subscriptions travel in the POST requests as two filters, "board" and "owner". Unlike the other filters, these two widen each other: an entity matches if it's in one of the boards, or if it's by one of the owners. The remotes that understand these filters declare it with a subprotocol (SubscriptionFiltersSubprotocol), older remotes would reject the request. Those get the request without the filters, and their response is filtered on our side, the same as the cache pages, which are the same for everyone.
+*/
+
+type Subscriptions struct {
+	Boards []Fingerprint
+	Users  []Fingerprint
+}
+
+// LocalSubscriptions returns the subscriptions of this node. The frontend has no subscriptions of its own, it sees whatever its backend has.
+func LocalSubscriptions() Subscriptions {
+	s := Subscriptions{}
+	if isFrontend() {
+		return s
+	}
+	for _, val := range globals.BackendConfig.GetSubscribedBoards() {
+		s.Boards = append(s.Boards, Fingerprint(val))
+	}
+	for _, val := range globals.BackendConfig.GetFollowedUsers() {
+		s.Users = append(s.Users, Fingerprint(val))
+	}
+	return s
+}
+
+// Partial returns whether these subscriptions cover only a part of the network.
+func (s *Subscriptions) Partial() bool {
+	return len(s.Boards) > 0 || len(s.Users) > 0
+}
+
+// Filters returns the filters that ask a remote for only what the subscriptions cover.
+func (s *Subscriptions) Filters() []Filter {
+	filters := []Filter{}
+	if len(s.Boards) > 0 {
+		f := Filter{Type: "board"}
+		for _, val := range s.Boards {
+			f.Values = append(f.Values, string(val))
+		}
+		filters = append(filters, f)
+	}
+	if len(s.Users) > 0 {
+		f := Filter{Type: "owner"}
+		for _, val := range s.Users {
+			f.Values = append(f.Values, string(val))
+		}
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// Widens returns whether these subscriptions cover something that the prior ones didn't. Going from partial to the whole network widens, going from the whole network to partial doesn't.
+func (s *Subscriptions) Widens(prior Subscriptions) bool {
+	if !prior.Partial() {
+		return false
+	}
+	if !s.Partial() {
+		return true
+	}
+	for _, val := range s.Boards {
+		if !fingerprintIn(val, prior.Boards) {
+			return true
+		}
+	}
+	for _, val := range s.Users {
+		if !fingerprintIn(val, prior.Users) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Subscriptions) matches(board, owner Fingerprint) bool {
+	if !s.Partial() {
+		return true
+	}
+	return fingerprintIn(board, s.Boards) || fingerprintIn(owner, s.Users)
+}
+
+// SubscriptionBound returns whether the entities of the endpoint are subject to the subscriptions.
+func SubscriptionBound(endpoint string) bool {
+	return endpoint == "threads" || endpoint == "posts" || endpoint == "votes"
+}
+
+// SupportsSubscriptionFilters returns whether the remote with the given protocol understands the board and owner filters. It does if it declares SubscriptionFiltersSubprotocol with both of them.
+func SupportsSubscriptionFilters(remote Protocol) bool {
+	for _, val := range remote.Subprotocols {
+		if val.Name != SubscriptionFiltersSubprotocol {
+			continue
+		}
+		for _, filter := range SubscriptionFilters {
+			if !entityIn(filter, val.SupportedEntities) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// FilterSubscriptions removes the threads, posts and votes that the subscriptions don't cover from the response. The rest is left intact.
+func (r *Response) FilterSubscriptions(s Subscriptions) {
+	if !s.Partial() {
+		return
+	}
+	threads := []Thread{}
+	for key, _ := range r.Threads {
+		if s.matches(r.Threads[key].Board, r.Threads[key].Owner) {
+			threads = append(threads, r.Threads[key])
+		}
+	}
+	r.Threads = threads
+	posts := []Post{}
+	for key, _ := range r.Posts {
+		if s.matches(r.Posts[key].Board, r.Posts[key].Owner) {
+			posts = append(posts, r.Posts[key])
+		}
+	}
+	r.Posts = posts
+	votes := []Vote{}
+	for key, _ := range r.Votes {
+		if s.matches(r.Votes[key].Board, r.Votes[key].Owner) {
+			votes = append(votes, r.Votes[key])
+		}
+	}
+	r.Votes = votes
+}
+
+func fingerprintIn(fp Fingerprint, fps []Fingerprint) bool {
+	for key, _ := range fps {
+		if fps[key] == fp {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@
 package persistence
 
 import (
+	"aether-core/aether/io/api"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
@@ -11,7 +12,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	// "github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
-	// _ "github.com/lib/pq"
+	_ "github.com/lib/pq"
 	"errors"
 	// "github.com/fatih/color"
 	// "os"
@@ -27,12 +28,18 @@ func DeleteDatabase() {
 		toolbox.DeleteFromDisk(dbLoc)
 	} else if globals.BackendConfig.GetDbEngine() == "mysql" {
 		globals.DbInstance.MustExec("DROP DATABASE `AetherDB`;")
+	} else if globals.BackendConfig.GetDbEngine() == "postgres" {
+		// Postgres does not let us drop the database we're connected to, so we drop the tables instead. The database itself is provisioned by the operator.
+		globals.DbInstance.MustExec("DROP TABLE IF EXISTS BoardOwners, Boards, Threads, Posts, Votes, Addresses, PublicKeys, Truststates, DirectMessages, Nodes, Subprotocols, AddressesSubprotocols, ThreadHistory, PostHistory, PeerReputations, Diagnostics;")
 	}
 }
 
 // CreateDatabase creates a new database in the default location and places into it the database schema.
 
 func CreateDatabase() {
+	// Once the database is there, the API layer can find the boards in it when it needs their PoW requirements to verify threads and posts, and the threads and posts we already have.
+	api.BoardLookup = LookupBoard
+	api.ContentLookup = LookupContent
 	err := createDatabase()
 	if err != nil {
 		if strings.Contains(err.Error(), "Database was locked") {
@@ -68,11 +75,11 @@ func createDatabase() error {
 	var schema10 string
 	var schema11 string
 	var schema12 string
-	// var schema13 string
-	// var schema14 string
-	// var schema15 string
+	var schema13 string
+	var schema14 string
+	var schema15 string
 	var schema16 string
-	// var schema17 string
+	var schema17 string
 	// var schema18 string
 	var idxSqlite1 string
 	var idxSqlite2 string
@@ -98,6 +105,25 @@ func createDatabase() error {
 	var idxSqlite22 string
 	var idxSqlite23 string
 	var idxSqlite24 string
+	var idxSqlite25 string
+	var idxSqlite26 string
+	var idxSqlite27 string
+	var idxSqlite28 string
+	var idxSqlite29 string
+	var idxPostgres1 string
+	var idxPostgres2 string
+	var idxPostgres3 string
+	var idxPostgres4 string
+	var idxPostgres5 string
+	var idxPostgres6 string
+	var idxPostgres7 string
+	var idxPostgres8 string
+	var idxPostgres9 string
+	var idxPostgres10 string
+	var idxPostgres11 string
+	var idxPostgres12 string
+	var idxPostgres13 string
+	var idxPostgres14 string
 
 	if globals.BackendConfig.DbEngine == "mysql" {
 		schemaPrep1 = `
@@ -184,6 +210,57 @@ func createDatabase() error {
           RealmId VARCHAR(64) NOT NULL,
           EncrContent MEDIUMTEXT NOT NULL,
           INDEX (Board, Thread, Parent, LastReferenced, LastUpdate, Creation)
+        )ROW_FORMAT=COMPRESSED;`
+		// The prior versions of updated threads and posts. Same columns as their tables, plus the local arrival of the version that replaced them.
+		schema13 = `
+        CREATE TABLE IF NOT EXISTS ThreadHistory (
+          Fingerprint VARCHAR(64) NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Name VARCHAR(255) NOT NULL,
+          Body MEDIUMTEXT NOT NULL,
+          Link VARCHAR(5000) NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta MEDIUMTEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent MEDIUMTEXT NOT NULL,
+          Superseded BIGINT NOT NULL,
+          PRIMARY KEY (Fingerprint, LastUpdate),
+          INDEX (Superseded)
+        )ROW_FORMAT=COMPRESSED;`
+		schema14 = `
+        CREATE TABLE IF NOT EXISTS PostHistory (
+          Fingerprint VARCHAR(64) NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Thread VARCHAR(64) NOT NULL,
+          Parent VARCHAR(64) NOT NULL,
+          Body MEDIUMTEXT NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta MEDIUMTEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent MEDIUMTEXT NOT NULL,
+          Superseded BIGINT NOT NULL,
+          PRIMARY KEY (Fingerprint, LastUpdate),
+          INDEX (Superseded)
         )ROW_FORMAT=COMPRESSED;`
 		schema6 = `
         CREATE TABLE IF NOT EXISTS Votes (
@@ -275,6 +352,31 @@ func createDatabase() error {
           EncrContent MEDIUMTEXT NOT NULL,
           INDEX (LastReferenced, LastUpdate, Creation)
         )ROW_FORMAT=COMPRESSED;
+      `
+		// Direct messages are immutable, but they keep the updateable columns so that they look like every other entity to the readers.
+		schema15 = `
+        CREATE TABLE IF NOT EXISTS DirectMessages (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Recipient VARCHAR(64) NOT NULL,
+          Body MEDIUMTEXT NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta MEDIUMTEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent MEDIUMTEXT NOT NULL,
+          INDEX (Recipient, LastReferenced),
+          INDEX (Owner, LastReferenced),
+          INDEX (LastReferenced, LastUpdate, Creation)
+        )ROW_FORMAT=COMPRESSED;
       `
 		schema10 = `
           CREATE TABLE IF NOT EXISTS Nodes (
@@ -285,7 +387,8 @@ func createDatabase() error {
             VotesLastCheckin BIGINT NOT NULL,
             KeysLastCheckin BIGINT NOT NULL,
             TruststatesLastCheckin BIGINT NOT NULL,
-            AddressesLastCheckin BIGINT NOT NULL
+            AddressesLastCheckin BIGINT NOT NULL,
+            DirectMessagesLastCheckin BIGINT NOT NULL DEFAULT 0
           )ROW_FORMAT=COMPRESSED;
         `
 		schema11 = `
@@ -308,6 +411,26 @@ func createDatabase() error {
 		schema16 = `
           CREATE TABLE IF NOT EXISTS Diagnostics (
             DbRoundtripTestField BIGINT PRIMARY KEY NOT NULL
+          )ROW_FORMAT=COMPRESSED;`
+		// What we know about how the remotes behaved in our syncs with them. See dispatch/reputation.go.
+		schema17 = `
+          CREATE TABLE IF NOT EXISTS PeerReputations (
+            Location VARCHAR(256) NOT NULL,
+            Sublocation VARCHAR(256) NOT NULL,
+            Port INTEGER NOT NULL,
+            Successes BIGINT NOT NULL,
+            Failures BIGINT NOT NULL,
+            Timeouts BIGINT NOT NULL,
+            Received BIGINT NOT NULL,
+            InvalidEntities BIGINT NOT NULL,
+            RejectedPages BIGINT NOT NULL,
+            PurgatoryDiscards BIGINT NOT NULL,
+            RecentOffences INTEGER NOT NULL,
+            LastOffence BIGINT NOT NULL,
+            Bans INTEGER NOT NULL,
+            BannedUntil BIGINT NOT NULL,
+            LastUpdate BIGINT NOT NULL,
+            PRIMARY KEY(Location, Sublocation, Port)
           )ROW_FORMAT=COMPRESSED;`
 	} else if globals.BackendConfig.DbEngine == "sqlite" {
 		schemaPrep1 = `
@@ -388,6 +511,55 @@ func createDatabase() error {
         ,  "RealmId" varchar(64) NOT NULL
         ,  "EncrContent" text NOT NULL
         ,  PRIMARY KEY ("Fingerprint")
+        );`
+		// The prior versions of updated threads and posts. Same columns as their tables, plus the local arrival of the version that replaced them.
+		schema13 = `
+        CREATE TABLE IF NOT EXISTS "ThreadHistory" (
+          "Fingerprint" varchar(64) NOT NULL
+        ,  "Board" varchar(64) NOT NULL
+        ,  "Name" varchar(255) NOT NULL
+        ,  "Body" text NOT NULL
+        ,  "Link" varchar(5000) NOT NULL
+        ,  "Owner" varchar(64) NOT NULL
+        ,  "OwnerPublicKey" varchar(128) NOT NULL
+        ,  "Creation" integer NOT NULL
+        ,  "ProofOfWork" varchar(1024) NOT NULL
+        ,  "Signature" varchar(512) NOT NULL
+        ,  "LastUpdate" integer NOT NULL
+        ,  "UpdateProofOfWork" varchar(1024) NOT NULL
+        ,  "UpdateSignature" varchar(512) NOT NULL
+        ,  "LocalArrival" integer NOT NULL
+        ,  "LastReferenced" integer NOT NULL
+        ,  "EntityVersion" integer NOT NULL
+        ,  "Meta" text NOT NULL
+        ,  "RealmId" varchar(64) NOT NULL
+        ,  "EncrContent" text NOT NULL
+        ,  "Superseded" integer NOT NULL
+        ,  PRIMARY KEY ("Fingerprint","LastUpdate")
+        );`
+		schema14 = `
+        CREATE TABLE IF NOT EXISTS "PostHistory" (
+          "Fingerprint" varchar(64) NOT NULL
+        ,  "Board" varchar(64) NOT NULL
+        ,  "Thread" varchar(64) NOT NULL
+        ,  "Parent" varchar(64) NOT NULL
+        ,  "Body" text NOT NULL
+        ,  "Owner" varchar(64) NOT NULL
+        ,  "OwnerPublicKey" varchar(128) NOT NULL
+        ,  "Creation" integer NOT NULL
+        ,  "ProofOfWork" varchar(1024) NOT NULL
+        ,  "Signature" varchar(512) NOT NULL
+        ,  "LastUpdate" integer NOT NULL
+        ,  "UpdateProofOfWork" varchar(1024) NOT NULL
+        ,  "UpdateSignature" varchar(512) NOT NULL
+        ,  "LocalArrival" integer NOT NULL
+        ,  "LastReferenced" integer NOT NULL
+        ,  "EntityVersion" integer NOT NULL
+        ,  "Meta" text NOT NULL
+        ,  "RealmId" varchar(64) NOT NULL
+        ,  "EncrContent" text NOT NULL
+        ,  "Superseded" integer NOT NULL
+        ,  PRIMARY KEY ("Fingerprint","LastUpdate")
         );`
 		schema6 = `
         CREATE TABLE IF NOT EXISTS "Votes" (
@@ -458,6 +630,27 @@ func createDatabase() error {
         ,  "RealmId" varchar(64) NOT NULL
         ,  "EncrContent" text NOT NULL
         ,  PRIMARY KEY ("Fingerprint")
+        );`
+		schema15 = `
+        CREATE TABLE IF NOT EXISTS "DirectMessages" (
+          "Fingerprint" varchar(64) NOT NULL
+        ,  "Recipient" varchar(64) NOT NULL
+        ,  "Body" text NOT NULL
+        ,  "Owner" varchar(64) NOT NULL
+        ,  "OwnerPublicKey" varchar(128) NOT NULL
+        ,  "Creation" integer NOT NULL
+        ,  "ProofOfWork" varchar(1024) NOT NULL
+        ,  "Signature" varchar(512) NOT NULL
+        ,  "LastUpdate" integer NOT NULL
+        ,  "UpdateProofOfWork" varchar(1024) NOT NULL
+        ,  "UpdateSignature" varchar(512) NOT NULL
+        ,  "LocalArrival" integer NOT NULL
+        ,  "LastReferenced" integer NOT NULL
+        ,  "EntityVersion" integer NOT NULL
+        ,  "Meta" text NOT NULL
+        ,  "RealmId" varchar(64) NOT NULL
+        ,  "EncrContent" text NOT NULL
+        ,  PRIMARY KEY ("Fingerprint")
         );`
 		schema7 = `
         CREATE TABLE IF NOT EXISTS "Addresses" (
@@ -489,6 +682,7 @@ func createDatabase() error {
           ,  "KeysLastCheckin" integer NOT NULL
           ,  "TruststatesLastCheckin" integer NOT NULL
           ,  "AddressesLastCheckin" integer NOT NULL
+          ,  "DirectMessagesLastCheckin" integer NOT NULL DEFAULT 0
           ,  PRIMARY KEY ("Fingerprint")
           );`
 		schema11 = `
@@ -512,6 +706,25 @@ func createDatabase() error {
             CREATE TABLE IF NOT EXISTS "Diagnostics" (
               "DbRoundtripTestField" integer NOT NULL
             ,  PRIMARY KEY ("DbRoundtripTestField")
+          );`
+		schema17 = `
+          CREATE TABLE IF NOT EXISTS "PeerReputations" (
+            "Location" varchar(256) NOT NULL
+          ,  "Sublocation" varchar(256) NOT NULL
+          ,  "Port" integer NOT NULL
+          ,  "Successes" integer NOT NULL
+          ,  "Failures" integer NOT NULL
+          ,  "Timeouts" integer NOT NULL
+          ,  "Received" integer NOT NULL
+          ,  "InvalidEntities" integer NOT NULL
+          ,  "RejectedPages" integer NOT NULL
+          ,  "PurgatoryDiscards" integer NOT NULL
+          ,  "RecentOffences" integer NOT NULL
+          ,  "LastOffence" integer NOT NULL
+          ,  "Bans" integer NOT NULL
+          ,  "BannedUntil" integer NOT NULL
+          ,  "LastUpdate" integer NOT NULL
+          ,  PRIMARY KEY ("Location","Sublocation","Port")
           );`
 
 		idxSqlite1 = `
@@ -590,552 +803,2096 @@ func createDatabase() error {
 		idxSqlite24 = `
           CREATE INDEX IF NOT EXISTS "idx_Posts_Board" ON "Posts" ("Board");
           `
-	} else {
-		logging.LogCrash(fmt.Sprintf("Storage engine you've inputted is not supported. Please change it from the backend user config into something that is supported. You've provided: %s", globals.BackendConfig.GetDbEngine()))
-	}
-
-	var creationSchemas []string
-	if globals.BackendConfig.GetDbEngine() == "sqlite" {
-		creationSchemas = append(creationSchemas, schemaPrep1)
-		creationSchemas = append(creationSchemas, schema1)
-		creationSchemas = append(creationSchemas, schema3)
-		creationSchemas = append(creationSchemas, schema4)
-		creationSchemas = append(creationSchemas, schema5)
-		creationSchemas = append(creationSchemas, schema6)
-		creationSchemas = append(creationSchemas, schema7)
-		creationSchemas = append(creationSchemas, schema8)
-		creationSchemas = append(creationSchemas, schema9)
-		creationSchemas = append(creationSchemas, schema10)
-		creationSchemas = append(creationSchemas, schema11)
-		creationSchemas = append(creationSchemas, schema12)
-		creationSchemas = append(creationSchemas, schema16)
-		creationSchemas = append(creationSchemas, idxSqlite1)
-		creationSchemas = append(creationSchemas, idxSqlite2)
-		creationSchemas = append(creationSchemas, idxSqlite3)
-		creationSchemas = append(creationSchemas, idxSqlite4)
-		creationSchemas = append(creationSchemas, idxSqlite5)
-		creationSchemas = append(creationSchemas, idxSqlite6)
-		creationSchemas = append(creationSchemas, idxSqlite7)
-		creationSchemas = append(creationSchemas, idxSqlite8)
-		creationSchemas = append(creationSchemas, idxSqlite9)
-		creationSchemas = append(creationSchemas, idxSqlite10)
-		creationSchemas = append(creationSchemas, idxSqlite11)
-		creationSchemas = append(creationSchemas, idxSqlite12)
-		creationSchemas = append(creationSchemas, idxSqlite13)
-		creationSchemas = append(creationSchemas, idxSqlite14)
-		creationSchemas = append(creationSchemas, idxSqlite15)
-		creationSchemas = append(creationSchemas, idxSqlite16)
-		creationSchemas = append(creationSchemas, idxSqlite17)
-		creationSchemas = append(creationSchemas, idxSqlite18)
-		creationSchemas = append(creationSchemas, idxSqlite19)
-		creationSchemas = append(creationSchemas, idxSqlite20)
-		creationSchemas = append(creationSchemas, idxSqlite21)
-		creationSchemas = append(creationSchemas, idxSqlite22)
-		creationSchemas = append(creationSchemas, idxSqlite23)
-		creationSchemas = append(creationSchemas, idxSqlite24)
-	} else if globals.BackendConfig.GetDbEngine() == "mysql" {
-		creationSchemas = append(creationSchemas, schemaPrep1)
-		creationSchemas = append(creationSchemas, schemaPrep2)
-		creationSchemas = append(creationSchemas, schemaPrep3)
-		creationSchemas = append(creationSchemas, schemaPrep4)
-		creationSchemas = append(creationSchemas, schema1)
-		creationSchemas = append(creationSchemas, schema3)
-		creationSchemas = append(creationSchemas, schema4)
-		creationSchemas = append(creationSchemas, schema5)
-		creationSchemas = append(creationSchemas, schema6)
-		creationSchemas = append(creationSchemas, schema7)
-		creationSchemas = append(creationSchemas, schema8)
-		creationSchemas = append(creationSchemas, schema9)
-		creationSchemas = append(creationSchemas, schema10)
-		creationSchemas = append(creationSchemas, schema11)
-		creationSchemas = append(creationSchemas, schema12)
-		creationSchemas = append(creationSchemas, schema16)
-	}
-
-	tx, err := globals.DbInstance.Beginx()
-	if err != nil {
-		logging.LogCrash(err)
-	}
-	for _, schema := range creationSchemas {
-		// fmt.Println(schema)
-		_, err2 := tx.Exec(schema)
-		if err2 != nil {
-			logging.LogCrash(err2)
-		}
-	}
-	err3 := tx.Commit()
-	if err3 != nil {
-		tx.Rollback()
-		logging.Log(1, fmt.Sprintf("CreateDatabase encountered an error when trying to commit to the database. Error is: %s", err3))
-		if strings.Contains(err3.Error(), "database is locked") {
-			logging.Log(1, fmt.Sprintf("This database seems to be locked. We'll sleep 10 seconds to give it the time it needs to recover. This mostly happens when the app has crashed and there is a hot journal - and SQLite is in the process of repairing the database. THE DATA IN THIS TRANSACTION WAS NOT COMMITTED. PLEASE RETRY."))
-			return errors.New("Database was locked. THE DATA IN THIS TRANSACTION WAS NOT COMMITTED. PLEASE RETRY.")
-		}
-		return err3
-	}
-	return nil
-}
-
-func CheckDatabaseReady() {
-	DiagInsert := `REPLACE INTO Diagnostics
-  (
-    DbRoundtripTestField
-  ) VALUES (
-    :DbRoundtripTestField
-  )`
-	DiagDelete := `DELETE FROM Diagnostics`
-	// We're using time.now because we don't want DB to optimise out the write and not test the connection that way. Get a random number between 0- 65535 for entry test.
-	ss := map[string]interface{}{"DbRoundtripTestField": toolbox.GetInsecureRand(65535)}
-	// First, remove everything.
-	tx, err := globals.DbInstance.Beginx()
-	if err != nil {
-		logging.LogCrash(err)
-	}
-	_, err2 := tx.Exec(DiagDelete)
-	if err2 != nil {
-		logging.LogCrash(err2)
-	}
-	tx.Commit()
-	// Second, insert a new item.
-	tx2, err3 := globals.DbInstance.Beginx()
-	if err3 != nil {
-		logging.LogCrash(err3)
-	}
-	_, err4 := tx2.NamedExec(DiagInsert, ss)
-	if err4 != nil {
-		logging.LogCrash(err4)
-	}
-	err5 := tx2.Commit()
-	if err5 != nil {
-		logging.LogCrash(err5)
-	}
-	logging.Logf(1, "Database is ready. Just verified by removing and inserting data successfully.")
-}
-
-// Insertion SQL code used by the writer.
-
-// var nodeInsert = `
-// REPLACE INTO Nodes
-// (
-//   Fingerprint, BoardsLastCheckin, ThreadsLastCheckin, PostsLastCheckin, VotesLastCheckin, KeysLastCheckin, TruststatesLastCheckin, AddressesLastCheckin
-// ) VALUES (
-//   :Fingerprint,
-//   MAX(:BoardsLastCheckin, IFNULL((SELECT BoardsLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
-//   MAX(:ThreadsLastCheckin, IFNULL((SELECT ThreadsLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
-//   MAX(:PostsLastCheckin, IFNULL((SELECT PostsLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
-//   MAX(:VotesLastCheckin, IFNULL((SELECT VotesLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
-//   MAX(:KeysLastCheckin, IFNULL((SELECT KeysLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
-//   MAX(:TruststatesLastCheckin, IFNULL((SELECT TruststatesLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
-//   MAX(:AddressesLastCheckin, IFNULL((SELECT AddressesLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0))
-// )
-// `
+		// History indexes
+		idxSqlite25 = `
+          CREATE INDEX IF NOT EXISTS "idx_ThreadHistory_Superseded" ON "ThreadHistory" ("Superseded");
+          `
+		idxSqlite26 = `
+          CREATE INDEX IF NOT EXISTS "idx_PostHistory_Superseded" ON "PostHistory" ("Superseded");
+          `
+		// Direct message indexes. The frontends read them by their recipient or their owner.
+		idxSqlite27 = `
+          CREATE INDEX IF NOT EXISTS "idx_DirectMessages_Recipient" ON "DirectMessages" ("Recipient");
+          `
+		idxSqlite28 = `
+          CREATE INDEX IF NOT EXISTS "idx_DirectMessages_Owner" ON "DirectMessages" ("Owner");
+          `
+		idxSqlite29 = `
+          CREATE INDEX IF NOT EXISTS "idx_DirectMessages_LastReferenced" ON "DirectMessages" ("LastReferenced");
+          `
+	} else if globals.BackendConfig.DbEngine == "postgres" {
+		/*
+		  Postgres folds unquoted identifiers into lowercase. We leave all identifiers unquoted here, so that the reader queries, which are shared across all engines, keep working without quoting. The flip side is that the column names that come back from Postgres are lowercase, which is why the Postgres connection is set up with a lowercasing struct mapper in the backend cmd.
+		*/
+		schema1 = `
+        CREATE TABLE IF NOT EXISTS BoardOwners (
+          BoardFingerprint VARCHAR(64) NOT NULL,
+          KeyFingerprint VARCHAR(64) NOT NULL,
+          Expiry BIGINT NOT NULL,
+          Level SMALLINT NOT NULL,
+          PRIMARY KEY(BoardFingerprint, KeyFingerprint)
+        );`
+		schema3 = `
+        CREATE TABLE IF NOT EXISTS Boards (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Name VARCHAR(255) NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Description TEXT NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Language VARCHAR(3) NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		schema4 = `
+        CREATE TABLE IF NOT EXISTS Threads (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Name VARCHAR(255) NOT NULL,
+          Body TEXT NOT NULL,
+          Link VARCHAR(5000) NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		schema5 = `
+        CREATE TABLE IF NOT EXISTS Posts (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Thread VARCHAR(64) NOT NULL,
+          Parent VARCHAR(64) NOT NULL,
+          Body TEXT NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		// The prior versions of updated threads and posts. Same columns as their tables, plus the local arrival of the version that replaced them.
+		schema13 = `
+        CREATE TABLE IF NOT EXISTS ThreadHistory (
+          Fingerprint VARCHAR(64) NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Name VARCHAR(255) NOT NULL,
+          Body TEXT NOT NULL,
+          Link VARCHAR(5000) NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL,
+          Superseded BIGINT NOT NULL,
+          PRIMARY KEY (Fingerprint, LastUpdate)
+        );`
+		schema14 = `
+        CREATE TABLE IF NOT EXISTS PostHistory (
+          Fingerprint VARCHAR(64) NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Thread VARCHAR(64) NOT NULL,
+          Parent VARCHAR(64) NOT NULL,
+          Body TEXT NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL,
+          Superseded BIGINT NOT NULL,
+          PRIMARY KEY (Fingerprint, LastUpdate)
+        );`
+		schema6 = `
+        CREATE TABLE IF NOT EXISTS Votes (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Board VARCHAR(64) NOT NULL,
+          Thread VARCHAR(64) NOT NULL,
+          Target VARCHAR(64) NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          TypeClass SMALLINT NOT NULL,
+          Type SMALLINT NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		schema7 = `
+        CREATE TABLE IF NOT EXISTS Addresses (
+          Location VARCHAR(256) NOT NULL,
+          Sublocation VARCHAR(256) NOT NULL,
+          Port INTEGER NOT NULL,
+          IPType SMALLINT NOT NULL,
+          AddressType SMALLINT NOT NULL,
+          LastSuccessfulPing BIGINT NOT NULL,
+          LastSuccessfulSync BIGINT NOT NULL,
+          ProtocolVersionMajor SMALLINT NOT NULL,
+          ProtocolVersionMinor INTEGER NOT NULL,
+          ClientVersionMajor SMALLINT NOT NULL,
+          ClientVersionMinor INTEGER NOT NULL,
+          ClientVersionPatch INTEGER NOT NULL,
+          ClientName VARCHAR(255) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          PRIMARY KEY(Location, Sublocation, Port)
+        );`
+		schema8 = `
+        CREATE TABLE IF NOT EXISTS PublicKeys (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Type VARCHAR(64) NOT NULL,
+          PublicKey VARCHAR(128) NOT NULL,
+          Expiry BIGINT NOT NULL,
+          Name VARCHAR(64) NOT NULL,
+          Info TEXT NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		schema9 = `
+        CREATE TABLE IF NOT EXISTS Truststates (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Target VARCHAR(64) NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          TypeClass SMALLINT NOT NULL,
+          Type SMALLINT NOT NULL,
+          Domain VARCHAR(64) NOT NULL,
+          Expiry BIGINT NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		schema15 = `
+        CREATE TABLE IF NOT EXISTS DirectMessages (
+          Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+          Recipient VARCHAR(64) NOT NULL,
+          Body TEXT NOT NULL,
+          Owner VARCHAR(64) NOT NULL,
+          OwnerPublicKey VARCHAR(128) NOT NULL,
+          Creation BIGINT NOT NULL,
+          ProofOfWork VARCHAR(1024) NOT NULL,
+          Signature VARCHAR(512) NOT NULL,
+          LastUpdate BIGINT NOT NULL,
+          UpdateProofOfWork VARCHAR(1024) NOT NULL,
+          UpdateSignature VARCHAR(512) NOT NULL,
+          LocalArrival BIGINT NOT NULL,
+          LastReferenced BIGINT NOT NULL,
+          EntityVersion SMALLINT NOT NULL,
+          Meta TEXT NOT NULL,
+          RealmId VARCHAR(64) NOT NULL,
+          EncrContent TEXT NOT NULL
+        );`
+		schema10 = `
+          CREATE TABLE IF NOT EXISTS Nodes (
+            Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+            BoardsLastCheckin BIGINT NOT NULL,
+            ThreadsLastCheckin BIGINT NOT NULL,
+            PostsLastCheckin BIGINT NOT NULL,
+            VotesLastCheckin BIGINT NOT NULL,
+            KeysLastCheckin BIGINT NOT NULL,
+            TruststatesLastCheckin BIGINT NOT NULL,
+            AddressesLastCheckin BIGINT NOT NULL,
+            DirectMessagesLastCheckin BIGINT NOT NULL DEFAULT 0
+          );`
+		schema11 = `
+          CREATE TABLE IF NOT EXISTS Subprotocols (
+            Fingerprint VARCHAR(64) PRIMARY KEY NOT NULL,
+            Name VARCHAR(64) NOT NULL,
+            VersionMajor SMALLINT NOT NULL,
+            VersionMinor INTEGER NOT NULL,
+            SupportedEntities VARCHAR(5000) NOT NULL
+          );`
+		schema12 = `
+          CREATE TABLE IF NOT EXISTS AddressesSubprotocols (
+            AddressLocation VARCHAR(256) NOT NULL,
+            AddressSublocation VARCHAR(256) NOT NULL,
+            AddressPort INTEGER NOT NULL,
+            SubprotocolFingerprint VARCHAR(64) NOT NULL,
+            PRIMARY KEY(AddressLocation, AddressSublocation, AddressPort, SubprotocolFingerprint)
+          );`
+		schema16 = `
+          CREATE TABLE IF NOT EXISTS Diagnostics (
+            DbRoundtripTestField BIGINT PRIMARY KEY NOT NULL
+          );`
+		schema17 = `
+          CREATE TABLE IF NOT EXISTS PeerReputations (
+            Location VARCHAR(256) NOT NULL,
+            Sublocation VARCHAR(256) NOT NULL,
+            Port INTEGER NOT NULL,
+            Successes BIGINT NOT NULL,
+            Failures BIGINT NOT NULL,
+            Timeouts BIGINT NOT NULL,
+            Received BIGINT NOT NULL,
+            InvalidEntities BIGINT NOT NULL,
+            RejectedPages BIGINT NOT NULL,
+            PurgatoryDiscards BIGINT NOT NULL,
+            RecentOffences INTEGER NOT NULL,
+            LastOffence BIGINT NOT NULL,
+            Bans INTEGER NOT NULL,
+            BannedUntil BIGINT NOT NULL,
+            LastUpdate BIGINT NOT NULL,
+            PRIMARY KEY(Location, Sublocation, Port)
+          );`
+		// These mirror the composite indexes of the MySQL schema, plus the lookups by thread and parent that the post embeds need.
+		idxPostgres1 = `
+          CREATE INDEX IF NOT EXISTS idx_Boards_LR_LU_C ON Boards (LastReferenced, LastUpdate, Creation);
+          `
+		idxPostgres2 = `
+          CREATE INDEX IF NOT EXISTS idx_Threads_B_LR_LU_C ON Threads (Board, LastReferenced, LastUpdate, Creation);
+          `
+		idxPostgres3 = `
+          CREATE INDEX IF NOT EXISTS idx_Posts_B_T_P_LR_LU_C ON Posts (Board, Thread, Parent, LastReferenced, LastUpdate, Creation);
+          `
+		idxPostgres4 = `
+          CREATE INDEX IF NOT EXISTS idx_Votes_T_LR_LU_C ON Votes (Target, LastReferenced, LastUpdate, Creation);
+          `
+		idxPostgres5 = `
+          CREATE INDEX IF NOT EXISTS idx_PublicKeys_PK_LR ON PublicKeys (PublicKey, LastReferenced);
+          `
+		idxPostgres6 = `
+          CREATE INDEX IF NOT EXISTS idx_Truststates_LR_LU_C ON Truststates (LastReferenced, LastUpdate, Creation);
+          `
+		idxPostgres7 = `
+          CREATE INDEX IF NOT EXISTS idx_Posts_Thread ON Posts (Thread);
+          `
+		idxPostgres8 = `
+          CREATE INDEX IF NOT EXISTS idx_Posts_Parent ON Posts (Parent);
+          `
+		idxPostgres9 = `
+          CREATE INDEX IF NOT EXISTS idx_Threads_LastReferenced ON Threads (LastReferenced);
+          `
+		idxPostgres10 = `
+          CREATE INDEX IF NOT EXISTS idx_Posts_LastReferenced ON Posts (LastReferenced);
+          `
+		idxPostgres11 = `
+          CREATE INDEX IF NOT EXISTS idx_ThreadHistory_Superseded ON ThreadHistory (Superseded);
+          `
+		idxPostgres12 = `
+          CREATE INDEX IF NOT EXISTS idx_PostHistory_Superseded ON PostHistory (Superseded);
+          `
+		idxPostgres13 = `
+          CREATE INDEX IF NOT EXISTS idx_DirectMessages_R_LR ON DirectMessages (Recipient, LastReferenced);
+          `
+		idxPostgres14 = `
+          CREATE INDEX IF NOT EXISTS idx_DirectMessages_O_LR ON DirectMessages (Owner, LastReferenced);
+          `
+	} else {
+		logging.LogCrash(fmt.Sprintf("Storage engine you've inputted is not supported. Please change it from the backend user config into something that is supported. You've provided: %s", globals.BackendConfig.GetDbEngine()))
+	}
+
+	var creationSchemas []string
+	if globals.BackendConfig.GetDbEngine() == "sqlite" {
+		creationSchemas = append(creationSchemas, schemaPrep1)
+		creationSchemas = append(creationSchemas, schema1)
+		creationSchemas = append(creationSchemas, schema3)
+		creationSchemas = append(creationSchemas, schema4)
+		creationSchemas = append(creationSchemas, schema5)
+		creationSchemas = append(creationSchemas, schema6)
+		creationSchemas = append(creationSchemas, schema7)
+		creationSchemas = append(creationSchemas, schema8)
+		creationSchemas = append(creationSchemas, schema9)
+		creationSchemas = append(creationSchemas, schema15)
+		creationSchemas = append(creationSchemas, schema10)
+		creationSchemas = append(creationSchemas, schema11)
+		creationSchemas = append(creationSchemas, schema12)
+		creationSchemas = append(creationSchemas, schema13)
+		creationSchemas = append(creationSchemas, schema14)
+		creationSchemas = append(creationSchemas, schema16)
+		creationSchemas = append(creationSchemas, schema17)
+		creationSchemas = append(creationSchemas, idxSqlite1)
+		creationSchemas = append(creationSchemas, idxSqlite2)
+		creationSchemas = append(creationSchemas, idxSqlite3)
+		creationSchemas = append(creationSchemas, idxSqlite4)
+		creationSchemas = append(creationSchemas, idxSqlite5)
+		creationSchemas = append(creationSchemas, idxSqlite6)
+		creationSchemas = append(creationSchemas, idxSqlite7)
+		creationSchemas = append(creationSchemas, idxSqlite8)
+		creationSchemas = append(creationSchemas, idxSqlite9)
+		creationSchemas = append(creationSchemas, idxSqlite10)
+		creationSchemas = append(creationSchemas, idxSqlite11)
+		creationSchemas = append(creationSchemas, idxSqlite12)
+		creationSchemas = append(creationSchemas, idxSqlite13)
+		creationSchemas = append(creationSchemas, idxSqlite14)
+		creationSchemas = append(creationSchemas, idxSqlite15)
+		creationSchemas = append(creationSchemas, idxSqlite16)
+		creationSchemas = append(creationSchemas, idxSqlite17)
+		creationSchemas = append(creationSchemas, idxSqlite18)
+		creationSchemas = append(creationSchemas, idxSqlite19)
+		creationSchemas = append(creationSchemas, idxSqlite20)
+		creationSchemas = append(creationSchemas, idxSqlite21)
+		creationSchemas = append(creationSchemas, idxSqlite22)
+		creationSchemas = append(creationSchemas, idxSqlite23)
+		creationSchemas = append(creationSchemas, idxSqlite24)
+		creationSchemas = append(creationSchemas, idxSqlite25)
+		creationSchemas = append(creationSchemas, idxSqlite26)
+		creationSchemas = append(creationSchemas, idxSqlite27)
+		creationSchemas = append(creationSchemas, idxSqlite28)
+		creationSchemas = append(creationSchemas, idxSqlite29)
+	} else if globals.BackendConfig.GetDbEngine() == "mysql" {
+		creationSchemas = append(creationSchemas, schemaPrep1)
+		creationSchemas = append(creationSchemas, schemaPrep2)
+		creationSchemas = append(creationSchemas, schemaPrep3)
+		creationSchemas = append(creationSchemas, schemaPrep4)
+		creationSchemas = append(creationSchemas, schema1)
+		creationSchemas = append(creationSchemas, schema3)
+		creationSchemas = append(creationSchemas, schema4)
+		creationSchemas = append(creationSchemas, schema5)
+		creationSchemas = append(creationSchemas, schema6)
+		creationSchemas = append(creationSchemas, schema7)
+		creationSchemas = append(creationSchemas, schema8)
+		creationSchemas = append(creationSchemas, schema9)
+		creationSchemas = append(creationSchemas, schema15)
+		creationSchemas = append(creationSchemas, schema10)
+		creationSchemas = append(creationSchemas, schema11)
+		creationSchemas = append(creationSchemas, schema12)
+		creationSchemas = append(creationSchemas, schema13)
+		creationSchemas = append(creationSchemas, schema14)
+		creationSchemas = append(creationSchemas, schema16)
+		creationSchemas = append(creationSchemas, schema17)
+	} else if globals.BackendConfig.GetDbEngine() == "postgres" {
+		creationSchemas = append(creationSchemas, schema1)
+		creationSchemas = append(creationSchemas, schema3)
+		creationSchemas = append(creationSchemas, schema4)
+		creationSchemas = append(creationSchemas, schema5)
+		creationSchemas = append(creationSchemas, schema6)
+		creationSchemas = append(creationSchemas, schema7)
+		creationSchemas = append(creationSchemas, schema8)
+		creationSchemas = append(creationSchemas, schema9)
+		creationSchemas = append(creationSchemas, schema15)
+		creationSchemas = append(creationSchemas, schema10)
+		creationSchemas = append(creationSchemas, schema11)
+		creationSchemas = append(creationSchemas, schema12)
+		creationSchemas = append(creationSchemas, schema13)
+		creationSchemas = append(creationSchemas, schema14)
+		creationSchemas = append(creationSchemas, schema16)
+		creationSchemas = append(creationSchemas, schema17)
+		creationSchemas = append(creationSchemas, idxPostgres1)
+		creationSchemas = append(creationSchemas, idxPostgres2)
+		creationSchemas = append(creationSchemas, idxPostgres3)
+		creationSchemas = append(creationSchemas, idxPostgres4)
+		creationSchemas = append(creationSchemas, idxPostgres5)
+		creationSchemas = append(creationSchemas, idxPostgres6)
+		creationSchemas = append(creationSchemas, idxPostgres7)
+		creationSchemas = append(creationSchemas, idxPostgres8)
+		creationSchemas = append(creationSchemas, idxPostgres9)
+		creationSchemas = append(creationSchemas, idxPostgres10)
+		creationSchemas = append(creationSchemas, idxPostgres11)
+		creationSchemas = append(creationSchemas, idxPostgres12)
+		creationSchemas = append(creationSchemas, idxPostgres13)
+		creationSchemas = append(creationSchemas, idxPostgres14)
+	}
+
+	tx, err := globals.DbInstance.Beginx()
+	if err != nil {
+		logging.LogCrash(err)
+	}
+	for _, schema := range creationSchemas {
+		// fmt.Println(schema)
+		_, err2 := tx.Exec(schema)
+		if err2 != nil {
+			logging.LogCrash(err2)
+		}
+	}
+	err3 := tx.Commit()
+	if err3 != nil {
+		tx.Rollback()
+		logging.Log(1, fmt.Sprintf("CreateDatabase encountered an error when trying to commit to the database. Error is: %s", err3))
+		if strings.Contains(err3.Error(), "database is locked") {
+			logging.Log(1, fmt.Sprintf("This database seems to be locked. We'll sleep 10 seconds to give it the time it needs to recover. This mostly happens when the app has crashed and there is a hot journal - and SQLite is in the process of repairing the database. THE DATA IN THIS TRANSACTION WAS NOT COMMITTED. PLEASE RETRY."))
+			return errors.New("Database was locked. THE DATA IN THIS TRANSACTION WAS NOT COMMITTED. PLEASE RETRY.")
+		}
+		return err3
+	}
+	return migrateDatabase()
+}
+
+// migrateDatabase brings the databases created by the earlier versions up to date. The tables that were added later are created by their IF NOT EXISTS above, this is for the columns added to the tables that were already there.
+func migrateDatabase() error {
+	// Nodes.DirectMessagesLastCheckin came with direct messages. If we can select it, the database already has it.
+	_, err := globals.DbInstance.Exec("SELECT DirectMessagesLastCheckin FROM Nodes LIMIT 1")
+	if err == nil {
+		return nil
+	}
+	logging.Logf(1, "The nodes table predates direct messages. We're adding the direct messages checkin column.")
+	_, err2 := globals.DbInstance.Exec("ALTER TABLE Nodes ADD COLUMN DirectMessagesLastCheckin BIGINT NOT NULL DEFAULT 0")
+	if err2 != nil {
+		return errors.New(fmt.Sprintf("Adding the direct messages checkin column to the nodes table failed. Error: %v", err2))
+	}
+	return nil
+}
+
+func CheckDatabaseReady() {
+	DiagInsert := `REPLACE INTO Diagnostics
+  (
+    DbRoundtripTestField
+  ) VALUES (
+    :DbRoundtripTestField
+  )`
+	if globals.BackendConfig.GetDbEngine() == "postgres" {
+		// Postgres has no REPLACE. The table is emptied right before this, so a plain insert is enough.
+		DiagInsert = `INSERT INTO Diagnostics
+  (
+    DbRoundtripTestField
+  ) VALUES (
+    :DbRoundtripTestField
+  )`
+	}
+	DiagDelete := `DELETE FROM Diagnostics`
+	// We're using time.now because we don't want DB to optimise out the write and not test the connection that way. Get a random number between 0- 65535 for entry test.
+	ss := map[string]interface{}{"DbRoundtripTestField": toolbox.GetInsecureRand(65535)}
+	// First, remove everything.
+	tx, err := globals.DbInstance.Beginx()
+	if err != nil {
+		logging.LogCrash(err)
+	}
+	_, err2 := tx.Exec(DiagDelete)
+	if err2 != nil {
+		logging.LogCrash(err2)
+	}
+	tx.Commit()
+	// Second, insert a new item.
+	tx2, err3 := globals.DbInstance.Beginx()
+	if err3 != nil {
+		logging.LogCrash(err3)
+	}
+	_, err4 := tx2.NamedExec(DiagInsert, ss)
+	if err4 != nil {
+		logging.LogCrash(err4)
+	}
+	err5 := tx2.Commit()
+	if err5 != nil {
+		logging.LogCrash(err5)
+	}
+	logging.Logf(1, "Database is ready. Just verified by removing and inserting data successfully.")
+}
+
+// Insertion SQL code used by the writer.
+
+// var nodeInsert = `
+// REPLACE INTO Nodes
+// (
+//   Fingerprint, BoardsLastCheckin, ThreadsLastCheckin, PostsLastCheckin, VotesLastCheckin, KeysLastCheckin, TruststatesLastCheckin, AddressesLastCheckin
+// ) VALUES (
+//   :Fingerprint,
+//   MAX(:BoardsLastCheckin, IFNULL((SELECT BoardsLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
+//   MAX(:ThreadsLastCheckin, IFNULL((SELECT ThreadsLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
+//   MAX(:PostsLastCheckin, IFNULL((SELECT PostsLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
+//   MAX(:VotesLastCheckin, IFNULL((SELECT VotesLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
+//   MAX(:KeysLastCheckin, IFNULL((SELECT KeysLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
+//   MAX(:TruststatesLastCheckin, IFNULL((SELECT TruststatesLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0)),
+//   MAX(:AddressesLastCheckin, IFNULL((SELECT AddressesLastCheckin FROM Nodes WHERE Fingerprint = :Fingerprint), 0))
+// )
+// `
+
+// NodeInsert just inserts the Node details into the entry. This is mutable.
+var nodeInsert = `REPLACE INTO Nodes
+(
+  Fingerprint, BoardsLastCheckin, ThreadsLastCheckin, PostsLastCheckin,
+  VotesLastCheckin, KeysLastCheckin, TruststatesLastCheckin, AddressesLastCheckin,
+  DirectMessagesLastCheckin
+) VALUES (
+  :Fingerprint, :BoardsLastCheckin, :ThreadsLastCheckin, :PostsLastCheckin,
+  :VotesLastCheckin, :KeysLastCheckin, :TruststatesLastCheckin, :AddressesLastCheckin,
+  :DirectMessagesLastCheckin
+)`
+
+// peerReputationInsert saves what we know about a remote. The dispatcher keeps the counts in memory and writes them out whole, so this is a plain replace.
+var peerReputationInsert = `REPLACE INTO PeerReputations
+(
+  Location, Sublocation, Port, Successes, Failures, Timeouts, Received,
+  InvalidEntities, RejectedPages, PurgatoryDiscards, RecentOffences, LastOffence,
+  Bans, BannedUntil, LastUpdate
+) VALUES (
+  :Location, :Sublocation, :Port, :Successes, :Failures, :Timeouts, :Received,
+  :InvalidEntities, :RejectedPages, :PurgatoryDiscards, :RecentOffences, :LastOffence,
+  :Bans, :BannedUntil, :LastUpdate
+)`
+
+/*
+In the SQL statements below, the path of the execution is explained.
+(Y): LastReferenced insert
+BOARD > KEY(Y): Update board's key's lastreferenced timestamp.
+*/
+
+// ok, this is an attempted board insert
+
+/*
+  whats our exec model?
+
+  attempt to select existing board
+  if exists, use case to determine whether it validates
+  if it does, insert board, remove board owners, and add new board owners.
+
+*/
+
+/*
+   Important - If the board that is being inserted was not in the database before, we do not delete anything from BoardOwners. That is why the null cases are missing from this one.
+
+   THE ORDER OF THE STATEMENTS DO MATTER - The main insert needs to happen the LAST, because after the insert succeeds, all of these IS NULL constraints that we rely on will start to fail, since at that point the candidate is already in, and now the candidate comparison becomes a comparison with itself - which candidate will fail.
+*/
+
+var boardInsert_BoardsBoardOwners_DeletePriors = `
+WITH ExtantE(Creation, LastUpdate) AS (
+  SELECT Creation, LastUpdate
+  FROM Boards WHERE Fingerprint = :Fingerprint
+)
+DELETE FROM BoardOwners
+WHERE (
+  :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+  :LastUpdate > (SELECT Creation FROM ExtantE) AND
+  :LastUpdate > :Creation AND
+  BoardFingerprint = :Fingerprint
+);
+`
+var boardInsert_BoardsKey_LastReferencedUpdate = `
+/* Update ORIGINBOARD > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+SELECT Fingerprint, Creation, LastUpdate
+FROM Boards WHERE Fingerprint = :Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var boardInsert = `
+REPLACE INTO Boards
+SELECT Candidate.* FROM
+(SELECT :Fingerprint AS Fingerprint,
+       :Name AS Name,
+       :Owner AS Owner,
+       :OwnerPublicKey AS OwnerPublicKey,
+       :Description AS Description,
+       :Creation AS Creation,
+       :ProofOfWork AS ProofOfWork,
+       :Signature AS Signature,
+       :LastUpdate AS LastUpdate,
+       :UpdateProofOfWork AS UpdateProofOfWork,
+       :UpdateSignature AS UpdateSignature,
+       :LocalArrival AS LocalArrival,
+       :LastReferenced AS LastReferenced,
+       :EntityVersion AS EntityVersion,
+       :Language AS Language,
+       :Meta AS Meta,
+       :RealmId AS RealmId,
+       :EncrContent AS EncrContent
+       ) AS Candidate
+LEFT JOIN Boards ON Candidate.Fingerprint = Boards.Fingerprint
+WHERE (
+    Candidate.LastUpdate > Boards.LastUpdate AND
+    Candidate.LastUpdate > Boards.Creation AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Boards.Fingerprint IS NULL AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Boards.Fingerprint IS NULL AND
+    Candidate.LastUpdate = 0
+);
+`
+
+// BoardOwners are mutable, but the condition of mutation is handled in the application layer. The only place the REPLACE could trigger is change of Expiry and level. The BoardFingerprint and KeyFingerprint are identity columns, so anything with different data on those will be committed as a new item.
+/* This looks a little complex, but effectively, this is what is happening:
+INSERT IF
+    a) the board owner's board's candidate last update is newer than extant board's last update AND
+    b) the board owner's board's candidate last update is newer than extant board's creation AND
+    c) the board owner's board's candidate last update is newer than the candidate board's creation.
+  OR
+    a) This boardowner does not exist in DB (checking both primary keys) AND
+    b) the board owner's board's candidate last update is newer than the candidate board's creation.
+  OR
+    a) This boardowner does not exist in DB (checking both primary keys) AND
+    b) the board owner's board's candidate last update is zero, which means this object has never been updated.
+*/
+var boardOwnerInsert = `
+REPLACE INTO BoardOwners
+  WITH ExtantParent(Creation, LastUpdate) AS (
+    SELECT Creation, LastUpdate
+    FROM Boards WHERE Fingerprint = :BoardFingerprint
+  )
+  SELECT Candidate.* FROM
+  (SELECT :BoardFingerprint AS BoardFingerprint,
+          :KeyFingerprint AS KeyFingerprint,
+          :Expiry AS Expiry,
+          :Level AS Level
+          ) AS Candidate
+LEFT JOIN BoardOwners ON (
+  (Candidate.BoardFingerprint = BoardOwners.BoardFingerprint)
+  AND
+  (Candidate.KeyFingerprint = BoardOwners.KeyFingerprint)
+)
+WHERE (
+    :ParentBoardLastUpdate > (SELECT LastUpdate FROM ExtantParent) AND
+    :ParentBoardLastUpdate > (SELECT Creation FROM ExtantParent) AND
+    :ParentBoardLastUpdate > :ParentBoardCreation
+  OR
+    BoardOwners.BoardFingerprint IS NULL AND
+    BoardOwners.KeyFingerprint IS NULL AND
+    :ParentBoardLastUpdate > :ParentBoardCreation
+  OR
+    BoardOwners.BoardFingerprint IS NULL AND
+    BoardOwners.KeyFingerprint IS NULL AND
+    :ParentBoardLastUpdate = 0
+);
+`
+
+// Deletion for BoardOwner. This triggers when a person is no longer a moderator, etc. This is the only deletion here because nothing else really gets deleted.
+
+// Deletion for board owner has moved into the board insert. A successful board insertion (both update and creation) will delete all board owners for that board and will reinsert.
+
+var threadInsert_ThreadsBoard_LastReferencedUpdate = `
+/* Update ORIGINTHREAD > BOARD(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Threads WHERE Fingerprint = :Fingerprint
+)
+UPDATE Boards
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Board
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Board
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Board
+);
+`
+var threadInsert_ThreadsKey_LastReferencedUpdate = `
+/* Update ORIGINTHREAD > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Threads WHERE Fingerprint = :Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var threadInsert_ThreadsBoardsKey_LastReferencedUpdate = `
+/* Update ORIGINTHREAD > BOARD > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Threads WHERE Fingerprint = :Fingerprint
+),
+ParentBoard AS (
+  SELECT Owner FROM Boards
+  WHERE Fingerprint = :Board
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = (SELECT Owner FROM ParentBoard) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = (SELECT Owner FROM ParentBoard) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = (SELECT Owner FROM ParentBoard) AND
+    PublicKey = :OwnerPublicKey
+);
+`
+
+// Keeps the version being replaced in the thread history. Same conditions as the replacement below, so that only the versions that actually get overwritten make it in.
+var threadInsert_ThreadHistory_KeepPrior = `
+INSERT INTO ThreadHistory
+  SELECT Threads.*, :LocalArrival FROM Threads
+  WHERE (
+    Threads.Fingerprint = :Fingerprint AND
+    :LastUpdate > Threads.LastUpdate AND
+    :LastUpdate > Threads.Creation AND
+    NOT EXISTS (
+      SELECT 1 FROM ThreadHistory
+      WHERE ThreadHistory.Fingerprint = Threads.Fingerprint AND
+            ThreadHistory.LastUpdate = Threads.LastUpdate
+    )
+);
+`
+var threadInsert = `
+REPLACE INTO Threads
+  SELECT Candidate.* FROM
+  (SELECT :Fingerprint AS Fingerprint,
+          :Board AS Board,
+          :Name AS Name,
+          :Body AS Body,
+          :Link AS Link,
+          :Owner AS Owner,
+          :OwnerPublicKey AS OwnerPublicKey,
+          :Creation AS Creation,
+          :ProofOfWork AS ProofOfWork,
+          :Signature AS Signature,
+          :LastUpdate AS LastUpdate,
+          :UpdateProofOfWork AS UpdateProofOfWork,
+          :UpdateSignature AS UpdateSignature,
+          :LocalArrival AS LocalArrival,
+          :LastReferenced AS LastReferenced,
+          :EntityVersion AS EntityVersion,
+          :Meta AS Meta,
+          :RealmId AS RealmId,
+          :EncrContent AS EncrContent
+          ) AS Candidate
+LEFT JOIN Threads ON Candidate.Fingerprint = Threads.Fingerprint
+WHERE (
+    Candidate.LastUpdate > Threads.LastUpdate AND
+    Candidate.LastUpdate > Threads.Creation
+  OR
+    Threads.Fingerprint IS NULL AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Threads.Fingerprint IS NULL AND
+    Candidate.LastUpdate = 0
+);
+`
+
+var postInsert_PostsBoard_LastReferencedUpdate = `
+/* Update ORIGINPOST > BOARD(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+)
+UPDATE Boards
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Board
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Board
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Board
+);
+`
+var postInsert_PostsBoardsKey_LastReferencedUpdate = `
+/* Update ORIGINPOST > BOARD > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+),
+ParentBoard AS (
+  SELECT Owner FROM Boards
+  WHERE Fingerprint = :Board
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = (SELECT Owner FROM ParentBoard) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = (SELECT Owner FROM ParentBoard) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = (SELECT Owner FROM ParentBoard) AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var postInsert_PostsThread_LastReferencedUpdate = `
+/* Update ORIGINPOST > THREAD(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+)
+UPDATE Threads
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Thread
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Thread
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Thread
+);
+`
+var postInsert_PostsThreadsKey_LastReferencedUpdate = `
+/* Update ORIGINPOST > THREAD > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+),
+ParentThread AS (
+  SELECT Owner FROM Boards
+  WHERE Fingerprint = :Thread
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = (SELECT Owner FROM ParentThread) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = (SELECT Owner FROM ParentThread) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = (SELECT Owner FROM ParentThread) AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var postInsert_PostsKey_LastReferencedUpdate = `
+/* Update ORIGINPOST > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var postInsert_PostsPosts_Recursive_LastReferencedUpdate = `
+/*
+Update ORIGINPOST > POST (Y) > ...(Y) > POST(Y)
+(post's parent post chain, if any)
+*/
+
+WITH RECURSIVE ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+),
+ParentOf(Fingerprint, ParentPostFingerprint) AS (
+  SELECT Fingerprint, Parent FROM Posts
+),
+AncestorOf(Fingerprint) AS (
+  SELECT CASE
+  WHEN (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint) IS NULL
+  THEN (SELECT :Parent)
+  ELSE (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint)
+  END AS ParentPostFingerprint
+  UNION ALL
+  SELECT ParentPostFingerprint FROM ParentOf
+  JOIN AncestorOf
+  ON AncestorOf.Fingerprint=ParentOf.Fingerprint
+),
+FinalTable(Fingerprint) AS (
+  SELECT Posts.Fingerprint FROM AncestorOf, Posts
+  WHERE
+  AncestorOf.Fingerprint=Posts.Fingerprint
+)
+UPDATE Posts
+SET LastReferenced=:LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Posts.Fingerprint IN (SELECT Fingerprint FROM FinalTable)
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Posts.Fingerprint IN (SELECT Fingerprint FROM FinalTable)
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Posts.Fingerprint IN (SELECT Fingerprint FROM FinalTable)
+);
+`
+var postInsert_PostsPostsKeys_Recursive_LastReferencedUpdate = `
+/*
+Update ORIGINPOST > POST > KEY(Y)
+                  > POST > KEY(Y)
+                  > ...
+                  > POST > KEY(Y)
+(post's parent post chain's keys, if any)
+*/
+
+WITH RECURSIVE ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Posts WHERE Fingerprint = :Fingerprint
+),
+ParentOf(Fingerprint, ParentPostFingerprint, Owner) AS (
+  SELECT Fingerprint, Parent, Owner FROM Posts
+),
+AncestorOf(Fingerprint) AS (
+  SELECT CASE
+  WHEN (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint) IS NULL
+  THEN (SELECT :Parent)
+  ELSE (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint)
+  END AS ParentPostFingerprint
+  UNION ALL
+  SELECT ParentPostFingerprint FROM ParentOf
+  JOIN AncestorOf
+  ON AncestorOf.Fingerprint=ParentOf.Fingerprint
+),
+FinalTable(Fingerprint, Owner) AS (
+  SELECT Posts.Fingerprint, Posts.Owner FROM AncestorOf, Posts
+  WHERE
+  AncestorOf.Fingerprint=Posts.Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced=:LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    PublicKeys.Fingerprint IN (SELECT Owner FROM FinalTable) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    PublicKeys.Fingerprint IN (SELECT Owner FROM FinalTable) AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    PublicKeys.Fingerprint IN (SELECT Owner FROM FinalTable) AND
+    PublicKey = :OwnerPublicKey
+);
+`
+
+// Keeps the version being replaced in the post history. Same conditions as the replacement below, so that only the versions that actually get overwritten make it in.
+var postInsert_PostHistory_KeepPrior = `
+INSERT INTO PostHistory
+  SELECT Posts.*, :LocalArrival FROM Posts
+  WHERE (
+    Posts.Fingerprint = :Fingerprint AND
+    :LastUpdate > Posts.LastUpdate AND
+    :LastUpdate > Posts.Creation AND
+    NOT EXISTS (
+      SELECT 1 FROM PostHistory
+      WHERE PostHistory.Fingerprint = Posts.Fingerprint AND
+            PostHistory.LastUpdate = Posts.LastUpdate
+    )
+);
+`
+var postInsert = `
+REPLACE INTO Posts
+SELECT Candidate.* FROM
+(SELECT :Fingerprint AS Fingerprint,
+        :Board AS Board,
+        :Thread AS Thread,
+        :Parent AS Parent,
+        :Body AS Body,
+        :Owner AS Owner,
+        :OwnerPublicKey AS OwnerPublicKey,
+        :Creation AS Creation,
+        :ProofOfWork AS ProofOfWork,
+        :Signature AS Signature,
+        :LastUpdate AS LastUpdate,
+        :UpdateProofOfWork AS UpdateProofOfWork,
+        :UpdateSignature AS UpdateSignature,
+        :LocalArrival AS LocalArrival,
+        :LastReferenced AS LastReferenced,
+        :EntityVersion AS EntityVersion,
+        :Meta AS Meta,
+        :RealmId AS RealmId,
+        :EncrContent AS EncrContent
+        ) AS Candidate
+LEFT JOIN Posts ON Candidate.Fingerprint = Posts.Fingerprint
+WHERE (
+    Candidate.LastUpdate > Posts.LastUpdate AND
+    Candidate.LastUpdate > Posts.Creation
+  OR
+    Posts.Fingerprint IS NULL AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Posts.Fingerprint IS NULL AND
+    Candidate.LastUpdate = 0
+);
+`
+var voteInsert_VotesKey_LastReferencedUpdate = `
+/* Update ORIGINVOTE > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Votes WHERE Fingerprint = :Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var voteInsert = `
+REPLACE INTO Votes
+SELECT Candidate.* FROM
+(SELECT :Fingerprint AS Fingerprint,
+        :Board AS Board,
+        :Thread AS Thread,
+        :Target AS Target,
+        :Owner AS Owner,
+        :OwnerPublicKey AS OwnerPublicKey,
+        :TypeClass AS TypeClass,
+        :Type AS Type,
+        :Creation AS Creation,
+        :ProofOfWork AS ProofOfWork,
+        :Signature AS Signature,
+        :LastUpdate AS LastUpdate,
+        :UpdateProofOfWork AS UpdateProofOfWork,
+        :UpdateSignature AS UpdateSignature,
+        :LocalArrival AS LocalArrival,
+        :LastReferenced AS LastReferenced,
+        :EntityVersion AS EntityVersion,
+        :Meta AS Meta,
+        :RealmId AS RealmId,
+        :EncrContent AS EncrContent
+        ) AS Candidate
+LEFT JOIN Votes ON Candidate.Fingerprint = Votes.Fingerprint
+WHERE (
+    Candidate.LastUpdate > Votes.LastUpdate AND
+    Candidate.LastUpdate > Votes.Creation AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Votes.Fingerprint IS NULL AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Votes.Fingerprint IS NULL AND Candidate.LastUpdate = 0
+);
+`
+
+// above, you nede to basically do everything that a post does, update board, thread, key, target, board key, thread key, target key (target being a post or a thread, attempt both.)
+
+// actually scratch that. voting on something should only light up the key that created the vote, nothing else.
+
+var keyInsert = `
+REPLACE INTO PublicKeys
+SELECT Candidate.* FROM
+(SELECT :Fingerprint AS Fingerprint,
+        :Type AS Type,
+        :PublicKey AS PublicKey,
+        :Expiry AS Expiry,
+        :Name AS Name,
+        :Info AS Info,
+        :Creation AS Creation,
+        :ProofOfWork AS ProofOfWork,
+        :Signature AS Signature,
+        :LastUpdate AS LastUpdate,
+        :UpdateProofOfWork AS UpdateProofOfWork,
+        :UpdateSignature AS UpdateSignature,
+        :LocalArrival AS LocalArrival,
+        :LastReferenced AS LastReferenced,
+        :EntityVersion AS EntityVersion,
+        :Meta AS Meta,
+        :RealmId AS RealmId,
+        :EncrContent AS EncrContent
+        ) AS Candidate
+LEFT JOIN PublicKeys ON Candidate.Fingerprint = PublicKeys.Fingerprint
+WHERE (
+    Candidate.LastUpdate > PublicKeys.LastUpdate AND
+      Candidate.LastUpdate > PublicKeys.Creation
+  OR
+    PublicKeys.Fingerprint IS NULL AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    PublicKeys.Fingerprint IS NULL AND
+    Candidate.LastUpdate = 0
+);
+`
+var truststateInsert_TruststatesKey_LastReferencedUpdate = `
+/* Update ORIGINTS > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Truststates WHERE Fingerprint = :Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Owner AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var truststateInsert_TruststatesTargetKey_LastReferencedUpdate = `
+/* Update ORIGINTS > TARGET(KEY)(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Truststates WHERE Fingerprint = :Fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
+    :LastUpdate > (SELECT Creation FROM ExtantE) AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Target AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate > :Creation AND
+    Fingerprint = :Target AND
+    PublicKey = :OwnerPublicKey
+  OR
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    :LastUpdate = 0 AND
+    Fingerprint = :Target AND
+    PublicKey = :OwnerPublicKey
+);
+`
+var truststateInsert = `
+REPLACE INTO Truststates
+SELECT Candidate.* FROM
+(SELECT :Fingerprint AS Fingerprint,
+        :Target AS Target,
+        :Owner AS Owner,
+        :OwnerPublicKey AS OwnerPublicKey,
+        :TypeClass AS TypeClass,
+        :Type AS Type,
+        :Domain AS Domain,
+        :Expiry AS Expiry,
+        :Creation AS Creation,
+        :ProofOfWork AS ProofOfWork,
+        :Signature AS Signature,
+        :LastUpdate AS LastUpdate,
+        :UpdateProofOfWork AS UpdateProofOfWork,
+        :UpdateSignature AS UpdateSignature,
+        :LocalArrival AS LocalArrival,
+        :LastReferenced AS LastReferenced,
+        :EntityVersion AS EntityVersion,
+        :Meta AS Meta,
+        :RealmId AS RealmId,
+        :EncrContent AS EncrContent
+        ) AS Candidate
+LEFT JOIN Truststates ON Candidate.Fingerprint = Truststates.Fingerprint
+WHERE (
+    Candidate.LastUpdate > Truststates.LastUpdate AND
+    Candidate.LastUpdate > Truststates.Creation
+  OR
+    Truststates.Fingerprint IS NULL AND
+    Candidate.LastUpdate > Candidate.Creation
+  OR
+    Truststates.Fingerprint IS NULL AND
+    Candidate.LastUpdate = 0
+);
+`
+
+// Direct messages are immutable: the first copy of a message we receive is the one we keep, every later copy is ignored.
+var directMessageInsert_DirectMessagesKeys_LastReferencedUpdate = `
+/* Update ORIGINDM > KEY(Y), RECIPIENTKEY(Y) */
+UPDATE PublicKeys
+SET LastReferenced = :LastReferenced
+WHERE (
+    (SELECT count(1) FROM DirectMessages WHERE Fingerprint = :Fingerprint) = 0 AND
+    :LastUpdate = 0 AND
+    (Fingerprint = :Owner AND PublicKey = :OwnerPublicKey OR Fingerprint = :Recipient)
+);
+`
+var directMessageInsertMySQL = `
+INSERT IGNORE INTO DirectMessages
+(
+  Fingerprint,
+  Recipient,
+  Body,
+  Owner,
+  OwnerPublicKey,
+  Creation,
+  ProofOfWork,
+  Signature,
+  LastUpdate,
+  UpdateProofOfWork,
+  UpdateSignature,
+  LocalArrival,
+  LastReferenced,
+  EntityVersion,
+  Meta,
+  RealmId,
+  EncrContent
+) VALUES (
+  :Fingerprint,
+  :Recipient,
+  :Body,
+  :Owner,
+  :OwnerPublicKey,
+  :Creation,
+  :ProofOfWork,
+  :Signature,
+  :LastUpdate,
+  :UpdateProofOfWork,
+  :UpdateSignature,
+  :LocalArrival,
+  :LastReferenced,
+  :EntityVersion,
+  :Meta,
+  :RealmId,
+  :EncrContent
+)
+`
+var directMessageInsertSQLite = `
+INSERT OR IGNORE INTO DirectMessages
+(
+  Fingerprint,
+  Recipient,
+  Body,
+  Owner,
+  OwnerPublicKey,
+  Creation,
+  ProofOfWork,
+  Signature,
+  LastUpdate,
+  UpdateProofOfWork,
+  UpdateSignature,
+  LocalArrival,
+  LastReferenced,
+  EntityVersion,
+  Meta,
+  RealmId,
+  EncrContent
+) VALUES (
+  :Fingerprint,
+  :Recipient,
+  :Body,
+  :Owner,
+  :OwnerPublicKey,
+  :Creation,
+  :ProofOfWork,
+  :Signature,
+  :LastUpdate,
+  :UpdateProofOfWork,
+  :UpdateSignature,
+  :LocalArrival,
+  :LastReferenced,
+  :EntityVersion,
+  :Meta,
+  :RealmId,
+  :EncrContent
+)
+`
+
+// Address insert is immutable. This is used for when a node receives data from an address from a node that is not at the aforementioned address. In other words, an address object coming from a third party node not at that address cannot change an existing address saved in the database.
+var addressInsertMySQL = `
+INSERT IGNORE INTO Addresses
+(
+  Location,
+  Sublocation,
+  Port, IPType,
+  AddressType,
+  LastSuccessfulPing,
+  LastSuccessfulSync,
+  ProtocolVersionMajor,
+  ProtocolVersionMinor,
+  ClientVersionMajor,
+  ClientVersionMinor,
+  ClientVersionPatch,
+  ClientName,
+  EntityVersion,
+  RealmId,
+  LocalArrival
+) VALUES (
+  :Location,
+  :Sublocation,
+  :Port,
+  :IPType,
+  :AddressType,
+  :LastSuccessfulPing,
+  :LastSuccessfulSync,
+  :ProtocolVersionMajor,
+  :ProtocolVersionMinor,
+  :ClientVersionMajor,
+  :ClientVersionMinor,
+  :ClientVersionPatch,
+  :ClientName,
+  :EntityVersion,
+  :RealmId,
+  :LocalArrival
+)
+  `
+
+var addressInsertSQLite = `
+INSERT OR IGNORE INTO Addresses
+(
+  Location,
+  Sublocation,
+  Port,
+  IPType,
+  AddressType,
+  LastSuccessfulPing,
+  LastSuccessfulSync,
+  ProtocolVersionMajor,
+  ProtocolVersionMinor,
+  ClientVersionMajor,
+  ClientVersionMinor,
+  ClientVersionPatch,
+  ClientName,
+  EntityVersion,
+  RealmId,
+  LocalArrival
+) VALUES (
+  :Location,
+  :Sublocation,
+  :Port,
+  :IPType,
+  :AddressType,
+  :LastSuccessfulPing,
+  :LastSuccessfulSync,
+  :ProtocolVersionMajor,
+  :ProtocolVersionMinor,
+  :ClientVersionMajor,
+  :ClientVersionMinor,
+  :ClientVersionPatch,
+  :ClientName,
+  :EntityVersion,
+  :RealmId,
+  :LocalArrival
+)
+  `
+
+// Address update insert is mutable. This is used when the node connects to the address itself. Example: When a node connects to 256.253.231.123:8080, it will update the entry for that address with the data coming from the remote node. This is the only way to mutate an address object.
+
+/* In the WHERE clause below, we do not check for inequivalency of location, sublocation and port because we do the join based on that, they're guaranteed to be the same. We are also explicitly NOT checking for the local arrival being different because this is the entire point of this comparison: if the only thing that changes is the local arrival, we do NOT insert.
+
+Why?
+
+Because a static node's LastSuccessfulSync only gets updated to its timestamp. That means LastSuccessfulSync can actually be in the past. If we generate responses and caches based on LastSuccessfulSync, what happens is that it might actually be placed outside that cache's or responses scope, and in the scope of caches / responses that are already generated. Which effectively means it won't be communicated.
+
+If we do the opposite, and scan based on localarrival, then every time a node is communicated with, the localarrival will update. Even when there are no updates. That means when a static node is hit, it will be placed to the top of the list of addresses that node has connected to and will be served at the top. Considering that static nodes stay online 24/7, this will cause the addresses list that this node serves to be mostly / all made of static nodes.
+
+To prevent that, what we do is that every time LastSuccessfulSync changes in an address we update the localarrival, but ONLY then. If anything changes in an address except localarrival then we update localarrival as well as the changes, but if it's just localarrival change and nothing else, then we do not update.
+
+This solves our problem, because if a static node is updated, the LastSuccessfulSync will be set to its timestamp and the localarrival will be set to now. since we scan by localarrival, it is guaranteed to be included in the response, but so long as the LastSuccessfulSync does not change, that will be it. It won't update every time the node is connected to.
+*/
+var addressUpdateInsert = `
+REPLACE INTO Addresses
+SELECT Candidate.* FROM
+(SELECT :Location AS Location,
+        :Sublocation AS Sublocation,
+        :Port AS Port,
+        :IPType AS IPType,
+        :AddressType AS AddressType,
+        MAX(:LastSuccessfulPing,
+          IFNULL(
+            (SELECT LastSuccessfulPing FROM Addresses WHERE Location = :Location AND Sublocation=:Sublocation AND Port=:Port) , 0
+          )
+        )
+        AS LastSuccessfulPing,
+        MAX(:LastSuccessfulSync,
+          IFNULL(
+            (SELECT LastSuccessfulSync FROM Addresses WHERE Location = :Location AND Sublocation=:Sublocation AND Port=:Port) , 0
+          )
+        )
+        AS LastSuccessfulSync,
+        :ProtocolVersionMajor AS ProtocolVersionMajor,
+        :ProtocolVersionMinor AS ProtocolVersionMinor,
+        :ClientVersionMajor AS ClientVersionMajor,
+        :ClientVersionMinor AS ClientVersionMinor,
+        :ClientVersionPatch AS ClientVersionPatch,
+        :ClientName AS ClientName,
+        :LocalArrival AS LocalArrival,
+        :EntityVersion AS EntityVersion,
+        :RealmId AS RealmId
+        ) AS Candidate
+LEFT JOIN Addresses ON Candidate.Location = Addresses.Location AND Candidate.Sublocation = Addresses.Sublocation AND Candidate.Port = Addresses.Port
+WHERE (
+      Candidate.IPType != Addresses.IPType OR
+      Candidate.AddressType != Addresses.AddressType OR
+      Candidate.LastSuccessfulPing != Addresses.LastSuccessfulPing OR
+      Candidate.LastSuccessfulSync != Addresses.LastSuccessfulSync OR
+      Candidate.ProtocolVersionMajor != Addresses.ProtocolVersionMajor OR
+      Candidate.ProtocolVersionMinor != Addresses.ProtocolVersionMinor OR
+      Candidate.ClientVersionMajor != Addresses.ClientVersionMajor OR
+      Candidate.ClientVersionMinor != Addresses.ClientVersionMinor OR
+      Candidate.ClientVersionPatch != Addresses.ClientVersionPatch OR
+      Candidate.ClientName != Addresses.ClientName OR
+      Candidate.EntityVersion != Addresses.EntityVersion OR
+      Candidate.RealmId != Addresses.RealmId
+    OR
+      Addresses.Location IS NULL AND
+      Addresses.Sublocation IS NULL AND
+      Addresses.Port IS NULL
+);
+`
 
-// NodeInsert just inserts the Node details into the entry. This is mutable.
-var nodeInsert = `REPLACE INTO Nodes
+// Subprotocol insert is the part of address insertion series. This makes it so that we have a list of all subprotocols flying around.
+var subprotocolInsert = `
+REPLACE INTO Subprotocols
 (
-  Fingerprint, BoardsLastCheckin, ThreadsLastCheckin, PostsLastCheckin,
-  VotesLastCheckin, KeysLastCheckin, TruststatesLastCheckin, AddressesLastCheckin
+  Fingerprint,
+  Name,
+  VersionMajor,
+  VersionMinor,
+  SupportedEntities
 ) VALUES (
-  :Fingerprint, :BoardsLastCheckin, :ThreadsLastCheckin, :PostsLastCheckin,
-  :VotesLastCheckin, :KeysLastCheckin, :TruststatesLastCheckin, :AddressesLastCheckin
-)`
+  :Fingerprint,
+  :Name,
+  :VersionMajor,
+  :VersionMinor,
+  :SupportedEntities
+)
+  `
 
-/*
-In the SQL statements below, the path of the execution is explained.
-(Y): LastReferenced insert
-BOARD > KEY(Y): Update board's key's lastreferenced timestamp.
-*/
+// AddressSubprotocolInsert inserts into the many to many junction table so that we can keep track of the subprotocols an address uses.
 
-// ok, this is an attempted board insert
+var addressSubprotocolInsertMySQL = `
+INSERT IGNORE INTO AddressesSubprotocols
+(
+ AddressLocation,
+ AddressSublocation,
+ AddressPort,
+ SubprotocolFingerprint
+) VALUES (
+ :AddressLocation,
+ :AddressSublocation,
+ :AddressPort,
+ :SubprotocolFingerprint
+)
+`
+
+var addressSubprotocolInsertSQLite = `
+INSERT OR IGNORE INTO AddressesSubprotocols
+(
+  AddressLocation,
+  AddressSublocation,
+  AddressPort,
+  SubprotocolFingerprint
+) VALUES (
+  :AddressLocation,
+  :AddressSublocation,
+  :AddressPort,
+  :SubprotocolFingerprint
+)
+`
+
+// var addressPrune = `
+// DELETE FROM Addresses ORDER BY LastSuccessfulPing ASC, LastSuccessfulSync ASC LIMIT (MAX(-(? - (SELECT COUNT(*) FROM Addresses)),0))
+// `
+var addressPrune = `
+DELETE FROM Addresses
+WHERE (Location, Sublocation, Port)
+NOT IN
+  (
+  SELECT Location, Sublocation, Port
+  FROM Addresses
+  ORDER BY LastSuccessfulPing DESC,
+           LastSuccessfulSync DESC
+  LIMIT ?
+  )`
 
 /*
-  whats our exec model?
+  Postgres
 
-  attempt to select existing board
-  if exists, use case to determine whether it validates
-  if it does, insert board, remove board owners, and add new board owners.
+  Postgres has no REPLACE, and it does not do the implicit type juggling SQLite and MySQL do. So the statements below are the Postgres equivalents of the statements above, with the same gates, in the same order of execution. Three things differ:
 
-*/
+  a) Upserts are INSERT ... ON CONFLICT DO UPDATE, with the update gate in the WHERE of the conflict clause. The gate for new entries (LastUpdate > Creation, or LastUpdate = 0) sits in the WHERE of the SELECT that feeds the insert.
 
-/*
-   Important - If the board that is being inserted was not in the database before, we do not delete anything from BoardOwners. That is why the null cases are missing from this one.
+  b) Parameters that are compared against each other (:lastupdate > :creation) have no type Postgres can infer, and they'd be compared as text. Those, and the numeric columns fed through a SELECT, are cast explicitly.
 
-   THE ORDER OF THE STATEMENTS DO MATTER - The main insert needs to happen the LAST, because after the insert succeeds, all of these IS NULL constraints that we rely on will start to fail, since at that point the candidate is already in, and now the candidate comparison becomes a comparison with itself - which candidate will fail.
+  c) The named parameters are lowercase. The Postgres connection uses a lowercasing struct mapper (because Postgres returns lowercase column names), and named parameters are resolved through that same mapper.
 */
 
-var boardInsert_BoardsBoardOwners_DeletePriors = `
+var nodeInsertPostgres = `
+INSERT INTO Nodes
+(
+  Fingerprint, BoardsLastCheckin, ThreadsLastCheckin, PostsLastCheckin,
+  VotesLastCheckin, KeysLastCheckin, TruststatesLastCheckin, AddressesLastCheckin,
+  DirectMessagesLastCheckin
+) VALUES (
+  :fingerprint, :boardslastcheckin, :threadslastcheckin, :postslastcheckin,
+  :voteslastcheckin, :keyslastcheckin, :truststateslastcheckin, :addresseslastcheckin,
+  :directmessageslastcheckin
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  BoardsLastCheckin = EXCLUDED.BoardsLastCheckin,
+  ThreadsLastCheckin = EXCLUDED.ThreadsLastCheckin,
+  PostsLastCheckin = EXCLUDED.PostsLastCheckin,
+  VotesLastCheckin = EXCLUDED.VotesLastCheckin,
+  KeysLastCheckin = EXCLUDED.KeysLastCheckin,
+  TruststatesLastCheckin = EXCLUDED.TruststatesLastCheckin,
+  AddressesLastCheckin = EXCLUDED.AddressesLastCheckin,
+  DirectMessagesLastCheckin = EXCLUDED.DirectMessagesLastCheckin;
+`
+
+var peerReputationInsertPostgres = `
+INSERT INTO PeerReputations
+(
+  Location, Sublocation, Port, Successes, Failures, Timeouts, Received,
+  InvalidEntities, RejectedPages, PurgatoryDiscards, RecentOffences, LastOffence,
+  Bans, BannedUntil, LastUpdate
+) VALUES (
+  :location, :sublocation, :port, :successes, :failures, :timeouts, :received,
+  :invalidentities, :rejectedpages, :purgatorydiscards, :recentoffences, :lastoffence,
+  :bans, :banneduntil, :lastupdate
+)
+ON CONFLICT (Location, Sublocation, Port) DO UPDATE SET
+  Successes = EXCLUDED.Successes,
+  Failures = EXCLUDED.Failures,
+  Timeouts = EXCLUDED.Timeouts,
+  Received = EXCLUDED.Received,
+  InvalidEntities = EXCLUDED.InvalidEntities,
+  RejectedPages = EXCLUDED.RejectedPages,
+  PurgatoryDiscards = EXCLUDED.PurgatoryDiscards,
+  RecentOffences = EXCLUDED.RecentOffences,
+  LastOffence = EXCLUDED.LastOffence,
+  Bans = EXCLUDED.Bans,
+  BannedUntil = EXCLUDED.BannedUntil,
+  LastUpdate = EXCLUDED.LastUpdate;
+`
+
+var boardInsert_BoardsBoardOwners_DeletePriorsPostgres = `
 WITH ExtantE(Creation, LastUpdate) AS (
   SELECT Creation, LastUpdate
-  FROM Boards WHERE Fingerprint = :Fingerprint
+  FROM Boards WHERE Fingerprint = :fingerprint
 )
 DELETE FROM BoardOwners
 WHERE (
-  :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-  :LastUpdate > (SELECT Creation FROM ExtantE) AND
-  :LastUpdate > :Creation AND
-  BoardFingerprint = :Fingerprint
+  CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+  CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+  CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+  BoardFingerprint = :fingerprint
 );
 `
-var boardInsert_BoardsKey_LastReferencedUpdate = `
+var boardInsert_BoardsKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINBOARD > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
 SELECT Fingerprint, Creation, LastUpdate
-FROM Boards WHERE Fingerprint = :Fingerprint
+FROM Boards WHERE Fingerprint = :fingerprint
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
 );
 `
-var boardInsert = `
-REPLACE INTO Boards
-SELECT Candidate.* FROM
-(SELECT :Fingerprint AS Fingerprint,
-       :Name AS Name,
-       :Owner AS Owner,
-       :OwnerPublicKey AS OwnerPublicKey,
-       :Description AS Description,
-       :Creation AS Creation,
-       :ProofOfWork AS ProofOfWork,
-       :Signature AS Signature,
-       :LastUpdate AS LastUpdate,
-       :UpdateProofOfWork AS UpdateProofOfWork,
-       :UpdateSignature AS UpdateSignature,
-       :LocalArrival AS LocalArrival,
-       :LastReferenced AS LastReferenced,
-       :EntityVersion AS EntityVersion,
-       :Language AS Language,
-       :Meta AS Meta,
-       :RealmId AS RealmId,
-       :EncrContent AS EncrContent
-       ) AS Candidate
-LEFT JOIN Boards ON Candidate.Fingerprint = Boards.Fingerprint
+var boardInsertPostgres = `
+INSERT INTO Boards
+(
+  Fingerprint, Name, Owner, OwnerPublicKey, Description, Creation,
+  ProofOfWork, Signature, LastUpdate, UpdateProofOfWork, UpdateSignature,
+  LocalArrival, LastReferenced, EntityVersion, Language, Meta, RealmId,
+  EncrContent
+)
+SELECT :fingerprint,
+       :name,
+       :owner,
+       :ownerpublickey,
+       :description,
+       CAST(:creation AS BIGINT),
+       :proofofwork,
+       :signature,
+       CAST(:lastupdate AS BIGINT),
+       :updateproofofwork,
+       :updatesignature,
+       CAST(:localarrival AS BIGINT),
+       CAST(:lastreferenced AS BIGINT),
+       CAST(:entityversion AS SMALLINT),
+       :language,
+       :meta,
+       :realmid,
+       :encrcontent
 WHERE (
-    Candidate.LastUpdate > Boards.LastUpdate AND
-    Candidate.LastUpdate > Boards.Creation AND
-    Candidate.LastUpdate > Candidate.Creation
-  OR
-    Boards.Fingerprint IS NULL AND
-    Candidate.LastUpdate > Candidate.Creation
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT)
   OR
-    Boards.Fingerprint IS NULL AND
-    Candidate.LastUpdate = 0
+    CAST(:lastupdate AS BIGINT) = 0
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Name = EXCLUDED.Name,
+  Owner = EXCLUDED.Owner,
+  OwnerPublicKey = EXCLUDED.OwnerPublicKey,
+  Description = EXCLUDED.Description,
+  Creation = EXCLUDED.Creation,
+  ProofOfWork = EXCLUDED.ProofOfWork,
+  Signature = EXCLUDED.Signature,
+  LastUpdate = EXCLUDED.LastUpdate,
+  UpdateProofOfWork = EXCLUDED.UpdateProofOfWork,
+  UpdateSignature = EXCLUDED.UpdateSignature,
+  LocalArrival = EXCLUDED.LocalArrival,
+  LastReferenced = EXCLUDED.LastReferenced,
+  EntityVersion = EXCLUDED.EntityVersion,
+  Language = EXCLUDED.Language,
+  Meta = EXCLUDED.Meta,
+  RealmId = EXCLUDED.RealmId,
+  EncrContent = EXCLUDED.EncrContent
+WHERE (
+  EXCLUDED.LastUpdate > Boards.LastUpdate AND
+  EXCLUDED.LastUpdate > Boards.Creation AND
+  EXCLUDED.LastUpdate > EXCLUDED.Creation
 );
 `
-
-// BoardOwners are mutable, but the condition of mutation is handled in the application layer. The only place the REPLACE could trigger is change of Expiry and level. The BoardFingerprint and KeyFingerprint are identity columns, so anything with different data on those will be committed as a new item.
-/* This looks a little complex, but effectively, this is what is happening:
-INSERT IF
-    a) the board owner's board's candidate last update is newer than extant board's last update AND
-    b) the board owner's board's candidate last update is newer than extant board's creation AND
-    c) the board owner's board's candidate last update is newer than the candidate board's creation.
-  OR
-    a) This boardowner does not exist in DB (checking both primary keys) AND
-    b) the board owner's board's candidate last update is newer than the candidate board's creation.
-  OR
-    a) This boardowner does not exist in DB (checking both primary keys) AND
-    b) the board owner's board's candidate last update is zero, which means this object has never been updated.
-*/
-var boardOwnerInsert = `
-REPLACE INTO BoardOwners
-  WITH ExtantParent(Creation, LastUpdate) AS (
-    SELECT Creation, LastUpdate
-    FROM Boards WHERE Fingerprint = :BoardFingerprint
-  )
-  SELECT Candidate.* FROM
-  (SELECT :BoardFingerprint AS BoardFingerprint,
-          :KeyFingerprint AS KeyFingerprint,
-          :Expiry AS Expiry,
-          :Level AS Level
-          ) AS Candidate
-LEFT JOIN BoardOwners ON (
-  (Candidate.BoardFingerprint = BoardOwners.BoardFingerprint)
-  AND
-  (Candidate.KeyFingerprint = BoardOwners.KeyFingerprint)
+var boardOwnerInsertPostgres = `
+WITH ExtantParent(Creation, LastUpdate) AS (
+  SELECT Creation, LastUpdate
+  FROM Boards WHERE Fingerprint = :boardfingerprint
+)
+INSERT INTO BoardOwners
+(
+  BoardFingerprint, KeyFingerprint, Expiry, Level
 )
+SELECT :boardfingerprint,
+       :keyfingerprint,
+       CAST(:expiry AS BIGINT),
+       CAST(:level AS SMALLINT)
 WHERE (
-    :ParentBoardLastUpdate > (SELECT LastUpdate FROM ExtantParent) AND
-    :ParentBoardLastUpdate > (SELECT Creation FROM ExtantParent) AND
-    :ParentBoardLastUpdate > :ParentBoardCreation
+    CAST(:parentboardlastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantParent) AND
+    CAST(:parentboardlastupdate AS BIGINT) > (SELECT Creation FROM ExtantParent) AND
+    CAST(:parentboardlastupdate AS BIGINT) > CAST(:parentboardcreation AS BIGINT)
   OR
-    BoardOwners.BoardFingerprint IS NULL AND
-    BoardOwners.KeyFingerprint IS NULL AND
-    :ParentBoardLastUpdate > :ParentBoardCreation
+    CAST(:parentboardlastupdate AS BIGINT) > CAST(:parentboardcreation AS BIGINT)
   OR
-    BoardOwners.BoardFingerprint IS NULL AND
-    BoardOwners.KeyFingerprint IS NULL AND
-    :ParentBoardLastUpdate = 0
+    CAST(:parentboardlastupdate AS BIGINT) = 0
+)
+ON CONFLICT (BoardFingerprint, KeyFingerprint) DO UPDATE SET
+  Expiry = EXCLUDED.Expiry,
+  Level = EXCLUDED.Level
+WHERE (
+  CAST(:parentboardlastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantParent) AND
+  CAST(:parentboardlastupdate AS BIGINT) > (SELECT Creation FROM ExtantParent) AND
+  CAST(:parentboardlastupdate AS BIGINT) > CAST(:parentboardcreation AS BIGINT)
 );
 `
 
-// Deletion for BoardOwner. This triggers when a person is no longer a moderator, etc. This is the only deletion here because nothing else really gets deleted.
-
-// Deletion for board owner has moved into the board insert. A successful board insertion (both update and creation) will delete all board owners for that board and will reinsert.
-
-var threadInsert_ThreadsBoard_LastReferencedUpdate = `
+var threadInsert_ThreadsBoard_LastReferencedUpdatePostgres = `
 /* Update ORIGINTHREAD > BOARD(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Threads WHERE Fingerprint = :Fingerprint
+  FROM Threads WHERE Fingerprint = :fingerprint
 )
 UPDATE Boards
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Board
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :board
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Board
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :board
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Board
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :board
 );
 `
-var threadInsert_ThreadsKey_LastReferencedUpdate = `
+var threadInsert_ThreadsKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINTHREAD > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Threads WHERE Fingerprint = :Fingerprint
+  FROM Threads WHERE Fingerprint = :fingerprint
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
 );
 `
-var threadInsert_ThreadsBoardsKey_LastReferencedUpdate = `
+var threadInsert_ThreadsBoardsKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINTHREAD > BOARD > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Threads WHERE Fingerprint = :Fingerprint
+  FROM Threads WHERE Fingerprint = :fingerprint
 ),
 ParentBoard AS (
   SELECT Owner FROM Boards
-  WHERE Fingerprint = :Board
+  WHERE Fingerprint = :board
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Fingerprint = (SELECT Owner FROM ParentBoard) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Fingerprint = (SELECT Owner FROM ParentBoard) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
     Fingerprint = (SELECT Owner FROM ParentBoard) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
 );
 `
-var threadInsert = `
-REPLACE INTO Threads
-  SELECT Candidate.* FROM
-  (SELECT :Fingerprint AS Fingerprint,
-          :Board AS Board,
-          :Name AS Name,
-          :Body AS Body,
-          :Link AS Link,
-          :Owner AS Owner,
-          :OwnerPublicKey AS OwnerPublicKey,
-          :Creation AS Creation,
-          :ProofOfWork AS ProofOfWork,
-          :Signature AS Signature,
-          :LastUpdate AS LastUpdate,
-          :UpdateProofOfWork AS UpdateProofOfWork,
-          :UpdateSignature AS UpdateSignature,
-          :LocalArrival AS LocalArrival,
-          :LastReferenced AS LastReferenced,
-          :EntityVersion AS EntityVersion,
-          :Meta AS Meta,
-          :RealmId AS RealmId,
-          :EncrContent AS EncrContent
-          ) AS Candidate
-LEFT JOIN Threads ON Candidate.Fingerprint = Threads.Fingerprint
+var threadInsert_ThreadHistory_KeepPriorPostgres = `
+INSERT INTO ThreadHistory
+  SELECT Threads.*, CAST(:localarrival AS BIGINT) FROM Threads
+  WHERE (
+    Threads.Fingerprint = :fingerprint AND
+    CAST(:lastupdate AS BIGINT) > Threads.LastUpdate AND
+    CAST(:lastupdate AS BIGINT) > Threads.Creation
+  )
+ON CONFLICT (Fingerprint, LastUpdate) DO NOTHING;
+`
+var threadInsertPostgres = `
+INSERT INTO Threads
+(
+  Fingerprint, Board, Name, Body, Link, Owner, OwnerPublicKey, Creation,
+  ProofOfWork, Signature, LastUpdate, UpdateProofOfWork, UpdateSignature,
+  LocalArrival, LastReferenced, EntityVersion, Meta, RealmId, EncrContent
+)
+SELECT :fingerprint,
+       :board,
+       :name,
+       :body,
+       :link,
+       :owner,
+       :ownerpublickey,
+       CAST(:creation AS BIGINT),
+       :proofofwork,
+       :signature,
+       CAST(:lastupdate AS BIGINT),
+       :updateproofofwork,
+       :updatesignature,
+       CAST(:localarrival AS BIGINT),
+       CAST(:lastreferenced AS BIGINT),
+       CAST(:entityversion AS SMALLINT),
+       :meta,
+       :realmid,
+       :encrcontent
 WHERE (
-    Candidate.LastUpdate > Threads.LastUpdate AND
-    Candidate.LastUpdate > Threads.Creation
-  OR
-    Threads.Fingerprint IS NULL AND
-    Candidate.LastUpdate > Candidate.Creation
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT)
   OR
-    Threads.Fingerprint IS NULL AND
-    Candidate.LastUpdate = 0
+    CAST(:lastupdate AS BIGINT) = 0
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Board = EXCLUDED.Board,
+  Name = EXCLUDED.Name,
+  Body = EXCLUDED.Body,
+  Link = EXCLUDED.Link,
+  Owner = EXCLUDED.Owner,
+  OwnerPublicKey = EXCLUDED.OwnerPublicKey,
+  Creation = EXCLUDED.Creation,
+  ProofOfWork = EXCLUDED.ProofOfWork,
+  Signature = EXCLUDED.Signature,
+  LastUpdate = EXCLUDED.LastUpdate,
+  UpdateProofOfWork = EXCLUDED.UpdateProofOfWork,
+  UpdateSignature = EXCLUDED.UpdateSignature,
+  LocalArrival = EXCLUDED.LocalArrival,
+  LastReferenced = EXCLUDED.LastReferenced,
+  EntityVersion = EXCLUDED.EntityVersion,
+  Meta = EXCLUDED.Meta,
+  RealmId = EXCLUDED.RealmId,
+  EncrContent = EXCLUDED.EncrContent
+WHERE (
+  EXCLUDED.LastUpdate > Threads.LastUpdate AND
+  EXCLUDED.LastUpdate > Threads.Creation
 );
 `
 
-var postInsert_PostsBoard_LastReferencedUpdate = `
+var postInsert_PostsBoard_LastReferencedUpdatePostgres = `
 /* Update ORIGINPOST > BOARD(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 )
 UPDATE Boards
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Board
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :board
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Board
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :board
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Board
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :board
 );
 `
-var postInsert_PostsBoardsKey_LastReferencedUpdate = `
+var postInsert_PostsBoardsKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINPOST > BOARD > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 ),
 ParentBoard AS (
   SELECT Owner FROM Boards
-  WHERE Fingerprint = :Board
+  WHERE Fingerprint = :board
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Fingerprint = (SELECT Owner FROM ParentBoard) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Fingerprint = (SELECT Owner FROM ParentBoard) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
     Fingerprint = (SELECT Owner FROM ParentBoard) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
 );
 `
-var postInsert_PostsThread_LastReferencedUpdate = `
+var postInsert_PostsThread_LastReferencedUpdatePostgres = `
 /* Update ORIGINPOST > THREAD(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 )
 UPDATE Threads
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Thread
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :thread
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Thread
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :thread
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Thread
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :thread
 );
 `
-var postInsert_PostsThreadsKey_LastReferencedUpdate = `
+var postInsert_PostsThreadsKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINPOST > THREAD > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 ),
 ParentThread AS (
   SELECT Owner FROM Boards
-  WHERE Fingerprint = :Thread
+  WHERE Fingerprint = :thread
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Fingerprint = (SELECT Owner FROM ParentThread) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Fingerprint = (SELECT Owner FROM ParentThread) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
     Fingerprint = (SELECT Owner FROM ParentThread) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
 );
 `
-var postInsert_PostsKey_LastReferencedUpdate = `
+var postInsert_PostsKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINPOST > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
 );
 `
-var postInsert_PostsPosts_Recursive_LastReferencedUpdate = `
+var postInsert_PostsPosts_Recursive_LastReferencedUpdatePostgres = `
 /*
 Update ORIGINPOST > POST (Y) > ...(Y) > POST(Y)
 (post's parent post chain, if any)
@@ -1143,16 +2900,16 @@ Update ORIGINPOST > POST (Y) > ...(Y) > POST(Y)
 
 WITH RECURSIVE ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 ),
 ParentOf(Fingerprint, ParentPostFingerprint) AS (
   SELECT Fingerprint, Parent FROM Posts
 ),
 AncestorOf(Fingerprint) AS (
   SELECT CASE
-  WHEN (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint) IS NULL
-  THEN (SELECT :Parent)
-  ELSE (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint)
+  WHEN (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :fingerprint) IS NULL
+  THEN CAST(:parent AS VARCHAR(64))
+  ELSE (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :fingerprint)
   END AS ParentPostFingerprint
   UNION ALL
   SELECT ParentPostFingerprint FROM ParentOf
@@ -1165,23 +2922,23 @@ FinalTable(Fingerprint) AS (
   AncestorOf.Fingerprint=Posts.Fingerprint
 )
 UPDATE Posts
-SET LastReferenced=:LastReferenced
+SET LastReferenced=:lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Posts.Fingerprint IN (SELECT Fingerprint FROM FinalTable)
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     Posts.Fingerprint IN (SELECT Fingerprint FROM FinalTable)
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
     Posts.Fingerprint IN (SELECT Fingerprint FROM FinalTable)
 );
 `
-var postInsert_PostsPostsKeys_Recursive_LastReferencedUpdate = `
+var postInsert_PostsPostsKeys_Recursive_LastReferencedUpdatePostgres = `
 /*
 Update ORIGINPOST > POST > KEY(Y)
                   > POST > KEY(Y)
@@ -1192,16 +2949,16 @@ Update ORIGINPOST > POST > KEY(Y)
 
 WITH RECURSIVE ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Posts WHERE Fingerprint = :Fingerprint
+  FROM Posts WHERE Fingerprint = :fingerprint
 ),
 ParentOf(Fingerprint, ParentPostFingerprint, Owner) AS (
   SELECT Fingerprint, Parent, Owner FROM Posts
 ),
 AncestorOf(Fingerprint) AS (
   SELECT CASE
-  WHEN (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint) IS NULL
-  THEN (SELECT :Parent)
-  ELSE (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :Fingerprint)
+  WHEN (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :fingerprint) IS NULL
+  THEN CAST(:parent AS VARCHAR(64))
+  ELSE (SELECT ParentPostFingerprint FROM ParentOf WHERE Fingerprint = :fingerprint)
   END AS ParentPostFingerprint
   UNION ALL
   SELECT ParentPostFingerprint FROM ParentOf
@@ -1214,257 +2971,399 @@ FinalTable(Fingerprint, Owner) AS (
   AncestorOf.Fingerprint=Posts.Fingerprint
 )
 UPDATE PublicKeys
-SET LastReferenced=:LastReferenced
+SET LastReferenced=:lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     PublicKeys.Fingerprint IN (SELECT Owner FROM FinalTable) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
     PublicKeys.Fingerprint IN (SELECT Owner FROM FinalTable) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
     PublicKeys.Fingerprint IN (SELECT Owner FROM FinalTable) AND
-    PublicKey = :OwnerPublicKey
+    PublicKey = :ownerpublickey
 );
 `
-var postInsert = `
-REPLACE INTO Posts
-SELECT Candidate.* FROM
-(SELECT :Fingerprint AS Fingerprint,
-        :Board AS Board,
-        :Thread AS Thread,
-        :Parent AS Parent,
-        :Body AS Body,
-        :Owner AS Owner,
-        :OwnerPublicKey AS OwnerPublicKey,
-        :Creation AS Creation,
-        :ProofOfWork AS ProofOfWork,
-        :Signature AS Signature,
-        :LastUpdate AS LastUpdate,
-        :UpdateProofOfWork AS UpdateProofOfWork,
-        :UpdateSignature AS UpdateSignature,
-        :LocalArrival AS LocalArrival,
-        :LastReferenced AS LastReferenced,
-        :EntityVersion AS EntityVersion,
-        :Meta AS Meta,
-        :RealmId AS RealmId,
-        :EncrContent AS EncrContent
-        ) AS Candidate
-LEFT JOIN Posts ON Candidate.Fingerprint = Posts.Fingerprint
-WHERE (
-    Candidate.LastUpdate > Posts.LastUpdate AND
-    Candidate.LastUpdate > Posts.Creation
-  OR
-    Posts.Fingerprint IS NULL AND
-    Candidate.LastUpdate > Candidate.Creation
-  OR
-    Posts.Fingerprint IS NULL AND
-    Candidate.LastUpdate = 0
-);
+var postInsert_PostHistory_KeepPriorPostgres = `
+INSERT INTO PostHistory
+  SELECT Posts.*, CAST(:localarrival AS BIGINT) FROM Posts
+  WHERE (
+    Posts.Fingerprint = :fingerprint AND
+    CAST(:lastupdate AS BIGINT) > Posts.LastUpdate AND
+    CAST(:lastupdate AS BIGINT) > Posts.Creation
+  )
+ON CONFLICT (Fingerprint, LastUpdate) DO NOTHING;
 `
-var voteInsert_VotesKey_LastReferencedUpdate = `
-/* Update ORIGINVOTE > KEY(Y) */
-WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
-  SELECT Fingerprint, Creation, LastUpdate
-  FROM Votes WHERE Fingerprint = :Fingerprint
+var postInsertPostgres = `
+INSERT INTO Posts
+(
+  Fingerprint, Board, Thread, Parent, Body, Owner, OwnerPublicKey, Creation,
+  ProofOfWork, Signature, LastUpdate, UpdateProofOfWork, UpdateSignature,
+  LocalArrival, LastReferenced, EntityVersion, Meta, RealmId, EncrContent
 )
-UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SELECT :fingerprint,
+       :board,
+       :thread,
+       :parent,
+       :body,
+       :owner,
+       :ownerpublickey,
+       CAST(:creation AS BIGINT),
+       :proofofwork,
+       :signature,
+       CAST(:lastupdate AS BIGINT),
+       :updateproofofwork,
+       :updatesignature,
+       CAST(:localarrival AS BIGINT),
+       CAST(:lastreferenced AS BIGINT),
+       CAST(:entityversion AS SMALLINT),
+       :meta,
+       :realmid,
+       :encrcontent
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
-  OR
-    (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT)
   OR
-    (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
-);
-`
-var voteInsert = `
-REPLACE INTO Votes
-SELECT Candidate.* FROM
-(SELECT :Fingerprint AS Fingerprint,
-        :Board AS Board,
-        :Thread AS Thread,
-        :Target AS Target,
-        :Owner AS Owner,
-        :OwnerPublicKey AS OwnerPublicKey,
-        :TypeClass AS TypeClass,
-        :Type AS Type,
-        :Creation AS Creation,
-        :ProofOfWork AS ProofOfWork,
-        :Signature AS Signature,
-        :LastUpdate AS LastUpdate,
-        :UpdateProofOfWork AS UpdateProofOfWork,
-        :UpdateSignature AS UpdateSignature,
-        :LocalArrival AS LocalArrival,
-        :LastReferenced AS LastReferenced,
-        :EntityVersion AS EntityVersion,
-        :Meta AS Meta,
-        :RealmId AS RealmId,
-        :EncrContent AS EncrContent
-        ) AS Candidate
-LEFT JOIN Votes ON Candidate.Fingerprint = Votes.Fingerprint
+    CAST(:lastupdate AS BIGINT) = 0
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Board = EXCLUDED.Board,
+  Thread = EXCLUDED.Thread,
+  Parent = EXCLUDED.Parent,
+  Body = EXCLUDED.Body,
+  Owner = EXCLUDED.Owner,
+  OwnerPublicKey = EXCLUDED.OwnerPublicKey,
+  Creation = EXCLUDED.Creation,
+  ProofOfWork = EXCLUDED.ProofOfWork,
+  Signature = EXCLUDED.Signature,
+  LastUpdate = EXCLUDED.LastUpdate,
+  UpdateProofOfWork = EXCLUDED.UpdateProofOfWork,
+  UpdateSignature = EXCLUDED.UpdateSignature,
+  LocalArrival = EXCLUDED.LocalArrival,
+  LastReferenced = EXCLUDED.LastReferenced,
+  EntityVersion = EXCLUDED.EntityVersion,
+  Meta = EXCLUDED.Meta,
+  RealmId = EXCLUDED.RealmId,
+  EncrContent = EXCLUDED.EncrContent
 WHERE (
-    Candidate.LastUpdate > Votes.LastUpdate AND
-    Candidate.LastUpdate > Votes.Creation AND
-    Candidate.LastUpdate > Candidate.Creation
-  OR
-    Votes.Fingerprint IS NULL AND
-    Candidate.LastUpdate > Candidate.Creation
-  OR
-    Votes.Fingerprint IS NULL AND Candidate.LastUpdate = 0
+  EXCLUDED.LastUpdate > Posts.LastUpdate AND
+  EXCLUDED.LastUpdate > Posts.Creation
 );
 `
-
-// above, you nede to basically do everything that a post does, update board, thread, key, target, board key, thread key, target key (target being a post or a thread, attempt both.)
-
-// actually scratch that. voting on something should only light up the key that created the vote, nothing else.
-
-var keyInsert = `
-REPLACE INTO PublicKeys
-SELECT Candidate.* FROM
-(SELECT :Fingerprint AS Fingerprint,
-        :Type AS Type,
-        :PublicKey AS PublicKey,
-        :Expiry AS Expiry,
-        :Name AS Name,
-        :Info AS Info,
-        :Creation AS Creation,
-        :ProofOfWork AS ProofOfWork,
-        :Signature AS Signature,
-        :LastUpdate AS LastUpdate,
-        :UpdateProofOfWork AS UpdateProofOfWork,
-        :UpdateSignature AS UpdateSignature,
-        :LocalArrival AS LocalArrival,
-        :LastReferenced AS LastReferenced,
-        :EntityVersion AS EntityVersion,
-        :Meta AS Meta,
-        :RealmId AS RealmId,
-        :EncrContent AS EncrContent
-        ) AS Candidate
-LEFT JOIN PublicKeys ON Candidate.Fingerprint = PublicKeys.Fingerprint
+var voteInsert_VotesKey_LastReferencedUpdatePostgres = `
+/* Update ORIGINVOTE > KEY(Y) */
+WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
+  SELECT Fingerprint, Creation, LastUpdate
+  FROM Votes WHERE Fingerprint = :fingerprint
+)
+UPDATE PublicKeys
+SET LastReferenced = :lastreferenced
 WHERE (
-    Candidate.LastUpdate > PublicKeys.LastUpdate AND
-      Candidate.LastUpdate > PublicKeys.Creation
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
-    PublicKeys.Fingerprint IS NULL AND
-    Candidate.LastUpdate > Candidate.Creation
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
-    PublicKeys.Fingerprint IS NULL AND
-    Candidate.LastUpdate = 0
+    (SELECT Fingerprint FROM ExtantE) IS NULL AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
 );
 `
-var truststateInsert_TruststatesKey_LastReferencedUpdate = `
+var voteInsertPostgres = `
+INSERT INTO Votes
+(
+  Fingerprint, Board, Thread, Target, Owner, OwnerPublicKey, TypeClass, Type,
+  Creation, ProofOfWork, Signature, LastUpdate, UpdateProofOfWork,
+  UpdateSignature, LocalArrival, LastReferenced, EntityVersion, Meta, RealmId,
+  EncrContent
+)
+SELECT :fingerprint,
+       :board,
+       :thread,
+       :target,
+       :owner,
+       :ownerpublickey,
+       CAST(:typeclass AS SMALLINT),
+       CAST(:type AS SMALLINT),
+       CAST(:creation AS BIGINT),
+       :proofofwork,
+       :signature,
+       CAST(:lastupdate AS BIGINT),
+       :updateproofofwork,
+       :updatesignature,
+       CAST(:localarrival AS BIGINT),
+       CAST(:lastreferenced AS BIGINT),
+       CAST(:entityversion AS SMALLINT),
+       :meta,
+       :realmid,
+       :encrcontent
+WHERE (
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT)
+  OR
+    CAST(:lastupdate AS BIGINT) = 0
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Board = EXCLUDED.Board,
+  Thread = EXCLUDED.Thread,
+  Target = EXCLUDED.Target,
+  Owner = EXCLUDED.Owner,
+  OwnerPublicKey = EXCLUDED.OwnerPublicKey,
+  TypeClass = EXCLUDED.TypeClass,
+  Type = EXCLUDED.Type,
+  Creation = EXCLUDED.Creation,
+  ProofOfWork = EXCLUDED.ProofOfWork,
+  Signature = EXCLUDED.Signature,
+  LastUpdate = EXCLUDED.LastUpdate,
+  UpdateProofOfWork = EXCLUDED.UpdateProofOfWork,
+  UpdateSignature = EXCLUDED.UpdateSignature,
+  LocalArrival = EXCLUDED.LocalArrival,
+  LastReferenced = EXCLUDED.LastReferenced,
+  EntityVersion = EXCLUDED.EntityVersion,
+  Meta = EXCLUDED.Meta,
+  RealmId = EXCLUDED.RealmId,
+  EncrContent = EXCLUDED.EncrContent
+WHERE (
+  EXCLUDED.LastUpdate > Votes.LastUpdate AND
+  EXCLUDED.LastUpdate > Votes.Creation AND
+  EXCLUDED.LastUpdate > EXCLUDED.Creation
+);
+`
+var keyInsertPostgres = `
+INSERT INTO PublicKeys
+(
+  Fingerprint, Type, PublicKey, Expiry, Name, Info, Creation, ProofOfWork,
+  Signature, LastUpdate, UpdateProofOfWork, UpdateSignature, LocalArrival,
+  LastReferenced, EntityVersion, Meta, RealmId, EncrContent
+)
+SELECT :fingerprint,
+       :type,
+       :publickey,
+       CAST(:expiry AS BIGINT),
+       :name,
+       :info,
+       CAST(:creation AS BIGINT),
+       :proofofwork,
+       :signature,
+       CAST(:lastupdate AS BIGINT),
+       :updateproofofwork,
+       :updatesignature,
+       CAST(:localarrival AS BIGINT),
+       CAST(:lastreferenced AS BIGINT),
+       CAST(:entityversion AS SMALLINT),
+       :meta,
+       :realmid,
+       :encrcontent
+WHERE (
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT)
+  OR
+    CAST(:lastupdate AS BIGINT) = 0
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Type = EXCLUDED.Type,
+  PublicKey = EXCLUDED.PublicKey,
+  Expiry = EXCLUDED.Expiry,
+  Name = EXCLUDED.Name,
+  Info = EXCLUDED.Info,
+  Creation = EXCLUDED.Creation,
+  ProofOfWork = EXCLUDED.ProofOfWork,
+  Signature = EXCLUDED.Signature,
+  LastUpdate = EXCLUDED.LastUpdate,
+  UpdateProofOfWork = EXCLUDED.UpdateProofOfWork,
+  UpdateSignature = EXCLUDED.UpdateSignature,
+  LocalArrival = EXCLUDED.LocalArrival,
+  LastReferenced = EXCLUDED.LastReferenced,
+  EntityVersion = EXCLUDED.EntityVersion,
+  Meta = EXCLUDED.Meta,
+  RealmId = EXCLUDED.RealmId,
+  EncrContent = EXCLUDED.EncrContent
+WHERE (
+  EXCLUDED.LastUpdate > PublicKeys.LastUpdate AND
+  EXCLUDED.LastUpdate > PublicKeys.Creation
+);
+`
+var truststateInsert_TruststatesKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINTS > KEY(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Truststates WHERE Fingerprint = :Fingerprint
+  FROM Truststates WHERE Fingerprint = :fingerprint
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Owner AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :owner AND
+    PublicKey = :ownerpublickey
 );
 `
-var truststateInsert_TruststatesTargetKey_LastReferencedUpdate = `
+var truststateInsert_TruststatesTargetKey_LastReferencedUpdatePostgres = `
 /* Update ORIGINTS > TARGET(KEY)(Y) */
 WITH ExtantE(Fingerprint, Creation, LastUpdate) AS (
   SELECT Fingerprint, Creation, LastUpdate
-  FROM Truststates WHERE Fingerprint = :Fingerprint
+  FROM Truststates WHERE Fingerprint = :fingerprint
 )
 UPDATE PublicKeys
-SET LastReferenced = :LastReferenced
+SET LastReferenced = :lastreferenced
 WHERE (
-    :LastUpdate > (SELECT LastUpdate FROM ExtantE) AND
-    :LastUpdate > (SELECT Creation FROM ExtantE) AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Target AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > (SELECT LastUpdate FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > (SELECT Creation FROM ExtantE) AND
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :target AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate > :Creation AND
-    Fingerprint = :Target AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT) AND
+    Fingerprint = :target AND
+    PublicKey = :ownerpublickey
   OR
     (SELECT Fingerprint FROM ExtantE) IS NULL AND
-    :LastUpdate = 0 AND
-    Fingerprint = :Target AND
-    PublicKey = :OwnerPublicKey
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    Fingerprint = :target AND
+    PublicKey = :ownerpublickey
 );
 `
-var truststateInsert = `
-REPLACE INTO Truststates
-SELECT Candidate.* FROM
-(SELECT :Fingerprint AS Fingerprint,
-        :Target AS Target,
-        :Owner AS Owner,
-        :OwnerPublicKey AS OwnerPublicKey,
-        :TypeClass AS TypeClass,
-        :Type AS Type,
-        :Domain AS Domain,
-        :Expiry AS Expiry,
-        :Creation AS Creation,
-        :ProofOfWork AS ProofOfWork,
-        :Signature AS Signature,
-        :LastUpdate AS LastUpdate,
-        :UpdateProofOfWork AS UpdateProofOfWork,
-        :UpdateSignature AS UpdateSignature,
-        :LocalArrival AS LocalArrival,
-        :LastReferenced AS LastReferenced,
-        :EntityVersion AS EntityVersion,
-        :Meta AS Meta,
-        :RealmId AS RealmId,
-        :EncrContent AS EncrContent
-        ) AS Candidate
-LEFT JOIN Truststates ON Candidate.Fingerprint = Truststates.Fingerprint
+var truststateInsertPostgres = `
+INSERT INTO Truststates
+(
+  Fingerprint, Target, Owner, OwnerPublicKey, TypeClass, Type, Domain,
+  Expiry, Creation, ProofOfWork, Signature, LastUpdate, UpdateProofOfWork,
+  UpdateSignature, LocalArrival, LastReferenced, EntityVersion, Meta, RealmId,
+  EncrContent
+)
+SELECT :fingerprint,
+       :target,
+       :owner,
+       :ownerpublickey,
+       CAST(:typeclass AS SMALLINT),
+       CAST(:type AS SMALLINT),
+       :domain,
+       CAST(:expiry AS BIGINT),
+       CAST(:creation AS BIGINT),
+       :proofofwork,
+       :signature,
+       CAST(:lastupdate AS BIGINT),
+       :updateproofofwork,
+       :updatesignature,
+       CAST(:localarrival AS BIGINT),
+       CAST(:lastreferenced AS BIGINT),
+       CAST(:entityversion AS SMALLINT),
+       :meta,
+       :realmid,
+       :encrcontent
 WHERE (
-    Candidate.LastUpdate > Truststates.LastUpdate AND
-    Candidate.LastUpdate > Truststates.Creation
+    CAST(:lastupdate AS BIGINT) > CAST(:creation AS BIGINT)
   OR
-    Truststates.Fingerprint IS NULL AND
-    Candidate.LastUpdate > Candidate.Creation
-  OR
-    Truststates.Fingerprint IS NULL AND
-    Candidate.LastUpdate = 0
+    CAST(:lastupdate AS BIGINT) = 0
+)
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Target = EXCLUDED.Target,
+  Owner = EXCLUDED.Owner,
+  OwnerPublicKey = EXCLUDED.OwnerPublicKey,
+  TypeClass = EXCLUDED.TypeClass,
+  Type = EXCLUDED.Type,
+  Domain = EXCLUDED.Domain,
+  Expiry = EXCLUDED.Expiry,
+  Creation = EXCLUDED.Creation,
+  ProofOfWork = EXCLUDED.ProofOfWork,
+  Signature = EXCLUDED.Signature,
+  LastUpdate = EXCLUDED.LastUpdate,
+  UpdateProofOfWork = EXCLUDED.UpdateProofOfWork,
+  UpdateSignature = EXCLUDED.UpdateSignature,
+  LocalArrival = EXCLUDED.LocalArrival,
+  LastReferenced = EXCLUDED.LastReferenced,
+  EntityVersion = EXCLUDED.EntityVersion,
+  Meta = EXCLUDED.Meta,
+  RealmId = EXCLUDED.RealmId,
+  EncrContent = EXCLUDED.EncrContent
+WHERE (
+  EXCLUDED.LastUpdate > Truststates.LastUpdate AND
+  EXCLUDED.LastUpdate > Truststates.Creation
 );
 `
 
-// Address insert is immutable. This is used for when a node receives data from an address from a node that is not at the aforementioned address. In other words, an address object coming from a third party node not at that address cannot change an existing address saved in the database.
-var addressInsertMySQL = `
-INSERT IGNORE INTO Addresses
+var directMessageInsert_DirectMessagesKeys_LastReferencedUpdatePostgres = `
+/* Update ORIGINDM > KEY(Y), RECIPIENTKEY(Y) */
+UPDATE PublicKeys
+SET LastReferenced = :lastreferenced
+WHERE (
+    (SELECT count(1) FROM DirectMessages WHERE Fingerprint = :fingerprint) = 0 AND
+    CAST(:lastupdate AS BIGINT) = 0 AND
+    (Fingerprint = :owner AND PublicKey = :ownerpublickey OR Fingerprint = :recipient)
+);
+`
+var directMessageInsertPostgres = `
+INSERT INTO DirectMessages
+(
+  Fingerprint,
+  Recipient,
+  Body,
+  Owner,
+  OwnerPublicKey,
+  Creation,
+  ProofOfWork,
+  Signature,
+  LastUpdate,
+  UpdateProofOfWork,
+  UpdateSignature,
+  LocalArrival,
+  LastReferenced,
+  EntityVersion,
+  Meta,
+  RealmId,
+  EncrContent
+) VALUES (
+  :fingerprint,
+  :recipient,
+  :body,
+  :owner,
+  :ownerpublickey,
+  CAST(:creation AS BIGINT),
+  :proofofwork,
+  :signature,
+  CAST(:lastupdate AS BIGINT),
+  :updateproofofwork,
+  :updatesignature,
+  CAST(:localarrival AS BIGINT),
+  CAST(:lastreferenced AS BIGINT),
+  CAST(:entityversion AS SMALLINT),
+  :meta,
+  :realmid,
+  :encrcontent
+)
+ON CONFLICT DO NOTHING;
+`
+
+// Untrusted address insert, the Postgres equivalent of INSERT OR IGNORE.
+var addressInsertPostgres = `
+INSERT INTO Addresses
 (
   Location,
   Sublocation,
-  Port, IPType,
+  Port,
+  IPType,
   AddressType,
   LastSuccessfulPing,
   LastSuccessfulSync,
@@ -1478,27 +3377,29 @@ INSERT IGNORE INTO Addresses
   RealmId,
   LocalArrival
 ) VALUES (
-  :Location,
-  :Sublocation,
-  :Port,
-  :IPType,
-  :AddressType,
-  :LastSuccessfulPing,
-  :LastSuccessfulSync,
-  :ProtocolVersionMajor,
-  :ProtocolVersionMinor,
-  :ClientVersionMajor,
-  :ClientVersionMinor,
-  :ClientVersionPatch,
-  :ClientName,
-  :EntityVersion,
-  :RealmId,
-  :LocalArrival
+  :location,
+  :sublocation,
+  :port,
+  :iptype,
+  :addresstype,
+  :lastsuccessfulping,
+  :lastsuccessfulsync,
+  :protocolversionmajor,
+  :protocolversionminor,
+  :clientversionmajor,
+  :clientversionminor,
+  :clientversionpatch,
+  :clientname,
+  :entityversion,
+  :realmid,
+  :localarrival
 )
-  `
+ON CONFLICT DO NOTHING;
+`
 
-var addressInsertSQLite = `
-INSERT OR IGNORE INTO Addresses
+// Trusted address insert. See addressUpdateInsert for why LocalArrival alone does not trigger an update.
+var addressUpdateInsertPostgres = `
+INSERT INTO Addresses
 (
   Location,
   Sublocation,
@@ -1513,97 +3414,59 @@ INSERT OR IGNORE INTO Addresses
   ClientVersionMinor,
   ClientVersionPatch,
   ClientName,
+  LocalArrival,
   EntityVersion,
-  RealmId,
-  LocalArrival
+  RealmId
 ) VALUES (
-  :Location,
-  :Sublocation,
-  :Port,
-  :IPType,
-  :AddressType,
-  :LastSuccessfulPing,
-  :LastSuccessfulSync,
-  :ProtocolVersionMajor,
-  :ProtocolVersionMinor,
-  :ClientVersionMajor,
-  :ClientVersionMinor,
-  :ClientVersionPatch,
-  :ClientName,
-  :EntityVersion,
-  :RealmId,
-  :LocalArrival
+  :location,
+  :sublocation,
+  :port,
+  :iptype,
+  :addresstype,
+  :lastsuccessfulping,
+  :lastsuccessfulsync,
+  :protocolversionmajor,
+  :protocolversionminor,
+  :clientversionmajor,
+  :clientversionminor,
+  :clientversionpatch,
+  :clientname,
+  :localarrival,
+  :entityversion,
+  :realmid
 )
-  `
-
-// Address update insert is mutable. This is used when the node connects to the address itself. Example: When a node connects to 256.253.231.123:8080, it will update the entry for that address with the data coming from the remote node. This is the only way to mutate an address object.
-
-/* In the WHERE clause below, we do not check for inequivalency of location, sublocation and port because we do the join based on that, they're guaranteed to be the same. We are also explicitly NOT checking for the local arrival being different because this is the entire point of this comparison: if the only thing that changes is the local arrival, we do NOT insert.
-
-Why?
-
-Because a static node's LastSuccessfulSync only gets updated to its timestamp. That means LastSuccessfulSync can actually be in the past. If we generate responses and caches based on LastSuccessfulSync, what happens is that it might actually be placed outside that cache's or responses scope, and in the scope of caches / responses that are already generated. Which effectively means it won't be communicated.
-
-If we do the opposite, and scan based on localarrival, then every time a node is communicated with, the localarrival will update. Even when there are no updates. That means when a static node is hit, it will be placed to the top of the list of addresses that node has connected to and will be served at the top. Considering that static nodes stay online 24/7, this will cause the addresses list that this node serves to be mostly / all made of static nodes.
-
-To prevent that, what we do is that every time LastSuccessfulSync changes in an address we update the localarrival, but ONLY then. If anything changes in an address except localarrival then we update localarrival as well as the changes, but if it's just localarrival change and nothing else, then we do not update.
-
-This solves our problem, because if a static node is updated, the LastSuccessfulSync will be set to its timestamp and the localarrival will be set to now. since we scan by localarrival, it is guaranteed to be included in the response, but so long as the LastSuccessfulSync does not change, that will be it. It won't update every time the node is connected to.
-*/
-var addressUpdateInsert = `
-REPLACE INTO Addresses
-SELECT Candidate.* FROM
-(SELECT :Location AS Location,
-        :Sublocation AS Sublocation,
-        :Port AS Port,
-        :IPType AS IPType,
-        :AddressType AS AddressType,
-        MAX(:LastSuccessfulPing,
-          IFNULL(
-            (SELECT LastSuccessfulPing FROM Addresses WHERE Location = :Location AND Sublocation=:Sublocation AND Port=:Port) , 0
-          )
-        )
-        AS LastSuccessfulPing,
-        MAX(:LastSuccessfulSync,
-          IFNULL(
-            (SELECT LastSuccessfulSync FROM Addresses WHERE Location = :Location AND Sublocation=:Sublocation AND Port=:Port) , 0
-          )
-        )
-        AS LastSuccessfulSync,
-        :ProtocolVersionMajor AS ProtocolVersionMajor,
-        :ProtocolVersionMinor AS ProtocolVersionMinor,
-        :ClientVersionMajor AS ClientVersionMajor,
-        :ClientVersionMinor AS ClientVersionMinor,
-        :ClientVersionPatch AS ClientVersionPatch,
-        :ClientName AS ClientName,
-        :LocalArrival AS LocalArrival,
-        :EntityVersion AS EntityVersion,
-        :RealmId AS RealmId
-        ) AS Candidate
-LEFT JOIN Addresses ON Candidate.Location = Addresses.Location AND Candidate.Sublocation = Addresses.Sublocation AND Candidate.Port = Addresses.Port
+ON CONFLICT (Location, Sublocation, Port) DO UPDATE SET
+  IPType = EXCLUDED.IPType,
+  AddressType = EXCLUDED.AddressType,
+  LastSuccessfulPing = GREATEST(EXCLUDED.LastSuccessfulPing, Addresses.LastSuccessfulPing),
+  LastSuccessfulSync = GREATEST(EXCLUDED.LastSuccessfulSync, Addresses.LastSuccessfulSync),
+  ProtocolVersionMajor = EXCLUDED.ProtocolVersionMajor,
+  ProtocolVersionMinor = EXCLUDED.ProtocolVersionMinor,
+  ClientVersionMajor = EXCLUDED.ClientVersionMajor,
+  ClientVersionMinor = EXCLUDED.ClientVersionMinor,
+  ClientVersionPatch = EXCLUDED.ClientVersionPatch,
+  ClientName = EXCLUDED.ClientName,
+  LocalArrival = EXCLUDED.LocalArrival,
+  EntityVersion = EXCLUDED.EntityVersion,
+  RealmId = EXCLUDED.RealmId
 WHERE (
-      Candidate.IPType != Addresses.IPType OR
-      Candidate.AddressType != Addresses.AddressType OR
-      Candidate.LastSuccessfulPing != Addresses.LastSuccessfulPing OR
-      Candidate.LastSuccessfulSync != Addresses.LastSuccessfulSync OR
-      Candidate.ProtocolVersionMajor != Addresses.ProtocolVersionMajor OR
-      Candidate.ProtocolVersionMinor != Addresses.ProtocolVersionMinor OR
-      Candidate.ClientVersionMajor != Addresses.ClientVersionMajor OR
-      Candidate.ClientVersionMinor != Addresses.ClientVersionMinor OR
-      Candidate.ClientVersionPatch != Addresses.ClientVersionPatch OR
-      Candidate.ClientName != Addresses.ClientName OR
-      Candidate.EntityVersion != Addresses.EntityVersion OR
-      Candidate.RealmId != Addresses.RealmId
-    OR
-      Addresses.Location IS NULL AND
-      Addresses.Sublocation IS NULL AND
-      Addresses.Port IS NULL
+  EXCLUDED.IPType != Addresses.IPType OR
+  EXCLUDED.AddressType != Addresses.AddressType OR
+  GREATEST(EXCLUDED.LastSuccessfulPing, Addresses.LastSuccessfulPing) != Addresses.LastSuccessfulPing OR
+  GREATEST(EXCLUDED.LastSuccessfulSync, Addresses.LastSuccessfulSync) != Addresses.LastSuccessfulSync OR
+  EXCLUDED.ProtocolVersionMajor != Addresses.ProtocolVersionMajor OR
+  EXCLUDED.ProtocolVersionMinor != Addresses.ProtocolVersionMinor OR
+  EXCLUDED.ClientVersionMajor != Addresses.ClientVersionMajor OR
+  EXCLUDED.ClientVersionMinor != Addresses.ClientVersionMinor OR
+  EXCLUDED.ClientVersionPatch != Addresses.ClientVersionPatch OR
+  EXCLUDED.ClientName != Addresses.ClientName OR
+  EXCLUDED.EntityVersion != Addresses.EntityVersion OR
+  EXCLUDED.RealmId != Addresses.RealmId
 );
 `
 
-// Subprotocol insert is the part of address insertion series. This makes it so that we have a list of all subprotocols flying around.
-var subprotocolInsert = `
-REPLACE INTO Subprotocols
+var subprotocolInsertPostgres = `
+INSERT INTO Subprotocols
 (
   Fingerprint,
   Name,
@@ -1611,57 +3474,31 @@ REPLACE INTO Subprotocols
   VersionMinor,
   SupportedEntities
 ) VALUES (
-  :Fingerprint,
-  :Name,
-  :VersionMajor,
-  :VersionMinor,
-  :SupportedEntities
-)
-  `
-
-// AddressSubprotocolInsert inserts into the many to many junction table so that we can keep track of the subprotocols an address uses.
-
-var addressSubprotocolInsertMySQL = `
-INSERT IGNORE INTO AddressesSubprotocols
-(
- AddressLocation,
- AddressSublocation,
- AddressPort,
- SubprotocolFingerprint
-) VALUES (
- :AddressLocation,
- :AddressSublocation,
- :AddressPort,
- :SubprotocolFingerprint
+  :fingerprint,
+  :name,
+  :versionmajor,
+  :versionminor,
+  :supportedentities
 )
+ON CONFLICT (Fingerprint) DO UPDATE SET
+  Name = EXCLUDED.Name,
+  VersionMajor = EXCLUDED.VersionMajor,
+  VersionMinor = EXCLUDED.VersionMinor,
+  SupportedEntities = EXCLUDED.SupportedEntities;
 `
 
-var addressSubprotocolInsertSQLite = `
-INSERT OR IGNORE INTO AddressesSubprotocols
+var addressSubprotocolInsertPostgres = `
+INSERT INTO AddressesSubprotocols
 (
   AddressLocation,
   AddressSublocation,
   AddressPort,
   SubprotocolFingerprint
 ) VALUES (
-  :AddressLocation,
-  :AddressSublocation,
-  :AddressPort,
-  :SubprotocolFingerprint
+  :addresslocation,
+  :addresssublocation,
+  :addressport,
+  :subprotocolfingerprint
 )
+ON CONFLICT DO NOTHING;
 `
-
-// var addressPrune = `
-// DELETE FROM Addresses ORDER BY LastSuccessfulPing ASC, LastSuccessfulSync ASC LIMIT (MAX(-(? - (SELECT COUNT(*) FROM Addresses)),0))
-// `
-var addressPrune = `
-DELETE FROM Addresses
-WHERE (Location, Sublocation, Port)
-NOT IN
-  (
-  SELECT Location, Sublocation, Port
-  FROM Addresses
-  ORDER BY LastSuccessfulPing DESC,
-           LastSuccessfulSync DESC
-  LIMIT ?
-  )`
@@ -0,0 +1,148 @@
+package persistence_test
+
+// These test the PoW strengths that threads and posts need when their board declares its own, with the boards and the content found in the database.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/io/persistence"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/metaparse"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+// powBoard is a board that requires a PoW stronger than the node configs from its threads and posts, since an hour ago.
+func powBoard(t *testing.T, fp string) api.Board {
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	meta, err := metaparse.CreateMetaString(&metaparse.BoardMeta{
+		MinimumPoW: &metaparse.BoardPoW{
+			Thread:       s.Thread + 3,
+			ThreadUpdate: s.ThreadUpdate + 3,
+			Post:         s.Post + 3,
+			PostUpdate:   s.PostUpdate + 3,
+			Since:        time.Now().Unix() - 3600,
+		},
+	})
+	if err != nil {
+		t.Fatalf("The board meta could not be created. Error: %v", err)
+	}
+	var b api.Board
+	b.Fingerprint = api.Fingerprint(fp)
+	b.Name = "pow board"
+	b.Creation = 1
+	b.ProofOfWork = "pow"
+	b.Owner = "2389749283fasdf"
+	b.OwnerPublicKey = "public key"
+	b.Language = "en"
+	b.Meta = meta
+	b.EntityVersion = 1
+	b.SetVerified(true)
+	return b
+}
+
+func insert(t *testing.T, entities ...interface{}) {
+	if _, err := persistence.BatchInsert(entities); err != nil {
+		t.Fatalf("The fixtures could not be inserted. Error: %v", err)
+	}
+}
+
+func storedThread(boardfp, fp string, creation, lastUpdate api.Timestamp) api.Thread {
+	var th api.Thread
+	th.Fingerprint = api.Fingerprint(fp)
+	th.Board = api.Fingerprint(boardfp)
+	th.Name = "stored thread"
+	th.Owner = "2389749283fasdf"
+	th.OwnerPublicKey = "public key"
+	th.Creation = creation
+	th.LastUpdate = lastUpdate
+	th.ProofOfWork = "pow"
+	th.EntityVersion = 1
+	th.SetVerified(true)
+	return th
+}
+
+// countBoardLookups counts the board lookups that reach the database, until the returned function is called.
+func countBoardLookups(count *int) func() {
+	prior := api.BoardLookup
+	api.BoardLookup = func(boardfp api.Fingerprint) (api.Board, bool) {
+		*count++
+		return prior(boardfp)
+	}
+	return func() { api.BoardLookup = prior }
+}
+
+// Tests
+
+func TestBoardPoW_BoardSeenFirst_Success(t *testing.T) {
+	b := powBoard(t, "pow board seen first")
+	insert(t, b)
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	now := api.Timestamp(time.Now().Unix())
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "new thread", now, false); strength != s.Thread+3 {
+		t.Errorf("A new thread should need the strength the board declares. Expected: %v, Got: %v", s.Thread+3, strength)
+	}
+	if strength := api.PostPoWStrength(b.Fingerprint, "new post", now, true); strength != s.PostUpdate+3 {
+		t.Errorf("A new post update should need the strength the board declares. Expected: %v, Got: %v", s.PostUpdate+3, strength)
+	}
+}
+
+func TestBoardPoW_BackdatedThread_Fail(t *testing.T) {
+	b := powBoard(t, "pow board backdated")
+	insert(t, b)
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	// The thread claims to be from before the declaration, but we've never seen it.
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "backdated thread", 1, false); strength != s.Thread+3 {
+		t.Errorf("A backdated thread should need the strength the board declares. Expected: %v, Got: %v", s.Thread+3, strength)
+	}
+	if strength := api.PostPoWStrength(b.Fingerprint, "backdated post", 1, false); strength != s.Post+3 {
+		t.Errorf("A backdated post should need the strength the board declares. Expected: %v, Got: %v", s.Post+3, strength)
+	}
+}
+
+func TestBoardPoW_StoredBeforeDeclaration_Success(t *testing.T) {
+	b := powBoard(t, "pow board stored")
+	insert(t, b, storedThread(string(b.Fingerprint), "thread stored before the declaration", 1, 5))
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "thread stored before the declaration", 1, false); strength != s.Thread {
+		t.Errorf("A thread we had from before the declaration should need only the node configs. Expected: %v, Got: %v", s.Thread, strength)
+	}
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "thread stored before the declaration", 5, true); strength != s.ThreadUpdate {
+		t.Errorf("The update we have of the thread should need only the node configs. Expected: %v, Got: %v", s.ThreadUpdate, strength)
+	}
+	// A newer update that claims to be from before the declaration is new to us, so it doesn't get the pass.
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "thread stored before the declaration", 6, true); strength != s.ThreadUpdate+3 {
+		t.Errorf("A backdated update of a stored thread should need the strength the board declares. Expected: %v, Got: %v", s.ThreadUpdate+3, strength)
+	}
+}
+
+func TestBoardPoW_UnknownBoard_Success(t *testing.T) {
+	lookups := 0
+	defer countBoardLookups(&lookups)()
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	now := api.Timestamp(time.Now().Unix())
+	for i := 0; i < 3; i++ {
+		if strength := api.ThreadPoWStrength("unknown pow board", "thread in unknown board", now, false); strength != s.Thread {
+			t.Errorf("A thread in a board we don't have should need only the node configs. Expected: %v, Got: %v", s.Thread, strength)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("A board that can't be found should be remembered as such, and not looked up again right away. Lookups: %v", lookups)
+	}
+}
+
+func TestBoardPoW_BoardSeenLater_Success(t *testing.T) {
+	b := powBoard(t, "pow board seen later")
+	s := globals.BackendConfig.GetMinimumPoWStrengths()
+	now := api.Timestamp(time.Now().Unix())
+	// The thread arrives before its board.
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "thread before board", now, false); strength != s.Thread {
+		t.Errorf("Before the board arrives, only the node configs should apply. Expected: %v, Got: %v", s.Thread, strength)
+	}
+	// The board arrives and passes the verification, which registers its declaration. The board not being found before this doesn't stand in the way.
+	api.RegisterBoardPoW(&b)
+	if strength := api.ThreadPoWStrength(b.Fingerprint, "thread after board", now, false); strength != s.Thread+3 {
+		t.Errorf("After the board arrives, its declaration should apply. Expected: %v, Got: %v", s.Thread+3, strength)
+	}
+}
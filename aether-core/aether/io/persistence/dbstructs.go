@@ -114,6 +114,20 @@ type DbPost struct {
 	DbUpdateable
 }
 
+// History
+
+// DbThreadRevision is a prior version of a thread that was replaced by an update. Superseded is the local arrival of the version that replaced it.
+type DbThreadRevision struct {
+	DbThread
+	Superseded api.Timestamp `db:"Superseded"`
+}
+
+// DbPostRevision is a prior version of a post that was replaced by an update.
+type DbPostRevision struct {
+	DbPost
+	Superseded api.Timestamp `db:"Superseded"`
+}
+
 type DbVote struct {
 	Fingerprint    api.Fingerprint `db:"Fingerprint"`
 	Board          api.Fingerprint `db:"Board"`
@@ -169,6 +183,22 @@ type DbTruststate struct {
 	DbUpdateable
 }
 
+type DbDirectMessage struct {
+	Fingerprint    api.Fingerprint `db:"Fingerprint"`
+	Recipient      api.Fingerprint `db:"Recipient"`
+	Body           string          `db:"Body"`
+	Owner          api.Fingerprint `db:"Owner"`
+	OwnerPublicKey string          `db:"OwnerPublicKey"`
+	LocalArrival   api.Timestamp   `db:"LocalArrival"`
+	LastReferenced api.Timestamp   `db:"LastReferenced"`
+	EntityVersion  int             `db:"EntityVersion"`
+	Meta           string          `db:"Meta"`
+	RealmId        api.Fingerprint `db:"RealmId"`
+	EncrContent    string          `db:"EncrContent"`
+	DbProvable
+	DbUpdateable
+}
+
 type DbAddress struct {
 	Location             api.Location  `db:"Location"`
 	Sublocation          api.Location  `db:"Sublocation"`
@@ -191,14 +221,34 @@ type DbAddress struct {
 
 // Non-communicating entities
 type DbNode struct {
-	Fingerprint            api.Fingerprint `db:"Fingerprint"`
-	BoardsLastCheckin      api.Timestamp   `db:"BoardsLastCheckin"`
-	ThreadsLastCheckin     api.Timestamp   `db:"ThreadsLastCheckin"`
-	PostsLastCheckin       api.Timestamp   `db:"PostsLastCheckin"`
-	VotesLastCheckin       api.Timestamp   `db:"VotesLastCheckin"`
-	KeysLastCheckin        api.Timestamp   `db:"KeysLastCheckin"`
-	TruststatesLastCheckin api.Timestamp   `db:"TruststatesLastCheckin"`
-	AddressesLastCheckin   api.Timestamp   `db:"AddressesLastCheckin"`
+	Fingerprint               api.Fingerprint `db:"Fingerprint"`
+	BoardsLastCheckin         api.Timestamp   `db:"BoardsLastCheckin"`
+	ThreadsLastCheckin        api.Timestamp   `db:"ThreadsLastCheckin"`
+	PostsLastCheckin          api.Timestamp   `db:"PostsLastCheckin"`
+	VotesLastCheckin          api.Timestamp   `db:"VotesLastCheckin"`
+	KeysLastCheckin           api.Timestamp   `db:"KeysLastCheckin"`
+	TruststatesLastCheckin    api.Timestamp   `db:"TruststatesLastCheckin"`
+	AddressesLastCheckin      api.Timestamp   `db:"AddressesLastCheckin"`
+	DirectMessagesLastCheckin api.Timestamp   `db:"DirectMessagesLastCheckin"`
+}
+
+// DbPeerReputation is what we know about how a remote behaved in our syncs with it. It's keyed the same way as the address.
+type DbPeerReputation struct {
+	Location          api.Location  `db:"Location"`
+	Sublocation       api.Location  `db:"Sublocation"`
+	Port              uint16        `db:"Port"`
+	Successes         int64         `db:"Successes"`
+	Failures          int64         `db:"Failures"`
+	Timeouts          int64         `db:"Timeouts"`
+	Received          int64         `db:"Received"`
+	InvalidEntities   int64         `db:"InvalidEntities"`
+	RejectedPages     int64         `db:"RejectedPages"`
+	PurgatoryDiscards int64         `db:"PurgatoryDiscards"`
+	RecentOffences    int           `db:"RecentOffences"`
+	LastOffence       api.Timestamp `db:"LastOffence"`
+	Bans              int           `db:"Bans"`
+	BannedUntil       api.Timestamp `db:"BannedUntil"`
+	LastUpdate        api.Timestamp `db:"LastUpdate"`
 }
 
 // Return types of APIToDB. This is necessary because some API objects, when converted to their DB form, return more than one DB object.
@@ -413,6 +463,32 @@ func APItoDB(object interface{}, ts time.Time) (interface{}, error) {
 		dbObj.Domain = obj.Domain
 		return dbObj, nil
 
+	case api.DirectMessage:
+		if !obj.GetVerified() {
+			return DbDirectMessage{}, errors.New(fmt.Sprintf("This Api entity failed verification (or the verification hasn't been run on it), thus is denied conversion to the Db entity. Entity %#v", obj))
+		}
+		var dbObj DbDirectMessage
+		dbObj.Fingerprint = obj.Fingerprint
+		dbObj.Recipient = obj.Recipient
+		dbObj.Body = obj.Body
+		dbObj.Owner = obj.Owner
+		dbObj.OwnerPublicKey = obj.OwnerPublicKey
+		dbObj.LocalArrival = api.Timestamp(now)
+		dbObj.LastReferenced = api.Timestamp(now)
+		dbObj.EntityVersion = obj.EntityVersion
+		dbObj.Meta = obj.Meta
+		dbObj.RealmId = obj.RealmId
+		dbObj.EncrContent = obj.EncrContent
+		// Provable set
+		dbObj.Creation = obj.Creation
+		dbObj.ProofOfWork = obj.ProofOfWork
+		dbObj.Signature = obj.Signature
+		// Updateable set
+		dbObj.LastUpdate = obj.LastUpdate
+		dbObj.UpdateProofOfWork = obj.UpdateProofOfWork
+		dbObj.UpdateSignature = obj.UpdateSignature
+		return dbObj, nil
+
 	case api.Address:
 		if !obj.GetVerified() {
 			return AddressPack{}, errors.New(fmt.Sprintf("This Api entity failed verification (or the verification hasn't been run on it), thus is denied conversion to the Db entity. Entity %#v", obj))
@@ -628,6 +704,27 @@ func DBtoAPI(object interface{}) (interface{}, error) {
 		apiObj.Domain = obj.Domain
 		return apiObj, nil
 
+	case DbDirectMessage:
+		var apiObj api.DirectMessage
+		apiObj.Fingerprint = obj.Fingerprint
+		apiObj.Recipient = obj.Recipient
+		apiObj.Body = obj.Body
+		apiObj.Owner = obj.Owner
+		apiObj.OwnerPublicKey = obj.OwnerPublicKey
+		apiObj.EntityVersion = obj.EntityVersion
+		apiObj.Meta = obj.Meta
+		apiObj.RealmId = obj.RealmId
+		apiObj.EncrContent = obj.EncrContent
+		// Provable set
+		apiObj.Creation = obj.Creation
+		apiObj.ProofOfWork = obj.ProofOfWork
+		apiObj.Signature = obj.Signature
+		// Updateable set
+		apiObj.LastUpdate = obj.LastUpdate
+		apiObj.UpdateProofOfWork = obj.UpdateProofOfWork
+		apiObj.UpdateSignature = obj.UpdateSignature
+		return apiObj, nil
+
 	case DbAddress:
 		// Corner case
 		var apiObj api.Address
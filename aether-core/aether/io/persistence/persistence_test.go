@@ -39,7 +39,7 @@ func setup() {
 
 func teardown() {
 	// The tests assume a nonexistent database. If this is disabled, your tests will fail!
-	// persistence.DeleteDatabase()
+	persistence.DeleteDatabase()
 	// Mind that this isn't as optional as you think. There are some tests, especially those related to updates below that need the database to be clean. Because we automatically switch to update when something is there, it breaks the creation tests (they end up being updates.)
 }
 
@@ -120,6 +120,8 @@ func createNodeData() {
 	b2.Name = "alice"
 	b2.Creation = 1
 	b2.ProofOfWork = "pow"
+	b2.Owner = k.Fingerprint
+	b2.OwnerPublicKey = k.Key
 	bo2.KeyFingerprint = k.Fingerprint
 	bo2.Level = 1
 	b2.BoardOwners = append(b2.BoardOwners, bo2)
@@ -127,6 +129,8 @@ func createNodeData() {
 	t.Fingerprint = "my thread fingerprint"
 	t.Board = b.Fingerprint
 	t.Name = "alice"
+	t.Owner = k.Fingerprint
+	t.OwnerPublicKey = k.Key
 	t.Creation = 1
 	t.ProofOfWork = "pow"
 
@@ -135,6 +139,8 @@ func createNodeData() {
 	p.Thread = t.Fingerprint
 	p.Parent = t.Fingerprint
 	p.Body = "a"
+	p.Owner = k.Fingerprint
+	p.OwnerPublicKey = k.Key
 	p.Creation = 1
 	p.ProofOfWork = "pow"
 
@@ -213,7 +219,7 @@ func createNodeData() {
 
 func TestRead_Success(t *testing.T) {
 	fp := api.Fingerprint("my board fingerprint")
-	resp, err := persistence.Read("boards", []api.Fingerprint{api.Fingerprint(fp)}, []string{}, 0, 0)
+	resp, err := persistence.Read("boards", []api.Fingerprint{api.Fingerprint(fp)}, []string{}, 0, 0, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -226,7 +232,7 @@ func TestRead_Success(t *testing.T) {
 
 func TestRead_SingleEmbed_BoardEmbedThread_Success(t *testing.T) {
 	fp := api.Fingerprint("my board fingerprint")
-	resp, err := persistence.Read("boards", []api.Fingerprint{api.Fingerprint(fp)}, []string{"threads"}, 0, 0)
+	resp, err := persistence.Read("boards", []api.Fingerprint{api.Fingerprint(fp)}, []string{"threads"}, 0, 0, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -296,7 +302,7 @@ func TestRead_MultiEmbed_Success(t *testing.T) {
 	_, err := persistence.BatchInsert(batch)
 
 	fp := api.Fingerprint("my board fingerprint multi entity batch test")
-	resp, err := persistence.Read("boards", []api.Fingerprint{api.Fingerprint(fp)}, []string{"threads", "keys"}, 0, 0)
+	resp, err := persistence.Read("boards", []api.Fingerprint{api.Fingerprint(fp)}, []string{"threads", "keys"}, 0, 0, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -322,6 +328,8 @@ func TestRead_PostEmbedVote_Success(t *testing.T) {
 	p.Parent = "thread fingerprint"
 	p.Body = "a"
 	p.Creation = 1
+	p.Owner = "key fingerprint"
+	p.OwnerPublicKey = "owner pk"
 	p.ProofOfWork = "pow"
 
 	v.Fingerprint = "my vote fingerprint100"
@@ -341,7 +349,7 @@ func TestRead_PostEmbedVote_Success(t *testing.T) {
 	_, err := persistence.BatchInsert(batch)
 
 	fp := api.Fingerprint("my post fingerprint99")
-	resp, err := persistence.Read("posts", []api.Fingerprint{api.Fingerprint(fp)}, []string{"votes"}, 0, 0)
+	resp, err := persistence.Read("posts", []api.Fingerprint{api.Fingerprint(fp)}, []string{"votes"}, 0, 0, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -365,12 +373,16 @@ func TestRead_ThreadEmbedPost_Success(t *testing.T) {
 	p.Parent = "my thread fingerprint99"
 	p.Body = "a"
 	p.Creation = 1
+	p.Owner = "key fingerprint"
+	p.OwnerPublicKey = "owner pk"
 	p.ProofOfWork = "pow"
 
 	t2.Fingerprint = "my thread fingerprint99"
 	t2.Board = "board fingerprint"
 	t2.Name = "alice"
 	t2.Creation = 1
+	t2.Owner = "key fingerprint"
+	t2.OwnerPublicKey = "owner pk"
 	t2.ProofOfWork = "pow"
 
 	var batch []interface{}
@@ -379,7 +391,7 @@ func TestRead_ThreadEmbedPost_Success(t *testing.T) {
 	_, err := persistence.BatchInsert(batch)
 
 	fp := api.Fingerprint("my thread fingerprint99")
-	resp, err := persistence.Read("threads", []api.Fingerprint{api.Fingerprint(fp)}, []string{"posts"}, 0, 0)
+	resp, err := persistence.Read("threads", []api.Fingerprint{api.Fingerprint(fp)}, []string{"posts"}, 0, 0, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -418,7 +430,7 @@ func TestRead_TruststateEmbedKey_Success(t *testing.T) {
 	_, err := persistence.BatchInsert(batch)
 
 	fp := api.Fingerprint("my truststate fingerprint99")
-	resp, err := persistence.Read("truststates", []api.Fingerprint{api.Fingerprint(fp)}, []string{"keys"}, 0, 0)
+	resp, err := persistence.Read("truststates", []api.Fingerprint{api.Fingerprint(fp)}, []string{"keys"}, 0, 0, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -442,7 +454,7 @@ func TestReadBasedOnArrivalTimeRange(t *testing.T) {
 	time.Sleep(1000 * time.Millisecond) // Wait a bit so we have a decent range.
 	now := api.Timestamp(time.Now().Unix())
 	// fmt.Printf("%#v\n", now)
-	resp, err := persistence.Read("boards", []api.Fingerprint{}, []string{}, 0, now)
+	resp, err := persistence.Read("boards", []api.Fingerprint{}, []string{}, 0, now, false, nil)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -456,7 +468,7 @@ func TestReadBasedOnArrivalTimeRange(t *testing.T) {
 func TestReadBoard_Success(t *testing.T) {
 	fp := api.Fingerprint("my board fingerprint")
 	resp, err := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -471,7 +483,7 @@ func TestReadMultipleBoards_Success(t *testing.T) {
 	fp := api.Fingerprint("my board fingerprint")
 	fp2 := api.Fingerprint("my board fingerprint_second")
 	resp, err := persistence.ReadBoards(
-		[]api.Fingerprint{fp, fp2}, 0, 0)
+		[]api.Fingerprint{fp, fp2}, 0, 0, "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	// fmt.Printf("%#v\n", len(resp))
 	if err != nil {
@@ -487,7 +499,7 @@ func TestReadMultipleBoards_Success(t *testing.T) {
 
 func TestReadBoard_Empty(t *testing.T) {
 	resp, err := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint("fake board fingerprint")}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint("fake board fingerprint")}, 0, 0, "", "", 0, 0)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -536,7 +548,7 @@ func TestReadDBBoardOwner_Empty(t *testing.T) {
 
 func TestReadThread_Success(t *testing.T) {
 	fp := api.Fingerprint("my thread fingerprint")
-	resp, err := persistence.ReadThreads([]api.Fingerprint{fp}, 0, 0)
+	resp, err := persistence.ReadThreads([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -548,7 +560,7 @@ func TestReadThread_Success(t *testing.T) {
 }
 
 func TestReadThread_Empty(t *testing.T) {
-	resp, err := persistence.ReadThreads([]api.Fingerprint{"fake thread fingerprint"}, 0, 0)
+	resp, err := persistence.ReadThreads([]api.Fingerprint{"fake thread fingerprint"}, 0, 0, "", "", 0, 0)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -558,7 +570,7 @@ func TestReadThread_Empty(t *testing.T) {
 
 func TestReadPost_Success(t *testing.T) {
 	fp := api.Fingerprint("my post fingerprint")
-	resp, err := persistence.ReadPosts([]api.Fingerprint{fp}, 0, 0)
+	resp, err := persistence.ReadPosts([]api.Fingerprint{fp}, 0, 0, "", "", "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -570,7 +582,7 @@ func TestReadPost_Success(t *testing.T) {
 }
 
 func TestReadPost_Empty(t *testing.T) {
-	resp, err := persistence.ReadPosts([]api.Fingerprint{"fake post fingerprint"}, 0, 0)
+	resp, err := persistence.ReadPosts([]api.Fingerprint{"fake post fingerprint"}, 0, 0, "", "", "", "", 0, 0)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -580,7 +592,7 @@ func TestReadPost_Empty(t *testing.T) {
 
 func TestReadVote_Success(t *testing.T) {
 	fp := api.Fingerprint("my vote fingerprint")
-	resp, err := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0)
+	resp, err := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -592,7 +604,7 @@ func TestReadVote_Success(t *testing.T) {
 }
 
 func TestReadVote_Empty(t *testing.T) {
-	resp, err := persistence.ReadVotes([]api.Fingerprint{"fake vote fingerprint"}, 0, 0)
+	resp, err := persistence.ReadVotes([]api.Fingerprint{"fake vote fingerprint"}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -605,7 +617,7 @@ func TestReadAddress_Success(t *testing.T) {
 	subloc := api.Location("example")
 	port := uint16(8090)
 	resp, err := persistence.ReadAddresses(
-		loc, subloc, port, 0, 0, 0, 0, 0, "timerange_all")
+		loc, subloc, port, 0, 0, 0, 0, 0, "basic")
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -640,14 +652,17 @@ func TestFirstPartyInsertReadAddress_Success(t *testing.T) {
 	a2.Client.ClientName = "client name"
 	a2.EntityVersion = 1
 	addressSet := []api.Address{a2}
-	persistence.InsertOrUpdateAddresses(&addressSet)
+	errs := persistence.InsertOrUpdateAddresses(&addressSet)
+	if len(errs) > 0 {
+		t.Fatalf("Test failed, the address could not be inserted. Errors: %v", errs)
+	}
 
 	loc := api.Location("www.example33.com")
 	subloc := api.Location("example33")
 	port := uint16(1111)
 
 	resp, err := persistence.ReadAddresses(
-		loc, subloc, port, 0, 0, 0, 0, 0, "timerange_all")
+		loc, subloc, port, 0, 0, 0, 0, 0, "basic")
 	if !(resp[0].Protocol.Subprotocols[0].Name == "c0" || resp[0].Protocol.Subprotocols[1].Name == "c0") {
 		t.Errorf(fmt.Sprintf("Test failed, the subprotocol information has not been committed. Response: %#v", resp))
 	}
@@ -661,9 +676,47 @@ func TestFirstPartyInsertReadAddress_Success(t *testing.T) {
 
 }
 
+func TestBatchInsert_Address_UncarriedTransport_Fail(t *testing.T) {
+	// The Unix socket transport is not enabled for this node, so an address on it that comes from the network should be dropped. The TCP one should still go in.
+	var unix, tcp api.Address
+	unix.Location = "unix:/tmp/somebody-else"
+	unix.Port = 51000
+	unix.LocationType = 7
+	unix.EntityVersion = 1
+	tcp.Location = "www.example-carried.com"
+	tcp.Port = 51000
+	tcp.LocationType = 3
+	tcp.LastSuccessfulPing = 1
+	tcp.Protocol.VersionMajor = 1
+	tcp.Client.VersionMajor = 1
+	tcp.Client.ClientName = "client name"
+	tcp.EntityVersion = 1
+	tcp.SetVerified(true)
+	unix.SetVerified(true)
+	unix.LastSuccessfulPing = tcp.LastSuccessfulPing
+	unix.Protocol = tcp.Protocol
+	unix.Client = tcp.Client
+	_, err := persistence.BatchInsert([]interface{}{unix, tcp})
+	if err != nil {
+		t.Errorf("Test failed, err: '%s'", err)
+	}
+	resp, err2 := persistence.ReadAddresses(unix.Location, "", unix.Port, 0, 0, 0, 0, 0, "basic")
+	if err2 != nil {
+		t.Errorf("Test failed, err: '%s'", err2)
+	} else if len(resp) > 0 {
+		t.Errorf("An address on a transport this node doesn't carry was inserted. Address: '%#v'", resp[0])
+	}
+	resp2, err3 := persistence.ReadAddresses(tcp.Location, "", tcp.Port, 0, 0, 0, 0, 0, "basic")
+	if err3 != nil {
+		t.Errorf("Test failed, err: '%s'", err3)
+	} else if len(resp2) == 0 {
+		t.Errorf("The TCP address in the same batch should have been inserted.")
+	}
+}
+
 func TestReadAddress_Empty(t *testing.T) {
 	resp, err := persistence.ReadAddresses(
-		"fake loc", "fake subloc", 9090, 0, 0, 0, 0, 0, "timerange_all")
+		"fake loc", "fake subloc", 9090, 0, 0, 0, 0, 0, "basic")
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -673,7 +726,7 @@ func TestReadAddress_Empty(t *testing.T) {
 
 func TestReadKey_Success(t *testing.T) {
 	fp := api.Fingerprint("2389749283fasdf")
-	resp, err := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	resp, err := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -685,7 +738,7 @@ func TestReadKey_Success(t *testing.T) {
 }
 
 func TestReadKey_Empty(t *testing.T) {
-	resp, err := persistence.ReadKeys([]api.Fingerprint{"fake key fingerprint"}, 0, 0)
+	resp, err := persistence.ReadKeys([]api.Fingerprint{"fake key fingerprint"}, 0, 0, "", "", 0, 0)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -695,7 +748,7 @@ func TestReadKey_Empty(t *testing.T) {
 
 func TestReadTruststate_Success(t *testing.T) {
 	fp := api.Fingerprint("my truststate fingerprint")
-	resp, err := persistence.ReadTruststates([]api.Fingerprint{fp}, 0, 0)
+	resp, err := persistence.ReadTruststates([]api.Fingerprint{fp}, 0, 0, -1, -1, "", "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -707,7 +760,7 @@ func TestReadTruststate_Success(t *testing.T) {
 }
 
 func TestReadTruststate_Empty(t *testing.T) {
-	resp, err := persistence.ReadTruststates([]api.Fingerprint{"fake truststate fingerprint"}, 0, 0)
+	resp, err := persistence.ReadTruststates([]api.Fingerprint{"fake truststate fingerprint"}, 0, 0, -1, -1, "", "", "", 0, 0)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else if len(resp) > 0 {
@@ -732,18 +785,25 @@ func TestDbToApi_Success(t *testing.T) {
 	}
 }
 
+// The domain of a truststate is a single fingerprint now, not a comma separated list, so DBtoAPI doesn't parse it any more. An overlong domain is caught by the bounds check of the API entity instead.
 func TestDbToApi_ItemLengthLongerThanAllowed(t *testing.T) {
 	var ts persistence.DbTruststate
 	ts.Fingerprint = "my awesome truststate fingerprint"
 	ts.Target = "my target key"
 	ts.Owner = "my owner's key fingerprint"
-	ts.Domain = "my first domain fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint, my second domain fingerprint, my third domain fingerprint" // heads up we converted this from domains plural this test might get weirded out
-	_, err := persistence.DBtoAPI(ts)
-	errMessage := "This string is too long for this field."
-	if err == nil {
-		t.Errorf("Expected an error to be raised from this test.")
-	} else if !strings.Contains(err.Error(), errMessage) {
-		t.Errorf("Test returned an error that was different than the expected one. '%s'", err)
+	ts.Domain = "my first domain fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint fingerprint, my second domain fingerprint, my third domain fingerprint"
+	ts.EntityVersion = 1
+	apiTs, err := persistence.DBtoAPI(ts)
+	if err != nil {
+		t.Errorf("Test failed, err: '%s'", err)
+		return
+	}
+	obj := apiTs.(api.Truststate)
+	valid, err2 := obj.CheckBounds()
+	if err2 != nil {
+		t.Errorf("Test failed, err: '%s'", err2)
+	} else if valid {
+		t.Errorf("Expected the bounds check to reject this truststate.")
 	}
 }
 
@@ -793,7 +853,7 @@ func TestApiToDb_Success(t *testing.T) {
 	s.VersionMinor = 0
 	s.SupportedEntities = []string{"board", "thread", "post", "vote", "key", "truststate"}
 	a.Protocol.Subprotocols = []api.Subprotocol{s}
-	addressPack, err := persistence.APItoDB(a)
+	addressPack, err := persistence.APItoDB(a, time.Now())
 	obj := addressPack.(persistence.AddressPack)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -817,7 +877,7 @@ func TestApiToDb_RepeatedItems(t *testing.T) {
 	s1.VersionMinor = 0
 	s1.SupportedEntities = []string{"board", "board"}
 	a.Protocol.Subprotocols = []api.Subprotocol{s1}
-	_, err := persistence.APItoDB(a)
+	_, err := persistence.APItoDB(a, time.Now())
 	errMessage := "This list includes items that are duplicates."
 	if err == nil {
 		t.Errorf("Expected an error to be raised from this test.")
@@ -836,9 +896,11 @@ func TestApiToDb_TooManyItems(t *testing.T) {
 	s.Name = "c0"
 	s.VersionMajor = 1
 	s.VersionMinor = 0
-	s.SupportedEntities = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "111", "211", "311", "411", "511", "611", "711", "811", "911", "1011", "1111", "1211", "1311", "1411", "1511", "1611", "1711", "1811", "1911", "2011", "2111", "2211", "2311", "2411", "2511", "2611", "2711", "2811", "2911", "3011", "11111", "21111", "31111", "41111", "51111", "61111", "71111", "81111", "91111", "101111", "111111", "121111", "131111", "141111", "151111", "161111", "171111", "181111", "191111", "201111", "211111", "221111", "231111", "241111", "251111", "261111", "271111", "281111", "291111", "301111", "1111111", "2111111", "3111111", "4111111", "5111111", "6111111", "7111111", "8111111", "9111111", "10111111", "11111111", "12111111", "13111111", "14111111", "15111111", "16111111", "17111111", "18111111", "19111111", "20111111", "21111111", "22111111", "23111111", "24111111", "25111111", "26111111", "27111111", "28111111", "29111111", "30111111"}
+	for i := 0; i <= api.MAX_ADDRESS_PROTOCOL_SUBPROTOCOL_SUPPORTEDENTITIES_V1; i++ {
+		s.SupportedEntities = append(s.SupportedEntities, fmt.Sprint(i))
+	}
 	a.Protocol.Subprotocols = []api.Subprotocol{s}
-	_, err := persistence.APItoDB(a)
+	_, err := persistence.APItoDB(a, time.Now())
 	errMessage := "The string slice provided has too many items."
 	if err == nil {
 		t.Errorf("Expected an error to be raised from this test.")
@@ -859,7 +921,7 @@ func TestApiToDb_ItemLengthLongerThanAllowed(t *testing.T) {
 	s.VersionMinor = 0
 	s.SupportedEntities = []string{"boaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaard"}
 	a.Protocol.Subprotocols = []api.Subprotocol{s}
-	_, err := persistence.APItoDB(a)
+	_, err := persistence.APItoDB(a, time.Now())
 	errMessage := "This string is too long for this field."
 	if err == nil {
 		t.Errorf("Expected an error to be raised from this test.")
@@ -889,7 +951,7 @@ func TestSingleInsert_Success(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) == 0 {
@@ -931,7 +993,7 @@ func TestSingleInsert_Duplicate(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) > 1 {
@@ -977,7 +1039,7 @@ func TestBatchInsert_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	// Check for first
-	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) == 0 {
@@ -986,7 +1048,7 @@ func TestBatchInsert_Success(t *testing.T) {
 		t.Errorf("The response received isn't the expected one. Fingerprint: '%s'", resp[0].Fingerprint)
 	}
 	// Check for second
-	resp2, err3 := persistence.ReadVotes([]api.Fingerprint{fp2}, 0, 0)
+	resp2, err3 := persistence.ReadVotes([]api.Fingerprint{fp2}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	if err3 != nil {
 		t.Errorf("Test failed, err: '%s'", err3)
 	} else if len(resp2) == 0 {
@@ -1037,7 +1099,7 @@ func TestInsert_MultipleTypes_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	// Check for first
-	resp, err2 := persistence.ReadAddresses(addressLoc, addressSubloc, addressPort, 0, 0, 0, 0, 0, "timerange_all")
+	resp, err2 := persistence.ReadAddresses(addressLoc, addressSubloc, addressPort, 0, 0, 0, 0, 0, "basic")
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) == 0 {
@@ -1046,7 +1108,7 @@ func TestInsert_MultipleTypes_Success(t *testing.T) {
 		t.Errorf("The response received isn't the expected one. Address: '%#v'", resp[0])
 	}
 	// Check for second
-	resp2, err3 := persistence.ReadTruststates([]api.Fingerprint{tfp}, 0, 0)
+	resp2, err3 := persistence.ReadTruststates([]api.Fingerprint{tfp}, 0, 0, -1, -1, "", "", "", 0, 0)
 	if err3 != nil {
 		t.Errorf("Test failed, err: '%s'", err3)
 	} else if len(resp2) == 0 {
@@ -1080,7 +1142,7 @@ func TestInsert_ItemsWithUpdates_Board_SimpleField_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	resp, err2 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1098,7 +1160,7 @@ func TestInsert_ItemsWithUpdates_Board_SimpleField_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err9)
 	}
 	resp3, err10 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err10 != nil {
 		t.Errorf("Test failed, err: '%s'", err10)
 	}
@@ -1115,7 +1177,7 @@ func TestInsert_ItemsWithUpdates_Board_SimpleField_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err11)
 	}
 	resp4, err12 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err12 != nil {
 		t.Errorf("Test failed, err: '%s'", err12)
 	}
@@ -1132,7 +1194,7 @@ func TestInsert_ItemsWithUpdates_Board_SimpleField_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err13)
 	}
 	resp5, err14 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err14 != nil {
 		t.Errorf("Test failed, err: '%s'", err14)
 	}
@@ -1142,6 +1204,87 @@ func TestInsert_ItemsWithUpdates_Board_SimpleField_Success(t *testing.T) {
 	}
 }
 
+func TestInsert_ItemsWithUpdates_Post_History_Success(t *testing.T) {
+	// Insert a post.
+	var p api.Post
+	p.SetVerified(true)
+	p.EntityVersion = 1
+	fp := api.Fingerprint("my edited post fingerprint")
+	p.Fingerprint = fp
+	p.Board = "board fingerprint"
+	p.Thread = "thread fingerprint"
+	p.Parent = "thread fingerprint"
+	p.Body = "first version"
+	p.Creation = 2
+	p.ProofOfWork = "pow"
+	p.Owner = "post owner"
+	p.OwnerPublicKey = "post owner pk"
+	_, err := persistence.BatchInsert([]interface{}{p})
+	if err != nil {
+		t.Errorf("Test failed, err: '%s'", err)
+	}
+	hist, err2 := persistence.ReadPostHistory(fp)
+	if err2 != nil {
+		t.Errorf("Test failed, err: '%s'", err2)
+	}
+	if len(hist) != 0 {
+		t.Errorf("A post that was never updated should have no history. History: '%#v\n'", hist)
+		t.Fatal()
+	}
+
+	// Update it with a last update earlier than creation. This won't enter the database, so the first version should not go into the history either.
+	p.Body = "rejected version"
+	p.LastUpdate = 1
+	_, err3 := persistence.BatchInsert([]interface{}{p})
+	if err3 != nil {
+		t.Errorf("Test failed, err: '%s'", err3)
+	}
+	hist2, err4 := persistence.ReadPostHistory(fp)
+	if err4 != nil {
+		t.Errorf("Test failed, err: '%s'", err4)
+	}
+	if len(hist2) != 0 {
+		t.Errorf("A rejected update should not put anything into the history. History: '%#v\n'", hist2)
+		t.Fatal()
+	}
+
+	// Update it twice. Both prior versions should be in the history, oldest first, and the current version should not.
+	p.Body = "second version"
+	p.LastUpdate = 3
+	_, err5 := persistence.BatchInsert([]interface{}{p})
+	if err5 != nil {
+		t.Errorf("Test failed, err: '%s'", err5)
+	}
+	p.Body = "third version"
+	p.LastUpdate = 4
+	_, err6 := persistence.BatchInsert([]interface{}{p})
+	if err6 != nil {
+		t.Errorf("Test failed, err: '%s'", err6)
+	}
+	hist3, err7 := persistence.ReadPostHistory(fp)
+	if err7 != nil {
+		t.Errorf("Test failed, err: '%s'", err7)
+	}
+	if len(hist3) != 2 || hist3[0].Body != "first version" || hist3[1].Body != "second version" {
+		t.Errorf("The history should have the first and the second versions in it, in that order. History: '%#v\n'", hist3)
+		t.Fatal()
+	}
+
+	// Insert the same update again. The history should not change.
+	_, err8 := persistence.BatchInsert([]interface{}{p})
+	if err8 != nil {
+		t.Errorf("Test failed, err: '%s'", err8)
+	}
+	hist4, err9 := persistence.ReadPostHistory(fp)
+	if err9 != nil {
+		t.Errorf("Test failed, err: '%s'", err9)
+	}
+	if len(hist4) != 2 {
+		t.Errorf("Reinserting the current version should not add to the history. History: '%#v\n'", hist4)
+		t.Fatal()
+	}
+}
+
 func TestInsert_ItemsWithUpdates_Board_SubObject_Success(t *testing.T) {
 	// Insert a board.
 	var b api.Board
@@ -1150,6 +1293,8 @@ func TestInsert_ItemsWithUpdates_Board_SubObject_Success(t *testing.T) {
 	b.EntityVersion = 1
 	b.Fingerprint = "my board fingerprint"
 	b.Name = "alice"
+	b.Owner = "key fingerprint"
+	b.OwnerPublicKey = "owner pk"
 	// b.Creation = 1
 	b.ProofOfWork = "pow"
 	var bo1 api.BoardOwner
@@ -1170,7 +1315,7 @@ func TestInsert_ItemsWithUpdates_Board_SubObject_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	resp, err2 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1188,7 +1333,7 @@ func TestInsert_ItemsWithUpdates_Board_SubObject_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err3)
 	}
 	resp2, err4 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err4 != nil {
 		t.Errorf("Test failed, err: '%s'", err4)
 	}
@@ -1203,7 +1348,7 @@ func TestInsert_ItemsWithUpdates_Board_SubObject_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err5)
 	}
 	resp3, err6 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err6 != nil {
 		t.Errorf("Test failed, err: '%s'", err6)
 	}
@@ -1218,7 +1363,7 @@ func TestInsert_ItemsWithUpdates_Board_SubObject_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err7)
 	}
 	resp4, err8 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err8 != nil {
 		t.Errorf("Test failed, err: '%s'", err8)
 	}
@@ -1246,7 +1391,7 @@ func TestInsert_ItemsWithUpdates_Key_SimpleField_Success(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1263,7 +1408,7 @@ func TestInsert_ItemsWithUpdates_Key_SimpleField_Success(t *testing.T) {
 	if err9 != nil {
 		t.Errorf("Test failed, err: '%s'", err9)
 	}
-	resp3, err10 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	resp3, err10 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err10 != nil {
 		t.Errorf("Test failed, err: '%s'", err10)
 	}
@@ -1279,7 +1424,7 @@ func TestInsert_ItemsWithUpdates_Key_SimpleField_Success(t *testing.T) {
 	if err11 != nil {
 		t.Errorf("Test failed, err: '%s'", err11)
 	}
-	resp4, err12 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	resp4, err12 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err12 != nil {
 		t.Errorf("Test failed, err: '%s'", err12)
 	}
@@ -1295,7 +1440,7 @@ func TestInsert_ItemsWithUpdates_Key_SimpleField_Success(t *testing.T) {
 	if err13 != nil {
 		t.Errorf("Test failed, err: '%s'", err13)
 	}
-	resp5, err14 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	resp5, err14 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err14 != nil {
 		t.Errorf("Test failed, err: '%s'", err14)
 	}
@@ -1323,7 +1468,7 @@ func TestInsert_ItemsWithUpdates_Key_SubObject_Success(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1339,7 +1484,7 @@ func TestInsert_ItemsWithUpdates_Key_SubObject_Success(t *testing.T) {
 	// if err3 != nil {
 	// 	t.Errorf("Test failed, err: '%s'", err3)
 	// }
-	// resp2, err4 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	// resp2, err4 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	// if err4 != nil {
 	// 	t.Errorf("Test failed, err: '%s'", err4)
 	// }
@@ -1353,7 +1498,7 @@ func TestInsert_ItemsWithUpdates_Key_SubObject_Success(t *testing.T) {
 	// if err5 != nil {
 	// 	t.Errorf("Test failed, err: '%s'", err5)
 	// }
-	// resp3, err6 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	// resp3, err6 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	// if err6 != nil {
 	// 	t.Errorf("Test failed, err: '%s'", err6)
 	// }
@@ -1367,7 +1512,7 @@ func TestInsert_ItemsWithUpdates_Key_SubObject_Success(t *testing.T) {
 	// if err7 != nil {
 	// 	t.Errorf("Test failed, err: '%s'", err7)
 	// }
-	// resp4, err8 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0)
+	// resp4, err8 := persistence.ReadKeys([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	// if err8 != nil {
 	// 	t.Errorf("Test failed, err: '%s'", err8)
 	// }
@@ -1398,6 +1543,8 @@ func TestInsert_AddRemoveBoardOwner(t *testing.T) {
 	fp := api.Fingerprint("hello this is a board bo insert remove test")
 	b.Fingerprint = fp
 	b.Name = "alice"
+	b.Owner = "key fingerprint"
+	b.OwnerPublicKey = "owner pk"
 	b.Creation = 1
 	b.ProofOfWork = "pow"
 	var bo1 api.BoardOwner
@@ -1424,7 +1571,7 @@ func TestInsert_AddRemoveBoardOwner(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
 	resp, err3 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp[0].BoardOwners)
 	if err3 != nil {
 		t.Errorf("Test failed, err: '%s'", err3)
@@ -1441,6 +1588,8 @@ func TestInsert_AddRemoveAndEditBoardOwner(t *testing.T) {
 	fp := api.Fingerprint("hello this is a board3 add remove edit board owner test")
 	b.Fingerprint = fp
 	b.Name = "alice"
+	b.Owner = "key fingerprint"
+	b.OwnerPublicKey = "owner pk"
 	b.Creation = 1
 	b.ProofOfWork = "pow"
 	var bo1 api.BoardOwner
@@ -1469,7 +1618,7 @@ func TestInsert_AddRemoveAndEditBoardOwner(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
 	resp, err3 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	// fmt.Printf("%#v\n", resp[0].BoardOwners)
 	if err3 != nil {
 		t.Errorf("Test failed, err: '%s'", err3)
@@ -1495,6 +1644,8 @@ func TestInsert_DuplicateBoardOwner(t *testing.T) {
 	fp := api.Fingerprint("hello this is a boardie")
 	b.Fingerprint = fp
 	b.Name = "alice"
+	b.Owner = "key fingerprint"
+	b.OwnerPublicKey = "owner pk"
 	b.Creation = 1
 	b.ProofOfWork = "pow"
 	var bo1 api.BoardOwner
@@ -1512,7 +1663,7 @@ func TestInsert_DuplicateBoardOwner(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	resp, err3 := persistence.ReadBoards(
-		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0)
+		[]api.Fingerprint{api.Fingerprint(fp)}, 0, 0, "", "", 0, 0)
 	if err3 != nil {
 		t.Errorf("Test failed, err: '%s'", err3)
 	} else if len(resp[0].BoardOwners) > 2 {
@@ -1537,7 +1688,7 @@ func TestSingleInsert_CircularPost(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadPosts([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadPosts([]api.Fingerprint{fp}, 0, 0, "", "", "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) > 0 {
@@ -1576,7 +1727,7 @@ func TestBoardInsertNonrecursiveLastReferenced_Success(t *testing.T) {
 	}
 	// read back and save last referenced
 	// fmt.Printf("Time at first DB Key Read: %d\n", time.Now().Unix())
-	resp, err2 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0)
+	resp, err2 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1590,7 +1741,7 @@ func TestBoardInsertNonrecursiveLastReferenced_Success(t *testing.T) {
 	}
 	// get the key again and save its new last referenced
 	// fmt.Printf("Time at second DB Key Read: %d\n", time.Now().Unix())
-	resp2, err4 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0)
+	resp2, err4 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0, "", "", 0, 0)
 	if err4 != nil {
 		t.Errorf("Test failed, err: '%s'", err4)
 	}
@@ -1633,7 +1784,7 @@ func TestBoardInsertLastReferencedGating_MainObjectDidNotInsert(t *testing.T) {
 	}
 	// read back and save last referenced
 	// fmt.Printf("Time at first DB Key Read: %d\n", time.Now().Unix())
-	resp, err2 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0)
+	resp, err2 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1647,7 +1798,7 @@ func TestBoardInsertLastReferencedGating_MainObjectDidNotInsert(t *testing.T) {
 	}
 	// get the key again and save its new last referenced
 	// fmt.Printf("Time at second DB Key Read: %d\n", time.Now().Unix())
-	resp2, err4 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0)
+	resp2, err4 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0, "", "", 0, 0)
 	if err4 != nil {
 		t.Errorf("Test failed, err: '%s'", err4)
 	}
@@ -1664,7 +1815,7 @@ func TestBoardInsertLastReferencedGating_MainObjectDidNotInsert(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err5)
 	}
 
-	resp3, err6 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0)
+	resp3, err6 := persistence.ReadDbKeys([]api.Fingerprint{kfp}, 0, 0, "", "", 0, 0)
 	if err6 != nil {
 		t.Errorf("Test failed, err: '%s'", err6)
 	}
@@ -1769,7 +1920,7 @@ func TestPostInsertRecursiveLastReferenced_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	// read back and save last referenced
-	resp, err2 := persistence.ReadDbPosts([]api.Fingerprint{p.Fingerprint}, 0, 0)
+	resp, err2 := persistence.ReadDbPosts([]api.Fingerprint{p.Fingerprint}, 0, 0, "", "", "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1781,7 +1932,7 @@ func TestPostInsertRecursiveLastReferenced_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err3)
 	}
 	// get the key again and save its new last referenced
-	resp2, err4 := persistence.ReadDbPosts([]api.Fingerprint{p.Fingerprint}, 0, 0)
+	resp2, err4 := persistence.ReadDbPosts([]api.Fingerprint{p.Fingerprint}, 0, 0, "", "", "", "", 0, 0)
 	if err4 != nil {
 		t.Errorf("Test failed, err: '%s'", err4)
 	}
@@ -1810,7 +1961,7 @@ func TestSingleInsert_Board_LangValid_Success(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadBoards([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadBoards([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) == 0 {
@@ -1821,6 +1972,10 @@ func TestSingleInsert_Board_LangValid_Success(t *testing.T) {
 }
 
 func TestSingleInsert_Board_LangTooLong(t *testing.T) {
+	// The length of the language is enforced by the column width, and SQLite doesn't enforce column widths.
+	if globals.BackendConfig.GetDbEngine() == "sqlite" {
+		t.Skip("SQLite doesn't enforce the width of VARCHAR columns.")
+	}
 	fp := api.Fingerprint("my awesome board fingerprint with too long a language")
 	var b api.Board
 	b.SetVerified(true)
@@ -1838,7 +1993,7 @@ func TestSingleInsert_Board_LangTooLong(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadBoards([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadBoards([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) > 0 {
@@ -1869,7 +2024,7 @@ func TestSingleInsert_Vote_UpdateEarlierThanCreationInsertedAtTheSameTime(t *tes
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadVotes([]api.Fingerprint{fp}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) > 0 {
@@ -1898,7 +2053,7 @@ func TestSingleInsert_Thread_Update_InsertedAtTheSameTime(t *testing.T) {
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadThreads([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadThreads([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if resp[0].GetUpdateSignature() == "" {
@@ -1929,7 +2084,7 @@ func TestSingleInsert_Post_UpdateEarlierThanCreationInsertedAtTheSameTime(t *tes
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
-	resp, err2 := persistence.ReadPosts([]api.Fingerprint{fp}, 0, 0)
+	resp, err2 := persistence.ReadPosts([]api.Fingerprint{fp}, 0, 0, "", "", "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	} else if len(resp) > 0 {
@@ -1983,7 +2138,7 @@ func TestPostInsertRecursiveDeepInsert_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err)
 	}
 	// read back and save last referenced
-	resp, err2 := persistence.ReadDbPosts([]api.Fingerprint{p1.Fingerprint}, 0, 0)
+	resp, err2 := persistence.ReadDbPosts([]api.Fingerprint{p1.Fingerprint}, 0, 0, "", "", "", "", 0, 0)
 	if err2 != nil {
 		t.Errorf("Test failed, err: '%s'", err2)
 	}
@@ -1996,7 +2151,7 @@ func TestPostInsertRecursiveDeepInsert_Success(t *testing.T) {
 		t.Errorf("Test failed, err: '%s'", err3)
 	}
 	// get the key again and save its new last referenced
-	resp2, err4 := persistence.ReadDbPosts([]api.Fingerprint{p1.Fingerprint}, 0, 0)
+	resp2, err4 := persistence.ReadDbPosts([]api.Fingerprint{p1.Fingerprint}, 0, 0, "", "", "", "", 0, 0)
 	if err4 != nil {
 		t.Errorf("Test failed, err: '%s'", err4)
 	}
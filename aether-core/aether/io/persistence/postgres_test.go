@@ -0,0 +1,239 @@
+package persistence
+
+// These test the Postgres engine against a real Postgres server: the savepoints that keep a transaction going past a failed statement, and the Postgres insert statements with their update gates. They only run if AETHER_TEST_POSTGRES_DSN is set to the connection string of a database whose tables they can drop, e.g. "host=127.0.0.1 port=5432 user=aether password=aether dbname=aethertest sslmode=disable". Otherwise they're skipped.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/services/globals"
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	_ "github.com/lib/pq"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Infrastructure
+
+// usePostgres points the persistence layer at a fresh schema in the Postgres database from the environment, and returns the func that points it back.
+func usePostgres(t *testing.T) func() {
+	dsn := os.Getenv("AETHER_TEST_POSTGRES_DSN")
+	if len(dsn) == 0 {
+		t.Skip("AETHER_TEST_POSTGRES_DSN is not set, skipping the Postgres tests.")
+	}
+	conn, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("Could not connect to the Postgres database. Error: %v", err)
+	}
+	// Same as the backend cmd does for Postgres, see there for why.
+	conn.Mapper = reflectx.NewMapperTagFunc("db", strings.ToLower, strings.ToLower)
+	priorDb := globals.DbInstance
+	priorEngine := globals.BackendConfig.DbEngine
+	globals.DbInstance = conn
+	globals.BackendConfig.DbEngine = "postgres"
+	DeleteDatabase()
+	CreateDatabase()
+	return func() {
+		DeleteDatabase()
+		conn.Close()
+		globals.DbInstance = priorDb
+		globals.BackendConfig.DbEngine = priorEngine
+	}
+}
+
+func pgSubprotocol(name string) DbSubprotocol {
+	return DbSubprotocol{Fingerprint: api.Fingerprint(name + " fingerprint"), Name: name, VersionMajor: 1, SupportedEntities: "board"}
+}
+
+func pgSubprotocolCount(t *testing.T) int {
+	var count int
+	if err := globals.DbInstance.Get(&count, "SELECT COUNT(*) FROM Subprotocols;"); err != nil {
+		t.Fatalf("Could not count the subprotocols. Error: %v", err)
+	}
+	return count
+}
+
+func pgBoard(fp api.Fingerprint, description string, lastUpdate api.Timestamp) api.Board {
+	var b api.Board
+	b.SetVerified(true)
+	b.EntityVersion = 1
+	b.Fingerprint = fp
+	b.Name = "alice"
+	b.Description = description
+	b.Language = "en"
+	b.Creation = 2
+	b.LastUpdate = lastUpdate
+	b.ProofOfWork = "pow"
+	b.Owner = "board owner"
+	b.OwnerPublicKey = "board owner pk"
+	return b
+}
+
+func pgThread(fp api.Fingerprint, body string, lastUpdate api.Timestamp) api.Thread {
+	var th api.Thread
+	th.SetVerified(true)
+	th.EntityVersion = 1
+	th.Fingerprint = fp
+	th.Board = "pg board fingerprint"
+	th.Name = "thread name"
+	th.Body = body
+	th.Creation = 2
+	th.LastUpdate = lastUpdate
+	th.ProofOfWork = "pow"
+	th.Owner = "thread owner"
+	th.OwnerPublicKey = "thread owner pk"
+	return th
+}
+
+func pgPost(fp api.Fingerprint, body string, lastUpdate api.Timestamp) api.Post {
+	var p api.Post
+	p.SetVerified(true)
+	p.EntityVersion = 1
+	p.Fingerprint = fp
+	p.Board = "pg board fingerprint"
+	p.Thread = "pg thread fingerprint"
+	p.Parent = "pg thread fingerprint"
+	p.Body = body
+	p.Creation = 2
+	p.LastUpdate = lastUpdate
+	p.ProofOfWork = "pow"
+	p.Owner = "post owner"
+	p.OwnerPublicKey = "post owner pk"
+	return p
+}
+
+func pgInsert(t *testing.T, entities ...interface{}) {
+	if _, err := BatchInsert(entities); err != nil {
+		t.Fatalf("The insert failed. Error: %v", err)
+	}
+}
+
+// Tests
+
+func TestPostgres_TxNamedExec_FailedStatement_Success(t *testing.T) {
+	defer usePostgres(t)()
+	failing := "INSERT INTO NoSuchTable (Name) VALUES (:name);"
+	tx, err := globals.DbInstance.Beginx()
+	if err != nil {
+		t.Fatalf("Could not start the transaction. Error: %v", err)
+	}
+	if _, err := txNamedExec(tx, getSQLCommands("dbSubprotocol")[0], pgSubprotocol("first")); err != nil {
+		t.Fatalf("The first statement should have succeeded. Error: %v", err)
+	}
+	if _, err := txNamedExec(tx, failing, pgSubprotocol("failing")); err == nil {
+		t.Fatalf("The statement into a table that doesn't exist should have failed.")
+	}
+	if _, err := txNamedExec(tx, getSQLCommands("dbSubprotocol")[0], pgSubprotocol("second")); err != nil {
+		t.Fatalf("The statement after the failed one should have succeeded. Error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("The transaction should commit past the failed statement. Error: %v", err)
+	}
+	if count := pgSubprotocolCount(t); count != 2 {
+		t.Errorf("Both statements around the failed one should have been committed. Count: %v", count)
+	}
+	// Without the savepoints, Postgres aborts the rest of the transaction at the failed statement. This is what the savepoints are there for.
+	tx2, err2 := globals.DbInstance.Beginx()
+	if err2 != nil {
+		t.Fatalf("Could not start the transaction. Error: %v", err2)
+	}
+	defer tx2.Rollback()
+	tx2.NamedExec(failing, pgSubprotocol("failing"))
+	if _, err := tx2.NamedExec(getSQLCommands("dbSubprotocol")[0], pgSubprotocol("third")); err == nil {
+		t.Errorf("A bare statement after a failed one should fail on Postgres. If this passes, the savepoints are no longer needed.")
+	}
+}
+
+func TestPostgres_BatchInsert_AllTypes_Success(t *testing.T) {
+	defer usePostgres(t)()
+	var k api.Key
+	k.SetVerified(true)
+	k.EntityVersion = 1
+	k.Fingerprint = "pg key fingerprint"
+	k.Key = "public key"
+	k.Type = "key type"
+	k.Creation = 1
+	k.ProofOfWork = "pow"
+	k.Signature = "sig"
+	var v api.Vote
+	v.SetVerified(true)
+	v.EntityVersion = 1
+	v.Fingerprint = "pg vote fingerprint"
+	v.Board = "pg board fingerprint"
+	v.Thread = "pg thread fingerprint"
+	v.Target = "pg post fingerprint"
+	v.Owner = "vote owner"
+	v.OwnerPublicKey = "vote owner pk"
+	v.Type = 1
+	v.Creation = 1
+	v.Signature = "sig"
+	v.ProofOfWork = "pow"
+	b := pgBoard("pg board fingerprint", "board", 0)
+	b.BoardOwners = []api.BoardOwner{{KeyFingerprint: k.Fingerprint, Level: 1}}
+	pgInsert(t, k, b, pgThread("pg thread fingerprint", "thread", 0), pgPost("pg post fingerprint", "post", 0), v)
+	keys, err := ReadKeys([]api.Fingerprint{k.Fingerprint}, 0, 0, "", "", 0, 0)
+	if err != nil || len(keys) != 1 {
+		t.Errorf("The key should be in the database. Keys: %#v, Error: %v", keys, err)
+	}
+	boards, err2 := ReadBoards([]api.Fingerprint{b.Fingerprint}, 0, 0, "", "", 0, 0)
+	if err2 != nil || len(boards) != 1 || len(boards[0].BoardOwners) != 1 {
+		t.Errorf("The board should be in the database, with its owner. Boards: %#v, Error: %v", boards, err2)
+	}
+	threads, err3 := ReadThreads([]api.Fingerprint{"pg thread fingerprint"}, 0, 0, "", "", 0, 0)
+	if err3 != nil || len(threads) != 1 {
+		t.Errorf("The thread should be in the database. Threads: %#v, Error: %v", threads, err3)
+	}
+	posts, err4 := ReadPosts([]api.Fingerprint{"pg post fingerprint"}, 0, 0, "", "", "", "", 0, 0)
+	if err4 != nil || len(posts) != 1 {
+		t.Errorf("The post should be in the database. Posts: %#v, Error: %v", posts, err4)
+	}
+	votes, err5 := ReadVotes([]api.Fingerprint{v.Fingerprint}, 0, 0, -1, -1, "", "", "", false, "", 0, 0)
+	if err5 != nil || len(votes) != 1 {
+		t.Errorf("The vote should be in the database. Votes: %#v, Error: %v", votes, err5)
+	}
+}
+
+func TestPostgres_BatchInsert_UpdateGates_Success(t *testing.T) {
+	defer usePostgres(t)()
+	fp := api.Fingerprint("pg gated board fingerprint")
+	pgInsert(t, pgBoard(fp, "this should not change", 0))
+	// Earlier than creation, then the same as creation: neither should enter. Later than creation should.
+	cases := []struct {
+		lastUpdate api.Timestamp
+		expected   string
+	}{
+		{1, "this should not change"},
+		{2, "this should not change"},
+		{3, "changed"},
+	}
+	for _, c := range cases {
+		pgInsert(t, pgBoard(fp, "changed", c.lastUpdate))
+		boards, err := ReadBoards([]api.Fingerprint{fp}, 0, 0, "", "", 0, 0)
+		if err != nil || len(boards) != 1 {
+			t.Fatalf("The board should be in the database. Boards: %#v, Error: %v", boards, err)
+		}
+		if boards[0].Description != c.expected {
+			t.Errorf("Last update: %v, Expected description: %v, Got: %v", c.lastUpdate, c.expected, boards[0].Description)
+		}
+	}
+}
+
+func TestPostgres_BatchInsert_History_Success(t *testing.T) {
+	defer usePostgres(t)()
+	thfp := api.Fingerprint("pg history thread fingerprint")
+	pfp := api.Fingerprint("pg history post fingerprint")
+	pgInsert(t, pgThread(thfp, "first version", 0), pgPost(pfp, "first version", 0))
+	// A rejected update, then two accepted ones, then the last one again.
+	pgInsert(t, pgThread(thfp, "rejected version", 1), pgPost(pfp, "rejected version", 1))
+	pgInsert(t, pgThread(thfp, "second version", 3), pgPost(pfp, "second version", 3))
+	pgInsert(t, pgThread(thfp, "third version", 4), pgPost(pfp, "third version", 4))
+	pgInsert(t, pgThread(thfp, "third version", 4), pgPost(pfp, "third version", 4))
+	thist, err := ReadThreadHistory(thfp)
+	if err != nil || len(thist) != 2 || thist[0].Body != "first version" || thist[1].Body != "second version" {
+		t.Errorf("The thread history should have the first and the second versions in it, in that order. History: %#v, Error: %v", thist, err)
+	}
+	phist, err2 := ReadPostHistory(pfp)
+	if err2 != nil || len(phist) != 2 || phist[0].Body != "first version" || phist[1].Body != "second version" {
+		t.Errorf("The post history should have the first and the second versions in it, in that order. History: %#v, Error: %v", phist, err2)
+	}
+}
@@ -23,7 +23,7 @@ func ReadNode(fingerprint api.Fingerprint) (DbNode, error) {
 		if err != nil {
 			return n, err
 		}
-		rows, err := globals.DbInstance.Queryx(query, args...)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 		if err != nil {
 			return n, err
 		}
@@ -42,6 +42,26 @@ func ReadNode(fingerprint api.Fingerprint) (DbNode, error) {
 	return n, nil
 }
 
+// ReadDbPeerReputations returns all the peer reputations we have. The table is bounded by the addresses we've synced with, so this is never large.
+func ReadDbPeerReputations() ([]DbPeerReputation, error) {
+	var dbArr []DbPeerReputation
+	rows, err := globals.DbInstance.Queryx("SELECT * FROM PeerReputations")
+	if err != nil {
+		return dbArr, err
+	}
+	defer rows.Close() // In case of premature exit.
+	for rows.Next() {
+		var entity DbPeerReputation
+		err := rows.StructScan(&entity)
+		if err != nil {
+			return dbArr, err
+		}
+		dbArr = append(dbArr, entity)
+	}
+	rows.Close()
+	return dbArr, nil
+}
+
 // enforceReadValidity enforces that, in a ReadX function (medium level API below), either a time range or a list of fingerprints are asked, and not both.
 func enforceReadValidity(
 	fingerprints []api.Fingerprint,
@@ -157,16 +177,49 @@ type OptionalReadInputs struct {
 	Truststate_Domain    string
 	Truststate_TypeClass int
 	Truststate_Type      int
+	// DirectMessage
+	DirectMessage_Recipient string
 	// All provables (all except Address)
 	// Heads up / limit / offsets are only defined over owner key based search. I'll basically have to build a query builder for SQL to make this work with all other options, and I'd rather not have to do that. if you end up needing limit / offset on something else, just write the query here.
 	AllProvables_Owner  string
 	AllProvables_Limit  int
 	AllProvables_Offset int
+	// Realms partitions the results by realm. If given, only entities in one of these realms are returned. The public realm has to be listed explicitly (as api.PublicRealm) if it is wanted. If empty, there is no realm filtering.
+	// Heads up, this is applied after the query, so when combined with limit / offset, a page can come back shorter than the limit.
+	Realms []api.Fingerprint
+}
+
+// NewOptionalReadInputs returns the optional read inputs with nothing specified. Start from this if you only want to set some of the fields.
+func NewOptionalReadInputs() *OptionalReadInputs {
+	return &OptionalReadInputs{ // -1: not specified (0 is a valid value for type, means it was reverted to default from something else.)
+		// Board
+		// Thread
+		Thread_Board: "",
+		// Post
+		Post_Board:  "",
+		Post_Thread: "",
+		Post_Parent: "",
+		// Vote
+		Vote_Board:         "",
+		Vote_Thread:        "",
+		Vote_Target:        "",
+		Vote_TypeClass:     -1,
+		Vote_Type:          -1,
+		Vote_NoDescendants: false,
+		// Key
+		// Truststate
+		Truststate_Target:    "",
+		Truststate_Domain:    "",
+		Truststate_TypeClass: -1,
+		Truststate_Type:      -1,
+		// DirectMessage
+		DirectMessage_Recipient: "",
+	}
 }
 
 // Read is the high level API for DB reads. It provides filtering support. It can return multiple types if requested by the embeds.
 func Read(
-	entityType string, // boards, threads, posts, votes, addresses, keys, truststates
+	entityType string, // boards, threads, posts, votes, addresses, keys, truststates, directmessages
 	fingerprints []api.Fingerprint,
 	embeds []string,
 	beginTimestamp api.Timestamp,
@@ -178,28 +231,7 @@ func Read(
 		return api.Response{}, nil
 	}
 	if opts == nil {
-		opts = &OptionalReadInputs{ // -1: not specified (0 is a valid value for type, means it was reverted to default from something else.)
-			// Board
-			// Thread
-			Thread_Board: "",
-			// Post
-			Post_Board:  "",
-			Post_Thread: "",
-			Post_Parent: "",
-			// Vote
-			Vote_Board:         "",
-			Vote_Thread:        "",
-			Vote_Target:        "",
-			Vote_TypeClass:     -1,
-			Vote_Type:          -1,
-			Vote_NoDescendants: false,
-			// Key
-			// Truststate
-			Truststate_Target:    "",
-			Truststate_Domain:    "",
-			Truststate_TypeClass: -1,
-			Truststate_Type:      -1,
-		}
+		opts = NewOptionalReadInputs()
 	}
 	var result api.Response
 	now := api.Timestamp(time.Now().Unix())
@@ -281,12 +313,26 @@ func Read(
 		for i, _ := range entities {
 			provableArr = append(provableArr, &entities[i])
 		}
+	case "directmessages":
+		entities, err := ReadDirectMessages(fingerprints, sanitisedBeginTimestamp, sanitisedEndTimestamp, opts.DirectMessage_Recipient, opts.AllProvables_Owner, opts.AllProvables_Limit, opts.AllProvables_Offset)
+		if err != nil {
+			return result, err
+		}
+		result.DirectMessages = entities
+		// Convert the result to []api.Provable
+		for i, _ := range entities {
+			provableArr = append(provableArr, &entities[i])
+		}
 	}
 	// We deal with filling the embedded fields. Embed handler has all the code for the different types of embeds.
 	embedErr := handleEmbeds(provableArr, &result, embeds)
 	if embedErr != nil {
 		return result, embedErr
 	}
+	// Realm partitioning happens after the embeds, so that the embedded entities are partitioned as well.
+	if len(opts.Realms) > 0 {
+		result.FilterRealms(opts.Realms)
+	}
 	return result, nil
 }
 
@@ -379,7 +425,7 @@ func ReadThreadEmbed(entities []api.Provable) ([]api.Thread, error) {
 		if err != nil {
 			return arr, err
 		}
-		rows, err := globals.DbInstance.Queryx(query, args...)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 		if err != nil {
 			return arr, err
 		}
@@ -428,7 +474,7 @@ func ReadPostEmbed(entities []api.Provable) ([]api.Post, error) {
 		if err != nil {
 			return arr, err
 		}
-		rows, err := globals.DbInstance.Queryx(query, args...)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 		if err != nil {
 			return arr, err
 		}
@@ -477,7 +523,7 @@ func ReadVoteEmbed(entities []api.Provable) ([]api.Vote, error) {
 		if err != nil {
 			return arr, err
 		}
-		rows, err := globals.DbInstance.Queryx(query, args...)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 		if err != nil {
 			return arr, err
 		}
@@ -532,7 +578,7 @@ func ReadKeyEmbed(entities []api.Provable, firstEmbedCache []api.Provable) ([]ap
 	if err != nil {
 		return arr, err
 	}
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return arr, err
 	}
@@ -584,6 +630,49 @@ func ReadBoards(
 	return arr, nil
 }
 
+// LookupBoard returns the board with the given fingerprint, if we have it.
+func LookupBoard(fingerprint api.Fingerprint) (api.Board, bool) {
+	boards, err := ReadBoards([]api.Fingerprint{fingerprint}, 0, 0, "", "", 0, 0)
+	if err != nil {
+		logging.Logf(1, "Board lookup failed. Fingerprint: %v, Error: %v", fingerprint, err)
+		return api.Board{}, false
+	}
+	if len(boards) == 0 {
+		return api.Board{}, false
+	}
+	return boards[0], true
+}
+
+// LookupContent returns the last update of the thread or post with the given fingerprint, if we have it. For one that was never updated, that's its creation.
+func LookupContent(fingerprint api.Fingerprint, isPost bool) (api.Timestamp, bool) {
+	var creation, lastUpdate api.Timestamp
+	if isPost {
+		posts, err := ReadDbPosts([]api.Fingerprint{fingerprint}, 0, 0, "", "", "", "", 0, 0)
+		if err != nil {
+			logging.Logf(1, "Post lookup failed. Fingerprint: %v, Error: %v", fingerprint, err)
+			return 0, false
+		}
+		if len(posts) == 0 {
+			return 0, false
+		}
+		creation, lastUpdate = posts[0].Creation, posts[0].LastUpdate
+	} else {
+		threads, err := ReadDbThreads([]api.Fingerprint{fingerprint}, 0, 0, "", "", 0, 0)
+		if err != nil {
+			logging.Logf(1, "Thread lookup failed. Fingerprint: %v, Error: %v", fingerprint, err)
+			return 0, false
+		}
+		if len(threads) == 0 {
+			return 0, false
+		}
+		creation, lastUpdate = threads[0].Creation, threads[0].LastUpdate
+	}
+	if lastUpdate < creation {
+		return creation, true
+	}
+	return lastUpdate, true
+}
+
 // ReadDbBoards returns the search result in DB form. This layer has a few fields like LocalArrival and LastReferenced not exposed to the API layer that allows for internal decision making.
 func ReadDbBoards(
 	fingerprints []api.Fingerprint,
@@ -640,7 +729,7 @@ func ReadDbBoards(
 		logging.Logf(1, "The request you've made to ReadDbBoards was invalid. Fps: %v, Start: %v, End: %v", fingerprints, beginTimestamp, endTimestamp)
 		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbBoards was invalid. Fps: %v, Start: %v, End: %v All opts: %#v", fingerprints, beginTimestamp, endTimestamp, opts))
 	}
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return dbArr, err
 	}
@@ -741,7 +830,7 @@ func ReadDbThreads(
 		logging.Logf(1, "The request you've made to ReadDbThreads was invalid. Reqtype: %v, Fps: %v, Start: %v, End: %v", reqtyp, fingerprints, beginTimestamp, endTimestamp)
 		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbThreads was invalid. Fps: %v, Start: %v, End: %v", fingerprints, beginTimestamp, endTimestamp))
 	}
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return dbArr, err
 	}
@@ -853,7 +942,7 @@ func ReadDbPosts(
 		logging.Logf(1, "The request you've made to ReadDbPosts was invalid. Fps: %v, Start: %v, End: %v", fingerprints, beginTimestamp, endTimestamp)
 		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbPosts was invalid. Fps: %v, Start: %v, End: %v", fingerprints, beginTimestamp, endTimestamp))
 	}
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return dbArr, err
 	}
@@ -870,6 +959,45 @@ func ReadDbPosts(
 	return dbArr, nil
 }
 
+// ReadThreadHistory reads the prior versions of a thread that were replaced by its updates, oldest first. The current version is not in it, that one is in the Threads table.
+func ReadThreadHistory(fingerprint api.Fingerprint) ([]api.Thread, error) {
+	var arr []api.Thread
+	var dbArr []DbThreadRevision
+	err := globals.DbInstance.Select(&dbArr, globals.DbInstance.Rebind("SELECT * FROM ThreadHistory WHERE Fingerprint = ? ORDER BY LastUpdate ASC"), fingerprint)
+	if err != nil {
+		return arr, err
+	}
+	for _, rev := range dbArr {
+		apiEntity, err := DBtoAPI(rev.DbThread)
+		if err != nil {
+			// Log the problem and go to the next iteration without saving this one.
+			logging.Log(1, err)
+			continue
+		}
+		arr = append(arr, apiEntity.(api.Thread))
+	}
+	return arr, nil
+}
+
+// ReadPostHistory reads the prior versions of a post that were replaced by its updates, oldest first. The current version is not in it, that one is in the Posts table.
+func ReadPostHistory(fingerprint api.Fingerprint) ([]api.Post, error) {
+	var arr []api.Post
+	var dbArr []DbPostRevision
+	err := globals.DbInstance.Select(&dbArr, globals.DbInstance.Rebind("SELECT * FROM PostHistory WHERE Fingerprint = ? ORDER BY LastUpdate ASC"), fingerprint)
+	if err != nil {
+		return arr, err
+	}
+	for _, rev := range dbArr {
+		apiEntity, err := DBtoAPI(rev.DbPost)
+		if err != nil {
+			logging.Log(1, err)
+			continue
+		}
+		arr = append(arr, apiEntity.(api.Post))
+	}
+	return arr, nil
+}
+
 // ReadVotes reads votes from the database. Even when there is a single result, it will still be arriving in an array to provide a consistent API.
 func ReadVotes(
 	fingerprints []api.Fingerprint,
@@ -998,7 +1126,7 @@ func ReadDbVotes(
 		logging.Logf(1, "The request you've made to ReadDbVotes was invalid. Fps: %v, Start: %v, End: %v, ReqType: %v", fingerprints, beginTimestamp, endTimestamp, reqtyp)
 		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbVotes was invalid. Fps: %v, Start: %v, End: %v ReqType: %v", fingerprints, beginTimestamp, endTimestamp, reqtyp))
 	}
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return dbArr, err
 	}
@@ -1018,7 +1146,7 @@ func ReadDbVotes(
 func readDbAddressesBasicSearch(Location api.Location, Sublocation api.Location, Port uint16) (*[]DbAddress, error) {
 	var dbArr []DbAddress
 	if len(Location) > 0 && Port > 0 { // Regular address search.
-		rows, err := globals.DbInstance.Queryx("SELECT * from Addresses WHERE Location = ? AND Sublocation = ? AND Port = ?", Location, Sublocation, Port)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind("SELECT * from Addresses WHERE Location = ? AND Sublocation = ? AND Port = ?"), Location, Sublocation, Port)
 		if err != nil {
 			return &dbArr, err
 		}
@@ -1043,10 +1171,10 @@ func readDbAddressesFirstXResultsSearch(maxResults int, offset int, addrType uin
 	var err error
 	if maxResults == 0 {
 		query = "SELECT * from Addresses WHERE AddressType = ? ORDER BY LocalArrival DESC OFFSET ?"
-		rows, err = globals.DbInstance.Queryx(query, addrType, offset)
+		rows, err = globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), addrType, offset)
 	} else if maxResults > 0 {
 		query = "SELECT * from Addresses WHERE AddressType = ? ORDER BY LocalArrival DESC LIMIT ? OFFSET ?"
-		rows, err = globals.DbInstance.Queryx(query, addrType, maxResults, offset)
+		rows, err = globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), addrType, maxResults, offset)
 	} else {
 		// if negative value
 		return &dbArr, errors.New("You've provided a negative maxResults value to address search.")
@@ -1098,7 +1226,7 @@ func readDbAddressesTimeRangeSearch(
 		return &dbArr, errors.New(fmt.Sprintf("You have provided an invalid time range search type. You provided: %s", searchType))
 	}
 	query := fmt.Sprintf("SELECT DISTINCT * from Addresses WHERE (%s > ? AND %s < ?) ORDER BY %s DESC", rangeSearchColumn, rangeSearchColumn, rangeSearchColumn)
-	rows, err := globals.DbInstance.Queryx(query, beginTimestamp, endTs)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), beginTimestamp, endTs)
 	if err != nil {
 		return &dbArr, err
 	}
@@ -1120,7 +1248,7 @@ func readAddressContainerResponse(beg, end api.Timestamp, addrType uint8, limit
 	// Filter by time range given, sort by fixed elements, and limit the results to limit
 	results := []DbAddress{}
 	q := "SELECT * FROM Addresses WHERE (LastSuccessfulPing > ? AND LastSuccessfulPing < ? AND AddressType = ?) ORDER BY LastSuccessfulSync DESC, LastSuccessfulPing DESC LIMIT ?"
-	r, err := globals.DbInstance.Queryx(q, beg, end, addrType, limit)
+	r, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(q), beg, end, addrType, limit)
 	defer r.Close() // In case of premature exit.
 	if err != nil {
 		return &results, err
@@ -1146,7 +1274,7 @@ func readDBAddressesAll(isDesc bool) (*[]DbAddress, error) {
 	} else {
 		q = "SELECT * FROM Addresses ORDER BY LastSuccessfulSync ASC, LastSuccessfulPing ASC, LocalArrival ASC LIMIT ?"
 	}
-	r, err := globals.DbInstance.Queryx(q, globals.BackendConfig.MaxAddressTableSize)
+	r, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(q), globals.BackendConfig.MaxAddressTableSize)
 	defer r.Close()
 	if err != nil {
 		return &results, err
@@ -1300,7 +1428,7 @@ func ReadDbKeys(
 		logging.Logf(1, "The request you've made to ReadDbKeys was invalid. Fps: %v, Start: %v, End: %v", fingerprints, beginTimestamp, endTimestamp)
 		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbKeys was invalid. Fps: %v, Start: %v, End: %v All opts: %#v", fingerprints, beginTimestamp, endTimestamp, opts))
 	}
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return dbArr, err
 	}
@@ -1438,7 +1566,7 @@ func ReadDbTruststates(
 		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbTruststates was invalid. Fps: %v, Start: %v, End: %v, Opts: %#v", fingerprints, beginTimestamp, endTimestamp, opts))
 	}
 
-	rows, err := globals.DbInstance.Queryx(query, args...)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
 	if err != nil {
 		return dbArr, err
 	}
@@ -1455,6 +1583,92 @@ func ReadDbTruststates(
 	return dbArr, nil
 }
 
+// ReadDirectMessages reads direct messages from the database. A frontend reads its inbox by the recipient, and its sent messages by the owner. Even when there is a single result, it will still be arriving in an array to provide a consistent API.
+func ReadDirectMessages(
+	fingerprints []api.Fingerprint,
+	beginTimestamp api.Timestamp,
+	endTimestamp api.Timestamp,
+	recipientfp string,
+	ownerfp string, limit, offset int,
+) ([]api.DirectMessage, error) {
+	var arr []api.DirectMessage
+	dbArr, err := ReadDbDirectMessages(fingerprints, beginTimestamp, endTimestamp, recipientfp, ownerfp, limit, offset)
+	if err != nil {
+		return arr, err
+	}
+	for _, entity := range dbArr {
+		apiEntity, err := DBtoAPI(entity)
+		if err != nil {
+			// Log the problem and go to the next iteration without saving this one.
+			logging.Log(1, err)
+			continue
+		}
+		arr = append(arr, apiEntity.(api.DirectMessage))
+	}
+	return arr, nil
+}
+
+func ReadDbDirectMessages(
+	fingerprints []api.Fingerprint,
+	beginTimestamp api.Timestamp,
+	endTimestamp api.Timestamp,
+	recipientfp string,
+	ownerfp string, limit, offset int,
+) ([]DbDirectMessage, error) {
+	var dbArr []DbDirectMessage
+	var query string
+	var args []interface{}
+	var err error
+	opts := reqtypeOpts{
+		fingerprints:   fingerprints,
+		beginTimestamp: beginTimestamp,
+		endTimestamp:   endTimestamp,
+		tclass:         -1,
+		typ:            -1,
+		targetFp:       recipientfp,
+		ownerFp:        ownerfp,
+		limit:          limit,
+		offset:         offset,
+	}
+	switch reqtype(opts) {
+	case "(fp)(ts)": // fingerprint + timespan
+		query, args, err = sqlx.In("SELECT * FROM DirectMessages WHERE Fingerprint IN (?) AND (LastReferenced >= ? AND LastReferenced <= ?);", fingerprints, beginTimestamp, endTimestamp)
+	case "(fp)": // fingerprint
+		query, args, err = sqlx.In("SELECT * FROM DirectMessages WHERE Fingerprint IN (?);", fingerprints)
+	case "(ts)": // timespan
+		query, args, err = sqlx.In("SELECT DISTINCT * from DirectMessages WHERE (LastReferenced >= ? AND LastReferenced <= ? ) ORDER BY LastReferenced DESC", beginTimestamp, endTimestamp)
+	case "(ts)(tafp)": // timespan + recipient
+		query, args, err = sqlx.In("SELECT * FROM DirectMessages WHERE Recipient = ? AND (LastReferenced >= ? AND LastReferenced <= ?) ORDER BY LastReferenced DESC;", recipientfp, beginTimestamp, endTimestamp)
+	case "(tafp)": // recipient
+		query, args, err = sqlx.In("SELECT * FROM DirectMessages WHERE Recipient = ? ORDER BY LastReferenced DESC;", recipientfp)
+	case "(ownr)": // owner
+		query, args, err = sqlx.In("SELECT * from DirectMessages WHERE (Owner = ?) ORDER BY LastReferenced DESC", ownerfp)
+	case "(ownr)(lim-offs)": // owner + limit/offset
+		query, args, err = sqlx.In("SELECT * from DirectMessages WHERE (Owner = ?) ORDER BY LastReferenced DESC LIMIT ? OFFSET ?", ownerfp, limit, offset)
+	default:
+		logging.Logf(1, "The request you've made to ReadDbDirectMessages was invalid. Fps: %v, Start: %v, End: %v, Opts: %#v", fingerprints, beginTimestamp, endTimestamp, opts)
+		return dbArr, errors.New(fmt.Sprintf("The request you've made to ReadDbDirectMessages was invalid. Fps: %v, Start: %v, End: %v, Opts: %#v", fingerprints, beginTimestamp, endTimestamp, opts))
+	}
+	if err != nil {
+		return dbArr, err
+	}
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind(query), args...)
+	if err != nil {
+		return dbArr, err
+	}
+	defer rows.Close() // In case of premature exit.
+	for rows.Next() {
+		var entity DbDirectMessage
+		err := rows.StructScan(&entity)
+		if err != nil {
+			return dbArr, err
+		}
+		dbArr = append(dbArr, entity)
+	}
+	rows.Close()
+	return dbArr, nil
+}
+
 // The Reader functions that return DB instances, rather than API ones.
 
 // ReadDBBoardOwners reads board owners from the database. Even when there is a single result, it will still be arriving in an array to provide a consistent API.
@@ -1465,7 +1679,7 @@ func ReadDBBoardOwners(BoardFingerprint api.Fingerprint,
 	var arr []DbBoardOwner
 	// If this query is without a key fingerprint (we want all addresses with that board fingerprint), change the query as such.
 	if KeyFingerprint == "" {
-		rows, err := globals.DbInstance.Queryx("SELECT * from BoardOwners WHERE BoardFingerprint = ?", BoardFingerprint)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind("SELECT * from BoardOwners WHERE BoardFingerprint = ?"), BoardFingerprint)
 		if err != nil {
 			logging.Log(1, err)
 		}
@@ -1480,7 +1694,7 @@ func ReadDBBoardOwners(BoardFingerprint api.Fingerprint,
 		}
 		rows.Close()
 	} else {
-		rows, err := globals.DbInstance.Queryx("SELECT * from BoardOwners WHERE BoardFingerprint = ? AND KeyFingerprint = ?", BoardFingerprint, KeyFingerprint)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind("SELECT * from BoardOwners WHERE BoardFingerprint = ? AND KeyFingerprint = ?"), BoardFingerprint, KeyFingerprint)
 		if err != nil {
 			logging.Log(1, err)
 		}
@@ -1502,7 +1716,7 @@ func ReadDBBoardOwners(BoardFingerprint api.Fingerprint,
 
 func ReadDBSubprotocols(Location api.Location, Sublocation api.Location, Port uint16) ([]DbSubprotocol, error) {
 	var fpArr []api.Fingerprint
-	rows, err := globals.DbInstance.Queryx("SELECT * from AddressesSubprotocols WHERE AddressLocation = ? AND AddressSublocation = ? AND AddressPort = ?", Location, Sublocation, Port)
+	rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind("SELECT * from AddressesSubprotocols WHERE AddressLocation = ? AND AddressSublocation = ? AND AddressPort = ?"), Location, Sublocation, Port)
 	if err != nil {
 		logging.Log(1, err)
 	}
@@ -1520,7 +1734,7 @@ func ReadDBSubprotocols(Location api.Location, Sublocation api.Location, Port ui
 	// For each fingerprint, get the matching subprotocol.
 	var subprotArr []DbSubprotocol
 	for _, val := range fpArr {
-		rows, err := globals.DbInstance.Queryx("SELECT * from Subprotocols WHERE Fingerprint = ?", val)
+		rows, err := globals.DbInstance.Queryx(globals.DbInstance.Rebind("SELECT * from Subprotocols WHERE Fingerprint = ?"), val)
 		if err != nil {
 			logging.Log(1, err)
 		}
@@ -1615,7 +1829,7 @@ err = db.Get(&id, "SELECT count(*) FROM place")
 
 func GetBoardThreadsCount(fp string) int {
 	var count int
-	err := globals.DbInstance.Get(&count, "SELECT COUNT(1) from Threads where Board= ?", fp)
+	err := globals.DbInstance.Get(&count, globals.DbInstance.Rebind("SELECT COUNT(1) from Threads where Board= ?"), fp)
 	if err != nil {
 		logging.Log(1, err)
 	}
@@ -1624,7 +1838,7 @@ func GetBoardThreadsCount(fp string) int {
 
 func GetThreadPostsCount(fp string) int {
 	var count int
-	err := globals.DbInstance.Get(&count, "SELECT COUNT(1) from Posts where Thread= ?", fp)
+	err := globals.DbInstance.Get(&count, globals.DbInstance.Rebind("SELECT COUNT(1) from Posts where Thread= ?"), fp)
 	if err != nil {
 		logging.Log(1, err)
 	}
@@ -6,24 +6,28 @@ package persistence
 import (
 	"aether-core/aether/backend/feapiconsumer"
 	"aether-core/aether/io/api"
+	"database/sql"
 	"fmt"
 	// _ "github.com/mattn/go-sqlite3"
 	// "aether-core/aether/backend/metrics"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/transport"
 	"errors"
 	"github.com/fatih/color"
+	"github.com/jmoiron/sqlx"
 	// "github.com/jmoiron/sqlx/types"
 	// "github.com/davecgh/go-spew/spew"
 	// "runtime"
 	// "github.com/pkg/errors"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-// Node is a non-communicating entity that holds the LastCheckin timestamps of each of the entities provided in the remote node. There is no way to send this data over to somebody, this is entirely local. There is also no batch processing because there is no situation in which you would need to insert multiple nodes at the same time (since you won't be connecting to multiple nodes simultaneously)
+// Node is a non-communicating entity that holds the LastCheckin timestamps of each of the entities provided in the remote node. There is no way to send this data over to somebody, this is entirely local. There is also no batch processing because there is no situation in which you would need to insert multiple nodes at the same time (every sync inserts only the node it is syncing with)
 
 func InsertNode(n DbNode) error {
 	err := insertNode(n)
@@ -62,7 +66,7 @@ func insertNode(n DbNode) error {
 	if err != nil {
 		return err
 	}
-	_, err2 := tx.NamedExec(nodeInsert, n)
+	_, err2 := txNamedExec(tx, getSQLCommands("dbNode")[0], n)
 	if err2 != nil {
 		return err2
 	}
@@ -86,6 +90,7 @@ func insertNode(n DbNode) error {
 	nodeAsMap["KeysLastCheckin"] = strconv.Itoa(int(n.KeysLastCheckin))
 	nodeAsMap["TruststatesLastCheckin"] = strconv.Itoa(int(n.TruststatesLastCheckin))
 	nodeAsMap["AddressesLastCheckin"] = strconv.Itoa(int(n.AddressesLastCheckin))
+	nodeAsMap["DirectMessagesLastCheckin"] = strconv.Itoa(int(n.DirectMessagesLastCheckin))
 	// metrics.CollateMetrics("NodeInsertionsSinceLastMetricsDbg", nodeAsMap)
 	// client, conn := metrics.StartConnection()
 	// defer conn.Close()
@@ -93,6 +98,42 @@ func insertNode(n DbNode) error {
 	return nil
 }
 
+// InsertOrUpdatePeerReputations saves the reputations given, replacing the ones we had for the same addresses.
+func InsertOrUpdatePeerReputations(rs []DbPeerReputation) error {
+	if len(rs) == 0 {
+		return nil
+	}
+	tx, err := globals.DbInstance.Beginx()
+	if err != nil {
+		return err
+	}
+	for k, _ := range rs {
+		_, err2 := txNamedExec(tx, getSQLCommands("dbPeerReputation")[0], rs[k])
+		if err2 != nil {
+			logging.Logf(1, "InsertOrUpdatePeerReputations could not save the reputation of %s:%d. Error: %v", rs[k].Location, rs[k].Port, err2)
+		}
+	}
+	err3 := tx.Commit()
+	if err3 != nil {
+		tx.Rollback()
+		logging.Log(1, fmt.Sprintf("InsertOrUpdatePeerReputations encountered an error when trying to commit to the database. Error is: %s", err3))
+		return err3
+	}
+	return nil
+}
+
+// DeletePeerReputationsBefore removes the reputations that haven't been updated since the cutoff. Those are remotes we haven't seen in a long while, and what we knew about them is stale.
+func DeletePeerReputationsBefore(cutoff api.Timestamp) error {
+	_, err := globals.DbInstance.Exec(globals.DbInstance.Rebind("DELETE FROM PeerReputations WHERE LastUpdate < ?"), cutoff)
+	return err
+}
+
+// ResetSubscriptionCheckins forgets the thread, post and vote sync timestamps of all remotes, so that the next syncs with them fetch these from the beginning. The subscriptions of the node widening is what calls for this.
+func ResetSubscriptionCheckins() error {
+	_, err := globals.DbInstance.Exec("UPDATE Nodes SET ThreadsLastCheckin = 0, PostsLastCheckin = 0, VotesLastCheckin = 0")
+	return err
+}
+
 func AddrTrustedInsert(a *[]api.Address) error {
 	if globals.BackendTransientConfig.ShutdownInitiated {
 		return nil
@@ -122,13 +163,13 @@ func AddrTrustedInsert(a *[]api.Address) error {
 			logging.Log(1, fmt.Sprintf("AddrTrustedInsert encountered an error in checking required fields. Error: %#v", err3))
 			continue
 		}
-		_, err5 := tx.NamedExec(getSQLCommands("dbAddressUpdate")[0], addrPack.Address)
+		_, err5 := txNamedExec(tx, getSQLCommands("dbAddressUpdate")[0], addrPack.Address)
 		if err5 != nil {
 			logging.Log(1, err5)
 		}
 		if len(addrPack.Subprotocols) > 0 {
 			for _, sp := range addrPack.Subprotocols {
-				_, err6 := tx.NamedExec(getSQLCommands("dbSubprotocol")[0], sp)
+				_, err6 := txNamedExec(tx, getSQLCommands("dbSubprotocol")[0], sp)
 				if err6 != nil {
 					logging.Log(1, err6)
 				}
@@ -136,7 +177,7 @@ func AddrTrustedInsert(a *[]api.Address) error {
 		}
 		if len(addrPack.Junctions) > 0 {
 			for _, jn := range addrPack.Junctions {
-				_, err7 := tx.NamedExec(getSQLCommands("dbAddressSubprotocol")[0], jn)
+				_, err7 := txNamedExec(tx, getSQLCommands("dbAddressSubprotocol")[0], jn)
 				if err7 != nil {
 					logging.Log(1, err)
 				}
@@ -147,7 +188,7 @@ func AddrTrustedInsert(a *[]api.Address) error {
 	if err8 != nil {
 		tx.Rollback()
 		logging.Log(1, fmt.Sprintf("AddrTrustedInsert encountered an error when trying to commit to the database. Error is: %s", err8))
-		return errors.New(fmt.Sprintf("AddrTrustedInsert encountered an error when trying to commit to the database.", err8))
+		return errors.New(fmt.Sprintf("AddrTrustedInsert encountered an error when trying to commit to the database. Error is: %s", err8))
 	}
 	return nil
 }
@@ -223,13 +264,13 @@ func insertOrUpdateAddress(a api.Address) error {
 	if err3 != nil {
 		logging.Log(1, err3)
 	}
-	_, err4 := tx.NamedExec(getSQLCommands("dbAddressUpdate")[0], dbAddress)
+	_, err4 := txNamedExec(tx, getSQLCommands("dbAddressUpdate")[0], dbAddress)
 	if err4 != nil {
 		logging.Log(1, err4)
 	}
 	if len(dbSubprotocols) > 0 {
 		for _, dbSubprotocol := range dbSubprotocols {
-			_, err5 := tx.NamedExec(getSQLCommands("dbSubprotocol")[0], dbSubprotocol)
+			_, err5 := txNamedExec(tx, getSQLCommands("dbSubprotocol")[0], dbSubprotocol)
 			if err5 != nil {
 				logging.Log(1, err5)
 			}
@@ -237,7 +278,7 @@ func insertOrUpdateAddress(a api.Address) error {
 	}
 	if len(dbJunctionItems) > 0 {
 		for _, dbJunctionItem := range dbJunctionItems {
-			_, err5 := tx.NamedExec(getSQLCommands("dbAddressSubprotocol")[0], dbJunctionItem)
+			_, err5 := txNamedExec(tx, getSQLCommands("dbAddressSubprotocol")[0], dbJunctionItem)
 			if err5 != nil {
 				logging.Log(1, err5)
 			}
@@ -265,8 +306,13 @@ func generateAdrSprotJunctionItem(addr DbAddress, sprot DbSubprotocol) DbAddress
 	return adrSprot
 }
 
+// batchInsertLock makes sure only one batch is committed at a time. Multiple syncs can be running at the same time, and each of them commits what it receives as it goes. Letting those transactions race each other would make 'DB is locked' errors the norm on SQLite, and it would break the guarantee that every batch is a single instant in time (see insertTimestamp in batchInsert).
+var batchInsertLock sync.Mutex
+
 // This is where we capture DB errors like 'DB is locked' and take action, such as retrying.
 func BatchInsert(apiObjects []interface{}) (InsertMetrics, error) {
+	batchInsertLock.Lock()
+	defer batchInsertLock.Unlock()
 	var im InsertMetrics
 	var err error
 	im, err = batchInsert(&apiObjects)
@@ -294,13 +340,14 @@ func BatchInsert(apiObjects []interface{}) (InsertMetrics, error) {
 }
 
 type batchBucket struct {
-	DbBoards      []DbBoard
-	DbThreads     []DbThread
-	DbPosts       []DbPost
-	DbVotes       []DbVote
-	DbKeys        []DbKey
-	DbTruststates []DbTruststate
-	DbAddresses   []DbAddress
+	DbBoards         []DbBoard
+	DbThreads        []DbThread
+	DbPosts          []DbPost
+	DbVotes          []DbVote
+	DbKeys           []DbKey
+	DbTruststates    []DbTruststate
+	DbDirectMessages []DbDirectMessage
+	DbAddresses      []DbAddress
 	// Sub objects
 	// // Parent: Board
 	DbBoardOwners         []DbBoardOwner
@@ -326,6 +373,8 @@ type InsertMetrics struct {
 	KeysDBCommitTime               float64
 	TruststatesReceived            int
 	TruststatesDBCommitTime        float64
+	DirectMessagesReceived         int
+	DirectMessagesDBCommitTime     float64
 	AddressesReceived              int
 	AddressesDBCommitTime          float64
 	MultipleInsertDBCommitTime     float64
@@ -348,6 +397,8 @@ func (im *InsertMetrics) Add(im2 InsertMetrics) {
 	im.KeysDBCommitTime = im.KeysDBCommitTime + im2.KeysDBCommitTime
 	im.TruststatesReceived = im.TruststatesReceived + im2.TruststatesReceived
 	im.TruststatesDBCommitTime = im.TruststatesDBCommitTime + im2.TruststatesDBCommitTime
+	im.DirectMessagesReceived = im.DirectMessagesReceived + im2.DirectMessagesReceived
+	im.DirectMessagesDBCommitTime = im.DirectMessagesDBCommitTime + im2.DirectMessagesDBCommitTime
 	im.AddressesReceived = im.AddressesReceived + im2.AddressesReceived
 	im.AddressesDBCommitTime = im.AddressesDBCommitTime + im2.AddressesDBCommitTime
 	im.MultipleInsertDBCommitTime = im.MultipleInsertDBCommitTime + im2.MultipleInsertDBCommitTime
@@ -415,6 +466,12 @@ func batchInsert(apiObjectsPtr *[]interface{}) (InsertMetrics, error) {
 
 			// This also means that we will actually be not using the Subprotocols data, as that would be untrusted data.
 
+			// Addresses on a transport this node doesn't carry (a Unix socket or an in-process listener of some other machine) can't be reached, and they shouldn't be dialed even if they could.
+			if !transport.Carried(string(dbObject.Address.Location)) {
+				logging.Logf(2, "This address is on a transport this node doesn't carry. Skipping. Location: %s", dbObject.Address.Location)
+				continue
+			}
+
 			dbObject.Address.LocationType = 0       // IPv4 or 6
 			dbObject.Address.Type = 0               // 2 = live, 255 = static
 			dbObject.Address.LastSuccessfulPing = 0 // We cannot trust someone else's lsp timestamp
@@ -430,6 +487,8 @@ func batchInsert(apiObjectsPtr *[]interface{}) (InsertMetrics, error) {
 			bb.DbKeys = append(bb.DbKeys, dbObject)
 		case DbTruststate:
 			bb.DbTruststates = append(bb.DbTruststates, dbObject)
+		case DbDirectMessage:
+			bb.DbDirectMessages = append(bb.DbDirectMessages, dbObject)
 		default:
 			return InsertMetrics{}, errors.New(
 				fmt.Sprintf(
@@ -447,12 +506,13 @@ func batchInsert(apiObjectsPtr *[]interface{}) (InsertMetrics, error) {
 	im.VotesReceived = len(bb.DbVotes)
 	im.KeysReceived = len(bb.DbKeys)
 	im.TruststatesReceived = len(bb.DbTruststates)
+	im.DirectMessagesReceived = len(bb.DbDirectMessages)
 	im.AddressesReceived = len(bb.DbAddresses)
 	elapsed := time.Since(start)
 	im.TimeElapsedSeconds = int(elapsed.Seconds())
 	clr := color.New(color.FgCyan)
 	logging.Log(2, clr.Sprintf("It took %v to insert %v objects. %s", elapsed.Round(time.Millisecond), numberOfObjectsCommitted, generateInsertLog(&bb)))
-	committedToDb := len(bb.DbBoards) + len(bb.DbThreads) + len(bb.DbPosts) + len(bb.DbVotes) + len(bb.DbKeys) + +len(bb.DbTruststates) + len(bb.DbAddresses)
+	committedToDb := len(bb.DbBoards) + len(bb.DbThreads) + len(bb.DbPosts) + len(bb.DbVotes) + len(bb.DbKeys) + +len(bb.DbTruststates) + len(bb.DbDirectMessages) + len(bb.DbAddresses)
 	if (committedToDb != numberOfObjectsCommitted) && numberOfObjectsCommitted == 1 {
 		clr2 := color.New(color.FgRed)
 		logging.Log(1, clr2.Sprintf("There is a discrepancy between the number of entities in the inbound package, and those that end up being committed. Inbound entities count: %d, Committed to DB: %d", numberOfObjectsCommitted, committedToDb))
@@ -497,6 +557,9 @@ func generateInsertLog(bb *batchBucket) string {
 	if len(bb.DbTruststates) > 0 {
 		str = str + fmt.Sprintf(" %d Truststates", len(bb.DbTruststates))
 	}
+	if len(bb.DbDirectMessages) > 0 {
+		str = str + fmt.Sprintf(" %d Direct messages", len(bb.DbDirectMessages))
+	}
 	if len(bb.DbAddresses) > 0 {
 		str = str + fmt.Sprintf(" %d Untrusted Addresses", len(bb.DbAddresses))
 	}
@@ -510,6 +573,7 @@ func generateInsertLog(bb *batchBucket) string {
 		len(bb.DbVotes) == 0 &&
 		len(bb.DbKeys) == 0 &&
 		len(bb.DbTruststates) == 0 &&
+		len(bb.DbDirectMessages) == 0 &&
 		len(bb.DbAddresses) == 0 {
 		str = str + " Nothing."
 	} else {
@@ -536,7 +600,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbBoard := range bb.DbBoards {
-				_, err := tx.NamedExec(cmd, dbBoard)
+				_, err := txNamedExec(tx, cmd, dbBoard)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -548,7 +612,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbThread := range bb.DbThreads {
-				_, err := tx.NamedExec(cmd, dbThread)
+				_, err := txNamedExec(tx, cmd, dbThread)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -560,7 +624,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbPost := range bb.DbPosts {
-				_, err := tx.NamedExec(cmd, dbPost)
+				_, err := txNamedExec(tx, cmd, dbPost)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -572,7 +636,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbVote := range bb.DbVotes {
-				_, err := tx.NamedExec(cmd, dbVote)
+				_, err := txNamedExec(tx, cmd, dbVote)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -584,7 +648,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbKey := range bb.DbKeys {
-				_, err := tx.NamedExec(cmd, dbKey)
+				_, err := txNamedExec(tx, cmd, dbKey)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -596,7 +660,19 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbTruststate := range bb.DbTruststates {
-				_, err := tx.NamedExec(cmd, dbTruststate)
+				_, err := txNamedExec(tx, cmd, dbTruststate)
+				if err != nil {
+					logging.Log(1, err)
+				}
+			}
+		}
+	}
+	if len(bb.DbDirectMessages) > 0 {
+		etype := "dbDirectMessage"
+		insertType = append(insertType, etype)
+		for _, cmd := range getSQLCommands(etype) {
+			for _, dbDirectMessage := range bb.DbDirectMessages {
+				_, err := txNamedExec(tx, cmd, dbDirectMessage)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -608,7 +684,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbAddress := range bb.DbAddresses {
-				_, err := tx.NamedExec(cmd, dbAddress)
+				_, err := txNamedExec(tx, cmd, dbAddress)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -616,7 +692,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		}
 		// fmt.Println("Db address prune hits.")
 		cmds := getSQLCommands("dbAddressPrune")
-		_, err := tx.Exec(cmds[0], globals.BackendConfig.GetMaxAddressTableSize())
+		_, err := tx.Exec(tx.Rebind(cmds[0]), globals.BackendConfig.GetMaxAddressTableSize())
 		if err != nil {
 			logging.Log(1, err)
 		}
@@ -626,7 +702,7 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 		insertType = append(insertType, etype)
 		for _, cmd := range getSQLCommands(etype) {
 			for _, dbBoardOwner := range bb.DbBoardOwners {
-				_, err := tx.NamedExec(cmd, dbBoardOwner)
+				_, err := txNamedExec(tx, cmd, dbBoardOwner)
 				if err != nil {
 					logging.Log(1, err)
 				}
@@ -659,6 +735,8 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 			im.KeysDBCommitTime = toolbox.Round(elapsed.Seconds(), 0.1)
 		} else if insertType[0] == "dbTruststate" {
 			im.TruststatesDBCommitTime = toolbox.Round(elapsed.Seconds(), 0.1)
+		} else if insertType[0] == "dbDirectMessage" {
+			im.DirectMessagesDBCommitTime = toolbox.Round(elapsed.Seconds(), 0.1)
 		} else if insertType[0] == "dbAddress" {
 			im.AddressesDBCommitTime = toolbox.Round(elapsed.Seconds(), 0.1)
 		}
@@ -676,8 +754,15 @@ func insert(batchBucket *batchBucket, im *InsertMetrics) error {
 
 // getSQLCommands determines the order of execution of these commands based on the order they're appended here. The fundamental rule is that all of these are gated, and gate only works in the case the object has not already inserted, so the object's actual insertion always comes last.
 func getSQLCommands(dbType string) []string {
+	if globals.BackendConfig.GetDbEngine() == "postgres" {
+		return getPostgresSQLCommands(dbType)
+	}
 	var sqlstrs []string
-	if dbType == "dbBoard" {
+	if dbType == "dbNode" {
+		sqlstrs = append(sqlstrs, nodeInsert)
+	} else if dbType == "dbPeerReputation" {
+		sqlstrs = append(sqlstrs, peerReputationInsert)
+	} else if dbType == "dbBoard" {
 		sqlstrs =
 			append(sqlstrs, boardInsert_BoardsBoardOwners_DeletePriors)
 		sqlstrs =
@@ -691,6 +776,8 @@ func getSQLCommands(dbType string) []string {
 			append(sqlstrs, threadInsert_ThreadsKey_LastReferencedUpdate)
 		sqlstrs =
 			append(sqlstrs, threadInsert_ThreadsBoardsKey_LastReferencedUpdate)
+		sqlstrs =
+			append(sqlstrs, threadInsert_ThreadHistory_KeepPrior)
 		sqlstrs =
 			append(sqlstrs, threadInsert)
 	} else if dbType == "dbPost" {
@@ -708,6 +795,8 @@ func getSQLCommands(dbType string) []string {
 			append(sqlstrs, postInsert_PostsPosts_Recursive_LastReferencedUpdate)
 		sqlstrs =
 			append(sqlstrs, postInsert_PostsPostsKeys_Recursive_LastReferencedUpdate)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostHistory_KeepPrior)
 		sqlstrs =
 			append(sqlstrs, postInsert)
 	} else if dbType == "dbVote" {
@@ -725,6 +814,18 @@ func getSQLCommands(dbType string) []string {
 			append(sqlstrs, truststateInsert_TruststatesTargetKey_LastReferencedUpdate)
 		sqlstrs =
 			append(sqlstrs, truststateInsert)
+	} else if dbType == "dbDirectMessage" {
+		sqlstrs =
+			append(sqlstrs, directMessageInsert_DirectMessagesKeys_LastReferencedUpdate)
+		if globals.BackendConfig.GetDbEngine() == "mysql" {
+			sqlstrs =
+				append(sqlstrs, directMessageInsertMySQL)
+		} else if globals.BackendConfig.GetDbEngine() == "sqlite" {
+			sqlstrs =
+				append(sqlstrs, directMessageInsertSQLite)
+		} else {
+			logging.LogCrash(fmt.Sprintf("Db Engine type not recognised."))
+		}
 	} else if dbType == "dbAddress" { // untrusted address
 		if globals.BackendConfig.GetDbEngine() == "mysql" {
 			sqlstrs =
@@ -755,6 +856,113 @@ func getSQLCommands(dbType string) []string {
 	return sqlstrs
 }
 
+// getPostgresSQLCommands is the Postgres counterpart of getSQLCommands. The order of execution is the same.
+func getPostgresSQLCommands(dbType string) []string {
+	var sqlstrs []string
+	if dbType == "dbNode" {
+		sqlstrs = append(sqlstrs, nodeInsertPostgres)
+	} else if dbType == "dbPeerReputation" {
+		sqlstrs = append(sqlstrs, peerReputationInsertPostgres)
+	} else if dbType == "dbBoard" {
+		sqlstrs =
+			append(sqlstrs, boardInsert_BoardsBoardOwners_DeletePriorsPostgres)
+		sqlstrs =
+			append(sqlstrs, boardInsert_BoardsKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, boardInsertPostgres)
+	} else if dbType == "dbThread" {
+		sqlstrs =
+			append(sqlstrs, threadInsert_ThreadsBoard_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, threadInsert_ThreadsKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, threadInsert_ThreadsBoardsKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, threadInsert_ThreadHistory_KeepPriorPostgres)
+		sqlstrs =
+			append(sqlstrs, threadInsertPostgres)
+	} else if dbType == "dbPost" {
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsBoard_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsBoardsKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsThread_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsThreadsKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsPosts_Recursive_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostsPostsKeys_Recursive_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, postInsert_PostHistory_KeepPriorPostgres)
+		sqlstrs =
+			append(sqlstrs, postInsertPostgres)
+	} else if dbType == "dbVote" {
+		sqlstrs =
+			append(sqlstrs, voteInsert_VotesKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, voteInsertPostgres)
+	} else if dbType == "dbKey" {
+		sqlstrs =
+			append(sqlstrs, keyInsertPostgres)
+	} else if dbType == "dbTruststate" {
+		sqlstrs =
+			append(sqlstrs, truststateInsert_TruststatesKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, truststateInsert_TruststatesTargetKey_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, truststateInsertPostgres)
+	} else if dbType == "dbDirectMessage" {
+		sqlstrs =
+			append(sqlstrs, directMessageInsert_DirectMessagesKeys_LastReferencedUpdatePostgres)
+		sqlstrs =
+			append(sqlstrs, directMessageInsertPostgres)
+	} else if dbType == "dbAddress" { // untrusted address
+		sqlstrs = append(sqlstrs, addressInsertPostgres)
+	} else if dbType == "dbAddressUpdate" { // trusted address
+		sqlstrs = append(sqlstrs, addressUpdateInsertPostgres)
+	} else if dbType == "dbBoardOwner" {
+		sqlstrs = append(sqlstrs, boardOwnerInsertPostgres)
+	} else if dbType == "dbSubprotocol" {
+		sqlstrs = append(sqlstrs, subprotocolInsertPostgres)
+	} else if dbType == "dbAddressSubprotocol" {
+		sqlstrs = append(sqlstrs, addressSubprotocolInsertPostgres)
+	} else if dbType == "dbAddressPrune" {
+		sqlstrs = append(sqlstrs, addressPrune) // Engine-agnostic, rebound at the call site.
+	}
+	return sqlstrs
+}
+
+/*
+txNamedExec runs a named statement within a transaction.
+
+Why not just call tx.NamedExec?
+
+SQLite and MySQL let a transaction carry on after one of its statements fails, and our insert loops rely on that: a single bad entity is logged and skipped, and the rest of the batch still commits. Postgres instead marks the whole transaction as aborted at the first error, and every statement after that fails until rollback. To keep the same behaviour on Postgres, we wrap each statement in a savepoint and roll back only to that savepoint on failure.
+*/
+func txNamedExec(tx *sqlx.Tx, query string, arg interface{}) (sql.Result, error) {
+	if globals.BackendConfig.GetDbEngine() != "postgres" {
+		return tx.NamedExec(query, arg)
+	}
+	_, err := tx.Exec("SAVEPOINT aether_stmt")
+	if err != nil {
+		return nil, err
+	}
+	res, err2 := tx.NamedExec(query, arg)
+	if err2 != nil {
+		tx.Exec("ROLLBACK TO SAVEPOINT aether_stmt")
+		return nil, err2
+	}
+	_, err3 := tx.Exec("RELEASE SAVEPOINT aether_stmt")
+	if err3 != nil {
+		return nil, err3
+	}
+	return res, nil
+}
+
 // enforceNoEmptyIdentityFields enforces that nothing will enter the database without having proper identity columns. For most objects this is Fingerprint(s), for some, like address, it's a combination of multiple fields.
 func enforceNoEmptyIdentityFields(object interface{}) error {
 	switch obj := object.(type) {
@@ -833,6 +1041,12 @@ func enforceNoEmptyIdentityFields(object interface{}) error {
 				fmt.Sprintf(
 					"This trust state has an empty primary key. Truststate: %#v\n", obj))
 		}
+	case DbDirectMessage:
+		if obj.Fingerprint == "" {
+			return errors.New(
+				fmt.Sprintf(
+					"This direct message has an empty primary key. DirectMessage: %#v\n", obj))
+		}
 	}
 	return nil
 }
@@ -962,6 +1176,23 @@ func enforceNoEmptyRequiredFields(object interface{}) error {
 				fmt.Sprintf(
 					"This truststate has the PoW field empty. Truststate: %#v\n", obj))
 		}
+	case DbDirectMessage:
+		if obj.Recipient == "" ||
+			obj.Body == "" ||
+			obj.Creation == 0 ||
+			obj.EntityVersion == 0 ||
+			obj.Signature == "" ||
+			len(obj.Owner) == 0 ||
+			len(obj.OwnerPublicKey) == 0 {
+			return errors.New(
+				fmt.Sprintf(
+					"This direct message has some required fields empty (One or more of: Recipient, Body, Creation, Signature, PoW, EntityVersion, Owner, OwnerPublicKey). DirectMessage: %#v\n", obj))
+		}
+		if powEnabled() && obj.ProofOfWork == "" {
+			return errors.New(
+				fmt.Sprintf(
+					"This direct message has the PoW field empty. DirectMessage: %#v\n", obj))
+		}
 	}
 	return nil
 }
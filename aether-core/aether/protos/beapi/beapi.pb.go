@@ -37,6 +37,10 @@ It has these top-level messages:
 	MintedContentResponse
 	ConnectToRemoteRequest
 	ConnectToRemoteResponse
+	EntityHistoryRequest
+	EntityHistoryResponse
+	DirectMessagesRequest
+	DirectMessagesResponse
 */
 package beapi
 
@@ -750,14 +754,15 @@ func (m *ThreadPostsCountResponse) GetCount() int32 {
 }
 
 type MintedContentPayload struct {
-	RequesterId *RequesterId         `protobuf:"bytes,1,opt,name=RequesterId" json:"RequesterId,omitempty"`
-	Boards      []*mimapi.Board      `protobuf:"bytes,2,rep,name=Boards" json:"Boards,omitempty"`
-	Threads     []*mimapi.Thread     `protobuf:"bytes,3,rep,name=Threads" json:"Threads,omitempty"`
-	Posts       []*mimapi.Post       `protobuf:"bytes,4,rep,name=Posts" json:"Posts,omitempty"`
-	Votes       []*mimapi.Vote       `protobuf:"bytes,5,rep,name=Votes" json:"Votes,omitempty"`
-	Keys        []*mimapi.Key        `protobuf:"bytes,6,rep,name=Keys" json:"Keys,omitempty"`
-	Truststates []*mimapi.Truststate `protobuf:"bytes,7,rep,name=Truststates" json:"Truststates,omitempty"`
-	Addresses   []*mimapi.Address    `protobuf:"bytes,8,rep,name=Addresses" json:"Addresses,omitempty"`
+	RequesterId    *RequesterId            `protobuf:"bytes,1,opt,name=RequesterId" json:"RequesterId,omitempty"`
+	Boards         []*mimapi.Board         `protobuf:"bytes,2,rep,name=Boards" json:"Boards,omitempty"`
+	Threads        []*mimapi.Thread        `protobuf:"bytes,3,rep,name=Threads" json:"Threads,omitempty"`
+	Posts          []*mimapi.Post          `protobuf:"bytes,4,rep,name=Posts" json:"Posts,omitempty"`
+	Votes          []*mimapi.Vote          `protobuf:"bytes,5,rep,name=Votes" json:"Votes,omitempty"`
+	Keys           []*mimapi.Key           `protobuf:"bytes,6,rep,name=Keys" json:"Keys,omitempty"`
+	Truststates    []*mimapi.Truststate    `protobuf:"bytes,7,rep,name=Truststates" json:"Truststates,omitempty"`
+	Addresses      []*mimapi.Address       `protobuf:"bytes,8,rep,name=Addresses" json:"Addresses,omitempty"`
+	DirectMessages []*mimapi.DirectMessage `protobuf:"bytes,9,rep,name=DirectMessages" json:"DirectMessages,omitempty"`
 }
 
 func (m *MintedContentPayload) Reset()                    { *m = MintedContentPayload{} }
@@ -821,6 +826,13 @@ func (m *MintedContentPayload) GetAddresses() []*mimapi.Address {
 	return nil
 }
 
+func (m *MintedContentPayload) GetDirectMessages() []*mimapi.DirectMessage {
+	if m != nil {
+		return m.DirectMessages
+	}
+	return nil
+}
+
 type MintedContentResponse struct {
 	Status *Status `protobuf:"bytes,1,opt,name=Status" json:"Status,omitempty"`
 }
@@ -877,6 +889,111 @@ func (m *ConnectToRemoteResponse) GetStatus() *Status {
 	return nil
 }
 
+type EntityHistoryRequest struct {
+	RequesterId *RequesterId `protobuf:"bytes,1,opt,name=RequesterId" json:"RequesterId,omitempty"`
+	Fingerprint string       `protobuf:"bytes,2,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
+}
+
+func (m *EntityHistoryRequest) Reset()                    { *m = EntityHistoryRequest{} }
+func (m *EntityHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*EntityHistoryRequest) ProtoMessage()               {}
+func (*EntityHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *EntityHistoryRequest) GetRequesterId() *RequesterId {
+	if m != nil {
+		return m.RequesterId
+	}
+	return nil
+}
+
+func (m *EntityHistoryRequest) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+type EntityHistoryResponse struct {
+	Status *Status `protobuf:"bytes,1,opt,name=Status" json:"Status,omitempty"`
+	// Only one of these is filled, depending on what the fingerprint belongs to.
+	Threads []*mimapi.Thread `protobuf:"bytes,2,rep,name=Threads" json:"Threads,omitempty"`
+	Posts   []*mimapi.Post   `protobuf:"bytes,3,rep,name=Posts" json:"Posts,omitempty"`
+}
+
+func (m *EntityHistoryResponse) Reset()                    { *m = EntityHistoryResponse{} }
+func (m *EntityHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*EntityHistoryResponse) ProtoMessage()               {}
+func (*EntityHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *EntityHistoryResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *EntityHistoryResponse) GetThreads() []*mimapi.Thread {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *EntityHistoryResponse) GetPosts() []*mimapi.Post {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
+}
+
+type DirectMessagesRequest struct {
+	RequesterId *RequesterId `protobuf:"bytes,1,opt,name=RequesterId" json:"RequesterId,omitempty"`
+	Filters     *Filters     `protobuf:"bytes,2,opt,name=Filters" json:"Filters,omitempty"`
+}
+
+func (m *DirectMessagesRequest) Reset()                    { *m = DirectMessagesRequest{} }
+func (m *DirectMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*DirectMessagesRequest) ProtoMessage()               {}
+func (*DirectMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *DirectMessagesRequest) GetRequesterId() *RequesterId {
+	if m != nil {
+		return m.RequesterId
+	}
+	return nil
+}
+
+func (m *DirectMessagesRequest) GetFilters() *Filters {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type DirectMessagesResponse struct {
+	Status         *Status                 `protobuf:"bytes,1,opt,name=Status" json:"Status,omitempty"`
+	DirectMessages []*mimapi.DirectMessage `protobuf:"bytes,2,rep,name=DirectMessages" json:"DirectMessages,omitempty"`
+}
+
+func (m *DirectMessagesResponse) Reset()                    { *m = DirectMessagesResponse{} }
+func (m *DirectMessagesResponse) String() string            { return proto.CompactTextString(m) }
+func (*DirectMessagesResponse) ProtoMessage()               {}
+func (*DirectMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *DirectMessagesResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DirectMessagesResponse) GetDirectMessages() []*mimapi.DirectMessage {
+	if m != nil {
+		return m.DirectMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RequesterId)(nil), "beapi.RequesterId")
 	proto.RegisterType((*Status)(nil), "beapi.Status")
@@ -907,6 +1024,10 @@ func init() {
 	proto.RegisterType((*MintedContentResponse)(nil), "beapi.MintedContentResponse")
 	proto.RegisterType((*ConnectToRemoteRequest)(nil), "beapi.ConnectToRemoteRequest")
 	proto.RegisterType((*ConnectToRemoteResponse)(nil), "beapi.ConnectToRemoteResponse")
+	proto.RegisterType((*EntityHistoryRequest)(nil), "beapi.EntityHistoryRequest")
+	proto.RegisterType((*EntityHistoryResponse)(nil), "beapi.EntityHistoryResponse")
+	proto.RegisterType((*DirectMessagesRequest)(nil), "beapi.DirectMessagesRequest")
+	proto.RegisterType((*DirectMessagesResponse)(nil), "beapi.DirectMessagesResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -938,6 +1059,10 @@ type BackendAPIClient interface {
 	GetThreadPostsCount(ctx context.Context, in *ThreadPostsCountRequest, opts ...grpc.CallOption) (*ThreadPostsCountResponse, error)
 	SendMintedContent(ctx context.Context, in *MintedContentPayload, opts ...grpc.CallOption) (*MintedContentResponse, error)
 	SendConnectToRemoteRequest(ctx context.Context, in *ConnectToRemoteRequest, opts ...grpc.CallOption) (*ConnectToRemoteResponse, error)
+	// The prior versions of an updated thread or post, oldest first.
+	GetEntityHistory(ctx context.Context, in *EntityHistoryRequest, opts ...grpc.CallOption) (*EntityHistoryResponse, error)
+	// Direct messages. Target filters by the recipient, Owner by the sender.
+	GetDirectMessages(ctx context.Context, in *DirectMessagesRequest, opts ...grpc.CallOption) (*DirectMessagesResponse, error)
 }
 
 type backendAPIClient struct {
@@ -1047,6 +1172,24 @@ func (c *backendAPIClient) SendConnectToRemoteRequest(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *backendAPIClient) GetEntityHistory(ctx context.Context, in *EntityHistoryRequest, opts ...grpc.CallOption) (*EntityHistoryResponse, error) {
+	out := new(EntityHistoryResponse)
+	err := grpc.Invoke(ctx, "/beapi.BackendAPI/GetEntityHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendAPIClient) GetDirectMessages(ctx context.Context, in *DirectMessagesRequest, opts ...grpc.CallOption) (*DirectMessagesResponse, error) {
+	out := new(DirectMessagesResponse)
+	err := grpc.Invoke(ctx, "/beapi.BackendAPI/GetDirectMessages", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BackendAPI service
 
 type BackendAPIServer interface {
@@ -1068,6 +1211,10 @@ type BackendAPIServer interface {
 	GetThreadPostsCount(context.Context, *ThreadPostsCountRequest) (*ThreadPostsCountResponse, error)
 	SendMintedContent(context.Context, *MintedContentPayload) (*MintedContentResponse, error)
 	SendConnectToRemoteRequest(context.Context, *ConnectToRemoteRequest) (*ConnectToRemoteResponse, error)
+	// The prior versions of an updated thread or post, oldest first.
+	GetEntityHistory(context.Context, *EntityHistoryRequest) (*EntityHistoryResponse, error)
+	// Direct messages. Target filters by the recipient, Owner by the sender.
+	GetDirectMessages(context.Context, *DirectMessagesRequest) (*DirectMessagesResponse, error)
 }
 
 func RegisterBackendAPIServer(s *grpc.Server, srv BackendAPIServer) {
@@ -1272,6 +1419,42 @@ func _BackendAPI_SendConnectToRemoteRequest_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BackendAPI_GetEntityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendAPIServer).GetEntityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/beapi.BackendAPI/GetEntityHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendAPIServer).GetEntityHistory(ctx, req.(*EntityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendAPI_GetDirectMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DirectMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendAPIServer).GetDirectMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/beapi.BackendAPI/GetDirectMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendAPIServer).GetDirectMessages(ctx, req.(*DirectMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BackendAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "beapi.BackendAPI",
 	HandlerType: (*BackendAPIServer)(nil),
@@ -1320,6 +1503,14 @@ var _BackendAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendConnectToRemoteRequest",
 			Handler:    _BackendAPI_SendConnectToRemoteRequest_Handler,
 		},
+		{
+			MethodName: "GetEntityHistory",
+			Handler:    _BackendAPI_GetEntityHistory_Handler,
+		},
+		{
+			MethodName: "GetDirectMessages",
+			Handler:    _BackendAPI_GetDirectMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "beapi/beapi.proto",
@@ -1328,77 +1519,85 @@ var _BackendAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("beapi/beapi.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1150 bytes of a gzipped FileDescriptorProto
+	// 1267 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x6d, 0x4f, 0x1b, 0xc7,
-	0x13, 0xc7, 0x18, 0xdb, 0x78, 0x0c, 0x0e, 0x59, 0x1c, 0x72, 0xb9, 0x7f, 0xfe, 0xe0, 0xae, 0x1a,
-	0x89, 0xbe, 0x48, 0x90, 0x48, 0xa4, 0x48, 0x55, 0x9f, 0xc0, 0x21, 0x08, 0x41, 0x88, 0xb5, 0x58,
-	0x69, 0x9a, 0xaa, 0x95, 0x0e, 0xdf, 0x00, 0xa7, 0xe0, 0x3b, 0xe7, 0x76, 0xad, 0xca, 0x2f, 0xdb,
-	0x6f, 0xd3, 0xcf, 0xd6, 0x17, 0xfd, 0x0a, 0xd5, 0x3e, 0x9d, 0xf7, 0xfc, 0x50, 0xc5, 0x45, 0xf2,
-	0x9b, 0xe4, 0xe6, 0x37, 0x3b, 0x33, 0xfb, 0x9b, 0x9d, 0x99, 0x5d, 0x0c, 0xf7, 0x2f, 0x31, 0xe8,
-	0x47, 0x7b, 0xea, 0xdf, 0x67, 0xfd, 0x34, 0x11, 0x09, 0x29, 0x29, 0xc1, 0xdf, 0xec, 0x45, 0x3d,
-	0xa9, 0xd2, 0xff, 0x69, 0x1d, 0xfd, 0xbd, 0x00, 0x35, 0x86, 0x9f, 0x06, 0xc8, 0x05, 0xa6, 0x27,
-	0x21, 0x69, 0x42, 0xed, 0xa0, 0xdb, 0x45, 0xce, 0x3b, 0xc9, 0x47, 0x8c, 0xbd, 0x42, 0xb3, 0xb0,
-	0x5b, 0x65, 0x2e, 0x44, 0x1a, 0x50, 0x3a, 0x4f, 0xe2, 0x2e, 0x7a, 0xcb, 0x4a, 0xa7, 0x05, 0xf2,
-	0x18, 0xaa, 0xed, 0xc1, 0xe5, 0x6d, 0xd4, 0x3d, 0xc5, 0xa1, 0x57, 0x54, 0x9a, 0x11, 0x20, 0xb5,
-	0x9d, 0xa8, 0x87, 0x5c, 0x04, 0xbd, 0xbe, 0xb7, 0xd2, 0x2c, 0xec, 0x16, 0xd9, 0x08, 0xa0, 0x67,
-	0x50, 0xbe, 0x10, 0x81, 0x18, 0x70, 0xb2, 0x0d, 0xa0, 0xbf, 0x5a, 0x49, 0x88, 0x2a, 0x78, 0x89,
-	0x39, 0x08, 0xa1, 0xb0, 0x76, 0x94, 0xa6, 0x49, 0xfa, 0x06, 0x39, 0x0f, 0xae, 0xed, 0x16, 0x72,
-	0x18, 0xfd, 0xbb, 0x00, 0x95, 0xd7, 0xd1, 0xad, 0xc0, 0x94, 0x93, 0x6f, 0x60, 0xe3, 0x2c, 0xe0,
-	0x82, 0xe1, 0x95, 0x8c, 0xc6, 0x82, 0xf8, 0x5a, 0x7b, 0xad, 0xed, 0x6f, 0x3c, 0xd3, 0x19, 0xca,
-	0x70, 0x36, 0xb1, 0x92, 0xbc, 0x84, 0xb5, 0xd7, 0x51, 0x7c, 0x8d, 0x69, 0x3f, 0x8d, 0x62, 0xc1,
-	0x55, 0xb4, 0xda, 0xfe, 0xa6, 0xb1, 0x74, 0x55, 0x2c, 0xb7, 0x90, 0xbc, 0x80, 0x5a, 0x67, 0xd8,
-	0x47, 0xb3, 0x0b, 0x95, 0x8e, 0xda, 0x3e, 0xb1, 0x11, 0x47, 0x1a, 0xe6, 0x2e, 0x93, 0xe1, 0x8e,
-	0xd3, 0xa0, 0x7f, 0x63, 0xcd, 0x56, 0x72, 0xe1, 0x5c, 0x15, 0xcb, 0x2d, 0xa4, 0xcf, 0x75, 0x76,
-	0xf5, 0xa6, 0x1b, 0x50, 0xba, 0x10, 0x41, 0x2a, 0x14, 0xcf, 0x22, 0xd3, 0x02, 0xd9, 0x80, 0xe2,
-	0x51, 0x1c, 0xaa, 0x9d, 0x14, 0x99, 0xfc, 0xa4, 0xfb, 0x79, 0x72, 0x32, 0xb5, 0x39, 0xb2, 0x85,
-	0x66, 0x51, 0xa6, 0xd6, 0xc5, 0xe8, 0xf7, 0x39, 0x5e, 0xea, 0x54, 0x87, 0x7d, 0x6c, 0xdd, 0x06,
-	0x9c, 0x9b, 0xc3, 0x1a, 0x01, 0x84, 0xc0, 0x8a, 0x14, 0x54, 0xd6, 0x4a, 0x4c, 0x7d, 0xd3, 0x3f,
-	0x96, 0xf3, 0x1c, 0xe5, 0x6e, 0x0f, 0x93, 0x20, 0x0d, 0x4d, 0xa1, 0x69, 0x81, 0x6c, 0x41, 0xb9,
-	0x73, 0x93, 0x62, 0x10, 0x9a, 0x03, 0x36, 0x92, 0xc4, 0xdb, 0x41, 0x8a, 0xb1, 0x30, 0x15, 0x66,
-	0x24, 0xe9, 0xe5, 0xed, 0x6f, 0x31, 0xa6, 0x2a, 0x65, 0x55, 0xa6, 0x05, 0xe5, 0x25, 0x48, 0xaf,
-	0x51, 0x78, 0x25, 0xe3, 0x45, 0x49, 0x12, 0x7f, 0x95, 0xf4, 0x82, 0x28, 0xf6, 0xca, 0x1a, 0xd7,
-	0x12, 0xf9, 0x12, 0xd6, 0xcf, 0x93, 0x57, 0xc8, 0xbb, 0x18, 0x87, 0x81, 0x4c, 0x41, 0xa5, 0x59,
-	0xd8, 0x5d, 0x65, 0x79, 0x50, 0xc6, 0x3a, 0x8b, 0x7a, 0x91, 0xf0, 0x56, 0x15, 0x2f, 0x2d, 0x48,
-	0x9f, 0x6f, 0xaf, 0xae, 0x38, 0x0a, 0xaf, 0xaa, 0x60, 0x23, 0xc9, 0x24, 0x9c, 0x07, 0x3d, 0xf4,
-	0x40, 0x45, 0x52, 0xdf, 0xf4, 0x08, 0xd6, 0x75, 0x3f, 0x99, 0xbe, 0x93, 0xe5, 0xe2, 0xb4, 0xa0,
-	0x29, 0x50, 0x5b, 0x2e, 0x8e, 0x86, 0xb9, 0xcb, 0xe8, 0x4f, 0x50, 0xb7, 0x6e, 0x78, 0x3f, 0x89,
-	0x39, 0x92, 0x27, 0xb6, 0x8f, 0x8c, 0x8b, 0x75, 0xe3, 0x42, 0x83, 0xcc, 0x36, 0xd9, 0x58, 0x8b,
-	0x2f, 0x4f, 0xb4, 0x38, 0x4d, 0x60, 0x5d, 0x1d, 0xc4, 0xdd, 0x76, 0x48, 0x76, 0xb3, 0x46, 0x34,
-	0xad, 0x53, 0xcf, 0x5a, 0x47, 0x97, 0xb1, 0x55, 0xd3, 0x5f, 0xa1, 0x6e, 0x03, 0xce, 0xc7, 0xe5,
-	0x09, 0x94, 0xb5, 0xa1, 0xb7, 0xdc, 0x2c, 0xaa, 0x65, 0x66, 0xba, 0x29, 0x94, 0x19, 0x25, 0xed,
-	0x43, 0x5d, 0x97, 0xd0, 0xc2, 0x18, 0x5d, 0xc2, 0xbd, 0x2c, 0xe2, 0x7c, 0x94, 0x76, 0xa1, 0x62,
-	0x2c, 0x0d, 0xa7, 0xba, 0xe5, 0xa4, 0x61, 0x66, 0xd5, 0x34, 0x86, 0xb5, 0x76, 0xc2, 0xc5, 0xc2,
-	0x38, 0x7d, 0x80, 0x75, 0x13, 0x6f, 0x3e, 0x46, 0x14, 0x4a, 0xca, 0xce, 0xf0, 0x59, 0xb3, 0x7c,
-	0x24, 0xc8, 0xb4, 0x4a, 0x72, 0x79, 0x97, 0x08, 0x5c, 0x24, 0x17, 0x13, 0x6f, 0x6e, 0x2e, 0xca,
-	0x6e, 0x9c, 0x8b, 0x04, 0x99, 0x56, 0xd1, 0x1e, 0xd4, 0x4e, 0x71, 0xb8, 0x30, 0x2a, 0xef, 0x60,
-	0x4d, 0x87, 0x9b, 0x8f, 0xc9, 0x0e, 0xac, 0x48, 0x33, 0x43, 0xa4, 0x66, 0x89, 0x9c, 0xe2, 0x90,
-	0x29, 0x05, 0x15, 0x40, 0x3a, 0xe9, 0x80, 0x0b, 0x2e, 0x82, 0x05, 0x1e, 0x4c, 0x0a, 0x9b, 0xb9,
-	0xa8, 0xf3, 0x91, 0x92, 0x37, 0xef, 0xc8, 0xda, 0x70, 0x23, 0x59, 0x03, 0x65, 0x2a, 0xe6, 0x2e,
-	0xa3, 0x29, 0x78, 0x6a, 0x50, 0x98, 0xc6, 0x6a, 0x25, 0x83, 0x58, 0xdc, 0x8d, 0x6f, 0x13, 0x6a,
-	0xce, 0xcd, 0x69, 0x67, 0xac, 0x03, 0xd1, 0xf7, 0xf0, 0x68, 0x4a, 0xcc, 0xf9, 0xd8, 0x36, 0xa0,
-	0xd4, 0x95, 0x76, 0xe6, 0x8e, 0xd5, 0x02, 0xfd, 0x04, 0x0f, 0xb5, 0x53, 0xd5, 0x59, 0x0b, 0x21,
-	0xf3, 0x23, 0x78, 0x93, 0x21, 0xe7, 0xe6, 0xd2, 0x72, 0xb9, 0x28, 0x81, 0xfe, 0xb5, 0x0c, 0x8d,
-	0x37, 0x51, 0x2c, 0x30, 0x6c, 0x25, 0xb1, 0xc0, 0x58, 0xb4, 0x83, 0xe1, 0x6d, 0x12, 0x84, 0xff,
-	0x91, 0xc9, 0xe7, 0x5d, 0x17, 0xee, 0x08, 0x2e, 0xfe, 0xeb, 0x08, 0x1e, 0x8d, 0xb6, 0x95, 0x99,
-	0xa3, 0x6d, 0x34, 0x32, 0x4a, 0x33, 0x47, 0x46, 0xd6, 0x8c, 0xe5, 0x19, 0xcd, 0x38, 0x5e, 0xd8,
-	0x95, 0xcf, 0x2a, 0x6c, 0xf2, 0x14, 0xaa, 0x07, 0x61, 0x98, 0x22, 0xe7, 0xc8, 0xbd, 0x55, 0x65,
-	0x73, 0xcf, 0xda, 0x18, 0x05, 0x1b, 0xad, 0xa0, 0xdf, 0xc1, 0x83, 0x5c, 0xb2, 0xe7, 0x3c, 0x43,
-	0x3a, 0x84, 0xad, 0x56, 0x12, 0xc7, 0xd8, 0x15, 0x9d, 0x84, 0x61, 0x4f, 0xf2, 0xbb, 0x53, 0xe1,
-	0x7d, 0x05, 0x15, 0xb3, 0x39, 0x33, 0x35, 0x26, 0x36, 0x6f, 0xf5, 0xf4, 0x07, 0x78, 0x38, 0x11,
-	0x7a, 0xae, 0xcd, 0xef, 0xff, 0x59, 0x06, 0x38, 0x0c, 0xba, 0x1f, 0x31, 0x0e, 0x0f, 0xda, 0x27,
-	0xe4, 0x08, 0x1a, 0x66, 0x2b, 0x16, 0x54, 0x0f, 0x24, 0xd2, 0x30, 0xd6, 0xb9, 0x27, 0x9c, 0xff,
-	0x60, 0x0c, 0xd5, 0xa1, 0xe9, 0x12, 0xf9, 0x1a, 0xaa, 0xc7, 0x28, 0x4c, 0x5d, 0x59, 0xdb, 0xdc,
-	0xe3, 0x2a, 0xb3, 0xcd, 0xbf, 0x80, 0xe8, 0x12, 0xf9, 0x16, 0xe0, 0x18, 0x85, 0x2d, 0x35, 0xbb,
-	0x2c, 0xff, 0x90, 0xf1, 0xb7, 0xc6, 0xe1, 0xcc, 0xfc, 0x25, 0xac, 0x1e, 0xa3, 0xd0, 0x35, 0x68,
-	0xff, 0x8a, 0x70, 0xdf, 0x0b, 0x7e, 0x23, 0x0f, 0x8e, 0x19, 0xea, 0xc2, 0xb4, 0x86, 0xee, 0xe5,
-	0x9c, 0x19, 0xe6, 0x6e, 0x50, 0xba, 0x44, 0x5e, 0x40, 0xe5, 0x18, 0x85, 0xaa, 0x57, 0x7b, 0xb6,
-	0xce, 0x45, 0xe8, 0x6f, 0xe6, 0xb0, 0xcc, 0xea, 0x04, 0xea, 0x92, 0xa6, 0x53, 0xb6, 0x8f, 0x2c,
-	0xa7, 0x89, 0xeb, 0xc7, 0xf7, 0xa7, 0xa9, 0x32, 0x57, 0x3f, 0x43, 0xc3, 0x66, 0xdb, 0x9d, 0xab,
-	0x64, 0xc7, 0x4d, 0xf1, 0x94, 0x29, 0xef, 0x37, 0x67, 0x2f, 0xc8, 0x9c, 0xbf, 0x87, 0xcd, 0xec,
-	0x38, 0x46, 0x73, 0x8e, 0x6c, 0xe7, 0x0e, 0x60, 0x62, 0xe6, 0xfa, 0x3b, 0x33, 0xf5, 0x99, 0xe7,
-	0x36, 0xdc, 0xbf, 0xc0, 0x38, 0xcc, 0xf5, 0x1e, 0xf9, 0x9f, 0xb1, 0x9b, 0x36, 0xfe, 0xfc, 0xc7,
-	0xd3, 0x94, 0x8e, 0xc7, 0x5f, 0xc0, 0x97, 0x1e, 0x67, 0x74, 0xe3, 0xff, 0x8d, 0xf5, 0x74, 0xb5,
-	0xbf, 0x3d, 0x4b, 0x6d, 0xdd, 0x1f, 0x7e, 0xf1, 0x61, 0x27, 0x40, 0x71, 0x83, 0xe9, 0xd3, 0x6e,
-	0x92, 0xe2, 0x9e, 0xfe, 0xde, 0x53, 0xbf, 0x28, 0x70, 0xfd, 0xd3, 0xc3, 0x65, 0x59, 0x49, 0xcf,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x34, 0x99, 0x89, 0xde, 0x90, 0x10, 0x00, 0x00,
+	0x13, 0xc7, 0x18, 0xdb, 0x78, 0x0c, 0x0e, 0x59, 0x0c, 0xb9, 0xdc, 0x3f, 0x09, 0xfe, 0xaf, 0x1a,
+	0x89, 0xbe, 0x48, 0x22, 0x91, 0x48, 0x91, 0xaa, 0xa6, 0x6d, 0x30, 0xc4, 0x45, 0x10, 0xb0, 0x16,
+	0x2b, 0x4d, 0x53, 0xb5, 0xd2, 0xe1, 0x5b, 0xe0, 0x14, 0x7c, 0xe7, 0xec, 0x2e, 0x8a, 0xfc, 0xa6,
+	0x52, 0xfb, 0xba, 0x9f, 0xa4, 0x1f, 0xa6, 0x5f, 0xa5, 0x5f, 0xa1, 0xda, 0xa7, 0xf3, 0xad, 0x1f,
+	0xaa, 0x5c, 0x51, 0xfc, 0x26, 0xb9, 0x99, 0xdf, 0xce, 0xcc, 0xfe, 0x76, 0x67, 0x66, 0x07, 0xc3,
+	0xed, 0x33, 0x1a, 0x0c, 0xa2, 0x27, 0xea, 0xdf, 0xc7, 0x03, 0x96, 0x88, 0x04, 0x95, 0x94, 0xe0,
+	0xaf, 0xf7, 0xa3, 0xbe, 0x84, 0xf4, 0x7f, 0x1a, 0xc3, 0xbf, 0x15, 0xa0, 0x46, 0xe8, 0x87, 0x6b,
+	0xca, 0x05, 0x65, 0x07, 0x21, 0x6a, 0x42, 0xed, 0x65, 0xaf, 0x47, 0x39, 0xef, 0x26, 0xef, 0x69,
+	0xec, 0x15, 0x9a, 0x85, 0xed, 0x2a, 0xc9, 0xaa, 0x50, 0x03, 0x4a, 0xc7, 0x49, 0xdc, 0xa3, 0xde,
+	0xa2, 0xc2, 0xb4, 0x80, 0xee, 0x41, 0xb5, 0x73, 0x7d, 0x76, 0x15, 0xf5, 0x0e, 0xe9, 0xd0, 0x2b,
+	0x2a, 0x64, 0xa4, 0x90, 0x68, 0x37, 0xea, 0x53, 0x2e, 0x82, 0xfe, 0xc0, 0x5b, 0x6a, 0x16, 0xb6,
+	0x8b, 0x64, 0xa4, 0xc0, 0x47, 0x50, 0x3e, 0x15, 0x81, 0xb8, 0xe6, 0xe8, 0x01, 0x80, 0xfe, 0x6a,
+	0x25, 0x21, 0x55, 0xc1, 0x4b, 0x24, 0xa3, 0x41, 0x18, 0x56, 0xf6, 0x19, 0x4b, 0xd8, 0x6b, 0xca,
+	0x79, 0x70, 0x61, 0xb7, 0xe0, 0xe8, 0xf0, 0xdf, 0x05, 0xa8, 0xbc, 0x8a, 0xae, 0x04, 0x65, 0x1c,
+	0x7d, 0x0d, 0x6b, 0x47, 0x01, 0x17, 0x84, 0x9e, 0xcb, 0x68, 0x24, 0x88, 0x2f, 0xb4, 0xd7, 0xda,
+	0xce, 0xda, 0x63, 0x7d, 0x42, 0xa9, 0x9e, 0x4c, 0xac, 0x44, 0xcf, 0x61, 0xe5, 0x55, 0x14, 0x5f,
+	0x50, 0x36, 0x60, 0x51, 0x2c, 0xb8, 0x8a, 0x56, 0xdb, 0x59, 0x37, 0x96, 0x59, 0x88, 0x38, 0x0b,
+	0xd1, 0x33, 0xa8, 0x75, 0x87, 0x03, 0x6a, 0x76, 0xa1, 0x8e, 0xa3, 0xb6, 0x83, 0x6c, 0xc4, 0x11,
+	0x42, 0xb2, 0xcb, 0x64, 0xb8, 0x36, 0x0b, 0x06, 0x97, 0xd6, 0x6c, 0xc9, 0x09, 0x97, 0x85, 0x88,
+	0xb3, 0x10, 0x3f, 0xd5, 0xa7, 0xab, 0x37, 0xdd, 0x80, 0xd2, 0xa9, 0x08, 0x98, 0x50, 0x3c, 0x8b,
+	0x44, 0x0b, 0x68, 0x0d, 0x8a, 0xfb, 0x71, 0xa8, 0x76, 0x52, 0x24, 0xf2, 0x13, 0xef, 0xb8, 0xe4,
+	0xe4, 0xd1, 0x3a, 0x64, 0x0b, 0xcd, 0xa2, 0x3c, 0xda, 0xac, 0x0e, 0x7f, 0xeb, 0xf0, 0x52, 0xb7,
+	0x3a, 0x1c, 0xd0, 0xd6, 0x55, 0xc0, 0xb9, 0xb9, 0xac, 0x91, 0x02, 0x21, 0x58, 0x92, 0x82, 0x3a,
+	0xb5, 0x12, 0x51, 0xdf, 0xf8, 0xf7, 0x45, 0x97, 0xa3, 0xdc, 0xed, 0x6e, 0x12, 0xb0, 0xd0, 0x24,
+	0x9a, 0x16, 0xd0, 0x26, 0x94, 0xbb, 0x97, 0x8c, 0x06, 0xa1, 0xb9, 0x60, 0x23, 0x49, 0x7d, 0x27,
+	0x60, 0x34, 0x16, 0x26, 0xc3, 0x8c, 0x24, 0xbd, 0x9c, 0x7c, 0x8c, 0x29, 0x53, 0x47, 0x56, 0x25,
+	0x5a, 0x50, 0x5e, 0x02, 0x76, 0x41, 0x85, 0x57, 0x32, 0x5e, 0x94, 0x24, 0xf5, 0x7b, 0x49, 0x3f,
+	0x88, 0x62, 0xaf, 0xac, 0xf5, 0x5a, 0x42, 0x5f, 0xc0, 0xea, 0x71, 0xb2, 0x47, 0x79, 0x8f, 0xc6,
+	0x61, 0x20, 0x8f, 0xa0, 0xd2, 0x2c, 0x6c, 0x2f, 0x13, 0x57, 0x29, 0x63, 0x1d, 0x45, 0xfd, 0x48,
+	0x78, 0xcb, 0x8a, 0x97, 0x16, 0xa4, 0xcf, 0x93, 0xf3, 0x73, 0x4e, 0x85, 0x57, 0x55, 0x6a, 0x23,
+	0xc9, 0x43, 0x38, 0x0e, 0xfa, 0xd4, 0x03, 0x15, 0x49, 0x7d, 0xe3, 0x7d, 0x58, 0xd5, 0xf5, 0x64,
+	0xea, 0x4e, 0xa6, 0x4b, 0xa6, 0x04, 0x4d, 0x82, 0xda, 0x74, 0xc9, 0x20, 0x24, 0xbb, 0x0c, 0xff,
+	0x08, 0x75, 0xeb, 0x86, 0x0f, 0x92, 0x98, 0x53, 0xf4, 0xd0, 0xd6, 0x91, 0x71, 0xb1, 0x6a, 0x5c,
+	0x68, 0x25, 0xb1, 0x45, 0x36, 0x56, 0xe2, 0x8b, 0x13, 0x25, 0x8e, 0x13, 0x58, 0x55, 0x17, 0x71,
+	0xb3, 0x1d, 0xa2, 0xed, 0xb4, 0x10, 0x4d, 0xe9, 0xd4, 0xd3, 0xd2, 0xd1, 0x69, 0x6c, 0x61, 0xfc,
+	0x0b, 0xd4, 0x6d, 0xc0, 0x7c, 0x5c, 0x1e, 0x42, 0x59, 0x1b, 0x7a, 0x8b, 0xcd, 0xa2, 0x5a, 0x66,
+	0xba, 0x9b, 0xd2, 0x12, 0x03, 0xe2, 0x01, 0xd4, 0x75, 0x0a, 0xcd, 0x8d, 0xd1, 0x19, 0xdc, 0x4a,
+	0x23, 0xe6, 0xa3, 0xb4, 0x0d, 0x15, 0x63, 0x69, 0x38, 0xd5, 0x2d, 0x27, 0xad, 0x26, 0x16, 0xc6,
+	0x31, 0xac, 0x74, 0x12, 0x2e, 0xe6, 0xc6, 0xe9, 0x1d, 0xac, 0x9a, 0x78, 0xf9, 0x18, 0x61, 0x28,
+	0x29, 0x3b, 0xc3, 0x67, 0xc5, 0xf2, 0x91, 0x4a, 0xa2, 0x21, 0xc9, 0xe5, 0x4d, 0x22, 0xe8, 0x3c,
+	0xb9, 0x98, 0x78, 0xb9, 0xb9, 0x28, 0xbb, 0x71, 0x2e, 0x52, 0x49, 0x34, 0x84, 0xfb, 0x50, 0x3b,
+	0xa4, 0xc3, 0xb9, 0x51, 0x79, 0x03, 0x2b, 0x3a, 0x5c, 0x3e, 0x26, 0x5b, 0xb0, 0x24, 0xcd, 0x0c,
+	0x91, 0x9a, 0x25, 0x72, 0x48, 0x87, 0x44, 0x01, 0x58, 0x00, 0xea, 0xb2, 0x6b, 0x2e, 0xb8, 0x08,
+	0xe6, 0x78, 0x31, 0x0c, 0xd6, 0x9d, 0xa8, 0xf9, 0x48, 0xc9, 0x97, 0x77, 0x64, 0x6d, 0xb8, 0xa1,
+	0xb4, 0x80, 0x52, 0x88, 0x64, 0x97, 0x61, 0x06, 0x9e, 0x6a, 0x14, 0xa6, 0xb0, 0x5a, 0xc9, 0x75,
+	0x2c, 0x6e, 0xc6, 0xb7, 0x09, 0xb5, 0xcc, 0xcb, 0x69, 0x7b, 0x6c, 0x46, 0x85, 0xdf, 0xc2, 0xdd,
+	0x29, 0x31, 0xf3, 0xb1, 0x6d, 0x40, 0xa9, 0x27, 0xed, 0xcc, 0x1b, 0xab, 0x05, 0xfc, 0x01, 0xee,
+	0x68, 0xa7, 0xaa, 0xb2, 0xe6, 0x42, 0xe6, 0x07, 0xf0, 0x26, 0x43, 0xe6, 0xe6, 0xd2, 0xca, 0x72,
+	0x51, 0x02, 0xfe, 0xb3, 0x08, 0x8d, 0xd7, 0x51, 0x2c, 0x68, 0xd8, 0x4a, 0x62, 0x41, 0x63, 0xd1,
+	0x09, 0x86, 0x57, 0x49, 0x10, 0xfe, 0x47, 0x26, 0x9f, 0xf6, 0x5c, 0x64, 0x5b, 0x70, 0xf1, 0x5f,
+	0x5b, 0xf0, 0xa8, 0xb5, 0x2d, 0xcd, 0x6c, 0x6d, 0xa3, 0x96, 0x51, 0x9a, 0xd9, 0x32, 0xd2, 0x62,
+	0x2c, 0xcf, 0x28, 0xc6, 0xf1, 0xc4, 0xae, 0x7c, 0x52, 0x62, 0xa3, 0x47, 0x50, 0x7d, 0x19, 0x86,
+	0x8c, 0x72, 0x4e, 0xb9, 0xb7, 0xac, 0x6c, 0x6e, 0x59, 0x1b, 0x03, 0x90, 0xd1, 0x0a, 0xf4, 0x02,
+	0xea, 0x7b, 0x11, 0xa3, 0x3d, 0x61, 0x66, 0x69, 0xee, 0x55, 0x95, 0xcd, 0x86, 0xb5, 0x71, 0x50,
+	0x32, 0xb6, 0x18, 0x7f, 0x03, 0x1b, 0xce, 0x5d, 0xe5, 0x4c, 0x01, 0x3c, 0x84, 0xcd, 0x56, 0x12,
+	0xc7, 0xb4, 0x27, 0xba, 0x09, 0xa1, 0x7d, 0x79, 0x3c, 0x37, 0xca, 0xdb, 0x2f, 0xa1, 0x62, 0xb8,
+	0x99, 0xa6, 0x33, 0xc1, 0xdd, 0xe2, 0xf8, 0x3b, 0xb8, 0x33, 0x11, 0x3a, 0xdf, 0xe6, 0x63, 0x68,
+	0xec, 0xc7, 0x22, 0x12, 0xc3, 0xef, 0x23, 0x2e, 0x12, 0x36, 0xfc, 0xdc, 0x25, 0xf7, 0x47, 0x01,
+	0x36, 0xc6, 0x02, 0x7e, 0xa6, 0x39, 0x63, 0x94, 0xe4, 0xc5, 0xd9, 0xef, 0xf7, 0x47, 0xd8, 0x70,
+	0xb3, 0x61, 0x5e, 0xef, 0xc5, 0xaf, 0xb0, 0x39, 0x1e, 0x38, 0xdf, 0x39, 0x4c, 0x26, 0xfd, 0x62,
+	0x8e, 0xa4, 0xdf, 0xf9, 0xab, 0x02, 0xb0, 0x1b, 0xf4, 0xde, 0xd3, 0x38, 0x7c, 0xd9, 0x39, 0x40,
+	0xfb, 0xd0, 0x30, 0x3c, 0xac, 0x52, 0xcd, 0xd5, 0xa8, 0x61, 0x82, 0x3b, 0x93, 0xbf, 0xbf, 0x31,
+	0xa6, 0xd5, 0x3b, 0xc7, 0x0b, 0xe8, 0x2b, 0xa8, 0xb6, 0xa9, 0x30, 0xed, 0xc8, 0xda, 0x3a, 0x33,
+	0x79, 0x6a, 0xeb, 0x0e, 0xce, 0x78, 0x01, 0xbd, 0x00, 0x68, 0x53, 0x61, 0x2f, 0xcf, 0x2e, 0x73,
+	0xe7, 0x5f, 0x7f, 0x73, 0x5c, 0x9d, 0x9a, 0x3f, 0x87, 0xe5, 0x36, 0x15, 0xba, 0x75, 0xd9, 0x3f,
+	0x3e, 0xb3, 0x63, 0xa6, 0xdf, 0x70, 0x95, 0x63, 0x86, 0xba, 0x9f, 0x59, 0xc3, 0xec, 0x4c, 0x97,
+	0x1a, 0x3a, 0x83, 0x17, 0x5e, 0x40, 0xcf, 0xa0, 0xd2, 0xa6, 0x42, 0xb5, 0x39, 0x9b, 0x18, 0x99,
+	0xf9, 0xc9, 0x5f, 0x77, 0x74, 0xa9, 0xd5, 0x01, 0xd4, 0x25, 0xcd, 0x4c, 0xb7, 0xbb, 0x6b, 0x39,
+	0x4d, 0x4c, 0x2d, 0xbe, 0x3f, 0x0d, 0x4a, 0x5d, 0xfd, 0x04, 0x0d, 0x7b, 0xda, 0xd9, 0xe7, 0x18,
+	0x6d, 0x65, 0x8f, 0x78, 0xca, 0x70, 0xe0, 0x37, 0x67, 0x2f, 0x48, 0x9d, 0xbf, 0x85, 0xf5, 0xf4,
+	0x3a, 0x46, 0xcf, 0x23, 0x7a, 0xe0, 0x5c, 0xc0, 0xc4, 0x53, 0xed, 0x6f, 0xcd, 0xc4, 0x53, 0xcf,
+	0x1d, 0xb8, 0x7d, 0x4a, 0xe3, 0xd0, 0xe9, 0xb9, 0xe8, 0x7f, 0xc6, 0x6e, 0xda, 0xab, 0xe9, 0xdf,
+	0x9b, 0x06, 0x66, 0x3c, 0xfe, 0x0c, 0xbe, 0xf4, 0x38, 0xa3, 0x0b, 0xdf, 0x37, 0xd6, 0xd3, 0x61,
+	0xff, 0xc1, 0x2c, 0x38, 0x75, 0x7f, 0x02, 0x6b, 0x6d, 0x2a, 0x9c, 0xae, 0x95, 0xee, 0x77, 0x5a,
+	0xf3, 0x4c, 0xf7, 0x3b, 0xb5, 0xd1, 0xe1, 0x05, 0x44, 0xe0, 0x76, 0x9b, 0x0a, 0xb7, 0x22, 0x91,
+	0x35, 0x9a, 0xda, 0x8f, 0xfc, 0xfb, 0x33, 0x50, 0xeb, 0x73, 0xf7, 0xff, 0xef, 0xb6, 0x02, 0x2a,
+	0x2e, 0x29, 0x7b, 0xd4, 0x4b, 0x18, 0x7d, 0xa2, 0xbf, 0x9f, 0xa8, 0x5f, 0xcb, 0xb8, 0xfe, 0x59,
+	0xed, 0xac, 0xac, 0xa4, 0xa7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x29, 0xba, 0xdf, 0x59, 0x6c,
+	0x13, 0x00, 0x00,
 }
@@ -96,6 +96,50 @@ function deserialize_beapi_ConnectToRemoteResponse(buffer_arg) {
   return beapi_beapi_pb.ConnectToRemoteResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_beapi_DirectMessagesRequest(arg) {
+  if (!(arg instanceof beapi_beapi_pb.DirectMessagesRequest)) {
+    throw new Error('Expected argument of type beapi.DirectMessagesRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_beapi_DirectMessagesRequest(buffer_arg) {
+  return beapi_beapi_pb.DirectMessagesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_beapi_DirectMessagesResponse(arg) {
+  if (!(arg instanceof beapi_beapi_pb.DirectMessagesResponse)) {
+    throw new Error('Expected argument of type beapi.DirectMessagesResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_beapi_DirectMessagesResponse(buffer_arg) {
+  return beapi_beapi_pb.DirectMessagesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_beapi_EntityHistoryRequest(arg) {
+  if (!(arg instanceof beapi_beapi_pb.EntityHistoryRequest)) {
+    throw new Error('Expected argument of type beapi.EntityHistoryRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_beapi_EntityHistoryRequest(buffer_arg) {
+  return beapi_beapi_pb.EntityHistoryRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_beapi_EntityHistoryResponse(arg) {
+  if (!(arg instanceof beapi_beapi_pb.EntityHistoryResponse)) {
+    throw new Error('Expected argument of type beapi.EntityHistoryResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_beapi_EntityHistoryResponse(buffer_arg) {
+  return beapi_beapi_pb.EntityHistoryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_beapi_KeysRequest(arg) {
   if (!(arg instanceof beapi_beapi_pb.KeysRequest)) {
     throw new Error('Expected argument of type beapi.KeysRequest');
@@ -380,6 +424,30 @@ var BackendAPIService = exports.BackendAPIService = {
     responseSerialize: serialize_beapi_ConnectToRemoteResponse,
     responseDeserialize: deserialize_beapi_ConnectToRemoteResponse,
   },
+  // The prior versions of an updated thread or post, oldest first.
+  getEntityHistory: {
+    path: '/beapi.BackendAPI/GetEntityHistory',
+    requestStream: false,
+    responseStream: false,
+    requestType: beapi_beapi_pb.EntityHistoryRequest,
+    responseType: beapi_beapi_pb.EntityHistoryResponse,
+    requestSerialize: serialize_beapi_EntityHistoryRequest,
+    requestDeserialize: deserialize_beapi_EntityHistoryRequest,
+    responseSerialize: serialize_beapi_EntityHistoryResponse,
+    responseDeserialize: deserialize_beapi_EntityHistoryResponse,
+  },
+  // Direct messages. Target filters by the recipient, Owner by the sender.
+  getDirectMessages: {
+    path: '/beapi.BackendAPI/GetDirectMessages',
+    requestStream: false,
+    responseStream: false,
+    requestType: beapi_beapi_pb.DirectMessagesRequest,
+    responseType: beapi_beapi_pb.DirectMessagesResponse,
+    requestSerialize: serialize_beapi_DirectMessagesRequest,
+    requestDeserialize: deserialize_beapi_DirectMessagesRequest,
+    responseSerialize: serialize_beapi_DirectMessagesResponse,
+    responseDeserialize: deserialize_beapi_DirectMessagesResponse,
+  },
 };
 
 exports.BackendAPIClient = grpc.makeGenericClientConstructor(BackendAPIService);
@@ -693,5 +693,115 @@ proto.beapi.BackendAPIPromiseClient.prototype.sendConnectToRemoteRequest =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.beapi.EntityHistoryRequest,
+ *   !proto.beapi.EntityHistoryResponse>}
+ */
+const methodInfo_BackendAPI_GetEntityHistory = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.beapi.EntityHistoryResponse,
+  /** @param {!proto.beapi.EntityHistoryRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.beapi.EntityHistoryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.beapi.EntityHistoryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.beapi.EntityHistoryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.beapi.EntityHistoryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.beapi.BackendAPIClient.prototype.getEntityHistory =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/beapi.BackendAPI/GetEntityHistory',
+      request,
+      metadata || {},
+      methodInfo_BackendAPI_GetEntityHistory,
+      callback);
+};
+
+
+/**
+ * @param {!proto.beapi.EntityHistoryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.beapi.EntityHistoryResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.beapi.BackendAPIPromiseClient.prototype.getEntityHistory =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/beapi.BackendAPI/GetEntityHistory',
+      request,
+      metadata || {},
+      methodInfo_BackendAPI_GetEntityHistory);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.beapi.DirectMessagesRequest,
+ *   !proto.beapi.DirectMessagesResponse>}
+ */
+const methodInfo_BackendAPI_GetDirectMessages = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.beapi.DirectMessagesResponse,
+  /** @param {!proto.beapi.DirectMessagesRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.beapi.DirectMessagesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.beapi.DirectMessagesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.beapi.DirectMessagesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.beapi.DirectMessagesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.beapi.BackendAPIClient.prototype.getDirectMessages =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/beapi.BackendAPI/GetDirectMessages',
+      request,
+      metadata || {},
+      methodInfo_BackendAPI_GetDirectMessages,
+      callback);
+};
+
+
+/**
+ * @param {!proto.beapi.DirectMessagesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.beapi.DirectMessagesResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.beapi.BackendAPIPromiseClient.prototype.getDirectMessages =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/beapi.BackendAPI/GetDirectMessages',
+      request,
+      metadata || {},
+      methodInfo_BackendAPI_GetDirectMessages);
+};
+
+
 module.exports = proto.beapi;
 
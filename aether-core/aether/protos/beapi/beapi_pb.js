@@ -20,6 +20,10 @@ goog.exportSymbol('proto.beapi.BoardsRequest', null, global);
 goog.exportSymbol('proto.beapi.BoardsResponse', null, global);
 goog.exportSymbol('proto.beapi.ConnectToRemoteRequest', null, global);
 goog.exportSymbol('proto.beapi.ConnectToRemoteResponse', null, global);
+goog.exportSymbol('proto.beapi.DirectMessagesRequest', null, global);
+goog.exportSymbol('proto.beapi.DirectMessagesResponse', null, global);
+goog.exportSymbol('proto.beapi.EntityHistoryRequest', null, global);
+goog.exportSymbol('proto.beapi.EntityHistoryResponse', null, global);
 goog.exportSymbol('proto.beapi.Filters', null, global);
 goog.exportSymbol('proto.beapi.Fingerprints', null, global);
 goog.exportSymbol('proto.beapi.GraphFilters', null, global);
@@ -5214,7 +5218,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.beapi.MintedContentPayload.repeatedFields_ = [2,3,4,5,6,7,8];
+proto.beapi.MintedContentPayload.repeatedFields_ = [2,3,4,5,6,7,8,9];
 
 
 
@@ -5259,7 +5263,9 @@ proto.beapi.MintedContentPayload.toObject = function(includeInstance, msg) {
     truststatesList: jspb.Message.toObjectList(msg.getTruststatesList(),
     mimapi_mimapi_pb.Truststate.toObject, includeInstance),
     addressesList: jspb.Message.toObjectList(msg.getAddressesList(),
-    mimapi_mimapi_pb.Address.toObject, includeInstance)
+    mimapi_mimapi_pb.Address.toObject, includeInstance),
+    directmessagesList: jspb.Message.toObjectList(msg.getDirectmessagesList(),
+    mimapi_mimapi_pb.DirectMessage.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5336,6 +5342,11 @@ proto.beapi.MintedContentPayload.deserializeBinaryFromReader = function(msg, rea
       reader.readMessage(value,mimapi_mimapi_pb.Address.deserializeBinaryFromReader);
       msg.addAddresses(value);
       break;
+    case 9:
+      var value = new mimapi_mimapi_pb.DirectMessage;
+      reader.readMessage(value,mimapi_mimapi_pb.DirectMessage.deserializeBinaryFromReader);
+      msg.addDirectmessages(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5429,6 +5440,14 @@ proto.beapi.MintedContentPayload.serializeBinaryToWriter = function(message, wri
       mimapi_mimapi_pb.Address.serializeBinaryToWriter
     );
   }
+  f = message.getDirectmessagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      mimapi_mimapi_pb.DirectMessage.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -5679,6 +5698,37 @@ proto.beapi.MintedContentPayload.prototype.clearAddressesList = function() {
 };
 
 
+/**
+ * repeated mimapi.DirectMessage DirectMessages = 9;
+ * @return {!Array.<!proto.mimapi.DirectMessage>}
+ */
+proto.beapi.MintedContentPayload.prototype.getDirectmessagesList = function() {
+  return /** @type{!Array.<!proto.mimapi.DirectMessage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, mimapi_mimapi_pb.DirectMessage, 9));
+};
+
+
+/** @param {!Array.<!proto.mimapi.DirectMessage>} value */
+proto.beapi.MintedContentPayload.prototype.setDirectmessagesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.mimapi.DirectMessage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.mimapi.DirectMessage}
+ */
+proto.beapi.MintedContentPayload.prototype.addDirectmessages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.mimapi.DirectMessage, opt_index);
+};
+
+
+proto.beapi.MintedContentPayload.prototype.clearDirectmessagesList = function() {
+  this.setDirectmessagesList([]);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -6200,4 +6250,863 @@ proto.beapi.ConnectToRemoteResponse.prototype.hasStatus = function() {
 };
 
 
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.beapi.EntityHistoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.beapi.EntityHistoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.beapi.EntityHistoryRequest.displayName = 'proto.beapi.EntityHistoryRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.beapi.EntityHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.beapi.EntityHistoryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.beapi.EntityHistoryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.EntityHistoryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    requesterid: (f = msg.getRequesterid()) && proto.beapi.RequesterId.toObject(includeInstance, f),
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.beapi.EntityHistoryRequest}
+ */
+proto.beapi.EntityHistoryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.beapi.EntityHistoryRequest;
+  return proto.beapi.EntityHistoryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.beapi.EntityHistoryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.beapi.EntityHistoryRequest}
+ */
+proto.beapi.EntityHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.beapi.RequesterId;
+      reader.readMessage(value,proto.beapi.RequesterId.deserializeBinaryFromReader);
+      msg.setRequesterid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFingerprint(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.beapi.EntityHistoryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.beapi.EntityHistoryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.beapi.EntityHistoryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.EntityHistoryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequesterid();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.beapi.RequesterId.serializeBinaryToWriter
+    );
+  }
+  f = message.getFingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional RequesterId RequesterId = 1;
+ * @return {?proto.beapi.RequesterId}
+ */
+proto.beapi.EntityHistoryRequest.prototype.getRequesterid = function() {
+  return /** @type{?proto.beapi.RequesterId} */ (
+    jspb.Message.getWrapperField(this, proto.beapi.RequesterId, 1));
+};
+
+
+/** @param {?proto.beapi.RequesterId|undefined} value */
+proto.beapi.EntityHistoryRequest.prototype.setRequesterid = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.beapi.EntityHistoryRequest.prototype.clearRequesterid = function() {
+  this.setRequesterid(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.beapi.EntityHistoryRequest.prototype.hasRequesterid = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string Fingerprint = 2;
+ * @return {string}
+ */
+proto.beapi.EntityHistoryRequest.prototype.getFingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.beapi.EntityHistoryRequest.prototype.setFingerprint = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.beapi.EntityHistoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.beapi.EntityHistoryResponse.repeatedFields_, null);
+};
+goog.inherits(proto.beapi.EntityHistoryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.beapi.EntityHistoryResponse.displayName = 'proto.beapi.EntityHistoryResponse';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.beapi.EntityHistoryResponse.repeatedFields_ = [2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.beapi.EntityHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.beapi.EntityHistoryResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.beapi.EntityHistoryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.EntityHistoryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && proto.beapi.Status.toObject(includeInstance, f),
+    threadsList: jspb.Message.toObjectList(msg.getThreadsList(),
+    mimapi_mimapi_pb.Thread.toObject, includeInstance),
+    postsList: jspb.Message.toObjectList(msg.getPostsList(),
+    mimapi_mimapi_pb.Post.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.beapi.EntityHistoryResponse}
+ */
+proto.beapi.EntityHistoryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.beapi.EntityHistoryResponse;
+  return proto.beapi.EntityHistoryResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.beapi.EntityHistoryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.beapi.EntityHistoryResponse}
+ */
+proto.beapi.EntityHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.beapi.Status;
+      reader.readMessage(value,proto.beapi.Status.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new mimapi_mimapi_pb.Thread;
+      reader.readMessage(value,mimapi_mimapi_pb.Thread.deserializeBinaryFromReader);
+      msg.addThreads(value);
+      break;
+    case 3:
+      var value = new mimapi_mimapi_pb.Post;
+      reader.readMessage(value,mimapi_mimapi_pb.Post.deserializeBinaryFromReader);
+      msg.addPosts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.beapi.EntityHistoryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.beapi.EntityHistoryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.beapi.EntityHistoryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.EntityHistoryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.beapi.Status.serializeBinaryToWriter
+    );
+  }
+  f = message.getThreadsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      mimapi_mimapi_pb.Thread.serializeBinaryToWriter
+    );
+  }
+  f = message.getPostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      mimapi_mimapi_pb.Post.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Status Status = 1;
+ * @return {?proto.beapi.Status}
+ */
+proto.beapi.EntityHistoryResponse.prototype.getStatus = function() {
+  return /** @type{?proto.beapi.Status} */ (
+    jspb.Message.getWrapperField(this, proto.beapi.Status, 1));
+};
+
+
+/** @param {?proto.beapi.Status|undefined} value */
+proto.beapi.EntityHistoryResponse.prototype.setStatus = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.beapi.EntityHistoryResponse.prototype.clearStatus = function() {
+  this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.beapi.EntityHistoryResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated mimapi.Thread Threads = 2;
+ * @return {!Array.<!proto.mimapi.Thread>}
+ */
+proto.beapi.EntityHistoryResponse.prototype.getThreadsList = function() {
+  return /** @type{!Array.<!proto.mimapi.Thread>} */ (
+    jspb.Message.getRepeatedWrapperField(this, mimapi_mimapi_pb.Thread, 2));
+};
+
+
+/** @param {!Array.<!proto.mimapi.Thread>} value */
+proto.beapi.EntityHistoryResponse.prototype.setThreadsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.mimapi.Thread=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.mimapi.Thread}
+ */
+proto.beapi.EntityHistoryResponse.prototype.addThreads = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.mimapi.Thread, opt_index);
+};
+
+
+proto.beapi.EntityHistoryResponse.prototype.clearThreadsList = function() {
+  this.setThreadsList([]);
+};
+
+
+/**
+ * repeated mimapi.Post Posts = 3;
+ * @return {!Array.<!proto.mimapi.Post>}
+ */
+proto.beapi.EntityHistoryResponse.prototype.getPostsList = function() {
+  return /** @type{!Array.<!proto.mimapi.Post>} */ (
+    jspb.Message.getRepeatedWrapperField(this, mimapi_mimapi_pb.Post, 3));
+};
+
+
+/** @param {!Array.<!proto.mimapi.Post>} value */
+proto.beapi.EntityHistoryResponse.prototype.setPostsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.mimapi.Post=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.mimapi.Post}
+ */
+proto.beapi.EntityHistoryResponse.prototype.addPosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.mimapi.Post, opt_index);
+};
+
+
+proto.beapi.EntityHistoryResponse.prototype.clearPostsList = function() {
+  this.setPostsList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.beapi.DirectMessagesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.beapi.DirectMessagesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.beapi.DirectMessagesRequest.displayName = 'proto.beapi.DirectMessagesRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.beapi.DirectMessagesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.beapi.DirectMessagesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.beapi.DirectMessagesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.DirectMessagesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    requesterid: (f = msg.getRequesterid()) && proto.beapi.RequesterId.toObject(includeInstance, f),
+    filters: (f = msg.getFilters()) && proto.beapi.Filters.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.beapi.DirectMessagesRequest}
+ */
+proto.beapi.DirectMessagesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.beapi.DirectMessagesRequest;
+  return proto.beapi.DirectMessagesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.beapi.DirectMessagesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.beapi.DirectMessagesRequest}
+ */
+proto.beapi.DirectMessagesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.beapi.RequesterId;
+      reader.readMessage(value,proto.beapi.RequesterId.deserializeBinaryFromReader);
+      msg.setRequesterid(value);
+      break;
+    case 2:
+      var value = new proto.beapi.Filters;
+      reader.readMessage(value,proto.beapi.Filters.deserializeBinaryFromReader);
+      msg.setFilters(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.beapi.DirectMessagesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.beapi.DirectMessagesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.beapi.DirectMessagesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.DirectMessagesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequesterid();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.beapi.RequesterId.serializeBinaryToWriter
+    );
+  }
+  f = message.getFilters();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.beapi.Filters.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional RequesterId RequesterId = 1;
+ * @return {?proto.beapi.RequesterId}
+ */
+proto.beapi.DirectMessagesRequest.prototype.getRequesterid = function() {
+  return /** @type{?proto.beapi.RequesterId} */ (
+    jspb.Message.getWrapperField(this, proto.beapi.RequesterId, 1));
+};
+
+
+/** @param {?proto.beapi.RequesterId|undefined} value */
+proto.beapi.DirectMessagesRequest.prototype.setRequesterid = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.beapi.DirectMessagesRequest.prototype.clearRequesterid = function() {
+  this.setRequesterid(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.beapi.DirectMessagesRequest.prototype.hasRequesterid = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Filters Filters = 2;
+ * @return {?proto.beapi.Filters}
+ */
+proto.beapi.DirectMessagesRequest.prototype.getFilters = function() {
+  return /** @type{?proto.beapi.Filters} */ (
+    jspb.Message.getWrapperField(this, proto.beapi.Filters, 2));
+};
+
+
+/** @param {?proto.beapi.Filters|undefined} value */
+proto.beapi.DirectMessagesRequest.prototype.setFilters = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+proto.beapi.DirectMessagesRequest.prototype.clearFilters = function() {
+  this.setFilters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.beapi.DirectMessagesRequest.prototype.hasFilters = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.beapi.DirectMessagesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.beapi.DirectMessagesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.beapi.DirectMessagesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.beapi.DirectMessagesResponse.displayName = 'proto.beapi.DirectMessagesResponse';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.beapi.DirectMessagesResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.beapi.DirectMessagesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.beapi.DirectMessagesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.beapi.DirectMessagesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.DirectMessagesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && proto.beapi.Status.toObject(includeInstance, f),
+    directmessagesList: jspb.Message.toObjectList(msg.getDirectmessagesList(),
+    mimapi_mimapi_pb.DirectMessage.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.beapi.DirectMessagesResponse}
+ */
+proto.beapi.DirectMessagesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.beapi.DirectMessagesResponse;
+  return proto.beapi.DirectMessagesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.beapi.DirectMessagesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.beapi.DirectMessagesResponse}
+ */
+proto.beapi.DirectMessagesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.beapi.Status;
+      reader.readMessage(value,proto.beapi.Status.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new mimapi_mimapi_pb.DirectMessage;
+      reader.readMessage(value,mimapi_mimapi_pb.DirectMessage.deserializeBinaryFromReader);
+      msg.addDirectmessages(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.beapi.DirectMessagesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.beapi.DirectMessagesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.beapi.DirectMessagesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.beapi.DirectMessagesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.beapi.Status.serializeBinaryToWriter
+    );
+  }
+  f = message.getDirectmessagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      mimapi_mimapi_pb.DirectMessage.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Status Status = 1;
+ * @return {?proto.beapi.Status}
+ */
+proto.beapi.DirectMessagesResponse.prototype.getStatus = function() {
+  return /** @type{?proto.beapi.Status} */ (
+    jspb.Message.getWrapperField(this, proto.beapi.Status, 1));
+};
+
+
+/** @param {?proto.beapi.Status|undefined} value */
+proto.beapi.DirectMessagesResponse.prototype.setStatus = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.beapi.DirectMessagesResponse.prototype.clearStatus = function() {
+  this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.beapi.DirectMessagesResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated mimapi.DirectMessage DirectMessages = 2;
+ * @return {!Array.<!proto.mimapi.DirectMessage>}
+ */
+proto.beapi.DirectMessagesResponse.prototype.getDirectmessagesList = function() {
+  return /** @type{!Array.<!proto.mimapi.DirectMessage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, mimapi_mimapi_pb.DirectMessage, 2));
+};
+
+
+/** @param {!Array.<!proto.mimapi.DirectMessage>} value */
+proto.beapi.DirectMessagesResponse.prototype.setDirectmessagesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.mimapi.DirectMessage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.mimapi.DirectMessage}
+ */
+proto.beapi.DirectMessagesResponse.prototype.addDirectmessages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.mimapi.DirectMessage, opt_index);
+};
+
+
+proto.beapi.DirectMessagesResponse.prototype.clearDirectmessagesList = function() {
+  this.setDirectmessagesList([]);
+};
+
+
 goog.object.extend(exports, proto.beapi);
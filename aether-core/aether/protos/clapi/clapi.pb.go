@@ -19,6 +19,7 @@ It has these top-level messages:
 	InflightVote
 	InflightKey
 	InflightTruststate
+	InflightDirectMessage
 	Inflights
 	AmbientStatusPayload
 	AmbientStatusResponse
@@ -40,6 +41,7 @@ It has these top-level messages:
 	ExternalContentAutoloadDisabledStatusResponse
 	SearchResultPayload
 	SearchResultResponse
+	SearchHighlight
 */
 package clapi
 
@@ -123,11 +125,17 @@ func (*AmbientsResponse) ProtoMessage()               {}
 func (*AmbientsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type InflightStatus struct {
-	CompletionPercent   int32  `protobuf:"varint,1,opt,name=CompletionPercent" json:"CompletionPercent,omitempty"`
-	StatusText          string `protobuf:"bytes,2,opt,name=StatusText" json:"StatusText,omitempty"`
-	RequestedTimestamp  int64  `protobuf:"varint,4,opt,name=RequestedTimestamp" json:"RequestedTimestamp,omitempty"`
-	LastActionTimestamp int64  `protobuf:"varint,5,opt,name=LastActionTimestamp" json:"LastActionTimestamp,omitempty"`
-	EventType           string `protobuf:"bytes,6,opt,name=EventType" json:"EventType,omitempty"`
+	CompletionPercent    int32  `protobuf:"varint,1,opt,name=CompletionPercent" json:"CompletionPercent,omitempty"`
+	StatusText           string `protobuf:"bytes,2,opt,name=StatusText" json:"StatusText,omitempty"`
+	RequestedTimestamp   int64  `protobuf:"varint,4,opt,name=RequestedTimestamp" json:"RequestedTimestamp,omitempty"`
+	LastActionTimestamp  int64  `protobuf:"varint,5,opt,name=LastActionTimestamp" json:"LastActionTimestamp,omitempty"`
+	EventType            string `protobuf:"bytes,6,opt,name=EventType" json:"EventType,omitempty"`
+	Id                   string `protobuf:"bytes,7,opt,name=Id" json:"Id,omitempty"`
+	HashesTried          int64  `protobuf:"varint,8,opt,name=HashesTried" json:"HashesTried,omitempty"`
+	EstimatedSecondsLeft int64  `protobuf:"varint,9,opt,name=EstimatedSecondsLeft" json:"EstimatedSecondsLeft,omitempty"`
+	Attempts             int32  `protobuf:"varint,10,opt,name=Attempts" json:"Attempts,omitempty"`
+	NextAttemptTimestamp int64  `protobuf:"varint,11,opt,name=NextAttemptTimestamp" json:"NextAttemptTimestamp,omitempty"`
+	FailureReason        string `protobuf:"bytes,12,opt,name=FailureReason" json:"FailureReason,omitempty"`
 }
 
 func (m *InflightStatus) Reset()                    { *m = InflightStatus{} }
@@ -170,6 +178,48 @@ func (m *InflightStatus) GetEventType() string {
 	return ""
 }
 
+func (m *InflightStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InflightStatus) GetHashesTried() int64 {
+	if m != nil {
+		return m.HashesTried
+	}
+	return 0
+}
+
+func (m *InflightStatus) GetEstimatedSecondsLeft() int64 {
+	if m != nil {
+		return m.EstimatedSecondsLeft
+	}
+	return 0
+}
+
+func (m *InflightStatus) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *InflightStatus) GetNextAttemptTimestamp() int64 {
+	if m != nil {
+		return m.NextAttemptTimestamp
+	}
+	return 0
+}
+
+func (m *InflightStatus) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
 type InflightBoard struct {
 	Status *InflightStatus `protobuf:"bytes,1,opt,name=Status" json:"Status,omitempty"`
 	Entity *mimapi.Board   `protobuf:"bytes,2,opt,name=Entity" json:"Entity,omitempty"`
@@ -314,20 +364,45 @@ func (m *InflightTruststate) GetEntity() *mimapi.Truststate {
 	return nil
 }
 
+type InflightDirectMessage struct {
+	Status *InflightStatus       `protobuf:"bytes,1,opt,name=Status" json:"Status,omitempty"`
+	Entity *mimapi.DirectMessage `protobuf:"bytes,2,opt,name=Entity" json:"Entity,omitempty"`
+}
+
+func (m *InflightDirectMessage) Reset()                    { *m = InflightDirectMessage{} }
+func (m *InflightDirectMessage) String() string            { return proto.CompactTextString(m) }
+func (*InflightDirectMessage) ProtoMessage()               {}
+func (*InflightDirectMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *InflightDirectMessage) GetStatus() *InflightStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *InflightDirectMessage) GetEntity() *mimapi.DirectMessage {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
 // The objects currently being processed.
 type Inflights struct {
-	Boards      []*InflightBoard      `protobuf:"bytes,1,rep,name=Boards" json:"Boards,omitempty"`
-	Threads     []*InflightThread     `protobuf:"bytes,2,rep,name=Threads" json:"Threads,omitempty"`
-	Posts       []*InflightPost       `protobuf:"bytes,3,rep,name=Posts" json:"Posts,omitempty"`
-	Votes       []*InflightVote       `protobuf:"bytes,4,rep,name=Votes" json:"Votes,omitempty"`
-	Keys        []*InflightKey        `protobuf:"bytes,5,rep,name=Keys" json:"Keys,omitempty"`
-	Truststates []*InflightTruststate `protobuf:"bytes,6,rep,name=Truststates" json:"Truststates,omitempty"`
+	Boards         []*InflightBoard         `protobuf:"bytes,1,rep,name=Boards" json:"Boards,omitempty"`
+	Threads        []*InflightThread        `protobuf:"bytes,2,rep,name=Threads" json:"Threads,omitempty"`
+	Posts          []*InflightPost          `protobuf:"bytes,3,rep,name=Posts" json:"Posts,omitempty"`
+	Votes          []*InflightVote          `protobuf:"bytes,4,rep,name=Votes" json:"Votes,omitempty"`
+	Keys           []*InflightKey           `protobuf:"bytes,5,rep,name=Keys" json:"Keys,omitempty"`
+	Truststates    []*InflightTruststate    `protobuf:"bytes,6,rep,name=Truststates" json:"Truststates,omitempty"`
+	DirectMessages []*InflightDirectMessage `protobuf:"bytes,7,rep,name=DirectMessages" json:"DirectMessages,omitempty"`
 }
 
 func (m *Inflights) Reset()                    { *m = Inflights{} }
 func (m *Inflights) String() string            { return proto.CompactTextString(m) }
 func (*Inflights) ProtoMessage()               {}
-func (*Inflights) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Inflights) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *Inflights) GetBoards() []*InflightBoard {
 	if m != nil {
@@ -371,6 +446,13 @@ func (m *Inflights) GetTruststates() []*InflightTruststate {
 	return nil
 }
 
+func (m *Inflights) GetDirectMessages() []*InflightDirectMessage {
+	if m != nil {
+		return m.DirectMessages
+	}
+	return nil
+}
+
 type AmbientStatusPayload struct {
 	// Health data from the backend, only provided to its admin frontend
 	BackendAmbientStatus *feobjects.BackendAmbientStatus `protobuf:"bytes,1,opt,name=BackendAmbientStatus" json:"BackendAmbientStatus,omitempty"`
@@ -383,7 +465,7 @@ type AmbientStatusPayload struct {
 func (m *AmbientStatusPayload) Reset()                    { *m = AmbientStatusPayload{} }
 func (m *AmbientStatusPayload) String() string            { return proto.CompactTextString(m) }
 func (*AmbientStatusPayload) ProtoMessage()               {}
-func (*AmbientStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*AmbientStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *AmbientStatusPayload) GetBackendAmbientStatus() *feobjects.BackendAmbientStatus {
 	if m != nil {
@@ -412,7 +494,7 @@ type AmbientStatusResponse struct {
 func (m *AmbientStatusResponse) Reset()                    { *m = AmbientStatusResponse{} }
 func (m *AmbientStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*AmbientStatusResponse) ProtoMessage()               {}
-func (*AmbientStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*AmbientStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 type AmbientLocalUserEntityPayload struct {
 	LocalUserExists bool                          `protobuf:"varint,1,opt,name=LocalUserExists" json:"LocalUserExists,omitempty"`
@@ -422,7 +504,7 @@ type AmbientLocalUserEntityPayload struct {
 func (m *AmbientLocalUserEntityPayload) Reset()                    { *m = AmbientLocalUserEntityPayload{} }
 func (m *AmbientLocalUserEntityPayload) String() string            { return proto.CompactTextString(m) }
 func (*AmbientLocalUserEntityPayload) ProtoMessage()               {}
-func (*AmbientLocalUserEntityPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*AmbientLocalUserEntityPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *AmbientLocalUserEntityPayload) GetLocalUserExists() bool {
 	if m != nil {
@@ -444,7 +526,7 @@ type AmbientLocalUserEntityResponse struct {
 func (m *AmbientLocalUserEntityResponse) Reset()                    { *m = AmbientLocalUserEntityResponse{} }
 func (m *AmbientLocalUserEntityResponse) String() string            { return proto.CompactTextString(m) }
 func (*AmbientLocalUserEntityResponse) ProtoMessage()               {}
-func (*AmbientLocalUserEntityResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*AmbientLocalUserEntityResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 type HomeViewPayload struct {
 	Threads []*feobjects.CompiledThreadEntity `protobuf:"bytes,1,rep,name=Threads" json:"Threads,omitempty"`
@@ -453,7 +535,7 @@ type HomeViewPayload struct {
 func (m *HomeViewPayload) Reset()                    { *m = HomeViewPayload{} }
 func (m *HomeViewPayload) String() string            { return proto.CompactTextString(m) }
 func (*HomeViewPayload) ProtoMessage()               {}
-func (*HomeViewPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*HomeViewPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *HomeViewPayload) GetThreads() []*feobjects.CompiledThreadEntity {
 	if m != nil {
@@ -468,7 +550,7 @@ type HomeViewResponse struct {
 func (m *HomeViewResponse) Reset()                    { *m = HomeViewResponse{} }
 func (m *HomeViewResponse) String() string            { return proto.CompactTextString(m) }
 func (*HomeViewResponse) ProtoMessage()               {}
-func (*HomeViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*HomeViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 type PopularViewPayload struct {
 	Threads []*feobjects.CompiledThreadEntity `protobuf:"bytes,1,rep,name=Threads" json:"Threads,omitempty"`
@@ -477,7 +559,7 @@ type PopularViewPayload struct {
 func (m *PopularViewPayload) Reset()                    { *m = PopularViewPayload{} }
 func (m *PopularViewPayload) String() string            { return proto.CompactTextString(m) }
 func (*PopularViewPayload) ProtoMessage()               {}
-func (*PopularViewPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*PopularViewPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *PopularViewPayload) GetThreads() []*feobjects.CompiledThreadEntity {
 	if m != nil {
@@ -492,7 +574,7 @@ type PopularViewResponse struct {
 func (m *PopularViewResponse) Reset()                    { *m = PopularViewResponse{} }
 func (m *PopularViewResponse) String() string            { return proto.CompactTextString(m) }
 func (*PopularViewResponse) ProtoMessage()               {}
-func (*PopularViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*PopularViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 type NewViewPayload struct {
 	Threads []*feobjects.CompiledThreadEntity `protobuf:"bytes,1,rep,name=Threads" json:"Threads,omitempty"`
@@ -502,7 +584,7 @@ type NewViewPayload struct {
 func (m *NewViewPayload) Reset()                    { *m = NewViewPayload{} }
 func (m *NewViewPayload) String() string            { return proto.CompactTextString(m) }
 func (*NewViewPayload) ProtoMessage()               {}
-func (*NewViewPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*NewViewPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *NewViewPayload) GetThreads() []*feobjects.CompiledThreadEntity {
 	if m != nil {
@@ -524,7 +606,7 @@ type NewViewResponse struct {
 func (m *NewViewResponse) Reset()                    { *m = NewViewResponse{} }
 func (m *NewViewResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewViewResponse) ProtoMessage()               {}
-func (*NewViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*NewViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 type NotificationsPayload struct {
 	Notifications []*feobjects.CompiledNotification `protobuf:"bytes,1,rep,name=Notifications" json:"Notifications,omitempty"`
@@ -534,7 +616,7 @@ type NotificationsPayload struct {
 func (m *NotificationsPayload) Reset()                    { *m = NotificationsPayload{} }
 func (m *NotificationsPayload) String() string            { return proto.CompactTextString(m) }
 func (*NotificationsPayload) ProtoMessage()               {}
-func (*NotificationsPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*NotificationsPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *NotificationsPayload) GetNotifications() []*feobjects.CompiledNotification {
 	if m != nil {
@@ -556,7 +638,7 @@ type NotificationsResponse struct {
 func (m *NotificationsResponse) Reset()                    { *m = NotificationsResponse{} }
 func (m *NotificationsResponse) String() string            { return proto.CompactTextString(m) }
 func (*NotificationsResponse) ProtoMessage()               {}
-func (*NotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*NotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 type OnboardCompleteStatusPayload struct {
 	OnboardComplete bool `protobuf:"varint,1,opt,name=OnboardComplete" json:"OnboardComplete,omitempty"`
@@ -565,7 +647,7 @@ type OnboardCompleteStatusPayload struct {
 func (m *OnboardCompleteStatusPayload) Reset()                    { *m = OnboardCompleteStatusPayload{} }
 func (m *OnboardCompleteStatusPayload) String() string            { return proto.CompactTextString(m) }
 func (*OnboardCompleteStatusPayload) ProtoMessage()               {}
-func (*OnboardCompleteStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*OnboardCompleteStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *OnboardCompleteStatusPayload) GetOnboardComplete() bool {
 	if m != nil {
@@ -580,7 +662,7 @@ type OnboardCompleteStatusResponse struct {
 func (m *OnboardCompleteStatusResponse) Reset()                    { *m = OnboardCompleteStatusResponse{} }
 func (m *OnboardCompleteStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*OnboardCompleteStatusResponse) ProtoMessage()               {}
-func (*OnboardCompleteStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*OnboardCompleteStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 type ModModeEnabledStatusPayload struct {
 	ModModeEnabled bool `protobuf:"varint,1,opt,name=ModModeEnabled" json:"ModModeEnabled,omitempty"`
@@ -589,7 +671,7 @@ type ModModeEnabledStatusPayload struct {
 func (m *ModModeEnabledStatusPayload) Reset()                    { *m = ModModeEnabledStatusPayload{} }
 func (m *ModModeEnabledStatusPayload) String() string            { return proto.CompactTextString(m) }
 func (*ModModeEnabledStatusPayload) ProtoMessage()               {}
-func (*ModModeEnabledStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*ModModeEnabledStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *ModModeEnabledStatusPayload) GetModModeEnabled() bool {
 	if m != nil {
@@ -604,7 +686,7 @@ type ModModeEnabledStatusResponse struct {
 func (m *ModModeEnabledStatusResponse) Reset()                    { *m = ModModeEnabledStatusResponse{} }
 func (m *ModModeEnabledStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*ModModeEnabledStatusResponse) ProtoMessage()               {}
-func (*ModModeEnabledStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*ModModeEnabledStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 type ExternalContentAutoloadDisabledStatusPayload struct {
 	ExternalContentAutoloadDisabled bool `protobuf:"varint,1,opt,name=ExternalContentAutoloadDisabled" json:"ExternalContentAutoloadDisabled,omitempty"`
@@ -618,7 +700,7 @@ func (m *ExternalContentAutoloadDisabledStatusPayload) String() string {
 }
 func (*ExternalContentAutoloadDisabledStatusPayload) ProtoMessage() {}
 func (*ExternalContentAutoloadDisabledStatusPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{28}
+	return fileDescriptor0, []int{29}
 }
 
 func (m *ExternalContentAutoloadDisabledStatusPayload) GetExternalContentAutoloadDisabled() bool {
@@ -639,7 +721,7 @@ func (m *ExternalContentAutoloadDisabledStatusResponse) String() string {
 }
 func (*ExternalContentAutoloadDisabledStatusResponse) ProtoMessage() {}
 func (*ExternalContentAutoloadDisabledStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{29}
+	return fileDescriptor0, []int{30}
 }
 
 type SearchResultPayload struct {
@@ -648,12 +730,16 @@ type SearchResultPayload struct {
 	Threads    []*feobjects.CompiledThreadEntity `protobuf:"bytes,3,rep,name=Threads" json:"Threads,omitempty"`
 	Posts      []*feobjects.CompiledPostEntity   `protobuf:"bytes,4,rep,name=Posts" json:"Posts,omitempty"`
 	Users      []*feobjects.CompiledUserEntity   `protobuf:"bytes,5,rep,name=Users" json:"Users,omitempty"`
+	Offset     int32                             `protobuf:"varint,6,opt,name=Offset" json:"Offset,omitempty"`
+	Limit      int32                             `protobuf:"varint,7,opt,name=Limit" json:"Limit,omitempty"`
+	Total      uint64                            `protobuf:"varint,8,opt,name=Total" json:"Total,omitempty"`
+	Highlights []*SearchHighlight                `protobuf:"bytes,9,rep,name=Highlights" json:"Highlights,omitempty"`
 }
 
 func (m *SearchResultPayload) Reset()                    { *m = SearchResultPayload{} }
 func (m *SearchResultPayload) String() string            { return proto.CompactTextString(m) }
 func (*SearchResultPayload) ProtoMessage()               {}
-func (*SearchResultPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*SearchResultPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *SearchResultPayload) GetSearchType() string {
 	if m != nil {
@@ -690,13 +776,74 @@ func (m *SearchResultPayload) GetUsers() []*feobjects.CompiledUserEntity {
 	return nil
 }
 
+func (m *SearchResultPayload) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SearchResultPayload) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchResultPayload) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SearchResultPayload) GetHighlights() []*SearchHighlight {
+	if m != nil {
+		return m.Highlights
+	}
+	return nil
+}
+
 type SearchResultResponse struct {
 }
 
 func (m *SearchResultResponse) Reset()                    { *m = SearchResultResponse{} }
 func (m *SearchResultResponse) String() string            { return proto.CompactTextString(m) }
 func (*SearchResultResponse) ProtoMessage()               {}
-func (*SearchResultResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*SearchResultResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+// The highlighted fragments of a field of a search result.
+type SearchHighlight struct {
+	Fingerprint string   `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
+	Field       string   `protobuf:"bytes,2,opt,name=Field" json:"Field,omitempty"`
+	Fragments   []string `protobuf:"bytes,3,rep,name=Fragments" json:"Fragments,omitempty"`
+}
+
+func (m *SearchHighlight) Reset()                    { *m = SearchHighlight{} }
+func (m *SearchHighlight) String() string            { return proto.CompactTextString(m) }
+func (*SearchHighlight) ProtoMessage()               {}
+func (*SearchHighlight) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *SearchHighlight) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *SearchHighlight) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SearchHighlight) GetFragments() []string {
+	if m != nil {
+		return m.Fragments
+	}
+	return nil
+}
 
 func init() {
 	proto.RegisterType((*FEReadyRequest)(nil), "clapi.FEReadyRequest")
@@ -710,6 +857,7 @@ func init() {
 	proto.RegisterType((*InflightVote)(nil), "clapi.InflightVote")
 	proto.RegisterType((*InflightKey)(nil), "clapi.InflightKey")
 	proto.RegisterType((*InflightTruststate)(nil), "clapi.InflightTruststate")
+	proto.RegisterType((*InflightDirectMessage)(nil), "clapi.InflightDirectMessage")
 	proto.RegisterType((*Inflights)(nil), "clapi.Inflights")
 	proto.RegisterType((*AmbientStatusPayload)(nil), "clapi.AmbientStatusPayload")
 	proto.RegisterType((*AmbientStatusResponse)(nil), "clapi.AmbientStatusResponse")
@@ -731,6 +879,7 @@ func init() {
 	proto.RegisterType((*ExternalContentAutoloadDisabledStatusResponse)(nil), "clapi.ExternalContentAutoloadDisabledStatusResponse")
 	proto.RegisterType((*SearchResultPayload)(nil), "clapi.SearchResultPayload")
 	proto.RegisterType((*SearchResultResponse)(nil), "clapi.SearchResultResponse")
+	proto.RegisterType((*SearchHighlight)(nil), "clapi.SearchHighlight")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1177,82 +1326,98 @@ var _ClientAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("clapi/clapi.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x52, 0xdb, 0x46,
-	0x14, 0x8e, 0x31, 0x76, 0xc2, 0x71, 0xc0, 0xb0, 0x40, 0x22, 0xc4, 0x6f, 0x05, 0x61, 0x9c, 0x4e,
-	0xb0, 0x3b, 0xd0, 0x76, 0xa6, 0xd3, 0x99, 0x4c, 0xf9, 0x71, 0x4a, 0x0a, 0x24, 0x1e, 0x41, 0xb9,
-	0xe8, 0x9d, 0xb0, 0x97, 0xa0, 0x46, 0x96, 0x5c, 0xed, 0x3a, 0xc1, 0x77, 0x7d, 0x86, 0x5e, 0xf5,
-	0x05, 0x7a, 0xdb, 0x47, 0xea, 0x4b, 0xf4, 0x05, 0x3a, 0xfb, 0xab, 0x1f, 0xef, 0x18, 0xdc, 0xe1,
-	0x06, 0xa4, 0xdd, 0xef, 0x7c, 0xdf, 0xd1, 0x39, 0xbb, 0xdf, 0x2e, 0xc0, 0x5c, 0x3b, 0xf0, 0x7a,
-	0x7e, 0x83, 0xff, 0xac, 0xf7, 0xe2, 0x88, 0x46, 0xa8, 0xc4, 0x5f, 0xec, 0xa5, 0x6b, 0x1c, 0x5d,
-	0xfd, 0x8a, 0xdb, 0x94, 0x34, 0xf4, 0x93, 0x40, 0xd8, 0xf3, 0x5d, 0xbf, 0xcb, 0xa2, 0xc4, 0x2f,
-	0x31, 0xe8, 0xbc, 0x86, 0x99, 0x37, 0x4d, 0x17, 0x7b, 0x9d, 0x81, 0x8b, 0x7f, 0xeb, 0x63, 0x42,
-	0x91, 0x05, 0x8f, 0xbd, 0x4e, 0x27, 0xc6, 0x84, 0x58, 0x85, 0x8d, 0x42, 0x6d, 0xca, 0x55, 0xaf,
-	0x08, 0xc1, 0x64, 0x2f, 0x8a, 0xa9, 0x35, 0xb1, 0x51, 0xa8, 0x95, 0x5c, 0xfe, 0xec, 0xcc, 0x41,
-	0x55, 0xc7, 0x93, 0x5e, 0x14, 0x12, 0xec, 0x1c, 0x43, 0x75, 0xbf, 0x7b, 0xe5, 0xe3, 0x90, 0x12,
-	0xc5, 0xf9, 0x0d, 0x94, 0x0f, 0x22, 0x2f, 0xee, 0x30, 0xca, 0x62, 0xad, 0xb2, 0xbb, 0x5a, 0x4f,
-	0x92, 0x93, 0x58, 0x3e, 0xdf, 0x0c, 0xa9, 0x4f, 0x07, 0xae, 0x04, 0x3b, 0x08, 0x66, 0x13, 0x26,
-	0xc9, 0xfe, 0x4f, 0x01, 0x66, 0xde, 0x86, 0xd7, 0x81, 0xff, 0xe1, 0x86, 0x9e, 0x53, 0x8f, 0xf6,
-	0x09, 0x7a, 0x05, 0x73, 0x87, 0x51, 0xb7, 0x17, 0x60, 0xea, 0x47, 0x61, 0x0b, 0xc7, 0x6d, 0x1c,
-	0x52, 0x9e, 0x7b, 0xc9, 0x1d, 0x9e, 0x40, 0x6b, 0x00, 0x22, 0xee, 0x02, 0xdf, 0x8a, 0x6f, 0x99,
-	0x72, 0x53, 0x23, 0xa8, 0x0e, 0x48, 0xa6, 0x8d, 0x3b, 0x17, 0x7e, 0x17, 0x13, 0xea, 0x75, 0x7b,
-	0xd6, 0xe4, 0x46, 0xa1, 0x56, 0x74, 0x0d, 0x33, 0xe8, 0x2b, 0x98, 0x3f, 0xf5, 0x08, 0xdd, 0x6f,
-	0x33, 0x91, 0x24, 0xa0, 0xc4, 0x03, 0x4c, 0x53, 0x68, 0x05, 0xa6, 0x9a, 0x9f, 0x70, 0x48, 0x2f,
-	0x06, 0x3d, 0x6c, 0x95, 0x79, 0x02, 0xc9, 0x80, 0x83, 0x61, 0x5a, 0x7d, 0x1f, 0x2f, 0x03, 0xda,
-	0x81, 0xb2, 0x48, 0x8f, 0x7f, 0x53, 0x65, 0x77, 0xb1, 0x2e, 0xfa, 0x9e, 0xad, 0x82, 0x2b, 0x41,
-	0xe8, 0x05, 0x94, 0x45, 0x19, 0xf9, 0xb7, 0x55, 0x76, 0xa7, 0xeb, 0xb2, 0xe1, 0x9c, 0xcd, 0x95,
-	0x93, 0xce, 0x87, 0xa4, 0x8c, 0x17, 0x37, 0x31, 0xf6, 0xc6, 0xd6, 0xd9, 0xce, 0xe9, 0xcc, 0x28,
-	0x1d, 0x41, 0xa7, 0x85, 0xda, 0xf0, 0x54, 0x31, 0xb4, 0x22, 0x42, 0xc7, 0x95, 0xd9, 0xca, 0xc9,
-	0x3c, 0x55, 0x32, 0x8c, 0xcc, 0x24, 0x72, 0x19, 0x51, 0xfc, 0x60, 0x22, 0x8c, 0x4c, 0x8b, 0x78,
-	0x50, 0x51, 0xf1, 0x27, 0x78, 0x30, 0xae, 0xc6, 0x66, 0x4e, 0xa3, 0xa2, 0x34, 0x4e, 0xf0, 0x40,
-	0x4b, 0x44, 0x80, 0x74, 0x57, 0xe2, 0x3e, 0xa1, 0x84, 0x7a, 0xe3, 0x7f, 0xcd, 0x97, 0x39, 0x25,
-	0xa4, 0x3b, 0xa3, 0x29, 0xb5, 0xe0, 0x5f, 0x13, 0x30, 0xa5, 0x68, 0xd8, 0x4e, 0xca, 0xee, 0xd3,
-	0x85, 0x9c, 0x90, 0x5c, 0x42, 0x02, 0x83, 0x1a, 0xf0, 0x58, 0xf4, 0x9a, 0x58, 0x13, 0x1c, 0x9e,
-	0xcf, 0x4b, 0xae, 0x04, 0x85, 0x42, 0x2f, 0xa1, 0xc4, 0xba, 0x46, 0xac, 0x22, 0x87, 0xcf, 0xe7,
-	0xe0, 0xbc, 0xa3, 0x02, 0xc1, 0xa0, 0xac, 0xf6, 0xc4, 0x9a, 0x34, 0x42, 0x79, 0x5f, 0x04, 0x02,
-	0x6d, 0xc3, 0xe4, 0x09, 0x1e, 0x10, 0xab, 0xc4, 0x91, 0x28, 0x87, 0x64, 0xd5, 0xe5, 0xf3, 0xe8,
-	0x7b, 0xa8, 0x24, 0x05, 0x20, 0x56, 0x99, 0xc3, 0x97, 0xf2, 0x29, 0x27, 0x25, 0x4a, 0xa3, 0x9d,
-	0x7f, 0x0b, 0xb0, 0x20, 0xbd, 0x48, 0x54, 0xb9, 0xe5, 0x0d, 0x82, 0xc8, 0xeb, 0xa0, 0x73, 0x58,
-	0x38, 0xf0, 0xda, 0x1f, 0x71, 0xd8, 0xc9, 0x4c, 0xcb, 0x4e, 0xad, 0xa7, 0x8c, 0xce, 0x04, 0x73,
-	0x8d, 0xc1, 0xe8, 0x12, 0x16, 0xdf, 0xc4, 0x51, 0x48, 0x87, 0x58, 0x45, 0x43, 0x37, 0x52, 0xac,
-	0x46, 0x9c, 0x6b, 0x0e, 0x47, 0xf5, 0x54, 0xb3, 0xad, 0x22, 0xe7, 0x9a, 0xcd, 0x15, 0x80, 0xb8,
-	0x09, 0xc4, 0x79, 0x0e, 0x8b, 0x59, 0x5e, 0xe5, 0xc2, 0x7f, 0x14, 0x60, 0x55, 0xce, 0x9c, 0x46,
-	0x6d, 0x2f, 0xf8, 0x99, 0xe0, 0x58, 0xac, 0x28, 0x55, 0x97, 0x1a, 0x54, 0x93, 0x99, 0x5b, 0x9f,
-	0x75, 0x9d, 0x95, 0xe4, 0x89, 0x9b, 0x1f, 0x46, 0x3f, 0xa6, 0x91, 0xe9, 0x75, 0x9b, 0x3e, 0x25,
-	0x98, 0x8f, 0xfb, 0x01, 0xee, 0x24, 0x20, 0x37, 0x1f, 0xe5, 0x6c, 0xc0, 0x9a, 0x39, 0x27, 0x9d,
-	0xf6, 0x29, 0x54, 0x8f, 0xa3, 0x2e, 0xbe, 0xf4, 0xf1, 0x67, 0x95, 0xe7, 0x77, 0xc9, 0x22, 0x16,
-	0x6b, 0x7e, 0xdd, 0xa0, 0x2a, 0x10, 0x92, 0x4c, 0xe1, 0xd9, 0xf1, 0xa4, 0xd8, 0xb4, 0xc2, 0x7b,
-	0x40, 0xad, 0xa8, 0xd7, 0x0f, 0xbc, 0xf8, 0x81, 0x44, 0x16, 0x61, 0x3e, 0x45, 0xa8, 0x75, 0x7e,
-	0x2f, 0xc0, 0xcc, 0x3b, 0xfc, 0xf9, 0x61, 0x44, 0xd0, 0x9e, 0xda, 0x98, 0x13, 0x43, 0xc7, 0xb3,
-	0x0a, 0x64, 0xf3, 0x32, 0x4c, 0x60, 0xd9, 0xd1, 0x2f, 0x33, 0xd0, 0x59, 0x0d, 0x60, 0xe1, 0x5d,
-	0x44, 0xfd, 0x6b, 0xbf, 0xed, 0xb1, 0x23, 0x4f, 0x6f, 0x92, 0x26, 0x4c, 0x67, 0xc6, 0x47, 0x24,
-	0x98, 0xc6, 0xb9, 0xd9, 0x28, 0x64, 0xc3, 0x13, 0x76, 0x9e, 0x9e, 0x63, 0x1c, 0xf2, 0x25, 0x52,
-	0x74, 0xf5, 0x3b, 0x5b, 0xaa, 0x19, 0x70, 0xea, 0x3a, 0xb2, 0xf2, 0x3e, 0xbc, 0x62, 0x8e, 0x25,
-	0xef, 0x02, 0x38, 0xbb, 0x81, 0x6b, 0x50, 0xcd, 0xcd, 0xab, 0x85, 0x9a, 0x1b, 0x76, 0xd6, 0x61,
-	0xd5, 0xc8, 0xa4, 0xa5, 0x9a, 0xb0, 0x7c, 0x16, 0x75, 0xce, 0xa2, 0x0e, 0x6e, 0x86, 0xde, 0x55,
-	0x80, 0x3b, 0x59, 0xa5, 0x6d, 0x98, 0xc9, 0x4e, 0x4b, 0xa1, 0xdc, 0xa8, 0xb3, 0x06, 0x2b, 0x26,
-	0x1a, 0x2d, 0x73, 0x0b, 0xaf, 0x9a, 0xb7, 0x14, 0xc7, 0xa1, 0x17, 0x1c, 0xf2, 0x5d, 0x4e, 0xf7,
-	0xfb, 0x34, 0x62, 0x12, 0x47, 0x3e, 0x31, 0xe8, 0x1e, 0xc3, 0xfa, 0x1d, 0x78, 0x99, 0xc8, 0x5d,
-	0x30, 0xa7, 0x01, 0x3b, 0xf7, 0x52, 0x4e, 0x7c, 0x62, 0x02, 0xe6, 0xcf, 0xb1, 0x17, 0xb7, 0x6f,
-	0x5c, 0x4c, 0xfa, 0x01, 0x55, 0x29, 0xb1, 0x4b, 0x18, 0x1f, 0xe6, 0x77, 0xa0, 0x82, 0xbc, 0x84,
-	0xe9, 0x11, 0xf4, 0xad, 0x3e, 0x88, 0xc4, 0x8a, 0x5c, 0x33, 0xac, 0x14, 0xc3, 0x8d, 0x31, 0xbd,
-	0x07, 0x8a, 0xff, 0x77, 0x0f, 0x4c, 0xde, 0x7f, 0x0f, 0xb0, 0x20, 0x66, 0x33, 0xea, 0xf0, 0xb9,
-	0xc3, 0xb1, 0x04, 0xd6, 0x79, 0x06, 0x0b, 0xe9, 0x9a, 0xa8, 0x62, 0xed, 0xfe, 0xfd, 0x04, 0xa6,
-	0x0e, 0x03, 0xe6, 0x5f, 0xfb, 0xad, 0xb7, 0xe8, 0x07, 0x98, 0x56, 0x26, 0xce, 0xef, 0xd7, 0x48,
-	0x9d, 0xae, 0xd9, 0xfb, 0xba, 0xfd, 0x2c, 0x3f, 0x2c, 0x4b, 0xff, 0x08, 0x1d, 0x41, 0xf5, 0x08,
-	0x07, 0xfe, 0x27, 0x1c, 0xab, 0x5b, 0x34, 0x52, 0xe0, 0xdc, 0x05, 0xdd, 0x7e, 0x3e, 0x34, 0xae,
-	0x59, 0x5a, 0x30, 0x77, 0x3e, 0x74, 0x90, 0x2c, 0x67, 0xf1, 0x99, 0xf5, 0x66, 0xaf, 0x98, 0x26,
-	0x53, 0x8c, 0x1f, 0xc1, 0x4e, 0x31, 0xe6, 0xbc, 0x1a, 0x6d, 0x65, 0xa3, 0xcd, 0xc7, 0x8b, 0xfd,
-	0x62, 0x24, 0x2a, 0x25, 0xb6, 0x0f, 0x4f, 0x99, 0x98, 0x32, 0x6a, 0x5d, 0x81, 0xdc, 0x39, 0xa0,
-	0x2b, 0x30, 0xe4, 0xe8, 0x8f, 0xd0, 0x4f, 0x50, 0x65, 0x14, 0x29, 0x1b, 0x46, 0xea, 0xda, 0x30,
-	0xec, 0xf5, 0xb6, 0x3d, 0x3c, 0x95, 0xe2, 0x7a, 0x0d, 0x15, 0xc6, 0x25, 0x8d, 0x53, 0xf7, 0x34,
-	0x6b, 0xe5, 0xba, 0xa7, 0x79, 0x7f, 0xd5, 0xdd, 0xc8, 0xfa, 0xa2, 0xea, 0x86, 0xc9, 0x7b, 0x75,
-	0x37, 0xcc, 0xee, 0xf8, 0x08, 0xdd, 0xc0, 0x12, 0x63, 0x34, 0x3a, 0x1b, 0xda, 0x94, 0xc1, 0xa3,
-	0x1c, 0xd4, 0xde, 0x1a, 0x05, 0x4a, 0x29, 0x61, 0xb0, 0x98, 0x92, 0xc9, 0xdb, 0x90, 0x23, 0x39,
-	0x46, 0xf8, 0xa7, 0xbd, 0x39, 0x02, 0x93, 0x92, 0xf9, 0xb3, 0x00, 0x2f, 0x99, 0xce, 0xbd, 0x9c,
-	0x0a, 0xed, 0x49, 0xd2, 0x71, 0x1c, 0xd5, 0xfe, 0x7a, 0x9c, 0xa0, 0x54, 0x6a, 0x67, 0x30, 0xcb,
-	0x32, 0x4b, 0xef, 0x7e, 0xa4, 0xd6, 0x8b, 0xc1, 0x26, 0xed, 0x65, 0xc3, 0x5c, 0x42, 0x77, 0xf0,
-	0xc5, 0x2f, 0xeb, 0x1e, 0xa6, 0x37, 0x38, 0xde, 0x69, 0x47, 0x31, 0x6e, 0x88, 0xe7, 0x06, 0xff,
-	0xc3, 0x9e, 0x88, 0x7f, 0x0e, 0x5c, 0x95, 0xf9, 0xdb, 0xde, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x2d, 0x71, 0x3c, 0x77, 0x32, 0x10, 0x00, 0x00,
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x52, 0x1b, 0x47,
+	0x10, 0xb6, 0x10, 0x02, 0xd4, 0xe2, 0xc7, 0x0c, 0x02, 0xaf, 0xd7, 0x18, 0xc8, 0x1a, 0xbb, 0x70,
+	0xca, 0x40, 0x0a, 0x27, 0xae, 0x4a, 0xa5, 0xca, 0x15, 0x6c, 0x44, 0x20, 0x06, 0x9b, 0x5a, 0x88,
+	0x0f, 0xb9, 0x2d, 0xda, 0x46, 0xda, 0x78, 0xb5, 0xab, 0xec, 0x8c, 0x6c, 0x74, 0xcb, 0x13, 0xe4,
+	0x90, 0x53, 0x9e, 0x22, 0x0f, 0x90, 0x57, 0xca, 0x31, 0x2f, 0x90, 0x9a, 0xdf, 0xfd, 0xd1, 0x16,
+	0xb6, 0x52, 0xbe, 0xd8, 0x9a, 0xe9, 0xaf, 0xbf, 0xaf, 0x77, 0xba, 0x67, 0x7a, 0x06, 0x58, 0x6c,
+	0x87, 0x5e, 0x3f, 0xd8, 0x15, 0xff, 0xee, 0xf4, 0x93, 0x98, 0xc5, 0xa4, 0x26, 0x06, 0xf6, 0xdd,
+	0x2b, 0x8c, 0x2f, 0x7f, 0xc1, 0x36, 0xa3, 0xbb, 0xe6, 0x97, 0x44, 0xd8, 0x4b, 0xbd, 0xa0, 0xc7,
+	0xbd, 0xe4, 0x7f, 0x72, 0xd2, 0x79, 0x0e, 0xf3, 0x87, 0x2d, 0x17, 0x3d, 0x7f, 0xe8, 0xe2, 0xaf,
+	0x03, 0xa4, 0x8c, 0x58, 0x30, 0xed, 0xf9, 0x7e, 0x82, 0x94, 0x5a, 0x95, 0x8d, 0xca, 0x56, 0xdd,
+	0xd5, 0x43, 0x42, 0x60, 0xb2, 0x1f, 0x27, 0xcc, 0x9a, 0xd8, 0xa8, 0x6c, 0xd5, 0x5c, 0xf1, 0xdb,
+	0x59, 0x84, 0x05, 0xe3, 0x4f, 0xfb, 0x71, 0x44, 0xd1, 0x39, 0x82, 0x85, 0xfd, 0xde, 0x65, 0x80,
+	0x11, 0xa3, 0x9a, 0xf3, 0x1b, 0x98, 0x7a, 0x11, 0x7b, 0x89, 0xcf, 0x29, 0xab, 0x5b, 0x8d, 0xbd,
+	0xfb, 0x3b, 0x69, 0x70, 0x0a, 0x2b, 0xec, 0xad, 0x88, 0x05, 0x6c, 0xe8, 0x2a, 0xb0, 0x43, 0xe0,
+	0x76, 0xca, 0xa4, 0xd8, 0xff, 0xae, 0xc2, 0xfc, 0x71, 0x74, 0x15, 0x06, 0x9d, 0x2e, 0x3b, 0x67,
+	0x1e, 0x1b, 0x50, 0xf2, 0x04, 0x16, 0x5f, 0xc6, 0xbd, 0x7e, 0x88, 0x2c, 0x88, 0xa3, 0x33, 0x4c,
+	0xda, 0x18, 0x31, 0x11, 0x7b, 0xcd, 0x1d, 0x35, 0x90, 0x35, 0x00, 0xe9, 0x77, 0x81, 0xd7, 0xf2,
+	0x5b, 0xea, 0x6e, 0x66, 0x86, 0xec, 0x00, 0x51, 0x61, 0xa3, 0x7f, 0x11, 0xf4, 0x90, 0x32, 0xaf,
+	0xd7, 0xb7, 0x26, 0x37, 0x2a, 0x5b, 0x55, 0xb7, 0xc4, 0x42, 0xbe, 0x82, 0xa5, 0x13, 0x8f, 0xb2,
+	0xfd, 0x36, 0x17, 0x49, 0x1d, 0x6a, 0xc2, 0xa1, 0xcc, 0x44, 0x56, 0xa1, 0xde, 0x7a, 0x8f, 0x11,
+	0xbb, 0x18, 0xf6, 0xd1, 0x9a, 0x12, 0x01, 0xa4, 0x13, 0x64, 0x1e, 0x26, 0x8e, 0x7d, 0x6b, 0x5a,
+	0x4c, 0x4f, 0x1c, 0xfb, 0x64, 0x03, 0x1a, 0x47, 0x1e, 0xed, 0x22, 0xbd, 0x48, 0x02, 0xf4, 0xad,
+	0x19, 0xc1, 0x9b, 0x9d, 0x22, 0x7b, 0xd0, 0x6c, 0x51, 0x16, 0xf4, 0x3c, 0x86, 0xfe, 0x39, 0xb6,
+	0xe3, 0xc8, 0xa7, 0x27, 0x78, 0xc5, 0xac, 0xba, 0x80, 0x96, 0xda, 0x88, 0x0d, 0x33, 0xfb, 0x8c,
+	0x61, 0xaf, 0xcf, 0xa8, 0x05, 0x62, 0xa9, 0xcc, 0x98, 0xf3, 0xbd, 0xc6, 0x6b, 0xa6, 0xc6, 0xe9,
+	0x27, 0x35, 0x24, 0x5f, 0x99, 0x8d, 0x6c, 0xc2, 0xdc, 0xa1, 0x17, 0x84, 0x83, 0x04, 0x5d, 0xf4,
+	0x68, 0x1c, 0x59, 0xb3, 0xe2, 0x03, 0xf2, 0x93, 0x0e, 0xc2, 0x9c, 0xce, 0x9d, 0x48, 0x31, 0xd9,
+	0x86, 0x29, 0xb9, 0xf4, 0x22, 0x5f, 0x8d, 0xbd, 0xe5, 0x1d, 0x59, 0xd3, 0xf9, 0x0c, 0xbb, 0x0a,
+	0x44, 0x1e, 0xc2, 0x94, 0x2c, 0x11, 0x91, 0xb7, 0xc6, 0xde, 0xdc, 0x8e, 0x2a, 0x66, 0xc1, 0xe6,
+	0x2a, 0xa3, 0xd3, 0x49, 0x4b, 0xe4, 0xa2, 0x9b, 0xa0, 0x37, 0xb6, 0xce, 0xa3, 0x82, 0xce, 0xbc,
+	0xd6, 0x91, 0x74, 0x46, 0xa8, 0x0d, 0xb3, 0x9a, 0xe1, 0x2c, 0xa6, 0x6c, 0x5c, 0x99, 0xcd, 0x82,
+	0xcc, 0xac, 0x96, 0xe1, 0x64, 0x65, 0x22, 0x6f, 0x63, 0x86, 0x9f, 0x4d, 0x84, 0x93, 0x19, 0x11,
+	0x0f, 0x1a, 0xda, 0xff, 0x15, 0x0e, 0xc7, 0xd5, 0x78, 0x50, 0xd0, 0x68, 0x68, 0x8d, 0x57, 0x38,
+	0x34, 0x12, 0x31, 0x10, 0x93, 0x95, 0x64, 0x40, 0x19, 0x65, 0xde, 0xf8, 0x5f, 0xf3, 0x65, 0x41,
+	0x89, 0x98, 0xcc, 0x18, 0x4a, 0x23, 0x38, 0x80, 0x65, 0xcd, 0x72, 0x10, 0x24, 0xd8, 0x66, 0xa7,
+	0x48, 0xa9, 0xd7, 0x19, 0x5b, 0x73, 0xbb, 0xa0, 0xb9, 0xac, 0x35, 0x73, 0xac, 0x46, 0xf6, 0x9f,
+	0x09, 0xa8, 0x6b, 0x26, 0x7e, 0x38, 0xe5, 0x8f, 0xbe, 0x66, 0x41, 0x4b, 0x55, 0xae, 0xc4, 0x90,
+	0x5d, 0x98, 0x96, 0x25, 0x46, 0xad, 0x09, 0x01, 0x2f, 0x86, 0xa6, 0x0a, 0x50, 0xa3, 0xc8, 0x63,
+	0xa8, 0xf1, 0x62, 0xa1, 0x56, 0x55, 0xc0, 0x97, 0x0a, 0x70, 0x51, 0x48, 0x12, 0xc1, 0xa1, 0x3c,
+	0xe5, 0xd4, 0x9a, 0x2c, 0x85, 0x8a, 0x72, 0x90, 0x08, 0xf2, 0x08, 0x26, 0x5f, 0xe1, 0x90, 0x5a,
+	0x35, 0x81, 0x24, 0x05, 0x24, 0x4f, 0xaa, 0xb0, 0x93, 0xef, 0xa0, 0x91, 0xae, 0x3b, 0xb5, 0xa6,
+	0x04, 0xfc, 0x6e, 0x31, 0xe4, 0x34, 0x33, 0x59, 0x34, 0x39, 0x80, 0xf9, 0xdc, 0x02, 0x52, 0x6b,
+	0x5a, 0xf8, 0xaf, 0x16, 0xfc, 0xf3, 0xab, 0x5c, 0xf0, 0x71, 0xfe, 0xad, 0x40, 0x53, 0x35, 0x09,
+	0x99, 0xae, 0x33, 0x6f, 0x18, 0xc6, 0x9e, 0x4f, 0xce, 0xa1, 0xf9, 0xc2, 0x6b, 0xbf, 0xc3, 0xc8,
+	0xcf, 0x99, 0x55, 0xca, 0xd7, 0x33, 0x1d, 0xa8, 0x0c, 0xe6, 0x96, 0x3a, 0x93, 0xb7, 0xb0, 0x7c,
+	0x98, 0xc4, 0x11, 0x1b, 0x61, 0x95, 0x95, 0xb1, 0x91, 0x61, 0x2d, 0xc5, 0xb9, 0xe5, 0xee, 0x64,
+	0x27, 0x53, 0x32, 0x56, 0x55, 0x70, 0xdd, 0x2e, 0x2c, 0x03, 0x75, 0x53, 0x88, 0x73, 0x07, 0x96,
+	0xf3, 0xbc, 0xba, 0x3d, 0xfe, 0x51, 0x81, 0xfb, 0xca, 0x72, 0x12, 0xb7, 0xbd, 0xf0, 0x27, 0x8a,
+	0x89, 0xac, 0x4b, 0xbd, 0x2e, 0x5b, 0xb0, 0x90, 0x5a, 0xae, 0x03, 0x5e, 0x3b, 0x7c, 0x49, 0x66,
+	0xdc, 0xe2, 0x34, 0xf9, 0x21, 0x8b, 0xcc, 0x6e, 0x80, 0x6c, 0xfb, 0xe6, 0x0d, 0x36, 0x08, 0xd1,
+	0x4f, 0x41, 0x6e, 0xd1, 0xcb, 0xd9, 0x80, 0xb5, 0xf2, 0x98, 0x4c, 0xd8, 0x27, 0xb0, 0x70, 0x14,
+	0xf7, 0xf0, 0x6d, 0x80, 0x1f, 0x74, 0x9c, 0xdf, 0xa6, 0x5b, 0x41, 0xee, 0x9c, 0xf5, 0x12, 0x55,
+	0x89, 0x50, 0x64, 0x1a, 0xcf, 0xef, 0x0d, 0x9a, 0xcd, 0x28, 0xbc, 0x01, 0x72, 0x16, 0xf7, 0x07,
+	0xa1, 0x97, 0x7c, 0x26, 0x91, 0x65, 0x58, 0xca, 0x10, 0x1a, 0x9d, 0xdf, 0x2a, 0x30, 0xff, 0x1a,
+	0x3f, 0x7c, 0x1e, 0x11, 0xf2, 0x54, 0x6f, 0xef, 0x89, 0x91, 0x7b, 0x93, 0x76, 0xe4, 0x76, 0xe5,
+	0x26, 0xb1, 0xfc, 0x4e, 0xa6, 0x22, 0x30, 0x51, 0x0d, 0xa1, 0xf9, 0x3a, 0x66, 0xc1, 0x55, 0xd0,
+	0xf6, 0xf8, 0x5d, 0xc4, 0x6c, 0x92, 0x16, 0xcc, 0xe5, 0xe6, 0x6f, 0x08, 0x30, 0x8b, 0x73, 0xf3,
+	0x5e, 0xfc, 0x36, 0xc1, 0x2f, 0x3a, 0xe7, 0x88, 0x91, 0x28, 0x91, 0xaa, 0x6b, 0xc6, 0xbc, 0x54,
+	0x73, 0xe0, 0xcc, 0x3d, 0x71, 0xf5, 0x4d, 0x74, 0xc9, 0xcf, 0x3d, 0x75, 0x49, 0xc3, 0xfc, 0x06,
+	0xde, 0x82, 0x85, 0x82, 0x5d, 0x17, 0x6a, 0x61, 0xda, 0x59, 0x87, 0xfb, 0xa5, 0x4c, 0x46, 0xaa,
+	0x05, 0xf7, 0x4e, 0x63, 0xff, 0x34, 0xf6, 0xb1, 0x15, 0x79, 0x97, 0x21, 0xfa, 0x79, 0xa5, 0x47,
+	0x30, 0x9f, 0x37, 0x2b, 0xa1, 0xc2, 0xac, 0xb3, 0x06, 0xab, 0x65, 0x34, 0x46, 0xe6, 0x1a, 0x9e,
+	0xb4, 0xae, 0x19, 0x26, 0x91, 0x17, 0xbe, 0x14, 0xbb, 0x9c, 0xed, 0x0f, 0x58, 0xcc, 0x25, 0x0e,
+	0x02, 0x5a, 0xa2, 0x7b, 0x04, 0xeb, 0x1f, 0xc1, 0xab, 0x40, 0x3e, 0x06, 0x73, 0x76, 0x61, 0xfb,
+	0x93, 0x94, 0x4d, 0xa8, 0xbf, 0x57, 0x61, 0xe9, 0x1c, 0xbd, 0xa4, 0xdd, 0x75, 0x91, 0x0e, 0x42,
+	0xa6, 0x43, 0xe2, 0xb7, 0x63, 0x31, 0x2d, 0x2e, 0xa7, 0x15, 0x75, 0x3b, 0x36, 0x33, 0xe4, 0x99,
+	0x69, 0x67, 0xb2, 0x22, 0xd7, 0x4a, 0x2a, 0xa5, 0xe4, 0x2a, 0x9f, 0xdd, 0x03, 0xd5, 0xff, 0xbb,
+	0x07, 0x26, 0x3f, 0x7d, 0x0f, 0x70, 0x27, 0x7e, 0xcc, 0xe8, 0x16, 0xf6, 0x91, 0x13, 0x4b, 0x62,
+	0xc9, 0x0a, 0x4c, 0xbd, 0xb9, 0xba, 0xa2, 0xc8, 0xc4, 0xad, 0xbc, 0xe6, 0xaa, 0x11, 0x69, 0x42,
+	0xed, 0x24, 0xe8, 0x05, 0x4c, 0xdc, 0xca, 0x6b, 0xae, 0x1c, 0xf0, 0xd9, 0x8b, 0x98, 0x79, 0xa1,
+	0xb8, 0x92, 0x4f, 0xba, 0x72, 0x40, 0x9e, 0x01, 0x1c, 0x05, 0x9d, 0xae, 0x3a, 0xca, 0xeb, 0x42,
+	0x7d, 0x45, 0x1d, 0xe5, 0x72, 0x1d, 0x8d, 0xd9, 0xcd, 0x20, 0x9d, 0x15, 0x68, 0x66, 0xf3, 0x61,
+	0x12, 0xd5, 0x81, 0x85, 0x82, 0x1b, 0x7f, 0x11, 0x1c, 0x06, 0x51, 0x07, 0x93, 0x7e, 0x12, 0xa8,
+	0x97, 0x4e, 0xdd, 0xcd, 0x4e, 0xf1, 0xd0, 0x0e, 0x03, 0x0c, 0x7d, 0xf5, 0xbc, 0x91, 0x03, 0xfe,
+	0xee, 0x38, 0x4c, 0xbc, 0x4e, 0x8f, 0xbf, 0xa7, 0x44, 0x16, 0xea, 0x6e, 0x3a, 0xb1, 0xf7, 0xd7,
+	0x0c, 0xd4, 0x5f, 0x86, 0xfc, 0x90, 0xde, 0x3f, 0x3b, 0x26, 0xdf, 0xc3, 0x9c, 0xee, 0x54, 0xe2,
+	0x75, 0x47, 0xf4, 0x45, 0x24, 0xff, 0x5a, 0xb4, 0x57, 0x8a, 0xd3, 0x2a, 0xec, 0x5b, 0xe4, 0x00,
+	0x16, 0x0e, 0x30, 0x0c, 0xde, 0x63, 0xa2, 0xdf, 0x70, 0x44, 0x83, 0x0b, 0xcf, 0x43, 0xfb, 0xce,
+	0xc8, 0xbc, 0x61, 0x39, 0x83, 0xc5, 0xf3, 0x91, 0x6e, 0x79, 0x2f, 0x8f, 0xcf, 0x6d, 0x2a, 0x7b,
+	0xb5, 0xcc, 0x98, 0x61, 0x7c, 0x07, 0x76, 0x86, 0xb1, 0xd0, 0x90, 0xc8, 0x66, 0xde, 0xbb, 0xbc,
+	0x87, 0xda, 0x0f, 0x6f, 0x44, 0x65, 0xc4, 0xf6, 0x61, 0x96, 0x8b, 0xe9, 0x6e, 0x64, 0x56, 0xa0,
+	0xd0, 0xec, 0xcc, 0x0a, 0x8c, 0xb4, 0xad, 0x5b, 0xe4, 0x47, 0x5e, 0x00, 0x91, 0x9f, 0xe9, 0x35,
+	0x44, 0xdf, 0xb0, 0x46, 0x1b, 0x9a, 0x6d, 0x8f, 0x9a, 0x32, 0x5c, 0xcf, 0xa1, 0xc1, 0xb9, 0x54,
+	0x77, 0x30, 0x39, 0xcd, 0xf7, 0x2b, 0x93, 0xd3, 0x62, 0x13, 0x31, 0xd9, 0xc8, 0x1f, 0xfe, 0x3a,
+	0x1b, 0x65, 0x0d, 0xc6, 0x64, 0xa3, 0xbc, 0x05, 0xdc, 0x22, 0x5d, 0xb8, 0xcb, 0x19, 0x4b, 0x8f,
+	0x6f, 0xf2, 0x40, 0x39, 0xdf, 0xd4, 0x26, 0xec, 0xcd, 0x9b, 0x40, 0x19, 0x25, 0x04, 0x8b, 0x2b,
+	0x95, 0x1d, 0xe0, 0xc4, 0x51, 0x1c, 0x37, 0x34, 0x09, 0xfb, 0xc1, 0x0d, 0x98, 0x8c, 0xcc, 0x9f,
+	0x15, 0x78, 0xcc, 0x75, 0x3e, 0xe9, 0x38, 0x26, 0x4f, 0x15, 0xe9, 0x38, 0x6d, 0xc3, 0xfe, 0x7a,
+	0x1c, 0xa7, 0x4c, 0x68, 0xa7, 0x70, 0x9b, 0x47, 0x96, 0x3d, 0x66, 0x88, 0x9d, 0x3b, 0x9a, 0x72,
+	0xbd, 0xc0, 0xbe, 0x57, 0x62, 0x4b, 0xe9, 0x5e, 0x7c, 0xf1, 0xf3, 0xba, 0x87, 0xac, 0x8b, 0xc9,
+	0x76, 0x3b, 0x4e, 0x70, 0x57, 0xfe, 0xde, 0x15, 0x7f, 0x56, 0xa2, 0xf2, 0x4f, 0x53, 0x97, 0x53,
+	0x62, 0xf4, 0xf4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x1b, 0x8b, 0x90, 0xb0, 0x12, 0x00,
+	0x00,
 }
@@ -26,6 +26,7 @@ goog.exportSymbol('proto.clapi.FEReadyResponse', null, global);
 goog.exportSymbol('proto.clapi.HomeViewPayload', null, global);
 goog.exportSymbol('proto.clapi.HomeViewResponse', null, global);
 goog.exportSymbol('proto.clapi.InflightBoard', null, global);
+goog.exportSymbol('proto.clapi.InflightDirectMessage', null, global);
 goog.exportSymbol('proto.clapi.InflightKey', null, global);
 goog.exportSymbol('proto.clapi.InflightPost', null, global);
 goog.exportSymbol('proto.clapi.InflightStatus', null, global);
@@ -43,6 +44,7 @@ goog.exportSymbol('proto.clapi.OnboardCompleteStatusPayload', null, global);
 goog.exportSymbol('proto.clapi.OnboardCompleteStatusResponse', null, global);
 goog.exportSymbol('proto.clapi.PopularViewPayload', null, global);
 goog.exportSymbol('proto.clapi.PopularViewResponse', null, global);
+goog.exportSymbol('proto.clapi.SearchHighlight', null, global);
 goog.exportSymbol('proto.clapi.SearchResultPayload', null, global);
 goog.exportSymbol('proto.clapi.SearchResultResponse', null, global);
 
@@ -665,7 +667,13 @@ proto.clapi.InflightStatus.toObject = function(includeInstance, msg) {
     statustext: jspb.Message.getFieldWithDefault(msg, 2, ""),
     requestedtimestamp: jspb.Message.getFieldWithDefault(msg, 4, 0),
     lastactiontimestamp: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    eventtype: jspb.Message.getFieldWithDefault(msg, 6, "")
+    eventtype: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    hashestried: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    estimatedsecondsleft: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    attempts: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    nextattempttimestamp: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    failurereason: jspb.Message.getFieldWithDefault(msg, 12, "")
   };
 
   if (includeInstance) {
@@ -722,6 +730,30 @@ proto.clapi.InflightStatus.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setEventtype(value);
       break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setHashestried(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEstimatedsecondsleft(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAttempts(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNextattempttimestamp(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFailurereason(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -786,6 +818,48 @@ proto.clapi.InflightStatus.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getHashestried();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getEstimatedsecondsleft();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getAttempts();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getNextattempttimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      11,
+      f
+    );
+  }
+  f = message.getFailurereason();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
 };
 
 
@@ -864,6 +938,96 @@ proto.clapi.InflightStatus.prototype.setEventtype = function(value) {
 };
 
 
+/**
+ * optional string Id = 7;
+ * @return {string}
+ */
+proto.clapi.InflightStatus.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/** @param {string} value */
+proto.clapi.InflightStatus.prototype.setId = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * optional int64 HashesTried = 8;
+ * @return {number}
+ */
+proto.clapi.InflightStatus.prototype.getHashestried = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.InflightStatus.prototype.setHashestried = function(value) {
+  jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * optional int64 EstimatedSecondsLeft = 9;
+ * @return {number}
+ */
+proto.clapi.InflightStatus.prototype.getEstimatedsecondsleft = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.InflightStatus.prototype.setEstimatedsecondsleft = function(value) {
+  jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * optional int32 Attempts = 10;
+ * @return {number}
+ */
+proto.clapi.InflightStatus.prototype.getAttempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.InflightStatus.prototype.setAttempts = function(value) {
+  jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * optional int64 NextAttemptTimestamp = 11;
+ * @return {number}
+ */
+proto.clapi.InflightStatus.prototype.getNextattempttimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.InflightStatus.prototype.setNextattempttimestamp = function(value) {
+  jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * optional string FailureReason = 12;
+ * @return {string}
+ */
+proto.clapi.InflightStatus.prototype.getFailurereason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/** @param {string} value */
+proto.clapi.InflightStatus.prototype.setFailurereason = function(value) {
+  jspb.Message.setField(this, 12, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -2083,6 +2247,209 @@ proto.clapi.InflightTruststate.prototype.hasEntity = function() {
 
 
 
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.clapi.InflightDirectMessage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.clapi.InflightDirectMessage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.clapi.InflightDirectMessage.displayName = 'proto.clapi.InflightDirectMessage';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.clapi.InflightDirectMessage.prototype.toObject = function(opt_includeInstance) {
+  return proto.clapi.InflightDirectMessage.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.clapi.InflightDirectMessage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.clapi.InflightDirectMessage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && proto.clapi.InflightStatus.toObject(includeInstance, f),
+    entity: (f = msg.getEntity()) && mimapi_mimapi_pb.DirectMessage.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.clapi.InflightDirectMessage}
+ */
+proto.clapi.InflightDirectMessage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.clapi.InflightDirectMessage;
+  return proto.clapi.InflightDirectMessage.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.clapi.InflightDirectMessage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.clapi.InflightDirectMessage}
+ */
+proto.clapi.InflightDirectMessage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.clapi.InflightStatus;
+      reader.readMessage(value,proto.clapi.InflightStatus.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new mimapi_mimapi_pb.DirectMessage;
+      reader.readMessage(value,mimapi_mimapi_pb.DirectMessage.deserializeBinaryFromReader);
+      msg.setEntity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.clapi.InflightDirectMessage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.clapi.InflightDirectMessage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.clapi.InflightDirectMessage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.clapi.InflightDirectMessage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.clapi.InflightStatus.serializeBinaryToWriter
+    );
+  }
+  f = message.getEntity();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      mimapi_mimapi_pb.DirectMessage.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional InflightStatus Status = 1;
+ * @return {?proto.clapi.InflightStatus}
+ */
+proto.clapi.InflightDirectMessage.prototype.getStatus = function() {
+  return /** @type{?proto.clapi.InflightStatus} */ (
+    jspb.Message.getWrapperField(this, proto.clapi.InflightStatus, 1));
+};
+
+
+/** @param {?proto.clapi.InflightStatus|undefined} value */
+proto.clapi.InflightDirectMessage.prototype.setStatus = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.clapi.InflightDirectMessage.prototype.clearStatus = function() {
+  this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.clapi.InflightDirectMessage.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional mimapi.DirectMessage Entity = 2;
+ * @return {?proto.mimapi.DirectMessage}
+ */
+proto.clapi.InflightDirectMessage.prototype.getEntity = function() {
+  return /** @type{?proto.mimapi.DirectMessage} */ (
+    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.DirectMessage, 2));
+};
+
+
+/** @param {?proto.mimapi.DirectMessage|undefined} value */
+proto.clapi.InflightDirectMessage.prototype.setEntity = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+proto.clapi.InflightDirectMessage.prototype.clearEntity = function() {
+  this.setEntity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.clapi.InflightDirectMessage.prototype.hasEntity = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -2105,7 +2472,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.clapi.Inflights.repeatedFields_ = [1,2,3,4,5,6];
+proto.clapi.Inflights.repeatedFields_ = [1,2,3,4,5,6,7];
 
 
 
@@ -2147,7 +2514,9 @@ proto.clapi.Inflights.toObject = function(includeInstance, msg) {
     keysList: jspb.Message.toObjectList(msg.getKeysList(),
     proto.clapi.InflightKey.toObject, includeInstance),
     truststatesList: jspb.Message.toObjectList(msg.getTruststatesList(),
-    proto.clapi.InflightTruststate.toObject, includeInstance)
+    proto.clapi.InflightTruststate.toObject, includeInstance),
+    directmessagesList: jspb.Message.toObjectList(msg.getDirectmessagesList(),
+    proto.clapi.InflightDirectMessage.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2214,6 +2583,11 @@ proto.clapi.Inflights.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.clapi.InflightTruststate.deserializeBinaryFromReader);
       msg.addTruststates(value);
       break;
+    case 7:
+      var value = new proto.clapi.InflightDirectMessage;
+      reader.readMessage(value,proto.clapi.InflightDirectMessage.deserializeBinaryFromReader);
+      msg.addDirectmessages(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2291,6 +2665,14 @@ proto.clapi.Inflights.serializeBinaryToWriter = function(message, writer) {
       proto.clapi.InflightTruststate.serializeBinaryToWriter
     );
   }
+  f = message.getDirectmessagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.clapi.InflightDirectMessage.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -2453,30 +2835,61 @@ proto.clapi.Inflights.prototype.clearKeysList = function() {
  * repeated InflightTruststate Truststates = 6;
  * @return {!Array.<!proto.clapi.InflightTruststate>}
  */
-proto.clapi.Inflights.prototype.getTruststatesList = function() {
-  return /** @type{!Array.<!proto.clapi.InflightTruststate>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.clapi.InflightTruststate, 6));
+proto.clapi.Inflights.prototype.getTruststatesList = function() {
+  return /** @type{!Array.<!proto.clapi.InflightTruststate>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.clapi.InflightTruststate, 6));
+};
+
+
+/** @param {!Array.<!proto.clapi.InflightTruststate>} value */
+proto.clapi.Inflights.prototype.setTruststatesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.clapi.InflightTruststate=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.clapi.InflightTruststate}
+ */
+proto.clapi.Inflights.prototype.addTruststates = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.clapi.InflightTruststate, opt_index);
+};
+
+
+proto.clapi.Inflights.prototype.clearTruststatesList = function() {
+  this.setTruststatesList([]);
+};
+
+
+/**
+ * repeated InflightDirectMessage DirectMessages = 7;
+ * @return {!Array.<!proto.clapi.InflightDirectMessage>}
+ */
+proto.clapi.Inflights.prototype.getDirectmessagesList = function() {
+  return /** @type{!Array.<!proto.clapi.InflightDirectMessage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.clapi.InflightDirectMessage, 7));
 };
 
 
-/** @param {!Array.<!proto.clapi.InflightTruststate>} value */
-proto.clapi.Inflights.prototype.setTruststatesList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 6, value);
+/** @param {!Array.<!proto.clapi.InflightDirectMessage>} value */
+proto.clapi.Inflights.prototype.setDirectmessagesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 7, value);
 };
 
 
 /**
- * @param {!proto.clapi.InflightTruststate=} opt_value
+ * @param {!proto.clapi.InflightDirectMessage=} opt_value
  * @param {number=} opt_index
- * @return {!proto.clapi.InflightTruststate}
+ * @return {!proto.clapi.InflightDirectMessage}
  */
-proto.clapi.Inflights.prototype.addTruststates = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.clapi.InflightTruststate, opt_index);
+proto.clapi.Inflights.prototype.addDirectmessages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.clapi.InflightDirectMessage, opt_index);
 };
 
 
-proto.clapi.Inflights.prototype.clearTruststatesList = function() {
-  this.setTruststatesList([]);
+proto.clapi.Inflights.prototype.clearDirectmessagesList = function() {
+  this.setDirectmessagesList([]);
 };
 
 
@@ -5159,7 +5572,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.clapi.SearchResultPayload.repeatedFields_ = [2,3,4,5];
+proto.clapi.SearchResultPayload.repeatedFields_ = [2,3,4,5,9];
 
 
 
@@ -5198,7 +5611,12 @@ proto.clapi.SearchResultPayload.toObject = function(includeInstance, msg) {
     postsList: jspb.Message.toObjectList(msg.getPostsList(),
     feobjects_feobjects_pb.CompiledPostEntity.toObject, includeInstance),
     usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    feobjects_feobjects_pb.CompiledUserEntity.toObject, includeInstance)
+    feobjects_feobjects_pb.CompiledUserEntity.toObject, includeInstance),
+    offset: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    total: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    highlightsList: jspb.Message.toObjectList(msg.getHighlightsList(),
+    proto.clapi.SearchHighlight.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5259,6 +5677,23 @@ proto.clapi.SearchResultPayload.deserializeBinaryFromReader = function(msg, read
       reader.readMessage(value,feobjects_feobjects_pb.CompiledUserEntity.deserializeBinaryFromReader);
       msg.addUsers(value);
       break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotal(value);
+      break;
+    case 9:
+      var value = new proto.clapi.SearchHighlight;
+      reader.readMessage(value,proto.clapi.SearchHighlight.deserializeBinaryFromReader);
+      msg.addHighlights(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5327,6 +5762,35 @@ proto.clapi.SearchResultPayload.serializeBinaryToWriter = function(message, writ
       feobjects_feobjects_pb.CompiledUserEntity.serializeBinaryToWriter
     );
   }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getHighlightsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.clapi.SearchHighlight.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -5469,6 +5933,82 @@ proto.clapi.SearchResultPayload.prototype.clearUsersList = function() {
 };
 
 
+/**
+ * optional int32 Offset = 6;
+ * @return {number}
+ */
+proto.clapi.SearchResultPayload.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.SearchResultPayload.prototype.setOffset = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional int32 Limit = 7;
+ * @return {number}
+ */
+proto.clapi.SearchResultPayload.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.SearchResultPayload.prototype.setLimit = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 Total = 8;
+ * @return {number}
+ */
+proto.clapi.SearchResultPayload.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/** @param {number} value */
+proto.clapi.SearchResultPayload.prototype.setTotal = function(value) {
+  jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * repeated SearchHighlight Highlights = 9;
+ * @return {!Array.<!proto.clapi.SearchHighlight>}
+ */
+proto.clapi.SearchResultPayload.prototype.getHighlightsList = function() {
+  return /** @type{!Array.<!proto.clapi.SearchHighlight>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.clapi.SearchHighlight, 9));
+};
+
+
+/** @param {!Array.<!proto.clapi.SearchHighlight>} value */
+proto.clapi.SearchResultPayload.prototype.setHighlightsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.clapi.SearchHighlight=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.clapi.SearchHighlight}
+ */
+proto.clapi.SearchResultPayload.prototype.addHighlights = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.clapi.SearchHighlight, opt_index);
+};
+
+
+proto.clapi.SearchResultPayload.prototype.clearHighlightsList = function() {
+  this.setHighlightsList([]);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -5585,4 +6125,221 @@ proto.clapi.SearchResultResponse.serializeBinaryToWriter = function(message, wri
 };
 
 
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.clapi.SearchHighlight = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.clapi.SearchHighlight.repeatedFields_, null);
+};
+goog.inherits(proto.clapi.SearchHighlight, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.clapi.SearchHighlight.displayName = 'proto.clapi.SearchHighlight';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.clapi.SearchHighlight.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.clapi.SearchHighlight.prototype.toObject = function(opt_includeInstance) {
+  return proto.clapi.SearchHighlight.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.clapi.SearchHighlight} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.clapi.SearchHighlight.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    field: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    fragmentsList: jspb.Message.getRepeatedField(msg, 3)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.clapi.SearchHighlight}
+ */
+proto.clapi.SearchHighlight.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.clapi.SearchHighlight;
+  return proto.clapi.SearchHighlight.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.clapi.SearchHighlight} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.clapi.SearchHighlight}
+ */
+proto.clapi.SearchHighlight.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFingerprint(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFragments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.clapi.SearchHighlight.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.clapi.SearchHighlight.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.clapi.SearchHighlight} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.clapi.SearchHighlight.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFragmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Fingerprint = 1;
+ * @return {string}
+ */
+proto.clapi.SearchHighlight.prototype.getFingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.clapi.SearchHighlight.prototype.setFingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional string Field = 2;
+ * @return {string}
+ */
+proto.clapi.SearchHighlight.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.clapi.SearchHighlight.prototype.setField = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * repeated string Fragments = 3;
+ * @return {!Array.<string>}
+ */
+proto.clapi.SearchHighlight.prototype.getFragmentsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.clapi.SearchHighlight.prototype.setFragmentsList = function(value) {
+  jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.clapi.SearchHighlight.prototype.addFragments = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+proto.clapi.SearchHighlight.prototype.clearFragmentsList = function() {
+  this.setFragmentsList([]);
+};
+
+
 goog.object.extend(exports, proto.clapi);
@@ -20,13 +20,25 @@ It has these top-level messages:
 	BoardAndThreadsResponse
 	BoardSignalRequest
 	BoardSignalResponse
+	ThreadSignalRequest
+	ThreadSignalResponse
 	UserAndGraphRequest
 	UserAndGraphResponse
 	Event
 	ContentEventPayload
+	BoardKeyRequest
+	BoardPoWRequest
 	ContentEventResponse
 	SignalEventPayload
 	SignalEventResponse
+	CancelInflightRequest
+	CancelInflightResponse
+	RetryInflightRequest
+	RetryInflightResponse
+	EntityHistoryRequest
+	EntityHistoryResponse
+	InboxRequest
+	InboxResponse
 	UncompiledEntityByKeyRequest
 	UncompiledEntityByKeyResponse
 	InflightsPruneRequest
@@ -110,6 +122,30 @@ func (x EventType) String() string {
 }
 func (EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+type BoardKeyAction int32
+
+const (
+	BoardKeyAction_UNKNOWN_BOARD_KEY_ACTION BoardKeyAction = 0
+	BoardKeyAction_ISSUE_BOARD_KEY          BoardKeyAction = 1
+	BoardKeyAction_GRANT_BOARD_KEY          BoardKeyAction = 2
+)
+
+var BoardKeyAction_name = map[int32]string{
+	0: "UNKNOWN_BOARD_KEY_ACTION",
+	1: "ISSUE_BOARD_KEY",
+	2: "GRANT_BOARD_KEY",
+}
+var BoardKeyAction_value = map[string]int32{
+	"UNKNOWN_BOARD_KEY_ACTION": 0,
+	"ISSUE_BOARD_KEY":          1,
+	"GRANT_BOARD_KEY":          2,
+}
+
+func (x BoardKeyAction) String() string {
+	return proto.EnumName(BoardKeyAction_name, int32(x))
+}
+func (BoardKeyAction) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
 type SignalTargetType int32
 
 const (
@@ -132,7 +168,7 @@ var SignalTargetType_value = map[string]int32{
 func (x SignalTargetType) String() string {
 	return proto.EnumName(SignalTargetType_name, int32(x))
 }
-func (SignalTargetType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (SignalTargetType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 type SignalTypeClass int32
 
@@ -171,7 +207,7 @@ var SignalTypeClass_value = map[string]int32{
 func (x SignalTypeClass) String() string {
 	return proto.EnumName(SignalTypeClass_name, int32(x))
 }
-func (SignalTypeClass) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (SignalTypeClass) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type SignalType int32
 
@@ -236,7 +272,7 @@ var SignalType_value = map[string]int32{
 func (x SignalType) String() string {
 	return proto.EnumName(SignalType_name, int32(x))
 }
-func (SignalType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (SignalType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 type UncompiledEntityType int32
 
@@ -272,7 +308,7 @@ var UncompiledEntityType_value = map[string]int32{
 func (x UncompiledEntityType) String() string {
 	return proto.EnumName(UncompiledEntityType_name, int32(x))
 }
-func (UncompiledEntityType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (UncompiledEntityType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 type BEReadyRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
@@ -413,6 +449,7 @@ func (m *ThreadAndPostsResponse) GetPosts() []*feobjects.CompiledPostEntity {
 type BoardAndThreadsRequest struct {
 	BoardFingerprint string `protobuf:"bytes,1,opt,name=BoardFingerprint" json:"BoardFingerprint,omitempty"`
 	SortThreadsByNew bool   `protobuf:"varint,2,opt,name=SortThreadsByNew" json:"SortThreadsByNew,omitempty"`
+	Sort             string `protobuf:"bytes,3,opt,name=Sort" json:"Sort,omitempty"`
 }
 
 func (m *BoardAndThreadsRequest) Reset()                    { *m = BoardAndThreadsRequest{} }
@@ -434,9 +471,17 @@ func (m *BoardAndThreadsRequest) GetSortThreadsByNew() bool {
 	return false
 }
 
+func (m *BoardAndThreadsRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
 type BoardAndThreadsResponse struct {
 	Board   *feobjects.CompiledBoardEntity    `protobuf:"bytes,1,opt,name=Board" json:"Board,omitempty"`
 	Threads []*feobjects.CompiledThreadEntity `protobuf:"bytes,2,rep,name=Threads" json:"Threads,omitempty"`
+	Sort    string                            `protobuf:"bytes,3,opt,name=Sort" json:"Sort,omitempty"`
 }
 
 func (m *BoardAndThreadsResponse) Reset()                    { *m = BoardAndThreadsResponse{} }
@@ -458,12 +503,22 @@ func (m *BoardAndThreadsResponse) GetThreads() []*feobjects.CompiledThreadEntity
 	return nil
 }
 
+func (m *BoardAndThreadsResponse) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
 type BoardSignalRequest struct {
 	Fingerprint  string `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	Subscribed   bool   `protobuf:"varint,2,opt,name=Subscribed" json:"Subscribed,omitempty"`
 	Notify       bool   `protobuf:"varint,3,opt,name=Notify" json:"Notify,omitempty"`
 	LastSeen     int64  `protobuf:"varint,4,opt,name=LastSeen" json:"LastSeen,omitempty"`
 	LastSeenOnly bool   `protobuf:"varint,5,opt,name=LastSeenOnly" json:"LastSeenOnly,omitempty"`
+	// ^ LastSeenOnly matters, because we don't want lastseen-only signals to wipe out other data, but we also want it to be sendable via other data esp. in the case of a new board being subscribed. If that case doesn't come with last seen, last seen will only be set at the second visit.
+	Ranking     string `protobuf:"bytes,6,opt,name=Ranking" json:"Ranking,omitempty"`
+	RankingOnly bool   `protobuf:"varint,7,opt,name=RankingOnly" json:"RankingOnly,omitempty"`
 }
 
 func (m *BoardSignalRequest) Reset()                    { *m = BoardSignalRequest{} }
@@ -506,6 +561,20 @@ func (m *BoardSignalRequest) GetLastSeenOnly() bool {
 	return false
 }
 
+func (m *BoardSignalRequest) GetRanking() string {
+	if m != nil {
+		return m.Ranking
+	}
+	return ""
+}
+
+func (m *BoardSignalRequest) GetRankingOnly() bool {
+	if m != nil {
+		return m.RankingOnly
+	}
+	return false
+}
+
 type BoardSignalResponse struct {
 	Committed bool `protobuf:"varint,1,opt,name=Committed" json:"Committed,omitempty"`
 }
@@ -522,6 +591,55 @@ func (m *BoardSignalResponse) GetCommitted() bool {
 	return false
 }
 
+// Following a thread. The new posts in a followed thread with notify enabled raise notifications.
+type ThreadSignalRequest struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
+	Subscribed  bool   `protobuf:"varint,2,opt,name=Subscribed" json:"Subscribed,omitempty"`
+	Notify      bool   `protobuf:"varint,3,opt,name=Notify" json:"Notify,omitempty"`
+}
+
+func (m *ThreadSignalRequest) Reset()                    { *m = ThreadSignalRequest{} }
+func (m *ThreadSignalRequest) String() string            { return proto.CompactTextString(m) }
+func (*ThreadSignalRequest) ProtoMessage()               {}
+func (*ThreadSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ThreadSignalRequest) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *ThreadSignalRequest) GetSubscribed() bool {
+	if m != nil {
+		return m.Subscribed
+	}
+	return false
+}
+
+func (m *ThreadSignalRequest) GetNotify() bool {
+	if m != nil {
+		return m.Notify
+	}
+	return false
+}
+
+type ThreadSignalResponse struct {
+	Committed bool `protobuf:"varint,1,opt,name=Committed" json:"Committed,omitempty"`
+}
+
+func (m *ThreadSignalResponse) Reset()                    { *m = ThreadSignalResponse{} }
+func (m *ThreadSignalResponse) String() string            { return proto.CompactTextString(m) }
+func (*ThreadSignalResponse) ProtoMessage()               {}
+func (*ThreadSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ThreadSignalResponse) GetCommitted() bool {
+	if m != nil {
+		return m.Committed
+	}
+	return false
+}
+
 type UserAndGraphRequest struct {
 	Fingerprint          string `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	UserEntityRequested  bool   `protobuf:"varint,2,opt,name=UserEntityRequested" json:"UserEntityRequested,omitempty"`
@@ -533,7 +651,7 @@ type UserAndGraphRequest struct {
 func (m *UserAndGraphRequest) Reset()                    { *m = UserAndGraphRequest{} }
 func (m *UserAndGraphRequest) String() string            { return proto.CompactTextString(m) }
 func (*UserAndGraphRequest) ProtoMessage()               {}
-func (*UserAndGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*UserAndGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *UserAndGraphRequest) GetFingerprint() string {
 	if m != nil {
@@ -584,7 +702,7 @@ type UserAndGraphResponse struct {
 func (m *UserAndGraphResponse) Reset()                    { *m = UserAndGraphResponse{} }
 func (m *UserAndGraphResponse) String() string            { return proto.CompactTextString(m) }
 func (*UserAndGraphResponse) ProtoMessage()               {}
-func (*UserAndGraphResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*UserAndGraphResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *UserAndGraphResponse) GetUser() *feobjects.CompiledUserEntity {
 	if m != nil {
@@ -652,7 +770,7 @@ type Event struct {
 func (m *Event) Reset()                    { *m = Event{} }
 func (m *Event) String() string            { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *Event) GetOwnerFingerprint() string {
 	if m != nil {
@@ -683,17 +801,20 @@ func (m *Event) GetTimestamp() int64 {
 }
 
 type ContentEventPayload struct {
-	Event      *Event         `protobuf:"bytes,1,opt,name=Event" json:"Event,omitempty"`
-	BoardData  *mimapi.Board  `protobuf:"bytes,2,opt,name=BoardData" json:"BoardData,omitempty"`
-	ThreadData *mimapi.Thread `protobuf:"bytes,3,opt,name=ThreadData" json:"ThreadData,omitempty"`
-	PostData   *mimapi.Post   `protobuf:"bytes,4,opt,name=PostData" json:"PostData,omitempty"`
-	KeyData    *mimapi.Key    `protobuf:"bytes,5,opt,name=KeyData" json:"KeyData,omitempty"`
+	Event             *Event                `protobuf:"bytes,1,opt,name=Event" json:"Event,omitempty"`
+	BoardData         *mimapi.Board         `protobuf:"bytes,2,opt,name=BoardData" json:"BoardData,omitempty"`
+	ThreadData        *mimapi.Thread        `protobuf:"bytes,3,opt,name=ThreadData" json:"ThreadData,omitempty"`
+	PostData          *mimapi.Post          `protobuf:"bytes,4,opt,name=PostData" json:"PostData,omitempty"`
+	KeyData           *mimapi.Key           `protobuf:"bytes,5,opt,name=KeyData" json:"KeyData,omitempty"`
+	DirectMessageData *mimapi.DirectMessage `protobuf:"bytes,6,opt,name=DirectMessageData" json:"DirectMessageData,omitempty"`
+	BoardKeyData      *BoardKeyRequest      `protobuf:"bytes,7,opt,name=BoardKeyData" json:"BoardKeyData,omitempty"`
+	BoardPoWData      *BoardPoWRequest      `protobuf:"bytes,8,opt,name=BoardPoWData" json:"BoardPoWData,omitempty"`
 }
 
 func (m *ContentEventPayload) Reset()                    { *m = ContentEventPayload{} }
 func (m *ContentEventPayload) String() string            { return proto.CompactTextString(m) }
 func (*ContentEventPayload) ProtoMessage()               {}
-func (*ContentEventPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*ContentEventPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *ContentEventPayload) GetEvent() *Event {
 	if m != nil {
@@ -730,13 +851,106 @@ func (m *ContentEventPayload) GetKeyData() *mimapi.Key {
 	return nil
 }
 
+func (m *ContentEventPayload) GetDirectMessageData() *mimapi.DirectMessage {
+	if m != nil {
+		return m.DirectMessageData
+	}
+	return nil
+}
+
+func (m *ContentEventPayload) GetBoardKeyData() *BoardKeyRequest {
+	if m != nil {
+		return m.BoardKeyData
+	}
+	return nil
+}
+
+func (m *ContentEventPayload) GetBoardPoWData() *BoardPoWRequest {
+	if m != nil {
+		return m.BoardPoWData
+	}
+	return nil
+}
+
+type BoardKeyRequest struct {
+	Action           BoardKeyAction `protobuf:"varint,1,opt,name=Action,enum=feapi.BoardKeyAction" json:"Action,omitempty"`
+	MemberPublicKeys []string       `protobuf:"bytes,2,rep,name=MemberPublicKeys" json:"MemberPublicKeys,omitempty"`
+}
+
+func (m *BoardKeyRequest) Reset()                    { *m = BoardKeyRequest{} }
+func (m *BoardKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*BoardKeyRequest) ProtoMessage()               {}
+func (*BoardKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *BoardKeyRequest) GetAction() BoardKeyAction {
+	if m != nil {
+		return m.Action
+	}
+	return BoardKeyAction_UNKNOWN_BOARD_KEY_ACTION
+}
+
+func (m *BoardKeyRequest) GetMemberPublicKeys() []string {
+	if m != nil {
+		return m.MemberPublicKeys
+	}
+	return nil
+}
+
+type BoardPoWRequest struct {
+	Remove       bool  `protobuf:"varint,1,opt,name=Remove" json:"Remove,omitempty"`
+	Thread       int32 `protobuf:"varint,2,opt,name=Thread" json:"Thread,omitempty"`
+	ThreadUpdate int32 `protobuf:"varint,3,opt,name=ThreadUpdate" json:"ThreadUpdate,omitempty"`
+	Post         int32 `protobuf:"varint,4,opt,name=Post" json:"Post,omitempty"`
+	PostUpdate   int32 `protobuf:"varint,5,opt,name=PostUpdate" json:"PostUpdate,omitempty"`
+}
+
+func (m *BoardPoWRequest) Reset()                    { *m = BoardPoWRequest{} }
+func (m *BoardPoWRequest) String() string            { return proto.CompactTextString(m) }
+func (*BoardPoWRequest) ProtoMessage()               {}
+func (*BoardPoWRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *BoardPoWRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+func (m *BoardPoWRequest) GetThread() int32 {
+	if m != nil {
+		return m.Thread
+	}
+	return 0
+}
+
+func (m *BoardPoWRequest) GetThreadUpdate() int32 {
+	if m != nil {
+		return m.ThreadUpdate
+	}
+	return 0
+}
+
+func (m *BoardPoWRequest) GetPost() int32 {
+	if m != nil {
+		return m.Post
+	}
+	return 0
+}
+
+func (m *BoardPoWRequest) GetPostUpdate() int32 {
+	if m != nil {
+		return m.PostUpdate
+	}
+	return 0
+}
+
 type ContentEventResponse struct {
 }
 
 func (m *ContentEventResponse) Reset()                    { *m = ContentEventResponse{} }
 func (m *ContentEventResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContentEventResponse) ProtoMessage()               {}
-func (*ContentEventResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*ContentEventResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 type SignalEventPayload struct {
 	Event            *Event           `protobuf:"bytes,1,opt,name=Event" json:"Event,omitempty"`
@@ -757,7 +971,7 @@ type SignalEventPayload struct {
 func (m *SignalEventPayload) Reset()                    { *m = SignalEventPayload{} }
 func (m *SignalEventPayload) String() string            { return proto.CompactTextString(m) }
 func (*SignalEventPayload) ProtoMessage()               {}
-func (*SignalEventPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*SignalEventPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *SignalEventPayload) GetEvent() *Event {
 	if m != nil {
@@ -842,7 +1056,153 @@ type SignalEventResponse struct {
 func (m *SignalEventResponse) Reset()                    { *m = SignalEventResponse{} }
 func (m *SignalEventResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignalEventResponse) ProtoMessage()               {}
-func (*SignalEventResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*SignalEventResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+// Cancelling an inflight item (i.e. stopping its proof-of-work minting), by the Id in its inflight status.
+type CancelInflightRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+}
+
+func (m *CancelInflightRequest) Reset()                    { *m = CancelInflightRequest{} }
+func (m *CancelInflightRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelInflightRequest) ProtoMessage()               {}
+func (*CancelInflightRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *CancelInflightRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CancelInflightResponse struct {
+	Cancelled bool `protobuf:"varint,1,opt,name=Cancelled" json:"Cancelled,omitempty"`
+}
+
+func (m *CancelInflightResponse) Reset()                    { *m = CancelInflightResponse{} }
+func (m *CancelInflightResponse) String() string            { return proto.CompactTextString(m) }
+func (*CancelInflightResponse) ProtoMessage()               {}
+func (*CancelInflightResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *CancelInflightResponse) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// Retrying a failed inflight item, or one that is waiting for a retry, right away.
+type RetryInflightRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+}
+
+func (m *RetryInflightRequest) Reset()                    { *m = RetryInflightRequest{} }
+func (m *RetryInflightRequest) String() string            { return proto.CompactTextString(m) }
+func (*RetryInflightRequest) ProtoMessage()               {}
+func (*RetryInflightRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *RetryInflightRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RetryInflightResponse struct {
+	Retried bool `protobuf:"varint,1,opt,name=Retried" json:"Retried,omitempty"`
+}
+
+func (m *RetryInflightResponse) Reset()                    { *m = RetryInflightResponse{} }
+func (m *RetryInflightResponse) String() string            { return proto.CompactTextString(m) }
+func (*RetryInflightResponse) ProtoMessage()               {}
+func (*RetryInflightResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *RetryInflightResponse) GetRetried() bool {
+	if m != nil {
+		return m.Retried
+	}
+	return false
+}
+
+type EntityHistoryRequest struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
+}
+
+func (m *EntityHistoryRequest) Reset()                    { *m = EntityHistoryRequest{} }
+func (m *EntityHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*EntityHistoryRequest) ProtoMessage()               {}
+func (*EntityHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *EntityHistoryRequest) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+type EntityHistoryResponse struct {
+	EntityType UncompiledEntityType `protobuf:"varint,1,opt,name=EntityType,enum=feapi.UncompiledEntityType" json:"EntityType,omitempty"`
+	Threads    []*mimapi.Thread     `protobuf:"bytes,2,rep,name=Threads" json:"Threads,omitempty"`
+	Posts      []*mimapi.Post       `protobuf:"bytes,3,rep,name=Posts" json:"Posts,omitempty"`
+}
+
+func (m *EntityHistoryResponse) Reset()                    { *m = EntityHistoryResponse{} }
+func (m *EntityHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*EntityHistoryResponse) ProtoMessage()               {}
+func (*EntityHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *EntityHistoryResponse) GetEntityType() UncompiledEntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return UncompiledEntityType_UNKNOWN_ENTITY_TYPE
+}
+
+func (m *EntityHistoryResponse) GetThreads() []*mimapi.Thread {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *EntityHistoryResponse) GetPosts() []*mimapi.Post {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
+}
+
+type InboxRequest struct {
+	MarkReadPeer string `protobuf:"bytes,1,opt,name=MarkReadPeer" json:"MarkReadPeer,omitempty"`
+}
+
+func (m *InboxRequest) Reset()                    { *m = InboxRequest{} }
+func (m *InboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*InboxRequest) ProtoMessage()               {}
+func (*InboxRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *InboxRequest) GetMarkReadPeer() string {
+	if m != nil {
+		return m.MarkReadPeer
+	}
+	return ""
+}
+
+type InboxResponse struct {
+	Conversations []*feobjects.CompiledConversationEntity `protobuf:"bytes,1,rep,name=Conversations" json:"Conversations,omitempty"`
+}
+
+func (m *InboxResponse) Reset()                    { *m = InboxResponse{} }
+func (m *InboxResponse) String() string            { return proto.CompactTextString(m) }
+func (*InboxResponse) ProtoMessage()               {}
+func (*InboxResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *InboxResponse) GetConversations() []*feobjects.CompiledConversationEntity {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
 
 type UncompiledEntityByKeyRequest struct {
 	EntityType       UncompiledEntityType `protobuf:"varint,1,opt,name=EntityType,enum=feapi.UncompiledEntityType" json:"EntityType,omitempty"`
@@ -856,7 +1216,7 @@ type UncompiledEntityByKeyRequest struct {
 func (m *UncompiledEntityByKeyRequest) Reset()                    { *m = UncompiledEntityByKeyRequest{} }
 func (m *UncompiledEntityByKeyRequest) String() string            { return proto.CompactTextString(m) }
 func (*UncompiledEntityByKeyRequest) ProtoMessage()               {}
-func (*UncompiledEntityByKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*UncompiledEntityByKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *UncompiledEntityByKeyRequest) GetEntityType() UncompiledEntityType {
 	if m != nil {
@@ -913,7 +1273,7 @@ type UncompiledEntityByKeyResponse struct {
 func (m *UncompiledEntityByKeyResponse) Reset()                    { *m = UncompiledEntityByKeyResponse{} }
 func (m *UncompiledEntityByKeyResponse) String() string            { return proto.CompactTextString(m) }
 func (*UncompiledEntityByKeyResponse) ProtoMessage()               {}
-func (*UncompiledEntityByKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*UncompiledEntityByKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *UncompiledEntityByKeyResponse) GetEntityType() UncompiledEntityType {
 	if m != nil {
@@ -970,7 +1330,7 @@ type InflightsPruneRequest struct {
 func (m *InflightsPruneRequest) Reset()                    { *m = InflightsPruneRequest{} }
 func (m *InflightsPruneRequest) String() string            { return proto.CompactTextString(m) }
 func (*InflightsPruneRequest) ProtoMessage()               {}
-func (*InflightsPruneRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*InflightsPruneRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 type InflightsPruneResponse struct {
 }
@@ -978,7 +1338,7 @@ type InflightsPruneResponse struct {
 func (m *InflightsPruneResponse) Reset()                    { *m = InflightsPruneResponse{} }
 func (m *InflightsPruneResponse) String() string            { return proto.CompactTextString(m) }
 func (*InflightsPruneResponse) ProtoMessage()               {}
-func (*InflightsPruneResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*InflightsPruneResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 type BackendAmbientStatusPayload struct {
 	BackendAmbientStatus *feobjects.BackendAmbientStatus `protobuf:"bytes,1,opt,name=BackendAmbientStatus" json:"BackendAmbientStatus,omitempty"`
@@ -987,7 +1347,7 @@ type BackendAmbientStatusPayload struct {
 func (m *BackendAmbientStatusPayload) Reset()                    { *m = BackendAmbientStatusPayload{} }
 func (m *BackendAmbientStatusPayload) String() string            { return proto.CompactTextString(m) }
 func (*BackendAmbientStatusPayload) ProtoMessage()               {}
-func (*BackendAmbientStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*BackendAmbientStatusPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *BackendAmbientStatusPayload) GetBackendAmbientStatus() *feobjects.BackendAmbientStatus {
 	if m != nil {
@@ -1002,7 +1362,7 @@ type BackendAmbientStatusResponse struct {
 func (m *BackendAmbientStatusResponse) Reset()                    { *m = BackendAmbientStatusResponse{} }
 func (m *BackendAmbientStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*BackendAmbientStatusResponse) ProtoMessage()               {}
-func (*BackendAmbientStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*BackendAmbientStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 //
 // These are useful because they allow the client to ask for ambient status in the case it refreshes. This is useful for the status entity.
@@ -1012,7 +1372,7 @@ type AmbientStatusRequest struct {
 func (m *AmbientStatusRequest) Reset()                    { *m = AmbientStatusRequest{} }
 func (m *AmbientStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*AmbientStatusRequest) ProtoMessage()               {}
-func (*AmbientStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*AmbientStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 type AmbientStatusResponse struct {
 }
@@ -1020,7 +1380,7 @@ type AmbientStatusResponse struct {
 func (m *AmbientStatusResponse) Reset()                    { *m = AmbientStatusResponse{} }
 func (m *AmbientStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*AmbientStatusResponse) ProtoMessage()               {}
-func (*AmbientStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*AmbientStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 type HomeViewRequest struct {
 }
@@ -1028,7 +1388,7 @@ type HomeViewRequest struct {
 func (m *HomeViewRequest) Reset()                    { *m = HomeViewRequest{} }
 func (m *HomeViewRequest) String() string            { return proto.CompactTextString(m) }
 func (*HomeViewRequest) ProtoMessage()               {}
-func (*HomeViewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*HomeViewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 type HomeViewResponse struct {
 }
@@ -1036,15 +1396,23 @@ type HomeViewResponse struct {
 func (m *HomeViewResponse) Reset()                    { *m = HomeViewResponse{} }
 func (m *HomeViewResponse) String() string            { return proto.CompactTextString(m) }
 func (*HomeViewResponse) ProtoMessage()               {}
-func (*HomeViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*HomeViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 type PopularViewRequest struct {
+	Sort string `protobuf:"bytes,1,opt,name=Sort" json:"Sort,omitempty"`
 }
 
 func (m *PopularViewRequest) Reset()                    { *m = PopularViewRequest{} }
 func (m *PopularViewRequest) String() string            { return proto.CompactTextString(m) }
 func (*PopularViewRequest) ProtoMessage()               {}
-func (*PopularViewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*PopularViewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *PopularViewRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
 
 type PopularViewResponse struct {
 }
@@ -1052,7 +1420,7 @@ type PopularViewResponse struct {
 func (m *PopularViewResponse) Reset()                    { *m = PopularViewResponse{} }
 func (m *PopularViewResponse) String() string            { return proto.CompactTextString(m) }
 func (*PopularViewResponse) ProtoMessage()               {}
-func (*PopularViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*PopularViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 type NewViewRequest struct {
 }
@@ -1060,7 +1428,7 @@ type NewViewRequest struct {
 func (m *NewViewRequest) Reset()                    { *m = NewViewRequest{} }
 func (m *NewViewRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewViewRequest) ProtoMessage()               {}
-func (*NewViewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*NewViewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 type NewViewResponse struct {
 }
@@ -1068,7 +1436,7 @@ type NewViewResponse struct {
 func (m *NewViewResponse) Reset()                    { *m = NewViewResponse{} }
 func (m *NewViewResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewViewResponse) ProtoMessage()               {}
-func (*NewViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*NewViewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 type NotificationsRequest struct {
 }
@@ -1076,7 +1444,7 @@ type NotificationsRequest struct {
 func (m *NotificationsRequest) Reset()                    { *m = NotificationsRequest{} }
 func (m *NotificationsRequest) String() string            { return proto.CompactTextString(m) }
 func (*NotificationsRequest) ProtoMessage()               {}
-func (*NotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*NotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 type NotificationsResponse struct {
 }
@@ -1084,7 +1452,7 @@ type NotificationsResponse struct {
 func (m *NotificationsResponse) Reset()                    { *m = NotificationsResponse{} }
 func (m *NotificationsResponse) String() string            { return proto.CompactTextString(m) }
 func (*NotificationsResponse) ProtoMessage()               {}
-func (*NotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*NotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 type NotificationsSignalPayload struct {
 	Seen                bool   `protobuf:"varint,1,opt,name=Seen" json:"Seen,omitempty"`
@@ -1095,7 +1463,7 @@ type NotificationsSignalPayload struct {
 func (m *NotificationsSignalPayload) Reset()                    { *m = NotificationsSignalPayload{} }
 func (m *NotificationsSignalPayload) String() string            { return proto.CompactTextString(m) }
 func (*NotificationsSignalPayload) ProtoMessage()               {}
-func (*NotificationsSignalPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*NotificationsSignalPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *NotificationsSignalPayload) GetSeen() bool {
 	if m != nil {
@@ -1124,7 +1492,7 @@ type NotificationsSignalResponse struct {
 func (m *NotificationsSignalResponse) Reset()                    { *m = NotificationsSignalResponse{} }
 func (m *NotificationsSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*NotificationsSignalResponse) ProtoMessage()               {}
-func (*NotificationsSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*NotificationsSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 type OnboardCompleteRequest struct {
 	OnboardComplete bool `protobuf:"varint,1,opt,name=OnboardComplete" json:"OnboardComplete,omitempty"`
@@ -1133,7 +1501,7 @@ type OnboardCompleteRequest struct {
 func (m *OnboardCompleteRequest) Reset()                    { *m = OnboardCompleteRequest{} }
 func (m *OnboardCompleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*OnboardCompleteRequest) ProtoMessage()               {}
-func (*OnboardCompleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*OnboardCompleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *OnboardCompleteRequest) GetOnboardComplete() bool {
 	if m != nil {
@@ -1148,7 +1516,7 @@ type OnboardCompleteResponse struct {
 func (m *OnboardCompleteResponse) Reset()                    { *m = OnboardCompleteResponse{} }
 func (m *OnboardCompleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*OnboardCompleteResponse) ProtoMessage()               {}
-func (*OnboardCompleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*OnboardCompleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 type SendAddressPayload struct {
 	Address *mimapi.Address `protobuf:"bytes,1,opt,name=Address" json:"Address,omitempty"`
@@ -1157,7 +1525,7 @@ type SendAddressPayload struct {
 func (m *SendAddressPayload) Reset()                    { *m = SendAddressPayload{} }
 func (m *SendAddressPayload) String() string            { return proto.CompactTextString(m) }
 func (*SendAddressPayload) ProtoMessage()               {}
-func (*SendAddressPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*SendAddressPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *SendAddressPayload) GetAddress() *mimapi.Address {
 	if m != nil {
@@ -1174,7 +1542,7 @@ type SendAddressResponse struct {
 func (m *SendAddressResponse) Reset()                    { *m = SendAddressResponse{} }
 func (m *SendAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendAddressResponse) ProtoMessage()               {}
-func (*SendAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*SendAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *SendAddressResponse) GetStatusCode() int32 {
 	if m != nil {
@@ -1200,7 +1568,7 @@ type FEConfigChangesPayload struct {
 func (m *FEConfigChangesPayload) Reset()                    { *m = FEConfigChangesPayload{} }
 func (m *FEConfigChangesPayload) String() string            { return proto.CompactTextString(m) }
 func (*FEConfigChangesPayload) ProtoMessage()               {}
-func (*FEConfigChangesPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*FEConfigChangesPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *FEConfigChangesPayload) GetModModeEnabledIsSet() bool {
 	if m != nil {
@@ -1236,7 +1604,7 @@ type FEConfigChangesResponse struct {
 func (m *FEConfigChangesResponse) Reset()                    { *m = FEConfigChangesResponse{} }
 func (m *FEConfigChangesResponse) String() string            { return proto.CompactTextString(m) }
 func (*FEConfigChangesResponse) ProtoMessage()               {}
-func (*FEConfigChangesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*FEConfigChangesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 type BoardReportsRequest struct {
 	BoardFingerprint string `protobuf:"bytes,1,opt,name=BoardFingerprint" json:"BoardFingerprint,omitempty"`
@@ -1245,7 +1613,7 @@ type BoardReportsRequest struct {
 func (m *BoardReportsRequest) Reset()                    { *m = BoardReportsRequest{} }
 func (m *BoardReportsRequest) String() string            { return proto.CompactTextString(m) }
 func (*BoardReportsRequest) ProtoMessage()               {}
-func (*BoardReportsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*BoardReportsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *BoardReportsRequest) GetBoardFingerprint() string {
 	if m != nil {
@@ -1261,7 +1629,7 @@ type BoardReportsResponse struct {
 func (m *BoardReportsResponse) Reset()                    { *m = BoardReportsResponse{} }
 func (m *BoardReportsResponse) String() string            { return proto.CompactTextString(m) }
 func (*BoardReportsResponse) ProtoMessage()               {}
-func (*BoardReportsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*BoardReportsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *BoardReportsResponse) GetReportsTabEntries() []*feobjects.ReportsTabEntry {
 	if m != nil {
@@ -1277,7 +1645,7 @@ type BoardModActionsRequest struct {
 func (m *BoardModActionsRequest) Reset()                    { *m = BoardModActionsRequest{} }
 func (m *BoardModActionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*BoardModActionsRequest) ProtoMessage()               {}
-func (*BoardModActionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*BoardModActionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *BoardModActionsRequest) GetBoardFingerprint() string {
 	if m != nil {
@@ -1293,7 +1661,7 @@ type BoardModActionsResponse struct {
 func (m *BoardModActionsResponse) Reset()                    { *m = BoardModActionsResponse{} }
 func (m *BoardModActionsResponse) String() string            { return proto.CompactTextString(m) }
 func (*BoardModActionsResponse) ProtoMessage()               {}
-func (*BoardModActionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*BoardModActionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *BoardModActionsResponse) GetModActionsTabEntries() []*feobjects.ModActionsTabEntry {
 	if m != nil {
@@ -1309,7 +1677,7 @@ type SendMintedUsernamesPayload struct {
 func (m *SendMintedUsernamesPayload) Reset()                    { *m = SendMintedUsernamesPayload{} }
 func (m *SendMintedUsernamesPayload) String() string            { return proto.CompactTextString(m) }
 func (*SendMintedUsernamesPayload) ProtoMessage()               {}
-func (*SendMintedUsernamesPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*SendMintedUsernamesPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *SendMintedUsernamesPayload) GetMintedUsernamesRawJSON() string {
 	if m != nil {
@@ -1324,7 +1692,7 @@ type SendMintedUsernamesResponse struct {
 func (m *SendMintedUsernamesResponse) Reset()                    { *m = SendMintedUsernamesResponse{} }
 func (m *SendMintedUsernamesResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendMintedUsernamesResponse) ProtoMessage()               {}
-func (*SendMintedUsernamesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*SendMintedUsernamesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 type ClientVersionPayload struct {
 	CurrentClientVersion string `protobuf:"bytes,1,opt,name=CurrentClientVersion" json:"CurrentClientVersion,omitempty"`
@@ -1333,7 +1701,7 @@ type ClientVersionPayload struct {
 func (m *ClientVersionPayload) Reset()                    { *m = ClientVersionPayload{} }
 func (m *ClientVersionPayload) String() string            { return proto.CompactTextString(m) }
 func (*ClientVersionPayload) ProtoMessage()               {}
-func (*ClientVersionPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*ClientVersionPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *ClientVersionPayload) GetCurrentClientVersion() string {
 	if m != nil {
@@ -1349,7 +1717,7 @@ type ClientVersionResponse struct {
 func (m *ClientVersionResponse) Reset()                    { *m = ClientVersionResponse{} }
 func (m *ClientVersionResponse) String() string            { return proto.CompactTextString(m) }
 func (*ClientVersionResponse) ProtoMessage()               {}
-func (*ClientVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*ClientVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 func (m *ClientVersionResponse) GetLastKnownClientVersion() string {
 	if m != nil {
@@ -1361,12 +1729,14 @@ func (m *ClientVersionResponse) GetLastKnownClientVersion() string {
 type SearchRequestPayload struct {
 	SearchType  string `protobuf:"bytes,1,opt,name=SearchType" json:"SearchType,omitempty"`
 	SearchQuery string `protobuf:"bytes,2,opt,name=SearchQuery" json:"SearchQuery,omitempty"`
+	Offset      int32  `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
+	Limit       int32  `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
 }
 
 func (m *SearchRequestPayload) Reset()                    { *m = SearchRequestPayload{} }
 func (m *SearchRequestPayload) String() string            { return proto.CompactTextString(m) }
 func (*SearchRequestPayload) ProtoMessage()               {}
-func (*SearchRequestPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*SearchRequestPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 func (m *SearchRequestPayload) GetSearchType() string {
 	if m != nil {
@@ -1382,6 +1752,20 @@ func (m *SearchRequestPayload) GetSearchQuery() string {
 	return ""
 }
 
+func (m *SearchRequestPayload) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SearchRequestPayload) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // Heads up, this will always be empty. The actual result is going to come via the feapi, as a gRPC call initiated by the FE.
 type SearchRequestResponse struct {
 }
@@ -1389,7 +1773,7 @@ type SearchRequestResponse struct {
 func (m *SearchRequestResponse) Reset()                    { *m = SearchRequestResponse{} }
 func (m *SearchRequestResponse) String() string            { return proto.CompactTextString(m) }
 func (*SearchRequestResponse) ProtoMessage()               {}
-func (*SearchRequestResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*SearchRequestResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func init() {
 	proto.RegisterType((*BEReadyRequest)(nil), "feapi.BEReadyRequest")
@@ -1404,13 +1788,25 @@ func init() {
 	proto.RegisterType((*BoardAndThreadsResponse)(nil), "feapi.BoardAndThreadsResponse")
 	proto.RegisterType((*BoardSignalRequest)(nil), "feapi.BoardSignalRequest")
 	proto.RegisterType((*BoardSignalResponse)(nil), "feapi.BoardSignalResponse")
+	proto.RegisterType((*ThreadSignalRequest)(nil), "feapi.ThreadSignalRequest")
+	proto.RegisterType((*ThreadSignalResponse)(nil), "feapi.ThreadSignalResponse")
 	proto.RegisterType((*UserAndGraphRequest)(nil), "feapi.UserAndGraphRequest")
 	proto.RegisterType((*UserAndGraphResponse)(nil), "feapi.UserAndGraphResponse")
 	proto.RegisterType((*Event)(nil), "feapi.Event")
 	proto.RegisterType((*ContentEventPayload)(nil), "feapi.ContentEventPayload")
+	proto.RegisterType((*BoardKeyRequest)(nil), "feapi.BoardKeyRequest")
+	proto.RegisterType((*BoardPoWRequest)(nil), "feapi.BoardPoWRequest")
 	proto.RegisterType((*ContentEventResponse)(nil), "feapi.ContentEventResponse")
 	proto.RegisterType((*SignalEventPayload)(nil), "feapi.SignalEventPayload")
 	proto.RegisterType((*SignalEventResponse)(nil), "feapi.SignalEventResponse")
+	proto.RegisterType((*CancelInflightRequest)(nil), "feapi.CancelInflightRequest")
+	proto.RegisterType((*CancelInflightResponse)(nil), "feapi.CancelInflightResponse")
+	proto.RegisterType((*RetryInflightRequest)(nil), "feapi.RetryInflightRequest")
+	proto.RegisterType((*RetryInflightResponse)(nil), "feapi.RetryInflightResponse")
+	proto.RegisterType((*EntityHistoryRequest)(nil), "feapi.EntityHistoryRequest")
+	proto.RegisterType((*EntityHistoryResponse)(nil), "feapi.EntityHistoryResponse")
+	proto.RegisterType((*InboxRequest)(nil), "feapi.InboxRequest")
+	proto.RegisterType((*InboxResponse)(nil), "feapi.InboxResponse")
 	proto.RegisterType((*UncompiledEntityByKeyRequest)(nil), "feapi.UncompiledEntityByKeyRequest")
 	proto.RegisterType((*UncompiledEntityByKeyResponse)(nil), "feapi.UncompiledEntityByKeyResponse")
 	proto.RegisterType((*InflightsPruneRequest)(nil), "feapi.InflightsPruneRequest")
@@ -1446,6 +1842,7 @@ func init() {
 	proto.RegisterType((*SearchRequestPayload)(nil), "feapi.SearchRequestPayload")
 	proto.RegisterType((*SearchRequestResponse)(nil), "feapi.SearchRequestResponse")
 	proto.RegisterEnum("feapi.EventType", EventType_name, EventType_value)
+	proto.RegisterEnum("feapi.BoardKeyAction", BoardKeyAction_name, BoardKeyAction_value)
 	proto.RegisterEnum("feapi.SignalTargetType", SignalTargetType_name, SignalTargetType_value)
 	proto.RegisterEnum("feapi.SignalTypeClass", SignalTypeClass_name, SignalTypeClass_value)
 	proto.RegisterEnum("feapi.SignalType", SignalType_name, SignalType_value)
@@ -1469,10 +1866,14 @@ type FrontendAPIClient interface {
 	GetBoardAndThreads(ctx context.Context, in *BoardAndThreadsRequest, opts ...grpc.CallOption) (*BoardAndThreadsResponse, error)
 	GetAllBoards(ctx context.Context, in *AllBoardsRequest, opts ...grpc.CallOption) (*AllBoardsResponse, error)
 	SetBoardSignal(ctx context.Context, in *BoardSignalRequest, opts ...grpc.CallOption) (*BoardSignalResponse, error)
+	SetThreadSignal(ctx context.Context, in *ThreadSignalRequest, opts ...grpc.CallOption) (*ThreadSignalResponse, error)
 	GetUserAndGraph(ctx context.Context, in *UserAndGraphRequest, opts ...grpc.CallOption) (*UserAndGraphResponse, error)
 	SendContentEvent(ctx context.Context, in *ContentEventPayload, opts ...grpc.CallOption) (*ContentEventResponse, error)
 	SendSignalEvent(ctx context.Context, in *SignalEventPayload, opts ...grpc.CallOption) (*SignalEventResponse, error)
+	CancelInflight(ctx context.Context, in *CancelInflightRequest, opts ...grpc.CallOption) (*CancelInflightResponse, error)
+	RetryInflight(ctx context.Context, in *RetryInflightRequest, opts ...grpc.CallOption) (*RetryInflightResponse, error)
 	GetUncompiledEntityByKey(ctx context.Context, in *UncompiledEntityByKeyRequest, opts ...grpc.CallOption) (*UncompiledEntityByKeyResponse, error)
+	GetEntityHistory(ctx context.Context, in *EntityHistoryRequest, opts ...grpc.CallOption) (*EntityHistoryResponse, error)
 	SendInflightsPruneRequest(ctx context.Context, in *InflightsPruneRequest, opts ...grpc.CallOption) (*InflightsPruneResponse, error)
 	RequestAmbientStatus(ctx context.Context, in *AmbientStatusRequest, opts ...grpc.CallOption) (*AmbientStatusResponse, error)
 	// ^ Client requests ambient status to be sent in.
@@ -1481,6 +1882,7 @@ type FrontendAPIClient interface {
 	RequestNewView(ctx context.Context, in *NewViewRequest, opts ...grpc.CallOption) (*NewViewResponse, error)
 	RequestNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationsResponse, error)
 	SetNotificationsSignal(ctx context.Context, in *NotificationsSignalPayload, opts ...grpc.CallOption) (*NotificationsSignalResponse, error)
+	RequestInbox(ctx context.Context, in *InboxRequest, opts ...grpc.CallOption) (*InboxResponse, error)
 	// rpc RequestOnboardCompleteStatus(OnboardCompleteStatusRequest) returns (OnboardCompleteStatusResponse) {}
 	SetOnboardComplete(ctx context.Context, in *OnboardCompleteRequest, opts ...grpc.CallOption) (*OnboardCompleteResponse, error)
 	SendAddress(ctx context.Context, in *SendAddressPayload, opts ...grpc.CallOption) (*SendAddressResponse, error)
@@ -1548,6 +1950,15 @@ func (c *frontendAPIClient) SetBoardSignal(ctx context.Context, in *BoardSignalR
 	return out, nil
 }
 
+func (c *frontendAPIClient) SetThreadSignal(ctx context.Context, in *ThreadSignalRequest, opts ...grpc.CallOption) (*ThreadSignalResponse, error) {
+	out := new(ThreadSignalResponse)
+	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/SetThreadSignal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontendAPIClient) GetUserAndGraph(ctx context.Context, in *UserAndGraphRequest, opts ...grpc.CallOption) (*UserAndGraphResponse, error) {
 	out := new(UserAndGraphResponse)
 	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/GetUserAndGraph", in, out, c.cc, opts...)
@@ -1575,6 +1986,24 @@ func (c *frontendAPIClient) SendSignalEvent(ctx context.Context, in *SignalEvent
 	return out, nil
 }
 
+func (c *frontendAPIClient) CancelInflight(ctx context.Context, in *CancelInflightRequest, opts ...grpc.CallOption) (*CancelInflightResponse, error) {
+	out := new(CancelInflightResponse)
+	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/CancelInflight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontendAPIClient) RetryInflight(ctx context.Context, in *RetryInflightRequest, opts ...grpc.CallOption) (*RetryInflightResponse, error) {
+	out := new(RetryInflightResponse)
+	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/RetryInflight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontendAPIClient) GetUncompiledEntityByKey(ctx context.Context, in *UncompiledEntityByKeyRequest, opts ...grpc.CallOption) (*UncompiledEntityByKeyResponse, error) {
 	out := new(UncompiledEntityByKeyResponse)
 	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/GetUncompiledEntityByKey", in, out, c.cc, opts...)
@@ -1584,6 +2013,15 @@ func (c *frontendAPIClient) GetUncompiledEntityByKey(ctx context.Context, in *Un
 	return out, nil
 }
 
+func (c *frontendAPIClient) GetEntityHistory(ctx context.Context, in *EntityHistoryRequest, opts ...grpc.CallOption) (*EntityHistoryResponse, error) {
+	out := new(EntityHistoryResponse)
+	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/GetEntityHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontendAPIClient) SendInflightsPruneRequest(ctx context.Context, in *InflightsPruneRequest, opts ...grpc.CallOption) (*InflightsPruneResponse, error) {
 	out := new(InflightsPruneResponse)
 	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/SendInflightsPruneRequest", in, out, c.cc, opts...)
@@ -1647,6 +2085,15 @@ func (c *frontendAPIClient) SetNotificationsSignal(ctx context.Context, in *Noti
 	return out, nil
 }
 
+func (c *frontendAPIClient) RequestInbox(ctx context.Context, in *InboxRequest, opts ...grpc.CallOption) (*InboxResponse, error) {
+	out := new(InboxResponse)
+	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/RequestInbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontendAPIClient) SetOnboardComplete(ctx context.Context, in *OnboardCompleteRequest, opts ...grpc.CallOption) (*OnboardCompleteResponse, error) {
 	out := new(OnboardCompleteResponse)
 	err := grpc.Invoke(ctx, "/feapi.FrontendAPI/SetOnboardComplete", in, out, c.cc, opts...)
@@ -1746,10 +2193,14 @@ type FrontendAPIServer interface {
 	GetBoardAndThreads(context.Context, *BoardAndThreadsRequest) (*BoardAndThreadsResponse, error)
 	GetAllBoards(context.Context, *AllBoardsRequest) (*AllBoardsResponse, error)
 	SetBoardSignal(context.Context, *BoardSignalRequest) (*BoardSignalResponse, error)
+	SetThreadSignal(context.Context, *ThreadSignalRequest) (*ThreadSignalResponse, error)
 	GetUserAndGraph(context.Context, *UserAndGraphRequest) (*UserAndGraphResponse, error)
 	SendContentEvent(context.Context, *ContentEventPayload) (*ContentEventResponse, error)
 	SendSignalEvent(context.Context, *SignalEventPayload) (*SignalEventResponse, error)
+	CancelInflight(context.Context, *CancelInflightRequest) (*CancelInflightResponse, error)
+	RetryInflight(context.Context, *RetryInflightRequest) (*RetryInflightResponse, error)
 	GetUncompiledEntityByKey(context.Context, *UncompiledEntityByKeyRequest) (*UncompiledEntityByKeyResponse, error)
+	GetEntityHistory(context.Context, *EntityHistoryRequest) (*EntityHistoryResponse, error)
 	SendInflightsPruneRequest(context.Context, *InflightsPruneRequest) (*InflightsPruneResponse, error)
 	RequestAmbientStatus(context.Context, *AmbientStatusRequest) (*AmbientStatusResponse, error)
 	// ^ Client requests ambient status to be sent in.
@@ -1758,6 +2209,7 @@ type FrontendAPIServer interface {
 	RequestNewView(context.Context, *NewViewRequest) (*NewViewResponse, error)
 	RequestNotifications(context.Context, *NotificationsRequest) (*NotificationsResponse, error)
 	SetNotificationsSignal(context.Context, *NotificationsSignalPayload) (*NotificationsSignalResponse, error)
+	RequestInbox(context.Context, *InboxRequest) (*InboxResponse, error)
 	// rpc RequestOnboardCompleteStatus(OnboardCompleteStatusRequest) returns (OnboardCompleteStatusResponse) {}
 	SetOnboardComplete(context.Context, *OnboardCompleteRequest) (*OnboardCompleteResponse, error)
 	SendAddress(context.Context, *SendAddressPayload) (*SendAddressResponse, error)
@@ -1866,6 +2318,24 @@ func _FrontendAPI_SetBoardSignal_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontendAPI_SetThreadSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThreadSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontendAPIServer).SetThreadSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feapi.FrontendAPI/SetThreadSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontendAPIServer).SetThreadSignal(ctx, req.(*ThreadSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontendAPI_GetUserAndGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserAndGraphRequest)
 	if err := dec(in); err != nil {
@@ -1920,6 +2390,42 @@ func _FrontendAPI_SendSignalEvent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontendAPI_CancelInflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontendAPIServer).CancelInflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feapi.FrontendAPI/CancelInflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontendAPIServer).CancelInflight(ctx, req.(*CancelInflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontendAPI_RetryInflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryInflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontendAPIServer).RetryInflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feapi.FrontendAPI/RetryInflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontendAPIServer).RetryInflight(ctx, req.(*RetryInflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontendAPI_GetUncompiledEntityByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UncompiledEntityByKeyRequest)
 	if err := dec(in); err != nil {
@@ -1938,6 +2444,24 @@ func _FrontendAPI_GetUncompiledEntityByKey_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontendAPI_GetEntityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontendAPIServer).GetEntityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feapi.FrontendAPI/GetEntityHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontendAPIServer).GetEntityHistory(ctx, req.(*EntityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontendAPI_SendInflightsPruneRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InflightsPruneRequest)
 	if err := dec(in); err != nil {
@@ -2064,6 +2588,24 @@ func _FrontendAPI_SetNotificationsSignal_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontendAPI_RequestInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontendAPIServer).RequestInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feapi.FrontendAPI/RequestInbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontendAPIServer).RequestInbox(ctx, req.(*InboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontendAPI_SetOnboardComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OnboardCompleteRequest)
 	if err := dec(in); err != nil {
@@ -2268,6 +2810,10 @@ var _FrontendAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBoardSignal",
 			Handler:    _FrontendAPI_SetBoardSignal_Handler,
 		},
+		{
+			MethodName: "SetThreadSignal",
+			Handler:    _FrontendAPI_SetThreadSignal_Handler,
+		},
 		{
 			MethodName: "GetUserAndGraph",
 			Handler:    _FrontendAPI_GetUserAndGraph_Handler,
@@ -2280,10 +2826,22 @@ var _FrontendAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendSignalEvent",
 			Handler:    _FrontendAPI_SendSignalEvent_Handler,
 		},
+		{
+			MethodName: "CancelInflight",
+			Handler:    _FrontendAPI_CancelInflight_Handler,
+		},
+		{
+			MethodName: "RetryInflight",
+			Handler:    _FrontendAPI_RetryInflight_Handler,
+		},
 		{
 			MethodName: "GetUncompiledEntityByKey",
 			Handler:    _FrontendAPI_GetUncompiledEntityByKey_Handler,
 		},
+		{
+			MethodName: "GetEntityHistory",
+			Handler:    _FrontendAPI_GetEntityHistory_Handler,
+		},
 		{
 			MethodName: "SendInflightsPruneRequest",
 			Handler:    _FrontendAPI_SendInflightsPruneRequest_Handler,
@@ -2312,6 +2870,10 @@ var _FrontendAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetNotificationsSignal",
 			Handler:    _FrontendAPI_SetNotificationsSignal_Handler,
 		},
+		{
+			MethodName: "RequestInbox",
+			Handler:    _FrontendAPI_RequestInbox_Handler,
+		},
 		{
 			MethodName: "SetOnboardComplete",
 			Handler:    _FrontendAPI_SetOnboardComplete_Handler,
@@ -2360,169 +2922,200 @@ var _FrontendAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("feapi/feapi.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x72, 0xe3, 0xc6,
-	0x11, 0x26, 0x45, 0x52, 0x3f, 0x2d, 0xad, 0x04, 0x8d, 0x24, 0x8a, 0xcb, 0xd5, 0xfe, 0x18, 0x5e,
-	0xa7, 0x14, 0x25, 0xd1, 0xda, 0x5a, 0xc7, 0xa9, 0x94, 0x53, 0x71, 0x20, 0x12, 0xd2, 0xd2, 0x12,
-	0x09, 0x2e, 0x00, 0xca, 0x91, 0x0f, 0x51, 0x20, 0x71, 0xa4, 0x45, 0x4c, 0x02, 0x34, 0x00, 0x59,
-	0xe6, 0x1b, 0xa4, 0x72, 0xca, 0x21, 0x4f, 0x91, 0xaa, 0xe4, 0x9e, 0xaa, 0x3c, 0x42, 0x9e, 0xc2,
-	0x97, 0x9c, 0xf2, 0x0c, 0x49, 0xcd, 0x0f, 0x06, 0x7f, 0xc3, 0xfd, 0xb1, 0xab, 0x72, 0x91, 0x30,
-	0xdd, 0x5f, 0xf7, 0x74, 0xf7, 0xcc, 0xf4, 0x74, 0x0f, 0x61, 0xfd, 0x1a, 0x3b, 0x13, 0xf7, 0x19,
-	0xfd, 0xbb, 0x3f, 0x09, 0xfc, 0xc8, 0x47, 0x35, 0x3a, 0x68, 0xde, 0xbf, 0xc6, 0xfe, 0xe5, 0x1f,
-	0xf0, 0x55, 0x14, 0x3e, 0x13, 0x5f, 0x0c, 0xd1, 0xdc, 0x18, 0xbb, 0x63, 0x22, 0xc5, 0xfe, 0x31,
-	0xa2, 0xfa, 0x6b, 0x58, 0x3d, 0xd4, 0x4d, 0xec, 0x0c, 0xa7, 0x26, 0xfe, 0xfa, 0x16, 0x87, 0x11,
-	0x6a, 0xc0, 0x82, 0x33, 0x1c, 0x06, 0x38, 0x0c, 0x1b, 0xe5, 0x27, 0xe5, 0xdd, 0x25, 0x33, 0x1e,
-	0x22, 0x04, 0xd5, 0x89, 0x1f, 0x44, 0x8d, 0xb9, 0x27, 0xe5, 0xdd, 0x9a, 0x49, 0xbf, 0xd5, 0x75,
-	0x58, 0x13, 0xf2, 0xe1, 0xc4, 0xf7, 0x42, 0xac, 0x3e, 0x87, 0x87, 0x16, 0x8e, 0x5a, 0x23, 0x17,
-	0x7b, 0x91, 0xd6, 0xef, 0x58, 0x38, 0xf8, 0x06, 0x07, 0x7d, 0x3f, 0x88, 0xe2, 0x19, 0x10, 0x54,
-	0xc9, 0x90, 0xaa, 0xaf, 0x99, 0xf4, 0x5b, 0x7d, 0x02, 0x8f, 0x66, 0x09, 0x71, 0xb5, 0x08, 0x14,
-	0x6d, 0x34, 0x3a, 0xf4, 0x9d, 0x60, 0x18, 0x72, 0x4d, 0xea, 0x4b, 0x58, 0x4f, 0xd1, 0x18, 0x10,
-	0xfd, 0x0a, 0x96, 0x04, 0xb1, 0x51, 0x7e, 0x52, 0xd9, 0x5d, 0x3e, 0x78, 0xb4, 0x9f, 0x04, 0xa3,
-	0xe5, 0x8f, 0x27, 0xee, 0x08, 0x0f, 0x29, 0x40, 0xf7, 0x22, 0x37, 0x9a, 0x9a, 0x89, 0x80, 0xfa,
-	0x35, 0x6c, 0xd9, 0xaf, 0x02, 0xec, 0x0c, 0x35, 0x6f, 0xd8, 0xf7, 0xc3, 0x28, 0x9e, 0x0b, 0xed,
-	0x81, 0x42, 0x21, 0x47, 0xae, 0x77, 0x83, 0x83, 0x49, 0xe0, 0x7a, 0x11, 0x0f, 0x50, 0x81, 0x8e,
-	0x7e, 0x0a, 0xeb, 0x4c, 0x49, 0x1a, 0x3c, 0x47, 0xc1, 0x45, 0x86, 0xfa, 0xcf, 0x32, 0xd4, 0xf3,
-	0x73, 0x72, 0x5f, 0x3e, 0x86, 0x1a, 0x55, 0x4e, 0x67, 0x7a, 0xb3, 0x1f, 0x0c, 0x8c, 0x7e, 0x01,
-	0xf3, 0x4c, 0x1f, 0x9d, 0x73, 0xf9, 0xe0, 0xb1, 0x44, 0x8c, 0x01, 0xb8, 0x1c, 0x87, 0xa3, 0xe7,
-	0x50, 0xa3, 0xf3, 0x37, 0x2a, 0x34, 0x6c, 0x0f, 0x25, 0x72, 0x84, 0x1f, 0xcf, 0x46, 0xb1, 0xea,
-	0x04, 0xea, 0x74, 0x5a, 0xcd, 0xe3, 0x4a, 0xbf, 0x57, 0xc8, 0xf6, 0x40, 0xb1, 0xfc, 0x20, 0xe2,
-	0x1a, 0x0e, 0xa7, 0x3d, 0x7c, 0x47, 0xad, 0x5f, 0x34, 0x0b, 0x74, 0xf5, 0x4f, 0x65, 0xd8, 0x2e,
-	0x4c, 0xf9, 0x83, 0x22, 0xf6, 0x4b, 0x58, 0xe0, 0x8a, 0x1a, 0x73, 0xd4, 0xf5, 0x37, 0x86, 0x2c,
-	0xc6, 0xab, 0x7f, 0x2f, 0x03, 0xa2, 0x4a, 0x2c, 0xf7, 0xc6, 0x73, 0x46, 0xb1, 0xef, 0x4f, 0x60,
-	0xb9, 0xe8, 0x76, 0x9a, 0x84, 0x1e, 0x01, 0x58, 0xb7, 0x97, 0xe1, 0x55, 0xe0, 0x5e, 0xe2, 0x21,
-	0xf7, 0x35, 0x45, 0x41, 0x75, 0x98, 0xef, 0xf9, 0x91, 0x7b, 0x3d, 0x6d, 0x54, 0x28, 0x8f, 0x8f,
-	0x50, 0x13, 0x16, 0x4f, 0x9d, 0x30, 0xb2, 0x30, 0xf6, 0x1a, 0xd5, 0x27, 0xe5, 0xdd, 0x8a, 0x29,
-	0xc6, 0x48, 0x85, 0x95, 0xf8, 0xdb, 0xf0, 0x46, 0xd3, 0x46, 0x8d, 0x4a, 0x66, 0x68, 0xea, 0x73,
-	0xd8, 0xc8, 0xd8, 0xcb, 0x03, 0xb7, 0x03, 0x4b, 0x2d, 0x7f, 0x3c, 0x76, 0xa3, 0x08, 0xb3, 0xe0,
-	0x2d, 0x9a, 0x09, 0x41, 0xfd, 0x6f, 0x19, 0x36, 0x06, 0x21, 0x0e, 0x34, 0x6f, 0x78, 0x1c, 0x38,
-	0x93, 0x57, 0x6f, 0xef, 0xe6, 0x87, 0x4c, 0x90, 0x87, 0x8d, 0x89, 0x09, 0x7f, 0x65, 0xac, 0x58,
-	0x22, 0x73, 0xd4, 0xf1, 0xb0, 0x31, 0x9f, 0x48, 0xe4, 0x58, 0xe8, 0x00, 0x36, 0x09, 0x39, 0xbb,
-	0xfd, 0xf0, 0x90, 0x86, 0x67, 0xd1, 0x94, 0xf2, 0xd0, 0x3e, 0x20, 0x42, 0x4f, 0x9f, 0x71, 0x3c,
-	0xe4, 0x01, 0x93, 0x70, 0xd4, 0x7f, 0x54, 0xd8, 0x24, 0x49, 0x04, 0x78, 0xe0, 0x3e, 0x82, 0x2a,
-	0xa1, 0xf3, 0x0d, 0x27, 0x3b, 0x33, 0x29, 0x27, 0x29, 0x14, 0x7d, 0x02, 0xf3, 0x3c, 0x3f, 0xcd,
-	0xbd, 0x55, 0x7e, 0xe2, 0xe8, 0xf4, 0x36, 0xad, 0xbc, 0xdb, 0x36, 0x4d, 0x8e, 0x76, 0xf5, 0xed,
-	0x8f, 0xf6, 0xac, 0xb5, 0xab, 0xfd, 0x3f, 0xd6, 0x6e, 0xe1, 0x9d, 0xd7, 0x6e, 0x71, 0xe6, 0xda,
-	0xfd, 0xad, 0x0c, 0x35, 0xfd, 0x1b, 0xcc, 0xd2, 0x8c, 0x71, 0xe7, 0xe1, 0x40, 0x92, 0x92, 0xf2,
-	0x74, 0x82, 0xed, 0x07, 0xae, 0x1f, 0x14, 0x93, 0x78, 0x81, 0x8e, 0xf6, 0x61, 0x89, 0x4e, 0x60,
-	0x4f, 0x27, 0x98, 0x9e, 0xd7, 0xd5, 0x03, 0x65, 0x9f, 0xdd, 0xcf, 0x82, 0x6e, 0x26, 0x10, 0x72,
-	0xda, 0x6c, 0x77, 0x8c, 0xc3, 0xc8, 0x19, 0x4f, 0xf8, 0x29, 0x4e, 0x08, 0xea, 0xbf, 0xcb, 0xb0,
-	0xd1, 0xf2, 0xbd, 0x08, 0x7b, 0x11, 0x15, 0xe9, 0x3b, 0xd3, 0x91, 0xef, 0x0c, 0x91, 0xca, 0xdd,
-	0xe0, 0x7b, 0x6d, 0x25, 0x3d, 0x83, 0xc9, 0x3d, 0xfc, 0x09, 0x2c, 0xd1, 0x10, 0xb7, 0x9d, 0xc8,
-	0xe1, 0xf9, 0xff, 0xde, 0x3e, 0xbf, 0xf3, 0x29, 0xc3, 0x4c, 0xf8, 0x68, 0x1f, 0x80, 0x05, 0x97,
-	0xa2, 0x2b, 0x14, 0xbd, 0x1a, 0xa3, 0x19, 0xc7, 0x4c, 0x21, 0xd0, 0x2e, 0x2c, 0x92, 0xd0, 0x52,
-	0x74, 0x95, 0xdb, 0xc0, 0xd1, 0x84, 0x6e, 0x0a, 0x2e, 0xfa, 0x00, 0x16, 0x4e, 0xf0, 0x94, 0x02,
-	0x6b, 0x14, 0xb8, 0x1c, 0x03, 0x4f, 0xf0, 0xd4, 0x8c, 0x79, 0x6a, 0x1d, 0x36, 0xd3, 0x8e, 0x8a,
-	0xdb, 0xfe, 0xbb, 0x0a, 0x20, 0x96, 0xa0, 0xde, 0x39, 0x00, 0x2d, 0x50, 0x98, 0xa4, 0xed, 0x04,
-	0x37, 0x98, 0xad, 0xc8, 0x1c, 0x5d, 0x91, 0x6d, 0x0e, 0xcf, 0xb3, 0xcd, 0x82, 0x00, 0xc9, 0x6b,
-	0x6c, 0xc4, 0x2e, 0x93, 0x0a, 0xcb, 0x6b, 0x29, 0x12, 0x49, 0xb5, 0x1c, 0xcf, 0xae, 0xda, 0x2a,
-	0x85, 0x64, 0x68, 0x09, 0xa6, 0xed, 0x8f, 0x1d, 0xd7, 0xa3, 0x91, 0x10, 0x18, 0x46, 0x4b, 0x30,
-	0xfa, 0xb7, 0x13, 0x37, 0x98, 0xd2, 0xa3, 0x52, 0x31, 0x33, 0x34, 0x52, 0x31, 0x75, 0x71, 0xe4,
-	0xd0, 0x33, 0xb1, 0x64, 0xd2, 0x6f, 0x5a, 0x63, 0x50, 0x4c, 0x7a, 0x7b, 0x2e, 0xf2, 0x1a, 0x23,
-	0xcf, 0x40, 0xbf, 0x81, 0x35, 0xee, 0xe3, 0x74, 0x82, 0x5b, 0x23, 0x27, 0x0c, 0x1b, 0x4b, 0x34,
-	0x26, 0xf5, 0x6c, 0x4c, 0x62, 0xae, 0x99, 0x87, 0xa3, 0x8f, 0x00, 0x12, 0x52, 0x03, 0xa8, 0xf0,
-	0x7a, 0x41, 0xd8, 0x4c, 0x81, 0xe8, 0x0d, 0xc7, 0x46, 0xf8, 0xdb, 0xa8, 0xb1, 0x4c, 0x6d, 0x4b,
-	0x51, 0xd4, 0x2d, 0xd8, 0x48, 0xad, 0xb1, 0x58, 0xfb, 0xff, 0x94, 0x61, 0x67, 0xe0, 0x5d, 0xf1,
-	0xac, 0xc4, 0x32, 0xcc, 0xe1, 0x94, 0x6c, 0x1b, 0x7e, 0xe9, 0x7c, 0x0a, 0xc0, 0xa8, 0xd4, 0x94,
-	0x32, 0x35, 0xe5, 0x01, 0x37, 0x25, 0x2f, 0xc8, 0x8c, 0x4a, 0xbe, 0xd1, 0x26, 0xd4, 0x4e, 0xdd,
-	0xb1, 0x1b, 0x97, 0xb1, 0x6c, 0x40, 0x2e, 0x5b, 0xe3, 0xfa, 0x3a, 0xc4, 0x11, 0x5d, 0xea, 0x9a,
-	0xc9, 0x47, 0xd2, 0x7c, 0x51, 0x9d, 0x91, 0x2f, 0x76, 0xf8, 0xc9, 0xeb, 0x39, 0x63, 0xcc, 0x97,
-	0x3a, 0x21, 0x90, 0xba, 0xfa, 0x04, 0x4f, 0x29, 0x6f, 0x9e, 0xd5, 0xd5, 0x7c, 0xa8, 0xfe, 0x6b,
-	0x0e, 0x1e, 0xce, 0xf0, 0x97, 0x5f, 0x31, 0x3f, 0xc8, 0xe1, 0x0f, 0x72, 0x97, 0x4d, 0x2e, 0x1b,
-	0xc4, 0x77, 0xcb, 0x6e, 0xfe, 0x6e, 0xc9, 0xe7, 0x01, 0x71, 0x95, 0xa8, 0xd9, 0xab, 0x24, 0x9b,
-	0x01, 0xf8, 0xcd, 0xa1, 0x42, 0xed, 0xcc, 0x8f, 0x70, 0xd8, 0xa8, 0x65, 0x31, 0x84, 0x68, 0x32,
-	0x16, 0x7a, 0x0c, 0xd5, 0x13, 0x3c, 0x0d, 0x1b, 0xf3, 0x14, 0x92, 0xc9, 0x0f, 0x94, 0x81, 0x3e,
-	0x86, 0x65, 0x3b, 0xb8, 0x0d, 0xa3, 0x30, 0x72, 0x88, 0xaa, 0x05, 0x8a, 0x43, 0xc2, 0x2c, 0xc1,
-	0x32, 0xd3, 0x30, 0x75, 0x1b, 0xb6, 0x3a, 0xde, 0xf5, 0xc8, 0xbd, 0x79, 0x15, 0x85, 0xfd, 0xe0,
-	0xd6, 0xc3, 0x71, 0xb7, 0xd0, 0x80, 0x7a, 0x9e, 0xc1, 0x77, 0x5c, 0x00, 0x0f, 0x0e, 0x9d, 0xab,
-	0xaf, 0xb0, 0x37, 0xd4, 0xc6, 0x97, 0xa4, 0x05, 0xb1, 0x22, 0x27, 0xba, 0x0d, 0xe3, 0xac, 0x63,
-	0xc1, 0xa6, 0x8c, 0xcd, 0x93, 0x50, 0xfa, 0x0e, 0x96, 0xc1, 0x4c, 0xa9, 0xb0, 0xfa, 0x08, 0x76,
-	0xa4, 0xe8, 0xd8, 0xa6, 0x3a, 0x6c, 0xe6, 0x18, 0xcc, 0x8b, 0x6d, 0xd8, 0x92, 0x0b, 0xac, 0xc3,
-	0xda, 0x0b, 0x7f, 0x8c, 0xcf, 0x5c, 0x7c, 0x17, 0x63, 0x11, 0x28, 0x09, 0x89, 0xc3, 0x36, 0x01,
-	0xf5, 0xfd, 0xc9, 0xed, 0xc8, 0x09, 0xd2, 0xc8, 0x2d, 0xd8, 0xc8, 0x50, 0x39, 0x58, 0x81, 0xd5,
-	0x1e, 0xbe, 0x4b, 0x03, 0xd7, 0x61, 0x4d, 0x50, 0x12, 0x4b, 0x69, 0x69, 0xea, 0x5e, 0x39, 0x91,
-	0xeb, 0x7b, 0x69, 0x4b, 0x73, 0x74, 0x2e, 0xf0, 0xc7, 0x32, 0x34, 0x33, 0x1c, 0x96, 0x05, 0xe2,
-	0x70, 0x23, 0xa8, 0xd2, 0xe2, 0x96, 0x15, 0xa1, 0xf4, 0x9b, 0xd4, 0x15, 0xa4, 0xcb, 0xec, 0x44,
-	0x78, 0x5c, 0xbc, 0x8e, 0x65, 0x2c, 0xf4, 0x14, 0xee, 0x75, 0x9d, 0xe0, 0x2b, 0x6d, 0x34, 0xd2,
-	0x42, 0xc2, 0xe7, 0x55, 0x74, 0x96, 0xa8, 0x3e, 0x84, 0x07, 0x12, 0x4b, 0x84, 0xa5, 0x87, 0x50,
-	0x37, 0xbc, 0x4b, 0x72, 0x40, 0x48, 0x91, 0x34, 0xc2, 0x51, 0xbc, 0x99, 0xd0, 0x2e, 0xac, 0xe5,
-	0x38, 0xdc, 0xde, 0x3c, 0x59, 0xbd, 0x0f, 0xdb, 0x05, 0x1d, 0x5c, 0xfd, 0x67, 0x80, 0x2c, 0xb2,
-	0x01, 0x58, 0x83, 0x1d, 0xfb, 0xff, 0x63, 0x58, 0xd0, 0x52, 0x1d, 0xf8, 0xf2, 0xc1, 0x5a, 0xbc,
-	0xe5, 0x39, 0xd9, 0x8c, 0xf9, 0xea, 0x39, 0x6c, 0xa4, 0x14, 0x88, 0x7c, 0x41, 0x12, 0x2f, 0xdd,
-	0x1c, 0x2d, 0x7f, 0x88, 0x79, 0x9f, 0x9d, 0xa2, 0x90, 0x3b, 0x47, 0x0f, 0x02, 0x3f, 0xe8, 0xe2,
-	0x30, 0x74, 0x6e, 0x30, 0x0f, 0x63, 0x86, 0xa6, 0xfe, 0x79, 0x0e, 0xea, 0x47, 0x7a, 0xcb, 0xf7,
-	0xae, 0xdd, 0x9b, 0xd6, 0x2b, 0xc7, 0xbb, 0xc1, 0xc2, 0xc0, 0x0f, 0x61, 0xa3, 0xeb, 0x0f, 0xbb,
-	0xfe, 0x10, 0xeb, 0x9e, 0x73, 0x39, 0xc2, 0xc3, 0x4e, 0x68, 0xe1, 0x88, 0xfb, 0x2f, 0x63, 0xa1,
-	0x1f, 0xc1, 0x6a, 0x96, 0xcc, 0xeb, 0xff, 0x1c, 0x15, 0xbd, 0x80, 0xc7, 0xfa, 0xb7, 0x11, 0x0e,
-	0x3c, 0x67, 0xc4, 0xcb, 0x02, 0xed, 0x36, 0xf2, 0xc9, 0xa4, 0x6d, 0x37, 0x64, 0x82, 0x6c, 0x19,
-	0xdf, 0x04, 0x43, 0x26, 0x3c, 0x7d, 0x03, 0x84, 0x19, 0xcd, 0x5a, 0x84, 0xb7, 0xc2, 0x92, 0x95,
-	0xcc, 0x45, 0x44, 0xac, 0xa4, 0xc6, 0x9b, 0x2a, 0x13, 0x4f, 0xfc, 0xe0, 0x7b, 0x3d, 0x1a, 0xa8,
-	0xbf, 0x87, 0xcd, 0xac, 0x0a, 0xbe, 0x98, 0x2f, 0x60, 0x9d, 0x93, 0x6c, 0xe7, 0x52, 0xf7, 0xa2,
-	0xc0, 0xc5, 0xf1, 0xbb, 0x46, 0x33, 0x95, 0x7a, 0xb2, 0x98, 0xa9, 0x59, 0x14, 0x52, 0xdb, 0xbc,
-	0x53, 0xef, 0xfa, 0x43, 0xed, 0x2a, 0x7d, 0x54, 0xdf, 0xc9, 0xce, 0x11, 0x6f, 0xbe, 0xd3, 0x5a,
-	0xb8, 0xa9, 0x2f, 0x61, 0x33, 0xa1, 0x16, 0xac, 0x4d, 0xf7, 0x1c, 0x05, 0xd8, 0xd4, 0x94, 0x8a,
-	0xaa, 0x36, 0x34, 0xc9, 0x0e, 0xef, 0xba, 0x5e, 0xc4, 0x1a, 0x29, 0xcf, 0x19, 0x27, 0x3b, 0xf1,
-	0x13, 0xa8, 0xe7, 0x38, 0xa6, 0x73, 0xf7, 0xb9, 0x65, 0xf4, 0xb8, 0xf5, 0x33, 0xb8, 0xe4, 0xd8,
-	0x4b, 0xb4, 0x8a, 0xd5, 0xfc, 0x1c, 0x36, 0xd9, 0x53, 0xd4, 0x19, 0x0e, 0x42, 0xd7, 0xf7, 0xe2,
-	0xe9, 0x0e, 0x60, 0xb3, 0x75, 0x1b, 0x04, 0xd8, 0x8b, 0x32, 0x6c, 0x3e, 0x99, 0x94, 0xa7, 0x1a,
-	0xb0, 0x95, 0x21, 0x88, 0x60, 0x7d, 0x02, 0x75, 0xd2, 0x97, 0x9f, 0x78, 0xfe, 0x9d, 0x27, 0x53,
-	0x37, 0x83, 0xab, 0xfe, 0x16, 0x36, 0x2d, 0xec, 0x04, 0x57, 0x71, 0x0f, 0x1e, 0x1b, 0x47, 0x0e,
-	0x3d, 0xa5, 0x8b, 0x22, 0x81, 0x54, 0x5b, 0x82, 0x42, 0x4a, 0x5a, 0x36, 0x7a, 0x79, 0x8b, 0x83,
-	0x29, 0x3f, 0xf3, 0x69, 0x12, 0x49, 0xd8, 0x19, 0xcd, 0xb1, 0xa9, 0x7b, 0x9f, 0xa6, 0xba, 0x1b,
-	0x54, 0x07, 0x34, 0xe8, 0x9d, 0xf4, 0x8c, 0x2f, 0x7a, 0x17, 0xfa, 0x99, 0xde, 0xb3, 0x2f, 0xec,
-	0xf3, 0xbe, 0xae, 0x94, 0x10, 0xc0, 0x7c, 0xcb, 0xd4, 0x35, 0x5b, 0x57, 0xca, 0xe4, 0x7b, 0xd0,
-	0x6f, 0x93, 0xef, 0xb9, 0xbd, 0x4e, 0xb1, 0x1e, 0x47, 0x8f, 0xa0, 0x19, 0xeb, 0xb0, 0x3a, 0xc7,
-	0x3d, 0xed, 0xf4, 0xc2, 0xd6, 0xcc, 0x63, 0x5d, 0xe8, 0x5a, 0x86, 0x85, 0x96, 0xd1, 0xb3, 0xf5,
-	0x9e, 0xad, 0x94, 0xd1, 0x22, 0x54, 0x07, 0x96, 0x6e, 0x2a, 0x73, 0x7b, 0x7f, 0x2d, 0x17, 0xca,
-	0x58, 0xb4, 0x03, 0x8d, 0xbc, 0xaa, 0xf3, 0xbe, 0xde, 0x3a, 0xd5, 0x2c, 0x4b, 0x29, 0x11, 0x63,
-	0xb5, 0x76, 0xdb, 0xba, 0xb0, 0x8d, 0x8b, 0x76, 0xc7, 0x6a, 0x0d, 0x2c, 0xab, 0x63, 0xf4, 0x94,
-	0x32, 0xa1, 0x1f, 0x19, 0xa7, 0xa7, 0xc6, 0x17, 0xd6, 0xc5, 0xf1, 0xa0, 0xd3, 0xd6, 0x4f, 0x3b,
-	0x3d, 0xdd, 0x52, 0xe6, 0xd0, 0x1a, 0x2c, 0x77, 0x8d, 0xf6, 0x85, 0xd6, 0xb2, 0x3b, 0x46, 0xcf,
-	0x52, 0x2a, 0x48, 0x81, 0x95, 0xfe, 0xe0, 0xf0, 0xb4, 0xd3, 0xba, 0xb0, 0xcd, 0x81, 0x65, 0x2b,
-	0x55, 0xe2, 0x5b, 0x4f, 0xeb, 0x76, 0x7a, 0xc7, 0x4a, 0x8d, 0x98, 0x76, 0xf4, 0xf1, 0xcf, 0x3f,
-	0x52, 0xe6, 0x53, 0x38, 0xfd, 0x54, 0x6f, 0xd9, 0xca, 0xc2, 0xde, 0x77, 0xe5, 0x74, 0xc5, 0x8c,
-	0xb6, 0x61, 0x43, 0x62, 0x27, 0x8b, 0xdb, 0xa0, 0x7f, 0x66, 0xd0, 0xb8, 0xad, 0xc0, 0x62, 0xdb,
-	0xf8, 0xa2, 0x47, 0x47, 0x73, 0x68, 0x1d, 0xee, 0x99, 0x7a, 0xdf, 0x30, 0x6d, 0x62, 0x7e, 0xd7,
-	0x68, 0x2b, 0x15, 0x02, 0xe8, 0x1a, 0xed, 0xc3, 0x53, 0xa3, 0x75, 0xa2, 0x54, 0xd1, 0x2a, 0x40,
-	0xd7, 0x68, 0x6b, 0xfd, 0xbe, 0x69, 0x9c, 0xe9, 0x4a, 0x0d, 0xdd, 0x83, 0xa5, 0xae, 0xd1, 0xee,
-	0x1c, 0xf7, 0x0c, 0x53, 0x57, 0xe6, 0x89, 0x66, 0xe6, 0xa4, 0xb2, 0x80, 0x96, 0xa0, 0xc6, 0xa4,
-	0x16, 0x89, 0x8f, 0x3d, 0xad, 0xab, 0x5f, 0x68, 0x16, 0x31, 0x44, 0x59, 0x22, 0xf3, 0xb4, 0xf4,
-	0x9e, 0x65, 0x98, 0x31, 0x09, 0x08, 0x9c, 0xf9, 0xb1, 0x4c, 0x26, 0x69, 0x77, 0xac, 0x97, 0x03,
-	0xed, 0xb4, 0x73, 0x74, 0xae, 0xac, 0x90, 0xb5, 0x31, 0x75, 0xdb, 0xd4, 0x5a, 0xb6, 0x72, 0x6f,
-	0x2f, 0x84, 0x4d, 0x59, 0x01, 0x9a, 0xf6, 0x56, 0xef, 0xd9, 0x1d, 0xfb, 0x3c, 0xf6, 0x96, 0xd8,
-	0x61, 0x68, 0x66, 0x9b, 0x6d, 0x12, 0xfb, 0x85, 0xa9, 0x6b, 0x6d, 0x65, 0x8e, 0x04, 0xb2, 0x6f,
-	0x58, 0xb6, 0x52, 0x21, 0x5f, 0xd4, 0xfd, 0x2a, 0x5a, 0x80, 0xca, 0x89, 0x7e, 0xae, 0xd4, 0x88,
-	0x05, 0x34, 0xf8, 0x96, 0x4d, 0x76, 0xd4, 0xfc, 0xc1, 0x5f, 0xd6, 0x61, 0xf9, 0x28, 0xa0, 0x99,
-	0x7a, 0xa8, 0xf5, 0x3b, 0xe8, 0x06, 0xea, 0xf2, 0xc7, 0x63, 0xf4, 0x34, 0x6e, 0x50, 0x5e, 0xf7,
-	0x20, 0xdd, 0xfc, 0xe0, 0x0d, 0x28, 0x9e, 0x15, 0x4a, 0xc8, 0x84, 0xf5, 0xe3, 0xb8, 0xb9, 0x8b,
-	0xdf, 0x6a, 0xd1, 0x0e, 0x97, 0x96, 0x3e, 0x1b, 0x37, 0x1f, 0xce, 0xe0, 0x0a, 0x9d, 0x03, 0x40,
-	0xc7, 0xbc, 0xa7, 0x4c, 0x9e, 0x33, 0x51, 0x2c, 0x26, 0x7f, 0x59, 0x6d, 0x3e, 0x9a, 0xc5, 0x16,
-	0x6a, 0x5b, 0xb0, 0x72, 0x8c, 0x23, 0xf1, 0xae, 0x8d, 0xe2, 0xde, 0x37, 0xff, 0x86, 0xde, 0x6c,
-	0x14, 0x19, 0x42, 0x49, 0x07, 0x56, 0x2d, 0x6e, 0x1b, 0xdb, 0xc9, 0xe8, 0x7e, 0x7a, 0xe2, 0xcc,
-	0x8b, 0x67, 0xb3, 0x29, 0x63, 0x09, 0x55, 0xa7, 0xb0, 0x76, 0x8c, 0xa3, 0xf4, 0x03, 0x1a, 0x8a,
-	0x05, 0x24, 0xef, 0x8a, 0xcd, 0x07, 0x52, 0x9e, 0xd0, 0xd6, 0x05, 0x85, 0xe4, 0xef, 0xf4, 0xd3,
-	0x81, 0x50, 0x27, 0x79, 0x38, 0x11, 0xea, 0xa4, 0x6f, 0x0d, 0x25, 0xf4, 0x39, 0xac, 0x11, 0x75,
-	0xa9, 0x66, 0x54, 0x38, 0x5a, 0x7c, 0x84, 0x10, 0x8e, 0xca, 0x7a, 0xd7, 0x12, 0xba, 0x81, 0x06,
-	0x71, 0x54, 0xd6, 0xcf, 0xa1, 0xf7, 0x67, 0xf4, 0x6c, 0xe9, 0xee, 0xb6, 0xf9, 0xf4, 0xf5, 0x20,
-	0x31, 0xd1, 0x97, 0x70, 0x9f, 0x18, 0x2d, 0xed, 0x75, 0xc4, 0xa6, 0x94, 0x72, 0xc5, 0xa6, 0x9c,
-	0xd1, 0x0e, 0x95, 0x48, 0xc7, 0xc3, 0xb1, 0x99, 0x5e, 0x03, 0xc5, 0x71, 0x94, 0x75, 0x26, 0xcd,
-	0x1d, 0x39, 0x53, 0x28, 0x6d, 0xc3, 0x1a, 0x87, 0xc6, 0x4d, 0x09, 0x8a, 0x5f, 0x1f, 0x72, 0x8d,
-	0x4b, 0x73, 0xbb, 0x40, 0x4f, 0x2d, 0x3d, 0x8a, 0x2f, 0xbe, 0xa4, 0x61, 0x11, 0xcb, 0x55, 0x6c,
-	0x6d, 0xc4, 0x72, 0xc9, 0xfa, 0x9b, 0x12, 0xd2, 0x60, 0x95, 0x03, 0x79, 0x5b, 0x83, 0xb6, 0x38,
-	0x3e, 0xdb, 0xf8, 0x34, 0xeb, 0x79, 0xb2, 0x24, 0x58, 0x99, 0x56, 0x42, 0x04, 0x4b, 0xd6, 0x1c,
-	0x89, 0x60, 0xc9, 0x3b, 0xa4, 0x12, 0x72, 0x68, 0x4e, 0x93, 0xf4, 0x26, 0xe8, 0x3d, 0x99, 0x64,
-	0xa6, 0x83, 0x6a, 0xaa, 0xb3, 0x21, 0xd9, 0xcc, 0x63, 0xe1, 0x28, 0xd7, 0x9b, 0x88, 0xcc, 0x23,
-	0xef, 0x7b, 0x44, 0xe6, 0x99, 0xd5, 0xd2, 0x94, 0xd0, 0x11, 0xa9, 0x33, 0x44, 0x4f, 0x92, 0x1c,
-	0xa4, 0x42, 0xa3, 0x93, 0x1c, 0xa4, 0x62, 0x0b, 0xa3, 0x96, 0xd0, 0x19, 0xeb, 0x6d, 0x72, 0x15,
-	0xb7, 0xb0, 0x4f, 0xde, 0x9b, 0x08, 0xfb, 0x66, 0x15, 0xea, 0x25, 0xd4, 0x27, 0xad, 0x24, 0x75,
-	0x26, 0x5d, 0x6e, 0xa3, 0x4c, 0xfa, 0xca, 0x96, 0xf1, 0x22, 0x7d, 0xc8, 0xea, 0x73, 0xb5, 0x84,
-	0xce, 0xa1, 0x9e, 0xd6, 0x98, 0xd4, 0xb1, 0xd9, 0x34, 0x5e, 0x28, 0xbb, 0xb3, 0x69, 0xbc, 0x58,
-	0x4f, 0xab, 0x25, 0xf4, 0x3b, 0x16, 0x84, 0x5c, 0xa1, 0x2a, 0xf6, 0xc0, 0xec, 0xd2, 0x58, 0xec,
-	0x81, 0xd7, 0xd5, 0xb9, 0x24, 0x18, 0xeb, 0x34, 0x91, 0xa6, 0x2b, 0x4c, 0xb1, 0x71, 0x65, 0x35,
-	0xb0, 0xd8, 0xb8, 0xd2, 0xa2, 0x36, 0xd1, 0x98, 0x29, 0x24, 0x85, 0x46, 0x59, 0xe1, 0x2a, 0x34,
-	0x4a, 0x6b, 0x4f, 0xb5, 0x84, 0x3e, 0x83, 0x15, 0xfe, 0x52, 0x42, 0x7f, 0x68, 0x16, 0x07, 0x34,
-	0xfb, 0xc3, 0xb5, 0x38, 0xa0, 0xf9, 0xdf, 0xa3, 0x4b, 0x08, 0x43, 0x83, 0x98, 0x24, 0x7b, 0x6e,
-	0x41, 0x71, 0x98, 0x5e, 0xf3, 0xfe, 0xd3, 0x7c, 0xff, 0x35, 0x98, 0x64, 0x9a, 0xc3, 0xf7, 0xbe,
-	0x7c, 0xec, 0xe0, 0xe8, 0x15, 0x0e, 0x7e, 0x76, 0xe5, 0x07, 0xf8, 0x19, 0xfb, 0x7e, 0x46, 0x7f,
-	0x67, 0x0f, 0xd9, 0x6f, 0xf5, 0x97, 0xf3, 0x74, 0xf4, 0xfc, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4b, 0x32, 0x45, 0x58, 0xc1, 0x1f, 0x00, 0x00,
+	// 3117 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x73, 0xdb, 0xc8,
+	0x11, 0x26, 0x29, 0x52, 0x8f, 0xd6, 0x0b, 0x1a, 0x51, 0x34, 0x4d, 0x3f, 0x17, 0x6b, 0x6f, 0x14,
+	0x25, 0x6b, 0xaf, 0x65, 0xc7, 0x79, 0x6c, 0x2a, 0x1b, 0x88, 0x84, 0x65, 0xae, 0xc4, 0x87, 0x07,
+	0x94, 0x1d, 0x6f, 0xa5, 0xa2, 0x40, 0xe2, 0x58, 0x46, 0x96, 0x04, 0xb8, 0x00, 0x64, 0x9b, 0xf7,
+	0x54, 0x2a, 0xb7, 0xe4, 0x9a, 0x4b, 0x0e, 0xa9, 0x9c, 0x52, 0x95, 0x1f, 0x90, 0xaa, 0xfc, 0x84,
+	0xfc, 0x8a, 0x3d, 0xa6, 0x2a, 0xbf, 0x21, 0xa9, 0x79, 0x60, 0x38, 0x00, 0x87, 0x96, 0x77, 0x37,
+	0xb5, 0x17, 0x1b, 0xe8, 0xfe, 0xba, 0xa7, 0xa7, 0xa7, 0xd1, 0xd3, 0xdd, 0x14, 0x6c, 0xbc, 0x20,
+	0xee, 0xc8, 0xbb, 0xcb, 0xfe, 0xbd, 0x33, 0x0a, 0x83, 0x38, 0x40, 0x25, 0xf6, 0x52, 0xbb, 0xfc,
+	0x82, 0x04, 0x27, 0xbf, 0x21, 0xa7, 0x71, 0x74, 0x57, 0x3e, 0x71, 0x44, 0x6d, 0x73, 0xe8, 0x0d,
+	0xa9, 0x14, 0xff, 0x8f, 0x13, 0xcd, 0x9f, 0xc1, 0xda, 0x9e, 0x8d, 0x89, 0xdb, 0x1f, 0x63, 0xf2,
+	0xc5, 0x39, 0x89, 0x62, 0x54, 0x85, 0x05, 0xb7, 0xdf, 0x0f, 0x49, 0x14, 0x55, 0xf3, 0x37, 0xf3,
+	0xdb, 0x4b, 0x38, 0x79, 0x45, 0x08, 0x8a, 0xa3, 0x20, 0x8c, 0xab, 0x85, 0x9b, 0xf9, 0xed, 0x12,
+	0x66, 0xcf, 0xe6, 0x06, 0xac, 0x4b, 0xf9, 0x68, 0x14, 0xf8, 0x11, 0x31, 0xef, 0xc3, 0x35, 0x87,
+	0xc4, 0xf5, 0x81, 0x47, 0xfc, 0xd8, 0xea, 0x36, 0x1d, 0x12, 0xbe, 0x22, 0x61, 0x37, 0x08, 0xe3,
+	0x64, 0x05, 0x04, 0x45, 0xfa, 0xca, 0xd4, 0x97, 0x30, 0x7b, 0x36, 0x6f, 0xc2, 0xf5, 0x59, 0x42,
+	0x42, 0x2d, 0x02, 0xc3, 0x1a, 0x0c, 0xf6, 0x02, 0x37, 0xec, 0x47, 0x42, 0x93, 0xf9, 0x04, 0x36,
+	0x14, 0x1a, 0x07, 0xa2, 0x9f, 0xc2, 0x92, 0x24, 0x56, 0xf3, 0x37, 0xe7, 0xb6, 0x97, 0x77, 0xaf,
+	0xdf, 0x99, 0x38, 0xa3, 0x1e, 0x0c, 0x47, 0xde, 0x80, 0xf4, 0x19, 0xc0, 0xf6, 0x63, 0x2f, 0x1e,
+	0xe3, 0x89, 0x80, 0xf9, 0x05, 0x6c, 0xf5, 0x5e, 0x86, 0xc4, 0xed, 0x5b, 0x7e, 0xbf, 0x1b, 0x44,
+	0x71, 0xb2, 0x16, 0xda, 0x01, 0x83, 0x41, 0x1e, 0x79, 0xfe, 0x19, 0x09, 0x47, 0xa1, 0xe7, 0xc7,
+	0xc2, 0x41, 0x53, 0x74, 0xf4, 0x7d, 0xd8, 0xe0, 0x4a, 0x54, 0x70, 0x81, 0x81, 0xa7, 0x19, 0xe6,
+	0x3f, 0xf3, 0x50, 0xc9, 0xae, 0x29, 0xf6, 0xf2, 0x00, 0x4a, 0x4c, 0x39, 0x5b, 0xe9, 0xe2, 0x7d,
+	0x70, 0x30, 0xfa, 0x21, 0xcc, 0x73, 0x7d, 0x6c, 0xcd, 0xe5, 0xdd, 0x1b, 0x1a, 0x31, 0x0e, 0x10,
+	0x72, 0x02, 0x8e, 0xee, 0x43, 0x89, 0xad, 0x5f, 0x9d, 0x63, 0x6e, 0xbb, 0xa6, 0x91, 0xa3, 0xfc,
+	0x64, 0x35, 0x86, 0x35, 0x7f, 0x9b, 0x87, 0x0a, 0x5b, 0xd7, 0xf2, 0x85, 0xd6, 0xaf, 0xe5, 0xb3,
+	0x1d, 0x30, 0x9c, 0x20, 0x8c, 0x85, 0x86, 0xbd, 0x71, 0x9b, 0xbc, 0x66, 0xe6, 0x2f, 0xe2, 0x29,
+	0x3a, 0x8d, 0x20, 0x4a, 0xab, 0xce, 0x31, 0x5d, 0xec, 0xd9, 0xfc, 0x73, 0x1e, 0x2e, 0x4d, 0x99,
+	0xf1, 0x8d, 0xdc, 0xf8, 0x63, 0x58, 0x10, 0x8a, 0xaa, 0x05, 0xe6, 0x8f, 0x0b, 0xfd, 0x98, 0xe0,
+	0xb5, 0x06, 0xfe, 0x3b, 0x0f, 0x88, 0x29, 0x76, 0xbc, 0x33, 0xdf, 0x1d, 0x24, 0x3e, 0xba, 0x09,
+	0xcb, 0xd3, 0xee, 0x51, 0x49, 0xe8, 0x3a, 0x80, 0x73, 0x7e, 0x12, 0x9d, 0x86, 0xde, 0x09, 0xe9,
+	0x0b, 0x9f, 0x28, 0x14, 0x54, 0x81, 0xf9, 0x76, 0x10, 0x7b, 0x2f, 0xc6, 0x6c, 0xb9, 0x45, 0x2c,
+	0xde, 0x50, 0x0d, 0x16, 0x0f, 0xdd, 0x28, 0x76, 0x08, 0xf1, 0xab, 0xc5, 0x9b, 0xf9, 0xed, 0x39,
+	0x2c, 0xdf, 0x91, 0x09, 0x2b, 0xc9, 0x73, 0xc7, 0x1f, 0x8c, 0xab, 0x25, 0x26, 0x99, 0xa2, 0xd1,
+	0x4c, 0x80, 0x5d, 0xff, 0x73, 0xcf, 0x3f, 0xab, 0xce, 0xf3, 0x4c, 0x20, 0x5e, 0xa9, 0xcd, 0xe2,
+	0x91, 0x09, 0x2f, 0x30, 0x61, 0x95, 0x64, 0xde, 0x87, 0xcd, 0xd4, 0x5e, 0xc5, 0x41, 0x5c, 0x85,
+	0xa5, 0x7a, 0x30, 0x1c, 0x7a, 0x71, 0x4c, 0xf8, 0x61, 0x2c, 0xe2, 0x09, 0xc1, 0x0c, 0x60, 0x93,
+	0x3b, 0xf0, 0x5b, 0xf2, 0x90, 0xf9, 0x00, 0xca, 0xe9, 0x05, 0xdf, 0xc9, 0xcc, 0xff, 0xe6, 0x61,
+	0xf3, 0x28, 0x22, 0xa1, 0xe5, 0xf7, 0xf7, 0x43, 0x77, 0xf4, 0xf2, 0xdd, 0xed, 0xfc, 0x88, 0x0b,
+	0x8a, 0x68, 0xe1, 0x62, 0xd2, 0x60, 0x1d, 0x2b, 0x91, 0x48, 0xa5, 0x3d, 0xd2, 0x67, 0xe7, 0x21,
+	0x24, 0x32, 0x2c, 0xb4, 0x0b, 0x65, 0x4a, 0x4e, 0x7f, 0x89, 0xa4, 0xcf, 0x22, 0x60, 0x11, 0x6b,
+	0x79, 0xe8, 0x0e, 0x20, 0x4a, 0x57, 0xf3, 0x1d, 0xe9, 0x8b, 0x98, 0xd0, 0x70, 0xcc, 0x7f, 0xcc,
+	0xf1, 0x45, 0x26, 0x1e, 0x10, 0x8e, 0xbb, 0x07, 0x45, 0x4a, 0x17, 0xdf, 0x99, 0x2e, 0x7f, 0x28,
+	0x9b, 0x64, 0x50, 0xf4, 0x10, 0xe6, 0x45, 0xae, 0x2e, 0xbc, 0x53, 0xae, 0x16, 0x68, 0xf5, 0xeb,
+	0x9c, 0xfb, 0x8a, 0x5f, 0xa7, 0x4c, 0x73, 0xc5, 0x77, 0x4f, 0x73, 0xb3, 0xce, 0xae, 0xf4, 0x6d,
+	0x9c, 0xdd, 0xc2, 0x57, 0x3e, 0xbb, 0xc5, 0x99, 0x67, 0xf7, 0xf7, 0x3c, 0x94, 0xec, 0x57, 0x84,
+	0x67, 0xdc, 0xce, 0x6b, 0x9f, 0x84, 0x9a, 0xec, 0x9c, 0xa5, 0x53, 0x6c, 0x37, 0xf4, 0x82, 0x70,
+	0xfa, 0x42, 0x9b, 0xa2, 0xa3, 0x3b, 0xb0, 0xc4, 0x16, 0xe8, 0x8d, 0x47, 0x84, 0x7d, 0x70, 0x6b,
+	0xbb, 0xc6, 0x1d, 0x5e, 0xab, 0x48, 0x3a, 0x9e, 0x40, 0xe8, 0xd7, 0xd6, 0xf3, 0x86, 0x24, 0x8a,
+	0xdd, 0xe1, 0x48, 0x24, 0xaa, 0x09, 0xc1, 0xfc, 0xeb, 0x1c, 0x6c, 0xd6, 0x03, 0x3f, 0x26, 0x7e,
+	0xcc, 0x44, 0xba, 0xee, 0x78, 0x10, 0xb8, 0x7d, 0x64, 0x8a, 0x6d, 0x88, 0x58, 0x5b, 0x51, 0x57,
+	0xc0, 0x62, 0x87, 0xdf, 0x83, 0x25, 0xe6, 0xe2, 0x86, 0x1b, 0xbb, 0xe2, 0x2e, 0x5c, 0xbd, 0x23,
+	0xea, 0x1f, 0xc6, 0xc0, 0x13, 0x3e, 0xba, 0x03, 0xc0, 0x9d, 0xcb, 0xd0, 0x73, 0x0c, 0xbd, 0x96,
+	0xa0, 0x39, 0x07, 0x2b, 0x08, 0xb4, 0x0d, 0x8b, 0xd4, 0xb5, 0x0c, 0x5d, 0x14, 0x36, 0x08, 0x34,
+	0xa5, 0x63, 0xc9, 0x45, 0xb7, 0x61, 0xe1, 0x80, 0x8c, 0x19, 0xb0, 0xc4, 0x80, 0xcb, 0x09, 0xf0,
+	0x80, 0x8c, 0x71, 0xc2, 0x43, 0x75, 0xd8, 0x68, 0x78, 0x21, 0x39, 0x8d, 0x5b, 0x24, 0x8a, 0xdc,
+	0x33, 0xc2, 0x04, 0xe6, 0x99, 0xc0, 0x56, 0x22, 0x90, 0x02, 0xe0, 0x69, 0x3c, 0xfa, 0x09, 0xac,
+	0xb0, 0x2d, 0x25, 0x0b, 0x2e, 0x30, 0xf9, 0x8a, 0xf0, 0x4e, 0xc2, 0x12, 0xe1, 0x80, 0x53, 0x58,
+	0x29, 0xdb, 0x0d, 0x9e, 0x31, 0xd9, 0xc5, 0x69, 0xd9, 0x6e, 0xf0, 0x2c, 0x2d, 0x2b, 0xb0, 0xe6,
+	0x00, 0xd6, 0x33, 0xca, 0xd1, 0x87, 0x30, 0x6f, 0x9d, 0xc6, 0x5e, 0xe0, 0xb3, 0x23, 0x5a, 0xdb,
+	0xdd, 0xca, 0x18, 0xc1, 0x99, 0x58, 0x80, 0x68, 0x88, 0xb5, 0xc8, 0xf0, 0x84, 0x84, 0xdd, 0xf3,
+	0x93, 0x81, 0x77, 0x7a, 0x40, 0xc6, 0x3c, 0x25, 0x2c, 0xe1, 0x29, 0xba, 0xf9, 0xa7, 0xbc, 0x58,
+	0x6e, 0x62, 0x0f, 0x4d, 0xf2, 0x98, 0x0c, 0x83, 0x57, 0x44, 0x64, 0x6c, 0xf1, 0x46, 0xe9, 0x4a,
+	0x35, 0x54, 0x92, 0xc5, 0x8e, 0x09, 0x2b, 0xfc, 0xe9, 0x68, 0xd4, 0x77, 0x63, 0x1e, 0xa9, 0x25,
+	0x9c, 0xa2, 0xf1, 0x52, 0x35, 0x8a, 0xd9, 0xf9, 0xb2, 0x52, 0x35, 0x62, 0x97, 0x0d, 0xfd, 0x5f,
+	0x48, 0x95, 0x18, 0x47, 0xa1, 0x98, 0x15, 0x28, 0xab, 0xf1, 0x2a, 0x0b, 0xd8, 0x2f, 0xe7, 0x00,
+	0xf1, 0x7b, 0xe6, 0x2b, 0xc7, 0x71, 0x1d, 0x0c, 0x2e, 0xd9, 0x73, 0xc3, 0x33, 0xc2, 0x3f, 0xac,
+	0x02, 0xf3, 0xe9, 0x25, 0x01, 0xcf, 0xb2, 0xf1, 0x94, 0x00, 0xbd, 0x9e, 0xf8, 0x1b, 0x2f, 0x85,
+	0x78, 0x69, 0xa2, 0x92, 0x98, 0x47, 0x38, 0x9e, 0xfb, 0xab, 0xc8, 0x20, 0x29, 0xda, 0x04, 0xd3,
+	0x08, 0x86, 0xae, 0xe7, 0xb3, 0xfd, 0x4b, 0x0c, 0xa7, 0x4d, 0x30, 0xf6, 0x9b, 0x91, 0x17, 0x8e,
+	0x59, 0x0c, 0xcf, 0xe1, 0x14, 0x8d, 0x7a, 0xb6, 0x45, 0x44, 0x7c, 0x2e, 0x61, 0xf6, 0xcc, 0xca,
+	0x66, 0x86, 0x51, 0xb3, 0xcc, 0xa2, 0x28, 0x9b, 0xb3, 0x0c, 0xf4, 0x73, 0x58, 0x17, 0x7b, 0x1c,
+	0x8f, 0x48, 0x7d, 0xe0, 0x46, 0x51, 0x75, 0x89, 0xf9, 0xa4, 0x92, 0xf6, 0x49, 0xc2, 0xc5, 0x59,
+	0x38, 0xba, 0x07, 0x30, 0x21, 0x55, 0x81, 0x09, 0x6f, 0x4c, 0x09, 0x63, 0x05, 0xc4, 0x2a, 0x0d,
+	0xfe, 0x46, 0xde, 0xc4, 0xd5, 0x65, 0x66, 0x9b, 0x42, 0x31, 0xb7, 0x60, 0x53, 0x39, 0x63, 0x79,
+	0xf6, 0xdf, 0x81, 0xad, 0xba, 0xeb, 0x9f, 0x92, 0x41, 0xd3, 0x7f, 0x31, 0xf0, 0xce, 0x5e, 0xca,
+	0x5e, 0x68, 0x0d, 0x0a, 0xcd, 0xbe, 0xc8, 0xba, 0x85, 0x66, 0xdf, 0x7c, 0x08, 0x95, 0x2c, 0x50,
+	0xa9, 0x49, 0x18, 0x67, 0xa0, 0xd4, 0x24, 0x09, 0xc1, 0xfc, 0x00, 0xca, 0x98, 0xc4, 0xe1, 0xf8,
+	0x22, 0xfd, 0xf7, 0x60, 0x2b, 0x83, 0x13, 0xea, 0x69, 0xb1, 0x47, 0xe2, 0xd0, 0x93, 0xca, 0x93,
+	0x57, 0xf3, 0x47, 0x50, 0xe6, 0x37, 0xdb, 0x63, 0x2f, 0x8a, 0x83, 0x70, 0xfc, 0xce, 0xe5, 0x8e,
+	0xf9, 0x97, 0x3c, 0x6c, 0x65, 0x44, 0xc5, 0x6a, 0x1f, 0x03, 0x70, 0x06, 0xf3, 0x3c, 0x4f, 0x0f,
+	0x57, 0x84, 0xe7, 0x8f, 0xfc, 0x53, 0x71, 0x09, 0x4f, 0x20, 0x58, 0x81, 0xa3, 0xed, 0x6c, 0x5d,
+	0x9e, 0xcd, 0xd2, 0xf2, 0xa2, 0x37, 0xd3, 0xfd, 0x4c, 0x3a, 0x3f, 0x8b, 0xf6, 0x65, 0x17, 0x56,
+	0x9a, 0xfe, 0x49, 0xf0, 0x26, 0xd9, 0x96, 0x09, 0x2b, 0x2d, 0x37, 0xfc, 0x9c, 0xf6, 0xb4, 0x5d,
+	0x22, 0x4a, 0x99, 0x25, 0x9c, 0xa2, 0x99, 0xbf, 0x84, 0x55, 0x21, 0x23, 0xf6, 0x73, 0x00, 0xab,
+	0xf5, 0xc0, 0x7f, 0x45, 0xc2, 0xc8, 0xa5, 0xb9, 0x2c, 0xe9, 0x3b, 0x6f, 0x6b, 0x2a, 0x0b, 0x15,
+	0x27, 0x2a, 0x86, 0xb4, 0xac, 0xf9, 0x9f, 0x3c, 0x5c, 0xcd, 0x3a, 0x61, 0x6f, 0xac, 0x24, 0xd6,
+	0x6f, 0xe4, 0xbd, 0x32, 0x94, 0x0e, 0xbd, 0xa1, 0x97, 0xb4, 0xf1, 0xfc, 0x85, 0x26, 0xc9, 0xce,
+	0x8b, 0x17, 0x11, 0x89, 0x45, 0x1a, 0x14, 0x6f, 0xda, 0x1a, 0xa1, 0x38, 0xa3, 0x46, 0xb8, 0x2a,
+	0x6e, 0xdb, 0xb6, 0x3b, 0x24, 0x22, 0x2f, 0x4c, 0x08, 0x34, 0xc0, 0x0e, 0xc8, 0x98, 0xf1, 0x44,
+	0x37, 0x21, 0x5e, 0xcd, 0x7f, 0x15, 0xe0, 0xda, 0x8c, 0xfd, 0xfe, 0x3f, 0xc2, 0xe5, 0x76, 0xa6,
+	0xc0, 0xcc, 0x54, 0x00, 0x49, 0x3d, 0xb9, 0x9d, 0xad, 0x27, 0x2f, 0x8e, 0xaa, 0xe2, 0xcc, 0xa8,
+	0xa2, 0x98, 0xa7, 0x41, 0x4c, 0xa2, 0x6a, 0x29, 0x8d, 0xa1, 0x44, 0xcc, 0x59, 0xe8, 0x06, 0x14,
+	0xd9, 0x25, 0x37, 0xcf, 0x20, 0xa9, 0x9a, 0x80, 0x31, 0xd0, 0x03, 0x58, 0xee, 0x85, 0xe7, 0x51,
+	0x1c, 0xc5, 0x2e, 0x55, 0xb5, 0xc0, 0x70, 0x48, 0x9a, 0x25, 0x59, 0x58, 0x85, 0x99, 0x97, 0x60,
+	0x2b, 0xf9, 0xba, 0xa3, 0x6e, 0x78, 0xee, 0x93, 0x64, 0x5a, 0x52, 0x85, 0x4a, 0x96, 0x21, 0xd2,
+	0x53, 0x08, 0x57, 0xf6, 0xdc, 0xd3, 0xcf, 0x89, 0xdf, 0xb7, 0x86, 0x27, 0x1e, 0xf1, 0x63, 0x27,
+	0x76, 0xe3, 0xf3, 0x28, 0xb9, 0xa2, 0x1c, 0x28, 0xeb, 0xd8, 0xe2, 0xc6, 0x52, 0xeb, 0x6e, 0x1d,
+	0x0c, 0x6b, 0x85, 0xcd, 0xeb, 0x70, 0x55, 0x8b, 0x4e, 0x6c, 0xaa, 0x40, 0x39, 0xc3, 0xe0, 0xbb,
+	0xb8, 0x04, 0x5b, 0x7a, 0x81, 0x0d, 0x58, 0x7f, 0x1c, 0x0c, 0xc9, 0x53, 0x8f, 0xbc, 0x4e, 0xb0,
+	0x08, 0x8c, 0x09, 0x49, 0xc0, 0xb6, 0x01, 0x75, 0x83, 0xd1, 0xf9, 0xc0, 0x0d, 0x15, 0xa4, 0x6c,
+	0xd8, 0xf3, 0x4a, 0xc3, 0xbe, 0x05, 0x9b, 0x29, 0xa4, 0x50, 0x60, 0xc0, 0x5a, 0x9b, 0xbc, 0x56,
+	0x97, 0xd9, 0x80, 0x75, 0x49, 0x99, 0x58, 0xcf, 0x7a, 0x4c, 0xef, 0x94, 0x7f, 0xd4, 0x8a, 0xf5,
+	0x19, 0xba, 0x10, 0xf8, 0x7d, 0x1e, 0x6a, 0x29, 0x0e, 0xbf, 0x46, 0x92, 0x23, 0xa0, 0xf6, 0xd1,
+	0x3e, 0x9e, 0xe7, 0x66, 0xf6, 0x4c, 0xfb, 0x0b, 0x9a, 0x91, 0x9a, 0x31, 0x19, 0x4e, 0x97, 0xe5,
+	0x3a, 0x16, 0xba, 0x05, 0xab, 0x34, 0x8f, 0x59, 0x83, 0x81, 0x15, 0x51, 0xbe, 0x68, 0x87, 0xd3,
+	0x44, 0xf3, 0x1a, 0x5c, 0xd1, 0x58, 0x22, 0x2d, 0xdd, 0x83, 0x4a, 0xc7, 0x3f, 0xa1, 0x1f, 0x0d,
+	0x4d, 0x69, 0x03, 0x12, 0x27, 0x01, 0x86, 0xb6, 0x61, 0x3d, 0xc3, 0x11, 0xf6, 0x66, 0xc9, 0xe6,
+	0x65, 0xb8, 0x34, 0xa5, 0x43, 0xa8, 0xff, 0x04, 0x90, 0x43, 0x83, 0x82, 0x0f, 0x1d, 0x93, 0xfd,
+	0x7f, 0x17, 0x16, 0x2c, 0x65, 0x2a, 0xb9, 0xbc, 0xbb, 0x9e, 0x7c, 0x06, 0x82, 0x8c, 0x13, 0xbe,
+	0xf9, 0x1c, 0x36, 0x15, 0x05, 0x32, 0x87, 0xd0, 0x9b, 0x9b, 0x05, 0x4c, 0x3d, 0xe8, 0x13, 0x31,
+	0x7b, 0x54, 0x28, 0x34, 0xef, 0xdb, 0x61, 0x18, 0x84, 0xa2, 0x98, 0x16, 0x6e, 0x4c, 0xd1, 0xcc,
+	0x3f, 0x16, 0xa0, 0xf2, 0xc8, 0xae, 0x07, 0xfe, 0x0b, 0xef, 0xac, 0xfe, 0xd2, 0xf5, 0xcf, 0x88,
+	0x34, 0xf0, 0x23, 0xd8, 0x6c, 0x05, 0xfd, 0x56, 0xd0, 0x27, 0xb6, 0xef, 0x9e, 0x0c, 0x48, 0xbf,
+	0x19, 0x39, 0x24, 0x16, 0xfb, 0xd7, 0xb1, 0xd0, 0x07, 0xb0, 0x96, 0x26, 0x8b, 0x39, 0x40, 0x86,
+	0x8a, 0x1e, 0xc3, 0x0d, 0xfb, 0x4d, 0x4c, 0x42, 0xdf, 0x1d, 0x88, 0xba, 0xd2, 0x3a, 0x8f, 0x03,
+	0xba, 0x68, 0xc3, 0x8b, 0xb8, 0x20, 0x3f, 0xc6, 0x8b, 0x60, 0x08, 0xc3, 0xad, 0x0b, 0x20, 0xdc,
+	0x68, 0x3e, 0x2a, 0x78, 0x27, 0x2c, 0x3d, 0xc9, 0x8c, 0x47, 0xe4, 0x49, 0x5a, 0x62, 0x06, 0x84,
+	0xc9, 0x28, 0x08, 0xbf, 0xd6, 0x20, 0xd5, 0xfc, 0x35, 0x94, 0xd3, 0x2a, 0xc4, 0x61, 0x3e, 0x86,
+	0x0d, 0x41, 0xea, 0xb9, 0x27, 0xb6, 0x4f, 0x0b, 0x95, 0xe4, 0xce, 0xad, 0x29, 0xe9, 0x28, 0x8d,
+	0x19, 0xe3, 0x69, 0x21, 0xb3, 0x21, 0x86, 0x97, 0xad, 0xa0, 0xcf, 0xfb, 0x90, 0xaf, 0x65, 0xe7,
+	0x40, 0xcc, 0x1e, 0x55, 0x2d, 0xc2, 0xd4, 0x27, 0x50, 0x9e, 0x50, 0xa7, 0xac, 0x55, 0x67, 0x0f,
+	0x53, 0xb0, 0x31, 0xd6, 0x8a, 0x9a, 0x3d, 0xa8, 0xd1, 0x08, 0x6f, 0x79, 0x7e, 0xcc, 0x07, 0x2a,
+	0xbe, 0x3b, 0x9c, 0x44, 0xe2, 0x43, 0xa8, 0x64, 0x38, 0xd8, 0x7d, 0xfd, 0xa9, 0xd3, 0x69, 0x0b,
+	0xeb, 0x67, 0x70, 0xe9, 0x67, 0xaf, 0xd1, 0x2a, 0x4f, 0xf3, 0x53, 0x28, 0xf3, 0xf1, 0xfc, 0x53,
+	0x12, 0x46, 0x5e, 0xe0, 0x27, 0xcb, 0xed, 0x42, 0xb9, 0x7e, 0x1e, 0x86, 0xc4, 0x8f, 0x53, 0x6c,
+	0xb1, 0x98, 0x96, 0x67, 0x76, 0x60, 0x2b, 0x45, 0x90, 0xce, 0x7a, 0x08, 0x95, 0x43, 0x37, 0x8a,
+	0x0f, 0xfc, 0xe0, 0xb5, 0xaf, 0x53, 0x37, 0x83, 0x6b, 0xfe, 0x2e, 0x0f, 0x65, 0x87, 0xb8, 0xe1,
+	0x69, 0x32, 0x8c, 0x4b, 0xac, 0xa3, 0x5f, 0x3d, 0xa3, 0xcb, 0xca, 0x81, 0xd6, 0xeb, 0x92, 0x42,
+	0x8b, 0x58, 0xfe, 0xf6, 0xe4, 0x9c, 0x84, 0x63, 0xf1, 0xd1, 0xab, 0xa4, 0x99, 0x95, 0x91, 0xac,
+	0xa3, 0x8a, 0x4a, 0x1d, 0x45, 0xf3, 0x7b, 0xca, 0x8e, 0x64, 0x67, 0x3b, 0x1f, 0x2b, 0x43, 0x11,
+	0x54, 0x01, 0x74, 0xd4, 0x3e, 0x68, 0x77, 0x9e, 0xb5, 0x8f, 0xed, 0xa7, 0x76, 0xbb, 0x77, 0xdc,
+	0x7b, 0xde, 0xb5, 0x8d, 0x1c, 0x02, 0x98, 0xaf, 0x63, 0xdb, 0xea, 0xd9, 0x46, 0x9e, 0x3e, 0x1f,
+	0x75, 0x1b, 0xf4, 0xb9, 0xb0, 0xf3, 0x0b, 0x58, 0x4b, 0x37, 0xcd, 0xe8, 0x2a, 0x54, 0x13, 0x0d,
+	0x7b, 0x1d, 0x0b, 0x37, 0x8e, 0x0f, 0xec, 0xe7, 0xc7, 0x56, 0xbd, 0xd7, 0xec, 0xb4, 0x8d, 0x1c,
+	0xda, 0x84, 0xf5, 0xa6, 0xe3, 0x1c, 0xd9, 0x13, 0x9e, 0x91, 0xa7, 0xc4, 0x7d, 0x6c, 0xb5, 0x7b,
+	0x0a, 0xb1, 0xb0, 0xd3, 0x9c, 0xee, 0x2c, 0xd1, 0x75, 0xa8, 0x25, 0xba, 0x9d, 0xe6, 0x7e, 0xdb,
+	0x3a, 0x3c, 0xee, 0x59, 0x78, 0xdf, 0x96, 0x56, 0x2e, 0xc3, 0x42, 0xbd, 0xd3, 0xee, 0xd9, 0xed,
+	0x9e, 0x91, 0x47, 0x8b, 0x50, 0x3c, 0x72, 0x6c, 0x6c, 0x14, 0x76, 0xfe, 0x96, 0x9f, 0x6a, 0xc8,
+	0x54, 0x33, 0x13, 0x55, 0xcf, 0xbb, 0x76, 0xfd, 0xd0, 0x72, 0x1c, 0x23, 0x47, 0xdd, 0x60, 0x35,
+	0x1a, 0xce, 0x71, 0xaf, 0x73, 0xdc, 0x68, 0x3a, 0xf5, 0x23, 0xc7, 0xa1, 0xe6, 0xe7, 0x29, 0xfd,
+	0x51, 0xe7, 0xf0, 0xb0, 0xf3, 0xcc, 0x39, 0xde, 0x3f, 0x6a, 0x36, 0xec, 0xc3, 0x66, 0xdb, 0x76,
+	0x8c, 0x02, 0x5a, 0x87, 0xe5, 0x56, 0xa7, 0x21, 0xb6, 0xe9, 0x18, 0x73, 0xc8, 0x80, 0x95, 0xee,
+	0xd1, 0xde, 0x61, 0xb3, 0x7e, 0xdc, 0xc3, 0x47, 0x4e, 0xcf, 0x28, 0x52, 0xaf, 0xb5, 0xad, 0x56,
+	0xb3, 0xbd, 0x6f, 0x94, 0xa8, 0x69, 0x8f, 0x1e, 0xfc, 0xe0, 0x9e, 0x31, 0xaf, 0xe0, 0xec, 0x43,
+	0xbb, 0xde, 0x33, 0x16, 0x76, 0xbe, 0xcc, 0xab, 0xbd, 0x1f, 0xba, 0x04, 0x9b, 0x1a, 0x3b, 0xf9,
+	0x89, 0x1c, 0x75, 0x9f, 0x76, 0xd8, 0x89, 0xac, 0xc0, 0x62, 0xa3, 0xf3, 0xac, 0xcd, 0xde, 0x0a,
+	0x68, 0x03, 0x56, 0xb1, 0xdd, 0xed, 0xe0, 0x1e, 0x35, 0xbf, 0xd5, 0x69, 0x18, 0x73, 0x14, 0xd0,
+	0xea, 0x34, 0xf6, 0x0e, 0x3b, 0xf5, 0x03, 0xa3, 0x88, 0xd6, 0x00, 0x5a, 0x9d, 0x86, 0xd5, 0xed,
+	0xe2, 0xce, 0x53, 0xdb, 0x28, 0xa1, 0x55, 0x58, 0x6a, 0x75, 0x1a, 0xcd, 0xfd, 0x76, 0x07, 0xdb,
+	0xc6, 0x3c, 0xd5, 0xcc, 0x37, 0x69, 0x2c, 0xa0, 0x25, 0x28, 0x71, 0xa9, 0x45, 0xba, 0xc7, 0xb6,
+	0xd5, 0xb2, 0x8f, 0x2d, 0x87, 0x1a, 0x62, 0x2c, 0xd1, 0x75, 0xea, 0x76, 0xdb, 0xe9, 0xe0, 0x84,
+	0x04, 0x14, 0xce, 0xf7, 0xb1, 0x4c, 0x17, 0x69, 0x34, 0x9d, 0x27, 0x47, 0xd6, 0x61, 0xf3, 0xd1,
+	0x73, 0x63, 0x85, 0x9e, 0x0d, 0xb6, 0x7b, 0xd8, 0xaa, 0xf7, 0x8c, 0xd5, 0x9d, 0x08, 0xca, 0xba,
+	0xea, 0x58, 0xdd, 0xad, 0xdd, 0xee, 0x35, 0x7b, 0xcf, 0x93, 0xdd, 0x52, 0x3b, 0x68, 0x70, 0xf0,
+	0xf0, 0xeb, 0x3d, 0xc6, 0xb6, 0xd5, 0x30, 0x0a, 0xd4, 0x91, 0xdd, 0x8e, 0xd3, 0x33, 0xe6, 0xe8,
+	0x13, 0xdb, 0x7e, 0x11, 0x2d, 0xc0, 0x1c, 0x8d, 0xa0, 0x12, 0xb5, 0x80, 0x39, 0xdf, 0xe9, 0xd1,
+	0x58, 0x9d, 0xdf, 0xfd, 0x43, 0x19, 0x96, 0x1f, 0x85, 0xec, 0xca, 0xe8, 0x5b, 0xdd, 0x26, 0x3a,
+	0x83, 0x8a, 0xfe, 0x97, 0x3d, 0x74, 0x2b, 0x69, 0xb5, 0xdf, 0xf6, 0x6b, 0x61, 0xed, 0xf6, 0x05,
+	0x28, 0x91, 0x9e, 0x72, 0x08, 0xc3, 0xc6, 0x7e, 0x32, 0xa6, 0x48, 0x7e, 0x48, 0x43, 0x57, 0x85,
+	0xb4, 0xf6, 0x37, 0xbd, 0xda, 0xb5, 0x19, 0x5c, 0xa9, 0xf3, 0x08, 0xd0, 0xbe, 0x98, 0x8e, 0x4c,
+	0x7e, 0x56, 0x42, 0xd7, 0xd4, 0x41, 0xd6, 0xd4, 0xaf, 0x5e, 0xb5, 0xeb, 0xb3, 0xd8, 0x52, 0x6d,
+	0x1d, 0x56, 0xf6, 0x49, 0x2c, 0x7f, 0x74, 0x44, 0xc9, 0x14, 0x27, 0xfb, 0x03, 0x67, 0xad, 0x3a,
+	0xcd, 0x90, 0x4a, 0x9a, 0xb0, 0xe6, 0x08, 0xdb, 0x78, 0x24, 0xa3, 0xcb, 0xea, 0xc2, 0xa9, 0xdf,
+	0x50, 0x6a, 0x35, 0x1d, 0x4b, 0xaa, 0x3a, 0x84, 0x75, 0x27, 0x71, 0x9d, 0xd0, 0x55, 0x4b, 0xb9,
+	0x26, 0xad, 0xec, 0x8a, 0x96, 0xa7, 0x6a, 0xdb, 0x27, 0xb1, 0xfa, 0xfb, 0x80, 0xd4, 0xa6, 0xf9,
+	0xd9, 0x44, 0x6a, 0xd3, 0xfd, 0xa0, 0x60, 0xe6, 0x50, 0x0b, 0x0c, 0x7a, 0x2d, 0xa9, 0x23, 0x35,
+	0xa9, 0x4e, 0x33, 0x17, 0x96, 0xea, 0xb4, 0x33, 0xb8, 0x1c, 0xfa, 0x94, 0x6e, 0xd5, 0xef, 0x2b,
+	0x43, 0x1a, 0xe9, 0xb6, 0xe9, 0xe1, 0x9c, 0x74, 0x9b, 0x6e, 0xa6, 0x93, 0x43, 0x1d, 0x58, 0x4b,
+	0x0f, 0x6b, 0x64, 0xb8, 0x69, 0x87, 0x3d, 0x32, 0xdc, 0xf4, 0x13, 0x1e, 0xe6, 0xb9, 0xd5, 0xd4,
+	0x74, 0x06, 0x25, 0x9b, 0xd1, 0xcd, 0x76, 0x6a, 0x57, 0xf5, 0x4c, 0xa9, 0xed, 0x0c, 0xaa, 0xf4,
+	0x1c, 0x74, 0x9d, 0x35, 0x7a, 0x7f, 0x46, 0xf7, 0xac, 0xce, 0x19, 0x6a, 0xb7, 0xde, 0x0e, 0x52,
+	0xfc, 0x60, 0xec, 0x93, 0x38, 0x35, 0xe9, 0x91, 0x96, 0xeb, 0x46, 0x47, 0xd2, 0x72, 0xed, 0x70,
+	0xc8, 0xcc, 0xa1, 0xcf, 0xe0, 0x32, 0x3d, 0x24, 0x6d, 0x1b, 0x2b, 0x7d, 0xac, 0xe5, 0x4a, 0x1f,
+	0xcf, 0xe8, 0x74, 0x73, 0xb4, 0x99, 0x15, 0xd8, 0x54, 0x1b, 0x29, 0x0d, 0xd6, 0x35, 0x9d, 0xd2,
+	0x60, 0x7d, 0xe7, 0x99, 0x43, 0x0d, 0x58, 0x17, 0xd0, 0xa4, 0xdf, 0x44, 0xc9, 0x14, 0x32, 0xd3,
+	0x93, 0xd6, 0x2e, 0x4d, 0xd1, 0x95, 0x50, 0x47, 0x49, 0xf9, 0x32, 0xe9, 0x3b, 0x65, 0x78, 0x4e,
+	0x77, 0xad, 0x32, 0x3c, 0x75, 0x6d, 0x6a, 0x0e, 0x59, 0xb0, 0x26, 0x80, 0xa2, 0x3b, 0x45, 0xc9,
+	0x04, 0x3e, 0xdd, 0xbf, 0xd6, 0x2a, 0x59, 0xb2, 0xc6, 0x59, 0xa9, 0x8e, 0x50, 0x3a, 0x4b, 0xd7,
+	0xe3, 0x4a, 0x67, 0xe9, 0x1b, 0xdd, 0x1c, 0x72, 0xd9, 0x8d, 0xa0, 0x69, 0x31, 0xd1, 0x7b, 0x3a,
+	0xc9, 0x54, 0x23, 0x5c, 0x33, 0x67, 0x43, 0x94, 0x25, 0x3e, 0x86, 0x15, 0x61, 0x0d, 0x9b, 0xd3,
+	0xa1, 0x4d, 0x19, 0x15, 0x93, 0x49, 0x5f, 0xad, 0x9c, 0x26, 0xaa, 0x49, 0xdf, 0x21, 0x71, 0xa6,
+	0x3f, 0x95, 0x49, 0x5f, 0xdf, 0xfb, 0xca, 0xa4, 0x3f, 0xab, 0xad, 0xcd, 0xa1, 0x47, 0xb4, 0xd4,
+	0x94, 0x7d, 0xe9, 0x24, 0xeb, 0x4c, 0x35, 0xbb, 0x93, 0xac, 0x33, 0xdd, 0xc6, 0x9a, 0x39, 0xf4,
+	0x94, 0xf7, 0xb7, 0x99, 0xae, 0x4b, 0xda, 0xa7, 0xef, 0x4f, 0xa5, 0x7d, 0xb3, 0x9a, 0xb5, 0x1c,
+	0xea, 0xc2, 0xa6, 0xd8, 0x8c, 0xda, 0x72, 0xa1, 0xd4, 0xcd, 0x91, 0x6e, 0xe5, 0x64, 0xae, 0xd5,
+	0xf5, 0x68, 0x66, 0x0e, 0x3d, 0x87, 0x8a, 0xaa, 0x71, 0xd2, 0xcb, 0xa4, 0x6f, 0xd0, 0xa9, 0xd6,
+	0x2b, 0x7d, 0x83, 0x4e, 0xf7, 0x54, 0x66, 0x0e, 0xfd, 0x8a, 0x3b, 0x21, 0xd3, 0xac, 0xc8, 0x00,
+	0x9a, 0xdd, 0x1e, 0xc9, 0x00, 0x7a, 0x5b, 0xaf, 0x43, 0x9d, 0xb1, 0xc1, 0x6e, 0x1d, 0xb5, 0xcb,
+	0x90, 0x51, 0xaf, 0xeb, 0x83, 0x64, 0xd4, 0x6b, 0x1b, 0x9b, 0x89, 0xc6, 0x54, 0x77, 0x20, 0x35,
+	0xea, 0x7a, 0x17, 0xa9, 0x51, 0xdb, 0x50, 0x98, 0x39, 0xf4, 0x09, 0xac, 0x88, 0x09, 0x1a, 0xfb,
+	0x03, 0x2c, 0xf9, 0x75, 0xa7, 0xff, 0xa0, 0x4b, 0x7e, 0xdd, 0xd9, 0xbf, 0xd3, 0xca, 0x21, 0x02,
+	0x55, 0x6a, 0x92, 0x6e, 0x0c, 0x87, 0x12, 0x37, 0xbd, 0x65, 0x2e, 0x58, 0x7b, 0xff, 0x2d, 0x98,
+	0xc9, 0x32, 0x7b, 0xef, 0x7d, 0x76, 0xc3, 0x25, 0xf1, 0x4b, 0x12, 0x7e, 0x78, 0x1a, 0x84, 0xe4,
+	0x2e, 0x7f, 0xbe, 0xcb, 0xfe, 0xfe, 0x2c, 0xe2, 0x7f, 0xc3, 0x76, 0x32, 0xcf, 0xde, 0xee, 0xff,
+	0x2f, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xed, 0xd8, 0x2b, 0xd9, 0x26, 0x00, 0x00,
 }
@@ -185,6 +185,28 @@ function deserialize_feapi_BoardSignalResponse(buffer_arg) {
   return feapi_feapi_pb.BoardSignalResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_feapi_CancelInflightRequest(arg) {
+  if (!(arg instanceof feapi_feapi_pb.CancelInflightRequest)) {
+    throw new Error('Expected argument of type feapi.CancelInflightRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_CancelInflightRequest(buffer_arg) {
+  return feapi_feapi_pb.CancelInflightRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_feapi_CancelInflightResponse(arg) {
+  if (!(arg instanceof feapi_feapi_pb.CancelInflightResponse)) {
+    throw new Error('Expected argument of type feapi.CancelInflightResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_CancelInflightResponse(buffer_arg) {
+  return feapi_feapi_pb.CancelInflightResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_feapi_ClientVersionPayload(arg) {
   if (!(arg instanceof feapi_feapi_pb.ClientVersionPayload)) {
     throw new Error('Expected argument of type feapi.ClientVersionPayload');
@@ -229,6 +251,28 @@ function deserialize_feapi_ContentEventResponse(buffer_arg) {
   return feapi_feapi_pb.ContentEventResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_feapi_EntityHistoryRequest(arg) {
+  if (!(arg instanceof feapi_feapi_pb.EntityHistoryRequest)) {
+    throw new Error('Expected argument of type feapi.EntityHistoryRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_EntityHistoryRequest(buffer_arg) {
+  return feapi_feapi_pb.EntityHistoryRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_feapi_EntityHistoryResponse(arg) {
+  if (!(arg instanceof feapi_feapi_pb.EntityHistoryResponse)) {
+    throw new Error('Expected argument of type feapi.EntityHistoryResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_EntityHistoryResponse(buffer_arg) {
+  return feapi_feapi_pb.EntityHistoryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_feapi_FEConfigChangesPayload(arg) {
   if (!(arg instanceof feapi_feapi_pb.FEConfigChangesPayload)) {
     throw new Error('Expected argument of type feapi.FEConfigChangesPayload');
@@ -273,6 +317,28 @@ function deserialize_feapi_HomeViewResponse(buffer_arg) {
   return feapi_feapi_pb.HomeViewResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_feapi_InboxRequest(arg) {
+  if (!(arg instanceof feapi_feapi_pb.InboxRequest)) {
+    throw new Error('Expected argument of type feapi.InboxRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_InboxRequest(buffer_arg) {
+  return feapi_feapi_pb.InboxRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_feapi_InboxResponse(arg) {
+  if (!(arg instanceof feapi_feapi_pb.InboxResponse)) {
+    throw new Error('Expected argument of type feapi.InboxResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_InboxResponse(buffer_arg) {
+  return feapi_feapi_pb.InboxResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_feapi_InflightsPruneRequest(arg) {
   if (!(arg instanceof feapi_feapi_pb.InflightsPruneRequest)) {
     throw new Error('Expected argument of type feapi.InflightsPruneRequest');
@@ -405,6 +471,28 @@ function deserialize_feapi_PopularViewResponse(buffer_arg) {
   return feapi_feapi_pb.PopularViewResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_feapi_RetryInflightRequest(arg) {
+  if (!(arg instanceof feapi_feapi_pb.RetryInflightRequest)) {
+    throw new Error('Expected argument of type feapi.RetryInflightRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_RetryInflightRequest(buffer_arg) {
+  return feapi_feapi_pb.RetryInflightRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_feapi_RetryInflightResponse(arg) {
+  if (!(arg instanceof feapi_feapi_pb.RetryInflightResponse)) {
+    throw new Error('Expected argument of type feapi.RetryInflightResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_RetryInflightResponse(buffer_arg) {
+  return feapi_feapi_pb.RetryInflightResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_feapi_SearchRequestPayload(arg) {
   if (!(arg instanceof feapi_feapi_pb.SearchRequestPayload)) {
     throw new Error('Expected argument of type feapi.SearchRequestPayload');
@@ -537,6 +625,28 @@ function deserialize_feapi_ThreadAndPostsResponse(buffer_arg) {
   return feapi_feapi_pb.ThreadAndPostsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_feapi_ThreadSignalRequest(arg) {
+  if (!(arg instanceof feapi_feapi_pb.ThreadSignalRequest)) {
+    throw new Error('Expected argument of type feapi.ThreadSignalRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_ThreadSignalRequest(buffer_arg) {
+  return feapi_feapi_pb.ThreadSignalRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_feapi_ThreadSignalResponse(arg) {
+  if (!(arg instanceof feapi_feapi_pb.ThreadSignalResponse)) {
+    throw new Error('Expected argument of type feapi.ThreadSignalResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_feapi_ThreadSignalResponse(buffer_arg) {
+  return feapi_feapi_pb.ThreadSignalResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_feapi_UncompiledEntityByKeyRequest(arg) {
   if (!(arg instanceof feapi_feapi_pb.UncompiledEntityByKeyRequest)) {
     throw new Error('Expected argument of type feapi.UncompiledEntityByKeyRequest');
@@ -640,6 +750,17 @@ var FrontendAPIService = exports.FrontendAPIService = {
     responseSerialize: serialize_feapi_BoardSignalResponse,
     responseDeserialize: deserialize_feapi_BoardSignalResponse,
   },
+  setThreadSignal: {
+    path: '/feapi.FrontendAPI/SetThreadSignal',
+    requestStream: false,
+    responseStream: false,
+    requestType: feapi_feapi_pb.ThreadSignalRequest,
+    responseType: feapi_feapi_pb.ThreadSignalResponse,
+    requestSerialize: serialize_feapi_ThreadSignalRequest,
+    requestDeserialize: deserialize_feapi_ThreadSignalRequest,
+    responseSerialize: serialize_feapi_ThreadSignalResponse,
+    responseDeserialize: deserialize_feapi_ThreadSignalResponse,
+  },
   getUserAndGraph: {
     path: '/feapi.FrontendAPI/GetUserAndGraph',
     requestStream: false,
@@ -673,6 +794,28 @@ var FrontendAPIService = exports.FrontendAPIService = {
     responseSerialize: serialize_feapi_SignalEventResponse,
     responseDeserialize: deserialize_feapi_SignalEventResponse,
   },
+  cancelInflight: {
+    path: '/feapi.FrontendAPI/CancelInflight',
+    requestStream: false,
+    responseStream: false,
+    requestType: feapi_feapi_pb.CancelInflightRequest,
+    responseType: feapi_feapi_pb.CancelInflightResponse,
+    requestSerialize: serialize_feapi_CancelInflightRequest,
+    requestDeserialize: deserialize_feapi_CancelInflightRequest,
+    responseSerialize: serialize_feapi_CancelInflightResponse,
+    responseDeserialize: deserialize_feapi_CancelInflightResponse,
+  },
+  retryInflight: {
+    path: '/feapi.FrontendAPI/RetryInflight',
+    requestStream: false,
+    responseStream: false,
+    requestType: feapi_feapi_pb.RetryInflightRequest,
+    responseType: feapi_feapi_pb.RetryInflightResponse,
+    requestSerialize: serialize_feapi_RetryInflightRequest,
+    requestDeserialize: deserialize_feapi_RetryInflightRequest,
+    responseSerialize: serialize_feapi_RetryInflightResponse,
+    responseDeserialize: deserialize_feapi_RetryInflightResponse,
+  },
   getUncompiledEntityByKey: {
     path: '/feapi.FrontendAPI/GetUncompiledEntityByKey',
     requestStream: false,
@@ -684,6 +827,17 @@ var FrontendAPIService = exports.FrontendAPIService = {
     responseSerialize: serialize_feapi_UncompiledEntityByKeyResponse,
     responseDeserialize: deserialize_feapi_UncompiledEntityByKeyResponse,
   },
+  getEntityHistory: {
+    path: '/feapi.FrontendAPI/GetEntityHistory',
+    requestStream: false,
+    responseStream: false,
+    requestType: feapi_feapi_pb.EntityHistoryRequest,
+    responseType: feapi_feapi_pb.EntityHistoryResponse,
+    requestSerialize: serialize_feapi_EntityHistoryRequest,
+    requestDeserialize: deserialize_feapi_EntityHistoryRequest,
+    responseSerialize: serialize_feapi_EntityHistoryResponse,
+    responseDeserialize: deserialize_feapi_EntityHistoryResponse,
+  },
   sendInflightsPruneRequest: {
     path: '/feapi.FrontendAPI/SendInflightsPruneRequest',
     requestStream: false,
@@ -762,6 +916,17 @@ var FrontendAPIService = exports.FrontendAPIService = {
     responseSerialize: serialize_feapi_NotificationsSignalResponse,
     responseDeserialize: deserialize_feapi_NotificationsSignalResponse,
   },
+  requestInbox: {
+    path: '/feapi.FrontendAPI/RequestInbox',
+    requestStream: false,
+    responseStream: false,
+    requestType: feapi_feapi_pb.InboxRequest,
+    responseType: feapi_feapi_pb.InboxResponse,
+    requestSerialize: serialize_feapi_InboxRequest,
+    requestDeserialize: deserialize_feapi_InboxRequest,
+    responseSerialize: serialize_feapi_InboxResponse,
+    responseDeserialize: deserialize_feapi_InboxResponse,
+  },
   // rpc RequestOnboardCompleteStatus(OnboardCompleteStatusRequest) returns (OnboardCompleteStatusResponse) {}
   setOnboardComplete: {
     path: '/feapi.FrontendAPI/SetOnboardComplete',
@@ -365,6 +365,61 @@ proto.feapi.FrontendAPIPromiseClient.prototype.setBoardSignal =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.feapi.ThreadSignalRequest,
+ *   !proto.feapi.ThreadSignalResponse>}
+ */
+const methodInfo_FrontendAPI_SetThreadSignal = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.feapi.ThreadSignalResponse,
+  /** @param {!proto.feapi.ThreadSignalRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.feapi.ThreadSignalResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.feapi.ThreadSignalRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.feapi.ThreadSignalResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.feapi.ThreadSignalResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.feapi.FrontendAPIClient.prototype.setThreadSignal =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/feapi.FrontendAPI/SetThreadSignal',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_SetThreadSignal,
+      callback);
+};
+
+
+/**
+ * @param {!proto.feapi.ThreadSignalRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.feapi.ThreadSignalResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.feapi.FrontendAPIPromiseClient.prototype.setThreadSignal =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/feapi.FrontendAPI/SetThreadSignal',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_SetThreadSignal);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.AbstractClientBase.MethodInfo<
@@ -530,6 +585,116 @@ proto.feapi.FrontendAPIPromiseClient.prototype.sendSignalEvent =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.feapi.CancelInflightRequest,
+ *   !proto.feapi.CancelInflightResponse>}
+ */
+const methodInfo_FrontendAPI_CancelInflight = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.feapi.CancelInflightResponse,
+  /** @param {!proto.feapi.CancelInflightRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.feapi.CancelInflightResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.feapi.CancelInflightRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.feapi.CancelInflightResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.feapi.CancelInflightResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.feapi.FrontendAPIClient.prototype.cancelInflight =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/feapi.FrontendAPI/CancelInflight',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_CancelInflight,
+      callback);
+};
+
+
+/**
+ * @param {!proto.feapi.CancelInflightRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.feapi.CancelInflightResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.feapi.FrontendAPIPromiseClient.prototype.cancelInflight =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/feapi.FrontendAPI/CancelInflight',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_CancelInflight);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.feapi.RetryInflightRequest,
+ *   !proto.feapi.RetryInflightResponse>}
+ */
+const methodInfo_FrontendAPI_RetryInflight = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.feapi.RetryInflightResponse,
+  /** @param {!proto.feapi.RetryInflightRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.feapi.RetryInflightResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.feapi.RetryInflightRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.feapi.RetryInflightResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.feapi.RetryInflightResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.feapi.FrontendAPIClient.prototype.retryInflight =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/feapi.FrontendAPI/RetryInflight',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_RetryInflight,
+      callback);
+};
+
+
+/**
+ * @param {!proto.feapi.RetryInflightRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.feapi.RetryInflightResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.feapi.FrontendAPIPromiseClient.prototype.retryInflight =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/feapi.FrontendAPI/RetryInflight',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_RetryInflight);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.AbstractClientBase.MethodInfo<
@@ -585,6 +750,61 @@ proto.feapi.FrontendAPIPromiseClient.prototype.getUncompiledEntityByKey =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.feapi.EntityHistoryRequest,
+ *   !proto.feapi.EntityHistoryResponse>}
+ */
+const methodInfo_FrontendAPI_GetEntityHistory = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.feapi.EntityHistoryResponse,
+  /** @param {!proto.feapi.EntityHistoryRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.feapi.EntityHistoryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.feapi.EntityHistoryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.feapi.EntityHistoryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.feapi.EntityHistoryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.feapi.FrontendAPIClient.prototype.getEntityHistory =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/feapi.FrontendAPI/GetEntityHistory',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_GetEntityHistory,
+      callback);
+};
+
+
+/**
+ * @param {!proto.feapi.EntityHistoryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.feapi.EntityHistoryResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.feapi.FrontendAPIPromiseClient.prototype.getEntityHistory =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/feapi.FrontendAPI/GetEntityHistory',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_GetEntityHistory);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.AbstractClientBase.MethodInfo<
@@ -970,6 +1190,61 @@ proto.feapi.FrontendAPIPromiseClient.prototype.setNotificationsSignal =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.AbstractClientBase.MethodInfo<
+ *   !proto.feapi.InboxRequest,
+ *   !proto.feapi.InboxResponse>}
+ */
+const methodInfo_FrontendAPI_RequestInbox = new grpc.web.AbstractClientBase.MethodInfo(
+  proto.feapi.InboxResponse,
+  /** @param {!proto.feapi.InboxRequest} request */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.feapi.InboxResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.feapi.InboxRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.Error, ?proto.feapi.InboxResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.feapi.InboxResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.feapi.FrontendAPIClient.prototype.requestInbox =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/feapi.FrontendAPI/RequestInbox',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_RequestInbox,
+      callback);
+};
+
+
+/**
+ * @param {!proto.feapi.InboxRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.feapi.InboxResponse>}
+ *     A native promise that resolves to the response
+ */
+proto.feapi.FrontendAPIPromiseClient.prototype.requestInbox =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/feapi.FrontendAPI/RequestInbox',
+      request,
+      metadata || {},
+      methodInfo_FrontendAPI_RequestInbox);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.AbstractClientBase.MethodInfo<
@@ -23,22 +23,31 @@ goog.exportSymbol('proto.feapi.BackendAmbientStatusPayload', null, global);
 goog.exportSymbol('proto.feapi.BackendAmbientStatusResponse', null, global);
 goog.exportSymbol('proto.feapi.BoardAndThreadsRequest', null, global);
 goog.exportSymbol('proto.feapi.BoardAndThreadsResponse', null, global);
+goog.exportSymbol('proto.feapi.BoardKeyAction', null, global);
+goog.exportSymbol('proto.feapi.BoardKeyRequest', null, global);
 goog.exportSymbol('proto.feapi.BoardModActionsRequest', null, global);
 goog.exportSymbol('proto.feapi.BoardModActionsResponse', null, global);
+goog.exportSymbol('proto.feapi.BoardPoWRequest', null, global);
 goog.exportSymbol('proto.feapi.BoardReportsRequest', null, global);
 goog.exportSymbol('proto.feapi.BoardReportsResponse', null, global);
 goog.exportSymbol('proto.feapi.BoardSignalRequest', null, global);
 goog.exportSymbol('proto.feapi.BoardSignalResponse', null, global);
+goog.exportSymbol('proto.feapi.CancelInflightRequest', null, global);
+goog.exportSymbol('proto.feapi.CancelInflightResponse', null, global);
 goog.exportSymbol('proto.feapi.ClientVersionPayload', null, global);
 goog.exportSymbol('proto.feapi.ClientVersionResponse', null, global);
 goog.exportSymbol('proto.feapi.ContentEventPayload', null, global);
 goog.exportSymbol('proto.feapi.ContentEventResponse', null, global);
+goog.exportSymbol('proto.feapi.EntityHistoryRequest', null, global);
+goog.exportSymbol('proto.feapi.EntityHistoryResponse', null, global);
 goog.exportSymbol('proto.feapi.Event', null, global);
 goog.exportSymbol('proto.feapi.EventType', null, global);
 goog.exportSymbol('proto.feapi.FEConfigChangesPayload', null, global);
 goog.exportSymbol('proto.feapi.FEConfigChangesResponse', null, global);
 goog.exportSymbol('proto.feapi.HomeViewRequest', null, global);
 goog.exportSymbol('proto.feapi.HomeViewResponse', null, global);
+goog.exportSymbol('proto.feapi.InboxRequest', null, global);
+goog.exportSymbol('proto.feapi.InboxResponse', null, global);
 goog.exportSymbol('proto.feapi.InflightsPruneRequest', null, global);
 goog.exportSymbol('proto.feapi.InflightsPruneResponse', null, global);
 goog.exportSymbol('proto.feapi.NewViewRequest', null, global);
@@ -51,6 +60,8 @@ goog.exportSymbol('proto.feapi.OnboardCompleteRequest', null, global);
 goog.exportSymbol('proto.feapi.OnboardCompleteResponse', null, global);
 goog.exportSymbol('proto.feapi.PopularViewRequest', null, global);
 goog.exportSymbol('proto.feapi.PopularViewResponse', null, global);
+goog.exportSymbol('proto.feapi.RetryInflightRequest', null, global);
+goog.exportSymbol('proto.feapi.RetryInflightResponse', null, global);
 goog.exportSymbol('proto.feapi.SearchRequestPayload', null, global);
 goog.exportSymbol('proto.feapi.SearchRequestResponse', null, global);
 goog.exportSymbol('proto.feapi.SendAddressPayload', null, global);
@@ -66,6 +77,8 @@ goog.exportSymbol('proto.feapi.SignalType', null, global);
 goog.exportSymbol('proto.feapi.SignalTypeClass', null, global);
 goog.exportSymbol('proto.feapi.ThreadAndPostsRequest', null, global);
 goog.exportSymbol('proto.feapi.ThreadAndPostsResponse', null, global);
+goog.exportSymbol('proto.feapi.ThreadSignalRequest', null, global);
+goog.exportSymbol('proto.feapi.ThreadSignalResponse', null, global);
 goog.exportSymbol('proto.feapi.UncompiledEntityByKeyRequest', null, global);
 goog.exportSymbol('proto.feapi.UncompiledEntityByKeyResponse', null, global);
 goog.exportSymbol('proto.feapi.UncompiledEntityType', null, global);
@@ -1371,7 +1384,8 @@ proto.feapi.BoardAndThreadsRequest.prototype.toObject = function(opt_includeInst
 proto.feapi.BoardAndThreadsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     boardfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sortthreadsbynew: jspb.Message.getFieldWithDefault(msg, 2, false)
+    sortthreadsbynew: jspb.Message.getFieldWithDefault(msg, 2, false),
+    sort: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -1416,6 +1430,10 @@ proto.feapi.BoardAndThreadsRequest.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setSortthreadsbynew(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSort(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1459,6 +1477,13 @@ proto.feapi.BoardAndThreadsRequest.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getSort();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1494,6 +1519,21 @@ proto.feapi.BoardAndThreadsRequest.prototype.setSortthreadsbynew = function(valu
 };
 
 
+/**
+ * optional string Sort = 3;
+ * @return {string}
+ */
+proto.feapi.BoardAndThreadsRequest.prototype.getSort = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.BoardAndThreadsRequest.prototype.setSort = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1550,7 +1590,8 @@ proto.feapi.BoardAndThreadsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     board: (f = msg.getBoard()) && feobjects_feobjects_pb.CompiledBoardEntity.toObject(includeInstance, f),
     threadsList: jspb.Message.toObjectList(msg.getThreadsList(),
-    feobjects_feobjects_pb.CompiledThreadEntity.toObject, includeInstance)
+    feobjects_feobjects_pb.CompiledThreadEntity.toObject, includeInstance),
+    sort: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -1597,6 +1638,10 @@ proto.feapi.BoardAndThreadsResponse.deserializeBinaryFromReader = function(msg,
       reader.readMessage(value,feobjects_feobjects_pb.CompiledThreadEntity.deserializeBinaryFromReader);
       msg.addThreads(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSort(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1642,6 +1687,13 @@ proto.feapi.BoardAndThreadsResponse.serializeBinaryToWriter = function(message,
       feobjects_feobjects_pb.CompiledThreadEntity.serializeBinaryToWriter
     );
   }
+  f = message.getSort();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1706,6 +1758,21 @@ proto.feapi.BoardAndThreadsResponse.prototype.clearThreadsList = function() {
 };
 
 
+/**
+ * optional string Sort = 3;
+ * @return {string}
+ */
+proto.feapi.BoardAndThreadsResponse.prototype.getSort = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.BoardAndThreadsResponse.prototype.setSort = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1757,7 +1824,9 @@ proto.feapi.BoardSignalRequest.toObject = function(includeInstance, msg) {
     subscribed: jspb.Message.getFieldWithDefault(msg, 2, false),
     notify: jspb.Message.getFieldWithDefault(msg, 3, false),
     lastseen: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    lastseenonly: jspb.Message.getFieldWithDefault(msg, 5, false)
+    lastseenonly: jspb.Message.getFieldWithDefault(msg, 5, false),
+    ranking: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    rankingonly: jspb.Message.getFieldWithDefault(msg, 7, false)
   };
 
   if (includeInstance) {
@@ -1814,6 +1883,14 @@ proto.feapi.BoardSignalRequest.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setLastseenonly(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRanking(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRankingonly(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1878,6 +1955,20 @@ proto.feapi.BoardSignalRequest.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getRanking();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRankingonly();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -1962,6 +2053,38 @@ proto.feapi.BoardSignalRequest.prototype.setLastseenonly = function(value) {
 };
 
 
+/**
+ * optional string Ranking = 6;
+ * @return {string}
+ */
+proto.feapi.BoardSignalRequest.prototype.getRanking = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.BoardSignalRequest.prototype.setRanking = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional bool RankingOnly = 7;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.BoardSignalRequest.prototype.getRankingonly = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 7, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.BoardSignalRequest.prototype.setRankingonly = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -2117,12 +2240,12 @@ proto.feapi.BoardSignalResponse.prototype.setCommitted = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.UserAndGraphRequest = function(opt_data) {
+proto.feapi.ThreadSignalRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feapi.UserAndGraphRequest, jspb.Message);
+goog.inherits(proto.feapi.ThreadSignalRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.UserAndGraphRequest.displayName = 'proto.feapi.UserAndGraphRequest';
+  proto.feapi.ThreadSignalRequest.displayName = 'proto.feapi.ThreadSignalRequest';
 }
 
 
@@ -2137,8 +2260,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.UserAndGraphRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.UserAndGraphRequest.toObject(opt_includeInstance, this);
+proto.feapi.ThreadSignalRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.ThreadSignalRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2147,17 +2270,15 @@ proto.feapi.UserAndGraphRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.UserAndGraphRequest} msg The msg instance to transform.
+ * @param {!proto.feapi.ThreadSignalRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.UserAndGraphRequest.toObject = function(includeInstance, msg) {
+proto.feapi.ThreadSignalRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    userentityrequested: jspb.Message.getFieldWithDefault(msg, 2, false),
-    userboardsrequested: jspb.Message.getFieldWithDefault(msg, 6, false),
-    userthreadsrequested: jspb.Message.getFieldWithDefault(msg, 4, false),
-    userpostsrequested: jspb.Message.getFieldWithDefault(msg, 5, false)
+    subscribed: jspb.Message.getFieldWithDefault(msg, 2, false),
+    notify: jspb.Message.getFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -2171,23 +2292,23 @@ proto.feapi.UserAndGraphRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.UserAndGraphRequest}
+ * @return {!proto.feapi.ThreadSignalRequest}
  */
-proto.feapi.UserAndGraphRequest.deserializeBinary = function(bytes) {
+proto.feapi.ThreadSignalRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.UserAndGraphRequest;
-  return proto.feapi.UserAndGraphRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.ThreadSignalRequest;
+  return proto.feapi.ThreadSignalRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.UserAndGraphRequest} msg The message object to deserialize into.
+ * @param {!proto.feapi.ThreadSignalRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.UserAndGraphRequest}
+ * @return {!proto.feapi.ThreadSignalRequest}
  */
-proto.feapi.UserAndGraphRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.ThreadSignalRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2200,19 +2321,11 @@ proto.feapi.UserAndGraphRequest.deserializeBinaryFromReader = function(msg, read
       break;
     case 2:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserentityrequested(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserboardsrequested(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserthreadsrequested(value);
+      msg.setSubscribed(value);
       break;
-    case 5:
+    case 3:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserpostsrequested(value);
+      msg.setNotify(value);
       break;
     default:
       reader.skipField();
@@ -2227,9 +2340,9 @@ proto.feapi.UserAndGraphRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feapi.UserAndGraphRequest.prototype.serializeBinary = function() {
+proto.feapi.ThreadSignalRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feapi.UserAndGraphRequest.serializeBinaryToWriter(this, writer);
+  proto.feapi.ThreadSignalRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2237,11 +2350,11 @@ proto.feapi.UserAndGraphRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.UserAndGraphRequest} message
+ * @param {!proto.feapi.ThreadSignalRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.UserAndGraphRequest.serializeBinaryToWriter = function(message, writer) {
+proto.feapi.ThreadSignalRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getFingerprint();
   if (f.length > 0) {
@@ -2250,31 +2363,17 @@ proto.feapi.UserAndGraphRequest.serializeBinaryToWriter = function(message, writ
       f
     );
   }
-  f = message.getUserentityrequested();
+  f = message.getSubscribed();
   if (f) {
     writer.writeBool(
       2,
       f
     );
   }
-  f = message.getUserboardsrequested();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getUserthreadsrequested();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getUserpostsrequested();
+  f = message.getNotify();
   if (f) {
     writer.writeBool(
-      5,
+      3,
       f
     );
   }
@@ -2285,82 +2384,48 @@ proto.feapi.UserAndGraphRequest.serializeBinaryToWriter = function(message, writ
  * optional string Fingerprint = 1;
  * @return {string}
  */
-proto.feapi.UserAndGraphRequest.prototype.getFingerprint = function() {
+proto.feapi.ThreadSignalRequest.prototype.getFingerprint = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feapi.UserAndGraphRequest.prototype.setFingerprint = function(value) {
+proto.feapi.ThreadSignalRequest.prototype.setFingerprint = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional bool UserEntityRequested = 2;
+ * optional bool Subscribed = 2;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feapi.UserAndGraphRequest.prototype.getUserentityrequested = function() {
+proto.feapi.ThreadSignalRequest.prototype.getSubscribed = function() {
   return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 2, false));
 };
 
 
 /** @param {boolean} value */
-proto.feapi.UserAndGraphRequest.prototype.setUserentityrequested = function(value) {
+proto.feapi.ThreadSignalRequest.prototype.setSubscribed = function(value) {
   jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional bool UserBoardsRequested = 6;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
- */
-proto.feapi.UserAndGraphRequest.prototype.getUserboardsrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
-};
-
-
-/** @param {boolean} value */
-proto.feapi.UserAndGraphRequest.prototype.setUserboardsrequested = function(value) {
-  jspb.Message.setField(this, 6, value);
-};
-
-
-/**
- * optional bool UserThreadsRequested = 4;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
- */
-proto.feapi.UserAndGraphRequest.prototype.getUserthreadsrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
-};
-
-
-/** @param {boolean} value */
-proto.feapi.UserAndGraphRequest.prototype.setUserthreadsrequested = function(value) {
-  jspb.Message.setField(this, 4, value);
-};
-
-
-/**
- * optional bool UserPostsRequested = 5;
+ * optional bool Notify = 3;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feapi.UserAndGraphRequest.prototype.getUserpostsrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+proto.feapi.ThreadSignalRequest.prototype.getNotify = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
 };
 
 
 /** @param {boolean} value */
-proto.feapi.UserAndGraphRequest.prototype.setUserpostsrequested = function(value) {
-  jspb.Message.setField(this, 5, value);
+proto.feapi.ThreadSignalRequest.prototype.setNotify = function(value) {
+  jspb.Message.setField(this, 3, value);
 };
 
 
@@ -2375,20 +2440,13 @@ proto.feapi.UserAndGraphRequest.prototype.setUserpostsrequested = function(value
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.UserAndGraphResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.feapi.UserAndGraphResponse.repeatedFields_, null);
+proto.feapi.ThreadSignalResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feapi.UserAndGraphResponse, jspb.Message);
+goog.inherits(proto.feapi.ThreadSignalResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.UserAndGraphResponse.displayName = 'proto.feapi.UserAndGraphResponse';
+  proto.feapi.ThreadSignalResponse.displayName = 'proto.feapi.ThreadSignalResponse';
 }
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.feapi.UserAndGraphResponse.repeatedFields_ = [2,3,4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2402,8 +2460,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.UserAndGraphResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.UserAndGraphResponse.toObject(opt_includeInstance, this);
+proto.feapi.ThreadSignalResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.ThreadSignalResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2412,23 +2470,13 @@ proto.feapi.UserAndGraphResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.UserAndGraphResponse} msg The msg instance to transform.
+ * @param {!proto.feapi.ThreadSignalResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.UserAndGraphResponse.toObject = function(includeInstance, msg) {
+proto.feapi.ThreadSignalResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    user: (f = msg.getUser()) && feobjects_feobjects_pb.CompiledUserEntity.toObject(includeInstance, f),
-    boardsList: jspb.Message.toObjectList(msg.getBoardsList(),
-    feobjects_feobjects_pb.CompiledBoardEntity.toObject, includeInstance),
-    threadsList: jspb.Message.toObjectList(msg.getThreadsList(),
-    feobjects_feobjects_pb.CompiledThreadEntity.toObject, includeInstance),
-    postsList: jspb.Message.toObjectList(msg.getPostsList(),
-    feobjects_feobjects_pb.CompiledPostEntity.toObject, includeInstance),
-    userentityrequested: jspb.Message.getFieldWithDefault(msg, 5, false),
-    userboardsrequested: jspb.Message.getFieldWithDefault(msg, 6, false),
-    userthreadsrequested: jspb.Message.getFieldWithDefault(msg, 7, false),
-    userpostsrequested: jspb.Message.getFieldWithDefault(msg, 8, false)
+    committed: jspb.Message.getFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -2442,23 +2490,23 @@ proto.feapi.UserAndGraphResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.UserAndGraphResponse}
+ * @return {!proto.feapi.ThreadSignalResponse}
  */
-proto.feapi.UserAndGraphResponse.deserializeBinary = function(bytes) {
+proto.feapi.ThreadSignalResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.UserAndGraphResponse;
-  return proto.feapi.UserAndGraphResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.ThreadSignalResponse;
+  return proto.feapi.ThreadSignalResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.UserAndGraphResponse} msg The message object to deserialize into.
+ * @param {!proto.feapi.ThreadSignalResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.UserAndGraphResponse}
+ * @return {!proto.feapi.ThreadSignalResponse}
  */
-proto.feapi.UserAndGraphResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.ThreadSignalResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2466,40 +2514,2129 @@ proto.feapi.UserAndGraphResponse.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new feobjects_feobjects_pb.CompiledUserEntity;
-      reader.readMessage(value,feobjects_feobjects_pb.CompiledUserEntity.deserializeBinaryFromReader);
-      msg.setUser(value);
-      break;
-    case 2:
-      var value = new feobjects_feobjects_pb.CompiledBoardEntity;
-      reader.readMessage(value,feobjects_feobjects_pb.CompiledBoardEntity.deserializeBinaryFromReader);
-      msg.addBoards(value);
-      break;
-    case 3:
-      var value = new feobjects_feobjects_pb.CompiledThreadEntity;
-      reader.readMessage(value,feobjects_feobjects_pb.CompiledThreadEntity.deserializeBinaryFromReader);
-      msg.addThreads(value);
-      break;
-    case 4:
-      var value = new feobjects_feobjects_pb.CompiledPostEntity;
-      reader.readMessage(value,feobjects_feobjects_pb.CompiledPostEntity.deserializeBinaryFromReader);
-      msg.addPosts(value);
-      break;
-    case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserentityrequested(value);
+      msg.setCommitted(value);
       break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserboardsrequested(value);
+    default:
+      reader.skipField();
       break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.ThreadSignalResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.ThreadSignalResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.ThreadSignalResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.ThreadSignalResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCommitted();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool Committed = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.ThreadSignalResponse.prototype.getCommitted = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.ThreadSignalResponse.prototype.setCommitted = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.UserAndGraphRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.UserAndGraphRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.UserAndGraphRequest.displayName = 'proto.feapi.UserAndGraphRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.UserAndGraphRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.UserAndGraphRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.UserAndGraphRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.UserAndGraphRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userentityrequested: jspb.Message.getFieldWithDefault(msg, 2, false),
+    userboardsrequested: jspb.Message.getFieldWithDefault(msg, 6, false),
+    userthreadsrequested: jspb.Message.getFieldWithDefault(msg, 4, false),
+    userpostsrequested: jspb.Message.getFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.UserAndGraphRequest}
+ */
+proto.feapi.UserAndGraphRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.UserAndGraphRequest;
+  return proto.feapi.UserAndGraphRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.UserAndGraphRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.UserAndGraphRequest}
+ */
+proto.feapi.UserAndGraphRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFingerprint(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserentityrequested(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserboardsrequested(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
       msg.setUserthreadsrequested(value);
       break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserpostsrequested(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.UserAndGraphRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.UserAndGraphRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.UserAndGraphRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.UserAndGraphRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserentityrequested();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getUserboardsrequested();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getUserthreadsrequested();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getUserpostsrequested();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Fingerprint = 1;
+ * @return {string}
+ */
+proto.feapi.UserAndGraphRequest.prototype.getFingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.UserAndGraphRequest.prototype.setFingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional bool UserEntityRequested = 2;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphRequest.prototype.getUserentityrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 2, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphRequest.prototype.setUserentityrequested = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional bool UserBoardsRequested = 6;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphRequest.prototype.getUserboardsrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphRequest.prototype.setUserboardsrequested = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional bool UserThreadsRequested = 4;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphRequest.prototype.getUserthreadsrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphRequest.prototype.setUserthreadsrequested = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * optional bool UserPostsRequested = 5;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphRequest.prototype.getUserpostsrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphRequest.prototype.setUserpostsrequested = function(value) {
+  jspb.Message.setField(this, 5, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.UserAndGraphResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feapi.UserAndGraphResponse.repeatedFields_, null);
+};
+goog.inherits(proto.feapi.UserAndGraphResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.UserAndGraphResponse.displayName = 'proto.feapi.UserAndGraphResponse';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feapi.UserAndGraphResponse.repeatedFields_ = [2,3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.UserAndGraphResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.UserAndGraphResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.UserAndGraphResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.UserAndGraphResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    user: (f = msg.getUser()) && feobjects_feobjects_pb.CompiledUserEntity.toObject(includeInstance, f),
+    boardsList: jspb.Message.toObjectList(msg.getBoardsList(),
+    feobjects_feobjects_pb.CompiledBoardEntity.toObject, includeInstance),
+    threadsList: jspb.Message.toObjectList(msg.getThreadsList(),
+    feobjects_feobjects_pb.CompiledThreadEntity.toObject, includeInstance),
+    postsList: jspb.Message.toObjectList(msg.getPostsList(),
+    feobjects_feobjects_pb.CompiledPostEntity.toObject, includeInstance),
+    userentityrequested: jspb.Message.getFieldWithDefault(msg, 5, false),
+    userboardsrequested: jspb.Message.getFieldWithDefault(msg, 6, false),
+    userthreadsrequested: jspb.Message.getFieldWithDefault(msg, 7, false),
+    userpostsrequested: jspb.Message.getFieldWithDefault(msg, 8, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.UserAndGraphResponse}
+ */
+proto.feapi.UserAndGraphResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.UserAndGraphResponse;
+  return proto.feapi.UserAndGraphResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.UserAndGraphResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.UserAndGraphResponse}
+ */
+proto.feapi.UserAndGraphResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new feobjects_feobjects_pb.CompiledUserEntity;
+      reader.readMessage(value,feobjects_feobjects_pb.CompiledUserEntity.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    case 2:
+      var value = new feobjects_feobjects_pb.CompiledBoardEntity;
+      reader.readMessage(value,feobjects_feobjects_pb.CompiledBoardEntity.deserializeBinaryFromReader);
+      msg.addBoards(value);
+      break;
+    case 3:
+      var value = new feobjects_feobjects_pb.CompiledThreadEntity;
+      reader.readMessage(value,feobjects_feobjects_pb.CompiledThreadEntity.deserializeBinaryFromReader);
+      msg.addThreads(value);
+      break;
+    case 4:
+      var value = new feobjects_feobjects_pb.CompiledPostEntity;
+      reader.readMessage(value,feobjects_feobjects_pb.CompiledPostEntity.deserializeBinaryFromReader);
+      msg.addPosts(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserentityrequested(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserboardsrequested(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserthreadsrequested(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUserpostsrequested(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.UserAndGraphResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.UserAndGraphResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.UserAndGraphResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.UserAndGraphResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      feobjects_feobjects_pb.CompiledUserEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getBoardsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      feobjects_feobjects_pb.CompiledBoardEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getThreadsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      feobjects_feobjects_pb.CompiledThreadEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getPostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      feobjects_feobjects_pb.CompiledPostEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getUserentityrequested();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getUserboardsrequested();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getUserthreadsrequested();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getUserpostsrequested();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional feobjects.CompiledUserEntity User = 1;
+ * @return {?proto.feobjects.CompiledUserEntity}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getUser = function() {
+  return /** @type{?proto.feobjects.CompiledUserEntity} */ (
+    jspb.Message.getWrapperField(this, feobjects_feobjects_pb.CompiledUserEntity, 1));
+};
+
+
+/** @param {?proto.feobjects.CompiledUserEntity|undefined} value */
+proto.feapi.UserAndGraphResponse.prototype.setUser = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.feapi.UserAndGraphResponse.prototype.clearUser = function() {
+  this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.UserAndGraphResponse.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated feobjects.CompiledBoardEntity Boards = 2;
+ * @return {!Array.<!proto.feobjects.CompiledBoardEntity>}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getBoardsList = function() {
+  return /** @type{!Array.<!proto.feobjects.CompiledBoardEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledBoardEntity, 2));
+};
+
+
+/** @param {!Array.<!proto.feobjects.CompiledBoardEntity>} value */
+proto.feapi.UserAndGraphResponse.prototype.setBoardsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.CompiledBoardEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.CompiledBoardEntity}
+ */
+proto.feapi.UserAndGraphResponse.prototype.addBoards = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.feobjects.CompiledBoardEntity, opt_index);
+};
+
+
+proto.feapi.UserAndGraphResponse.prototype.clearBoardsList = function() {
+  this.setBoardsList([]);
+};
+
+
+/**
+ * repeated feobjects.CompiledThreadEntity Threads = 3;
+ * @return {!Array.<!proto.feobjects.CompiledThreadEntity>}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getThreadsList = function() {
+  return /** @type{!Array.<!proto.feobjects.CompiledThreadEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledThreadEntity, 3));
+};
+
+
+/** @param {!Array.<!proto.feobjects.CompiledThreadEntity>} value */
+proto.feapi.UserAndGraphResponse.prototype.setThreadsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.CompiledThreadEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.CompiledThreadEntity}
+ */
+proto.feapi.UserAndGraphResponse.prototype.addThreads = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.feobjects.CompiledThreadEntity, opt_index);
+};
+
+
+proto.feapi.UserAndGraphResponse.prototype.clearThreadsList = function() {
+  this.setThreadsList([]);
+};
+
+
+/**
+ * repeated feobjects.CompiledPostEntity Posts = 4;
+ * @return {!Array.<!proto.feobjects.CompiledPostEntity>}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getPostsList = function() {
+  return /** @type{!Array.<!proto.feobjects.CompiledPostEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledPostEntity, 4));
+};
+
+
+/** @param {!Array.<!proto.feobjects.CompiledPostEntity>} value */
+proto.feapi.UserAndGraphResponse.prototype.setPostsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.CompiledPostEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.CompiledPostEntity}
+ */
+proto.feapi.UserAndGraphResponse.prototype.addPosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.feobjects.CompiledPostEntity, opt_index);
+};
+
+
+proto.feapi.UserAndGraphResponse.prototype.clearPostsList = function() {
+  this.setPostsList([]);
+};
+
+
+/**
+ * optional bool UserEntityRequested = 5;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getUserentityrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphResponse.prototype.setUserentityrequested = function(value) {
+  jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * optional bool UserBoardsRequested = 6;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getUserboardsrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphResponse.prototype.setUserboardsrequested = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional bool UserThreadsRequested = 7;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getUserthreadsrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 7, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphResponse.prototype.setUserthreadsrequested = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * optional bool UserPostsRequested = 8;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.UserAndGraphResponse.prototype.getUserpostsrequested = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 8, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.UserAndGraphResponse.prototype.setUserpostsrequested = function(value) {
+  jspb.Message.setField(this, 8, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.Event = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.Event, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.Event.displayName = 'proto.feapi.Event';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.Event.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.Event.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.Event} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.Event.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ownerfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    priorfingerprint: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    eventtype: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    timestamp: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.Event}
+ */
+proto.feapi.Event.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.Event;
+  return proto.feapi.Event.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.Event} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.Event}
+ */
+proto.feapi.Event.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnerfingerprint(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPriorfingerprint(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.feapi.EventType} */ (reader.readEnum());
+      msg.setEventtype(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTimestamp(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.Event.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.Event.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.Event} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.Event.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOwnerfingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPriorfingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEventtype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string OwnerFingerprint = 1;
+ * @return {string}
+ */
+proto.feapi.Event.prototype.getOwnerfingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.Event.prototype.setOwnerfingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional string PriorFingerprint = 2;
+ * @return {string}
+ */
+proto.feapi.Event.prototype.getPriorfingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.Event.prototype.setPriorfingerprint = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional EventType EventType = 3;
+ * @return {!proto.feapi.EventType}
+ */
+proto.feapi.Event.prototype.getEventtype = function() {
+  return /** @type {!proto.feapi.EventType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {!proto.feapi.EventType} value */
+proto.feapi.Event.prototype.setEventtype = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * optional int64 Timestamp = 4;
+ * @return {number}
+ */
+proto.feapi.Event.prototype.getTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.Event.prototype.setTimestamp = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.ContentEventPayload = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.ContentEventPayload, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.ContentEventPayload.displayName = 'proto.feapi.ContentEventPayload';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.ContentEventPayload.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.ContentEventPayload.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.ContentEventPayload} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.ContentEventPayload.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    event: (f = msg.getEvent()) && proto.feapi.Event.toObject(includeInstance, f),
+    boarddata: (f = msg.getBoarddata()) && mimapi_mimapi_pb.Board.toObject(includeInstance, f),
+    threaddata: (f = msg.getThreaddata()) && mimapi_mimapi_pb.Thread.toObject(includeInstance, f),
+    postdata: (f = msg.getPostdata()) && mimapi_mimapi_pb.Post.toObject(includeInstance, f),
+    keydata: (f = msg.getKeydata()) && mimapi_mimapi_pb.Key.toObject(includeInstance, f),
+    directmessagedata: (f = msg.getDirectmessagedata()) && mimapi_mimapi_pb.DirectMessage.toObject(includeInstance, f),
+    boardkeydata: (f = msg.getBoardkeydata()) && proto.feapi.BoardKeyRequest.toObject(includeInstance, f),
+    boardpowdata: (f = msg.getBoardpowdata()) && proto.feapi.BoardPoWRequest.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.ContentEventPayload}
+ */
+proto.feapi.ContentEventPayload.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.ContentEventPayload;
+  return proto.feapi.ContentEventPayload.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.ContentEventPayload} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.ContentEventPayload}
+ */
+proto.feapi.ContentEventPayload.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.feapi.Event;
+      reader.readMessage(value,proto.feapi.Event.deserializeBinaryFromReader);
+      msg.setEvent(value);
+      break;
+    case 2:
+      var value = new mimapi_mimapi_pb.Board;
+      reader.readMessage(value,mimapi_mimapi_pb.Board.deserializeBinaryFromReader);
+      msg.setBoarddata(value);
+      break;
+    case 3:
+      var value = new mimapi_mimapi_pb.Thread;
+      reader.readMessage(value,mimapi_mimapi_pb.Thread.deserializeBinaryFromReader);
+      msg.setThreaddata(value);
+      break;
+    case 4:
+      var value = new mimapi_mimapi_pb.Post;
+      reader.readMessage(value,mimapi_mimapi_pb.Post.deserializeBinaryFromReader);
+      msg.setPostdata(value);
+      break;
+    case 5:
+      var value = new mimapi_mimapi_pb.Key;
+      reader.readMessage(value,mimapi_mimapi_pb.Key.deserializeBinaryFromReader);
+      msg.setKeydata(value);
+      break;
+    case 6:
+      var value = new mimapi_mimapi_pb.DirectMessage;
+      reader.readMessage(value,mimapi_mimapi_pb.DirectMessage.deserializeBinaryFromReader);
+      msg.setDirectmessagedata(value);
+      break;
+    case 7:
+      var value = new proto.feapi.BoardKeyRequest;
+      reader.readMessage(value,proto.feapi.BoardKeyRequest.deserializeBinaryFromReader);
+      msg.setBoardkeydata(value);
+      break;
+    case 8:
+      var value = new proto.feapi.BoardPoWRequest;
+      reader.readMessage(value,proto.feapi.BoardPoWRequest.deserializeBinaryFromReader);
+      msg.setBoardpowdata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.ContentEventPayload.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.ContentEventPayload.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.ContentEventPayload} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.ContentEventPayload.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEvent();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.feapi.Event.serializeBinaryToWriter
+    );
+  }
+  f = message.getBoarddata();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      mimapi_mimapi_pb.Board.serializeBinaryToWriter
+    );
+  }
+  f = message.getThreaddata();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      mimapi_mimapi_pb.Thread.serializeBinaryToWriter
+    );
+  }
+  f = message.getPostdata();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      mimapi_mimapi_pb.Post.serializeBinaryToWriter
+    );
+  }
+  f = message.getKeydata();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      mimapi_mimapi_pb.Key.serializeBinaryToWriter
+    );
+  }
+  f = message.getDirectmessagedata();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      mimapi_mimapi_pb.DirectMessage.serializeBinaryToWriter
+    );
+  }
+  f = message.getBoardkeydata();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.feapi.BoardKeyRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getBoardpowdata();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.feapi.BoardPoWRequest.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Event Event = 1;
+ * @return {?proto.feapi.Event}
+ */
+proto.feapi.ContentEventPayload.prototype.getEvent = function() {
+  return /** @type{?proto.feapi.Event} */ (
+    jspb.Message.getWrapperField(this, proto.feapi.Event, 1));
+};
+
+
+/** @param {?proto.feapi.Event|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setEvent = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearEvent = function() {
+  this.setEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasEvent = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional mimapi.Board BoardData = 2;
+ * @return {?proto.mimapi.Board}
+ */
+proto.feapi.ContentEventPayload.prototype.getBoarddata = function() {
+  return /** @type{?proto.mimapi.Board} */ (
+    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Board, 2));
+};
+
+
+/** @param {?proto.mimapi.Board|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setBoarddata = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearBoarddata = function() {
+  this.setBoarddata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasBoarddata = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional mimapi.Thread ThreadData = 3;
+ * @return {?proto.mimapi.Thread}
+ */
+proto.feapi.ContentEventPayload.prototype.getThreaddata = function() {
+  return /** @type{?proto.mimapi.Thread} */ (
+    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Thread, 3));
+};
+
+
+/** @param {?proto.mimapi.Thread|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setThreaddata = function(value) {
+  jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearThreaddata = function() {
+  this.setThreaddata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasThreaddata = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional mimapi.Post PostData = 4;
+ * @return {?proto.mimapi.Post}
+ */
+proto.feapi.ContentEventPayload.prototype.getPostdata = function() {
+  return /** @type{?proto.mimapi.Post} */ (
+    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Post, 4));
+};
+
+
+/** @param {?proto.mimapi.Post|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setPostdata = function(value) {
+  jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearPostdata = function() {
+  this.setPostdata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasPostdata = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional mimapi.Key KeyData = 5;
+ * @return {?proto.mimapi.Key}
+ */
+proto.feapi.ContentEventPayload.prototype.getKeydata = function() {
+  return /** @type{?proto.mimapi.Key} */ (
+    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Key, 5));
+};
+
+
+/** @param {?proto.mimapi.Key|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setKeydata = function(value) {
+  jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearKeydata = function() {
+  this.setKeydata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasKeydata = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional mimapi.DirectMessage DirectMessageData = 6;
+ * @return {?proto.mimapi.DirectMessage}
+ */
+proto.feapi.ContentEventPayload.prototype.getDirectmessagedata = function() {
+  return /** @type{?proto.mimapi.DirectMessage} */ (
+    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.DirectMessage, 6));
+};
+
+
+/** @param {?proto.mimapi.DirectMessage|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setDirectmessagedata = function(value) {
+  jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearDirectmessagedata = function() {
+  this.setDirectmessagedata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasDirectmessagedata = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional BoardKeyRequest BoardKeyData = 7;
+ * @return {?proto.feapi.BoardKeyRequest}
+ */
+proto.feapi.ContentEventPayload.prototype.getBoardkeydata = function() {
+  return /** @type{?proto.feapi.BoardKeyRequest} */ (
+    jspb.Message.getWrapperField(this, proto.feapi.BoardKeyRequest, 7));
+};
+
+
+/** @param {?proto.feapi.BoardKeyRequest|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setBoardkeydata = function(value) {
+  jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearBoardkeydata = function() {
+  this.setBoardkeydata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasBoardkeydata = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional BoardPoWRequest BoardPoWData = 8;
+ * @return {?proto.feapi.BoardPoWRequest}
+ */
+proto.feapi.ContentEventPayload.prototype.getBoardpowdata = function() {
+  return /** @type{?proto.feapi.BoardPoWRequest} */ (
+    jspb.Message.getWrapperField(this, proto.feapi.BoardPoWRequest, 8));
+};
+
+
+/** @param {?proto.feapi.BoardPoWRequest|undefined} value */
+proto.feapi.ContentEventPayload.prototype.setBoardpowdata = function(value) {
+  jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+proto.feapi.ContentEventPayload.prototype.clearBoardpowdata = function() {
+  this.setBoardpowdata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.ContentEventPayload.prototype.hasBoardpowdata = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.BoardKeyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feapi.BoardKeyRequest.repeatedFields_, null);
+};
+goog.inherits(proto.feapi.BoardKeyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.BoardKeyRequest.displayName = 'proto.feapi.BoardKeyRequest';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feapi.BoardKeyRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.BoardKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.BoardKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.BoardKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.BoardKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    action: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    memberpublickeysList: jspb.Message.getRepeatedField(msg, 2)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.BoardKeyRequest}
+ */
+proto.feapi.BoardKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.BoardKeyRequest;
+  return proto.feapi.BoardKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.BoardKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.BoardKeyRequest}
+ */
+proto.feapi.BoardKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.feapi.BoardKeyAction} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMemberpublickeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.BoardKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.BoardKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.BoardKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.BoardKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getMemberpublickeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional BoardKeyAction Action = 1;
+ * @return {!proto.feapi.BoardKeyAction}
+ */
+proto.feapi.BoardKeyRequest.prototype.getAction = function() {
+  return /** @type {!proto.feapi.BoardKeyAction} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/** @param {!proto.feapi.BoardKeyAction} value */
+proto.feapi.BoardKeyRequest.prototype.setAction = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * repeated string MemberPublicKeys = 2;
+ * @return {!Array.<string>}
+ */
+proto.feapi.BoardKeyRequest.prototype.getMemberpublickeysList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.feapi.BoardKeyRequest.prototype.setMemberpublickeysList = function(value) {
+  jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.feapi.BoardKeyRequest.prototype.addMemberpublickeys = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+proto.feapi.BoardKeyRequest.prototype.clearMemberpublickeysList = function() {
+  this.setMemberpublickeysList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.BoardPoWRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.BoardPoWRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.BoardPoWRequest.displayName = 'proto.feapi.BoardPoWRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.BoardPoWRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.BoardPoWRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.BoardPoWRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.BoardPoWRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    remove: jspb.Message.getFieldWithDefault(msg, 1, false),
+    thread: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    threadupdate: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    post: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    postupdate: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.BoardPoWRequest}
+ */
+proto.feapi.BoardPoWRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.BoardPoWRequest;
+  return proto.feapi.BoardPoWRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.BoardPoWRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.BoardPoWRequest}
+ */
+proto.feapi.BoardPoWRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemove(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setThread(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setThreadupdate(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPost(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPostupdate(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.BoardPoWRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.BoardPoWRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.BoardPoWRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.BoardPoWRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemove();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getThread();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getThreadupdate();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPost();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getPostupdate();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool Remove = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.BoardPoWRequest.prototype.getRemove = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.BoardPoWRequest.prototype.setRemove = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional int32 Thread = 2;
+ * @return {number}
+ */
+proto.feapi.BoardPoWRequest.prototype.getThread = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.BoardPoWRequest.prototype.setThread = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional int32 ThreadUpdate = 3;
+ * @return {number}
+ */
+proto.feapi.BoardPoWRequest.prototype.getThreadupdate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.BoardPoWRequest.prototype.setThreadupdate = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * optional int32 Post = 4;
+ * @return {number}
+ */
+proto.feapi.BoardPoWRequest.prototype.getPost = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.BoardPoWRequest.prototype.setPost = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * optional int32 PostUpdate = 5;
+ * @return {number}
+ */
+proto.feapi.BoardPoWRequest.prototype.getPostupdate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.BoardPoWRequest.prototype.setPostupdate = function(value) {
+  jspb.Message.setField(this, 5, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.ContentEventResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.ContentEventResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.ContentEventResponse.displayName = 'proto.feapi.ContentEventResponse';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.ContentEventResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.ContentEventResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.ContentEventResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.ContentEventResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.ContentEventResponse}
+ */
+proto.feapi.ContentEventResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.ContentEventResponse;
+  return proto.feapi.ContentEventResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.ContentEventResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.ContentEventResponse}
+ */
+proto.feapi.ContentEventResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.ContentEventResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.ContentEventResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.ContentEventResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.ContentEventResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.SignalEventPayload = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.SignalEventPayload, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.SignalEventPayload.displayName = 'proto.feapi.SignalEventPayload';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.SignalEventPayload.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.SignalEventPayload.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.SignalEventPayload} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.SignalEventPayload.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    event: (f = msg.getEvent()) && proto.feapi.Event.toObject(includeInstance, f),
+    signaltargettype: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    targetboard: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    targetthread: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    targetdomain: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    targetexpiry: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    meta: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    targetfingerprint: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    signaltypeclass: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    signaltype: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    signaltext: jspb.Message.getFieldWithDefault(msg, 11, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.SignalEventPayload}
+ */
+proto.feapi.SignalEventPayload.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.SignalEventPayload;
+  return proto.feapi.SignalEventPayload.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.SignalEventPayload} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.SignalEventPayload}
+ */
+proto.feapi.SignalEventPayload.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.feapi.Event;
+      reader.readMessage(value,proto.feapi.Event.deserializeBinaryFromReader);
+      msg.setEvent(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.feapi.SignalTargetType} */ (reader.readEnum());
+      msg.setSignaltargettype(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetboard(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetthread(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetdomain(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTargetexpiry(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMeta(value);
+      break;
     case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUserpostsrequested(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetfingerprint(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.feapi.SignalTypeClass} */ (reader.readEnum());
+      msg.setSignaltypeclass(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.feapi.SignalType} */ (reader.readEnum());
+      msg.setSignaltype(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSignaltext(value);
       break;
     default:
       reader.skipField();
@@ -2514,9 +4651,9 @@ proto.feapi.UserAndGraphResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feapi.UserAndGraphResponse.prototype.serializeBinary = function() {
+proto.feapi.SignalEventPayload.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feapi.UserAndGraphResponse.serializeBinaryToWriter(this, writer);
+  proto.feapi.SignalEventPayload.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2524,263 +4661,386 @@ proto.feapi.UserAndGraphResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.UserAndGraphResponse} message
+ * @param {!proto.feapi.SignalEventPayload} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.UserAndGraphResponse.serializeBinaryToWriter = function(message, writer) {
+proto.feapi.SignalEventPayload.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUser();
+  f = message.getEvent();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      feobjects_feobjects_pb.CompiledUserEntity.serializeBinaryToWriter
+      proto.feapi.Event.serializeBinaryToWriter
     );
   }
-  f = message.getBoardsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getSignaltargettype();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
-      f,
-      feobjects_feobjects_pb.CompiledBoardEntity.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getThreadsList();
+  f = message.getTargetboard();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       3,
-      f,
-      feobjects_feobjects_pb.CompiledThreadEntity.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getPostsList();
+  f = message.getTargetthread();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       4,
-      f,
-      feobjects_feobjects_pb.CompiledPostEntity.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getUserentityrequested();
-  if (f) {
-    writer.writeBool(
+  f = message.getTargetdomain();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = message.getUserboardsrequested();
-  if (f) {
-    writer.writeBool(
+  f = message.getTargetexpiry();
+  if (f !== 0) {
+    writer.writeInt64(
       6,
       f
     );
   }
-  f = message.getUserthreadsrequested();
-  if (f) {
-    writer.writeBool(
+  f = message.getMeta();
+  if (f.length > 0) {
+    writer.writeString(
       7,
       f
     );
   }
-  f = message.getUserpostsrequested();
-  if (f) {
-    writer.writeBool(
+  f = message.getTargetfingerprint();
+  if (f.length > 0) {
+    writer.writeString(
       8,
       f
     );
   }
+  f = message.getSignaltypeclass();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getSignaltype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getSignaltext();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Event Event = 1;
+ * @return {?proto.feapi.Event}
+ */
+proto.feapi.SignalEventPayload.prototype.getEvent = function() {
+  return /** @type{?proto.feapi.Event} */ (
+    jspb.Message.getWrapperField(this, proto.feapi.Event, 1));
+};
+
+
+/** @param {?proto.feapi.Event|undefined} value */
+proto.feapi.SignalEventPayload.prototype.setEvent = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.feapi.SignalEventPayload.prototype.clearEvent = function() {
+  this.setEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feapi.SignalEventPayload.prototype.hasEvent = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional SignalTargetType SignalTargetType = 2;
+ * @return {!proto.feapi.SignalTargetType}
+ */
+proto.feapi.SignalEventPayload.prototype.getSignaltargettype = function() {
+  return /** @type {!proto.feapi.SignalTargetType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {!proto.feapi.SignalTargetType} value */
+proto.feapi.SignalEventPayload.prototype.setSignaltargettype = function(value) {
+  jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional feobjects.CompiledUserEntity User = 1;
- * @return {?proto.feobjects.CompiledUserEntity}
+ * optional string TargetBoard = 3;
+ * @return {string}
  */
-proto.feapi.UserAndGraphResponse.prototype.getUser = function() {
-  return /** @type{?proto.feobjects.CompiledUserEntity} */ (
-    jspb.Message.getWrapperField(this, feobjects_feobjects_pb.CompiledUserEntity, 1));
+proto.feapi.SignalEventPayload.prototype.getTargetboard = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
-/** @param {?proto.feobjects.CompiledUserEntity|undefined} value */
-proto.feapi.UserAndGraphResponse.prototype.setUser = function(value) {
-  jspb.Message.setWrapperField(this, 1, value);
+/** @param {string} value */
+proto.feapi.SignalEventPayload.prototype.setTargetboard = function(value) {
+  jspb.Message.setField(this, 3, value);
 };
 
 
-proto.feapi.UserAndGraphResponse.prototype.clearUser = function() {
-  this.setUser(undefined);
+/**
+ * optional string TargetThread = 4;
+ * @return {string}
+ */
+proto.feapi.SignalEventPayload.prototype.getTargetthread = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.SignalEventPayload.prototype.setTargetthread = function(value) {
+  jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {!boolean}
+ * optional string TargetDomain = 5;
+ * @return {string}
  */
-proto.feapi.UserAndGraphResponse.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.feapi.SignalEventPayload.prototype.getTargetdomain = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.SignalEventPayload.prototype.setTargetdomain = function(value) {
+  jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * repeated feobjects.CompiledBoardEntity Boards = 2;
- * @return {!Array.<!proto.feobjects.CompiledBoardEntity>}
+ * optional int64 TargetExpiry = 6;
+ * @return {number}
  */
-proto.feapi.UserAndGraphResponse.prototype.getBoardsList = function() {
-  return /** @type{!Array.<!proto.feobjects.CompiledBoardEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledBoardEntity, 2));
+proto.feapi.SignalEventPayload.prototype.getTargetexpiry = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
-/** @param {!Array.<!proto.feobjects.CompiledBoardEntity>} value */
-proto.feapi.UserAndGraphResponse.prototype.setBoardsList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 2, value);
+/** @param {number} value */
+proto.feapi.SignalEventPayload.prototype.setTargetexpiry = function(value) {
+  jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.feobjects.CompiledBoardEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.CompiledBoardEntity}
+ * optional string Meta = 7;
+ * @return {string}
  */
-proto.feapi.UserAndGraphResponse.prototype.addBoards = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.feobjects.CompiledBoardEntity, opt_index);
+proto.feapi.SignalEventPayload.prototype.getMeta = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
-proto.feapi.UserAndGraphResponse.prototype.clearBoardsList = function() {
-  this.setBoardsList([]);
+/** @param {string} value */
+proto.feapi.SignalEventPayload.prototype.setMeta = function(value) {
+  jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * repeated feobjects.CompiledThreadEntity Threads = 3;
- * @return {!Array.<!proto.feobjects.CompiledThreadEntity>}
+ * optional string TargetFingerprint = 8;
+ * @return {string}
  */
-proto.feapi.UserAndGraphResponse.prototype.getThreadsList = function() {
-  return /** @type{!Array.<!proto.feobjects.CompiledThreadEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledThreadEntity, 3));
+proto.feapi.SignalEventPayload.prototype.getTargetfingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
-/** @param {!Array.<!proto.feobjects.CompiledThreadEntity>} value */
-proto.feapi.UserAndGraphResponse.prototype.setThreadsList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 3, value);
+/** @param {string} value */
+proto.feapi.SignalEventPayload.prototype.setTargetfingerprint = function(value) {
+  jspb.Message.setField(this, 8, value);
 };
 
 
 /**
- * @param {!proto.feobjects.CompiledThreadEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.CompiledThreadEntity}
+ * optional SignalTypeClass SignalTypeClass = 9;
+ * @return {!proto.feapi.SignalTypeClass}
  */
-proto.feapi.UserAndGraphResponse.prototype.addThreads = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.feobjects.CompiledThreadEntity, opt_index);
+proto.feapi.SignalEventPayload.prototype.getSignaltypeclass = function() {
+  return /** @type {!proto.feapi.SignalTypeClass} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
-proto.feapi.UserAndGraphResponse.prototype.clearThreadsList = function() {
-  this.setThreadsList([]);
+/** @param {!proto.feapi.SignalTypeClass} value */
+proto.feapi.SignalEventPayload.prototype.setSignaltypeclass = function(value) {
+  jspb.Message.setField(this, 9, value);
 };
 
 
 /**
- * repeated feobjects.CompiledPostEntity Posts = 4;
- * @return {!Array.<!proto.feobjects.CompiledPostEntity>}
+ * optional SignalType SignalType = 10;
+ * @return {!proto.feapi.SignalType}
  */
-proto.feapi.UserAndGraphResponse.prototype.getPostsList = function() {
-  return /** @type{!Array.<!proto.feobjects.CompiledPostEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledPostEntity, 4));
+proto.feapi.SignalEventPayload.prototype.getSignaltype = function() {
+  return /** @type {!proto.feapi.SignalType} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
 };
 
 
-/** @param {!Array.<!proto.feobjects.CompiledPostEntity>} value */
-proto.feapi.UserAndGraphResponse.prototype.setPostsList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 4, value);
+/** @param {!proto.feapi.SignalType} value */
+proto.feapi.SignalEventPayload.prototype.setSignaltype = function(value) {
+  jspb.Message.setField(this, 10, value);
 };
 
 
 /**
- * @param {!proto.feobjects.CompiledPostEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.CompiledPostEntity}
+ * optional string SignalText = 11;
+ * @return {string}
  */
-proto.feapi.UserAndGraphResponse.prototype.addPosts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.feobjects.CompiledPostEntity, opt_index);
+proto.feapi.SignalEventPayload.prototype.getSignaltext = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
-proto.feapi.UserAndGraphResponse.prototype.clearPostsList = function() {
-  this.setPostsList([]);
+/** @param {string} value */
+proto.feapi.SignalEventPayload.prototype.setSignaltext = function(value) {
+  jspb.Message.setField(this, 11, value);
 };
 
 
+
 /**
- * optional bool UserEntityRequested = 5;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.feapi.UserAndGraphResponse.prototype.getUserentityrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+proto.feapi.SignalEventResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.feapi.SignalEventResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.SignalEventResponse.displayName = 'proto.feapi.SignalEventResponse';
+}
 
 
-/** @param {boolean} value */
-proto.feapi.UserAndGraphResponse.prototype.setUserentityrequested = function(value) {
-  jspb.Message.setField(this, 5, value);
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.SignalEventResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.SignalEventResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional bool UserBoardsRequested = 6;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.SignalEventResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.UserAndGraphResponse.prototype.getUserboardsrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
-};
+proto.feapi.SignalEventResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
 
+  };
 
-/** @param {boolean} value */
-proto.feapi.UserAndGraphResponse.prototype.setUserboardsrequested = function(value) {
-  jspb.Message.setField(this, 6, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bool UserThreadsRequested = 7;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.SignalEventResponse}
  */
-proto.feapi.UserAndGraphResponse.prototype.getUserthreadsrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 7, false));
+proto.feapi.SignalEventResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.SignalEventResponse;
+  return proto.feapi.SignalEventResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
-/** @param {boolean} value */
-proto.feapi.UserAndGraphResponse.prototype.setUserthreadsrequested = function(value) {
-  jspb.Message.setField(this, 7, value);
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.SignalEventResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.SignalEventResponse}
+ */
+proto.feapi.SignalEventResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool UserPostsRequested = 8;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.feapi.UserAndGraphResponse.prototype.getUserpostsrequested = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 8, false));
+proto.feapi.SignalEventResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.SignalEventResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
-/** @param {boolean} value */
-proto.feapi.UserAndGraphResponse.prototype.setUserpostsrequested = function(value) {
-  jspb.Message.setField(this, 8, value);
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.SignalEventResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.SignalEventResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -2795,12 +5055,12 @@ proto.feapi.UserAndGraphResponse.prototype.setUserpostsrequested = function(valu
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.Event = function(opt_data) {
+proto.feapi.CancelInflightRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feapi.Event, jspb.Message);
+goog.inherits(proto.feapi.CancelInflightRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.Event.displayName = 'proto.feapi.Event';
+  proto.feapi.CancelInflightRequest.displayName = 'proto.feapi.CancelInflightRequest';
 }
 
 
@@ -2815,8 +5075,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.Event.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.Event.toObject(opt_includeInstance, this);
+proto.feapi.CancelInflightRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.CancelInflightRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2825,16 +5085,13 @@ proto.feapi.Event.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.Event} msg The msg instance to transform.
+ * @param {!proto.feapi.CancelInflightRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.Event.toObject = function(includeInstance, msg) {
+proto.feapi.CancelInflightRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ownerfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    priorfingerprint: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    eventtype: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    timestamp: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2848,23 +5105,23 @@ proto.feapi.Event.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.Event}
+ * @return {!proto.feapi.CancelInflightRequest}
  */
-proto.feapi.Event.deserializeBinary = function(bytes) {
+proto.feapi.CancelInflightRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.Event;
-  return proto.feapi.Event.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.CancelInflightRequest;
+  return proto.feapi.CancelInflightRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.Event} msg The message object to deserialize into.
+ * @param {!proto.feapi.CancelInflightRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.Event}
+ * @return {!proto.feapi.CancelInflightRequest}
  */
-proto.feapi.Event.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.CancelInflightRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2873,19 +5130,7 @@ proto.feapi.Event.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setOwnerfingerprint(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPriorfingerprint(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.feapi.EventType} */ (reader.readEnum());
-      msg.setEventtype(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTimestamp(value);
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -2900,9 +5145,9 @@ proto.feapi.Event.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feapi.Event.prototype.serializeBinary = function() {
+proto.feapi.CancelInflightRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feapi.Event.serializeBinaryToWriter(this, writer);
+  proto.feapi.CancelInflightRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2910,103 +5155,37 @@ proto.feapi.Event.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.Event} message
+ * @param {!proto.feapi.CancelInflightRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.Event.serializeBinaryToWriter = function(message, writer) {
+proto.feapi.CancelInflightRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOwnerfingerprint();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPriorfingerprint();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getEventtype();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
-  f = message.getTimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string OwnerFingerprint = 1;
+ * optional string Id = 1;
  * @return {string}
  */
-proto.feapi.Event.prototype.getOwnerfingerprint = function() {
+proto.feapi.CancelInflightRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feapi.Event.prototype.setOwnerfingerprint = function(value) {
+proto.feapi.CancelInflightRequest.prototype.setId = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
-/**
- * optional string PriorFingerprint = 2;
- * @return {string}
- */
-proto.feapi.Event.prototype.getPriorfingerprint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/** @param {string} value */
-proto.feapi.Event.prototype.setPriorfingerprint = function(value) {
-  jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * optional EventType EventType = 3;
- * @return {!proto.feapi.EventType}
- */
-proto.feapi.Event.prototype.getEventtype = function() {
-  return /** @type {!proto.feapi.EventType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/** @param {!proto.feapi.EventType} value */
-proto.feapi.Event.prototype.setEventtype = function(value) {
-  jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * optional int64 Timestamp = 4;
- * @return {number}
- */
-proto.feapi.Event.prototype.getTimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/** @param {number} value */
-proto.feapi.Event.prototype.setTimestamp = function(value) {
-  jspb.Message.setField(this, 4, value);
-};
-
-
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -3018,12 +5197,12 @@ proto.feapi.Event.prototype.setTimestamp = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.ContentEventPayload = function(opt_data) {
+proto.feapi.CancelInflightResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feapi.ContentEventPayload, jspb.Message);
+goog.inherits(proto.feapi.CancelInflightResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.ContentEventPayload.displayName = 'proto.feapi.ContentEventPayload';
+  proto.feapi.CancelInflightResponse.displayName = 'proto.feapi.CancelInflightResponse';
 }
 
 
@@ -3038,8 +5217,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.ContentEventPayload.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.ContentEventPayload.toObject(opt_includeInstance, this);
+proto.feapi.CancelInflightResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.CancelInflightResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3048,17 +5227,13 @@ proto.feapi.ContentEventPayload.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.ContentEventPayload} msg The msg instance to transform.
+ * @param {!proto.feapi.CancelInflightResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.ContentEventPayload.toObject = function(includeInstance, msg) {
+proto.feapi.CancelInflightResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    event: (f = msg.getEvent()) && proto.feapi.Event.toObject(includeInstance, f),
-    boarddata: (f = msg.getBoarddata()) && mimapi_mimapi_pb.Board.toObject(includeInstance, f),
-    threaddata: (f = msg.getThreaddata()) && mimapi_mimapi_pb.Thread.toObject(includeInstance, f),
-    postdata: (f = msg.getPostdata()) && mimapi_mimapi_pb.Post.toObject(includeInstance, f),
-    keydata: (f = msg.getKeydata()) && mimapi_mimapi_pb.Key.toObject(includeInstance, f)
+    cancelled: jspb.Message.getFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -3072,23 +5247,23 @@ proto.feapi.ContentEventPayload.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.ContentEventPayload}
+ * @return {!proto.feapi.CancelInflightResponse}
  */
-proto.feapi.ContentEventPayload.deserializeBinary = function(bytes) {
+proto.feapi.CancelInflightResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.ContentEventPayload;
-  return proto.feapi.ContentEventPayload.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.CancelInflightResponse;
+  return proto.feapi.CancelInflightResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.ContentEventPayload} msg The message object to deserialize into.
+ * @param {!proto.feapi.CancelInflightResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.ContentEventPayload}
+ * @return {!proto.feapi.CancelInflightResponse}
  */
-proto.feapi.ContentEventPayload.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.CancelInflightResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3096,29 +5271,8 @@ proto.feapi.ContentEventPayload.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.feapi.Event;
-      reader.readMessage(value,proto.feapi.Event.deserializeBinaryFromReader);
-      msg.setEvent(value);
-      break;
-    case 2:
-      var value = new mimapi_mimapi_pb.Board;
-      reader.readMessage(value,mimapi_mimapi_pb.Board.deserializeBinaryFromReader);
-      msg.setBoarddata(value);
-      break;
-    case 3:
-      var value = new mimapi_mimapi_pb.Thread;
-      reader.readMessage(value,mimapi_mimapi_pb.Thread.deserializeBinaryFromReader);
-      msg.setThreaddata(value);
-      break;
-    case 4:
-      var value = new mimapi_mimapi_pb.Post;
-      reader.readMessage(value,mimapi_mimapi_pb.Post.deserializeBinaryFromReader);
-      msg.setPostdata(value);
-      break;
-    case 5:
-      var value = new mimapi_mimapi_pb.Key;
-      reader.readMessage(value,mimapi_mimapi_pb.Key.deserializeBinaryFromReader);
-      msg.setKeydata(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCancelled(value);
       break;
     default:
       reader.skipField();
@@ -3133,9 +5287,9 @@ proto.feapi.ContentEventPayload.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feapi.ContentEventPayload.prototype.serializeBinary = function() {
+proto.feapi.CancelInflightResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feapi.ContentEventPayload.serializeBinaryToWriter(this, writer);
+  proto.feapi.CancelInflightResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3143,202 +5297,322 @@ proto.feapi.ContentEventPayload.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.ContentEventPayload} message
+ * @param {!proto.feapi.CancelInflightResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.ContentEventPayload.serializeBinaryToWriter = function(message, writer) {
+proto.feapi.CancelInflightResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEvent();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCancelled();
+  if (f) {
+    writer.writeBool(
       1,
-      f,
-      proto.feapi.Event.serializeBinaryToWriter
-    );
-  }
-  f = message.getBoarddata();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      mimapi_mimapi_pb.Board.serializeBinaryToWriter
-    );
-  }
-  f = message.getThreaddata();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      mimapi_mimapi_pb.Thread.serializeBinaryToWriter
-    );
-  }
-  f = message.getPostdata();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      mimapi_mimapi_pb.Post.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getKeydata();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      mimapi_mimapi_pb.Key.serializeBinaryToWriter
-    );
+};
+
+
+/**
+ * optional bool Cancelled = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.CancelInflightResponse.prototype.getCancelled = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.feapi.CancelInflightResponse.prototype.setCancelled = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feapi.RetryInflightRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feapi.RetryInflightRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.RetryInflightRequest.displayName = 'proto.feapi.RetryInflightRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.RetryInflightRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.RetryInflightRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.RetryInflightRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.RetryInflightRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
   }
+  return obj;
 };
+}
 
 
 /**
- * optional Event Event = 1;
- * @return {?proto.feapi.Event}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.RetryInflightRequest}
  */
-proto.feapi.ContentEventPayload.prototype.getEvent = function() {
-  return /** @type{?proto.feapi.Event} */ (
-    jspb.Message.getWrapperField(this, proto.feapi.Event, 1));
+proto.feapi.RetryInflightRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.RetryInflightRequest;
+  return proto.feapi.RetryInflightRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
-/** @param {?proto.feapi.Event|undefined} value */
-proto.feapi.ContentEventPayload.prototype.setEvent = function(value) {
-  jspb.Message.setWrapperField(this, 1, value);
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.RetryInflightRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.RetryInflightRequest}
+ */
+proto.feapi.RetryInflightRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
-proto.feapi.ContentEventPayload.prototype.clearEvent = function() {
-  this.setEvent(undefined);
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.RetryInflightRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.RetryInflightRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {!boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.RetryInflightRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.ContentEventPayload.prototype.hasEvent = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.feapi.RetryInflightRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional mimapi.Board BoardData = 2;
- * @return {?proto.mimapi.Board}
+ * optional string Id = 1;
+ * @return {string}
  */
-proto.feapi.ContentEventPayload.prototype.getBoarddata = function() {
-  return /** @type{?proto.mimapi.Board} */ (
-    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Board, 2));
+proto.feapi.RetryInflightRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-/** @param {?proto.mimapi.Board|undefined} value */
-proto.feapi.ContentEventPayload.prototype.setBoarddata = function(value) {
-  jspb.Message.setWrapperField(this, 2, value);
+/** @param {string} value */
+proto.feapi.RetryInflightRequest.prototype.setId = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
-proto.feapi.ContentEventPayload.prototype.clearBoarddata = function() {
-  this.setBoarddata(undefined);
-};
-
 
 /**
- * Returns whether this field is set.
- * @return {!boolean}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.feapi.ContentEventPayload.prototype.hasBoarddata = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.feapi.RetryInflightResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.feapi.RetryInflightResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.RetryInflightResponse.displayName = 'proto.feapi.RetryInflightResponse';
+}
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional mimapi.Thread ThreadData = 3;
- * @return {?proto.mimapi.Thread}
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.feapi.ContentEventPayload.prototype.getThreaddata = function() {
-  return /** @type{?proto.mimapi.Thread} */ (
-    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Thread, 3));
+proto.feapi.RetryInflightResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.RetryInflightResponse.toObject(opt_includeInstance, this);
 };
 
 
-/** @param {?proto.mimapi.Thread|undefined} value */
-proto.feapi.ContentEventPayload.prototype.setThreaddata = function(value) {
-  jspb.Message.setWrapperField(this, 3, value);
-};
-
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.RetryInflightResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.RetryInflightResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    retried: jspb.Message.getFieldWithDefault(msg, 1, false)
+  };
 
-proto.feapi.ContentEventPayload.prototype.clearThreaddata = function() {
-  this.setThreaddata(undefined);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {!boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.RetryInflightResponse}
  */
-proto.feapi.ContentEventPayload.prototype.hasThreaddata = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.feapi.RetryInflightResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.RetryInflightResponse;
+  return proto.feapi.RetryInflightResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional mimapi.Post PostData = 4;
- * @return {?proto.mimapi.Post}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.RetryInflightResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.RetryInflightResponse}
  */
-proto.feapi.ContentEventPayload.prototype.getPostdata = function() {
-  return /** @type{?proto.mimapi.Post} */ (
-    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Post, 4));
-};
-
-
-/** @param {?proto.mimapi.Post|undefined} value */
-proto.feapi.ContentEventPayload.prototype.setPostdata = function(value) {
-  jspb.Message.setWrapperField(this, 4, value);
-};
-
-
-proto.feapi.ContentEventPayload.prototype.clearPostdata = function() {
-  this.setPostdata(undefined);
+proto.feapi.RetryInflightResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRetried(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {!boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.feapi.ContentEventPayload.prototype.hasPostdata = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.feapi.RetryInflightResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.RetryInflightResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional mimapi.Key KeyData = 5;
- * @return {?proto.mimapi.Key}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.RetryInflightResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.ContentEventPayload.prototype.getKeydata = function() {
-  return /** @type{?proto.mimapi.Key} */ (
-    jspb.Message.getWrapperField(this, mimapi_mimapi_pb.Key, 5));
-};
-
-
-/** @param {?proto.mimapi.Key|undefined} value */
-proto.feapi.ContentEventPayload.prototype.setKeydata = function(value) {
-  jspb.Message.setWrapperField(this, 5, value);
+proto.feapi.RetryInflightResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRetried();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
-proto.feapi.ContentEventPayload.prototype.clearKeydata = function() {
-  this.setKeydata(undefined);
+/**
+ * optional bool Retried = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feapi.RetryInflightResponse.prototype.getRetried = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.feapi.ContentEventPayload.prototype.hasKeydata = function() {
-  return jspb.Message.getField(this, 5) != null;
+/** @param {boolean} value */
+proto.feapi.RetryInflightResponse.prototype.setRetried = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
@@ -3353,12 +5627,12 @@ proto.feapi.ContentEventPayload.prototype.hasKeydata = function() {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.ContentEventResponse = function(opt_data) {
+proto.feapi.EntityHistoryRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feapi.ContentEventResponse, jspb.Message);
+goog.inherits(proto.feapi.EntityHistoryRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.ContentEventResponse.displayName = 'proto.feapi.ContentEventResponse';
+  proto.feapi.EntityHistoryRequest.displayName = 'proto.feapi.EntityHistoryRequest';
 }
 
 
@@ -3373,8 +5647,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.ContentEventResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.ContentEventResponse.toObject(opt_includeInstance, this);
+proto.feapi.EntityHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.EntityHistoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3383,13 +5657,13 @@ proto.feapi.ContentEventResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.ContentEventResponse} msg The msg instance to transform.
+ * @param {!proto.feapi.EntityHistoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.ContentEventResponse.toObject = function(includeInstance, msg) {
+proto.feapi.EntityHistoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3403,29 +5677,33 @@ proto.feapi.ContentEventResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.ContentEventResponse}
+ * @return {!proto.feapi.EntityHistoryRequest}
  */
-proto.feapi.ContentEventResponse.deserializeBinary = function(bytes) {
+proto.feapi.EntityHistoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.ContentEventResponse;
-  return proto.feapi.ContentEventResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.EntityHistoryRequest;
+  return proto.feapi.EntityHistoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.ContentEventResponse} msg The message object to deserialize into.
+ * @param {!proto.feapi.EntityHistoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.ContentEventResponse}
+ * @return {!proto.feapi.EntityHistoryRequest}
  */
-proto.feapi.ContentEventResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.EntityHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFingerprint(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3436,25 +5714,47 @@ proto.feapi.ContentEventResponse.deserializeBinaryFromReader = function(msg, rea
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feapi.EntityHistoryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.EntityHistoryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.EntityHistoryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.EntityHistoryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Fingerprint = 1;
+ * @return {string}
  */
-proto.feapi.ContentEventResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.feapi.ContentEventResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.feapi.EntityHistoryRequest.prototype.getFingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.ContentEventResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.feapi.ContentEventResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+/** @param {string} value */
+proto.feapi.EntityHistoryRequest.prototype.setFingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
@@ -3469,13 +5769,20 @@ proto.feapi.ContentEventResponse.serializeBinaryToWriter = function(message, wri
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.SignalEventPayload = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.feapi.EntityHistoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feapi.EntityHistoryResponse.repeatedFields_, null);
 };
-goog.inherits(proto.feapi.SignalEventPayload, jspb.Message);
+goog.inherits(proto.feapi.EntityHistoryResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.SignalEventPayload.displayName = 'proto.feapi.SignalEventPayload';
+  proto.feapi.EntityHistoryResponse.displayName = 'proto.feapi.EntityHistoryResponse';
 }
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feapi.EntityHistoryResponse.repeatedFields_ = [2,3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3489,8 +5796,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.SignalEventPayload.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.SignalEventPayload.toObject(opt_includeInstance, this);
+proto.feapi.EntityHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.EntityHistoryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3499,23 +5806,17 @@ proto.feapi.SignalEventPayload.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.SignalEventPayload} msg The msg instance to transform.
+ * @param {!proto.feapi.EntityHistoryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.SignalEventPayload.toObject = function(includeInstance, msg) {
+proto.feapi.EntityHistoryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    event: (f = msg.getEvent()) && proto.feapi.Event.toObject(includeInstance, f),
-    signaltargettype: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    targetboard: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    targetthread: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    targetdomain: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    targetexpiry: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    meta: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    targetfingerprint: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    signaltypeclass: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    signaltype: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    signaltext: jspb.Message.getFieldWithDefault(msg, 11, "")
+    entitytype: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    threadsList: jspb.Message.toObjectList(msg.getThreadsList(),
+    mimapi_mimapi_pb.Thread.toObject, includeInstance),
+    postsList: jspb.Message.toObjectList(msg.getPostsList(),
+    mimapi_mimapi_pb.Post.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3529,23 +5830,23 @@ proto.feapi.SignalEventPayload.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.SignalEventPayload}
+ * @return {!proto.feapi.EntityHistoryResponse}
  */
-proto.feapi.SignalEventPayload.deserializeBinary = function(bytes) {
+proto.feapi.EntityHistoryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.SignalEventPayload;
-  return proto.feapi.SignalEventPayload.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.EntityHistoryResponse;
+  return proto.feapi.EntityHistoryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.SignalEventPayload} msg The message object to deserialize into.
+ * @param {!proto.feapi.EntityHistoryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.SignalEventPayload}
+ * @return {!proto.feapi.EntityHistoryResponse}
  */
-proto.feapi.SignalEventPayload.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.EntityHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3553,49 +5854,18 @@ proto.feapi.SignalEventPayload.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.feapi.Event;
-      reader.readMessage(value,proto.feapi.Event.deserializeBinaryFromReader);
-      msg.setEvent(value);
+      var value = /** @type {!proto.feapi.UncompiledEntityType} */ (reader.readEnum());
+      msg.setEntitytype(value);
       break;
     case 2:
-      var value = /** @type {!proto.feapi.SignalTargetType} */ (reader.readEnum());
-      msg.setSignaltargettype(value);
+      var value = new mimapi_mimapi_pb.Thread;
+      reader.readMessage(value,mimapi_mimapi_pb.Thread.deserializeBinaryFromReader);
+      msg.addThreads(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTargetboard(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTargetthread(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTargetdomain(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTargetexpiry(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMeta(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTargetfingerprint(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.feapi.SignalTypeClass} */ (reader.readEnum());
-      msg.setSignaltypeclass(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.feapi.SignalType} */ (reader.readEnum());
-      msg.setSignaltype(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSignaltext(value);
+      var value = new mimapi_mimapi_pb.Post;
+      reader.readMessage(value,mimapi_mimapi_pb.Post.deserializeBinaryFromReader);
+      msg.addPosts(value);
       break;
     default:
       reader.skipField();
@@ -3610,9 +5880,9 @@ proto.feapi.SignalEventPayload.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feapi.SignalEventPayload.prototype.serializeBinary = function() {
+proto.feapi.EntityHistoryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feapi.SignalEventPayload.serializeBinaryToWriter(this, writer);
+  proto.feapi.EntityHistoryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3620,270 +5890,254 @@ proto.feapi.SignalEventPayload.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.SignalEventPayload} message
+ * @param {!proto.feapi.EntityHistoryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.SignalEventPayload.serializeBinaryToWriter = function(message, writer) {
+proto.feapi.EntityHistoryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEvent();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.feapi.Event.serializeBinaryToWriter
-    );
-  }
-  f = message.getSignaltargettype();
+  f = message.getEntitytype();
   if (f !== 0.0) {
     writer.writeEnum(
-      2,
-      f
-    );
-  }
-  f = message.getTargetboard();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getTargetthread();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getTargetdomain();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getTargetexpiry();
-  if (f !== 0) {
-    writer.writeInt64(
-      6,
-      f
-    );
-  }
-  f = message.getMeta();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
+      1,
       f
     );
   }
-  f = message.getTargetfingerprint();
+  f = message.getThreadsList();
   if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getSignaltypeclass();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = message.getSignaltype();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      mimapi_mimapi_pb.Thread.serializeBinaryToWriter
     );
   }
-  f = message.getSignaltext();
+  f = message.getPostsList();
   if (f.length > 0) {
-    writer.writeString(
-      11,
-      f
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      mimapi_mimapi_pb.Post.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Event Event = 1;
- * @return {?proto.feapi.Event}
- */
-proto.feapi.SignalEventPayload.prototype.getEvent = function() {
-  return /** @type{?proto.feapi.Event} */ (
-    jspb.Message.getWrapperField(this, proto.feapi.Event, 1));
-};
-
-
-/** @param {?proto.feapi.Event|undefined} value */
-proto.feapi.SignalEventPayload.prototype.setEvent = function(value) {
-  jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-proto.feapi.SignalEventPayload.prototype.clearEvent = function() {
-  this.setEvent(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.feapi.SignalEventPayload.prototype.hasEvent = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional SignalTargetType SignalTargetType = 2;
- * @return {!proto.feapi.SignalTargetType}
+ * optional UncompiledEntityType EntityType = 1;
+ * @return {!proto.feapi.UncompiledEntityType}
  */
-proto.feapi.SignalEventPayload.prototype.getSignaltargettype = function() {
-  return /** @type {!proto.feapi.SignalTargetType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.feapi.EntityHistoryResponse.prototype.getEntitytype = function() {
+  return /** @type {!proto.feapi.UncompiledEntityType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
-/** @param {!proto.feapi.SignalTargetType} value */
-proto.feapi.SignalEventPayload.prototype.setSignaltargettype = function(value) {
-  jspb.Message.setField(this, 2, value);
+/** @param {!proto.feapi.UncompiledEntityType} value */
+proto.feapi.EntityHistoryResponse.prototype.setEntitytype = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional string TargetBoard = 3;
- * @return {string}
+ * repeated mimapi.Thread Threads = 2;
+ * @return {!Array.<!proto.mimapi.Thread>}
  */
-proto.feapi.SignalEventPayload.prototype.getTargetboard = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.feapi.EntityHistoryResponse.prototype.getThreadsList = function() {
+  return /** @type{!Array.<!proto.mimapi.Thread>} */ (
+    jspb.Message.getRepeatedWrapperField(this, mimapi_mimapi_pb.Thread, 2));
 };
 
 
-/** @param {string} value */
-proto.feapi.SignalEventPayload.prototype.setTargetboard = function(value) {
-  jspb.Message.setField(this, 3, value);
+/** @param {!Array.<!proto.mimapi.Thread>} value */
+proto.feapi.EntityHistoryResponse.prototype.setThreadsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string TargetThread = 4;
- * @return {string}
+ * @param {!proto.mimapi.Thread=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.mimapi.Thread}
  */
-proto.feapi.SignalEventPayload.prototype.getTargetthread = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.feapi.EntityHistoryResponse.prototype.addThreads = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.mimapi.Thread, opt_index);
 };
 
 
-/** @param {string} value */
-proto.feapi.SignalEventPayload.prototype.setTargetthread = function(value) {
-  jspb.Message.setField(this, 4, value);
+proto.feapi.EntityHistoryResponse.prototype.clearThreadsList = function() {
+  this.setThreadsList([]);
 };
 
 
 /**
- * optional string TargetDomain = 5;
- * @return {string}
+ * repeated mimapi.Post Posts = 3;
+ * @return {!Array.<!proto.mimapi.Post>}
  */
-proto.feapi.SignalEventPayload.prototype.getTargetdomain = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.feapi.EntityHistoryResponse.prototype.getPostsList = function() {
+  return /** @type{!Array.<!proto.mimapi.Post>} */ (
+    jspb.Message.getRepeatedWrapperField(this, mimapi_mimapi_pb.Post, 3));
 };
 
 
-/** @param {string} value */
-proto.feapi.SignalEventPayload.prototype.setTargetdomain = function(value) {
-  jspb.Message.setField(this, 5, value);
+/** @param {!Array.<!proto.mimapi.Post>} value */
+proto.feapi.EntityHistoryResponse.prototype.setPostsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * optional int64 TargetExpiry = 6;
- * @return {number}
+ * @param {!proto.mimapi.Post=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.mimapi.Post}
  */
-proto.feapi.SignalEventPayload.prototype.getTargetexpiry = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.feapi.EntityHistoryResponse.prototype.addPosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.mimapi.Post, opt_index);
 };
 
 
-/** @param {number} value */
-proto.feapi.SignalEventPayload.prototype.setTargetexpiry = function(value) {
-  jspb.Message.setField(this, 6, value);
+proto.feapi.EntityHistoryResponse.prototype.clearPostsList = function() {
+  this.setPostsList([]);
 };
 
 
+
 /**
- * optional string Meta = 7;
- * @return {string}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.feapi.SignalEventPayload.prototype.getMeta = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.feapi.InboxRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.feapi.InboxRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feapi.InboxRequest.displayName = 'proto.feapi.InboxRequest';
+}
 
 
-/** @param {string} value */
-proto.feapi.SignalEventPayload.prototype.setMeta = function(value) {
-  jspb.Message.setField(this, 7, value);
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feapi.InboxRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.InboxRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string TargetFingerprint = 8;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feapi.InboxRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.SignalEventPayload.prototype.getTargetfingerprint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
-};
-
+proto.feapi.InboxRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    markreadpeer: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
 
-/** @param {string} value */
-proto.feapi.SignalEventPayload.prototype.setTargetfingerprint = function(value) {
-  jspb.Message.setField(this, 8, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional SignalTypeClass SignalTypeClass = 9;
- * @return {!proto.feapi.SignalTypeClass}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feapi.InboxRequest}
  */
-proto.feapi.SignalEventPayload.prototype.getSignaltypeclass = function() {
-  return /** @type {!proto.feapi.SignalTypeClass} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.feapi.InboxRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feapi.InboxRequest;
+  return proto.feapi.InboxRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
-/** @param {!proto.feapi.SignalTypeClass} value */
-proto.feapi.SignalEventPayload.prototype.setSignaltypeclass = function(value) {
-  jspb.Message.setField(this, 9, value);
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feapi.InboxRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feapi.InboxRequest}
+ */
+proto.feapi.InboxRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMarkreadpeer(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional SignalType SignalType = 10;
- * @return {!proto.feapi.SignalType}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.feapi.SignalEventPayload.prototype.getSignaltype = function() {
-  return /** @type {!proto.feapi.SignalType} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.feapi.InboxRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feapi.InboxRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
-/** @param {!proto.feapi.SignalType} value */
-proto.feapi.SignalEventPayload.prototype.setSignaltype = function(value) {
-  jspb.Message.setField(this, 10, value);
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feapi.InboxRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feapi.InboxRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMarkreadpeer();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string SignalText = 11;
+ * optional string MarkReadPeer = 1;
  * @return {string}
  */
-proto.feapi.SignalEventPayload.prototype.getSignaltext = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.feapi.InboxRequest.prototype.getMarkreadpeer = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feapi.SignalEventPayload.prototype.setSignaltext = function(value) {
-  jspb.Message.setField(this, 11, value);
+proto.feapi.InboxRequest.prototype.setMarkreadpeer = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
@@ -3898,13 +6152,20 @@ proto.feapi.SignalEventPayload.prototype.setSignaltext = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feapi.SignalEventResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.feapi.InboxResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feapi.InboxResponse.repeatedFields_, null);
 };
-goog.inherits(proto.feapi.SignalEventResponse, jspb.Message);
+goog.inherits(proto.feapi.InboxResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feapi.SignalEventResponse.displayName = 'proto.feapi.SignalEventResponse';
+  proto.feapi.InboxResponse.displayName = 'proto.feapi.InboxResponse';
 }
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feapi.InboxResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3918,8 +6179,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feapi.SignalEventResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.feapi.SignalEventResponse.toObject(opt_includeInstance, this);
+proto.feapi.InboxResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.feapi.InboxResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3928,13 +6189,14 @@ proto.feapi.SignalEventResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feapi.SignalEventResponse} msg The msg instance to transform.
+ * @param {!proto.feapi.InboxResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.SignalEventResponse.toObject = function(includeInstance, msg) {
+proto.feapi.InboxResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    conversationsList: jspb.Message.toObjectList(msg.getConversationsList(),
+    feobjects_feobjects_pb.CompiledConversationEntity.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3948,29 +6210,34 @@ proto.feapi.SignalEventResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feapi.SignalEventResponse}
+ * @return {!proto.feapi.InboxResponse}
  */
-proto.feapi.SignalEventResponse.deserializeBinary = function(bytes) {
+proto.feapi.InboxResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feapi.SignalEventResponse;
-  return proto.feapi.SignalEventResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feapi.InboxResponse;
+  return proto.feapi.InboxResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feapi.SignalEventResponse} msg The message object to deserialize into.
+ * @param {!proto.feapi.InboxResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feapi.SignalEventResponse}
+ * @return {!proto.feapi.InboxResponse}
  */
-proto.feapi.SignalEventResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.feapi.InboxResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new feobjects_feobjects_pb.CompiledConversationEntity;
+      reader.readMessage(value,feobjects_feobjects_pb.CompiledConversationEntity.deserializeBinaryFromReader);
+      msg.addConversations(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3984,9 +6251,9 @@ proto.feapi.SignalEventResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feapi.SignalEventResponse.prototype.serializeBinary = function() {
+proto.feapi.InboxResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feapi.SignalEventResponse.serializeBinaryToWriter(this, writer);
+  proto.feapi.InboxResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3994,12 +6261,51 @@ proto.feapi.SignalEventResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feapi.SignalEventResponse} message
+ * @param {!proto.feapi.InboxResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feapi.SignalEventResponse.serializeBinaryToWriter = function(message, writer) {
+proto.feapi.InboxResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getConversationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      feobjects_feobjects_pb.CompiledConversationEntity.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated feobjects.CompiledConversationEntity Conversations = 1;
+ * @return {!Array.<!proto.feobjects.CompiledConversationEntity>}
+ */
+proto.feapi.InboxResponse.prototype.getConversationsList = function() {
+  return /** @type{!Array.<!proto.feobjects.CompiledConversationEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, feobjects_feobjects_pb.CompiledConversationEntity, 1));
+};
+
+
+/** @param {!Array.<!proto.feobjects.CompiledConversationEntity>} value */
+proto.feapi.InboxResponse.prototype.setConversationsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.CompiledConversationEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.CompiledConversationEntity}
+ */
+proto.feapi.InboxResponse.prototype.addConversations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.feobjects.CompiledConversationEntity, opt_index);
+};
+
+
+proto.feapi.InboxResponse.prototype.clearConversationsList = function() {
+  this.setConversationsList([]);
 };
 
 
@@ -5723,7 +8029,7 @@ proto.feapi.PopularViewRequest.prototype.toObject = function(opt_includeInstance
  */
 proto.feapi.PopularViewRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    sort: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5760,6 +8066,10 @@ proto.feapi.PopularViewRequest.deserializeBinaryFromReader = function(msg, reade
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSort(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5789,6 +8099,28 @@ proto.feapi.PopularViewRequest.prototype.serializeBinary = function() {
  */
 proto.feapi.PopularViewRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getSort();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Sort = 1;
+ * @return {string}
+ */
+proto.feapi.PopularViewRequest.prototype.getSort = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.feapi.PopularViewRequest.prototype.setSort = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
@@ -8833,7 +11165,9 @@ proto.feapi.SearchRequestPayload.prototype.toObject = function(opt_includeInstan
 proto.feapi.SearchRequestPayload.toObject = function(includeInstance, msg) {
   var f, obj = {
     searchtype: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    searchquery: jspb.Message.getFieldWithDefault(msg, 2, "")
+    searchquery: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    offset: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -8878,6 +11212,14 @@ proto.feapi.SearchRequestPayload.deserializeBinaryFromReader = function(msg, rea
       var value = /** @type {string} */ (reader.readString());
       msg.setSearchquery(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8921,6 +11263,20 @@ proto.feapi.SearchRequestPayload.serializeBinaryToWriter = function(message, wri
       f
     );
   }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -8954,6 +11310,36 @@ proto.feapi.SearchRequestPayload.prototype.setSearchquery = function(value) {
 };
 
 
+/**
+ * optional int32 Offset = 3;
+ * @return {number}
+ */
+proto.feapi.SearchRequestPayload.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.SearchRequestPayload.prototype.setOffset = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * optional int32 Limit = 4;
+ * @return {number}
+ */
+proto.feapi.SearchRequestPayload.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.feapi.SearchRequestPayload.prototype.setLimit = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -9079,6 +11465,15 @@ proto.feapi.EventType = {
   UPDATE: 2
 };
 
+/**
+ * @enum {number}
+ */
+proto.feapi.BoardKeyAction = {
+  UNKNOWN_BOARD_KEY_ACTION: 0,
+  ISSUE_BOARD_KEY: 1,
+  GRANT_BOARD_KEY: 2
+};
+
 /**
  * @enum {number}
  */
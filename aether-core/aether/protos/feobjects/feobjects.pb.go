@@ -13,6 +13,8 @@ It has these top-level messages:
 	CompiledPostEntity
 	CompiledUserEntity
 	CUserUsername
+	BoardFlairEntity
+	ProfileLinkEntity
 	CompiledContentSignalsEntity
 	ExplainedSignalEntity
 	CompiledUserSignalsEntity
@@ -21,6 +23,8 @@ It has these top-level messages:
 	FrontendAmbientStatus
 	CompiledNotification
 	ReportsTabEntry
+	CompiledDirectMessageEntity
+	CompiledConversationEntity
 	ModActionsTabEntry
 */
 package feobjects
@@ -43,20 +47,32 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type NotificationType int32
 
 const (
-	NotificationType_UNKNOWN_NOTIFICATION_TYPE NotificationType = 0
-	NotificationType_REPLY_TO_THREAD           NotificationType = 1
-	NotificationType_REPLY_TO_POST             NotificationType = 2
+	NotificationType_UNKNOWN_NOTIFICATION_TYPE   NotificationType = 0
+	NotificationType_REPLY_TO_THREAD             NotificationType = 1
+	NotificationType_REPLY_TO_POST               NotificationType = 2
+	NotificationType_MENTION                     NotificationType = 3
+	NotificationType_POST_IN_FOLLOWED_THREAD     NotificationType = 4
+	NotificationType_KEYWORD_IN_SUBSCRIBED_BOARD NotificationType = 5
+	NotificationType_DIRECT_MESSAGE              NotificationType = 6
 )
 
 var NotificationType_name = map[int32]string{
 	0: "UNKNOWN_NOTIFICATION_TYPE",
 	1: "REPLY_TO_THREAD",
 	2: "REPLY_TO_POST",
+	3: "MENTION",
+	4: "POST_IN_FOLLOWED_THREAD",
+	5: "KEYWORD_IN_SUBSCRIBED_BOARD",
+	6: "DIRECT_MESSAGE",
 }
 var NotificationType_value = map[string]int32{
-	"UNKNOWN_NOTIFICATION_TYPE": 0,
-	"REPLY_TO_THREAD":           1,
-	"REPLY_TO_POST":             2,
+	"UNKNOWN_NOTIFICATION_TYPE":   0,
+	"REPLY_TO_THREAD":             1,
+	"REPLY_TO_POST":               2,
+	"MENTION":                     3,
+	"POST_IN_FOLLOWED_THREAD":     4,
+	"KEYWORD_IN_SUBSCRIBED_BOARD": 5,
+	"DIRECT_MESSAGE":              6,
 }
 
 func (x NotificationType) String() string {
@@ -84,6 +100,11 @@ type CompiledBoardEntity struct {
 	SFWListed              bool                          `protobuf:"varint,17,opt,name=SFWListed" json:"SFWListed,omitempty"`
 	ViewMeta_SearchScore   float64                       `protobuf:"fixed64,18,opt,name=ViewMeta_SearchScore,json=ViewMetaSearchScore" json:"ViewMeta_SearchScore,omitempty"`
 	LastNewThreadArrived   int64                         `protobuf:"varint,19,opt,name=LastNewThreadArrived" json:"LastNewThreadArrived,omitempty"`
+	// ^ This is useful for board dot notifications on the list.
+	// From the meta
+	Rules    string              `protobuf:"bytes,20,opt,name=Rules" json:"Rules,omitempty"`
+	Flairs   []*BoardFlairEntity `protobuf:"bytes,21,rep,name=Flairs" json:"Flairs,omitempty"`
+	IconHash string              `protobuf:"bytes,22,opt,name=IconHash" json:"IconHash,omitempty"`
 }
 
 func (m *CompiledBoardEntity) Reset()                    { *m = CompiledBoardEntity{} }
@@ -224,6 +245,27 @@ func (m *CompiledBoardEntity) GetLastNewThreadArrived() int64 {
 	return 0
 }
 
+func (m *CompiledBoardEntity) GetRules() string {
+	if m != nil {
+		return m.Rules
+	}
+	return ""
+}
+
+func (m *CompiledBoardEntity) GetFlairs() []*BoardFlairEntity {
+	if m != nil {
+		return m.Flairs
+	}
+	return nil
+}
+
+func (m *CompiledBoardEntity) GetIconHash() string {
+	if m != nil {
+		return m.IconHash
+	}
+	return ""
+}
+
 type CompiledThreadEntity struct {
 	Fingerprint            string                        `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	Board                  string                        `protobuf:"bytes,2,opt,name=Board" json:"Board,omitempty"`
@@ -242,6 +284,9 @@ type CompiledThreadEntity struct {
 	ViewMeta_BoardName     string                        `protobuf:"bytes,15,opt,name=ViewMeta_BoardName,json=ViewMetaBoardName" json:"ViewMeta_BoardName,omitempty"`
 	ViewMeta_SFWListed     bool                          `protobuf:"varint,16,opt,name=ViewMeta_SFWListed,json=ViewMetaSFWListed" json:"ViewMeta_SFWListed,omitempty"`
 	ViewMeta_SearchScore   float64                       `protobuf:"fixed64,17,opt,name=ViewMeta_SearchScore,json=ViewMetaSearchScore" json:"ViewMeta_SearchScore,omitempty"`
+	// From the meta
+	Flair           *BoardFlairEntity `protobuf:"bytes,18,opt,name=Flair" json:"Flair,omitempty"`
+	ContentWarnings []string          `protobuf:"bytes,19,rep,name=ContentWarnings" json:"ContentWarnings,omitempty"`
 }
 
 func (m *CompiledThreadEntity) Reset()                    { *m = CompiledThreadEntity{} }
@@ -368,6 +413,20 @@ func (m *CompiledThreadEntity) GetViewMeta_SearchScore() float64 {
 	return 0
 }
 
+func (m *CompiledThreadEntity) GetFlair() *BoardFlairEntity {
+	if m != nil {
+		return m.Flair
+	}
+	return nil
+}
+
+func (m *CompiledThreadEntity) GetContentWarnings() []string {
+	if m != nil {
+		return m.ContentWarnings
+	}
+	return nil
+}
+
 type CompiledPostEntity struct {
 	Fingerprint            string                        `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	Board                  string                        `protobuf:"bytes,2,opt,name=Board" json:"Board,omitempty"`
@@ -385,6 +444,8 @@ type CompiledPostEntity struct {
 	ViewMeta_SearchScore   float64                       `protobuf:"fixed64,14,opt,name=ViewMeta_SearchScore,json=ViewMetaSearchScore" json:"ViewMeta_SearchScore,omitempty"`
 	ViewMeta_BoardName     string                        `protobuf:"bytes,15,opt,name=ViewMeta_BoardName,json=ViewMetaBoardName" json:"ViewMeta_BoardName,omitempty"`
 	ViewMeta_ThreadName    string                        `protobuf:"bytes,16,opt,name=ViewMeta_ThreadName,json=ViewMetaThreadName" json:"ViewMeta_ThreadName,omitempty"`
+	// From the meta
+	ContentWarnings []string `protobuf:"bytes,17,rep,name=ContentWarnings" json:"ContentWarnings,omitempty"`
 }
 
 func (m *CompiledPostEntity) Reset()                    { *m = CompiledPostEntity{} }
@@ -504,6 +565,13 @@ func (m *CompiledPostEntity) GetViewMeta_ThreadName() string {
 	return ""
 }
 
+func (m *CompiledPostEntity) GetContentWarnings() []string {
+	if m != nil {
+		return m.ContentWarnings
+	}
+	return nil
+}
+
 type CompiledUserEntity struct {
 	Fingerprint          string                     `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	NonCanonicalName     string                     `protobuf:"bytes,2,opt,name=NonCanonicalName" json:"NonCanonicalName,omitempty"`
@@ -515,6 +583,9 @@ type CompiledUserEntity struct {
 	Info                 string                     `protobuf:"bytes,8,opt,name=Info" json:"Info,omitempty"`
 	Meta                 string                     `protobuf:"bytes,9,opt,name=Meta" json:"Meta,omitempty"`
 	ViewMeta_SearchScore float64                    `protobuf:"fixed64,10,opt,name=ViewMeta_SearchScore,json=ViewMetaSearchScore" json:"ViewMeta_SearchScore,omitempty"`
+	// From the meta
+	AvatarHash string               `protobuf:"bytes,11,opt,name=AvatarHash" json:"AvatarHash,omitempty"`
+	Links      []*ProfileLinkEntity `protobuf:"bytes,12,rep,name=Links" json:"Links,omitempty"`
 }
 
 func (m *CompiledUserEntity) Reset()                    { *m = CompiledUserEntity{} }
@@ -592,6 +663,20 @@ func (m *CompiledUserEntity) GetViewMeta_SearchScore() float64 {
 	return 0
 }
 
+func (m *CompiledUserEntity) GetAvatarHash() string {
+	if m != nil {
+		return m.AvatarHash
+	}
+	return ""
+}
+
+func (m *CompiledUserEntity) GetLinks() []*ProfileLinkEntity {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
 type CUserUsername struct {
 	SourceCUser string `protobuf:"bytes,1,opt,name=SourceCUser" json:"SourceCUser,omitempty"`
 	Username    string `protobuf:"bytes,2,opt,name=Username" json:"Username,omitempty"`
@@ -624,6 +709,63 @@ func (m *CUserUsername) GetCanonical() bool {
 	return false
 }
 
+// A flair as defined by the board. Threads refer to it by Id.
+type BoardFlairEntity struct {
+	Id     string `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=Name" json:"Name,omitempty"`
+	Colour string `protobuf:"bytes,3,opt,name=Colour" json:"Colour,omitempty"`
+}
+
+func (m *BoardFlairEntity) Reset()                    { *m = BoardFlairEntity{} }
+func (m *BoardFlairEntity) String() string            { return proto.CompactTextString(m) }
+func (*BoardFlairEntity) ProtoMessage()               {}
+func (*BoardFlairEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *BoardFlairEntity) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BoardFlairEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BoardFlairEntity) GetColour() string {
+	if m != nil {
+		return m.Colour
+	}
+	return ""
+}
+
+type ProfileLinkEntity struct {
+	Label string `protobuf:"bytes,1,opt,name=Label" json:"Label,omitempty"`
+	Url   string `protobuf:"bytes,2,opt,name=Url" json:"Url,omitempty"`
+}
+
+func (m *ProfileLinkEntity) Reset()                    { *m = ProfileLinkEntity{} }
+func (m *ProfileLinkEntity) String() string            { return proto.CompactTextString(m) }
+func (*ProfileLinkEntity) ProtoMessage()               {}
+func (*ProfileLinkEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ProfileLinkEntity) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ProfileLinkEntity) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
 type CompiledContentSignalsEntity struct {
 	TargetFingerprint string `protobuf:"bytes,1,opt,name=TargetFingerprint" json:"TargetFingerprint,omitempty"`
 	// ATD
@@ -654,7 +796,7 @@ type CompiledContentSignalsEntity struct {
 func (m *CompiledContentSignalsEntity) Reset()                    { *m = CompiledContentSignalsEntity{} }
 func (m *CompiledContentSignalsEntity) String() string            { return proto.CompactTextString(m) }
 func (*CompiledContentSignalsEntity) ProtoMessage()               {}
-func (*CompiledContentSignalsEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*CompiledContentSignalsEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *CompiledContentSignalsEntity) GetTargetFingerprint() string {
 	if m != nil {
@@ -820,7 +962,7 @@ type ExplainedSignalEntity struct {
 func (m *ExplainedSignalEntity) Reset()                    { *m = ExplainedSignalEntity{} }
 func (m *ExplainedSignalEntity) String() string            { return proto.CompactTextString(m) }
 func (*ExplainedSignalEntity) ProtoMessage()               {}
-func (*ExplainedSignalEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*ExplainedSignalEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *ExplainedSignalEntity) GetSourceFp() string {
 	if m != nil {
@@ -871,7 +1013,7 @@ type CompiledUserSignalsEntity struct {
 func (m *CompiledUserSignalsEntity) Reset()                    { *m = CompiledUserSignalsEntity{} }
 func (m *CompiledUserSignalsEntity) String() string            { return proto.CompactTextString(m) }
 func (*CompiledUserSignalsEntity) ProtoMessage()               {}
-func (*CompiledUserSignalsEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*CompiledUserSignalsEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *CompiledUserSignalsEntity) GetTargetFingerprint() string {
 	if m != nil {
@@ -991,7 +1133,7 @@ type AmbientBoardEntity struct {
 func (m *AmbientBoardEntity) Reset()                    { *m = AmbientBoardEntity{} }
 func (m *AmbientBoardEntity) String() string            { return proto.CompactTextString(m) }
 func (*AmbientBoardEntity) ProtoMessage()               {}
-func (*AmbientBoardEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*AmbientBoardEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *AmbientBoardEntity) GetFingerprint() string {
 	if m != nil {
@@ -1076,7 +1218,7 @@ type BackendAmbientStatus struct {
 func (m *BackendAmbientStatus) Reset()                    { *m = BackendAmbientStatus{} }
 func (m *BackendAmbientStatus) String() string            { return proto.CompactTextString(m) }
 func (*BackendAmbientStatus) ProtoMessage()               {}
-func (*BackendAmbientStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*BackendAmbientStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *BackendAmbientStatus) GetBootstrapInProgress() bool {
 	if m != nil {
@@ -1231,7 +1373,7 @@ type FrontendAmbientStatus struct {
 func (m *FrontendAmbientStatus) Reset()                    { *m = FrontendAmbientStatus{} }
 func (m *FrontendAmbientStatus) String() string            { return proto.CompactTextString(m) }
 func (*FrontendAmbientStatus) ProtoMessage()               {}
-func (*FrontendAmbientStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*FrontendAmbientStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *FrontendAmbientStatus) GetRefresherStatus() string {
 	if m != nil {
@@ -1292,12 +1434,13 @@ type CompiledNotification struct {
 	CreationTimestamp       int64                 `protobuf:"varint,7,opt,name=CreationTimestamp" json:"CreationTimestamp,omitempty"`
 	NewestResponseTimestamp int64                 `protobuf:"varint,8,opt,name=NewestResponseTimestamp" json:"NewestResponseTimestamp,omitempty"`
 	Read                    bool                  `protobuf:"varint,9,opt,name=Read" json:"Read,omitempty"`
+	Keyword                 string                `protobuf:"bytes,10,opt,name=Keyword" json:"Keyword,omitempty"`
 }
 
 func (m *CompiledNotification) Reset()                    { *m = CompiledNotification{} }
 func (m *CompiledNotification) String() string            { return proto.CompactTextString(m) }
 func (*CompiledNotification) ProtoMessage()               {}
-func (*CompiledNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*CompiledNotification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *CompiledNotification) GetType() NotificationType {
 	if m != nil {
@@ -1362,6 +1505,13 @@ func (m *CompiledNotification) GetRead() bool {
 	return false
 }
 
+func (m *CompiledNotification) GetKeyword() string {
+	if m != nil {
+		return m.Keyword
+	}
+	return ""
+}
+
 type ReportsTabEntry struct {
 	Fingerprint   string                `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	BoardPayload  *CompiledBoardEntity  `protobuf:"bytes,2,opt,name=BoardPayload" json:"BoardPayload,omitempty"`
@@ -1373,7 +1523,7 @@ type ReportsTabEntry struct {
 func (m *ReportsTabEntry) Reset()                    { *m = ReportsTabEntry{} }
 func (m *ReportsTabEntry) String() string            { return proto.CompactTextString(m) }
 func (*ReportsTabEntry) ProtoMessage()               {}
-func (*ReportsTabEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*ReportsTabEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *ReportsTabEntry) GetFingerprint() string {
 	if m != nil {
@@ -1410,6 +1560,118 @@ func (m *ReportsTabEntry) GetTimestamp() int64 {
 	return 0
 }
 
+type CompiledDirectMessageEntity struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
+	Owner       string `protobuf:"bytes,2,opt,name=Owner" json:"Owner,omitempty"`
+	Recipient   string `protobuf:"bytes,3,opt,name=Recipient" json:"Recipient,omitempty"`
+	Body        string `protobuf:"bytes,4,opt,name=Body" json:"Body,omitempty"`
+	Creation    int64  `protobuf:"varint,5,opt,name=Creation" json:"Creation,omitempty"`
+	SelfCreated bool   `protobuf:"varint,6,opt,name=SelfCreated" json:"SelfCreated,omitempty"`
+	Unreadable  bool   `protobuf:"varint,7,opt,name=Unreadable" json:"Unreadable,omitempty"`
+}
+
+func (m *CompiledDirectMessageEntity) Reset()                    { *m = CompiledDirectMessageEntity{} }
+func (m *CompiledDirectMessageEntity) String() string            { return proto.CompactTextString(m) }
+func (*CompiledDirectMessageEntity) ProtoMessage()               {}
+func (*CompiledDirectMessageEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *CompiledDirectMessageEntity) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *CompiledDirectMessageEntity) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CompiledDirectMessageEntity) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *CompiledDirectMessageEntity) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *CompiledDirectMessageEntity) GetCreation() int64 {
+	if m != nil {
+		return m.Creation
+	}
+	return 0
+}
+
+func (m *CompiledDirectMessageEntity) GetSelfCreated() bool {
+	if m != nil {
+		return m.SelfCreated
+	}
+	return false
+}
+
+func (m *CompiledDirectMessageEntity) GetUnreadable() bool {
+	if m != nil {
+		return m.Unreadable
+	}
+	return false
+}
+
+type CompiledConversationEntity struct {
+	Peer         string                         `protobuf:"bytes,1,opt,name=Peer" json:"Peer,omitempty"`
+	PeerUsername *CUserUsername                 `protobuf:"bytes,2,opt,name=PeerUsername" json:"PeerUsername,omitempty"`
+	Messages     []*CompiledDirectMessageEntity `protobuf:"bytes,3,rep,name=Messages" json:"Messages,omitempty"`
+	LastUpdate   int64                          `protobuf:"varint,4,opt,name=LastUpdate" json:"LastUpdate,omitempty"`
+	Unread       int32                          `protobuf:"varint,5,opt,name=Unread" json:"Unread,omitempty"`
+}
+
+func (m *CompiledConversationEntity) Reset()                    { *m = CompiledConversationEntity{} }
+func (m *CompiledConversationEntity) String() string            { return proto.CompactTextString(m) }
+func (*CompiledConversationEntity) ProtoMessage()               {}
+func (*CompiledConversationEntity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *CompiledConversationEntity) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *CompiledConversationEntity) GetPeerUsername() *CUserUsername {
+	if m != nil {
+		return m.PeerUsername
+	}
+	return nil
+}
+
+func (m *CompiledConversationEntity) GetMessages() []*CompiledDirectMessageEntity {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *CompiledConversationEntity) GetLastUpdate() int64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *CompiledConversationEntity) GetUnread() int32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
 type ModActionsTabEntry struct {
 	Fingerprint   string                `protobuf:"bytes,1,opt,name=Fingerprint" json:"Fingerprint,omitempty"`
 	BoardPayload  *CompiledBoardEntity  `protobuf:"bytes,2,opt,name=BoardPayload" json:"BoardPayload,omitempty"`
@@ -1421,7 +1683,7 @@ type ModActionsTabEntry struct {
 func (m *ModActionsTabEntry) Reset()                    { *m = ModActionsTabEntry{} }
 func (m *ModActionsTabEntry) String() string            { return proto.CompactTextString(m) }
 func (*ModActionsTabEntry) ProtoMessage()               {}
-func (*ModActionsTabEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*ModActionsTabEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *ModActionsTabEntry) GetFingerprint() string {
 	if m != nil {
@@ -1464,6 +1726,8 @@ func init() {
 	proto.RegisterType((*CompiledPostEntity)(nil), "feobjects.CompiledPostEntity")
 	proto.RegisterType((*CompiledUserEntity)(nil), "feobjects.CompiledUserEntity")
 	proto.RegisterType((*CUserUsername)(nil), "feobjects.CUserUsername")
+	proto.RegisterType((*BoardFlairEntity)(nil), "feobjects.BoardFlairEntity")
+	proto.RegisterType((*ProfileLinkEntity)(nil), "feobjects.ProfileLinkEntity")
 	proto.RegisterType((*CompiledContentSignalsEntity)(nil), "feobjects.CompiledContentSignalsEntity")
 	proto.RegisterType((*ExplainedSignalEntity)(nil), "feobjects.ExplainedSignalEntity")
 	proto.RegisterType((*CompiledUserSignalsEntity)(nil), "feobjects.CompiledUserSignalsEntity")
@@ -1472,6 +1736,8 @@ func init() {
 	proto.RegisterType((*FrontendAmbientStatus)(nil), "feobjects.FrontendAmbientStatus")
 	proto.RegisterType((*CompiledNotification)(nil), "feobjects.CompiledNotification")
 	proto.RegisterType((*ReportsTabEntry)(nil), "feobjects.ReportsTabEntry")
+	proto.RegisterType((*CompiledDirectMessageEntity)(nil), "feobjects.CompiledDirectMessageEntity")
+	proto.RegisterType((*CompiledConversationEntity)(nil), "feobjects.CompiledConversationEntity")
 	proto.RegisterType((*ModActionsTabEntry)(nil), "feobjects.ModActionsTabEntry")
 	proto.RegisterEnum("feobjects.NotificationType", NotificationType_name, NotificationType_value)
 }
@@ -1479,135 +1745,160 @@ func init() {
 func init() { proto.RegisterFile("feobjects/feobjects.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5f, 0x73, 0x2b, 0x37,
-	0x15, 0xc7, 0xb1, 0x9d, 0xc4, 0x8a, 0x93, 0x38, 0x4a, 0x6e, 0xba, 0xb7, 0xbd, 0x2d, 0x1e, 0x53,
-	0xc0, 0xd3, 0xa1, 0xf7, 0xd2, 0x14, 0x18, 0xe8, 0x40, 0x21, 0xfe, 0x13, 0xea, 0x21, 0x71, 0x3c,
-	0x6b, 0xa7, 0x9d, 0xcb, 0x4b, 0x66, 0xed, 0x55, 0x9c, 0x25, 0x8e, 0xe4, 0xd1, 0x6e, 0x6e, 0x62,
-	0xbe, 0x00, 0x8f, 0x0c, 0x03, 0xbc, 0x01, 0xc3, 0xf7, 0xe1, 0xcb, 0xf0, 0xc6, 0x2b, 0x73, 0x8e,
-	0xb4, 0xbb, 0xda, 0xf5, 0xae, 0x6f, 0x02, 0x7d, 0xec, 0x83, 0x67, 0xa4, 0xdf, 0x39, 0xd2, 0x1e,
-	0x9d, 0xf3, 0xd3, 0xd1, 0x91, 0x4c, 0x9e, 0x5f, 0x31, 0x31, 0xfe, 0x1d, 0x9b, 0x04, 0xfe, 0xab,
-	0xa8, 0xf5, 0x72, 0x2e, 0x45, 0x20, 0x68, 0x25, 0x02, 0x1a, 0xff, 0x29, 0x93, 0xfd, 0xb6, 0xb8,
-	0x9d, 0x7b, 0x33, 0xe6, 0xb6, 0x84, 0x23, 0xdd, 0x2e, 0x0f, 0xbc, 0x60, 0x41, 0xeb, 0x64, 0xeb,
-	0xc4, 0xe3, 0x53, 0x26, 0xe7, 0xd2, 0xe3, 0x81, 0x55, 0xa8, 0x17, 0x9a, 0x15, 0xdb, 0x84, 0x40,
-	0x63, 0xc8, 0x66, 0x57, 0x6d, 0xc9, 0x9c, 0x80, 0xb9, 0xd6, 0x5a, 0xbd, 0xd0, 0xdc, 0xb4, 0x4d,
-	0x88, 0x52, 0x52, 0xea, 0x3b, 0xb7, 0xcc, 0x2a, 0xe2, 0x60, 0x6c, 0xc3, 0xa8, 0x0e, 0xf3, 0x27,
-	0xd2, 0x9b, 0x07, 0x9e, 0xe0, 0x56, 0x49, 0xcd, 0x6b, 0x40, 0xf4, 0x92, 0x1c, 0x86, 0x06, 0xb5,
-	0x05, 0x0f, 0x18, 0x0f, 0x86, 0xde, 0x94, 0x3b, 0x33, 0xdf, 0x2a, 0xd7, 0x0b, 0xcd, 0xad, 0xa3,
-	0xef, 0xbf, 0x8c, 0x97, 0x93, 0xad, 0xa8, 0x96, 0x60, 0xe7, 0x4c, 0x43, 0x3f, 0x25, 0xe5, 0xf3,
-	0x7b, 0xce, 0xa4, 0xb5, 0x8e, 0xf3, 0xbd, 0x9f, 0x31, 0xdf, 0x85, 0xcf, 0xa4, 0x9e, 0x45, 0xe9,
-	0x82, 0xdd, 0xe8, 0x1e, 0xec, 0xf9, 0xd6, 0x46, 0xbd, 0x08, 0x76, 0x1b, 0x10, 0x7d, 0x97, 0x6c,
-	0xe2, 0xc2, 0x61, 0x59, 0x9b, 0xf5, 0x42, 0xb3, 0x68, 0x47, 0x7d, 0xfa, 0x01, 0x21, 0xa7, 0x8e,
-	0x1f, 0x5c, 0xcc, 0x5d, 0x27, 0x60, 0x56, 0x05, 0xa5, 0x06, 0x02, 0x9e, 0x3a, 0x63, 0x81, 0x63,
-	0x11, 0xe5, 0x29, 0x68, 0xd3, 0x36, 0xa9, 0xb6, 0xaf, 0xbd, 0x99, 0x3b, 0xba, 0x96, 0xcc, 0x71,
-	0x7d, 0x6b, 0xab, 0x5e, 0x6c, 0x6e, 0x1d, 0x7d, 0x3b, 0xc3, 0x5a, 0xa5, 0xa1, 0xed, 0x4d, 0x0c,
-	0xa2, 0x0d, 0x52, 0xd5, 0xcd, 0xb6, 0xb8, 0xe3, 0x81, 0x55, 0xad, 0x17, 0x9a, 0x65, 0x3b, 0x81,
-	0xd1, 0x17, 0xa4, 0x02, 0xeb, 0x55, 0x0a, 0xdb, 0xa8, 0x10, 0x03, 0x60, 0xfa, 0xf0, 0x6e, 0x0c,
-	0xe1, 0x19, 0x33, 0xd7, 0xda, 0xc1, 0x28, 0x1b, 0x08, 0x3d, 0x24, 0xeb, 0x7d, 0x11, 0x78, 0x57,
-	0x0b, 0x6b, 0x17, 0x65, 0xba, 0x07, 0xee, 0x80, 0x05, 0x0e, 0x19, 0xe3, 0x56, 0x4d, 0xb9, 0x23,
-	0xec, 0xc3, 0x17, 0x87, 0x27, 0x5f, 0x9d, 0x7a, 0x3e, 0x10, 0x67, 0x0f, 0x87, 0xc5, 0x00, 0xfd,
-	0x84, 0x1c, 0x7c, 0xe9, 0xb1, 0x7b, 0x70, 0xc2, 0xe5, 0x90, 0x39, 0x72, 0x72, 0x3d, 0x9c, 0x08,
-	0xc9, 0x2c, 0x5a, 0x2f, 0x34, 0x0b, 0xf6, 0x7e, 0x28, 0x33, 0x44, 0xf4, 0x88, 0x1c, 0xc0, 0xe4,
-	0x7d, 0x76, 0xaf, 0x56, 0x76, 0x2c, 0xa5, 0xf7, 0x86, 0xb9, 0xd6, 0x3e, 0x7e, 0x38, 0x53, 0xd6,
-	0xf8, 0x4b, 0x99, 0x1c, 0x64, 0x79, 0xf0, 0x11, 0xd4, 0x3f, 0x20, 0x65, 0x8c, 0x3c, 0x92, 0xbe,
-	0x62, 0xab, 0x4e, 0x7a, 0x43, 0x14, 0xf3, 0x37, 0x44, 0xc9, 0xd8, 0x10, 0x94, 0x94, 0x5a, 0xc2,
-	0x5d, 0x20, 0xb9, 0x2b, 0x36, 0xb6, 0x01, 0x3b, 0xf5, 0xf8, 0x0d, 0x12, 0xb4, 0x62, 0x63, 0x7b,
-	0xc5, 0xb6, 0xd8, 0xf8, 0x9a, 0xb7, 0xc5, 0xe6, 0x13, 0xb6, 0x85, 0x49, 0xfa, 0xca, 0x4a, 0xd2,
-	0x93, 0x5c, 0xd2, 0x6f, 0x19, 0xa4, 0xff, 0x19, 0xd9, 0x44, 0xfe, 0x4a, 0xc6, 0xad, 0x2a, 0x12,
-	0x3e, 0xcb, 0x8e, 0x81, 0xf0, 0x03, 0x6d, 0x47, 0xa4, 0x0e, 0x9f, 0x03, 0xdc, 0x37, 0x79, 0x6c,
-	0x20, 0x10, 0x34, 0xc5, 0xa3, 0x1d, 0xe4, 0x91, 0xea, 0xd0, 0x8f, 0x09, 0x8d, 0xc8, 0x86, 0x61,
-	0xc4, 0x00, 0xed, 0xa2, 0x49, 0x7b, 0xa1, 0x24, 0x12, 0x24, 0xd4, 0x63, 0x0a, 0xd7, 0x30, 0xd4,
-	0x91, 0xfa, 0xdb, 0xa9, 0xbc, 0x97, 0x4b, 0xe5, 0xc6, 0x5f, 0xcb, 0x84, 0x2e, 0xaf, 0xf3, 0x7f,
-	0x26, 0xe5, 0x21, 0x59, 0x57, 0xe4, 0xd6, 0x59, 0x58, 0xf7, 0x00, 0x1f, 0x38, 0x92, 0xf1, 0x40,
-	0x93, 0x51, 0xf7, 0xd2, 0x24, 0x2e, 0x67, 0x92, 0x18, 0x09, 0xbb, 0x6e, 0x10, 0xf6, 0x1b, 0x72,
-	0xbe, 0x85, 0x9c, 0xd9, 0xbc, 0xd9, 0x7e, 0x2a, 0x6f, 0x76, 0xf2, 0x53, 0xe0, 0x13, 0x89, 0xfc,
-	0x8a, 0x44, 0xb3, 0x5c, 0x2a, 0x4a, 0xa0, 0x7e, 0x0d, 0xf5, 0xa3, 0x99, 0x62, 0x49, 0xe3, 0x8f,
-	0xc5, 0x98, 0x97, 0xb1, 0xab, 0x1f, 0xc1, 0xcb, 0x8f, 0x48, 0xad, 0x2f, 0x78, 0xdb, 0xe1, 0x82,
-	0x7b, 0x13, 0x67, 0x86, 0x9f, 0x51, 0x14, 0x5d, 0xc2, 0x13, 0x11, 0x2b, 0xae, 0x8c, 0x58, 0x69,
-	0x29, 0x62, 0x1f, 0x92, 0x6d, 0xe8, 0xd9, 0xec, 0x4a, 0x32, 0xff, 0x5a, 0x73, 0xb7, 0x68, 0x27,
-	0x41, 0xfa, 0x65, 0x5c, 0xee, 0xc0, 0x2a, 0x42, 0x9a, 0xaa, 0x52, 0xe0, 0xc3, 0x1c, 0x5a, 0x25,
-	0x39, 0x9a, 0x35, 0x01, 0xec, 0xa7, 0xee, 0xc3, 0xdc, 0x93, 0x0b, 0x64, 0x7c, 0xd1, 0xd6, 0x3d,
-	0xe0, 0x51, 0x8f, 0x5f, 0x09, 0xe4, 0x6d, 0xc5, 0xc6, 0x76, 0xc4, 0xad, 0x8a, 0xc1, 0xad, 0xbc,
-	0x88, 0x93, 0xfc, 0x4c, 0x71, 0x43, 0xb6, 0xdb, 0x60, 0x02, 0xfc, 0xb8, 0xae, 0xad, 0x86, 0xe2,
-	0x4e, 0x4e, 0x18, 0xc2, 0x61, 0x2c, 0x0c, 0x08, 0xfc, 0x1b, 0x6a, 0xeb, 0x18, 0x44, 0x7d, 0x38,
-	0x94, 0xa3, 0x60, 0xe8, 0xc3, 0x2b, 0x06, 0x1a, 0x7f, 0xdb, 0x20, 0x2f, 0x56, 0xed, 0x5c, 0xfa,
-	0x03, 0xb2, 0x37, 0x72, 0xe4, 0x94, 0x05, 0xcb, 0x74, 0x58, 0x16, 0x50, 0x8b, 0x6c, 0x5c, 0xcc,
-	0xdf, 0x88, 0x80, 0xf9, 0x68, 0x47, 0xd9, 0x0e, 0xbb, 0x60, 0x46, 0x47, 0xdc, 0x73, 0x25, 0x2b,
-	0xaa, 0x6a, 0x24, 0x02, 0xc2, 0xf4, 0xa4, 0x94, 0x5d, 0x64, 0x81, 0x4e, 0x4f, 0x1a, 0x02, 0x1a,
-	0x40, 0x37, 0x1c, 0x12, 0xa6, 0xb0, 0x24, 0x48, 0x9b, 0x64, 0x17, 0x80, 0xe3, 0x51, 0x27, 0xe2,
-	0xdb, 0x3a, 0xc6, 0x2d, 0x0d, 0xc3, 0xba, 0x34, 0x64, 0xb0, 0x4f, 0xc5, 0x78, 0x59, 0x40, 0x5f,
-	0x12, 0xaa, 0x41, 0xd3, 0x0d, 0x2a, 0xf8, 0x19, 0x12, 0xfa, 0x19, 0xd9, 0xb0, 0xd9, 0x5c, 0xc8,
-	0xc0, 0xb7, 0x2a, 0x98, 0x51, 0xea, 0x06, 0x05, 0xbb, 0x0f, 0xf3, 0x99, 0xe3, 0x71, 0xe6, 0x2a,
-	0x4f, 0x6b, 0xfa, 0x85, 0x03, 0xe8, 0xe7, 0xa4, 0x72, 0x26, 0xdc, 0xd6, 0x4c, 0x4c, 0x6e, 0xc2,
-	0xea, 0xf0, 0xed, 0xa3, 0xe3, 0x21, 0xb4, 0x43, 0xaa, 0x67, 0xc2, 0x3d, 0x9e, 0xcf, 0xa5, 0x78,
-	0x03, 0x7b, 0xa0, 0xfa, 0xc8, 0x29, 0x12, 0xa3, 0xf0, 0xd8, 0x59, 0x9c, 0x89, 0x30, 0x99, 0xa9,
-	0x0e, 0x6c, 0xfa, 0xd6, 0xe2, 0x44, 0xcc, 0x66, 0xe2, 0x9e, 0xb9, 0x03, 0x26, 0x7d, 0xc1, 0x75,
-	0xed, 0xb8, 0x84, 0x43, 0x2c, 0x5a, 0x0b, 0xb4, 0x29, 0x52, 0x55, 0xa5, 0x64, 0x1a, 0xc6, 0xa3,
-	0x67, 0x71, 0x3e, 0xd0, 0xe7, 0x2d, 0xb6, 0x21, 0x2d, 0x84, 0x4b, 0x8a, 0x8a, 0x49, 0x03, 0x01,
-	0xc6, 0x44, 0xf6, 0x32, 0x17, 0x8b, 0xc8, 0x4d, 0xdb, 0x84, 0x96, 0x13, 0xc7, 0x7e, 0x56, 0xe2,
-	0xd0, 0x8c, 0x31, 0xe7, 0x3a, 0x50, 0x56, 0xa6, 0x60, 0xfa, 0x3d, 0xb2, 0xa3, 0xa1, 0xd0, 0xaa,
-	0x67, 0xa8, 0x98, 0x42, 0x0d, 0xbd, 0xde, 0x94, 0x0b, 0xc9, 0x5c, 0xeb, 0x30, 0xa1, 0xa7, 0x51,
-	0xa8, 0xe1, 0x01, 0x51, 0x61, 0x67, 0xae, 0xf5, 0x0e, 0x6a, 0x25, 0xb0, 0xc6, 0x1f, 0x0a, 0xe4,
-	0x59, 0x66, 0xb4, 0x60, 0xcb, 0xab, 0x0c, 0x70, 0x32, 0xd7, 0xdb, 0x31, 0xea, 0x43, 0xd2, 0xb2,
-	0x99, 0x03, 0x0e, 0x57, 0xc9, 0x40, 0xf7, 0xfe, 0x9f, 0x34, 0xdc, 0xf8, 0x53, 0x99, 0x3c, 0xcf,
-	0xcd, 0x9d, 0x4f, 0xcc, 0x12, 0x87, 0x64, 0xbd, 0x23, 0x6e, 0x1d, 0x2f, 0xb2, 0x4f, 0xf5, 0xc0,
-	0x73, 0x21, 0x87, 0x5a, 0x0b, 0xf0, 0x83, 0xce, 0x57, 0x29, 0x14, 0x22, 0xab, 0x9d, 0xad, 0xd5,
-	0x54, 0xbe, 0x48, 0x82, 0xa0, 0xa5, 0xc7, 0xe9, 0x3b, 0x50, 0x19, 0xb3, 0x4e, 0x12, 0x04, 0xad,
-	0xe4, 0x19, 0xa6, 0xea, 0x9f, 0x24, 0x48, 0x7f, 0x42, 0x0e, 0xdb, 0xd0, 0xd0, 0x2e, 0x36, 0x16,
-	0xb9, 0x81, 0xea, 0x39, 0xd2, 0x30, 0xcb, 0x0c, 0xba, 0xcb, 0x69, 0x63, 0x59, 0x10, 0x32, 0x67,
-	0xd0, 0x4d, 0x95, 0x37, 0x29, 0x14, 0x76, 0xa1, 0x42, 0x96, 0x4a, 0x9d, 0x25, 0x1c, 0xd6, 0x77,
-	0xe6, 0xb8, 0x0c, 0xf8, 0xa9, 0x7c, 0xb5, 0xa5, 0x7c, 0x95, 0x00, 0x61, 0x46, 0x00, 0xfa, 0x82,
-	0xc7, 0x8a, 0x55, 0xb5, 0xaf, 0xd3, 0x78, 0xa8, 0x8b, 0x40, 0x87, 0x5d, 0x39, 0x77, 0xb3, 0x40,
-	0x27, 0x89, 0x25, 0x3c, 0xa1, 0xdb, 0x67, 0xc1, 0xbd, 0x90, 0x37, 0x61, 0xbe, 0x48, 0xe3, 0xf4,
-	0x87, 0x64, 0xdf, 0xfc, 0x56, 0xa8, 0xae, 0x72, 0x46, 0x96, 0xa8, 0xf1, 0xef, 0x02, 0xa1, 0xc7,
-	0xb7, 0x63, 0x8f, 0xf1, 0xe0, 0x69, 0x6f, 0x1c, 0xe1, 0x85, 0x6d, 0xcd, 0xb8, 0xb0, 0x25, 0x37,
-	0x40, 0x71, 0xa9, 0x0e, 0x31, 0x2f, 0xbe, 0xa5, 0xd4, 0xc5, 0x37, 0xbe, 0x2c, 0x97, 0x13, 0x97,
-	0xe5, 0xc4, 0x15, 0x5c, 0x1d, 0x44, 0xc6, 0x15, 0x3c, 0xef, 0x76, 0xbb, 0xb1, 0xe2, 0x76, 0xfb,
-	0x8f, 0x4d, 0x72, 0xd0, 0x72, 0x26, 0x37, 0x8c, 0xbb, 0x7a, 0xe5, 0xc3, 0xc0, 0x09, 0xee, 0x7c,
-	0xf0, 0x5e, 0x4b, 0x88, 0xc0, 0x0f, 0xa4, 0x33, 0xef, 0xf1, 0x81, 0x14, 0x53, 0xc9, 0x7c, 0x5f,
-	0xe7, 0xc5, 0x2c, 0x11, 0x70, 0x1a, 0x3e, 0x11, 0x89, 0x46, 0xde, 0x2d, 0xf3, 0x03, 0xe7, 0x76,
-	0xae, 0x13, 0x65, 0x8e, 0x94, 0xfe, 0x94, 0xbc, 0x33, 0x92, 0xde, 0x74, 0xca, 0x64, 0x24, 0xd4,
-	0xe9, 0x54, 0x67, 0xce, 0x3c, 0x31, 0xfd, 0x8c, 0x58, 0x30, 0x67, 0x8f, 0x8f, 0xc5, 0x1d, 0x87,
-	0x72, 0x83, 0xc7, 0xdf, 0x2c, 0xe0, 0x37, 0x73, 0xe5, 0x90, 0xa7, 0x35, 0xae, 0xee, 0x7d, 0x9f,
-	0xfc, 0x58, 0x57, 0x18, 0x69, 0x98, 0xfe, 0x9c, 0x3c, 0x87, 0x59, 0xce, 0xef, 0x82, 0x8c, 0xcf,
-	0xa8, 0xb8, 0xe6, 0x2b, 0x00, 0x63, 0x43, 0x41, 0xf4, 0xa1, 0x12, 0x7e, 0x68, 0x09, 0xa7, 0xbf,
-	0x22, 0xef, 0x99, 0x13, 0x75, 0xee, 0x24, 0xee, 0xcf, 0x21, 0x9b, 0x08, 0xee, 0xfa, 0x3a, 0xdf,
-	0xac, 0x52, 0x81, 0xa8, 0x9d, 0x0a, 0x48, 0x32, 0xc2, 0x65, 0xdd, 0x87, 0x00, 0x4a, 0xb6, 0x59,
-	0x6f, 0xa0, 0x73, 0x50, 0x96, 0x88, 0xfe, 0x88, 0x3c, 0x5b, 0x82, 0x07, 0x42, 0xaa, 0x44, 0x54,
-	0xb6, 0xb3, 0x85, 0x40, 0xee, 0x8b, 0x41, 0x7f, 0xa0, 0xb8, 0xa2, 0x13, 0x90, 0x81, 0x40, 0xe6,
-	0xe9, 0x38, 0x81, 0x33, 0x76, 0x7c, 0xa6, 0x75, 0x54, 0x11, 0x9b, 0x42, 0x61, 0x13, 0x74, 0xc6,
-	0x43, 0xef, 0xf7, 0xec, 0x6c, 0xac, 0x33, 0x4e, 0xd4, 0xc7, 0x13, 0xd9, 0x79, 0x88, 0xc4, 0x5b,
-	0x28, 0x36, 0x21, 0xb4, 0xdd, 0xf1, 0x83, 0xce, 0xb8, 0xc7, 0x7d, 0x26, 0x83, 0x38, 0x2a, 0x55,
-	0xd4, 0xcd, 0x16, 0x86, 0xf1, 0x54, 0x70, 0xda, 0xc7, 0xea, 0x3d, 0x20, 0x5f, 0x41, 0xe5, 0xf7,
-	0xc9, 0xb5, 0xc7, 0xa7, 0x7a, 0x61, 0x3b, 0x61, 0x7e, 0x37, 0x40, 0xda, 0x22, 0x2f, 0x60, 0x0a,
-	0x00, 0xd9, 0xaf, 0x19, 0x67, 0x6a, 0x8e, 0xd8, 0xc0, 0x5d, 0x34, 0x70, 0xa5, 0x0e, 0xed, 0x93,
-	0x46, 0x86, 0x3c, 0x6d, 0x70, 0x0d, 0x0d, 0x7e, 0x84, 0x26, 0x78, 0x4b, 0xef, 0xf4, 0xb6, 0xe0,
-	0x57, 0xde, 0x14, 0x22, 0x8b, 0x87, 0xc2, 0x1e, 0xae, 0x20, 0x5b, 0xd8, 0xf8, 0x73, 0x91, 0x3c,
-	0x3b, 0x91, 0x58, 0xc9, 0xa7, 0x32, 0x44, 0x93, 0xec, 0x86, 0x65, 0x8f, 0xd4, 0xbe, 0x50, 0xa9,
-	0x31, 0x0d, 0x87, 0x89, 0x49, 0xc3, 0xb1, 0x17, 0xd6, 0xe2, 0xc4, 0x94, 0x96, 0xd1, 0xcf, 0xc9,
-	0xbb, 0x06, 0x9e, 0x5e, 0xb5, 0x2a, 0xf8, 0x57, 0x68, 0x40, 0x36, 0x0a, 0xcd, 0x4e, 0x2d, 0x57,
-	0x3d, 0x64, 0xe4, 0x48, 0xb1, 0x7e, 0x53, 0xf7, 0xeb, 0x8e, 0xe7, 0x3b, 0xe3, 0x59, 0x74, 0x33,
-	0x48, 0xc3, 0x60, 0x61, 0x3a, 0x23, 0x19, 0x89, 0x72, 0x1d, 0x07, 0xad, 0xd0, 0x80, 0xec, 0x95,
-	0x96, 0x42, 0x41, 0x34, 0x63, 0xfa, 0xe2, 0xb0, 0x69, 0xe7, 0xca, 0x1b, 0xff, 0x2a, 0xc6, 0x8f,
-	0x92, 0x78, 0x36, 0x78, 0xda, 0xfc, 0x57, 0xa4, 0x34, 0x5a, 0xcc, 0x19, 0x46, 0x62, 0xe7, 0xe8,
-	0x3d, 0xa3, 0x48, 0x37, 0xd5, 0x40, 0xc5, 0x46, 0x45, 0x38, 0xba, 0x46, 0xec, 0x21, 0x08, 0x8f,
-	0x2e, 0x68, 0x03, 0xc7, 0x6d, 0xe6, 0xcf, 0x05, 0xf7, 0x19, 0x3e, 0x8c, 0x59, 0x45, 0x7c, 0xc6,
-	0x4e, 0x82, 0xf4, 0x0b, 0x42, 0x13, 0x00, 0x1c, 0x44, 0xbe, 0x55, 0xc2, 0xdb, 0x81, 0x65, 0xde,
-	0x90, 0xcd, 0xcb, 0xa7, 0x9d, 0x31, 0x86, 0xb6, 0x49, 0x55, 0x3d, 0x2b, 0xe9, 0x27, 0x28, 0xf5,
-	0x80, 0xff, 0xf6, 0x27, 0x6c, 0x73, 0x10, 0xfd, 0x05, 0x21, 0xaa, 0x0f, 0x13, 0xaf, 0x78, 0xb3,
-	0x37, 0xde, 0x5d, 0x8c, 0x01, 0x50, 0x59, 0x85, 0xf5, 0x50, 0x4c, 0x50, 0x7d, 0x7f, 0x5b, 0x12,
-	0xc0, 0x99, 0xd5, 0x67, 0xf7, 0x0c, 0xd8, 0xa7, 0x56, 0x13, 0x8f, 0x51, 0x6f, 0xfa, 0x79, 0x62,
-	0xf0, 0xb7, 0x0d, 0x6b, 0xac, 0xa8, 0xbb, 0x09, 0xb4, 0x1b, 0x7f, 0x5f, 0x83, 0xad, 0x84, 0xb7,
-	0xb5, 0x91, 0x33, 0xee, 0xf2, 0x40, 0x3e, 0xa6, 0xe8, 0x68, 0x91, 0x2a, 0x56, 0x29, 0x03, 0x67,
-	0x31, 0x13, 0x8e, 0x7a, 0xcf, 0xdb, 0x3a, 0xfa, 0x20, 0x63, 0xc9, 0x46, 0x31, 0x63, 0x27, 0xc6,
-	0xd0, 0x2e, 0xd9, 0x56, 0xee, 0x0b, 0x27, 0x29, 0x3e, 0xce, 0xf5, 0xc9, 0x51, 0xf4, 0x97, 0x64,
-	0x0b, 0x9c, 0x18, 0x4e, 0x52, 0x7a, 0x8c, 0xf3, 0xcd, 0x11, 0x50, 0xd8, 0xc4, 0x1e, 0x54, 0x0f,
-	0x32, 0x31, 0xd0, 0xf8, 0xe7, 0x1a, 0xa1, 0x70, 0x73, 0x9a, 0x40, 0x10, 0xbe, 0x71, 0x51, 0x86,
-	0x8b, 0x3e, 0x7a, 0x4d, 0x6a, 0xe9, 0x0d, 0x4e, 0xdf, 0x27, 0xcf, 0x2f, 0xfa, 0xbf, 0xe9, 0x9f,
-	0x7f, 0xd5, 0xbf, 0xec, 0x9f, 0x8f, 0x7a, 0x27, 0xbd, 0xf6, 0xf1, 0xa8, 0x77, 0xde, 0xbf, 0x1c,
-	0xbd, 0x1e, 0x74, 0x6b, 0xdf, 0xa2, 0xfb, 0x64, 0xd7, 0xee, 0x0e, 0x4e, 0x5f, 0x5f, 0x8e, 0xce,
-	0x2f, 0x47, 0x5f, 0xd8, 0xdd, 0xe3, 0x4e, 0xad, 0x40, 0xf7, 0xc8, 0x76, 0x04, 0x0e, 0xce, 0x87,
-	0xa3, 0xda, 0x5a, 0xeb, 0xbb, 0xbf, 0xfd, 0x8e, 0xc3, 0x82, 0x6b, 0x26, 0x3f, 0x9e, 0x08, 0xc9,
-	0x5e, 0xa9, 0xf6, 0x2b, 0xfc, 0x87, 0xd0, 0xf8, 0xcb, 0x70, 0xbc, 0x8e, 0xc8, 0xa7, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0x92, 0x2a, 0xc2, 0x50, 0x1c, 0x00, 0x00,
+	// 2477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x72, 0x23, 0x49,
+	0x11, 0x46, 0xd6, 0x8f, 0xad, 0x94, 0x6c, 0xcb, 0x65, 0x8f, 0xb7, 0xe7, 0x67, 0x67, 0x1d, 0x62,
+	0x59, 0x1c, 0x1b, 0xec, 0x0c, 0xe3, 0x01, 0x02, 0x96, 0x65, 0xc1, 0xfa, 0xf1, 0x8e, 0x62, 0x6c,
+	0x49, 0xd1, 0x92, 0x77, 0x62, 0xb8, 0x28, 0x5a, 0xea, 0xb2, 0xdc, 0x8c, 0xdc, 0xa5, 0xa8, 0x6e,
+	0x8f, 0x2d, 0x5e, 0x80, 0x33, 0xc1, 0x15, 0x08, 0x1e, 0x83, 0x1b, 0x6f, 0xc2, 0x85, 0xd8, 0x0b,
+	0x27, 0x78, 0x03, 0x22, 0xab, 0xaa, 0xbb, 0xab, 0x5b, 0xdd, 0xfe, 0x01, 0x8e, 0x7b, 0x98, 0x98,
+	0xaa, 0x2f, 0xb3, 0xaa, 0xab, 0x32, 0xbf, 0xcc, 0xaa, 0x4a, 0x19, 0x1e, 0x9e, 0x51, 0x36, 0xfe,
+	0x0d, 0x9d, 0xf8, 0xde, 0xf3, 0xb0, 0xf5, 0x6c, 0xce, 0x99, 0xcf, 0x48, 0x39, 0x04, 0xea, 0x7f,
+	0x2f, 0xc1, 0x76, 0x93, 0x5d, 0xcc, 0x9d, 0x19, 0xb5, 0x1b, 0xcc, 0xe2, 0x76, 0xdb, 0xf5, 0x1d,
+	0x7f, 0x41, 0xf6, 0xa0, 0x72, 0xe4, 0xb8, 0x53, 0xca, 0xe7, 0xdc, 0x71, 0x7d, 0x23, 0xb7, 0x97,
+	0xdb, 0x2f, 0x9b, 0x3a, 0x84, 0x1a, 0x03, 0x3a, 0x3b, 0x6b, 0x72, 0x6a, 0xf9, 0xd4, 0x36, 0x56,
+	0xf6, 0x72, 0xfb, 0x6b, 0xa6, 0x0e, 0x11, 0x02, 0x85, 0xae, 0x75, 0x41, 0x8d, 0xbc, 0x18, 0x2c,
+	0xda, 0x38, 0xaa, 0x45, 0xbd, 0x09, 0x77, 0xe6, 0xbe, 0xc3, 0x5c, 0xa3, 0x20, 0xe7, 0xd5, 0x20,
+	0x32, 0x82, 0xdd, 0x60, 0x41, 0x4d, 0xe6, 0xfa, 0xd4, 0xf5, 0x07, 0xce, 0xd4, 0xb5, 0x66, 0x9e,
+	0x51, 0xdc, 0xcb, 0xed, 0x57, 0x0e, 0xbe, 0xff, 0x2c, 0xda, 0x4e, 0xba, 0xa2, 0xdc, 0x82, 0x99,
+	0x31, 0x0d, 0x79, 0x09, 0xc5, 0xde, 0x95, 0x4b, 0xb9, 0x51, 0x12, 0xf3, 0x7d, 0x98, 0x32, 0xdf,
+	0xa9, 0x47, 0xb9, 0x9a, 0x45, 0xea, 0xe2, 0xba, 0x85, 0x79, 0x44, 0xcf, 0x33, 0x56, 0xf7, 0xf2,
+	0xb8, 0x6e, 0x0d, 0x22, 0x8f, 0x60, 0x4d, 0x6c, 0x1c, 0xb7, 0xb5, 0xb6, 0x97, 0xdb, 0xcf, 0x9b,
+	0x61, 0x9f, 0x3c, 0x05, 0x38, 0xb6, 0x3c, 0xff, 0x74, 0x6e, 0x5b, 0x3e, 0x35, 0xca, 0x42, 0xaa,
+	0x21, 0x68, 0xa9, 0x13, 0xea, 0x5b, 0x06, 0x48, 0x4b, 0x61, 0x9b, 0x34, 0xa1, 0xda, 0x3c, 0x77,
+	0x66, 0xf6, 0xf0, 0x9c, 0x53, 0xcb, 0xf6, 0x8c, 0xca, 0x5e, 0x7e, 0xbf, 0x72, 0xf0, 0x51, 0xca,
+	0x6a, 0xa5, 0x86, 0x5a, 0x6f, 0x6c, 0x10, 0xa9, 0x43, 0x55, 0x35, 0x9b, 0xec, 0xd2, 0xf5, 0x8d,
+	0xea, 0x5e, 0x6e, 0xbf, 0x68, 0xc6, 0x30, 0xf2, 0x04, 0xca, 0xb8, 0x5f, 0xa9, 0xb0, 0x2e, 0x14,
+	0x22, 0x00, 0x97, 0x3e, 0xb8, 0x1c, 0xa3, 0x7b, 0xc6, 0xd4, 0x36, 0x36, 0x84, 0x97, 0x35, 0x84,
+	0xec, 0x42, 0xa9, 0xcb, 0x7c, 0xe7, 0x6c, 0x61, 0x6c, 0x0a, 0x99, 0xea, 0xa1, 0x39, 0x70, 0x83,
+	0x03, 0x4a, 0x5d, 0xa3, 0x26, 0xcd, 0x11, 0xf4, 0xf1, 0x8b, 0x83, 0xa3, 0x37, 0xc7, 0x8e, 0x87,
+	0xc4, 0xd9, 0x12, 0xc3, 0x22, 0x80, 0xbc, 0x80, 0x9d, 0xaf, 0x1d, 0x7a, 0x85, 0x46, 0x18, 0x0d,
+	0xa8, 0xc5, 0x27, 0xe7, 0x83, 0x09, 0xe3, 0xd4, 0x20, 0x7b, 0xb9, 0xfd, 0x9c, 0xb9, 0x1d, 0xc8,
+	0x34, 0x11, 0x39, 0x80, 0x1d, 0x9c, 0xbc, 0x4b, 0xaf, 0xe4, 0xce, 0x0e, 0x39, 0x77, 0xde, 0x53,
+	0xdb, 0xd8, 0x16, 0x1f, 0x4e, 0x95, 0x91, 0x1d, 0x28, 0x9a, 0x97, 0x33, 0xea, 0x19, 0x3b, 0xc2,
+	0xe8, 0xb2, 0x43, 0x5e, 0x42, 0xe9, 0x68, 0x66, 0x39, 0xdc, 0x33, 0x1e, 0x08, 0x7b, 0x3f, 0xd6,
+	0xec, 0x2d, 0xbc, 0x2d, 0xa4, 0xca, 0xd6, 0x4a, 0x15, 0xf7, 0xda, 0x99, 0x30, 0xf7, 0x95, 0xe5,
+	0x9d, 0x1b, 0xbb, 0x62, 0xb6, 0xb0, 0x5f, 0xff, 0x77, 0x11, 0x76, 0xd2, 0x1c, 0x75, 0x87, 0x08,
+	0xdb, 0x81, 0xa2, 0xf8, 0xa4, 0x88, 0xad, 0xb2, 0x29, 0x3b, 0xc9, 0xb8, 0xcb, 0x67, 0xc7, 0x5d,
+	0x41, 0x8b, 0x3b, 0x02, 0x85, 0x06, 0xb3, 0x17, 0x22, 0x86, 0xca, 0xa6, 0x68, 0x23, 0x76, 0xec,
+	0xb8, 0xef, 0x44, 0x1c, 0x94, 0x4d, 0xd1, 0xbe, 0x21, 0xfa, 0x56, 0xff, 0xcf, 0xd1, 0xb7, 0x76,
+	0x8f, 0xe8, 0xd3, 0x63, 0xab, 0x7c, 0x63, 0x6c, 0x41, 0x66, 0x6c, 0x55, 0xb4, 0xd8, 0xfa, 0x19,
+	0xac, 0x89, 0x30, 0xe1, 0xd4, 0x35, 0xaa, 0xc2, 0xcf, 0x69, 0xeb, 0xe8, 0x33, 0xcf, 0x57, 0xeb,
+	0x08, 0xd5, 0xf1, 0x73, 0x88, 0x7b, 0x7a, 0xb8, 0x68, 0x08, 0x3a, 0x4d, 0xd2, 0x75, 0x43, 0xd0,
+	0x55, 0x76, 0xc8, 0x67, 0x40, 0x42, 0x4e, 0x0b, 0x37, 0x0a, 0x07, 0x6d, 0x8a, 0x25, 0x6d, 0x05,
+	0x92, 0x50, 0x10, 0x53, 0x8f, 0x22, 0xa5, 0x26, 0x5c, 0x1d, 0xaa, 0xdf, 0x1e, 0x31, 0x5b, 0xd9,
+	0x11, 0xf3, 0x02, 0x8a, 0x82, 0xbc, 0x22, 0xaa, 0x6e, 0xa1, 0xb9, 0xd4, 0x24, 0xfb, 0xb0, 0xa9,
+	0x7c, 0xf9, 0xc6, 0xe2, 0xae, 0xe3, 0x4e, 0x3d, 0x63, 0x5b, 0xa4, 0xc1, 0x24, 0x5c, 0xff, 0x5b,
+	0x11, 0xc8, 0xb2, 0x11, 0xff, 0x6b, 0xc6, 0xef, 0x42, 0x49, 0x46, 0x8e, 0x3a, 0x49, 0x54, 0x0f,
+	0xf1, 0xbe, 0xc5, 0xa9, 0xeb, 0x2b, 0xa6, 0xab, 0x5e, 0x32, 0x42, 0x8a, 0xa9, 0x11, 0x22, 0xa2,
+	0xa1, 0xa4, 0x45, 0xc3, 0xb7, 0xcc, 0xbf, 0x85, 0xf9, 0xe9, 0xa4, 0x5c, 0xbf, 0x2f, 0x29, 0x37,
+	0xb2, 0x49, 0x79, 0xcf, 0x28, 0x79, 0x0e, 0xe1, 0x2c, 0x23, 0x49, 0x09, 0xa1, 0x5f, 0x13, 0xfa,
+	0xe1, 0x4c, 0x91, 0x24, 0x8d, 0xc1, 0x5b, 0xe9, 0x0c, 0xfe, 0x47, 0x3e, 0x62, 0x70, 0xe4, 0x94,
+	0x3b, 0x30, 0xf8, 0x53, 0xa8, 0x75, 0x99, 0xdb, 0xb4, 0x5c, 0xe6, 0x3a, 0x13, 0x6b, 0x26, 0x16,
+	0x24, 0xc9, 0xbc, 0x84, 0xc7, 0x7c, 0x9b, 0xbf, 0xd1, 0xb7, 0x85, 0x25, 0xdf, 0x7e, 0x0c, 0xeb,
+	0xd8, 0x33, 0xe9, 0x19, 0xa7, 0xde, 0xb9, 0x62, 0x79, 0xde, 0x8c, 0x83, 0xe4, 0xeb, 0xe8, 0x72,
+	0x87, 0xbb, 0x08, 0x08, 0x2d, 0x2f, 0x3e, 0x1f, 0x67, 0x10, 0x30, 0xce, 0xe6, 0xb4, 0x09, 0x30,
+	0xf2, 0xda, 0xd7, 0x73, 0x87, 0x2f, 0x44, 0x6c, 0xe4, 0x4d, 0xd5, 0x43, 0xc6, 0x75, 0xdc, 0x33,
+	0x26, 0x18, 0x5e, 0x36, 0x45, 0x3b, 0x64, 0x61, 0x59, 0x63, 0x61, 0x16, 0x37, 0x20, 0x9b, 0x1b,
+	0x4f, 0x01, 0x0e, 0xdf, 0x5b, 0xbe, 0xc5, 0xc5, 0x29, 0x2b, 0x29, 0xad, 0x21, 0xe4, 0x00, 0x8a,
+	0x78, 0x80, 0x79, 0x8a, 0xd5, 0x4f, 0xb4, 0xcd, 0xf5, 0x39, 0x3b, 0x73, 0x66, 0x14, 0xc5, 0x41,
+	0x70, 0x09, 0xd5, 0xfa, 0x3b, 0x58, 0x6f, 0xe2, 0xb6, 0xf0, 0x9f, 0xab, 0x6e, 0xa7, 0x03, 0x76,
+	0xc9, 0x27, 0x54, 0xc0, 0x81, 0x7f, 0x35, 0x08, 0x7d, 0x16, 0x68, 0x2b, 0xbf, 0x86, 0x7d, 0xbc,
+	0xd6, 0x84, 0x0e, 0x56, 0xe7, 0x72, 0x04, 0xd4, 0xbb, 0x50, 0x4b, 0x66, 0x56, 0xb2, 0x01, 0x2b,
+	0x1d, 0x5b, 0x7d, 0x66, 0xa5, 0x13, 0x9d, 0xdc, 0x2b, 0xda, 0xc9, 0xbd, 0x0b, 0xa5, 0x26, 0x9b,
+	0xb1, 0x4b, 0x1e, 0x64, 0x3f, 0xd9, 0xab, 0xff, 0x1c, 0xb6, 0x96, 0x36, 0x86, 0x09, 0xf4, 0xd8,
+	0x1a, 0xd3, 0x99, 0x9a, 0x53, 0x76, 0x48, 0x0d, 0xf2, 0xa7, 0x7c, 0xa6, 0x66, 0xc5, 0x66, 0xfd,
+	0x8f, 0xab, 0xf0, 0xe4, 0xa6, 0x24, 0x46, 0x7e, 0x00, 0x5b, 0x43, 0x8b, 0x4f, 0xa9, 0xbf, 0xcc,
+	0xf7, 0x65, 0x01, 0x31, 0x60, 0xf5, 0x74, 0xfe, 0x9e, 0xf9, 0xd4, 0x13, 0x1f, 0x29, 0x9a, 0x41,
+	0x17, 0x6d, 0xd2, 0x62, 0x57, 0xae, 0x94, 0xe5, 0xe5, 0xe5, 0x32, 0x04, 0x82, 0x4c, 0x2d, 0x95,
+	0x6d, 0x41, 0x73, 0x95, 0xa9, 0x15, 0x84, 0x3c, 0xc7, 0x6e, 0x30, 0x24, 0xc8, 0xe6, 0x71, 0x10,
+	0x03, 0x1b, 0x81, 0xc3, 0x61, 0x2b, 0x0c, 0xa8, 0x92, 0x20, 0x66, 0x12, 0xc6, 0x7d, 0x29, 0x48,
+	0x0b, 0x2f, 0x49, 0xe2, 0x65, 0x01, 0x79, 0x06, 0x44, 0x81, 0xba, 0x19, 0x24, 0xbb, 0x53, 0x24,
+	0xe4, 0x73, 0x58, 0x35, 0xe9, 0x9c, 0x71, 0xdf, 0x33, 0xca, 0x82, 0x86, 0x7b, 0x1a, 0x0d, 0xdb,
+	0xd7, 0xf3, 0x99, 0xe5, 0xb8, 0xd4, 0x96, 0x96, 0x56, 0x54, 0x0c, 0x06, 0x90, 0x2f, 0xa1, 0x7c,
+	0xc2, 0xec, 0xc6, 0x8c, 0x4d, 0xde, 0x05, 0x97, 0xfd, 0xdb, 0x47, 0x47, 0x43, 0x48, 0x0b, 0xaa,
+	0x27, 0xcc, 0x3e, 0x9c, 0xcf, 0x39, 0x7b, 0x8f, 0x41, 0x5e, 0xbd, 0xe3, 0x14, 0xb1, 0x51, 0xe2,
+	0x04, 0x5e, 0x9c, 0xb0, 0x20, 0xaf, 0xcb, 0x0e, 0x66, 0xb5, 0xc6, 0xe2, 0x88, 0xcd, 0x66, 0xec,
+	0x8a, 0xda, 0x7d, 0xca, 0x3d, 0xe6, 0xaa, 0xa7, 0xc0, 0x12, 0x8e, 0xbe, 0x68, 0x2c, 0xc4, 0x9a,
+	0x42, 0x55, 0xf9, 0x32, 0x48, 0xc2, 0xe2, 0x14, 0x5e, 0xf4, 0xfa, 0xea, 0x5e, 0x23, 0xda, 0x18,
+	0xe6, 0xc1, 0x96, 0xc2, 0xb7, 0x81, 0x86, 0x20, 0x63, 0xc2, 0xf5, 0x52, 0x5b, 0xdc, 0x5e, 0xd6,
+	0x4c, 0x1d, 0x5a, 0xce, 0x8c, 0xdb, 0x69, 0x99, 0x51, 0x31, 0x46, 0x9f, 0x6b, 0x47, 0xae, 0x32,
+	0x01, 0x93, 0x4f, 0x60, 0x43, 0x41, 0xc1, 0xaa, 0x1e, 0x08, 0xc5, 0x04, 0xaa, 0xe9, 0x75, 0xa6,
+	0x2e, 0xe3, 0xd4, 0x16, 0x4f, 0x81, 0x48, 0x4f, 0xa1, 0xf8, 0x24, 0x43, 0x44, 0xba, 0x9d, 0xda,
+	0xc6, 0x07, 0x42, 0x2b, 0x86, 0xd5, 0x7f, 0x97, 0x83, 0x07, 0xa9, 0xde, 0xc2, 0xfc, 0x23, 0xd3,
+	0xd1, 0xd1, 0x5c, 0x85, 0x63, 0xd8, 0xc7, 0x4c, 0x61, 0x52, 0x0b, 0x0d, 0x2e, 0x23, 0x5d, 0xf5,
+	0xfe, 0x97, 0x73, 0xa6, 0xfe, 0xfb, 0x22, 0x3c, 0xcc, 0x3c, 0x1c, 0xee, 0x99, 0x25, 0x76, 0xa1,
+	0xd4, 0x62, 0x17, 0x96, 0x13, 0xae, 0x4f, 0xf6, 0xd0, 0x72, 0x01, 0x87, 0x1a, 0x0b, 0xb4, 0x83,
+	0x4a, 0x9e, 0x09, 0x14, 0x3d, 0xab, 0x8c, 0xad, 0xd4, 0x64, 0xbe, 0x88, 0x83, 0xa8, 0xa5, 0xc6,
+	0xa9, 0x27, 0x6d, 0x51, 0x64, 0x9d, 0x38, 0x88, 0x5a, 0xf1, 0x43, 0x5a, 0x5e, 0x05, 0xe3, 0x20,
+	0xf9, 0x09, 0xec, 0x36, 0xb1, 0xa1, 0x4c, 0xac, 0x6d, 0x72, 0x55, 0xa8, 0x67, 0x48, 0x83, 0x2c,
+	0xd3, 0x6f, 0x2f, 0xa7, 0x8d, 0x65, 0x41, 0xc0, 0x9c, 0x7e, 0x3b, 0x71, 0xd3, 0x4b, 0xa0, 0x18,
+	0x85, 0x12, 0x59, 0xba, 0xf5, 0x2d, 0xe1, 0xb8, 0xbf, 0x13, 0xcb, 0xa6, 0xc8, 0x4f, 0x69, 0xab,
+	0x8a, 0xb4, 0x55, 0x0c, 0xc4, 0x19, 0x11, 0xe8, 0x32, 0x37, 0x52, 0xac, 0xca, 0xb8, 0x4e, 0xe2,
+	0x81, 0xae, 0x00, 0x5a, 0xf4, 0xcc, 0xba, 0x9c, 0xf9, 0x2a, 0x49, 0x2c, 0xe1, 0x31, 0xdd, 0x2e,
+	0xf5, 0xaf, 0x18, 0x7f, 0x17, 0xe4, 0x8b, 0x24, 0x4e, 0x7e, 0x08, 0xdb, 0xfa, 0xb7, 0x02, 0x75,
+	0x99, 0x33, 0xd2, 0x44, 0xf5, 0x7f, 0xe5, 0x80, 0x1c, 0x5e, 0x8c, 0x1d, 0xea, 0xfa, 0xf7, 0x2b,
+	0x59, 0xa5, 0x1d, 0xaf, 0xf1, 0x00, 0xc8, 0x2f, 0x5d, 0xb4, 0xf4, 0x3a, 0x46, 0x21, 0x51, 0xc7,
+	0x88, 0x6a, 0x1f, 0xc5, 0x58, 0xed, 0x23, 0x56, 0x51, 0x91, 0x07, 0x91, 0x56, 0x51, 0xc9, 0x2a,
+	0x56, 0xac, 0x66, 0x17, 0x2b, 0xea, 0x7f, 0x5e, 0x83, 0x9d, 0x86, 0x35, 0x79, 0x47, 0x5d, 0x5b,
+	0xed, 0x7c, 0xe0, 0x5b, 0xfe, 0xa5, 0x87, 0xd6, 0x6b, 0x30, 0xe6, 0x7b, 0x3e, 0xb7, 0xe6, 0x1d,
+	0xb7, 0xcf, 0xd9, 0x94, 0x53, 0xcf, 0x53, 0x79, 0x31, 0x4d, 0x84, 0x9c, 0xc6, 0x4f, 0x84, 0xa2,
+	0xa1, 0x73, 0x41, 0x3d, 0xdf, 0xba, 0x98, 0xab, 0x44, 0x99, 0x21, 0x25, 0x3f, 0x85, 0x0f, 0x86,
+	0xdc, 0x99, 0x4e, 0x29, 0x0f, 0x85, 0x2a, 0x9d, 0xaa, 0xcc, 0x99, 0x25, 0x26, 0x9f, 0x83, 0x81,
+	0x73, 0x76, 0xdc, 0x31, 0xbb, 0x74, 0xf1, 0xba, 0xe1, 0x46, 0xdf, 0xcc, 0x89, 0x6f, 0x66, 0xca,
+	0x31, 0x4f, 0x2b, 0x5c, 0xbe, 0xaf, 0x5f, 0xfc, 0x58, 0xdd, 0x30, 0x92, 0x30, 0xf9, 0x02, 0x1e,
+	0xe2, 0x2c, 0xbd, 0x4b, 0x3f, 0xe5, 0x33, 0xd2, 0xaf, 0xd9, 0x0a, 0xc8, 0xd8, 0x40, 0x10, 0x7e,
+	0xa8, 0x20, 0x3e, 0xb4, 0x84, 0x93, 0x5f, 0xc1, 0x63, 0x7d, 0xa2, 0xd6, 0x25, 0x17, 0xf1, 0x39,
+	0xa0, 0x13, 0xe6, 0xda, 0x9e, 0xca, 0x37, 0x37, 0xa9, 0xa0, 0xd7, 0x8e, 0x19, 0x26, 0x19, 0x66,
+	0xd3, 0xf6, 0xb5, 0x8f, 0xf7, 0xc7, 0x59, 0xa7, 0xaf, 0x72, 0x50, 0x9a, 0x88, 0xfc, 0x08, 0x1e,
+	0x2c, 0xc1, 0x7d, 0xc6, 0x65, 0x22, 0x2a, 0x9a, 0xe9, 0x42, 0x24, 0xf7, 0x69, 0xbf, 0xdb, 0x97,
+	0x5c, 0x51, 0x09, 0x48, 0x43, 0x30, 0xf3, 0xb4, 0x2c, 0xdf, 0x1a, 0x5b, 0x1e, 0x55, 0x3a, 0xf2,
+	0x96, 0x9e, 0x40, 0x31, 0x08, 0x5a, 0xe3, 0x81, 0xf3, 0x5b, 0x7a, 0x32, 0x56, 0x19, 0x27, 0xec,
+	0x8b, 0x13, 0xd9, 0xba, 0x0e, 0xc5, 0x15, 0x21, 0xd6, 0x21, 0xb1, 0x76, 0xcb, 0xf3, 0x5b, 0xe3,
+	0x8e, 0xeb, 0x51, 0xee, 0x47, 0x5e, 0xa9, 0x0a, 0xdd, 0x74, 0x61, 0xe0, 0x4f, 0x09, 0x27, 0x6d,
+	0x2c, 0xeb, 0x2e, 0xd9, 0x0a, 0x32, 0xbf, 0x4f, 0xce, 0x1d, 0x77, 0xaa, 0x36, 0xb6, 0x11, 0xe4,
+	0x77, 0x0d, 0x24, 0x0d, 0x78, 0x82, 0x53, 0x20, 0x48, 0xbf, 0xa2, 0x2e, 0x95, 0x73, 0x44, 0x0b,
+	0xdc, 0x14, 0x0b, 0xbc, 0x51, 0x87, 0x74, 0xa1, 0x9e, 0x22, 0x4f, 0x2e, 0xb8, 0x26, 0x16, 0x7c,
+	0x07, 0x4d, 0xb4, 0x96, 0x8a, 0xf4, 0x26, 0x73, 0xcf, 0x9c, 0x29, 0x7a, 0x56, 0x1c, 0x0a, 0x5b,
+	0x62, 0x07, 0xe9, 0xc2, 0xfa, 0x1f, 0xf2, 0xf0, 0xe0, 0x88, 0x8b, 0x9b, 0x7c, 0x22, 0x43, 0xec,
+	0xc3, 0x66, 0x70, 0xed, 0xe1, 0xca, 0x16, 0x32, 0x35, 0x26, 0xe1, 0x20, 0x31, 0x29, 0x38, 0xb2,
+	0xc2, 0x4a, 0x94, 0x98, 0x92, 0x32, 0xf2, 0x25, 0x3c, 0xd2, 0xf0, 0xe4, 0xae, 0xe5, 0x85, 0xff,
+	0x06, 0x0d, 0xcc, 0x46, 0xc1, 0xb2, 0x13, 0xdb, 0x95, 0x35, 0x9d, 0x0c, 0xa9, 0xb8, 0xbf, 0xc9,
+	0x52, 0x43, 0xcb, 0xf1, 0xac, 0xf1, 0x2c, 0x7c, 0x19, 0x24, 0x61, 0x5c, 0x61, 0x32, 0x23, 0x69,
+	0x89, 0xb2, 0x24, 0x06, 0xdd, 0xa0, 0x81, 0xd9, 0x2b, 0x29, 0xc5, 0x0b, 0xd1, 0x8c, 0xaa, 0x87,
+	0xc3, 0x9a, 0x99, 0x29, 0xaf, 0x7f, 0x93, 0x8f, 0x8a, 0xbf, 0xe2, 0x6c, 0x70, 0xd4, 0xf2, 0x9f,
+	0x43, 0x61, 0xb8, 0x98, 0x53, 0xe1, 0x89, 0x8d, 0x58, 0xf5, 0x4d, 0x57, 0x43, 0x15, 0x53, 0x28,
+	0xe2, 0xd1, 0x35, 0xa4, 0xd7, 0x7e, 0x70, 0x74, 0x61, 0x1b, 0x39, 0x6e, 0x52, 0x6f, 0xce, 0x5c,
+	0x8f, 0x8a, 0x02, 0xa4, 0x91, 0x17, 0xc5, 0x8c, 0x38, 0x48, 0x5e, 0x01, 0x89, 0x01, 0x78, 0x10,
+	0x79, 0x46, 0x41, 0xbc, 0x0e, 0x0c, 0xbd, 0x04, 0xa0, 0xbf, 0x84, 0xcd, 0x94, 0x31, 0xa4, 0x09,
+	0x55, 0x59, 0x61, 0x53, 0xd5, 0x38, 0xf9, 0x7b, 0xcc, 0xed, 0xbf, 0x48, 0xe8, 0x83, 0xc8, 0x2f,
+	0x00, 0x64, 0x1f, 0x27, 0xbe, 0xe1, 0x27, 0x18, 0xad, 0x04, 0xa5, 0x0d, 0xc0, 0x9b, 0x55, 0x70,
+	0x1f, 0x8a, 0x08, 0xaa, 0xde, 0x6f, 0x4b, 0x02, 0x3c, 0xb3, 0xba, 0xf4, 0x8a, 0x22, 0xfb, 0xe4,
+	0x6e, 0xa2, 0x31, 0xf2, 0x27, 0x9a, 0x2c, 0x31, 0xda, 0xdb, 0xc4, 0x3d, 0x96, 0xe5, 0xdb, 0x04,
+	0xdb, 0xf8, 0xca, 0x7d, 0x4d, 0x17, 0x57, 0x8c, 0xdb, 0xea, 0x87, 0x9a, 0xa0, 0x5b, 0xff, 0xd3,
+	0x0a, 0x06, 0x99, 0x78, 0xc7, 0x0d, 0xad, 0x71, 0xdb, 0xf5, 0xf9, 0x5d, 0xae, 0x23, 0x0d, 0xa8,
+	0x8a, 0xfb, 0x4b, 0xdf, 0x5a, 0xcc, 0x98, 0x25, 0x8b, 0x9e, 0x95, 0x83, 0xa7, 0x29, 0xc6, 0xd0,
+	0xae, 0x39, 0x66, 0x6c, 0x0c, 0x69, 0xc3, 0xba, 0x34, 0x6c, 0x30, 0x49, 0xfe, 0x6e, 0x4e, 0x89,
+	0x8f, 0x22, 0xbf, 0x84, 0x0a, 0x9a, 0x37, 0x98, 0xa4, 0x70, 0x17, 0xb7, 0xe8, 0x23, 0xf0, 0xca,
+	0x13, 0xd9, 0x56, 0xd6, 0xa2, 0x22, 0xa0, 0xfe, 0x4d, 0x0e, 0x1e, 0x07, 0x33, 0xb4, 0x1c, 0x4e,
+	0x27, 0xfe, 0x09, 0xf5, 0x3c, 0x6b, 0x4a, 0xef, 0x53, 0x19, 0x96, 0xc5, 0x53, 0x55, 0x19, 0x96,
+	0xd5, 0xd1, 0x27, 0x50, 0x36, 0xe9, 0xc4, 0x99, 0x63, 0xba, 0x53, 0xe5, 0x91, 0x08, 0x08, 0xab,
+	0xbc, 0x05, 0xad, 0xca, 0xab, 0xbf, 0x85, 0x8a, 0x89, 0xb7, 0x50, 0xa2, 0x6e, 0x5c, 0x5a, 0xae,
+	0x1b, 0xe3, 0x79, 0xea, 0xa2, 0xdd, 0x30, 0xb5, 0xa8, 0xe8, 0xd7, 0x90, 0xfa, 0x3f, 0x73, 0xf0,
+	0x48, 0x2b, 0xab, 0xbc, 0xa7, 0xdc, 0x13, 0x53, 0xab, 0x6d, 0x12, 0x28, 0xf4, 0x69, 0x58, 0x57,
+	0x12, 0x6d, 0xf2, 0x05, 0x54, 0xf1, 0xff, 0x58, 0x51, 0xe9, 0xa6, 0xc0, 0x8c, 0x69, 0x93, 0x06,
+	0xac, 0x29, 0x4b, 0xca, 0xe8, 0xaf, 0x1c, 0x7c, 0x92, 0xe2, 0xb4, 0x14, 0x93, 0x9b, 0xe1, 0xb8,
+	0x5b, 0x4b, 0x8d, 0xbb, 0x50, 0x92, 0x5b, 0x54, 0x37, 0x1b, 0xd5, 0xab, 0xff, 0x65, 0x05, 0x08,
+	0x3e, 0x94, 0x27, 0xb8, 0xc3, 0x6f, 0x79, 0x9f, 0xc2, 0xfb, 0x4f, 0xff, 0x9a, 0x83, 0x5a, 0x32,
+	0xa1, 0x93, 0x0f, 0xe1, 0xe1, 0x69, 0xf7, 0x75, 0xb7, 0xf7, 0xa6, 0x3b, 0xea, 0xf6, 0x86, 0x9d,
+	0xa3, 0x4e, 0xf3, 0x70, 0xd8, 0xe9, 0x75, 0x47, 0xc3, 0xb7, 0xfd, 0x76, 0xed, 0x3b, 0x64, 0x1b,
+	0x36, 0xcd, 0x76, 0xff, 0xf8, 0xed, 0x68, 0xd8, 0x1b, 0x0d, 0x5f, 0x99, 0xed, 0xc3, 0x56, 0x2d,
+	0x47, 0xb6, 0x60, 0x3d, 0x04, 0xfb, 0xbd, 0xc1, 0xb0, 0xb6, 0x42, 0x2a, 0xb0, 0x7a, 0xd2, 0xee,
+	0xe2, 0xc8, 0x5a, 0x9e, 0x3c, 0x86, 0x0f, 0x10, 0x1e, 0x75, 0xba, 0xa3, 0xa3, 0xde, 0xf1, 0x71,
+	0xef, 0x4d, 0xbb, 0x15, 0x0c, 0x2e, 0x90, 0x8f, 0xe0, 0xf1, 0xeb, 0xf6, 0xdb, 0x37, 0x3d, 0xb3,
+	0x85, 0xf2, 0xc1, 0x69, 0x63, 0xd0, 0x34, 0x3b, 0x8d, 0x76, 0x6b, 0xd4, 0xe8, 0x1d, 0x9a, 0xad,
+	0x5a, 0x91, 0x10, 0xd8, 0x68, 0x75, 0xcc, 0x76, 0x73, 0x38, 0x3a, 0x69, 0x0f, 0x06, 0x87, 0x5f,
+	0xb5, 0x6b, 0xa5, 0xc6, 0xf7, 0x7e, 0xfd, 0x5d, 0x8b, 0xfa, 0xe7, 0x94, 0x7f, 0x36, 0x61, 0x9c,
+	0x3e, 0x97, 0xed, 0xe7, 0xe2, 0xef, 0x07, 0xb4, 0x3f, 0x28, 0x18, 0x97, 0x04, 0xf2, 0xf2, 0x3f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0x80, 0x55, 0x28, 0x22, 0x6e, 0x20, 0x00, 0x00,
 }
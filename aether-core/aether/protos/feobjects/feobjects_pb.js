@@ -13,9 +13,12 @@ var global = Function('return this')();
 
 goog.exportSymbol('proto.feobjects.AmbientBoardEntity', null, global);
 goog.exportSymbol('proto.feobjects.BackendAmbientStatus', null, global);
+goog.exportSymbol('proto.feobjects.BoardFlairEntity', null, global);
 goog.exportSymbol('proto.feobjects.CUserUsername', null, global);
 goog.exportSymbol('proto.feobjects.CompiledBoardEntity', null, global);
 goog.exportSymbol('proto.feobjects.CompiledContentSignalsEntity', null, global);
+goog.exportSymbol('proto.feobjects.CompiledConversationEntity', null, global);
+goog.exportSymbol('proto.feobjects.CompiledDirectMessageEntity', null, global);
 goog.exportSymbol('proto.feobjects.CompiledNotification', null, global);
 goog.exportSymbol('proto.feobjects.CompiledPostEntity', null, global);
 goog.exportSymbol('proto.feobjects.CompiledThreadEntity', null, global);
@@ -25,6 +28,7 @@ goog.exportSymbol('proto.feobjects.ExplainedSignalEntity', null, global);
 goog.exportSymbol('proto.feobjects.FrontendAmbientStatus', null, global);
 goog.exportSymbol('proto.feobjects.ModActionsTabEntry', null, global);
 goog.exportSymbol('proto.feobjects.NotificationType', null, global);
+goog.exportSymbol('proto.feobjects.ProfileLinkEntity', null, global);
 goog.exportSymbol('proto.feobjects.ReportsTabEntry', null, global);
 
 /**
@@ -49,7 +53,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.feobjects.CompiledBoardEntity.repeatedFields_ = [7,11];
+proto.feobjects.CompiledBoardEntity.repeatedFields_ = [7,11,21];
 
 
 
@@ -99,7 +103,11 @@ proto.feobjects.CompiledBoardEntity.toObject = function(includeInstance, msg) {
     lastseen: jspb.Message.getFieldWithDefault(msg, 16, 0),
     sfwlisted: jspb.Message.getFieldWithDefault(msg, 17, false),
     viewmetaSearchscore: +jspb.Message.getFieldWithDefault(msg, 18, 0.0),
-    lastnewthreadarrived: jspb.Message.getFieldWithDefault(msg, 19, 0)
+    lastnewthreadarrived: jspb.Message.getFieldWithDefault(msg, 19, 0),
+    rules: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    flairsList: jspb.Message.toObjectList(msg.getFlairsList(),
+    proto.feobjects.BoardFlairEntity.toObject, includeInstance),
+    iconhash: jspb.Message.getFieldWithDefault(msg, 22, "")
   };
 
   if (includeInstance) {
@@ -215,6 +223,19 @@ proto.feobjects.CompiledBoardEntity.deserializeBinaryFromReader = function(msg,
       var value = /** @type {number} */ (reader.readInt64());
       msg.setLastnewthreadarrived(value);
       break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRules(value);
+      break;
+    case 21:
+      var value = new proto.feobjects.BoardFlairEntity;
+      reader.readMessage(value,proto.feobjects.BoardFlairEntity.deserializeBinaryFromReader);
+      msg.addFlairs(value);
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIconhash(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -380,6 +401,28 @@ proto.feobjects.CompiledBoardEntity.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getRules();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = message.getFlairsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      21,
+      f,
+      proto.feobjects.BoardFlairEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getIconhash();
+  if (f.length > 0) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
 };
 
 
@@ -736,6 +779,67 @@ proto.feobjects.CompiledBoardEntity.prototype.setLastnewthreadarrived = function
 };
 
 
+/**
+ * optional string Rules = 20;
+ * @return {string}
+ */
+proto.feobjects.CompiledBoardEntity.prototype.getRules = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.CompiledBoardEntity.prototype.setRules = function(value) {
+  jspb.Message.setField(this, 20, value);
+};
+
+
+/**
+ * repeated BoardFlairEntity Flairs = 21;
+ * @return {!Array.<!proto.feobjects.BoardFlairEntity>}
+ */
+proto.feobjects.CompiledBoardEntity.prototype.getFlairsList = function() {
+  return /** @type{!Array.<!proto.feobjects.BoardFlairEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.BoardFlairEntity, 21));
+};
+
+
+/** @param {!Array.<!proto.feobjects.BoardFlairEntity>} value */
+proto.feobjects.CompiledBoardEntity.prototype.setFlairsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 21, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.BoardFlairEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.BoardFlairEntity}
+ */
+proto.feobjects.CompiledBoardEntity.prototype.addFlairs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 21, opt_value, proto.feobjects.BoardFlairEntity, opt_index);
+};
+
+
+proto.feobjects.CompiledBoardEntity.prototype.clearFlairsList = function() {
+  this.setFlairsList([]);
+};
+
+
+/**
+ * optional string IconHash = 22;
+ * @return {string}
+ */
+proto.feobjects.CompiledBoardEntity.prototype.getIconhash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.CompiledBoardEntity.prototype.setIconhash = function(value) {
+  jspb.Message.setField(this, 22, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -759,7 +863,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.feobjects.CompiledThreadEntity.repeatedFields_ = [12];
+proto.feobjects.CompiledThreadEntity.repeatedFields_ = [12,19];
 
 
 
@@ -807,7 +911,9 @@ proto.feobjects.CompiledThreadEntity.toObject = function(includeInstance, msg) {
     score: +jspb.Message.getFieldWithDefault(msg, 14, 0.0),
     viewmetaBoardname: jspb.Message.getFieldWithDefault(msg, 15, ""),
     viewmetaSfwlisted: jspb.Message.getFieldWithDefault(msg, 16, false),
-    viewmetaSearchscore: +jspb.Message.getFieldWithDefault(msg, 17, 0.0)
+    viewmetaSearchscore: +jspb.Message.getFieldWithDefault(msg, 17, 0.0),
+    flair: (f = msg.getFlair()) && proto.feobjects.BoardFlairEntity.toObject(includeInstance, f),
+    contentwarningsList: jspb.Message.getRepeatedField(msg, 19)
   };
 
   if (includeInstance) {
@@ -915,6 +1021,15 @@ proto.feobjects.CompiledThreadEntity.deserializeBinaryFromReader = function(msg,
       var value = /** @type {number} */ (reader.readDouble());
       msg.setViewmetaSearchscore(value);
       break;
+    case 18:
+      var value = new proto.feobjects.BoardFlairEntity;
+      reader.readMessage(value,proto.feobjects.BoardFlairEntity.deserializeBinaryFromReader);
+      msg.setFlair(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addContentwarnings(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1066,6 +1181,21 @@ proto.feobjects.CompiledThreadEntity.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getFlair();
+  if (f != null) {
+    writer.writeMessage(
+      18,
+      f,
+      proto.feobjects.BoardFlairEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getContentwarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      19,
+      f
+    );
+  }
 };
 
 
@@ -1374,6 +1504,65 @@ proto.feobjects.CompiledThreadEntity.prototype.setViewmetaSearchscore = function
 };
 
 
+/**
+ * optional BoardFlairEntity Flair = 18;
+ * @return {?proto.feobjects.BoardFlairEntity}
+ */
+proto.feobjects.CompiledThreadEntity.prototype.getFlair = function() {
+  return /** @type{?proto.feobjects.BoardFlairEntity} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.BoardFlairEntity, 18));
+};
+
+
+/** @param {?proto.feobjects.BoardFlairEntity|undefined} value */
+proto.feobjects.CompiledThreadEntity.prototype.setFlair = function(value) {
+  jspb.Message.setWrapperField(this, 18, value);
+};
+
+
+proto.feobjects.CompiledThreadEntity.prototype.clearFlair = function() {
+  this.setFlair(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feobjects.CompiledThreadEntity.prototype.hasFlair = function() {
+  return jspb.Message.getField(this, 18) != null;
+};
+
+
+/**
+ * repeated string ContentWarnings = 19;
+ * @return {!Array.<string>}
+ */
+proto.feobjects.CompiledThreadEntity.prototype.getContentwarningsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 19));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.feobjects.CompiledThreadEntity.prototype.setContentwarningsList = function(value) {
+  jspb.Message.setField(this, 19, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.feobjects.CompiledThreadEntity.prototype.addContentwarnings = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 19, value, opt_index);
+};
+
+
+proto.feobjects.CompiledThreadEntity.prototype.clearContentwarningsList = function() {
+  this.setContentwarningsList([]);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1397,7 +1586,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.feobjects.CompiledPostEntity.repeatedFields_ = [12];
+proto.feobjects.CompiledPostEntity.repeatedFields_ = [12,17];
 
 
 
@@ -1444,7 +1633,8 @@ proto.feobjects.CompiledPostEntity.toObject = function(includeInstance, msg) {
     viewmetaSfwlisted: jspb.Message.getFieldWithDefault(msg, 13, false),
     viewmetaSearchscore: +jspb.Message.getFieldWithDefault(msg, 14, 0.0),
     viewmetaBoardname: jspb.Message.getFieldWithDefault(msg, 15, ""),
-    viewmetaThreadname: jspb.Message.getFieldWithDefault(msg, 16, "")
+    viewmetaThreadname: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    contentwarningsList: jspb.Message.getRepeatedField(msg, 17)
   };
 
   if (includeInstance) {
@@ -1548,6 +1738,10 @@ proto.feobjects.CompiledPostEntity.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {string} */ (reader.readString());
       msg.setViewmetaThreadname(value);
       break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addContentwarnings(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1692,6 +1886,13 @@ proto.feobjects.CompiledPostEntity.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getContentwarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      17,
+      f
+    );
+  }
 };
 
 
@@ -1985,6 +2186,35 @@ proto.feobjects.CompiledPostEntity.prototype.setViewmetaThreadname = function(va
 };
 
 
+/**
+ * repeated string ContentWarnings = 17;
+ * @return {!Array.<string>}
+ */
+proto.feobjects.CompiledPostEntity.prototype.getContentwarningsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 17));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.feobjects.CompiledPostEntity.prototype.setContentwarningsList = function(value) {
+  jspb.Message.setField(this, 17, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.feobjects.CompiledPostEntity.prototype.addContentwarnings = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 17, value, opt_index);
+};
+
+
+proto.feobjects.CompiledPostEntity.prototype.clearContentwarningsList = function() {
+  this.setContentwarningsList([]);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1997,12 +2227,19 @@ proto.feobjects.CompiledPostEntity.prototype.setViewmetaThreadname = function(va
  * @constructor
  */
 proto.feobjects.CompiledUserEntity = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feobjects.CompiledUserEntity.repeatedFields_, null);
 };
 goog.inherits(proto.feobjects.CompiledUserEntity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   proto.feobjects.CompiledUserEntity.displayName = 'proto.feobjects.CompiledUserEntity';
 }
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feobjects.CompiledUserEntity.repeatedFields_ = [12];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2041,7 +2278,10 @@ proto.feobjects.CompiledUserEntity.toObject = function(includeInstance, msg) {
     expiry: jspb.Message.getFieldWithDefault(msg, 7, 0),
     info: jspb.Message.getFieldWithDefault(msg, 8, ""),
     meta: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    viewmetaSearchscore: +jspb.Message.getFieldWithDefault(msg, 10, 0.0)
+    viewmetaSearchscore: +jspb.Message.getFieldWithDefault(msg, 10, 0.0),
+    avatarhash: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    linksList: jspb.Message.toObjectList(msg.getLinksList(),
+    proto.feobjects.ProfileLinkEntity.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2119,6 +2359,15 @@ proto.feobjects.CompiledUserEntity.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {number} */ (reader.readDouble());
       msg.setViewmetaSearchscore(value);
       break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatarhash(value);
+      break;
+    case 12:
+      var value = new proto.feobjects.ProfileLinkEntity;
+      reader.readMessage(value,proto.feobjects.ProfileLinkEntity.deserializeBinaryFromReader);
+      msg.addLinks(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2219,6 +2468,21 @@ proto.feobjects.CompiledUserEntity.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getAvatarhash();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getLinksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.feobjects.ProfileLinkEntity.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -2387,6 +2651,52 @@ proto.feobjects.CompiledUserEntity.prototype.setViewmetaSearchscore = function(v
 };
 
 
+/**
+ * optional string AvatarHash = 11;
+ * @return {string}
+ */
+proto.feobjects.CompiledUserEntity.prototype.getAvatarhash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.CompiledUserEntity.prototype.setAvatarhash = function(value) {
+  jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * repeated ProfileLinkEntity Links = 12;
+ * @return {!Array.<!proto.feobjects.ProfileLinkEntity>}
+ */
+proto.feobjects.CompiledUserEntity.prototype.getLinksList = function() {
+  return /** @type{!Array.<!proto.feobjects.ProfileLinkEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ProfileLinkEntity, 12));
+};
+
+
+/** @param {!Array.<!proto.feobjects.ProfileLinkEntity>} value */
+proto.feobjects.CompiledUserEntity.prototype.setLinksList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.ProfileLinkEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.ProfileLinkEntity}
+ */
+proto.feobjects.CompiledUserEntity.prototype.addLinks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.feobjects.ProfileLinkEntity, opt_index);
+};
+
+
+proto.feobjects.CompiledUserEntity.prototype.clearLinksList = function() {
+  this.setLinksList([]);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -2596,20 +2906,13 @@ proto.feobjects.CUserUsername.prototype.setCanonical = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.CompiledContentSignalsEntity = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.feobjects.CompiledContentSignalsEntity.repeatedFields_, null);
+proto.feobjects.BoardFlairEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feobjects.CompiledContentSignalsEntity, jspb.Message);
+goog.inherits(proto.feobjects.BoardFlairEntity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.CompiledContentSignalsEntity.displayName = 'proto.feobjects.CompiledContentSignalsEntity';
+  proto.feobjects.BoardFlairEntity.displayName = 'proto.feobjects.BoardFlairEntity';
 }
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.feobjects.CompiledContentSignalsEntity.repeatedFields_ = [9,11,12];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2623,8 +2926,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.CompiledContentSignalsEntity.toObject(opt_includeInstance, this);
+proto.feobjects.BoardFlairEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.BoardFlairEntity.toObject(opt_includeInstance, this);
 };
 
 
@@ -2633,37 +2936,15 @@ proto.feobjects.CompiledContentSignalsEntity.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.CompiledContentSignalsEntity} msg The msg instance to transform.
+ * @param {!proto.feobjects.BoardFlairEntity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledContentSignalsEntity.toObject = function(includeInstance, msg) {
+proto.feobjects.BoardFlairEntity.toObject = function(includeInstance, msg) {
   var f, obj = {
-    targetfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    upvotes: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    downvotes: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    selfupvoted: jspb.Message.getFieldWithDefault(msg, 4, false),
-    selfdownvoted: jspb.Message.getFieldWithDefault(msg, 5, false),
-    selfatdcreation: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    selfatdlastupdate: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    selfatdfingerprint: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    reportsList: jspb.Message.toObjectList(msg.getReportsList(),
-    proto.feobjects.ExplainedSignalEntity.toObject, includeInstance),
-    modblocksList: jspb.Message.toObjectList(msg.getModblocksList(),
-    proto.feobjects.ExplainedSignalEntity.toObject, includeInstance),
-    modapprovalsList: jspb.Message.toObjectList(msg.getModapprovalsList(),
-    proto.feobjects.ExplainedSignalEntity.toObject, includeInstance),
-    bymod: jspb.Message.getFieldWithDefault(msg, 13, false),
-    byfollowedperson: jspb.Message.getFieldWithDefault(msg, 14, false),
-    byblockedperson: jspb.Message.getFieldWithDefault(msg, 15, false),
-    byop: jspb.Message.getFieldWithDefault(msg, 16, false),
-    modblocked: jspb.Message.getFieldWithDefault(msg, 17, false),
-    modapproved: jspb.Message.getFieldWithDefault(msg, 18, false),
-    lastrefreshed: jspb.Message.getFieldWithDefault(msg, 19, 0),
-    selfmodapproved: jspb.Message.getFieldWithDefault(msg, 20, false),
-    selfmodblocked: jspb.Message.getFieldWithDefault(msg, 21, false),
-    selfmodignored: jspb.Message.getFieldWithDefault(msg, 22, false),
-    selfreported: jspb.Message.getFieldWithDefault(msg, 23, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    colour: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -2677,23 +2958,23 @@ proto.feobjects.CompiledContentSignalsEntity.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.CompiledContentSignalsEntity}
+ * @return {!proto.feobjects.BoardFlairEntity}
  */
-proto.feobjects.CompiledContentSignalsEntity.deserializeBinary = function(bytes) {
+proto.feobjects.BoardFlairEntity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.CompiledContentSignalsEntity;
-  return proto.feobjects.CompiledContentSignalsEntity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.BoardFlairEntity;
+  return proto.feobjects.BoardFlairEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.CompiledContentSignalsEntity} msg The message object to deserialize into.
+ * @param {!proto.feobjects.BoardFlairEntity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.CompiledContentSignalsEntity}
+ * @return {!proto.feobjects.BoardFlairEntity}
  */
-proto.feobjects.CompiledContentSignalsEntity.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.BoardFlairEntity.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2702,94 +2983,15 @@ proto.feobjects.CompiledContentSignalsEntity.deserializeBinaryFromReader = funct
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTargetfingerprint(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setUpvotes(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setDownvotes(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSelfupvoted(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSelfdownvoted(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setSelfatdcreation(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setSelfatdlastupdate(value);
-      break;
-    case 8:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSelfatdfingerprint(value);
-      break;
-    case 9:
-      var value = new proto.feobjects.ExplainedSignalEntity;
-      reader.readMessage(value,proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader);
-      msg.addReports(value);
-      break;
-    case 11:
-      var value = new proto.feobjects.ExplainedSignalEntity;
-      reader.readMessage(value,proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader);
-      msg.addModblocks(value);
-      break;
-    case 12:
-      var value = new proto.feobjects.ExplainedSignalEntity;
-      reader.readMessage(value,proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader);
-      msg.addModapprovals(value);
-      break;
-    case 13:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBymod(value);
-      break;
-    case 14:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setByfollowedperson(value);
-      break;
-    case 15:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setByblockedperson(value);
-      break;
-    case 16:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setByop(value);
-      break;
-    case 17:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setModblocked(value);
-      break;
-    case 18:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setModapproved(value);
-      break;
-    case 19:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastrefreshed(value);
-      break;
-    case 20:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSelfmodapproved(value);
-      break;
-    case 21:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSelfmodblocked(value);
-      break;
-    case 22:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSelfmodignored(value);
+      msg.setName(value);
       break;
-    case 23:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSelfreported(value);
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setColour(value);
       break;
     default:
       reader.skipField();
@@ -2804,9 +3006,9 @@ proto.feobjects.CompiledContentSignalsEntity.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.serializeBinary = function() {
+proto.feobjects.BoardFlairEntity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.CompiledContentSignalsEntity.serializeBinaryToWriter(this, writer);
+  proto.feobjects.BoardFlairEntity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2814,166 +3016,30 @@ proto.feobjects.CompiledContentSignalsEntity.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.CompiledContentSignalsEntity} message
+ * @param {!proto.feobjects.BoardFlairEntity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledContentSignalsEntity.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.BoardFlairEntity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTargetfingerprint();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getUpvotes();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getDownvotes();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getSelfupvoted();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getSelfdownvoted();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getSelfatdcreation();
-  if (f !== 0) {
-    writer.writeInt64(
-      6,
-      f
-    );
-  }
-  f = message.getSelfatdlastupdate();
-  if (f !== 0) {
-    writer.writeInt64(
-      7,
-      f
-    );
-  }
-  f = message.getSelfatdfingerprint();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
-      8,
+      2,
       f
     );
   }
-  f = message.getReportsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      9,
-      f,
-      proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter
-    );
-  }
-  f = message.getModblocksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      11,
-      f,
-      proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter
-    );
-  }
-  f = message.getModapprovalsList();
+  f = message.getColour();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter
-    );
-  }
-  f = message.getBymod();
-  if (f) {
-    writer.writeBool(
-      13,
-      f
-    );
-  }
-  f = message.getByfollowedperson();
-  if (f) {
-    writer.writeBool(
-      14,
-      f
-    );
-  }
-  f = message.getByblockedperson();
-  if (f) {
-    writer.writeBool(
-      15,
-      f
-    );
-  }
-  f = message.getByop();
-  if (f) {
-    writer.writeBool(
-      16,
-      f
-    );
-  }
-  f = message.getModblocked();
-  if (f) {
-    writer.writeBool(
-      17,
-      f
-    );
-  }
-  f = message.getModapproved();
-  if (f) {
-    writer.writeBool(
-      18,
-      f
-    );
-  }
-  f = message.getLastrefreshed();
-  if (f !== 0) {
-    writer.writeInt64(
-      19,
-      f
-    );
-  }
-  f = message.getSelfmodapproved();
-  if (f) {
-    writer.writeBool(
-      20,
-      f
-    );
-  }
-  f = message.getSelfmodblocked();
-  if (f) {
-    writer.writeBool(
-      21,
-      f
-    );
-  }
-  f = message.getSelfmodignored();
-  if (f) {
-    writer.writeBool(
-      22,
-      f
-    );
-  }
-  f = message.getSelfreported();
-  if (f) {
-    writer.writeBool(
-      23,
+    writer.writeString(
+      3,
       f
     );
   }
@@ -2981,404 +3047,1770 @@ proto.feobjects.CompiledContentSignalsEntity.serializeBinaryToWriter = function(
 
 
 /**
- * optional string TargetFingerprint = 1;
+ * optional string Id = 1;
  * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getTargetfingerprint = function() {
+proto.feobjects.BoardFlairEntity.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setTargetfingerprint = function(value) {
+proto.feobjects.BoardFlairEntity.prototype.setId = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional int32 Upvotes = 2;
- * @return {number}
+ * optional string Name = 2;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getUpvotes = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.feobjects.BoardFlairEntity.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-/** @param {number} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setUpvotes = function(value) {
+/** @param {string} value */
+proto.feobjects.BoardFlairEntity.prototype.setName = function(value) {
   jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional int32 Downvotes = 3;
- * @return {number}
+ * optional string Colour = 3;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getDownvotes = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.feobjects.BoardFlairEntity.prototype.getColour = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
-/** @param {number} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setDownvotes = function(value) {
+/** @param {string} value */
+proto.feobjects.BoardFlairEntity.prototype.setColour = function(value) {
   jspb.Message.setField(this, 3, value);
 };
 
 
+
 /**
- * optional bool SelfUpvoted = 4;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfupvoted = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+proto.feobjects.ProfileLinkEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.feobjects.ProfileLinkEntity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feobjects.ProfileLinkEntity.displayName = 'proto.feobjects.ProfileLinkEntity';
+}
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfupvoted = function(value) {
-  jspb.Message.setField(this, 4, value);
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feobjects.ProfileLinkEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.ProfileLinkEntity.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional bool SelfDownvoted = 5;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feobjects.ProfileLinkEntity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfdownvoted = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
-};
-
+proto.feobjects.ProfileLinkEntity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    label: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
 
-/** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfdownvoted = function(value) {
-  jspb.Message.setField(this, 5, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int64 SelfATDCreation = 6;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feobjects.ProfileLinkEntity}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfatdcreation = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.feobjects.ProfileLinkEntity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feobjects.ProfileLinkEntity;
+  return proto.feobjects.ProfileLinkEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
-/** @param {number} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfatdcreation = function(value) {
-  jspb.Message.setField(this, 6, value);
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feobjects.ProfileLinkEntity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feobjects.ProfileLinkEntity}
+ */
+proto.feobjects.ProfileLinkEntity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLabel(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional int64 SelfATDLastUpdate = 7;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfatdlastupdate = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.feobjects.ProfileLinkEntity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feobjects.ProfileLinkEntity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
-/** @param {number} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfatdlastupdate = function(value) {
-  jspb.Message.setField(this, 7, value);
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feobjects.ProfileLinkEntity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feobjects.ProfileLinkEntity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLabel();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string SelfATDFingerprint = 8;
+ * optional string Label = 1;
  * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfatdfingerprint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.feobjects.ProfileLinkEntity.prototype.getLabel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfatdfingerprint = function(value) {
-  jspb.Message.setField(this, 8, value);
+proto.feobjects.ProfileLinkEntity.prototype.setLabel = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * repeated ExplainedSignalEntity Reports = 9;
- * @return {!Array.<!proto.feobjects.ExplainedSignalEntity>}
+ * optional string Url = 2;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getReportsList = function() {
-  return /** @type{!Array.<!proto.feobjects.ExplainedSignalEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ExplainedSignalEntity, 9));
+proto.feobjects.ProfileLinkEntity.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-/** @param {!Array.<!proto.feobjects.ExplainedSignalEntity>} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setReportsList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 9, value);
+/** @param {string} value */
+proto.feobjects.ProfileLinkEntity.prototype.setUrl = function(value) {
+  jspb.Message.setField(this, 2, value);
 };
 
 
+
 /**
- * @param {!proto.feobjects.ExplainedSignalEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.ExplainedSignalEntity}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.addReports = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.feobjects.ExplainedSignalEntity, opt_index);
+proto.feobjects.CompiledContentSignalsEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feobjects.CompiledContentSignalsEntity.repeatedFields_, null);
 };
+goog.inherits(proto.feobjects.CompiledContentSignalsEntity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feobjects.CompiledContentSignalsEntity.displayName = 'proto.feobjects.CompiledContentSignalsEntity';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feobjects.CompiledContentSignalsEntity.repeatedFields_ = [9,11,12];
 
 
-proto.feobjects.CompiledContentSignalsEntity.prototype.clearReportsList = function() {
-  this.setReportsList([]);
-};
-
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * repeated ExplainedSignalEntity ModBlocks = 11;
- * @return {!Array.<!proto.feobjects.ExplainedSignalEntity>}
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getModblocksList = function() {
-  return /** @type{!Array.<!proto.feobjects.ExplainedSignalEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ExplainedSignalEntity, 11));
-};
-
-
-/** @param {!Array.<!proto.feobjects.ExplainedSignalEntity>} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setModblocksList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 11, value);
+proto.feobjects.CompiledContentSignalsEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.CompiledContentSignalsEntity.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.feobjects.ExplainedSignalEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.ExplainedSignalEntity}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feobjects.CompiledContentSignalsEntity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.addModblocks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.feobjects.ExplainedSignalEntity, opt_index);
+proto.feobjects.CompiledContentSignalsEntity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    targetfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    upvotes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    downvotes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    selfupvoted: jspb.Message.getFieldWithDefault(msg, 4, false),
+    selfdownvoted: jspb.Message.getFieldWithDefault(msg, 5, false),
+    selfatdcreation: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    selfatdlastupdate: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    selfatdfingerprint: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    reportsList: jspb.Message.toObjectList(msg.getReportsList(),
+    proto.feobjects.ExplainedSignalEntity.toObject, includeInstance),
+    modblocksList: jspb.Message.toObjectList(msg.getModblocksList(),
+    proto.feobjects.ExplainedSignalEntity.toObject, includeInstance),
+    modapprovalsList: jspb.Message.toObjectList(msg.getModapprovalsList(),
+    proto.feobjects.ExplainedSignalEntity.toObject, includeInstance),
+    bymod: jspb.Message.getFieldWithDefault(msg, 13, false),
+    byfollowedperson: jspb.Message.getFieldWithDefault(msg, 14, false),
+    byblockedperson: jspb.Message.getFieldWithDefault(msg, 15, false),
+    byop: jspb.Message.getFieldWithDefault(msg, 16, false),
+    modblocked: jspb.Message.getFieldWithDefault(msg, 17, false),
+    modapproved: jspb.Message.getFieldWithDefault(msg, 18, false),
+    lastrefreshed: jspb.Message.getFieldWithDefault(msg, 19, 0),
+    selfmodapproved: jspb.Message.getFieldWithDefault(msg, 20, false),
+    selfmodblocked: jspb.Message.getFieldWithDefault(msg, 21, false),
+    selfmodignored: jspb.Message.getFieldWithDefault(msg, 22, false),
+    selfreported: jspb.Message.getFieldWithDefault(msg, 23, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
-proto.feobjects.CompiledContentSignalsEntity.prototype.clearModblocksList = function() {
-  this.setModblocksList([]);
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feobjects.CompiledContentSignalsEntity}
+ */
+proto.feobjects.CompiledContentSignalsEntity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feobjects.CompiledContentSignalsEntity;
+  return proto.feobjects.CompiledContentSignalsEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * repeated ExplainedSignalEntity ModApprovals = 12;
- * @return {!Array.<!proto.feobjects.ExplainedSignalEntity>}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feobjects.CompiledContentSignalsEntity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feobjects.CompiledContentSignalsEntity}
+ */
+proto.feobjects.CompiledContentSignalsEntity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetfingerprint(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setUpvotes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setDownvotes(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfupvoted(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfdownvoted(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSelfatdcreation(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSelfatdlastupdate(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSelfatdfingerprint(value);
+      break;
+    case 9:
+      var value = new proto.feobjects.ExplainedSignalEntity;
+      reader.readMessage(value,proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader);
+      msg.addReports(value);
+      break;
+    case 11:
+      var value = new proto.feobjects.ExplainedSignalEntity;
+      reader.readMessage(value,proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader);
+      msg.addModblocks(value);
+      break;
+    case 12:
+      var value = new proto.feobjects.ExplainedSignalEntity;
+      reader.readMessage(value,proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader);
+      msg.addModapprovals(value);
+      break;
+    case 13:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBymod(value);
+      break;
+    case 14:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setByfollowedperson(value);
+      break;
+    case 15:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setByblockedperson(value);
+      break;
+    case 16:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setByop(value);
+      break;
+    case 17:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setModblocked(value);
+      break;
+    case 18:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setModapproved(value);
+      break;
+    case 19:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastrefreshed(value);
+      break;
+    case 20:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfmodapproved(value);
+      break;
+    case 21:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfmodblocked(value);
+      break;
+    case 22:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfmodignored(value);
+      break;
+    case 23:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfreported(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feobjects.CompiledContentSignalsEntity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feobjects.CompiledContentSignalsEntity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feobjects.CompiledContentSignalsEntity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTargetfingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUpvotes();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getDownvotes();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getSelfupvoted();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getSelfdownvoted();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getSelfatdcreation();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getSelfatdlastupdate();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getSelfatdfingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getReportsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getModblocksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      11,
+      f,
+      proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getModapprovalsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getBymod();
+  if (f) {
+    writer.writeBool(
+      13,
+      f
+    );
+  }
+  f = message.getByfollowedperson();
+  if (f) {
+    writer.writeBool(
+      14,
+      f
+    );
+  }
+  f = message.getByblockedperson();
+  if (f) {
+    writer.writeBool(
+      15,
+      f
+    );
+  }
+  f = message.getByop();
+  if (f) {
+    writer.writeBool(
+      16,
+      f
+    );
+  }
+  f = message.getModblocked();
+  if (f) {
+    writer.writeBool(
+      17,
+      f
+    );
+  }
+  f = message.getModapproved();
+  if (f) {
+    writer.writeBool(
+      18,
+      f
+    );
+  }
+  f = message.getLastrefreshed();
+  if (f !== 0) {
+    writer.writeInt64(
+      19,
+      f
+    );
+  }
+  f = message.getSelfmodapproved();
+  if (f) {
+    writer.writeBool(
+      20,
+      f
+    );
+  }
+  f = message.getSelfmodblocked();
+  if (f) {
+    writer.writeBool(
+      21,
+      f
+    );
+  }
+  f = message.getSelfmodignored();
+  if (f) {
+    writer.writeBool(
+      22,
+      f
+    );
+  }
+  f = message.getSelfreported();
+  if (f) {
+    writer.writeBool(
+      23,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string TargetFingerprint = 1;
+ * @return {string}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getTargetfingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setTargetfingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional int32 Upvotes = 2;
+ * @return {number}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getUpvotes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setUpvotes = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional int32 Downvotes = 3;
+ * @return {number}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getDownvotes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setDownvotes = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * optional bool SelfUpvoted = 4;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfupvoted = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfupvoted = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * optional bool SelfDownvoted = 5;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfdownvoted = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfdownvoted = function(value) {
+  jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * optional int64 SelfATDCreation = 6;
+ * @return {number}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfatdcreation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfatdcreation = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional int64 SelfATDLastUpdate = 7;
+ * @return {number}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfatdlastupdate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfatdlastupdate = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * optional string SelfATDFingerprint = 8;
+ * @return {string}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfatdfingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfatdfingerprint = function(value) {
+  jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * repeated ExplainedSignalEntity Reports = 9;
+ * @return {!Array.<!proto.feobjects.ExplainedSignalEntity>}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getReportsList = function() {
+  return /** @type{!Array.<!proto.feobjects.ExplainedSignalEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ExplainedSignalEntity, 9));
+};
+
+
+/** @param {!Array.<!proto.feobjects.ExplainedSignalEntity>} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setReportsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.ExplainedSignalEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.ExplainedSignalEntity}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.addReports = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.feobjects.ExplainedSignalEntity, opt_index);
+};
+
+
+proto.feobjects.CompiledContentSignalsEntity.prototype.clearReportsList = function() {
+  this.setReportsList([]);
+};
+
+
+/**
+ * repeated ExplainedSignalEntity ModBlocks = 11;
+ * @return {!Array.<!proto.feobjects.ExplainedSignalEntity>}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getModblocksList = function() {
+  return /** @type{!Array.<!proto.feobjects.ExplainedSignalEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ExplainedSignalEntity, 11));
+};
+
+
+/** @param {!Array.<!proto.feobjects.ExplainedSignalEntity>} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setModblocksList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 11, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.ExplainedSignalEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.ExplainedSignalEntity}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.addModblocks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.feobjects.ExplainedSignalEntity, opt_index);
+};
+
+
+proto.feobjects.CompiledContentSignalsEntity.prototype.clearModblocksList = function() {
+  this.setModblocksList([]);
+};
+
+
+/**
+ * repeated ExplainedSignalEntity ModApprovals = 12;
+ * @return {!Array.<!proto.feobjects.ExplainedSignalEntity>}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getModapprovalsList = function() {
+  return /** @type{!Array.<!proto.feobjects.ExplainedSignalEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ExplainedSignalEntity, 12));
+};
+
+
+/** @param {!Array.<!proto.feobjects.ExplainedSignalEntity>} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setModapprovalsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.feobjects.ExplainedSignalEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.ExplainedSignalEntity}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.addModapprovals = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.feobjects.ExplainedSignalEntity, opt_index);
+};
+
+
+proto.feobjects.CompiledContentSignalsEntity.prototype.clearModapprovalsList = function() {
+  this.setModapprovalsList([]);
+};
+
+
+/**
+ * optional bool ByMod = 13;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getBymod = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 13, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setBymod = function(value) {
+  jspb.Message.setField(this, 13, value);
+};
+
+
+/**
+ * optional bool ByFollowedPerson = 14;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getByfollowedperson = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 14, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setByfollowedperson = function(value) {
+  jspb.Message.setField(this, 14, value);
+};
+
+
+/**
+ * optional bool ByBlockedPerson = 15;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getByblockedperson = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 15, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setByblockedperson = function(value) {
+  jspb.Message.setField(this, 15, value);
+};
+
+
+/**
+ * optional bool ByOP = 16;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getByop = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 16, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setByop = function(value) {
+  jspb.Message.setField(this, 16, value);
+};
+
+
+/**
+ * optional bool ModBlocked = 17;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getModblocked = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 17, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setModblocked = function(value) {
+  jspb.Message.setField(this, 17, value);
+};
+
+
+/**
+ * optional bool ModApproved = 18;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getModapproved = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 18, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setModapproved = function(value) {
+  jspb.Message.setField(this, 18, value);
+};
+
+
+/**
+ * optional int64 LastRefreshed = 19;
+ * @return {number}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getLastrefreshed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setLastrefreshed = function(value) {
+  jspb.Message.setField(this, 19, value);
+};
+
+
+/**
+ * optional bool SelfModApproved = 20;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfmodapproved = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 20, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfmodapproved = function(value) {
+  jspb.Message.setField(this, 20, value);
+};
+
+
+/**
+ * optional bool SelfModBlocked = 21;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfmodblocked = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 21, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfmodblocked = function(value) {
+  jspb.Message.setField(this, 21, value);
+};
+
+
+/**
+ * optional bool SelfModIgnored = 22;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfmodignored = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 22, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfmodignored = function(value) {
+  jspb.Message.setField(this, 22, value);
+};
+
+
+/**
+ * optional bool SelfReported = 23;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfreported = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 23, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfreported = function(value) {
+  jspb.Message.setField(this, 23, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feobjects.ExplainedSignalEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feobjects.ExplainedSignalEntity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feobjects.ExplainedSignalEntity.displayName = 'proto.feobjects.ExplainedSignalEntity';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feobjects.ExplainedSignalEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.ExplainedSignalEntity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feobjects.ExplainedSignalEntity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feobjects.ExplainedSignalEntity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    sourcefp: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    creation: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    lastupdate: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feobjects.ExplainedSignalEntity}
+ */
+proto.feobjects.ExplainedSignalEntity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feobjects.ExplainedSignalEntity;
+  return proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feobjects.ExplainedSignalEntity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feobjects.ExplainedSignalEntity}
+ */
+proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourcefp(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreation(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastupdate(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feobjects.ExplainedSignalEntity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feobjects.ExplainedSignalEntity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSourcefp();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCreation();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getLastupdate();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string SourceFp = 1;
+ * @return {string}
+ */
+proto.feobjects.ExplainedSignalEntity.prototype.getSourcefp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.ExplainedSignalEntity.prototype.setSourcefp = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional string Reason = 2;
+ * @return {string}
+ */
+proto.feobjects.ExplainedSignalEntity.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.ExplainedSignalEntity.prototype.setReason = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional int64 Creation = 3;
+ * @return {number}
+ */
+proto.feobjects.ExplainedSignalEntity.prototype.getCreation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.ExplainedSignalEntity.prototype.setCreation = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * optional int64 LastUpdate = 4;
+ * @return {number}
+ */
+proto.feobjects.ExplainedSignalEntity.prototype.getLastupdate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.ExplainedSignalEntity.prototype.setLastupdate = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.feobjects.CompiledUserSignalsEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.feobjects.CompiledUserSignalsEntity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.feobjects.CompiledUserSignalsEntity.displayName = 'proto.feobjects.CompiledUserSignalsEntity';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.feobjects.CompiledUserSignalsEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.CompiledUserSignalsEntity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.feobjects.CompiledUserSignalsEntity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feobjects.CompiledUserSignalsEntity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    targetfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    domain: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    followedbyself: jspb.Message.getFieldWithDefault(msg, 3, false),
+    blockedbyself: jspb.Message.getFieldWithDefault(msg, 4, false),
+    followercount: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    canonicalname: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    cnamesourcefingerprint: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    selfpefingerprint: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    selfpecreation: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    selfpelastupdate: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    mademodbyself: jspb.Message.getFieldWithDefault(msg, 11, false),
+    madenonmodbyself: jspb.Message.getFieldWithDefault(msg, 12, false),
+    mademodbydefault: jspb.Message.getFieldWithDefault(msg, 13, false),
+    mademodbynetwork: jspb.Message.getFieldWithDefault(msg, 14, false),
+    madenonmodbynetwork: jspb.Message.getFieldWithDefault(msg, 15, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.feobjects.CompiledUserSignalsEntity}
+ */
+proto.feobjects.CompiledUserSignalsEntity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.feobjects.CompiledUserSignalsEntity;
+  return proto.feobjects.CompiledUserSignalsEntity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.feobjects.CompiledUserSignalsEntity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.feobjects.CompiledUserSignalsEntity}
+ */
+proto.feobjects.CompiledUserSignalsEntity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetfingerprint(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDomain(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowedbyself(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBlockedbyself(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFollowercount(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCanonicalname(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCnamesourcefingerprint(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSelfpefingerprint(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSelfpecreation(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSelfpelastupdate(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMademodbyself(value);
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMadenonmodbyself(value);
+      break;
+    case 13:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMademodbydefault(value);
+      break;
+    case 14:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMademodbynetwork(value);
+      break;
+    case 15:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMadenonmodbynetwork(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.feobjects.CompiledUserSignalsEntity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.feobjects.CompiledUserSignalsEntity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.feobjects.CompiledUserSignalsEntity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.feobjects.CompiledUserSignalsEntity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTargetfingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDomain();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFollowedbyself();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getBlockedbyself();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getFollowercount();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getCanonicalname();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getCnamesourcefingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getSelfpefingerprint();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getSelfpecreation();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getSelfpelastupdate();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getMademodbyself();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
+  f = message.getMadenonmodbyself();
+  if (f) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
+  f = message.getMademodbydefault();
+  if (f) {
+    writer.writeBool(
+      13,
+      f
+    );
+  }
+  f = message.getMademodbynetwork();
+  if (f) {
+    writer.writeBool(
+      14,
+      f
+    );
+  }
+  f = message.getMadenonmodbynetwork();
+  if (f) {
+    writer.writeBool(
+      15,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string TargetFingerprint = 1;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getModapprovalsList = function() {
-  return /** @type{!Array.<!proto.feobjects.ExplainedSignalEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.ExplainedSignalEntity, 12));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getTargetfingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-/** @param {!Array.<!proto.feobjects.ExplainedSignalEntity>} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setModapprovalsList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 12, value);
+/** @param {string} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setTargetfingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.feobjects.ExplainedSignalEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.ExplainedSignalEntity}
+ * optional string Domain = 2;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.addModapprovals = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.feobjects.ExplainedSignalEntity, opt_index);
+proto.feobjects.CompiledUserSignalsEntity.prototype.getDomain = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-proto.feobjects.CompiledContentSignalsEntity.prototype.clearModapprovalsList = function() {
-  this.setModapprovalsList([]);
+/** @param {string} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setDomain = function(value) {
+  jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional bool ByMod = 13;
+ * optional bool FollowedBySelf = 3;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getBymod = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 13, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getFollowedbyself = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setBymod = function(value) {
-  jspb.Message.setField(this, 13, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setFollowedbyself = function(value) {
+  jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional bool ByFollowedPerson = 14;
+ * optional bool BlockedBySelf = 4;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getByfollowedperson = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 14, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getBlockedbyself = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setByfollowedperson = function(value) {
-  jspb.Message.setField(this, 14, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setBlockedbyself = function(value) {
+  jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional bool ByBlockedPerson = 15;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional int32 FollowerCount = 5;
+ * @return {number}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getByblockedperson = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 15, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getFollowercount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setByblockedperson = function(value) {
-  jspb.Message.setField(this, 15, value);
+/** @param {number} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setFollowercount = function(value) {
+  jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * optional bool ByOP = 16;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional string CanonicalName = 6;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getByop = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 16, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getCanonicalname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setByop = function(value) {
-  jspb.Message.setField(this, 16, value);
+/** @param {string} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setCanonicalname = function(value) {
+  jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * optional bool ModBlocked = 17;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional string CNameSourceFingerprint = 7;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getModblocked = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 17, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getCnamesourcefingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setModblocked = function(value) {
-  jspb.Message.setField(this, 17, value);
+/** @param {string} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setCnamesourcefingerprint = function(value) {
+  jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * optional bool ModApproved = 18;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional string SelfPEFingerprint = 8;
+ * @return {string}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getModapproved = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 18, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getSelfpefingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setModapproved = function(value) {
-  jspb.Message.setField(this, 18, value);
+/** @param {string} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setSelfpefingerprint = function(value) {
+  jspb.Message.setField(this, 8, value);
 };
 
 
 /**
- * optional int64 LastRefreshed = 19;
+ * optional int64 SelfPECreation = 9;
  * @return {number}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getLastrefreshed = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getSelfpecreation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setLastrefreshed = function(value) {
-  jspb.Message.setField(this, 19, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setSelfpecreation = function(value) {
+  jspb.Message.setField(this, 9, value);
 };
 
 
 /**
- * optional bool SelfModApproved = 20;
+ * optional int64 SelfPELastUpdate = 10;
+ * @return {number}
+ */
+proto.feobjects.CompiledUserSignalsEntity.prototype.getSelfpelastupdate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setSelfpelastupdate = function(value) {
+  jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * optional bool MadeModBySelf = 11;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfmodapproved = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 20, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getMademodbyself = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 11, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfmodapproved = function(value) {
-  jspb.Message.setField(this, 20, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setMademodbyself = function(value) {
+  jspb.Message.setField(this, 11, value);
 };
 
 
 /**
- * optional bool SelfModBlocked = 21;
+ * optional bool MadeNonModBySelf = 12;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfmodblocked = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 21, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getMadenonmodbyself = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 12, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfmodblocked = function(value) {
-  jspb.Message.setField(this, 21, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setMadenonmodbyself = function(value) {
+  jspb.Message.setField(this, 12, value);
 };
 
 
 /**
- * optional bool SelfModIgnored = 22;
+ * optional bool MadeModByDefault = 13;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfmodignored = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 22, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getMademodbydefault = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 13, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfmodignored = function(value) {
-  jspb.Message.setField(this, 22, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setMademodbydefault = function(value) {
+  jspb.Message.setField(this, 13, value);
 };
 
 
 /**
- * optional bool SelfReported = 23;
+ * optional bool MadeModByNetwork = 14;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledContentSignalsEntity.prototype.getSelfreported = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 23, false));
+proto.feobjects.CompiledUserSignalsEntity.prototype.getMademodbynetwork = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 14, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfreported = function(value) {
-  jspb.Message.setField(this, 23, value);
+proto.feobjects.CompiledUserSignalsEntity.prototype.setMademodbynetwork = function(value) {
+  jspb.Message.setField(this, 14, value);
+};
+
+
+/**
+ * optional bool MadeNonModByNetwork = 15;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.CompiledUserSignalsEntity.prototype.getMadenonmodbynetwork = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 15, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.CompiledUserSignalsEntity.prototype.setMadenonmodbynetwork = function(value) {
+  jspb.Message.setField(this, 15, value);
 };
 
 
@@ -3393,12 +4825,12 @@ proto.feobjects.CompiledContentSignalsEntity.prototype.setSelfreported = functio
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.ExplainedSignalEntity = function(opt_data) {
+proto.feobjects.AmbientBoardEntity = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feobjects.ExplainedSignalEntity, jspb.Message);
+goog.inherits(proto.feobjects.AmbientBoardEntity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.ExplainedSignalEntity.displayName = 'proto.feobjects.ExplainedSignalEntity';
+  proto.feobjects.AmbientBoardEntity.displayName = 'proto.feobjects.AmbientBoardEntity';
 }
 
 
@@ -3413,8 +4845,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.ExplainedSignalEntity.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.ExplainedSignalEntity.toObject(opt_includeInstance, this);
+proto.feobjects.AmbientBoardEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.AmbientBoardEntity.toObject(opt_includeInstance, this);
 };
 
 
@@ -3423,16 +4855,19 @@ proto.feobjects.ExplainedSignalEntity.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.ExplainedSignalEntity} msg The msg instance to transform.
+ * @param {!proto.feobjects.AmbientBoardEntity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.ExplainedSignalEntity.toObject = function(includeInstance, msg) {
+proto.feobjects.AmbientBoardEntity.toObject = function(includeInstance, msg) {
   var f, obj = {
-    sourcefp: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    reason: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    creation: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    lastupdate: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    lastupdate: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    lastseen: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    notify: jspb.Message.getFieldWithDefault(msg, 5, false),
+    usercount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    lastnewthreadarrived: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -3446,23 +4881,23 @@ proto.feobjects.ExplainedSignalEntity.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.ExplainedSignalEntity}
+ * @return {!proto.feobjects.AmbientBoardEntity}
  */
-proto.feobjects.ExplainedSignalEntity.deserializeBinary = function(bytes) {
+proto.feobjects.AmbientBoardEntity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.ExplainedSignalEntity;
-  return proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.AmbientBoardEntity;
+  return proto.feobjects.AmbientBoardEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.ExplainedSignalEntity} msg The message object to deserialize into.
+ * @param {!proto.feobjects.AmbientBoardEntity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.ExplainedSignalEntity}
+ * @return {!proto.feobjects.AmbientBoardEntity}
  */
-proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.AmbientBoardEntity.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3471,19 +4906,31 @@ proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader = function(msg
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSourcefp(value);
+      msg.setFingerprint(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setReason(value);
+      msg.setName(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setCreation(value);
+      msg.setLastupdate(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastupdate(value);
+      msg.setLastseen(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNotify(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUsercount(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastnewthreadarrived(value);
       break;
     default:
       reader.skipField();
@@ -3498,9 +4945,9 @@ proto.feobjects.ExplainedSignalEntity.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.ExplainedSignalEntity.prototype.serializeBinary = function() {
+proto.feobjects.AmbientBoardEntity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter(this, writer);
+  proto.feobjects.AmbientBoardEntity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3508,103 +4955,171 @@ proto.feobjects.ExplainedSignalEntity.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.ExplainedSignalEntity} message
+ * @param {!proto.feobjects.AmbientBoardEntity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.ExplainedSignalEntity.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.AmbientBoardEntity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSourcefp();
+  f = message.getFingerprint();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getReason();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getCreation();
+  f = message.getLastupdate();
   if (f !== 0) {
     writer.writeInt64(
       3,
       f
     );
   }
-  f = message.getLastupdate();
+  f = message.getLastseen();
   if (f !== 0) {
     writer.writeInt64(
       4,
       f
     );
   }
+  f = message.getNotify();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getUsercount();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getLastnewthreadarrived();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string SourceFp = 1;
+ * optional string Fingerprint = 1;
  * @return {string}
  */
-proto.feobjects.ExplainedSignalEntity.prototype.getSourcefp = function() {
+proto.feobjects.AmbientBoardEntity.prototype.getFingerprint = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.ExplainedSignalEntity.prototype.setSourcefp = function(value) {
+proto.feobjects.AmbientBoardEntity.prototype.setFingerprint = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional string Reason = 2;
+ * optional string Name = 2;
  * @return {string}
  */
-proto.feobjects.ExplainedSignalEntity.prototype.getReason = function() {
+proto.feobjects.AmbientBoardEntity.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.ExplainedSignalEntity.prototype.setReason = function(value) {
+proto.feobjects.AmbientBoardEntity.prototype.setName = function(value) {
   jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional int64 Creation = 3;
+ * optional int64 LastUpdate = 3;
  * @return {number}
  */
-proto.feobjects.ExplainedSignalEntity.prototype.getCreation = function() {
+proto.feobjects.AmbientBoardEntity.prototype.getLastupdate = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.ExplainedSignalEntity.prototype.setCreation = function(value) {
+proto.feobjects.AmbientBoardEntity.prototype.setLastupdate = function(value) {
   jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional int64 LastUpdate = 4;
+ * optional int64 LastSeen = 4;
  * @return {number}
  */
-proto.feobjects.ExplainedSignalEntity.prototype.getLastupdate = function() {
+proto.feobjects.AmbientBoardEntity.prototype.getLastseen = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.ExplainedSignalEntity.prototype.setLastupdate = function(value) {
+proto.feobjects.AmbientBoardEntity.prototype.setLastseen = function(value) {
   jspb.Message.setField(this, 4, value);
 };
 
 
+/**
+ * optional bool Notify = 5;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.AmbientBoardEntity.prototype.getNotify = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+};
+
+
+/** @param {boolean} value */
+proto.feobjects.AmbientBoardEntity.prototype.setNotify = function(value) {
+  jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * optional int64 UserCount = 6;
+ * @return {number}
+ */
+proto.feobjects.AmbientBoardEntity.prototype.getUsercount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.AmbientBoardEntity.prototype.setUsercount = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional int64 LastNewThreadArrived = 7;
+ * @return {number}
+ */
+proto.feobjects.AmbientBoardEntity.prototype.getLastnewthreadarrived = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.AmbientBoardEntity.prototype.setLastnewthreadarrived = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -3616,12 +5131,12 @@ proto.feobjects.ExplainedSignalEntity.prototype.setLastupdate = function(value)
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.CompiledUserSignalsEntity = function(opt_data) {
+proto.feobjects.BackendAmbientStatus = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feobjects.CompiledUserSignalsEntity, jspb.Message);
+goog.inherits(proto.feobjects.BackendAmbientStatus, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.CompiledUserSignalsEntity.displayName = 'proto.feobjects.CompiledUserSignalsEntity';
+  proto.feobjects.BackendAmbientStatus.displayName = 'proto.feobjects.BackendAmbientStatus';
 }
 
 
@@ -3636,8 +5151,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.CompiledUserSignalsEntity.toObject(opt_includeInstance, this);
+proto.feobjects.BackendAmbientStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.BackendAmbientStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -3646,27 +5161,32 @@ proto.feobjects.CompiledUserSignalsEntity.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.CompiledUserSignalsEntity} msg The msg instance to transform.
+ * @param {!proto.feobjects.BackendAmbientStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledUserSignalsEntity.toObject = function(includeInstance, msg) {
+proto.feobjects.BackendAmbientStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-    targetfingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    domain: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    followedbyself: jspb.Message.getFieldWithDefault(msg, 3, false),
-    blockedbyself: jspb.Message.getFieldWithDefault(msg, 4, false),
-    followercount: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    canonicalname: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    cnamesourcefingerprint: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    selfpefingerprint: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    selfpecreation: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    selfpelastupdate: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    mademodbyself: jspb.Message.getFieldWithDefault(msg, 11, false),
-    madenonmodbyself: jspb.Message.getFieldWithDefault(msg, 12, false),
-    mademodbydefault: jspb.Message.getFieldWithDefault(msg, 13, false),
-    mademodbynetwork: jspb.Message.getFieldWithDefault(msg, 14, false),
-    madenonmodbynetwork: jspb.Message.getFieldWithDefault(msg, 15, false)
+    bootstrapinprogress: jspb.Message.getFieldWithDefault(msg, 18, false),
+    lastbootstraptimestamp: jspb.Message.getFieldWithDefault(msg, 19, 0),
+    triggerbootstraprefresh: jspb.Message.getFieldWithDefault(msg, 20, false),
+    lastinboundconntimestamp: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    inboundscount15: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lastoutboundconntimestamp: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    outboundscount15: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    lastoutbounddurationseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    localnodeexternalip: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    localnodeexternalport: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    upnpstatus: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    databasestatus: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    dbsizemb: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    maxdbsizemb: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    lastdbinserttimestamp: jspb.Message.getFieldWithDefault(msg, 12, 0),
+    lastinsertdurationseconds: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    cachingstatus: jspb.Message.getFieldWithDefault(msg, 14, ""),
+    lastcachegenerationtimestamp: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    lastcachegenerationdurationseconds: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    backendconfiglocation: jspb.Message.getFieldWithDefault(msg, 17, "")
   };
 
   if (includeInstance) {
@@ -3680,88 +5200,108 @@ proto.feobjects.CompiledUserSignalsEntity.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.CompiledUserSignalsEntity}
+ * @return {!proto.feobjects.BackendAmbientStatus}
  */
-proto.feobjects.CompiledUserSignalsEntity.deserializeBinary = function(bytes) {
+proto.feobjects.BackendAmbientStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.CompiledUserSignalsEntity;
-  return proto.feobjects.CompiledUserSignalsEntity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.BackendAmbientStatus;
+  return proto.feobjects.BackendAmbientStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.CompiledUserSignalsEntity} msg The message object to deserialize into.
+ * @param {!proto.feobjects.BackendAmbientStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.CompiledUserSignalsEntity}
+ * @return {!proto.feobjects.BackendAmbientStatus}
  */
-proto.feobjects.CompiledUserSignalsEntity.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.BackendAmbientStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 18:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBootstrapinprogress(value);
+      break;
+    case 19:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastbootstraptimestamp(value);
+      break;
+    case 20:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTriggerbootstraprefresh(value);
+      break;
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTargetfingerprint(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastinboundconntimestamp(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDomain(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInboundscount15(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowedbyself(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastoutboundconntimestamp(value);
       break;
     case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBlockedbyself(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOutboundscount15(value);
       break;
     case 5:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setFollowercount(value);
+      msg.setLastoutbounddurationseconds(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCanonicalname(value);
+      msg.setLocalnodeexternalip(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCnamesourcefingerprint(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLocalnodeexternalport(value);
       break;
     case 8:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSelfpefingerprint(value);
+      msg.setUpnpstatus(value);
       break;
     case 9:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setSelfpecreation(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDatabasestatus(value);
       break;
     case 10:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setSelfpelastupdate(value);
+      msg.setDbsizemb(value);
       break;
     case 11:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setMademodbyself(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMaxdbsizemb(value);
       break;
     case 12:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setMadenonmodbyself(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastdbinserttimestamp(value);
       break;
     case 13:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setMademodbydefault(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLastinsertdurationseconds(value);
       break;
     case 14:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setMademodbynetwork(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCachingstatus(value);
       break;
     case 15:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setMadenonmodbynetwork(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastcachegenerationtimestamp(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLastcachegenerationdurationseconds(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBackendconfiglocation(value);
       break;
     default:
       reader.skipField();
@@ -3776,9 +5316,9 @@ proto.feobjects.CompiledUserSignalsEntity.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.serializeBinary = function() {
+proto.feobjects.BackendAmbientStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.CompiledUserSignalsEntity.serializeBinaryToWriter(this, writer);
+  proto.feobjects.BackendAmbientStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3786,356 +5326,456 @@ proto.feobjects.CompiledUserSignalsEntity.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.CompiledUserSignalsEntity} message
+ * @param {!proto.feobjects.BackendAmbientStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledUserSignalsEntity.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.BackendAmbientStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTargetfingerprint();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getBootstrapinprogress();
+  if (f) {
+    writer.writeBool(
+      18,
+      f
+    );
+  }
+  f = message.getLastbootstraptimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      19,
+      f
+    );
+  }
+  f = message.getTriggerbootstraprefresh();
+  if (f) {
+    writer.writeBool(
+      20,
+      f
+    );
+  }
+  f = message.getLastinboundconntimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getDomain();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getInboundscount15();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getFollowedbyself();
-  if (f) {
-    writer.writeBool(
+  f = message.getLastoutboundconntimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
       3,
       f
     );
   }
-  f = message.getBlockedbyself();
-  if (f) {
-    writer.writeBool(
+  f = message.getOutboundscount15();
+  if (f !== 0) {
+    writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getFollowercount();
+  f = message.getLastoutbounddurationseconds();
   if (f !== 0) {
     writer.writeInt32(
       5,
       f
     );
   }
-  f = message.getCanonicalname();
+  f = message.getLocalnodeexternalip();
   if (f.length > 0) {
     writer.writeString(
       6,
       f
     );
   }
-  f = message.getCnamesourcefingerprint();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLocalnodeexternalport();
+  if (f !== 0) {
+    writer.writeInt32(
       7,
       f
     );
   }
-  f = message.getSelfpefingerprint();
+  f = message.getUpnpstatus();
   if (f.length > 0) {
     writer.writeString(
       8,
       f
     );
   }
-  f = message.getSelfpecreation();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getDatabasestatus();
+  if (f.length > 0) {
+    writer.writeString(
       9,
       f
     );
   }
-  f = message.getSelfpelastupdate();
+  f = message.getDbsizemb();
   if (f !== 0) {
     writer.writeInt64(
       10,
       f
     );
   }
-  f = message.getMademodbyself();
-  if (f) {
-    writer.writeBool(
+  f = message.getMaxdbsizemb();
+  if (f !== 0) {
+    writer.writeInt64(
       11,
       f
     );
   }
-  f = message.getMadenonmodbyself();
-  if (f) {
-    writer.writeBool(
+  f = message.getLastdbinserttimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
       12,
       f
     );
   }
-  f = message.getMademodbydefault();
-  if (f) {
-    writer.writeBool(
+  f = message.getLastinsertdurationseconds();
+  if (f !== 0) {
+    writer.writeInt32(
       13,
       f
     );
   }
-  f = message.getMademodbynetwork();
-  if (f) {
-    writer.writeBool(
+  f = message.getCachingstatus();
+  if (f.length > 0) {
+    writer.writeString(
       14,
       f
     );
   }
-  f = message.getMadenonmodbynetwork();
-  if (f) {
-    writer.writeBool(
+  f = message.getLastcachegenerationtimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
       15,
       f
     );
   }
+  f = message.getLastcachegenerationdurationseconds();
+  if (f !== 0) {
+    writer.writeInt32(
+      16,
+      f
+    );
+  }
+  f = message.getBackendconfiglocation();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string TargetFingerprint = 1;
- * @return {string}
+ * optional bool BootstrapInProgress = 18;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getTargetfingerprint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.feobjects.BackendAmbientStatus.prototype.getBootstrapinprogress = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 18, false));
 };
 
 
-/** @param {string} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setTargetfingerprint = function(value) {
-  jspb.Message.setField(this, 1, value);
+/** @param {boolean} value */
+proto.feobjects.BackendAmbientStatus.prototype.setBootstrapinprogress = function(value) {
+  jspb.Message.setField(this, 18, value);
 };
 
 
 /**
- * optional string Domain = 2;
- * @return {string}
+ * optional int64 LastBootstrapTimestamp = 19;
+ * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getDomain = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.feobjects.BackendAmbientStatus.prototype.getLastbootstraptimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
 };
 
 
-/** @param {string} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setDomain = function(value) {
-  jspb.Message.setField(this, 2, value);
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLastbootstraptimestamp = function(value) {
+  jspb.Message.setField(this, 19, value);
 };
 
 
 /**
- * optional bool FollowedBySelf = 3;
+ * optional bool TriggerBootstrapRefresh = 20;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getFollowedbyself = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+proto.feobjects.BackendAmbientStatus.prototype.getTriggerbootstraprefresh = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 20, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setFollowedbyself = function(value) {
+proto.feobjects.BackendAmbientStatus.prototype.setTriggerbootstraprefresh = function(value) {
+  jspb.Message.setField(this, 20, value);
+};
+
+
+/**
+ * optional int64 LastInboundConnTimestamp = 1;
+ * @return {number}
+ */
+proto.feobjects.BackendAmbientStatus.prototype.getLastinboundconntimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLastinboundconntimestamp = function(value) {
+  jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * optional int32 InboundsCount15 = 2;
+ * @return {number}
+ */
+proto.feobjects.BackendAmbientStatus.prototype.getInboundscount15 = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setInboundscount15 = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional int64 LastOutboundConnTimestamp = 3;
+ * @return {number}
+ */
+proto.feobjects.BackendAmbientStatus.prototype.getLastoutboundconntimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLastoutboundconntimestamp = function(value) {
   jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional bool BlockedBySelf = 4;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional int32 OutboundsCount15 = 4;
+ * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getBlockedbyself = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+proto.feobjects.BackendAmbientStatus.prototype.getOutboundscount15 = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setBlockedbyself = function(value) {
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setOutboundscount15 = function(value) {
   jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional int32 FollowerCount = 5;
+ * optional int32 LastOutboundDurationSeconds = 5;
  * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getFollowercount = function() {
+proto.feobjects.BackendAmbientStatus.prototype.getLastoutbounddurationseconds = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setFollowercount = function(value) {
+proto.feobjects.BackendAmbientStatus.prototype.setLastoutbounddurationseconds = function(value) {
   jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * optional string CanonicalName = 6;
+ * optional string LocalNodeExternalIP = 6;
  * @return {string}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getCanonicalname = function() {
+proto.feobjects.BackendAmbientStatus.prototype.getLocalnodeexternalip = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setCanonicalname = function(value) {
+proto.feobjects.BackendAmbientStatus.prototype.setLocalnodeexternalip = function(value) {
   jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * optional string CNameSourceFingerprint = 7;
- * @return {string}
+ * optional int32 LocalNodeExternalPort = 7;
+ * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getCnamesourcefingerprint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.feobjects.BackendAmbientStatus.prototype.getLocalnodeexternalport = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
-/** @param {string} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setCnamesourcefingerprint = function(value) {
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLocalnodeexternalport = function(value) {
   jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * optional string SelfPEFingerprint = 8;
+ * optional string UPNPStatus = 8;
  * @return {string}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getSelfpefingerprint = function() {
+proto.feobjects.BackendAmbientStatus.prototype.getUpnpstatus = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setSelfpefingerprint = function(value) {
+proto.feobjects.BackendAmbientStatus.prototype.setUpnpstatus = function(value) {
   jspb.Message.setField(this, 8, value);
 };
 
 
 /**
- * optional int64 SelfPECreation = 9;
+ * optional string DatabaseStatus = 9;
+ * @return {string}
+ */
+proto.feobjects.BackendAmbientStatus.prototype.getDatabasestatus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/** @param {string} value */
+proto.feobjects.BackendAmbientStatus.prototype.setDatabasestatus = function(value) {
+  jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * optional int64 DbSizeMb = 10;
+ * @return {number}
+ */
+proto.feobjects.BackendAmbientStatus.prototype.getDbsizemb = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setDbsizemb = function(value) {
+  jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * optional int64 MaxDbSizeMb = 11;
  * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getSelfpecreation = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.feobjects.BackendAmbientStatus.prototype.getMaxdbsizemb = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setSelfpecreation = function(value) {
-  jspb.Message.setField(this, 9, value);
+proto.feobjects.BackendAmbientStatus.prototype.setMaxdbsizemb = function(value) {
+  jspb.Message.setField(this, 11, value);
 };
 
 
 /**
- * optional int64 SelfPELastUpdate = 10;
+ * optional int64 LastDbInsertTimestamp = 12;
  * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getSelfpelastupdate = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.feobjects.BackendAmbientStatus.prototype.getLastdbinserttimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setSelfpelastupdate = function(value) {
-  jspb.Message.setField(this, 10, value);
+proto.feobjects.BackendAmbientStatus.prototype.setLastdbinserttimestamp = function(value) {
+  jspb.Message.setField(this, 12, value);
 };
 
 
 /**
- * optional bool MadeModBySelf = 11;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional int32 LastInsertDurationSeconds = 13;
+ * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getMademodbyself = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 11, false));
+proto.feobjects.BackendAmbientStatus.prototype.getLastinsertdurationseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setMademodbyself = function(value) {
-  jspb.Message.setField(this, 11, value);
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLastinsertdurationseconds = function(value) {
+  jspb.Message.setField(this, 13, value);
 };
 
 
 /**
- * optional bool MadeNonModBySelf = 12;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional string CachingStatus = 14;
+ * @return {string}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getMadenonmodbyself = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 12, false));
+proto.feobjects.BackendAmbientStatus.prototype.getCachingstatus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setMadenonmodbyself = function(value) {
-  jspb.Message.setField(this, 12, value);
+/** @param {string} value */
+proto.feobjects.BackendAmbientStatus.prototype.setCachingstatus = function(value) {
+  jspb.Message.setField(this, 14, value);
 };
 
 
 /**
- * optional bool MadeModByDefault = 13;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional int64 LastCacheGenerationTimestamp = 15;
+ * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getMademodbydefault = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 13, false));
+proto.feobjects.BackendAmbientStatus.prototype.getLastcachegenerationtimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setMademodbydefault = function(value) {
-  jspb.Message.setField(this, 13, value);
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLastcachegenerationtimestamp = function(value) {
+  jspb.Message.setField(this, 15, value);
 };
 
 
 /**
- * optional bool MadeModByNetwork = 14;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional int32 LastCacheGenerationDurationSeconds = 16;
+ * @return {number}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getMademodbynetwork = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 14, false));
+proto.feobjects.BackendAmbientStatus.prototype.getLastcachegenerationdurationseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setMademodbynetwork = function(value) {
-  jspb.Message.setField(this, 14, value);
+/** @param {number} value */
+proto.feobjects.BackendAmbientStatus.prototype.setLastcachegenerationdurationseconds = function(value) {
+  jspb.Message.setField(this, 16, value);
 };
 
 
 /**
- * optional bool MadeNonModByNetwork = 15;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional string BackendConfigLocation = 17;
+ * @return {string}
  */
-proto.feobjects.CompiledUserSignalsEntity.prototype.getMadenonmodbynetwork = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 15, false));
+proto.feobjects.BackendAmbientStatus.prototype.getBackendconfiglocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.CompiledUserSignalsEntity.prototype.setMadenonmodbynetwork = function(value) {
-  jspb.Message.setField(this, 15, value);
+/** @param {string} value */
+proto.feobjects.BackendAmbientStatus.prototype.setBackendconfiglocation = function(value) {
+  jspb.Message.setField(this, 17, value);
 };
 
 
@@ -4150,12 +5790,12 @@ proto.feobjects.CompiledUserSignalsEntity.prototype.setMadenonmodbynetwork = fun
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.AmbientBoardEntity = function(opt_data) {
+proto.feobjects.FrontendAmbientStatus = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feobjects.AmbientBoardEntity, jspb.Message);
+goog.inherits(proto.feobjects.FrontendAmbientStatus, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.AmbientBoardEntity.displayName = 'proto.feobjects.AmbientBoardEntity';
+  proto.feobjects.FrontendAmbientStatus.displayName = 'proto.feobjects.FrontendAmbientStatus';
 }
 
 
@@ -4170,8 +5810,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.AmbientBoardEntity.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.AmbientBoardEntity.toObject(opt_includeInstance, this);
+proto.feobjects.FrontendAmbientStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.FrontendAmbientStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -4180,19 +5820,19 @@ proto.feobjects.AmbientBoardEntity.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.AmbientBoardEntity} msg The msg instance to transform.
+ * @param {!proto.feobjects.FrontendAmbientStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.AmbientBoardEntity.toObject = function(includeInstance, msg) {
+proto.feobjects.FrontendAmbientStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    lastupdate: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    lastseen: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    notify: jspb.Message.getFieldWithDefault(msg, 5, false),
-    usercount: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    lastnewthreadarrived: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    refresherstatus: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    lastrefreshtimestamp: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lastrefreshdurationseconds: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    frontendconfiglocation: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    sfwlistdisabled: jspb.Message.getFieldWithDefault(msg, 5, false),
+    bootstraprefreshinprogress: jspb.Message.getFieldWithDefault(msg, 6, false),
+    bootstraprefreshcomplete: jspb.Message.getFieldWithDefault(msg, 7, false)
   };
 
   if (includeInstance) {
@@ -4206,23 +5846,23 @@ proto.feobjects.AmbientBoardEntity.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.AmbientBoardEntity}
+ * @return {!proto.feobjects.FrontendAmbientStatus}
  */
-proto.feobjects.AmbientBoardEntity.deserializeBinary = function(bytes) {
+proto.feobjects.FrontendAmbientStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.AmbientBoardEntity;
-  return proto.feobjects.AmbientBoardEntity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.FrontendAmbientStatus;
+  return proto.feobjects.FrontendAmbientStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.AmbientBoardEntity} msg The message object to deserialize into.
+ * @param {!proto.feobjects.FrontendAmbientStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.AmbientBoardEntity}
+ * @return {!proto.feobjects.FrontendAmbientStatus}
  */
-proto.feobjects.AmbientBoardEntity.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.FrontendAmbientStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4231,31 +5871,31 @@ proto.feobjects.AmbientBoardEntity.deserializeBinaryFromReader = function(msg, r
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFingerprint(value);
+      msg.setRefresherstatus(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastrefreshtimestamp(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastupdate(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLastrefreshdurationseconds(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastseen(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFrontendconfiglocation(value);
       break;
     case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setNotify(value);
+      msg.setSfwlistdisabled(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setUsercount(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBootstraprefreshinprogress(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastnewthreadarrived(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBootstraprefreshcomplete(value);
       break;
     default:
       reader.skipField();
@@ -4270,9 +5910,9 @@ proto.feobjects.AmbientBoardEntity.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.AmbientBoardEntity.prototype.serializeBinary = function() {
+proto.feobjects.FrontendAmbientStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.AmbientBoardEntity.serializeBinaryToWriter(this, writer);
+  proto.feobjects.FrontendAmbientStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4280,57 +5920,57 @@ proto.feobjects.AmbientBoardEntity.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.AmbientBoardEntity} message
+ * @param {!proto.feobjects.FrontendAmbientStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.AmbientBoardEntity.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.FrontendAmbientStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFingerprint();
+  f = message.getRefresherstatus();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLastrefreshtimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
       f
     );
   }
-  f = message.getLastupdate();
+  f = message.getLastrefreshdurationseconds();
   if (f !== 0) {
-    writer.writeInt64(
+    writer.writeInt32(
       3,
       f
     );
   }
-  f = message.getLastseen();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getFrontendconfiglocation();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getNotify();
+  f = message.getSfwlistdisabled();
   if (f) {
     writer.writeBool(
       5,
       f
     );
   }
-  f = message.getUsercount();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getBootstraprefreshinprogress();
+  if (f) {
+    writer.writeBool(
       6,
       f
     );
   }
-  f = message.getLastnewthreadarrived();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getBootstraprefreshcomplete();
+  if (f) {
+    writer.writeBool(
       7,
       f
     );
@@ -4339,108 +5979,112 @@ proto.feobjects.AmbientBoardEntity.serializeBinaryToWriter = function(message, w
 
 
 /**
- * optional string Fingerprint = 1;
+ * optional string RefresherStatus = 1;
  * @return {string}
  */
-proto.feobjects.AmbientBoardEntity.prototype.getFingerprint = function() {
+proto.feobjects.FrontendAmbientStatus.prototype.getRefresherstatus = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.AmbientBoardEntity.prototype.setFingerprint = function(value) {
+proto.feobjects.FrontendAmbientStatus.prototype.setRefresherstatus = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional string Name = 2;
- * @return {string}
+ * optional int64 LastRefreshTimestamp = 2;
+ * @return {number}
  */
-proto.feobjects.AmbientBoardEntity.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.feobjects.FrontendAmbientStatus.prototype.getLastrefreshtimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
-/** @param {string} value */
-proto.feobjects.AmbientBoardEntity.prototype.setName = function(value) {
+/** @param {number} value */
+proto.feobjects.FrontendAmbientStatus.prototype.setLastrefreshtimestamp = function(value) {
   jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional int64 LastUpdate = 3;
+ * optional int32 LastRefreshDurationSeconds = 3;
  * @return {number}
  */
-proto.feobjects.AmbientBoardEntity.prototype.getLastupdate = function() {
+proto.feobjects.FrontendAmbientStatus.prototype.getLastrefreshdurationseconds = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.AmbientBoardEntity.prototype.setLastupdate = function(value) {
+proto.feobjects.FrontendAmbientStatus.prototype.setLastrefreshdurationseconds = function(value) {
   jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional int64 LastSeen = 4;
- * @return {number}
+ * optional string FrontendConfigLocation = 4;
+ * @return {string}
  */
-proto.feobjects.AmbientBoardEntity.prototype.getLastseen = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.feobjects.FrontendAmbientStatus.prototype.getFrontendconfiglocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
-/** @param {number} value */
-proto.feobjects.AmbientBoardEntity.prototype.setLastseen = function(value) {
+/** @param {string} value */
+proto.feobjects.FrontendAmbientStatus.prototype.setFrontendconfiglocation = function(value) {
   jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional bool Notify = 5;
+ * optional bool SFWListDisabled = 5;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.AmbientBoardEntity.prototype.getNotify = function() {
+proto.feobjects.FrontendAmbientStatus.prototype.getSfwlistdisabled = function() {
   return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.AmbientBoardEntity.prototype.setNotify = function(value) {
+proto.feobjects.FrontendAmbientStatus.prototype.setSfwlistdisabled = function(value) {
   jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * optional int64 UserCount = 6;
- * @return {number}
- */
-proto.feobjects.AmbientBoardEntity.prototype.getUsercount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+ * optional bool BootstrapRefreshInProgress = 6;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.feobjects.FrontendAmbientStatus.prototype.getBootstraprefreshinprogress = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
 };
 
 
-/** @param {number} value */
-proto.feobjects.AmbientBoardEntity.prototype.setUsercount = function(value) {
+/** @param {boolean} value */
+proto.feobjects.FrontendAmbientStatus.prototype.setBootstraprefreshinprogress = function(value) {
   jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * optional int64 LastNewThreadArrived = 7;
- * @return {number}
+ * optional bool BootstrapRefreshComplete = 7;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
  */
-proto.feobjects.AmbientBoardEntity.prototype.getLastnewthreadarrived = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.feobjects.FrontendAmbientStatus.prototype.getBootstraprefreshcomplete = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 7, false));
 };
 
 
-/** @param {number} value */
-proto.feobjects.AmbientBoardEntity.prototype.setLastnewthreadarrived = function(value) {
+/** @param {boolean} value */
+proto.feobjects.FrontendAmbientStatus.prototype.setBootstraprefreshcomplete = function(value) {
   jspb.Message.setField(this, 7, value);
 };
 
@@ -4456,13 +6100,20 @@ proto.feobjects.AmbientBoardEntity.prototype.setLastnewthreadarrived = function(
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.BackendAmbientStatus = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.feobjects.CompiledNotification = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feobjects.CompiledNotification.repeatedFields_, null);
 };
-goog.inherits(proto.feobjects.BackendAmbientStatus, jspb.Message);
+goog.inherits(proto.feobjects.CompiledNotification, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.BackendAmbientStatus.displayName = 'proto.feobjects.BackendAmbientStatus';
+  proto.feobjects.CompiledNotification.displayName = 'proto.feobjects.CompiledNotification';
 }
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feobjects.CompiledNotification.repeatedFields_ = [3,4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4476,8 +6127,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.BackendAmbientStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.BackendAmbientStatus.toObject(opt_includeInstance, this);
+proto.feobjects.CompiledNotification.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.CompiledNotification.toObject(opt_includeInstance, this);
 };
 
 
@@ -4486,32 +6137,23 @@ proto.feobjects.BackendAmbientStatus.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.BackendAmbientStatus} msg The msg instance to transform.
+ * @param {!proto.feobjects.CompiledNotification} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.BackendAmbientStatus.toObject = function(includeInstance, msg) {
+proto.feobjects.CompiledNotification.toObject = function(includeInstance, msg) {
   var f, obj = {
-    bootstrapinprogress: jspb.Message.getFieldWithDefault(msg, 18, false),
-    lastbootstraptimestamp: jspb.Message.getFieldWithDefault(msg, 19, 0),
-    triggerbootstraprefresh: jspb.Message.getFieldWithDefault(msg, 20, false),
-    lastinboundconntimestamp: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    inboundscount15: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    lastoutboundconntimestamp: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    outboundscount15: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    lastoutbounddurationseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    localnodeexternalip: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    localnodeexternalport: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    upnpstatus: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    databasestatus: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    dbsizemb: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    maxdbsizemb: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    lastdbinserttimestamp: jspb.Message.getFieldWithDefault(msg, 12, 0),
-    lastinsertdurationseconds: jspb.Message.getFieldWithDefault(msg, 13, 0),
-    cachingstatus: jspb.Message.getFieldWithDefault(msg, 14, ""),
-    lastcachegenerationtimestamp: jspb.Message.getFieldWithDefault(msg, 15, 0),
-    lastcachegenerationdurationseconds: jspb.Message.getFieldWithDefault(msg, 16, 0),
-    backendconfiglocation: jspb.Message.getFieldWithDefault(msg, 17, "")
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    text: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    responsepostsList: jspb.Message.getRepeatedField(msg, 3),
+    responsepostsusersList: jspb.Message.toObjectList(msg.getResponsepostsusersList(),
+    proto.feobjects.CUserUsername.toObject, includeInstance),
+    parentthread: (f = msg.getParentthread()) && proto.feobjects.CompiledThreadEntity.toObject(includeInstance, f),
+    parentpost: (f = msg.getParentpost()) && proto.feobjects.CompiledPostEntity.toObject(includeInstance, f),
+    creationtimestamp: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    newestresponsetimestamp: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    read: jspb.Message.getFieldWithDefault(msg, 9, false),
+    keyword: jspb.Message.getFieldWithDefault(msg, 10, "")
   };
 
   if (includeInstance) {
@@ -4525,108 +6167,71 @@ proto.feobjects.BackendAmbientStatus.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.BackendAmbientStatus}
+ * @return {!proto.feobjects.CompiledNotification}
  */
-proto.feobjects.BackendAmbientStatus.deserializeBinary = function(bytes) {
+proto.feobjects.CompiledNotification.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.BackendAmbientStatus;
-  return proto.feobjects.BackendAmbientStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.CompiledNotification;
+  return proto.feobjects.CompiledNotification.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.BackendAmbientStatus} msg The message object to deserialize into.
+ * @param {!proto.feobjects.CompiledNotification} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.BackendAmbientStatus}
+ * @return {!proto.feobjects.CompiledNotification}
  */
-proto.feobjects.BackendAmbientStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.CompiledNotification.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 18:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBootstrapinprogress(value);
-      break;
-    case 19:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastbootstraptimestamp(value);
-      break;
-    case 20:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setTriggerbootstraprefresh(value);
-      break;
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastinboundconntimestamp(value);
+      var value = /** @type {!proto.feobjects.NotificationType} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setInboundscount15(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastoutboundconntimestamp(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addResponseposts(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setOutboundscount15(value);
+      var value = new proto.feobjects.CUserUsername;
+      reader.readMessage(value,proto.feobjects.CUserUsername.deserializeBinaryFromReader);
+      msg.addResponsepostsusers(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLastoutbounddurationseconds(value);
+      var value = new proto.feobjects.CompiledThreadEntity;
+      reader.readMessage(value,proto.feobjects.CompiledThreadEntity.deserializeBinaryFromReader);
+      msg.setParentthread(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLocalnodeexternalip(value);
+      var value = new proto.feobjects.CompiledPostEntity;
+      reader.readMessage(value,proto.feobjects.CompiledPostEntity.deserializeBinaryFromReader);
+      msg.setParentpost(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLocalnodeexternalport(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreationtimestamp(value);
       break;
     case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUpnpstatus(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNewestresponsetimestamp(value);
       break;
     case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDatabasestatus(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRead(value);
       break;
     case 10:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setDbsizemb(value);
-      break;
-    case 11:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setMaxdbsizemb(value);
-      break;
-    case 12:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastdbinserttimestamp(value);
-      break;
-    case 13:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLastinsertdurationseconds(value);
-      break;
-    case 14:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCachingstatus(value);
-      break;
-    case 15:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastcachegenerationtimestamp(value);
-      break;
-    case 16:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLastcachegenerationdurationseconds(value);
-      break;
-    case 17:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBackendconfiglocation(value);
+      msg.setKeyword(value);
       break;
     default:
       reader.skipField();
@@ -4641,9 +6246,9 @@ proto.feobjects.BackendAmbientStatus.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.BackendAmbientStatus.prototype.serializeBinary = function() {
+proto.feobjects.CompiledNotification.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.BackendAmbientStatus.serializeBinaryToWriter(this, writer);
+  proto.feobjects.CompiledNotification.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4651,149 +6256,82 @@ proto.feobjects.BackendAmbientStatus.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.BackendAmbientStatus} message
+ * @param {!proto.feobjects.CompiledNotification} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.BackendAmbientStatus.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.CompiledNotification.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBootstrapinprogress();
-  if (f) {
-    writer.writeBool(
-      18,
-      f
-    );
-  }
-  f = message.getLastbootstraptimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      19,
-      f
-    );
-  }
-  f = message.getTriggerbootstraprefresh();
-  if (f) {
-    writer.writeBool(
-      20,
-      f
-    );
-  }
-  f = message.getLastinboundconntimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getInboundscount15();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getLastoutboundconntimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      3,
-      f
-    );
-  }
-  f = message.getOutboundscount15();
-  if (f !== 0) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = message.getLastoutbounddurationseconds();
-  if (f !== 0) {
-    writer.writeInt32(
-      5,
-      f
-    );
-  }
-  f = message.getLocalnodeexternalip();
+  f = message.getText();
   if (f.length > 0) {
     writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getLocalnodeexternalport();
-  if (f !== 0) {
-    writer.writeInt32(
-      7,
+      2,
       f
     );
   }
-  f = message.getUpnpstatus();
+  f = message.getResponsepostsList();
   if (f.length > 0) {
-    writer.writeString(
-      8,
+    writer.writeRepeatedString(
+      3,
       f
     );
   }
-  f = message.getDatabasestatus();
+  f = message.getResponsepostsusersList();
   if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.feobjects.CUserUsername.serializeBinaryToWriter
     );
   }
-  f = message.getDbsizemb();
-  if (f !== 0) {
-    writer.writeInt64(
-      10,
-      f
+  f = message.getParentthread();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.feobjects.CompiledThreadEntity.serializeBinaryToWriter
     );
   }
-  f = message.getMaxdbsizemb();
-  if (f !== 0) {
-    writer.writeInt64(
-      11,
-      f
+  f = message.getParentpost();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.feobjects.CompiledPostEntity.serializeBinaryToWriter
     );
   }
-  f = message.getLastdbinserttimestamp();
+  f = message.getCreationtimestamp();
   if (f !== 0) {
     writer.writeInt64(
-      12,
-      f
-    );
-  }
-  f = message.getLastinsertdurationseconds();
-  if (f !== 0) {
-    writer.writeInt32(
-      13,
-      f
-    );
-  }
-  f = message.getCachingstatus();
-  if (f.length > 0) {
-    writer.writeString(
-      14,
+      7,
       f
     );
   }
-  f = message.getLastcachegenerationtimestamp();
+  f = message.getNewestresponsetimestamp();
   if (f !== 0) {
     writer.writeInt64(
-      15,
+      8,
       f
     );
   }
-  f = message.getLastcachegenerationdurationseconds();
-  if (f !== 0) {
-    writer.writeInt32(
-      16,
+  f = message.getRead();
+  if (f) {
+    writer.writeBool(
+      9,
       f
     );
   }
-  f = message.getBackendconfiglocation();
+  f = message.getKeyword();
   if (f.length > 0) {
     writer.writeString(
-      17,
+      10,
       f
     );
   }
@@ -4801,306 +6339,214 @@ proto.feobjects.BackendAmbientStatus.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional bool BootstrapInProgress = 18;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getBootstrapinprogress = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 18, false));
-};
-
-
-/** @param {boolean} value */
-proto.feobjects.BackendAmbientStatus.prototype.setBootstrapinprogress = function(value) {
-  jspb.Message.setField(this, 18, value);
-};
-
-
-/**
- * optional int64 LastBootstrapTimestamp = 19;
- * @return {number}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getLastbootstraptimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 19, 0));
-};
-
-
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastbootstraptimestamp = function(value) {
-  jspb.Message.setField(this, 19, value);
-};
-
-
-/**
- * optional bool TriggerBootstrapRefresh = 20;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getTriggerbootstraprefresh = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 20, false));
-};
-
-
-/** @param {boolean} value */
-proto.feobjects.BackendAmbientStatus.prototype.setTriggerbootstraprefresh = function(value) {
-  jspb.Message.setField(this, 20, value);
-};
-
-
-/**
- * optional int64 LastInboundConnTimestamp = 1;
- * @return {number}
+ * optional NotificationType Type = 1;
+ * @return {!proto.feobjects.NotificationType}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getLastinboundconntimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.feobjects.CompiledNotification.prototype.getType = function() {
+  return /** @type {!proto.feobjects.NotificationType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastinboundconntimestamp = function(value) {
+/** @param {!proto.feobjects.NotificationType} value */
+proto.feobjects.CompiledNotification.prototype.setType = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional int32 InboundsCount15 = 2;
- * @return {number}
+ * optional string Text = 2;
+ * @return {string}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getInboundscount15 = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.feobjects.CompiledNotification.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setInboundscount15 = function(value) {
+/** @param {string} value */
+proto.feobjects.CompiledNotification.prototype.setText = function(value) {
   jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional int64 LastOutboundConnTimestamp = 3;
- * @return {number}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getLastoutboundconntimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastoutboundconntimestamp = function(value) {
-  jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * optional int32 OutboundsCount15 = 4;
- * @return {number}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getOutboundscount15 = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setOutboundscount15 = function(value) {
-  jspb.Message.setField(this, 4, value);
-};
-
-
-/**
- * optional int32 LastOutboundDurationSeconds = 5;
- * @return {number}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getLastoutbounddurationseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastoutbounddurationseconds = function(value) {
-  jspb.Message.setField(this, 5, value);
-};
-
-
-/**
- * optional string LocalNodeExternalIP = 6;
- * @return {string}
+ * repeated string ResponsePosts = 3;
+ * @return {!Array.<string>}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getLocalnodeexternalip = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.feobjects.CompiledNotification.prototype.getResponsepostsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
-/** @param {string} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLocalnodeexternalip = function(value) {
-  jspb.Message.setField(this, 6, value);
+/** @param {!Array.<string>} value */
+proto.feobjects.CompiledNotification.prototype.setResponsepostsList = function(value) {
+  jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * optional int32 LocalNodeExternalPort = 7;
- * @return {number}
+ * @param {!string} value
+ * @param {number=} opt_index
  */
-proto.feobjects.BackendAmbientStatus.prototype.getLocalnodeexternalport = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.feobjects.CompiledNotification.prototype.addResponseposts = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLocalnodeexternalport = function(value) {
-  jspb.Message.setField(this, 7, value);
+proto.feobjects.CompiledNotification.prototype.clearResponsepostsList = function() {
+  this.setResponsepostsList([]);
 };
 
 
 /**
- * optional string UPNPStatus = 8;
- * @return {string}
+ * repeated CUserUsername ResponsePostsUsers = 4;
+ * @return {!Array.<!proto.feobjects.CUserUsername>}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getUpnpstatus = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.feobjects.CompiledNotification.prototype.getResponsepostsusersList = function() {
+  return /** @type{!Array.<!proto.feobjects.CUserUsername>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.CUserUsername, 4));
 };
 
 
-/** @param {string} value */
-proto.feobjects.BackendAmbientStatus.prototype.setUpnpstatus = function(value) {
-  jspb.Message.setField(this, 8, value);
+/** @param {!Array.<!proto.feobjects.CUserUsername>} value */
+proto.feobjects.CompiledNotification.prototype.setResponsepostsusersList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * optional string DatabaseStatus = 9;
- * @return {string}
+ * @param {!proto.feobjects.CUserUsername=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.CUserUsername}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getDatabasestatus = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.feobjects.CompiledNotification.prototype.addResponsepostsusers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.feobjects.CUserUsername, opt_index);
 };
 
 
-/** @param {string} value */
-proto.feobjects.BackendAmbientStatus.prototype.setDatabasestatus = function(value) {
-  jspb.Message.setField(this, 9, value);
+proto.feobjects.CompiledNotification.prototype.clearResponsepostsusersList = function() {
+  this.setResponsepostsusersList([]);
 };
 
 
 /**
- * optional int64 DbSizeMb = 10;
- * @return {number}
+ * optional CompiledThreadEntity ParentThread = 5;
+ * @return {?proto.feobjects.CompiledThreadEntity}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getDbsizemb = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.feobjects.CompiledNotification.prototype.getParentthread = function() {
+  return /** @type{?proto.feobjects.CompiledThreadEntity} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.CompiledThreadEntity, 5));
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setDbsizemb = function(value) {
-  jspb.Message.setField(this, 10, value);
+/** @param {?proto.feobjects.CompiledThreadEntity|undefined} value */
+proto.feobjects.CompiledNotification.prototype.setParentthread = function(value) {
+  jspb.Message.setWrapperField(this, 5, value);
 };
 
 
-/**
- * optional int64 MaxDbSizeMb = 11;
- * @return {number}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getMaxdbsizemb = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.feobjects.CompiledNotification.prototype.clearParentthread = function() {
+  this.setParentthread(undefined);
 };
 
-
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setMaxdbsizemb = function(value) {
-  jspb.Message.setField(this, 11, value);
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feobjects.CompiledNotification.prototype.hasParentthread = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional int64 LastDbInsertTimestamp = 12;
- * @return {number}
+ * optional CompiledPostEntity ParentPost = 6;
+ * @return {?proto.feobjects.CompiledPostEntity}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getLastdbinserttimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+proto.feobjects.CompiledNotification.prototype.getParentpost = function() {
+  return /** @type{?proto.feobjects.CompiledPostEntity} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.CompiledPostEntity, 6));
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastdbinserttimestamp = function(value) {
-  jspb.Message.setField(this, 12, value);
+/** @param {?proto.feobjects.CompiledPostEntity|undefined} value */
+proto.feobjects.CompiledNotification.prototype.setParentpost = function(value) {
+  jspb.Message.setWrapperField(this, 6, value);
 };
 
 
-/**
- * optional int32 LastInsertDurationSeconds = 13;
- * @return {number}
- */
-proto.feobjects.BackendAmbientStatus.prototype.getLastinsertdurationseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.feobjects.CompiledNotification.prototype.clearParentpost = function() {
+  this.setParentpost(undefined);
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastinsertdurationseconds = function(value) {
-  jspb.Message.setField(this, 13, value);
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feobjects.CompiledNotification.prototype.hasParentpost = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional string CachingStatus = 14;
- * @return {string}
+ * optional int64 CreationTimestamp = 7;
+ * @return {number}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getCachingstatus = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+proto.feobjects.CompiledNotification.prototype.getCreationtimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
-/** @param {string} value */
-proto.feobjects.BackendAmbientStatus.prototype.setCachingstatus = function(value) {
-  jspb.Message.setField(this, 14, value);
+/** @param {number} value */
+proto.feobjects.CompiledNotification.prototype.setCreationtimestamp = function(value) {
+  jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * optional int64 LastCacheGenerationTimestamp = 15;
+ * optional int64 NewestResponseTimestamp = 8;
  * @return {number}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getLastcachegenerationtimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+proto.feobjects.CompiledNotification.prototype.getNewestresponsetimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastcachegenerationtimestamp = function(value) {
-  jspb.Message.setField(this, 15, value);
+proto.feobjects.CompiledNotification.prototype.setNewestresponsetimestamp = function(value) {
+  jspb.Message.setField(this, 8, value);
 };
 
 
 /**
- * optional int32 LastCacheGenerationDurationSeconds = 16;
- * @return {number}
+ * optional bool Read = 9;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getLastcachegenerationdurationseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+proto.feobjects.CompiledNotification.prototype.getRead = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 9, false));
 };
 
 
-/** @param {number} value */
-proto.feobjects.BackendAmbientStatus.prototype.setLastcachegenerationdurationseconds = function(value) {
-  jspb.Message.setField(this, 16, value);
+/** @param {boolean} value */
+proto.feobjects.CompiledNotification.prototype.setRead = function(value) {
+  jspb.Message.setField(this, 9, value);
 };
 
 
 /**
- * optional string BackendConfigLocation = 17;
+ * optional string Keyword = 10;
  * @return {string}
  */
-proto.feobjects.BackendAmbientStatus.prototype.getBackendconfiglocation = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+proto.feobjects.CompiledNotification.prototype.getKeyword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.BackendAmbientStatus.prototype.setBackendconfiglocation = function(value) {
-  jspb.Message.setField(this, 17, value);
+proto.feobjects.CompiledNotification.prototype.setKeyword = function(value) {
+  jspb.Message.setField(this, 10, value);
 };
 
 
@@ -5115,12 +6561,12 @@ proto.feobjects.BackendAmbientStatus.prototype.setBackendconfiglocation = functi
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.FrontendAmbientStatus = function(opt_data) {
+proto.feobjects.ReportsTabEntry = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feobjects.FrontendAmbientStatus, jspb.Message);
+goog.inherits(proto.feobjects.ReportsTabEntry, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.FrontendAmbientStatus.displayName = 'proto.feobjects.FrontendAmbientStatus';
+  proto.feobjects.ReportsTabEntry.displayName = 'proto.feobjects.ReportsTabEntry';
 }
 
 
@@ -5135,8 +6581,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.FrontendAmbientStatus.toObject(opt_includeInstance, this);
+proto.feobjects.ReportsTabEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.ReportsTabEntry.toObject(opt_includeInstance, this);
 };
 
 
@@ -5145,19 +6591,17 @@ proto.feobjects.FrontendAmbientStatus.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.FrontendAmbientStatus} msg The msg instance to transform.
+ * @param {!proto.feobjects.ReportsTabEntry} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.FrontendAmbientStatus.toObject = function(includeInstance, msg) {
+proto.feobjects.ReportsTabEntry.toObject = function(includeInstance, msg) {
   var f, obj = {
-    refresherstatus: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    lastrefreshtimestamp: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    lastrefreshdurationseconds: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    frontendconfiglocation: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    sfwlistdisabled: jspb.Message.getFieldWithDefault(msg, 5, false),
-    bootstraprefreshinprogress: jspb.Message.getFieldWithDefault(msg, 6, false),
-    bootstraprefreshcomplete: jspb.Message.getFieldWithDefault(msg, 7, false)
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    boardpayload: (f = msg.getBoardpayload()) && proto.feobjects.CompiledBoardEntity.toObject(includeInstance, f),
+    threadpayload: (f = msg.getThreadpayload()) && proto.feobjects.CompiledThreadEntity.toObject(includeInstance, f),
+    postpayload: (f = msg.getPostpayload()) && proto.feobjects.CompiledPostEntity.toObject(includeInstance, f),
+    timestamp: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -5171,23 +6615,23 @@ proto.feobjects.FrontendAmbientStatus.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.FrontendAmbientStatus}
+ * @return {!proto.feobjects.ReportsTabEntry}
  */
-proto.feobjects.FrontendAmbientStatus.deserializeBinary = function(bytes) {
+proto.feobjects.ReportsTabEntry.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.FrontendAmbientStatus;
-  return proto.feobjects.FrontendAmbientStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.ReportsTabEntry;
+  return proto.feobjects.ReportsTabEntry.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.FrontendAmbientStatus} msg The message object to deserialize into.
+ * @param {!proto.feobjects.ReportsTabEntry} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.FrontendAmbientStatus}
+ * @return {!proto.feobjects.ReportsTabEntry}
  */
-proto.feobjects.FrontendAmbientStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.ReportsTabEntry.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5196,31 +6640,26 @@ proto.feobjects.FrontendAmbientStatus.deserializeBinaryFromReader = function(msg
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRefresherstatus(value);
+      msg.setFingerprint(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastrefreshtimestamp(value);
+      var value = new proto.feobjects.CompiledBoardEntity;
+      reader.readMessage(value,proto.feobjects.CompiledBoardEntity.deserializeBinaryFromReader);
+      msg.setBoardpayload(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLastrefreshdurationseconds(value);
+      var value = new proto.feobjects.CompiledThreadEntity;
+      reader.readMessage(value,proto.feobjects.CompiledThreadEntity.deserializeBinaryFromReader);
+      msg.setThreadpayload(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFrontendconfiglocation(value);
+      var value = new proto.feobjects.CompiledPostEntity;
+      reader.readMessage(value,proto.feobjects.CompiledPostEntity.deserializeBinaryFromReader);
+      msg.setPostpayload(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSfwlistdisabled(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBootstraprefreshinprogress(value);
-      break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBootstraprefreshcomplete(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTimestamp(value);
       break;
     default:
       reader.skipField();
@@ -5235,9 +6674,9 @@ proto.feobjects.FrontendAmbientStatus.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.serializeBinary = function() {
+proto.feobjects.ReportsTabEntry.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.FrontendAmbientStatus.serializeBinaryToWriter(this, writer);
+  proto.feobjects.ReportsTabEntry.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5245,172 +6684,170 @@ proto.feobjects.FrontendAmbientStatus.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.FrontendAmbientStatus} message
+ * @param {!proto.feobjects.ReportsTabEntry} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.FrontendAmbientStatus.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.ReportsTabEntry.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRefresherstatus();
+  f = message.getFingerprint();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getLastrefreshtimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getBoardpayload();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.feobjects.CompiledBoardEntity.serializeBinaryToWriter
     );
   }
-  f = message.getLastrefreshdurationseconds();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getThreadpayload();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.feobjects.CompiledThreadEntity.serializeBinaryToWriter
     );
   }
-  f = message.getFrontendconfiglocation();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPostpayload();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
+      f,
+      proto.feobjects.CompiledPostEntity.serializeBinaryToWriter
     );
   }
-  f = message.getSfwlistdisabled();
-  if (f) {
-    writer.writeBool(
+  f = message.getTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
       5,
       f
     );
   }
-  f = message.getBootstraprefreshinprogress();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getBootstraprefreshcomplete();
-  if (f) {
-    writer.writeBool(
-      7,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string RefresherStatus = 1;
+ * optional string Fingerprint = 1;
  * @return {string}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.getRefresherstatus = function() {
+proto.feobjects.ReportsTabEntry.prototype.getFingerprint = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setRefresherstatus = function(value) {
+proto.feobjects.ReportsTabEntry.prototype.setFingerprint = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional int64 LastRefreshTimestamp = 2;
- * @return {number}
+ * optional CompiledBoardEntity BoardPayload = 2;
+ * @return {?proto.feobjects.CompiledBoardEntity}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.getLastrefreshtimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.feobjects.ReportsTabEntry.prototype.getBoardpayload = function() {
+  return /** @type{?proto.feobjects.CompiledBoardEntity} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.CompiledBoardEntity, 2));
 };
 
 
-/** @param {number} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setLastrefreshtimestamp = function(value) {
-  jspb.Message.setField(this, 2, value);
+/** @param {?proto.feobjects.CompiledBoardEntity|undefined} value */
+proto.feobjects.ReportsTabEntry.prototype.setBoardpayload = function(value) {
+  jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+proto.feobjects.ReportsTabEntry.prototype.clearBoardpayload = function() {
+  this.setBoardpayload(undefined);
 };
 
 
 /**
- * optional int32 LastRefreshDurationSeconds = 3;
- * @return {number}
+ * Returns whether this field is set.
+ * @return {!boolean}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.getLastrefreshdurationseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.feobjects.ReportsTabEntry.prototype.hasBoardpayload = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional CompiledThreadEntity ThreadPayload = 3;
+ * @return {?proto.feobjects.CompiledThreadEntity}
+ */
+proto.feobjects.ReportsTabEntry.prototype.getThreadpayload = function() {
+  return /** @type{?proto.feobjects.CompiledThreadEntity} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.CompiledThreadEntity, 3));
 };
 
 
-/** @param {number} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setLastrefreshdurationseconds = function(value) {
-  jspb.Message.setField(this, 3, value);
+/** @param {?proto.feobjects.CompiledThreadEntity|undefined} value */
+proto.feobjects.ReportsTabEntry.prototype.setThreadpayload = function(value) {
+  jspb.Message.setWrapperField(this, 3, value);
 };
 
 
-/**
- * optional string FrontendConfigLocation = 4;
- * @return {string}
- */
-proto.feobjects.FrontendAmbientStatus.prototype.getFrontendconfiglocation = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.feobjects.ReportsTabEntry.prototype.clearThreadpayload = function() {
+  this.setThreadpayload(undefined);
 };
 
 
-/** @param {string} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setFrontendconfiglocation = function(value) {
-  jspb.Message.setField(this, 4, value);
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feobjects.ReportsTabEntry.prototype.hasThreadpayload = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional bool SFWListDisabled = 5;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional CompiledPostEntity PostPayload = 4;
+ * @return {?proto.feobjects.CompiledPostEntity}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.getSfwlistdisabled = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+proto.feobjects.ReportsTabEntry.prototype.getPostpayload = function() {
+  return /** @type{?proto.feobjects.CompiledPostEntity} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.CompiledPostEntity, 4));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setSfwlistdisabled = function(value) {
-  jspb.Message.setField(this, 5, value);
+/** @param {?proto.feobjects.CompiledPostEntity|undefined} value */
+proto.feobjects.ReportsTabEntry.prototype.setPostpayload = function(value) {
+  jspb.Message.setWrapperField(this, 4, value);
 };
 
 
-/**
- * optional bool BootstrapRefreshInProgress = 6;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
- */
-proto.feobjects.FrontendAmbientStatus.prototype.getBootstraprefreshinprogress = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
+proto.feobjects.ReportsTabEntry.prototype.clearPostpayload = function() {
+  this.setPostpayload(undefined);
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setBootstraprefreshinprogress = function(value) {
-  jspb.Message.setField(this, 6, value);
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.feobjects.ReportsTabEntry.prototype.hasPostpayload = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional bool BootstrapRefreshComplete = 7;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional int64 Timestamp = 5;
+ * @return {number}
  */
-proto.feobjects.FrontendAmbientStatus.prototype.getBootstraprefreshcomplete = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 7, false));
+proto.feobjects.ReportsTabEntry.prototype.getTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
-/** @param {boolean} value */
-proto.feobjects.FrontendAmbientStatus.prototype.setBootstraprefreshcomplete = function(value) {
-  jspb.Message.setField(this, 7, value);
+/** @param {number} value */
+proto.feobjects.ReportsTabEntry.prototype.setTimestamp = function(value) {
+  jspb.Message.setField(this, 5, value);
 };
 
 
@@ -5425,20 +6862,13 @@ proto.feobjects.FrontendAmbientStatus.prototype.setBootstraprefreshcomplete = fu
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.CompiledNotification = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.feobjects.CompiledNotification.repeatedFields_, null);
+proto.feobjects.CompiledDirectMessageEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.feobjects.CompiledNotification, jspb.Message);
+goog.inherits(proto.feobjects.CompiledDirectMessageEntity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.CompiledNotification.displayName = 'proto.feobjects.CompiledNotification';
+  proto.feobjects.CompiledDirectMessageEntity.displayName = 'proto.feobjects.CompiledDirectMessageEntity';
 }
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.feobjects.CompiledNotification.repeatedFields_ = [3,4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5452,8 +6882,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.CompiledNotification.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.CompiledNotification.toObject(opt_includeInstance, this);
+proto.feobjects.CompiledDirectMessageEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.CompiledDirectMessageEntity.toObject(opt_includeInstance, this);
 };
 
 
@@ -5462,22 +6892,19 @@ proto.feobjects.CompiledNotification.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.CompiledNotification} msg The msg instance to transform.
+ * @param {!proto.feobjects.CompiledDirectMessageEntity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledNotification.toObject = function(includeInstance, msg) {
+proto.feobjects.CompiledDirectMessageEntity.toObject = function(includeInstance, msg) {
   var f, obj = {
-    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    text: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    responsepostsList: jspb.Message.getRepeatedField(msg, 3),
-    responsepostsusersList: jspb.Message.toObjectList(msg.getResponsepostsusersList(),
-    proto.feobjects.CUserUsername.toObject, includeInstance),
-    parentthread: (f = msg.getParentthread()) && proto.feobjects.CompiledThreadEntity.toObject(includeInstance, f),
-    parentpost: (f = msg.getParentpost()) && proto.feobjects.CompiledPostEntity.toObject(includeInstance, f),
-    creationtimestamp: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    newestresponsetimestamp: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    read: jspb.Message.getFieldWithDefault(msg, 9, false)
+    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    owner: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    recipient: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    body: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    creation: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    selfcreated: jspb.Message.getFieldWithDefault(msg, 6, false),
+    unreadable: jspb.Message.getFieldWithDefault(msg, 7, false)
   };
 
   if (includeInstance) {
@@ -5491,23 +6918,23 @@ proto.feobjects.CompiledNotification.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.CompiledNotification}
+ * @return {!proto.feobjects.CompiledDirectMessageEntity}
  */
-proto.feobjects.CompiledNotification.deserializeBinary = function(bytes) {
+proto.feobjects.CompiledDirectMessageEntity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.CompiledNotification;
-  return proto.feobjects.CompiledNotification.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.CompiledDirectMessageEntity;
+  return proto.feobjects.CompiledDirectMessageEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.CompiledNotification} msg The message object to deserialize into.
+ * @param {!proto.feobjects.CompiledDirectMessageEntity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.CompiledNotification}
+ * @return {!proto.feobjects.CompiledDirectMessageEntity}
  */
-proto.feobjects.CompiledNotification.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.CompiledDirectMessageEntity.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5515,43 +6942,32 @@ proto.feobjects.CompiledNotification.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.feobjects.NotificationType} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFingerprint(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setText(value);
+      msg.setOwner(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.addResponseposts(value);
+      msg.setRecipient(value);
       break;
     case 4:
-      var value = new proto.feobjects.CUserUsername;
-      reader.readMessage(value,proto.feobjects.CUserUsername.deserializeBinaryFromReader);
-      msg.addResponsepostsusers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
       break;
     case 5:
-      var value = new proto.feobjects.CompiledThreadEntity;
-      reader.readMessage(value,proto.feobjects.CompiledThreadEntity.deserializeBinaryFromReader);
-      msg.setParentthread(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreation(value);
       break;
     case 6:
-      var value = new proto.feobjects.CompiledPostEntity;
-      reader.readMessage(value,proto.feobjects.CompiledPostEntity.deserializeBinaryFromReader);
-      msg.setParentpost(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSelfcreated(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCreationtimestamp(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setNewestresponsetimestamp(value);
-      break;
-    case 9:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRead(value);
+      msg.setUnreadable(value);
       break;
     default:
       reader.skipField();
@@ -5566,9 +6982,9 @@ proto.feobjects.CompiledNotification.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.CompiledNotification.prototype.serializeBinary = function() {
+proto.feobjects.CompiledDirectMessageEntity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.CompiledNotification.serializeBinaryToWriter(this, writer);
+  proto.feobjects.CompiledDirectMessageEntity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5576,275 +6992,170 @@ proto.feobjects.CompiledNotification.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.CompiledNotification} message
+ * @param {!proto.feobjects.CompiledDirectMessageEntity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.CompiledNotification.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.CompiledDirectMessageEntity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getFingerprint();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getText();
+  f = message.getOwner();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getResponsepostsList();
+  f = message.getRecipient();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getResponsepostsusersList();
+  f = message.getBody();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       4,
-      f,
-      proto.feobjects.CUserUsername.serializeBinaryToWriter
-    );
-  }
-  f = message.getParentthread();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.feobjects.CompiledThreadEntity.serializeBinaryToWriter
-    );
-  }
-  f = message.getParentpost();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.feobjects.CompiledPostEntity.serializeBinaryToWriter
-    );
-  }
-  f = message.getCreationtimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      7,
-      f
-    );
-  }
-  f = message.getNewestresponsetimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      8,
-      f
-    );
-  }
-  f = message.getRead();
-  if (f) {
-    writer.writeBool(
-      9,
       f
     );
-  }
-};
-
-
-/**
- * optional NotificationType Type = 1;
- * @return {!proto.feobjects.NotificationType}
- */
-proto.feobjects.CompiledNotification.prototype.getType = function() {
-  return /** @type {!proto.feobjects.NotificationType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/** @param {!proto.feobjects.NotificationType} value */
-proto.feobjects.CompiledNotification.prototype.setType = function(value) {
-  jspb.Message.setField(this, 1, value);
-};
-
-
-/**
- * optional string Text = 2;
- * @return {string}
- */
-proto.feobjects.CompiledNotification.prototype.getText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/** @param {string} value */
-proto.feobjects.CompiledNotification.prototype.setText = function(value) {
-  jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * repeated string ResponsePosts = 3;
- * @return {!Array.<string>}
- */
-proto.feobjects.CompiledNotification.prototype.getResponsepostsList = function() {
-  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 3));
-};
-
-
-/** @param {!Array.<string>} value */
-proto.feobjects.CompiledNotification.prototype.setResponsepostsList = function(value) {
-  jspb.Message.setField(this, 3, value || []);
-};
-
-
-/**
- * @param {!string} value
- * @param {number=} opt_index
- */
-proto.feobjects.CompiledNotification.prototype.addResponseposts = function(value, opt_index) {
-  jspb.Message.addToRepeatedField(this, 3, value, opt_index);
-};
-
-
-proto.feobjects.CompiledNotification.prototype.clearResponsepostsList = function() {
-  this.setResponsepostsList([]);
+  }
+  f = message.getCreation();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getSelfcreated();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getUnreadable();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated CUserUsername ResponsePostsUsers = 4;
- * @return {!Array.<!proto.feobjects.CUserUsername>}
+ * optional string Fingerprint = 1;
+ * @return {string}
  */
-proto.feobjects.CompiledNotification.prototype.getResponsepostsusersList = function() {
-  return /** @type{!Array.<!proto.feobjects.CUserUsername>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.CUserUsername, 4));
+proto.feobjects.CompiledDirectMessageEntity.prototype.getFingerprint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-/** @param {!Array.<!proto.feobjects.CUserUsername>} value */
-proto.feobjects.CompiledNotification.prototype.setResponsepostsusersList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 4, value);
+/** @param {string} value */
+proto.feobjects.CompiledDirectMessageEntity.prototype.setFingerprint = function(value) {
+  jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.feobjects.CUserUsername=} opt_value
- * @param {number=} opt_index
- * @return {!proto.feobjects.CUserUsername}
+ * optional string Owner = 2;
+ * @return {string}
  */
-proto.feobjects.CompiledNotification.prototype.addResponsepostsusers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.feobjects.CUserUsername, opt_index);
+proto.feobjects.CompiledDirectMessageEntity.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-proto.feobjects.CompiledNotification.prototype.clearResponsepostsusersList = function() {
-  this.setResponsepostsusersList([]);
+/** @param {string} value */
+proto.feobjects.CompiledDirectMessageEntity.prototype.setOwner = function(value) {
+  jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional CompiledThreadEntity ParentThread = 5;
- * @return {?proto.feobjects.CompiledThreadEntity}
+ * optional string Recipient = 3;
+ * @return {string}
  */
-proto.feobjects.CompiledNotification.prototype.getParentthread = function() {
-  return /** @type{?proto.feobjects.CompiledThreadEntity} */ (
-    jspb.Message.getWrapperField(this, proto.feobjects.CompiledThreadEntity, 5));
-};
-
-
-/** @param {?proto.feobjects.CompiledThreadEntity|undefined} value */
-proto.feobjects.CompiledNotification.prototype.setParentthread = function(value) {
-  jspb.Message.setWrapperField(this, 5, value);
-};
-
-
-proto.feobjects.CompiledNotification.prototype.clearParentthread = function() {
-  this.setParentthread(undefined);
+proto.feobjects.CompiledDirectMessageEntity.prototype.getRecipient = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.feobjects.CompiledNotification.prototype.hasParentthread = function() {
-  return jspb.Message.getField(this, 5) != null;
+/** @param {string} value */
+proto.feobjects.CompiledDirectMessageEntity.prototype.setRecipient = function(value) {
+  jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional CompiledPostEntity ParentPost = 6;
- * @return {?proto.feobjects.CompiledPostEntity}
+ * optional string Body = 4;
+ * @return {string}
  */
-proto.feobjects.CompiledNotification.prototype.getParentpost = function() {
-  return /** @type{?proto.feobjects.CompiledPostEntity} */ (
-    jspb.Message.getWrapperField(this, proto.feobjects.CompiledPostEntity, 6));
-};
-
-
-/** @param {?proto.feobjects.CompiledPostEntity|undefined} value */
-proto.feobjects.CompiledNotification.prototype.setParentpost = function(value) {
-  jspb.Message.setWrapperField(this, 6, value);
-};
-
-
-proto.feobjects.CompiledNotification.prototype.clearParentpost = function() {
-  this.setParentpost(undefined);
+proto.feobjects.CompiledDirectMessageEntity.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.feobjects.CompiledNotification.prototype.hasParentpost = function() {
-  return jspb.Message.getField(this, 6) != null;
+/** @param {string} value */
+proto.feobjects.CompiledDirectMessageEntity.prototype.setBody = function(value) {
+  jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional int64 CreationTimestamp = 7;
+ * optional int64 Creation = 5;
  * @return {number}
  */
-proto.feobjects.CompiledNotification.prototype.getCreationtimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.feobjects.CompiledDirectMessageEntity.prototype.getCreation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.CompiledNotification.prototype.setCreationtimestamp = function(value) {
-  jspb.Message.setField(this, 7, value);
+proto.feobjects.CompiledDirectMessageEntity.prototype.setCreation = function(value) {
+  jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * optional int64 NewestResponseTimestamp = 8;
- * @return {number}
+ * optional bool SelfCreated = 6;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
  */
-proto.feobjects.CompiledNotification.prototype.getNewestresponsetimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.feobjects.CompiledDirectMessageEntity.prototype.getSelfcreated = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 6, false));
 };
 
 
-/** @param {number} value */
-proto.feobjects.CompiledNotification.prototype.setNewestresponsetimestamp = function(value) {
-  jspb.Message.setField(this, 8, value);
+/** @param {boolean} value */
+proto.feobjects.CompiledDirectMessageEntity.prototype.setSelfcreated = function(value) {
+  jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * optional bool Read = 9;
+ * optional bool Unreadable = 7;
  * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
  * You should avoid comparisons like {@code val === true/false} in those cases.
  * @return {boolean}
  */
-proto.feobjects.CompiledNotification.prototype.getRead = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 9, false));
+proto.feobjects.CompiledDirectMessageEntity.prototype.getUnreadable = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 7, false));
 };
 
 
 /** @param {boolean} value */
-proto.feobjects.CompiledNotification.prototype.setRead = function(value) {
-  jspb.Message.setField(this, 9, value);
+proto.feobjects.CompiledDirectMessageEntity.prototype.setUnreadable = function(value) {
+  jspb.Message.setField(this, 7, value);
 };
 
 
@@ -5859,13 +7170,20 @@ proto.feobjects.CompiledNotification.prototype.setRead = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.feobjects.ReportsTabEntry = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.feobjects.CompiledConversationEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.feobjects.CompiledConversationEntity.repeatedFields_, null);
 };
-goog.inherits(proto.feobjects.ReportsTabEntry, jspb.Message);
+goog.inherits(proto.feobjects.CompiledConversationEntity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.feobjects.ReportsTabEntry.displayName = 'proto.feobjects.ReportsTabEntry';
+  proto.feobjects.CompiledConversationEntity.displayName = 'proto.feobjects.CompiledConversationEntity';
 }
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.feobjects.CompiledConversationEntity.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5879,8 +7197,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.feobjects.ReportsTabEntry.prototype.toObject = function(opt_includeInstance) {
-  return proto.feobjects.ReportsTabEntry.toObject(opt_includeInstance, this);
+proto.feobjects.CompiledConversationEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.feobjects.CompiledConversationEntity.toObject(opt_includeInstance, this);
 };
 
 
@@ -5889,17 +7207,18 @@ proto.feobjects.ReportsTabEntry.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.feobjects.ReportsTabEntry} msg The msg instance to transform.
+ * @param {!proto.feobjects.CompiledConversationEntity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.ReportsTabEntry.toObject = function(includeInstance, msg) {
+proto.feobjects.CompiledConversationEntity.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fingerprint: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    boardpayload: (f = msg.getBoardpayload()) && proto.feobjects.CompiledBoardEntity.toObject(includeInstance, f),
-    threadpayload: (f = msg.getThreadpayload()) && proto.feobjects.CompiledThreadEntity.toObject(includeInstance, f),
-    postpayload: (f = msg.getPostpayload()) && proto.feobjects.CompiledPostEntity.toObject(includeInstance, f),
-    timestamp: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    peer: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    peerusername: (f = msg.getPeerusername()) && proto.feobjects.CUserUsername.toObject(includeInstance, f),
+    messagesList: jspb.Message.toObjectList(msg.getMessagesList(),
+    proto.feobjects.CompiledDirectMessageEntity.toObject, includeInstance),
+    lastupdate: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    unread: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -5913,23 +7232,23 @@ proto.feobjects.ReportsTabEntry.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.feobjects.ReportsTabEntry}
+ * @return {!proto.feobjects.CompiledConversationEntity}
  */
-proto.feobjects.ReportsTabEntry.deserializeBinary = function(bytes) {
+proto.feobjects.CompiledConversationEntity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.feobjects.ReportsTabEntry;
-  return proto.feobjects.ReportsTabEntry.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.feobjects.CompiledConversationEntity;
+  return proto.feobjects.CompiledConversationEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.feobjects.ReportsTabEntry} msg The message object to deserialize into.
+ * @param {!proto.feobjects.CompiledConversationEntity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.feobjects.ReportsTabEntry}
+ * @return {!proto.feobjects.CompiledConversationEntity}
  */
-proto.feobjects.ReportsTabEntry.deserializeBinaryFromReader = function(msg, reader) {
+proto.feobjects.CompiledConversationEntity.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5938,26 +7257,25 @@ proto.feobjects.ReportsTabEntry.deserializeBinaryFromReader = function(msg, read
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFingerprint(value);
+      msg.setPeer(value);
       break;
     case 2:
-      var value = new proto.feobjects.CompiledBoardEntity;
-      reader.readMessage(value,proto.feobjects.CompiledBoardEntity.deserializeBinaryFromReader);
-      msg.setBoardpayload(value);
+      var value = new proto.feobjects.CUserUsername;
+      reader.readMessage(value,proto.feobjects.CUserUsername.deserializeBinaryFromReader);
+      msg.setPeerusername(value);
       break;
     case 3:
-      var value = new proto.feobjects.CompiledThreadEntity;
-      reader.readMessage(value,proto.feobjects.CompiledThreadEntity.deserializeBinaryFromReader);
-      msg.setThreadpayload(value);
+      var value = new proto.feobjects.CompiledDirectMessageEntity;
+      reader.readMessage(value,proto.feobjects.CompiledDirectMessageEntity.deserializeBinaryFromReader);
+      msg.addMessages(value);
       break;
     case 4:
-      var value = new proto.feobjects.CompiledPostEntity;
-      reader.readMessage(value,proto.feobjects.CompiledPostEntity.deserializeBinaryFromReader);
-      msg.setPostpayload(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastupdate(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTimestamp(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setUnread(value);
       break;
     default:
       reader.skipField();
@@ -5972,9 +7290,9 @@ proto.feobjects.ReportsTabEntry.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.feobjects.ReportsTabEntry.prototype.serializeBinary = function() {
+proto.feobjects.CompiledConversationEntity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.feobjects.ReportsTabEntry.serializeBinaryToWriter(this, writer);
+  proto.feobjects.CompiledConversationEntity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5982,46 +7300,45 @@ proto.feobjects.ReportsTabEntry.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.feobjects.ReportsTabEntry} message
+ * @param {!proto.feobjects.CompiledConversationEntity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.feobjects.ReportsTabEntry.serializeBinaryToWriter = function(message, writer) {
+proto.feobjects.CompiledConversationEntity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFingerprint();
+  f = message.getPeer();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getBoardpayload();
+  f = message.getPeerusername();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.feobjects.CompiledBoardEntity.serializeBinaryToWriter
+      proto.feobjects.CUserUsername.serializeBinaryToWriter
     );
   }
-  f = message.getThreadpayload();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getMessagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
       f,
-      proto.feobjects.CompiledThreadEntity.serializeBinaryToWriter
+      proto.feobjects.CompiledDirectMessageEntity.serializeBinaryToWriter
     );
   }
-  f = message.getPostpayload();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getLastupdate();
+  if (f !== 0) {
+    writer.writeInt64(
       4,
-      f,
-      proto.feobjects.CompiledPostEntity.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getTimestamp();
+  f = message.getUnread();
   if (f !== 0) {
-    writer.writeInt64(
+    writer.writeInt32(
       5,
       f
     );
@@ -6030,38 +7347,38 @@ proto.feobjects.ReportsTabEntry.serializeBinaryToWriter = function(message, writ
 
 
 /**
- * optional string Fingerprint = 1;
+ * optional string Peer = 1;
  * @return {string}
  */
-proto.feobjects.ReportsTabEntry.prototype.getFingerprint = function() {
+proto.feobjects.CompiledConversationEntity.prototype.getPeer = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.feobjects.ReportsTabEntry.prototype.setFingerprint = function(value) {
+proto.feobjects.CompiledConversationEntity.prototype.setPeer = function(value) {
   jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional CompiledBoardEntity BoardPayload = 2;
- * @return {?proto.feobjects.CompiledBoardEntity}
+ * optional CUserUsername PeerUsername = 2;
+ * @return {?proto.feobjects.CUserUsername}
  */
-proto.feobjects.ReportsTabEntry.prototype.getBoardpayload = function() {
-  return /** @type{?proto.feobjects.CompiledBoardEntity} */ (
-    jspb.Message.getWrapperField(this, proto.feobjects.CompiledBoardEntity, 2));
+proto.feobjects.CompiledConversationEntity.prototype.getPeerusername = function() {
+  return /** @type{?proto.feobjects.CUserUsername} */ (
+    jspb.Message.getWrapperField(this, proto.feobjects.CUserUsername, 2));
 };
 
 
-/** @param {?proto.feobjects.CompiledBoardEntity|undefined} value */
-proto.feobjects.ReportsTabEntry.prototype.setBoardpayload = function(value) {
+/** @param {?proto.feobjects.CUserUsername|undefined} value */
+proto.feobjects.CompiledConversationEntity.prototype.setPeerusername = function(value) {
   jspb.Message.setWrapperField(this, 2, value);
 };
 
 
-proto.feobjects.ReportsTabEntry.prototype.clearBoardpayload = function() {
-  this.setBoardpayload(undefined);
+proto.feobjects.CompiledConversationEntity.prototype.clearPeerusername = function() {
+  this.setPeerusername(undefined);
 };
 
 
@@ -6069,82 +7386,68 @@ proto.feobjects.ReportsTabEntry.prototype.clearBoardpayload = function() {
  * Returns whether this field is set.
  * @return {!boolean}
  */
-proto.feobjects.ReportsTabEntry.prototype.hasBoardpayload = function() {
+proto.feobjects.CompiledConversationEntity.prototype.hasPeerusername = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional CompiledThreadEntity ThreadPayload = 3;
- * @return {?proto.feobjects.CompiledThreadEntity}
+ * repeated CompiledDirectMessageEntity Messages = 3;
+ * @return {!Array.<!proto.feobjects.CompiledDirectMessageEntity>}
  */
-proto.feobjects.ReportsTabEntry.prototype.getThreadpayload = function() {
-  return /** @type{?proto.feobjects.CompiledThreadEntity} */ (
-    jspb.Message.getWrapperField(this, proto.feobjects.CompiledThreadEntity, 3));
-};
-
-
-/** @param {?proto.feobjects.CompiledThreadEntity|undefined} value */
-proto.feobjects.ReportsTabEntry.prototype.setThreadpayload = function(value) {
-  jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-proto.feobjects.ReportsTabEntry.prototype.clearThreadpayload = function() {
-  this.setThreadpayload(undefined);
+proto.feobjects.CompiledConversationEntity.prototype.getMessagesList = function() {
+  return /** @type{!Array.<!proto.feobjects.CompiledDirectMessageEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.feobjects.CompiledDirectMessageEntity, 3));
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.feobjects.ReportsTabEntry.prototype.hasThreadpayload = function() {
-  return jspb.Message.getField(this, 3) != null;
+/** @param {!Array.<!proto.feobjects.CompiledDirectMessageEntity>} value */
+proto.feobjects.CompiledConversationEntity.prototype.setMessagesList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * optional CompiledPostEntity PostPayload = 4;
- * @return {?proto.feobjects.CompiledPostEntity}
+ * @param {!proto.feobjects.CompiledDirectMessageEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.feobjects.CompiledDirectMessageEntity}
  */
-proto.feobjects.ReportsTabEntry.prototype.getPostpayload = function() {
-  return /** @type{?proto.feobjects.CompiledPostEntity} */ (
-    jspb.Message.getWrapperField(this, proto.feobjects.CompiledPostEntity, 4));
+proto.feobjects.CompiledConversationEntity.prototype.addMessages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.feobjects.CompiledDirectMessageEntity, opt_index);
 };
 
 
-/** @param {?proto.feobjects.CompiledPostEntity|undefined} value */
-proto.feobjects.ReportsTabEntry.prototype.setPostpayload = function(value) {
-  jspb.Message.setWrapperField(this, 4, value);
+proto.feobjects.CompiledConversationEntity.prototype.clearMessagesList = function() {
+  this.setMessagesList([]);
 };
 
 
-proto.feobjects.ReportsTabEntry.prototype.clearPostpayload = function() {
-  this.setPostpayload(undefined);
+/**
+ * optional int64 LastUpdate = 4;
+ * @return {number}
+ */
+proto.feobjects.CompiledConversationEntity.prototype.getLastupdate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.feobjects.ReportsTabEntry.prototype.hasPostpayload = function() {
-  return jspb.Message.getField(this, 4) != null;
+/** @param {number} value */
+proto.feobjects.CompiledConversationEntity.prototype.setLastupdate = function(value) {
+  jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional int64 Timestamp = 5;
+ * optional int32 Unread = 5;
  * @return {number}
  */
-proto.feobjects.ReportsTabEntry.prototype.getTimestamp = function() {
+proto.feobjects.CompiledConversationEntity.prototype.getUnread = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /** @param {number} value */
-proto.feobjects.ReportsTabEntry.prototype.setTimestamp = function(value) {
+proto.feobjects.CompiledConversationEntity.prototype.setUnread = function(value) {
   jspb.Message.setField(this, 5, value);
 };
 
@@ -6456,7 +7759,11 @@ proto.feobjects.ModActionsTabEntry.prototype.setTimestamp = function(value) {
 proto.feobjects.NotificationType = {
   UNKNOWN_NOTIFICATION_TYPE: 0,
   REPLY_TO_THREAD: 1,
-  REPLY_TO_POST: 2
+  REPLY_TO_POST: 2,
+  MENTION: 3,
+  POST_IN_FOLLOWED_THREAD: 4,
+  KEYWORD_IN_SUBSCRIBED_BOARD: 5,
+  DIRECT_MESSAGE: 6
 };
 
 goog.object.extend(exports, proto.feobjects);
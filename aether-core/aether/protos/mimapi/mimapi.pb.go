@@ -21,6 +21,7 @@ It has these top-level messages:
 	Subprotocol
 	Protocol
 	Client
+	DirectMessage
 */
 package mimapi
 
@@ -977,6 +978,96 @@ func (m *Client) GetClientName() string {
 	return ""
 }
 
+//
+// The body is sealed from the owner to the recipient, so only the two ends of the conversation can read it.
+type DirectMessage struct {
+	Provable       *Provable   `protobuf:"bytes,1,opt,name=Provable" json:"Provable,omitempty"`
+	Recipient      string      `protobuf:"bytes,2,opt,name=Recipient" json:"Recipient,omitempty"`
+	Body           string      `protobuf:"bytes,3,opt,name=Body" json:"Body,omitempty"`
+	Owner          string      `protobuf:"bytes,4,opt,name=Owner" json:"Owner,omitempty"`
+	OwnerPublicKey string      `protobuf:"bytes,5,opt,name=OwnerPublicKey" json:"OwnerPublicKey,omitempty"`
+	EntityVersion  int32       `protobuf:"varint,6,opt,name=EntityVersion" json:"EntityVersion,omitempty"`
+	Meta           string      `protobuf:"bytes,7,opt,name=Meta" json:"Meta,omitempty"`
+	RealmId        string      `protobuf:"bytes,8,opt,name=RealmId" json:"RealmId,omitempty"`
+	EncrContent    string      `protobuf:"bytes,9,opt,name=EncrContent" json:"EncrContent,omitempty"`
+	Updateable     *Updateable `protobuf:"bytes,10,opt,name=Updateable" json:"Updateable,omitempty"`
+}
+
+func (m *DirectMessage) Reset()                    { *m = DirectMessage{} }
+func (m *DirectMessage) String() string            { return proto.CompactTextString(m) }
+func (*DirectMessage) ProtoMessage()               {}
+func (*DirectMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *DirectMessage) GetProvable() *Provable {
+	if m != nil {
+		return m.Provable
+	}
+	return nil
+}
+
+func (m *DirectMessage) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetOwnerPublicKey() string {
+	if m != nil {
+		return m.OwnerPublicKey
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetEntityVersion() int32 {
+	if m != nil {
+		return m.EntityVersion
+	}
+	return 0
+}
+
+func (m *DirectMessage) GetMeta() string {
+	if m != nil {
+		return m.Meta
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetRealmId() string {
+	if m != nil {
+		return m.RealmId
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetEncrContent() string {
+	if m != nil {
+		return m.EncrContent
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetUpdateable() *Updateable {
+	if m != nil {
+		return m.Updateable
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Provable)(nil), "mimapi.Provable")
 	proto.RegisterType((*Updateable)(nil), "mimapi.Updateable")
@@ -991,70 +1082,75 @@ func init() {
 	proto.RegisterType((*Subprotocol)(nil), "mimapi.Subprotocol")
 	proto.RegisterType((*Protocol)(nil), "mimapi.Protocol")
 	proto.RegisterType((*Client)(nil), "mimapi.Client")
+	proto.RegisterType((*DirectMessage)(nil), "mimapi.DirectMessage")
 }
 
 func init() { proto.RegisterFile("mimapi/mimapi.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xd6, 0x7a, 0xbd, 0x1b, 0xfb, 0xd9, 0x2d, 0x65, 0x5a, 0x45, 0x2b, 0x54, 0x21, 0x6b, 0x85,
-	0xaa, 0x1c, 0x4a, 0x22, 0x05, 0x24, 0xce, 0x24, 0x0d, 0x52, 0xd5, 0x94, 0x5a, 0xeb, 0x50, 0x24,
-	0x6e, 0xe3, 0xf5, 0xc4, 0x59, 0xba, 0xde, 0x59, 0xcd, 0xce, 0x16, 0x7c, 0xe7, 0x80, 0xc4, 0xb5,
-	0x12, 0x17, 0xae, 0xfc, 0x12, 0xfe, 0x10, 0x17, 0x38, 0xa3, 0x79, 0x33, 0xe3, 0x9d, 0x8d, 0x5d,
-	0xc7, 0x11, 0x45, 0x02, 0x71, 0xf2, 0x7b, 0xdf, 0x7b, 0x9e, 0x79, 0xef, 0xfb, 0x9e, 0x67, 0xc6,
-	0x70, 0x7f, 0x91, 0x2d, 0x68, 0x99, 0x1d, 0xe9, 0x8f, 0xc3, 0x52, 0x70, 0xc9, 0x49, 0xa8, 0xbd,
-	0xf8, 0x47, 0x0f, 0x7a, 0x63, 0xc1, 0x5f, 0xd3, 0x69, 0xce, 0xc8, 0x08, 0x06, 0x5f, 0x64, 0xc5,
-	0x9c, 0x89, 0x52, 0x64, 0x85, 0x8c, 0xbc, 0x91, 0x77, 0xd0, 0x4f, 0x5c, 0x88, 0x7c, 0x00, 0xbd,
-	0x53, 0xc1, 0xa8, 0xcc, 0x78, 0x11, 0x75, 0x46, 0xde, 0x81, 0x9f, 0xac, 0x7c, 0xf5, 0xed, 0xb1,
-	0xe0, 0xfc, 0xf2, 0xc5, 0xe5, 0xd7, 0x5c, 0xbc, 0x8a, 0x7c, 0xfd, 0x6d, 0x07, 0x22, 0x0f, 0xa1,
-	0x3f, 0xc9, 0xe6, 0x05, 0x95, 0xb5, 0x60, 0x51, 0x17, 0xe3, 0x0d, 0x10, 0xff, 0xe0, 0x01, 0x7c,
-	0x55, 0xce, 0xa8, 0x64, 0x58, 0xcc, 0x87, 0x00, 0xe7, 0xb4, 0x92, 0x1a, 0xc1, 0x5a, 0xfc, 0xc4,
-	0x41, 0xc8, 0x63, 0x78, 0x5f, 0x5b, 0xee, 0xa6, 0x1d, 0x5c, 0x74, 0x3d, 0x40, 0x0e, 0xe0, 0x3d,
-	0x0d, 0x36, 0x05, 0xe8, 0x02, 0xaf, 0xc3, 0xf1, 0x14, 0xe0, 0x84, 0x53, 0x31, 0x7b, 0xf1, 0x5d,
-	0xc1, 0x04, 0x79, 0x04, 0x77, 0x9f, 0xb1, 0xe5, 0x3a, 0x2b, 0xd7, 0x50, 0xb2, 0x0f, 0xe1, 0xd9,
-	0xf7, 0x65, 0x26, 0x96, 0x86, 0x16, 0xe3, 0x91, 0x07, 0x10, 0x9c, 0xb3, 0xd7, 0x2c, 0xc7, 0xdd,
-	0x82, 0x44, 0x3b, 0xf1, 0xaf, 0x3e, 0x04, 0xb8, 0x09, 0x79, 0xdc, 0xd0, 0x8f, 0x2b, 0x0f, 0x8e,
-	0xef, 0x1d, 0x1a, 0xa1, 0x2c, 0x9e, 0x34, 0x02, 0x11, 0xe8, 0x7e, 0x49, 0x17, 0xcc, 0xb4, 0x89,
-	0x36, 0xf9, 0x14, 0x06, 0x4d, 0xbd, 0x55, 0xe4, 0x8f, 0xfc, 0x83, 0xc1, 0x31, 0xb1, 0x8b, 0x34,
-	0xa1, 0xc4, 0x4d, 0x53, 0x62, 0x3d, 0x61, 0x55, 0x2a, 0xb2, 0x12, 0xb5, 0xd4, 0x62, 0xb8, 0x90,
-	0xaa, 0x1c, 0x73, 0xa3, 0x00, 0x63, 0xc1, 0x8a, 0x0f, 0x34, 0xc6, 0xf5, 0x34, 0xcf, 0xd2, 0x67,
-	0x6c, 0x19, 0x85, 0x9a, 0x8f, 0x36, 0x4a, 0x3e, 0x82, 0x3b, 0x67, 0x85, 0xcc, 0xe4, 0xf2, 0x25,
-	0x13, 0x95, 0xda, 0x61, 0x0f, 0xfb, 0x6f, 0x83, 0x6a, 0x9c, 0xce, 0x69, 0x31, 0xaf, 0xe9, 0x9c,
-	0x45, 0x3d, 0x5c, 0x67, 0xe5, 0xab, 0x5e, 0x9f, 0x33, 0x49, 0xa3, 0xbe, 0xee, 0x55, 0xd9, 0x24,
-	0x82, 0xbd, 0x84, 0xd1, 0x7c, 0xf1, 0x74, 0x16, 0x01, 0xc2, 0xd6, 0x55, 0xfd, 0x9c, 0x15, 0xa9,
-	0x38, 0xe5, 0x85, 0x64, 0x85, 0x8c, 0x06, 0xba, 0x1f, 0x07, 0x22, 0xc7, 0xee, 0x74, 0x45, 0x43,
-	0xe4, 0x7a, 0x45, 0x53, 0x13, 0x49, 0x9c, 0xac, 0xf8, 0xf7, 0x0e, 0x84, 0x17, 0x57, 0x82, 0xd1,
-	0xdb, 0x0a, 0xf5, 0xc0, 0xe8, 0x6b, 0x94, 0x32, 0x62, 0x5b, 0xf9, 0x7c, 0x47, 0x3e, 0x02, 0xdd,
-	0x13, 0x3e, 0x5b, 0x1a, 0x05, 0xd0, 0x56, 0xd8, 0x79, 0x56, 0xbc, 0x32, 0xcc, 0xa3, 0xdd, 0xc8,
-	0x11, 0x6e, 0x97, 0x63, 0x6f, 0x37, 0x39, 0x7a, 0x9b, 0xe4, 0xf8, 0x37, 0x50, 0xfe, 0x67, 0x07,
-	0xba, 0x63, 0x5e, 0xc9, 0x77, 0x42, 0xf8, 0xbe, 0x95, 0xcf, 0x50, 0x6e, 0xc5, 0xdc, 0x87, 0x70,
-	0x4c, 0x85, 0xaa, 0x5a, 0xd3, 0x6e, 0xbc, 0x95, 0x18, 0x81, 0x23, 0xc6, 0xff, 0x85, 0xf8, 0x37,
-	0x3e, 0x74, 0x5f, 0x72, 0x3c, 0x58, 0xff, 0x51, 0xe2, 0x2f, 0xa8, 0x98, 0xb3, 0x15, 0xf1, 0xda,
-	0xfb, 0x9b, 0x87, 0xcd, 0x43, 0xe8, 0x5f, 0x2c, 0x4b, 0x76, 0x9a, 0xd3, 0xaa, 0x32, 0x07, 0x4d,
-	0x03, 0x28, 0x72, 0x95, 0x63, 0x98, 0x47, 0x7b, 0x5d, 0x96, 0xfe, 0x36, 0x59, 0x60, 0xb3, 0x2c,
-	0x83, 0xad, 0xb2, 0x0c, 0x6f, 0x92, 0xe5, 0xce, 0x4e, 0xb2, 0xfc, 0xd6, 0x01, 0x5f, 0xf5, 0x78,
-	0xeb, 0x8b, 0x02, 0x7b, 0x36, 0x17, 0x05, 0xf6, 0x7c, 0x0f, 0x17, 0x32, 0x82, 0xe0, 0x9a, 0xcd,
-	0xa5, 0xd5, 0x6d, 0x5d, 0x5a, 0xf6, 0x9c, 0x0a, 0xda, 0xe7, 0xd4, 0xd3, 0xe2, 0x92, 0x1b, 0x05,
-	0xd0, 0xde, 0xf1, 0x90, 0xb7, 0x2c, 0xf6, 0x36, 0xb3, 0xd8, 0xdf, 0xca, 0x22, 0xdc, 0xc4, 0xe2,
-	0x60, 0x27, 0x16, 0x7f, 0xf1, 0x01, 0x2e, 0x44, 0x5d, 0xc9, 0x4a, 0xd2, 0x5b, 0x8f, 0x78, 0x33,
-	0xb4, 0x9d, 0xcd, 0x43, 0xeb, 0x6f, 0x1f, 0xda, 0xee, 0xcd, 0x43, 0x1b, 0xbc, 0x6d, 0x68, 0x43,
-	0x67, 0x68, 0xf7, 0x21, 0x7c, 0xc2, 0x17, 0x34, 0x2b, 0xcc, 0x59, 0x63, 0x3c, 0x47, 0xc6, 0x5e,
-	0x4b, 0xc6, 0xff, 0xce, 0x90, 0xff, 0xd1, 0x81, 0xbd, 0xcf, 0x67, 0x33, 0xc1, 0xaa, 0x0a, 0xdf,
-	0x04, 0x3c, 0xd5, 0x4f, 0x4c, 0xcf, 0xbc, 0x09, 0x8c, 0xaf, 0x76, 0x9f, 0xd4, 0xd3, 0xdc, 0x86,
-	0xb5, 0x1c, 0x2e, 0x44, 0x62, 0x18, 0xda, 0x6c, 0xe4, 0x4f, 0x3f, 0xbb, 0x5a, 0x98, 0xea, 0x78,
-	0xcc, 0x85, 0x3e, 0x82, 0x82, 0x04, 0x6d, 0x72, 0x08, 0x44, 0xbd, 0x2d, 0x27, 0x75, 0x9a, 0xb2,
-	0xaa, 0xba, 0xac, 0xf3, 0x71, 0x56, 0xcc, 0x51, 0x16, 0x3f, 0xd9, 0x10, 0x59, 0xcf, 0x9f, 0x2c,
-	0x8b, 0x14, 0xd5, 0x5a, 0xcb, 0x57, 0x11, 0x33, 0x71, 0x92, 0xa7, 0x3c, 0x47, 0xf5, 0xda, 0x13,
-	0x87, 0x78, 0xb2, 0xca, 0x20, 0x8f, 0x20, 0x3c, 0xcd, 0x33, 0x45, 0x70, 0x0f, 0x73, 0xef, 0xda,
-	0x5c, 0x8d, 0x26, 0x26, 0xba, 0xa3, 0xc2, 0x6f, 0xbd, 0x49, 0xe2, 0x9f, 0x3d, 0x24, 0xb4, 0xb4,
-	0xfb, 0xda, 0x1f, 0xbe, 0xe7, 0xfc, 0xf0, 0x63, 0x18, 0x9a, 0x85, 0x9e, 0xd3, 0x6f, 0xb9, 0x40,
-	0xd2, 0x83, 0xa4, 0x85, 0xb9, 0x39, 0x59, 0xc1, 0x85, 0x65, 0xdd, 0xc5, 0xd4, 0x7b, 0x7d, 0x52,
-	0x97, 0x25, 0x17, 0x92, 0xcd, 0xb0, 0xbe, 0x8c, 0x55, 0x51, 0x77, 0xe4, 0xab, 0xf7, 0xfa, 0x5a,
-	0x20, 0xfe, 0xc9, 0x6b, 0x08, 0x5b, 0x2b, 0xc1, 0xdb, 0xa1, 0x84, 0xce, 0x86, 0x12, 0x3e, 0x83,
-	0xa1, 0xd3, 0xad, 0x7d, 0x2b, 0xdf, 0xb7, 0xe4, 0x3a, 0xb1, 0xa4, 0x95, 0x18, 0xbf, 0xf1, 0xac,
-	0x20, 0xef, 0xac, 0x96, 0x26, 0x67, 0x4c, 0x65, 0x7a, 0x75, 0x8d, 0x32, 0xc4, 0xd4, 0x5f, 0x20,
-	0xbd, 0x2b, 0x8a, 0xa2, 0x8f, 0x11, 0x07, 0x39, 0x89, 0xbf, 0x19, 0x51, 0x26, 0xaf, 0x98, 0xf8,
-	0x38, 0xe5, 0x82, 0x1d, 0x69, 0xfb, 0x08, 0xcb, 0xae, 0xcc, 0xdf, 0xbd, 0x69, 0x88, 0xee, 0x27,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x85, 0x9b, 0x0b, 0x06, 0x0e, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xd7, 0x64, 0x32, 0x93, 0xe4, 0x25, 0x5d, 0x16, 0xef, 0xaa, 0x1a, 0xa1, 0x0a, 0x45, 0x23,
+	0xb4, 0xea, 0x61, 0x69, 0xa5, 0x82, 0xc4, 0x99, 0xfe, 0x41, 0x5a, 0x6d, 0xcb, 0x46, 0x93, 0xb2,
+	0x48, 0xdc, 0x9c, 0x89, 0x9b, 0x9a, 0x4d, 0xc6, 0x23, 0x8f, 0xb3, 0x90, 0x3b, 0x07, 0x24, 0xae,
+	0x2b, 0x71, 0xe1, 0xca, 0x27, 0xe1, 0x43, 0xf0, 0x35, 0xb8, 0xc0, 0x19, 0xf9, 0xd9, 0x93, 0xf1,
+	0x34, 0xd9, 0x34, 0x15, 0x45, 0x02, 0xed, 0xa9, 0x7e, 0x3f, 0xbf, 0xda, 0xef, 0xfd, 0x7e, 0x6f,
+	0x9e, 0x5f, 0xe0, 0xd1, 0x8c, 0xcf, 0x68, 0xce, 0x0f, 0xcd, 0x9f, 0x83, 0x5c, 0x0a, 0x25, 0x48,
+	0x68, 0xac, 0xf8, 0x47, 0x0f, 0xda, 0x03, 0x29, 0x5e, 0xd3, 0xd1, 0x94, 0x91, 0x3e, 0x74, 0xbf,
+	0xe0, 0xd9, 0x84, 0xc9, 0x5c, 0xf2, 0x4c, 0x45, 0x5e, 0xdf, 0xdb, 0xef, 0x24, 0x2e, 0x44, 0x3e,
+	0x80, 0xf6, 0x89, 0x64, 0x54, 0x71, 0x91, 0x45, 0x8d, 0xbe, 0xb7, 0xef, 0x27, 0x4b, 0x5b, 0xff,
+	0xf7, 0x40, 0x0a, 0x71, 0xf5, 0xe2, 0xea, 0x6b, 0x21, 0x5f, 0x45, 0xbe, 0xf9, 0x6f, 0x07, 0x22,
+	0x7b, 0xd0, 0x19, 0xf2, 0x49, 0x46, 0xd5, 0x5c, 0xb2, 0xa8, 0x89, 0xfb, 0x15, 0x10, 0xff, 0xe0,
+	0x01, 0x7c, 0x95, 0x8f, 0xa9, 0x62, 0x18, 0xcc, 0x87, 0x00, 0xe7, 0xb4, 0x50, 0x06, 0xc1, 0x58,
+	0xfc, 0xc4, 0x41, 0xc8, 0x53, 0x78, 0xdf, 0xac, 0xdc, 0x4b, 0x1b, 0x78, 0xe8, 0xea, 0x06, 0xd9,
+	0x87, 0xf7, 0x0c, 0x58, 0x05, 0x60, 0x02, 0xbc, 0x09, 0xc7, 0x23, 0x80, 0x63, 0x41, 0xe5, 0xf8,
+	0xc5, 0x77, 0x19, 0x93, 0xe4, 0x09, 0x3c, 0x78, 0xce, 0x16, 0xab, 0xac, 0xdc, 0x40, 0xc9, 0x2e,
+	0x84, 0x67, 0xdf, 0xe7, 0x5c, 0x2e, 0x2c, 0x2d, 0xd6, 0x22, 0x8f, 0x21, 0x38, 0x67, 0xaf, 0xd9,
+	0x14, 0x6f, 0x0b, 0x12, 0x63, 0xc4, 0xbf, 0xfa, 0x10, 0xe0, 0x25, 0xe4, 0x69, 0x45, 0x3f, 0x9e,
+	0xdc, 0x3d, 0x7a, 0x78, 0x60, 0x85, 0x2a, 0xf1, 0xa4, 0x12, 0x88, 0x40, 0xf3, 0x4b, 0x3a, 0x63,
+	0x36, 0x4d, 0x5c, 0x93, 0x4f, 0xa1, 0x5b, 0xc5, 0x5b, 0x44, 0x7e, 0xdf, 0xdf, 0xef, 0x1e, 0x91,
+	0xf2, 0x90, 0x6a, 0x2b, 0x71, 0xdd, 0xb4, 0x58, 0xa7, 0xac, 0x48, 0x25, 0xcf, 0x51, 0x4b, 0x23,
+	0x86, 0x0b, 0xe9, 0xc8, 0xd1, 0x37, 0x0a, 0x70, 0x2f, 0x58, 0xf2, 0x81, 0x8b, 0xc1, 0x7c, 0x34,
+	0xe5, 0xe9, 0x73, 0xb6, 0x88, 0x42, 0xc3, 0x47, 0x1d, 0x25, 0x1f, 0xc1, 0xce, 0x59, 0xa6, 0xb8,
+	0x5a, 0xbc, 0x64, 0xb2, 0xd0, 0x37, 0xb4, 0x30, 0xff, 0x3a, 0xa8, 0xcb, 0xe9, 0x9c, 0x66, 0x93,
+	0x39, 0x9d, 0xb0, 0xa8, 0x8d, 0xe7, 0x2c, 0x6d, 0x9d, 0xeb, 0x05, 0x53, 0x34, 0xea, 0x98, 0x5c,
+	0xf5, 0x9a, 0x44, 0xd0, 0x4a, 0x18, 0x9d, 0xce, 0x9e, 0x8d, 0x23, 0x40, 0xb8, 0x34, 0x75, 0x3e,
+	0x67, 0x59, 0x2a, 0x4f, 0x44, 0xa6, 0x58, 0xa6, 0xa2, 0xae, 0xc9, 0xc7, 0x81, 0xc8, 0x91, 0x5b,
+	0x5d, 0x51, 0x0f, 0xb9, 0x5e, 0xd2, 0x54, 0xed, 0x24, 0x8e, 0x57, 0xfc, 0x47, 0x03, 0xc2, 0xcb,
+	0x6b, 0xc9, 0xe8, 0x5d, 0x85, 0x7a, 0x6c, 0xf5, 0xb5, 0x4a, 0x59, 0xb1, 0x4b, 0xf9, 0x7c, 0x47,
+	0x3e, 0x02, 0xcd, 0x63, 0x31, 0x5e, 0x58, 0x05, 0x70, 0xad, 0xb1, 0x73, 0x9e, 0xbd, 0xb2, 0xcc,
+	0xe3, 0xba, 0x92, 0x23, 0xdc, 0x2c, 0x47, 0x6b, 0x3b, 0x39, 0xda, 0xeb, 0xe4, 0xf8, 0x2f, 0x50,
+	0xfe, 0x57, 0x03, 0x9a, 0x03, 0x51, 0xa8, 0x7b, 0x21, 0x7c, 0xb7, 0x94, 0xcf, 0x52, 0x5e, 0x8a,
+	0xb9, 0x0b, 0xe1, 0x80, 0x4a, 0x1d, 0xb5, 0xa1, 0xdd, 0x5a, 0x4b, 0x31, 0x02, 0x47, 0x8c, 0x77,
+	0x85, 0xf8, 0x37, 0x3e, 0x34, 0x5f, 0x0a, 0x6c, 0xac, 0xff, 0x2a, 0xf1, 0x97, 0x54, 0x4e, 0xd8,
+	0x92, 0x78, 0x63, 0xfd, 0xc3, 0x66, 0xb3, 0x07, 0x9d, 0xcb, 0x45, 0xce, 0x4e, 0xa6, 0xb4, 0x28,
+	0x6c, 0xa3, 0xa9, 0x00, 0x4d, 0xae, 0x36, 0x2c, 0xf3, 0xb8, 0x5e, 0x95, 0xa5, 0xb3, 0x49, 0x16,
+	0x58, 0x2f, 0x4b, 0x77, 0xa3, 0x2c, 0xbd, 0xdb, 0x64, 0xd9, 0xd9, 0x4a, 0x96, 0xdf, 0x1a, 0xe0,
+	0xeb, 0x1c, 0xef, 0xfc, 0x50, 0x60, 0xce, 0xf6, 0xa1, 0xc0, 0x9c, 0x1f, 0xe2, 0x41, 0x56, 0x10,
+	0x3c, 0xb3, 0x7a, 0xb4, 0x9a, 0xb5, 0x47, 0xab, 0xec, 0x53, 0x41, 0xbd, 0x4f, 0x3d, 0xcb, 0xae,
+	0x84, 0x55, 0x00, 0xd7, 0x5b, 0x36, 0xf9, 0x92, 0xc5, 0xf6, 0x7a, 0x16, 0x3b, 0x1b, 0x59, 0x84,
+	0xdb, 0x58, 0xec, 0x6e, 0xc5, 0xe2, 0x2f, 0x3e, 0xc0, 0xa5, 0x9c, 0x17, 0xaa, 0x50, 0xf4, 0xce,
+	0x25, 0x5e, 0x15, 0x6d, 0x63, 0x7d, 0xd1, 0xfa, 0x9b, 0x8b, 0xb6, 0x79, 0x7b, 0xd1, 0x06, 0x6f,
+	0x2b, 0xda, 0xd0, 0x29, 0xda, 0x5d, 0x08, 0x4f, 0xc5, 0x8c, 0xf2, 0xcc, 0xf6, 0x1a, 0x6b, 0x39,
+	0x32, 0xb6, 0x6b, 0x32, 0xfe, 0x7f, 0x8a, 0xfc, 0xcf, 0x06, 0xb4, 0x3e, 0x1f, 0x8f, 0x25, 0x2b,
+	0x0a, 0x9c, 0x09, 0x44, 0x6a, 0x46, 0x4c, 0xcf, 0xce, 0x04, 0xd6, 0xd6, 0xb7, 0x0f, 0xe7, 0xa3,
+	0x69, 0xb9, 0x6d, 0xe4, 0x70, 0x21, 0x12, 0x43, 0xaf, 0xf4, 0x46, 0xfe, 0xcc, 0xd8, 0x55, 0xc3,
+	0x74, 0xc6, 0x03, 0x21, 0x4d, 0x0b, 0x0a, 0x12, 0x5c, 0x93, 0x03, 0x20, 0x7a, 0xb6, 0x1c, 0xce,
+	0xd3, 0x94, 0x15, 0xc5, 0xd5, 0x7c, 0x3a, 0xe0, 0xd9, 0x04, 0x65, 0xf1, 0x93, 0x35, 0x3b, 0xab,
+	0xfe, 0xc3, 0x45, 0x96, 0xa2, 0x5a, 0x2b, 0xfe, 0x7a, 0xc7, 0x56, 0x9c, 0x12, 0xa9, 0x98, 0xa2,
+	0x7a, 0xf5, 0x8a, 0x43, 0x3c, 0x59, 0x7a, 0x90, 0x27, 0x10, 0x9e, 0x4c, 0xb9, 0x26, 0xb8, 0x8d,
+	0xbe, 0x0f, 0x4a, 0x5f, 0x83, 0x26, 0x76, 0x77, 0x4b, 0x85, 0xdf, 0xfa, 0x92, 0xc4, 0x3f, 0x7b,
+	0x48, 0x68, 0x5e, 0xde, 0x5b, 0x7e, 0xf8, 0x9e, 0xf3, 0xe1, 0xc7, 0xd0, 0xb3, 0x07, 0x5d, 0xd0,
+	0x6f, 0x85, 0x44, 0xd2, 0x83, 0xa4, 0x86, 0xb9, 0x3e, 0x3c, 0x13, 0xb2, 0x64, 0xdd, 0xc5, 0xf4,
+	0xbc, 0x3e, 0x9c, 0xe7, 0xb9, 0x90, 0x8a, 0x8d, 0x31, 0x3e, 0xce, 0x8a, 0xa8, 0xd9, 0xf7, 0xf5,
+	0xbc, 0xbe, 0xb2, 0x11, 0xff, 0xe4, 0x55, 0x84, 0xad, 0x84, 0xe0, 0x6d, 0x11, 0x42, 0x63, 0x4d,
+	0x08, 0x9f, 0x41, 0xcf, 0xc9, 0xb6, 0x9c, 0x95, 0x1f, 0x95, 0xe4, 0x3a, 0x7b, 0x49, 0xcd, 0x31,
+	0x7e, 0xe3, 0x95, 0x82, 0xdc, 0x5b, 0x2c, 0x95, 0xcf, 0x80, 0xaa, 0xf4, 0xfa, 0x06, 0x65, 0x88,
+	0xe9, 0x9f, 0x40, 0xe6, 0x56, 0x14, 0xc5, 0xb4, 0x11, 0x07, 0x89, 0x7f, 0x6f, 0xc0, 0xce, 0x29,
+	0x97, 0x2c, 0x55, 0x17, 0xac, 0x28, 0xf4, 0xd0, 0x7c, 0xb7, 0xc6, 0xb6, 0x07, 0x9d, 0x84, 0xa5,
+	0x3c, 0xc7, 0x4a, 0x33, 0x1f, 0x53, 0x05, 0x2c, 0x87, 0x21, 0x7f, 0xdd, 0x30, 0xd4, 0xdc, 0xdc,
+	0xf2, 0x82, 0xed, 0x86, 0xa1, 0x70, 0x53, 0x43, 0x6a, 0xad, 0x6f, 0x48, 0xed, 0x8d, 0x0d, 0xa9,
+	0x73, 0x5b, 0x43, 0x82, 0x6d, 0x1a, 0xd2, 0x71, 0xfc, 0x4d, 0x9f, 0x32, 0x75, 0xcd, 0xe4, 0xc7,
+	0xa9, 0x90, 0xec, 0xd0, 0xac, 0x0f, 0xb1, 0x20, 0x0a, 0xfb, 0x43, 0x7a, 0x14, 0xa2, 0xf9, 0xc9,
+	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xba, 0x25, 0x94, 0x77, 0x60, 0x0f, 0x00, 0x00,
 }
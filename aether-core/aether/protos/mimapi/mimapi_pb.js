@@ -15,6 +15,7 @@ goog.exportSymbol('proto.mimapi.Address', null, global);
 goog.exportSymbol('proto.mimapi.Board', null, global);
 goog.exportSymbol('proto.mimapi.BoardOwner', null, global);
 goog.exportSymbol('proto.mimapi.Client', null, global);
+goog.exportSymbol('proto.mimapi.DirectMessage', null, global);
 goog.exportSymbol('proto.mimapi.Key', null, global);
 goog.exportSymbol('proto.mimapi.Post', null, global);
 goog.exportSymbol('proto.mimapi.Protocol', null, global);
@@ -4638,4 +4639,423 @@ proto.mimapi.Client.prototype.setClientname = function(value) {
 };
 
 
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.mimapi.DirectMessage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.mimapi.DirectMessage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.mimapi.DirectMessage.displayName = 'proto.mimapi.DirectMessage';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.mimapi.DirectMessage.prototype.toObject = function(opt_includeInstance) {
+  return proto.mimapi.DirectMessage.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.mimapi.DirectMessage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.mimapi.DirectMessage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    provable: (f = msg.getProvable()) && proto.mimapi.Provable.toObject(includeInstance, f),
+    recipient: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    body: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    owner: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    ownerpublickey: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    entityversion: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    meta: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    realmid: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    encrcontent: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    updateable: (f = msg.getUpdateable()) && proto.mimapi.Updateable.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.mimapi.DirectMessage}
+ */
+proto.mimapi.DirectMessage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.mimapi.DirectMessage;
+  return proto.mimapi.DirectMessage.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.mimapi.DirectMessage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.mimapi.DirectMessage}
+ */
+proto.mimapi.DirectMessage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.mimapi.Provable;
+      reader.readMessage(value,proto.mimapi.Provable.deserializeBinaryFromReader);
+      msg.setProvable(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRecipient(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnerpublickey(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setEntityversion(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMeta(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRealmid(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEncrcontent(value);
+      break;
+    case 10:
+      var value = new proto.mimapi.Updateable;
+      reader.readMessage(value,proto.mimapi.Updateable.deserializeBinaryFromReader);
+      msg.setUpdateable(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.mimapi.DirectMessage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.mimapi.DirectMessage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.mimapi.DirectMessage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.mimapi.DirectMessage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProvable();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.mimapi.Provable.serializeBinaryToWriter
+    );
+  }
+  f = message.getRecipient();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getOwnerpublickey();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getEntityversion();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getMeta();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getRealmid();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getEncrcontent();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getUpdateable();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.mimapi.Updateable.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Provable Provable = 1;
+ * @return {?proto.mimapi.Provable}
+ */
+proto.mimapi.DirectMessage.prototype.getProvable = function() {
+  return /** @type{?proto.mimapi.Provable} */ (
+    jspb.Message.getWrapperField(this, proto.mimapi.Provable, 1));
+};
+
+
+/** @param {?proto.mimapi.Provable|undefined} value */
+proto.mimapi.DirectMessage.prototype.setProvable = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.mimapi.DirectMessage.prototype.clearProvable = function() {
+  this.setProvable(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.mimapi.DirectMessage.prototype.hasProvable = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string Recipient = 2;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getRecipient = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setRecipient = function(value) {
+  jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * optional string Body = 3;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setBody = function(value) {
+  jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * optional string Owner = 4;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setOwner = function(value) {
+  jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * optional string OwnerPublicKey = 5;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getOwnerpublickey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setOwnerpublickey = function(value) {
+  jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * optional int32 EntityVersion = 6;
+ * @return {number}
+ */
+proto.mimapi.DirectMessage.prototype.getEntityversion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/** @param {number} value */
+proto.mimapi.DirectMessage.prototype.setEntityversion = function(value) {
+  jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * optional string Meta = 7;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getMeta = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setMeta = function(value) {
+  jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * optional string RealmId = 8;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getRealmid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setRealmid = function(value) {
+  jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * optional string EncrContent = 9;
+ * @return {string}
+ */
+proto.mimapi.DirectMessage.prototype.getEncrcontent = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/** @param {string} value */
+proto.mimapi.DirectMessage.prototype.setEncrcontent = function(value) {
+  jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * optional Updateable Updateable = 10;
+ * @return {?proto.mimapi.Updateable}
+ */
+proto.mimapi.DirectMessage.prototype.getUpdateable = function() {
+  return /** @type{?proto.mimapi.Updateable} */ (
+    jspb.Message.getWrapperField(this, proto.mimapi.Updateable, 10));
+};
+
+
+/** @param {?proto.mimapi.Updateable|undefined} value */
+proto.mimapi.DirectMessage.prototype.setUpdateable = function(value) {
+  jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+proto.mimapi.DirectMessage.prototype.clearUpdateable = function() {
+  this.setUpdateable(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.mimapi.DirectMessage.prototype.hasUpdateable = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
 goog.object.extend(exports, proto.mimapi);
@@ -3,51 +3,284 @@
 
 package ca
 
-import ()
+import (
+	"aether-core/aether/services/signaturing"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+	"sync"
+	"time"
+)
+
+/*
+How this works:
+
+The trusted CA keys live in the backend and frontend configs, and the binaries load them in here at start with SetTrustedKeys. Until then, the default set is in effect.
+
+Every key has a validity window. A key vouches for something it signed if the thing was created within the window, and if the key is still valid now. The second part means that when a key is retired, what it signed stops counting as well. CA signals (canonical names, F451s) are kept alive by the CA refreshing them, so the new key takes over by signing them again.
+
+Priority decides between CAs that disagree, e.g. on the canonical name of a user. A lower number is a higher priority.
+
+Priority also bounds what a rotation statement can do. A key can retire or replace itself, or a key of the same or lower priority, but not a key of a higher priority. It also can't introduce a key with a higher priority than its own, since otherwise it could introduce one and retire the higher keys with it.
+
+Keys are added and retired without a new binary through rotation statements. A rotation statement is signed by a key that is trusted at the time of the statement and now, and it either introduces a new key, retires an existing one, or both. ApplyRotation checks the statement and returns the new key set, which the caller saves into the config.
+*/
+
+// CAKey is a trusted CA key.
+type CAKey struct {
+	PublicKey   string
+	Fingerprint string
+	Label       string
+	Priority    int   // Lower number is higher priority.
+	ValidFrom   int64 // Unix timestamp. 0: valid since always.
+	ValidUntil  int64 // Unix timestamp. 0: valid until retired.
+}
+
+const maxLabelSize = 256
+
+// ValidAt returns whether the key is valid at the given time.
+func (k *CAKey) ValidAt(ts int64) bool {
+	if k.ValidFrom != 0 && ts < k.ValidFrom {
+		return false
+	}
+	if k.ValidUntil != 0 && ts >= k.ValidUntil {
+		return false
+	}
+	return true
+}
+
+func (k *CAKey) validate() error {
+	pk, err := hex.DecodeString(k.PublicKey)
+	if err != nil || len(pk) != ed25519.PublicKeySize {
+		return errors.New(fmt.Sprintf("The public key of this CA key is not a valid ed25519 public key. Key: %#v", k))
+	}
+	if len(k.Fingerprint) == 0 {
+		return errors.New(fmt.Sprintf("This CA key has no fingerprint. Key: %#v", k))
+	}
+	if len(k.Label) > maxLabelSize {
+		return errors.New(fmt.Sprintf("The label of this CA key is too long. Key: %#v", k))
+	}
+	if k.ValidUntil != 0 && k.ValidUntil <= k.ValidFrom {
+		return errors.New(fmt.Sprintf("The validity window of this CA key ends before it starts. Key: %#v", k))
+	}
+	return nil
+}
+
+// ValidateKeys checks the keys in a key set, and that no public key is in it twice.
+func ValidateKeys(keys []CAKey) error {
+	seen := make(map[string]bool)
+	for k, _ := range keys {
+		if err := keys[k].validate(); err != nil {
+			return err
+		}
+		if seen[keys[k].PublicKey] {
+			return errors.New(fmt.Sprintf("This CA key is in the key set twice. Key: %#v", keys[k]))
+		}
+		seen[keys[k].PublicKey] = true
+	}
+	return nil
+}
+
+// DefaultKeys returns the CA keys that ship with the app.
+func DefaultKeys() []CAKey {
+	return []CAKey{
+		CAKey{
+			PublicKey:   "142ae631bcd46ab6e548c9b2d9494c7e30c65b4389e541e31bbe31e0ae47515e",
+			Fingerprint: "5460a18d7dd4c6b078199f5ee8ee70037f877166b07e9596cf26deb73223e15c",
+			Label:       "Aether",
+			Priority:    0,
+		},
+	}
+}
 
 var (
-	trustedCAsPKs = []string{"142ae631bcd46ab6e548c9b2d9494c7e30c65b4389e541e31bbe31e0ae47515e"}
-	trustedCAFps  = []string{"5460a18d7dd4c6b078199f5ee8ee70037f877166b07e9596cf26deb73223e15c"}
+	keysLock    sync.Mutex
+	trustedKeys = DefaultKeys()
 )
 
-// IsTrustedCAKeyByPK checks whether a key is one of our trusted CA keys based on the PK. Mind that this function does not actually check whether the message that this CA key came in is valid, so you should run this after you've otherwise validated the message and you know the message is signed properly by the key that you're checking.
-func IsTrustedCAKeyByPK(publicKey string) bool {
-	// return true // TODO (debug)
-	for key, _ := range trustedCAsPKs {
-		if publicKey == trustedCAsPKs[key] {
-			return true
+// SetTrustedKeys replaces the trusted CA key set.
+func SetTrustedKeys(keys []CAKey) {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+	trustedKeys = append([]CAKey{}, keys...)
+}
+
+// TrustedKeys returns a copy of the trusted CA key set.
+func TrustedKeys() []CAKey {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+	return append([]CAKey{}, trustedKeys...)
+}
+
+// find returns the key that matches, if it vouches for something created at the given time.
+func find(match func(k *CAKey) bool, ts int64) (CAKey, bool) {
+	now := time.Now().Unix()
+	keysLock.Lock()
+	defer keysLock.Unlock()
+	for k, _ := range trustedKeys {
+		if match(&trustedKeys[k]) && trustedKeys[k].ValidAt(ts) && trustedKeys[k].ValidAt(now) {
+			return trustedKeys[k], true
 		}
 	}
-	return false
+	return CAKey{}, false
+}
+
+// IsTrustedCAKeyByPK checks whether a key is one of our trusted CA keys based on the PK. Mind that this function does not actually check whether the message that this CA key came in is valid, so you should run this after you've otherwise validated the message and you know the message is signed properly by the key that you're checking.
+func IsTrustedCAKeyByPK(publicKey string) bool {
+	return IsTrustedCAKeyByPKAt(publicKey, time.Now().Unix())
+}
+
+// IsTrustedCAKeyByPKAt is IsTrustedCAKeyByPK for something the key signed at the given time.
+func IsTrustedCAKeyByPKAt(publicKey string, ts int64) bool {
+	_, found := find(func(k *CAKey) bool { return k.PublicKey == publicKey }, ts)
+	return found
 }
 
 func IsTrustedCAKeyByPKWithPriority(publicKey string) (bool, int) {
-	// return true // TODO (debug)
-	for key, _ := range trustedCAsPKs {
-		if publicKey == trustedCAsPKs[key] {
-			return true, key
-		}
+	key, found := find(func(k *CAKey) bool { return k.PublicKey == publicKey }, time.Now().Unix())
+	if !found {
+		return false, -1
 	}
-	return false, -1
+	return true, key.Priority
 }
 
 // IsTrustedCAKeyByFp checks whether a key is one of our trusted CA keys based on the Fingerprint. Mind that this function does not actually check whether the message that this CA key came in is valid, so you should run this after you've otherwise validated the message and you know the message is signed properly by the key that you're checking.
-func IsTrustedCAKeyByFp(publicKey string) bool {
-	// return true // TODO (debug)
-	for key, _ := range trustedCAFps {
-		if publicKey == trustedCAFps[key] {
-			return true
-		}
+func IsTrustedCAKeyByFp(fingerprint string) bool {
+	return IsTrustedCAKeyByFpAt(fingerprint, time.Now().Unix())
+}
+
+// IsTrustedCAKeyByFpAt is IsTrustedCAKeyByFp for something the key signed at the given time.
+func IsTrustedCAKeyByFpAt(fingerprint string, ts int64) bool {
+	_, found := find(func(k *CAKey) bool { return k.Fingerprint == fingerprint }, ts)
+	return found
+}
+
+func IsTrustedCAKeyByFpWithPriority(fingerprint string) (bool, int) {
+	return IsTrustedCAKeyByFpWithPriorityAt(fingerprint, time.Now().Unix())
+}
+
+// IsTrustedCAKeyByFpWithPriorityAt is IsTrustedCAKeyByFpWithPriority for something the key signed at the given time.
+func IsTrustedCAKeyByFpWithPriorityAt(fingerprint string, ts int64) (bool, int) {
+	key, found := find(func(k *CAKey) bool { return k.Fingerprint == fingerprint }, ts)
+	if !found {
+		return false, -1
+	}
+	return true, key.Priority
+}
+
+/*----------  Rotation  ----------*/
+
+// RotationStatement introduces a new CA key, retires an existing one, or both.
+type RotationStatement struct {
+	Introduce       CAKey  // Blank if the statement only retires.
+	RetirePublicKey string // Blank if the statement only introduces.
+	RetireAt        int64  // When the retired key stops being valid. 0: at the time of the statement.
+	Timestamp       int64
+	SignerPublicKey string
+	Signature       string
+}
+
+// maxRotationClockSkew is how far in the future the timestamp of a rotation statement can be.
+const maxRotationClockSkew = 10 * time.Minute
+
+func (s *RotationStatement) signingInput() string {
+	cp := *s
+	cp.Signature = ""
+	input, _ := json.Marshal(cp)
+	return string(input)
+}
+
+// Sign signs the statement with the private key of a CA.
+func (s *RotationStatement) Sign(privKey *ed25519.PrivateKey) error {
+	s.SignerPublicKey = signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
+	sig, err := signaturing.Sign(s.signingInput(), privKey)
+	if err != nil {
+		return err
 	}
-	return false
+	s.Signature = sig
+	return nil
+}
+
+// canRotate returns whether the key can retire or replace the other key: it's the same key, or the other key doesn't have a higher priority.
+func (k *CAKey) canRotate(other *CAKey) bool {
+	return k.PublicKey == other.PublicKey || k.Priority <= other.Priority
 }
 
-func IsTrustedCAKeyByFpWithPriority(publicKey string) (bool, int) {
-	// return true // TODO (debug)
-	for key, _ := range trustedCAFps {
-		if publicKey == trustedCAFps[key] {
-			return true, key
+// ApplyRotation checks the rotation statement against the key set, and returns the key set with the statement applied. The key set given is not modified.
+func ApplyRotation(keys []CAKey, s RotationStatement) ([]CAKey, error) {
+	if len(s.Introduce.PublicKey) == 0 && len(s.RetirePublicKey) == 0 {
+		return keys, errors.New(fmt.Sprintf("This rotation statement neither introduces nor retires a key. Statement: %#v", s))
+	}
+	now := time.Now()
+	if time.Unix(s.Timestamp, 0).After(now.Add(maxRotationClockSkew)) {
+		return keys, errors.New(fmt.Sprintf("This rotation statement is from the future. Statement: %#v", s))
+	}
+	signerValid := false
+	var signer CAKey
+	for k, _ := range keys {
+		if keys[k].PublicKey == s.SignerPublicKey && keys[k].ValidAt(s.Timestamp) && keys[k].ValidAt(now.Unix()) {
+			signer = keys[k]
+			signerValid = true
+			break
+		}
+	}
+	if !signerValid {
+		return keys, errors.New(fmt.Sprintf("This rotation statement is not signed by a CA key that is trusted at the time of the statement and now. Signer: %s", s.SignerPublicKey))
+	}
+	if !signaturing.Verify(s.signingInput(), s.Signature, s.SignerPublicKey) {
+		return keys, errors.New(fmt.Sprintf("The signature of this rotation statement is invalid. Signer: %s", s.SignerPublicKey))
+	}
+	newKeys := append([]CAKey{}, keys...)
+	if len(s.RetirePublicKey) > 0 {
+		retireAt := s.RetireAt
+		if retireAt == 0 {
+			retireAt = s.Timestamp
+		}
+		retired := false
+		for k, _ := range newKeys {
+			if newKeys[k].PublicKey != s.RetirePublicKey {
+				continue
+			}
+			if !signer.canRotate(&newKeys[k]) {
+				return keys, errors.New(fmt.Sprintf("This rotation statement retires a key with a higher priority than the key that signed it. Signer: %s, Retired key: %s", s.SignerPublicKey, s.RetirePublicKey))
+			}
+			if newKeys[k].ValidUntil == 0 || retireAt < newKeys[k].ValidUntil {
+				newKeys[k].ValidUntil = retireAt
+			}
+			if newKeys[k].ValidUntil <= newKeys[k].ValidFrom {
+				// Retired before it started. It never was valid, so it goes out.
+				newKeys = append(newKeys[:k], newKeys[k+1:]...)
+			}
+			retired = true
+			break
+		}
+		if !retired {
+			return keys, errors.New(fmt.Sprintf("The key this rotation statement retires is not in the key set. Key: %s", s.RetirePublicKey))
+		}
+	}
+	if len(s.Introduce.PublicKey) > 0 {
+		if err := s.Introduce.validate(); err != nil {
+			return keys, err
+		}
+		if s.Introduce.Priority < signer.Priority {
+			return keys, errors.New(fmt.Sprintf("This rotation statement introduces a key with a higher priority than the key that signed it. Signer: %s, Introduced key: %#v", s.SignerPublicKey, s.Introduce))
+		}
+		replaced := false
+		for k, _ := range newKeys {
+			if newKeys[k].PublicKey == s.Introduce.PublicKey {
+				if !signer.canRotate(&newKeys[k]) {
+					return keys, errors.New(fmt.Sprintf("This rotation statement replaces a key with a higher priority than the key that signed it. Signer: %s, Replaced key: %s", s.SignerPublicKey, s.Introduce.PublicKey))
+				}
+				newKeys[k] = s.Introduce
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			newKeys = append(newKeys, s.Introduce)
 		}
 	}
-	return false, -1
+	return newKeys, nil
 }
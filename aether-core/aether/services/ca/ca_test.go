@@ -0,0 +1,164 @@
+package ca_test
+
+import (
+	"aether-core/aether/services/ca"
+	"aether-core/aether/services/signaturing"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+func newCAKey(t *testing.T, fp string) (*ed25519.PrivateKey, ca.CAKey) {
+	privKey, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("Key pair creation failed. Err: '%s'", err)
+	}
+	return privKey, ca.CAKey{
+		PublicKey:   signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey)),
+		Fingerprint: fp,
+		Label:       fp,
+	}
+}
+
+// Tests
+
+func TestIsTrustedCAKeyByFpAt_ValidityWindow(t *testing.T) {
+	now := time.Now().Unix()
+	_, key := newCAKey(t, "windowed")
+	key.ValidFrom = now - 1000
+	ca.SetTrustedKeys([]ca.CAKey{key})
+	defer ca.SetTrustedKeys(ca.DefaultKeys())
+	if !ca.IsTrustedCAKeyByFpAt("windowed", now-500) {
+		t.Errorf("The key is not trusted for something it signed within its window.")
+	}
+	if ca.IsTrustedCAKeyByFpAt("windowed", now-2000) {
+		t.Errorf("The key is trusted for something it signed before its window.")
+	}
+}
+
+func TestApplyRotation_Success(t *testing.T) {
+	now := time.Now().Unix()
+	oldPriv, oldKey := newCAKey(t, "old")
+	_, newKey := newCAKey(t, "new")
+	stmt := ca.RotationStatement{
+		Introduce:       newKey,
+		RetirePublicKey: oldKey.PublicKey,
+		RetireAt:        now - 10,
+		Timestamp:       now - 20,
+	}
+	if err := stmt.Sign(oldPriv); err != nil {
+		t.Fatalf("Signing the rotation statement failed. Err: '%s'", err)
+	}
+	keys, err := ca.ApplyRotation([]ca.CAKey{oldKey}, stmt)
+	if err != nil {
+		t.Fatalf("Applying the rotation statement failed. Err: '%s'", err)
+	}
+	ca.SetTrustedKeys(keys)
+	defer ca.SetTrustedKeys(ca.DefaultKeys())
+	if !ca.IsTrustedCAKeyByFp("new") {
+		t.Errorf("The introduced key is not trusted.")
+	}
+	if ca.IsTrustedCAKeyByFpAt("old", now-30) {
+		t.Errorf("The retired key is still trusted.")
+	}
+}
+
+func TestApplyRotation_UntrustedSigner_Fail(t *testing.T) {
+	_, trustedKey := newCAKey(t, "trusted")
+	otherPriv, _ := newCAKey(t, "other")
+	_, newKey := newCAKey(t, "new")
+	stmt := ca.RotationStatement{
+		Introduce: newKey,
+		Timestamp: time.Now().Unix(),
+	}
+	stmt.Sign(otherPriv)
+	_, err := ca.ApplyRotation([]ca.CAKey{trustedKey}, stmt)
+	if err == nil {
+		t.Errorf("A rotation statement signed by a key that is not trusted was applied.")
+	}
+}
+
+func TestApplyRotation_TamperedStatement_Fail(t *testing.T) {
+	priv, key := newCAKey(t, "trusted")
+	_, newKey := newCAKey(t, "new")
+	stmt := ca.RotationStatement{
+		Introduce: newKey,
+		Timestamp: time.Now().Unix(),
+	}
+	stmt.Sign(priv)
+	stmt.Introduce.Priority = -1
+	_, err := ca.ApplyRotation([]ca.CAKey{key}, stmt)
+	if err == nil {
+		t.Errorf("A rotation statement changed after signing was applied.")
+	}
+}
+
+// rotationKeys returns a key set with a high (0) and a low (5) priority key, and their private keys.
+func rotationKeys(t *testing.T) (*ed25519.PrivateKey, ca.CAKey, *ed25519.PrivateKey, ca.CAKey) {
+	highPriv, high := newCAKey(t, "high")
+	lowPriv, low := newCAKey(t, "low")
+	low.Priority = 5
+	return highPriv, high, lowPriv, low
+}
+
+func signedRotation(t *testing.T, stmt ca.RotationStatement, privKey *ed25519.PrivateKey) ca.RotationStatement {
+	stmt.Timestamp = time.Now().Unix()
+	if err := stmt.Sign(privKey); err != nil {
+		t.Fatalf("Signing the rotation statement failed. Err: '%s'", err)
+	}
+	return stmt
+}
+
+func TestApplyRotation_RetireItself_Success(t *testing.T) {
+	_, high, lowPriv, low := rotationKeys(t)
+	stmt := signedRotation(t, ca.RotationStatement{RetirePublicKey: low.PublicKey}, lowPriv)
+	if _, err := ca.ApplyRotation([]ca.CAKey{high, low}, stmt); err != nil {
+		t.Errorf("A key should be able to retire itself. Err: '%s'", err)
+	}
+}
+
+func TestApplyRotation_RetireSameOrLowerPriority_Success(t *testing.T) {
+	highPriv, high, _, low := rotationKeys(t)
+	_, peer := newCAKey(t, "peer")
+	for _, target := range []ca.CAKey{low, peer} {
+		stmt := signedRotation(t, ca.RotationStatement{RetirePublicKey: target.PublicKey}, highPriv)
+		if _, err := ca.ApplyRotation([]ca.CAKey{high, low, peer}, stmt); err != nil {
+			t.Errorf("A key should be able to retire a key of the same or a lower priority. Retired: %s, Err: '%s'", target.Label, err)
+		}
+	}
+}
+
+func TestApplyRotation_RetireHigherPriority_Fail(t *testing.T) {
+	_, high, lowPriv, low := rotationKeys(t)
+	stmt := signedRotation(t, ca.RotationStatement{RetirePublicKey: high.PublicKey}, lowPriv)
+	keys, err := ca.ApplyRotation([]ca.CAKey{high, low}, stmt)
+	if err == nil {
+		t.Errorf("A key should not be able to retire a key of a higher priority.")
+	}
+	if keys[0].ValidUntil != 0 {
+		t.Errorf("The key set should not change when the statement is rejected. Keys: %#v", keys)
+	}
+}
+
+func TestApplyRotation_IntroduceHigherPriority_Fail(t *testing.T) {
+	_, high, lowPriv, low := rotationKeys(t)
+	_, newKey := newCAKey(t, "new")
+	// If this went through, the low key could retire the high key with the new one.
+	stmt := signedRotation(t, ca.RotationStatement{Introduce: newKey}, lowPriv)
+	if _, err := ca.ApplyRotation([]ca.CAKey{high, low}, stmt); err == nil {
+		t.Errorf("A key should not be able to introduce a key of a higher priority than its own.")
+	}
+}
+
+func TestApplyRotation_ReplaceHigherPriority_Fail(t *testing.T) {
+	_, high, lowPriv, low := rotationKeys(t)
+	demoted := high
+	demoted.Priority = 5
+	demoted.ValidUntil = time.Now().Unix() + 10
+	stmt := signedRotation(t, ca.RotationStatement{Introduce: demoted}, lowPriv)
+	if _, err := ca.ApplyRotation([]ca.CAKey{high, low}, stmt); err == nil {
+		t.Errorf("A key should not be able to replace a key of a higher priority.")
+	}
+}
@@ -0,0 +1,74 @@
+// Services > Clock
+// This package is the time source of the parts of the app that deal with the network: dispatch, the bouncer, and the scheduler. By default it's the wall clock. Tests can swap in a virtual clock, so that what takes hours in the network takes no time in the test, and happens the same way every run.
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+How this works:
+
+The callers use the functions of this package (clock.Now, clock.Sleep...) instead of the ones of the time package. These ask the current clock, which is the wall clock unless a test has called Use with another one.
+
+Mind that the deadlines of connections should keep using the time package. They're enforced by the network stack, which only knows about the wall clock.
+*/
+
+// Clock is a source of time.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+}
+
+type wallClock struct{}
+
+func (w wallClock) Now() time.Time                         { return time.Now() }
+func (w wallClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+func (w wallClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+var (
+	lock    sync.RWMutex
+	current Clock = wallClock{}
+)
+
+// Use makes the clock the time source of the app. It returns a function that puts back the prior one.
+func Use(c Clock) (restore func()) {
+	lock.Lock()
+	defer lock.Unlock()
+	prior := current
+	current = c
+	return func() {
+		lock.Lock()
+		defer lock.Unlock()
+		current = prior
+	}
+}
+
+func get() Clock {
+	lock.RLock()
+	defer lock.RUnlock()
+	return current
+}
+
+func Now() time.Time {
+	return get().Now()
+}
+
+func Since(t time.Time) time.Duration {
+	return get().Now().Sub(t)
+}
+
+func Until(t time.Time) time.Duration {
+	return t.Sub(get().Now())
+}
+
+func Sleep(d time.Duration) {
+	get().Sleep(d)
+}
+
+func After(d time.Duration) <-chan time.Time {
+	return get().After(d)
+}
@@ -0,0 +1,65 @@
+package clock_test
+
+import (
+	"aether-core/aether/services/clock"
+	"testing"
+	"time"
+)
+
+var epoch = time.Unix(1500000000, 0)
+
+func TestVirtual_AdvanceWakesInOrder_Success(t *testing.T) {
+	v := clock.NewVirtual(epoch)
+	woke := make(chan int, 3)
+	for i, d := range []time.Duration{3 * time.Second, time.Second, 3 * time.Second} {
+		v.BlockUntil(i)
+		go func(i int, d time.Duration) {
+			v.Sleep(d)
+			woke <- i
+		}(i, d)
+	}
+	v.BlockUntil(3)
+	v.Advance(2 * time.Second)
+	if got := <-woke; got != 1 {
+		t.Errorf("The shortest sleep should have woken first. Got: %v", got)
+	}
+	if v.Sleepers() != 2 {
+		t.Errorf("The longer sleeps should still be sleeping. Sleepers: %v", v.Sleepers())
+	}
+	v.Advance(time.Second)
+	first, second := <-woke, <-woke
+	if first+second != 2 {
+		t.Errorf("Both of the longer sleeps should have woken. Got: %v, %v", first, second)
+	}
+	if !v.Now().Equal(epoch.Add(3 * time.Second)) {
+		t.Errorf("The clock should be at the sum of the advances. Now: %v", v.Now())
+	}
+}
+
+func TestAutoVirtual_SleepJumps_Success(t *testing.T) {
+	v := clock.NewAutoVirtual(epoch)
+	start := time.Now()
+	v.Sleep(time.Hour)
+	if time.Since(start) > time.Second {
+		t.Errorf("An auto clock should not actually wait.")
+	}
+	if !v.Now().Equal(epoch.Add(time.Hour)) {
+		t.Errorf("An auto clock should jump to the end of the sleep. Now: %v", v.Now())
+	}
+}
+
+func TestUse_Success(t *testing.T) {
+	v := clock.NewVirtual(epoch)
+	restore := clock.Use(v)
+	if !clock.Now().Equal(epoch) {
+		t.Errorf("The virtual clock should be in use. Now: %v", clock.Now())
+	}
+	v.Advance(time.Minute)
+	if clock.Since(epoch) != time.Minute {
+		t.Errorf("Since should follow the virtual clock. Since: %v", clock.Since(epoch))
+	}
+	restore()
+	if clock.Since(epoch) < 24*time.Hour {
+		t.Errorf("The wall clock should be back after restore. Now: %v", clock.Now())
+	}
+}
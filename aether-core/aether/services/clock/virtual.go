@@ -0,0 +1,117 @@
+// Services > Clock > Virtual
+// This file implements the virtual clock that the tests and the network simulator use.
+
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+A virtual clock only moves when it's told to. There are two ways to move it:
+
+- Manual: The test calls Advance. Everything that's sleeping until a time that's now past wakes up, in the order of the times they wanted to wake up at, and in the order they went to sleep for the same time. BlockUntil lets the test wait until the goroutines it expects are asleep, so that it doesn't advance the clock before they get there.
+- Auto: Every sleep moves the clock to the time it ends at right away. Nothing actually waits, the time just jumps. This is useful when the test only cares that the timestamps and the timeouts come out right, not about the interleaving.
+*/
+
+type Virtual struct {
+	lock    sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	auto    bool
+	seq     int
+	waiters []*waiter
+}
+
+type waiter struct {
+	wake time.Time
+	seq  int
+	ch   chan time.Time
+}
+
+// NewVirtual returns a manual virtual clock that starts at the given time.
+func NewVirtual(start time.Time) *Virtual {
+	v := &Virtual{now: start}
+	v.cond = sync.NewCond(&v.lock)
+	return v
+}
+
+// NewAutoVirtual returns a virtual clock that jumps to the end of every sleep.
+func NewAutoVirtual(start time.Time) *Virtual {
+	v := NewVirtual(start)
+	v.auto = true
+	return v
+}
+
+func (v *Virtual) Now() time.Time {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.now
+}
+
+func (v *Virtual) Sleep(d time.Duration) {
+	<-v.After(d)
+}
+
+func (v *Virtual) After(d time.Duration) <-chan time.Time {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- v.now
+		return ch
+	}
+	v.seq++
+	v.waiters = append(v.waiters, &waiter{wake: v.now.Add(d), seq: v.seq, ch: ch})
+	sort.SliceStable(v.waiters, func(i, j int) bool {
+		if v.waiters[i].wake.Equal(v.waiters[j].wake) {
+			return v.waiters[i].seq < v.waiters[j].seq
+		}
+		return v.waiters[i].wake.Before(v.waiters[j].wake)
+	})
+	if v.auto {
+		v.advanceTo(v.now.Add(d))
+	}
+	v.cond.Broadcast()
+	return ch
+}
+
+// Advance moves the clock forward, and wakes up everything that was sleeping until a time within it.
+func (v *Virtual) Advance(d time.Duration) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.advanceTo(v.now.Add(d))
+}
+
+// Sleepers is the number of goroutines sleeping on the clock.
+func (v *Virtual) Sleepers() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return len(v.waiters)
+}
+
+// BlockUntil waits until at least n goroutines are sleeping on the clock.
+func (v *Virtual) BlockUntil(n int) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	for len(v.waiters) < n {
+		v.cond.Wait()
+	}
+}
+
+func (v *Virtual) advanceTo(t time.Time) {
+	if t.After(v.now) {
+		v.now = t
+	}
+	i := 0
+	for ; i < len(v.waiters); i++ {
+		if v.waiters[i].wake.After(v.now) {
+			break
+		}
+		v.waiters[i].ch <- v.waiters[i].wake
+	}
+	v.waiters = v.waiters[i:]
+	v.cond.Broadcast()
+}
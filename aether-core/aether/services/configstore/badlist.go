@@ -5,6 +5,8 @@
 package configstore
 
 import (
+	"aether-core/aether/services/ca"
+	"aether-core/aether/services/signaturing"
 	"aether-core/aether/services/toolbox"
 	"encoding/json"
 	"errors"
@@ -19,14 +21,35 @@ import (
 	"time"
 )
 
+/*
+How this works:
+
+The badlist is merged from two kinds of inputs.
+
+- The sources. These are URLs listed in the Sources field of the badlist file, and they default to the Aether static server. Every source serves a signedBadlist: the badlist JSON as a string, the public key that signed it, and the signature. A payload is only applied if the key is one of the trusted CA keys and the signature checks out. The last verified payload of every source is kept in the badlist file, so a source that can't be reached in a refresh keeps the entries it gave before.
+
+  The default source served the badlist JSON as is before the badlists were signed, and it keeps doing so until it moves to signed badlists. An operator who wants its entries in the meantime can set AcceptUnsignedDefault in the badlist file. This is off by default, and it only applies to the default source, since it's the same one the app has always trusted. Any other source has to be signed.
+
+- The local badlist file, badlist_local.json, in the same folder as the badlist file. This is maintained by the operator of the node, and it is not signed, since it doesn't come from the network. Its Add entries are added to the ones from the sources, and its Allow entries are removed from the result, whichever source they came from. Only the keys of the Allow entries matter.
+
+Every entry records the sources it came from in its Sources field, so that it is possible to tell why something was dropped.
+*/
+
+const (
+	defaultBadlistSource = "https://static.getaether.net/Badlist/Latest/badlist.json"
+	localBadlistSource   = "local"
+)
+
 type BadBoard struct {
 	Fingerprint string
 	Timestamp   int64
+	Sources     []string
 }
 type BadThread struct {
 	BoardFingerprint string
 	Fingerprint      string
 	Timestamp        int64
+	Sources          []string
 }
 type BadPost struct {
 	BoardFingerprint  string
@@ -34,6 +57,7 @@ type BadPost struct {
 	ParentFingerprint string
 	Fingerprint       string
 	Timestamp         int64
+	Sources           []string
 }
 type BadVote struct {
 	BoardFingerprint  string
@@ -41,15 +65,18 @@ type BadVote struct {
 	TargetFingerprint string
 	Fingerprint       string
 	Timestamp         int64
+	Sources           []string
 }
 type BadKey struct {
 	Fingerprint string
 	Timestamp   int64
+	Sources     []string
 }
 type BadTruststate struct {
 	TargetFingerprint string
 	Fingerprint       string
 	Timestamp         int64
+	Sources           []string
 }
 type BadAddress struct {
 	Location           string
@@ -59,12 +86,14 @@ type BadAddress struct {
 	ClientVersionMinor uint16
 	ClientVersionPatch uint16
 	ClientName         string
+	Sources            []string
 }
 
 type Badlist struct {
 	lock        sync.Mutex
 	LastUpdate  int64
-	Source      string
+	Sources     []string                  // The URLs of the signed badlists. Their entries are merged in this order.
+	Remote      map[string]badlistPayload // The last verified payload of every source.
 	Boards      map[string]BadBoard
 	Threads     map[string]BadThread
 	Posts       map[string]BadPost
@@ -72,6 +101,8 @@ type Badlist struct {
 	Keys        map[string]BadKey
 	Truststates map[string]BadTruststate
 	Addresses   map[string]BadAddress
+
+	AcceptUnsignedDefault bool // Lets the default source serve an unsigned badlist until it moves to signed ones. Off by default.
 }
 
 type badlistPayload struct {
@@ -84,6 +115,87 @@ type badlistPayload struct {
 	Addresses   map[string]BadAddress
 }
 
+// signedBadlist is what a badlist source serves. The payload is the badlist JSON as a string, so that the signature is over the exact bytes the signer produced.
+type signedBadlist struct {
+	Payload   string
+	PublicKey string
+	Signature string
+}
+
+// localBadlist is the badlist file maintained by the operator of the node.
+type localBadlist struct {
+	Add   badlistPayload
+	Allow badlistPayload
+}
+
+func newBadlistPayload() badlistPayload {
+	return badlistPayload{
+		Boards:      make(map[string]BadBoard),
+		Threads:     make(map[string]BadThread),
+		Posts:       make(map[string]BadPost),
+		Votes:       make(map[string]BadVote),
+		Keys:        make(map[string]BadKey),
+		Truststates: make(map[string]BadTruststate),
+		Addresses:   make(map[string]BadAddress)}
+}
+
+// add adds the entries of the other payload into this one, and records the source on them. The data of an entry that is already in comes from the later source, the sources of it accumulate.
+func (p *badlistPayload) add(other *badlistPayload, source string) {
+	for k, v := range other.Boards {
+		v.Sources = append(p.Boards[k].Sources, source)
+		p.Boards[k] = v
+	}
+	for k, v := range other.Threads {
+		v.Sources = append(p.Threads[k].Sources, source)
+		p.Threads[k] = v
+	}
+	for k, v := range other.Posts {
+		v.Sources = append(p.Posts[k].Sources, source)
+		p.Posts[k] = v
+	}
+	for k, v := range other.Votes {
+		v.Sources = append(p.Votes[k].Sources, source)
+		p.Votes[k] = v
+	}
+	for k, v := range other.Keys {
+		v.Sources = append(p.Keys[k].Sources, source)
+		p.Keys[k] = v
+	}
+	for k, v := range other.Truststates {
+		v.Sources = append(p.Truststates[k].Sources, source)
+		p.Truststates[k] = v
+	}
+	for k, v := range other.Addresses {
+		v.Sources = append(p.Addresses[k].Sources, source)
+		p.Addresses[k] = v
+	}
+}
+
+// allow removes the entries with the keys in the other payload from this one.
+func (p *badlistPayload) allow(other *badlistPayload) {
+	for k := range other.Boards {
+		delete(p.Boards, k)
+	}
+	for k := range other.Threads {
+		delete(p.Threads, k)
+	}
+	for k := range other.Posts {
+		delete(p.Posts, k)
+	}
+	for k := range other.Votes {
+		delete(p.Votes, k)
+	}
+	for k := range other.Keys {
+		delete(p.Keys, k)
+	}
+	for k := range other.Truststates {
+		delete(p.Truststates, k)
+	}
+	for k := range other.Addresses {
+		delete(p.Addresses, k)
+	}
+}
+
 var BadlistInstance = Badlist{
 	Boards:      make(map[string]BadBoard),
 	Threads:     make(map[string]BadThread),
@@ -95,6 +207,11 @@ var BadlistInstance = Badlist{
 
 var LastBadlistUpdateInThisRun int64
 
+// Logf is where the badlist logs go. This package can't import the logging package, since the logging package reads its settings from this one, so the logging package sets its own Logf in here when it's initialised.
+var Logf = func(level int, input string, v ...interface{}) {
+	log.Printf(input, v...)
+}
+
 func (list *Badlist) Refresh() {
 	list.lock.Lock()
 	defer list.lock.Unlock()
@@ -105,67 +222,119 @@ func (list *Badlist) refresh() {
 	list.fillFromDisk()
 	if LastBadlistUpdateInThisRun != 0 {
 		if time.Since(time.Unix(list.LastUpdate, 0)).Minutes() < 60 {
-			// If it's been updated in the last 60 minutes, no point in refreshing.
+			// If it's been updated in the last 60 minutes, no point in fetching the sources again. The local badlist file can still have changed, though.
+			list.refreshFromNewData()
 			return
 		}
 	}
 	list.LastUpdate = time.Now().Unix()
 	// ^ We set the last update timestamp even if it fails - so that we'll only check every hour. If this wasn't the case, every action that would trigger a refresh after a failed call would trigger another call.
-	if len(list.Source) == 0 {
-		list.Source = "https://static.getaether.net/Badlist/Latest/badlist.json"
+	if len(list.Sources) == 0 {
+		list.Sources = []string{defaultBadlistSource}
+	}
+	remote := make(map[string]badlistPayload)
+	rejected := 0
+	for _, source := range list.Sources {
+		remoteBadlist, err := fetchBadlist(source, list.AcceptUnsignedDefault)
+		if err != nil {
+			Logf(1, "Attempting to refresh the Badlist from this source encountered an error. The entries it gave before are kept. Source: %s, Err: %#v", source, err)
+			if prior, exists := list.Remote[source]; exists {
+				remote[source] = prior
+			}
+			rejected++
+			continue
+		}
+		Logf(2, "Badlist arrived. Source: %s, Badlist: %#v", source, remoteBadlist)
+		remote[source] = remoteBadlist
 	}
-	response, err := http.Get(list.Source)
+	if rejected == len(list.Sources) {
+		// Not a single source gave us a badlist we could use. This is the state in which content that should have been blocked comes in, so it should not go unnoticed.
+		Logf(0, "WARNING: None of the badlist sources gave a badlist that could be applied in this refresh. Only the entries they gave before and the local badlist are in effect. If this persists, check that the sources are reachable and that they are signed by a trusted CA key. Sources: %v", list.Sources)
+	}
+	// Sources that are no longer in the list go out with their entries, since only the ones in the list are carried over.
+	list.Remote = remote
+	list.refreshFromNewData()
+	list.saveToDisk()
+}
+
+// fetchBadlist fetches the signed badlist from the source and returns its payload if it is signed by a trusted CA key.
+func fetchBadlist(source string, acceptUnsignedDefault bool) (badlistPayload, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	response, err := client.Get(source)
 	if err != nil {
-		log.Printf("Attempting to refresh the Badlist encountered an error. Err: %#v", err)
-		return
+		return badlistPayload{}, errors.New(fmt.Sprintf("Fetching the badlist failed. Err: %#v", err))
 	}
 	defer response.Body.Close()
 	resp, err2 := ioutil.ReadAll(response.Body)
 	if err2 != nil {
-		log.Printf("Attempting to read the Badlist after fetch encountered an error. Err: %#v", err2)
-		return
+		return badlistPayload{}, errors.New(fmt.Sprintf("Reading the badlist after fetch failed. Err: %#v", err2))
 	}
-	remoteBadlist := badlistPayload{}
-	err3 := json.Unmarshal(resp, &remoteBadlist)
-	if err3 != nil {
-		log.Printf("Attempting to parse the JSON of the Badlist encountered an error. Err: %#v", err2)
-		return
+	return parseBadlist(source, resp, acceptUnsignedDefault)
+}
+
+// parseBadlist returns the payload of what the source served. That is a signed badlist, or for the default source, an unsigned one as well if the operator accepts it.
+func parseBadlist(source string, resp []byte, acceptUnsignedDefault bool) (badlistPayload, error) {
+	signed := signedBadlist{}
+	err := json.Unmarshal(resp, &signed)
+	if err != nil {
+		return badlistPayload{}, errors.New(fmt.Sprintf("Parsing the JSON of the signed badlist failed. Err: %#v", err))
 	}
-	log.Printf("Badlist arrived: %#v", remoteBadlist)
-	list.refreshFromNewData(&remoteBadlist)
-	list.saveToDisk()
+	if len(signed.Payload) > 0 || len(signed.Signature) > 0 || len(signed.PublicKey) > 0 {
+		return verifyBadlist(&signed)
+	}
+	if source != defaultBadlistSource {
+		return badlistPayload{}, errors.New(fmt.Sprintf("This badlist is not signed. Only the default source can serve an unsigned badlist. Source: %s", source))
+	}
+	if !acceptUnsignedDefault {
+		return badlistPayload{}, errors.New(fmt.Sprintf("This badlist is not signed, and unsigned badlists from the default source are not accepted. Set AcceptUnsignedDefault in the badlist file to accept them. Source: %s", source))
+	}
+	payload := badlistPayload{}
+	err2 := json.Unmarshal(resp, &payload)
+	if err2 != nil {
+		return badlistPayload{}, errors.New(fmt.Sprintf("Parsing the JSON of the unsigned badlist failed. Err: %#v", err2))
+	}
+	Logf(1, "The default badlist source served an unsigned badlist. It is applied because AcceptUnsignedDefault is set. Source: %s", source)
+	return payload, nil
 }
 
-// parseBadlist refreshes the active badlist cache that is being used, and it saves the BL to the disk.
-func (list *Badlist) refreshFromNewData(remoteBadlist *badlistPayload) {
-	list.Boards = remoteBadlist.Boards
-	list.Threads = remoteBadlist.Threads
-	list.Posts = remoteBadlist.Posts
-	list.Votes = remoteBadlist.Votes
-	list.Keys = remoteBadlist.Keys
-	list.Truststates = remoteBadlist.Truststates
-	list.Addresses = remoteBadlist.Addresses
-	// if len(remoteBadlist.Boards) > 0 {
-	// 	list.Boards = remoteBadlist.Boards
-	// }
-	// if len(remoteBadlist.Threads) > 0 {
-	// 	list.Threads = remoteBadlist.Threads
-	// }
-	// if len(remoteBadlist.Posts) > 0 {
-	// 	list.Posts = remoteBadlist.Posts
-	// }
-	// if len(remoteBadlist.Votes) > 0 {
-	// 	list.Votes = remoteBadlist.Votes
-	// }
-	// if len(remoteBadlist.Keys) > 0 {
-	// 	list.Keys = remoteBadlist.Keys
-	// }
-	// if len(remoteBadlist.Truststates) > 0 {
-	// 	list.Truststates = remoteBadlist.Truststates
-	// }
-	// if len(remoteBadlist.Addresses) > 0 {
-	// 	list.Addresses = remoteBadlist.Addresses
-	// }
+// verifyBadlist returns the payload of the signed badlist if it is signed by a trusted CA key.
+func verifyBadlist(signed *signedBadlist) (badlistPayload, error) {
+	if !ca.IsTrustedCAKeyByPK(signed.PublicKey) {
+		return badlistPayload{}, errors.New(fmt.Sprintf("This badlist is not signed by a trusted CA key. Public key: %s", signed.PublicKey))
+	}
+	if !signaturing.Verify(signed.Payload, signed.Signature, signed.PublicKey) {
+		return badlistPayload{}, errors.New(fmt.Sprintf("The signature of this badlist is invalid. Public key: %s", signed.PublicKey))
+	}
+	payload := badlistPayload{}
+	err := json.Unmarshal([]byte(signed.Payload), &payload)
+	if err != nil {
+		return badlistPayload{}, errors.New(fmt.Sprintf("Parsing the JSON of the badlist payload failed. Err: %#v", err))
+	}
+	return payload, nil
+}
+
+// refreshFromNewData refreshes the active badlist cache that is being used from the verified payloads of the sources and the local badlist file.
+func (list *Badlist) refreshFromNewData() {
+	merged := newBadlistPayload()
+	for _, source := range list.Sources {
+		if remoteBadlist, exists := list.Remote[source]; exists {
+			merged.add(&remoteBadlist, source)
+		}
+	}
+	local, err := readLocalBadlist()
+	if err != nil {
+		Logf(1, "The local badlist file could not be applied. Err: %#v", err)
+	} else {
+		merged.add(&local.Add, localBadlistSource)
+		merged.allow(&local.Allow)
+	}
+	list.Boards = merged.Boards
+	list.Threads = merged.Threads
+	list.Posts = merged.Posts
+	list.Votes = merged.Votes
+	list.Keys = merged.Keys
+	list.Truststates = merged.Truststates
+	list.Addresses = merged.Addresses
 	LastBadlistUpdateInThisRun = time.Now().Unix()
 }
 
@@ -187,6 +356,26 @@ func (list *Badlist) fillFromDisk() error {
 	return nil
 }
 
+// readLocalBadlist reads the local badlist file. It is fine for it not to exist.
+func readLocalBadlist() (localBadlist, error) {
+	local := localBadlist{}
+	binI, orgI, appI := getIdentifiers()
+	configDirs := cdir.New(orgI, appI)
+	folder := configDirs.QueryFolderContainsFile(filepath.Join(binI, "badlist_local.json"))
+	if folder == nil {
+		return local, nil
+	}
+	localJson, err := folder.ReadFile(filepath.Join(binI, "badlist_local.json"))
+	if err != nil {
+		return local, errors.New(fmt.Sprintf("The local badlist file could not be read. Error: %#v", err))
+	}
+	err2 := json.Unmarshal(localJson, &local)
+	if err2 != nil {
+		return local, errors.New(fmt.Sprintf("The local badlist file is corrupted. Please fix the file, or delete it. Error: %#v", err2))
+	}
+	return local, nil
+}
+
 /*----------  Save to disk  ----------*/
 
 func (list *Badlist) SaveToDisk() error {
@@ -468,7 +657,7 @@ func (list *Badlist) isBadAddress(loc, subloc string, port uint16) bool {
 		return false
 	}
 	// Full check path
-	parsed := fmt.Sprintf("%s:%s/%s", loc, port, subloc)
+	parsed := fmt.Sprintf("%s:%d/%s", loc, port, subloc)
 	addr := list.Addresses[parsed]
 	if addr.Location == loc && addr.Port == port && addr.Sublocation == subloc {
 		return true
@@ -0,0 +1,174 @@
+package configstore
+
+// These test that a badlist is only applied when a trusted CA key signed it, and how the badlists of the sources and the local badlist merge.
+
+import (
+	"aether-core/aether/services/ca"
+	"aether-core/aether/services/signaturing"
+	"encoding/json"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+)
+
+// Infrastructure
+
+// trustNewCAKey makes a new key the only trusted CA key, and returns it with the function that restores the prior ones.
+func trustNewCAKey(t *testing.T) (*ed25519.PrivateKey, func()) {
+	prior := ca.TrustedKeys()
+	key, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("The key pair could not be created. Error: %v", err)
+	}
+	ca.SetTrustedKeys([]ca.CAKey{{
+		PublicKey:   signaturing.MarshalPublicKey(key.Public().(ed25519.PublicKey)),
+		Fingerprint: "test ca key",
+	}})
+	return key, func() { ca.SetTrustedKeys(prior) }
+}
+
+func samplePayload() badlistPayload {
+	p := newBadlistPayload()
+	p.Boards["bad board"] = BadBoard{Fingerprint: "bad board"}
+	p.Keys["bad key"] = BadKey{Fingerprint: "bad key"}
+	return p
+}
+
+func sign(t *testing.T, p badlistPayload, key *ed25519.PrivateKey) signedBadlist {
+	payloadJson, _ := json.Marshal(p)
+	sig, err := signaturing.Sign(string(payloadJson), key)
+	if err != nil {
+		t.Fatalf("The badlist could not be signed. Error: %v", err)
+	}
+	return signedBadlist{
+		Payload:   string(payloadJson),
+		PublicKey: signaturing.MarshalPublicKey(key.Public().(ed25519.PublicKey)),
+		Signature: sig,
+	}
+}
+
+// Tests
+
+func TestVerifyBadlist_Success(t *testing.T) {
+	key, restore := trustNewCAKey(t)
+	defer restore()
+	signed := sign(t, samplePayload(), key)
+	p, err := verifyBadlist(&signed)
+	if err != nil {
+		t.Fatalf("A badlist signed by a trusted CA key should verify. Error: %v", err)
+	}
+	if _, ok := p.Boards["bad board"]; !ok {
+		t.Errorf("The payload should carry the entries of the badlist. Payload: %#v", p)
+	}
+}
+
+func TestVerifyBadlist_UntrustedKey_Fail(t *testing.T) {
+	_, restore := trustNewCAKey(t)
+	defer restore()
+	other, _ := signaturing.CreateKeyPair()
+	signed := sign(t, samplePayload(), other)
+	if _, err := verifyBadlist(&signed); err == nil {
+		t.Errorf("A badlist signed by a key that isn't a trusted CA key should not verify.")
+	}
+}
+
+func TestVerifyBadlist_TamperedPayload_Fail(t *testing.T) {
+	key, restore := trustNewCAKey(t)
+	defer restore()
+	signed := sign(t, samplePayload(), key)
+	tampered := newBadlistPayload()
+	tamperedJson, _ := json.Marshal(tampered)
+	signed.Payload = string(tamperedJson)
+	if _, err := verifyBadlist(&signed); err == nil {
+		t.Errorf("A badlist whose payload was changed after signing should not verify.")
+	}
+}
+
+func TestParseBadlist_UnsignedDefaultSource_Success(t *testing.T) {
+	unsigned, _ := json.Marshal(samplePayload())
+	p, err := parseBadlist(defaultBadlistSource, unsigned, true)
+	if err != nil {
+		t.Fatalf("The default source should be able to serve an unsigned badlist when the operator accepts it. Error: %v", err)
+	}
+	if _, ok := p.Keys["bad key"]; !ok {
+		t.Errorf("The unsigned badlist should be read in full. Payload: %#v", p)
+	}
+}
+
+func TestParseBadlist_UnsignedDefaultSource_NotAccepted_Fail(t *testing.T) {
+	unsigned, _ := json.Marshal(samplePayload())
+	if _, err := parseBadlist(defaultBadlistSource, unsigned, false); err == nil {
+		t.Errorf("An unsigned badlist from the default source should not be applied unless the operator accepts it.")
+	}
+}
+
+func TestParseBadlist_UnsignedOtherSource_Fail(t *testing.T) {
+	unsigned, _ := json.Marshal(samplePayload())
+	if _, err := parseBadlist("https://example.com/badlist.json", unsigned, true); err == nil {
+		t.Errorf("A source other than the default should not be able to serve an unsigned badlist.")
+	}
+}
+
+func TestParseBadlist_SignedOtherSource_Success(t *testing.T) {
+	key, restore := trustNewCAKey(t)
+	defer restore()
+	signedJson, _ := json.Marshal(sign(t, samplePayload(), key))
+	if _, err := parseBadlist("https://example.com/badlist.json", signedJson, false); err != nil {
+		t.Errorf("Any source should be able to serve a signed badlist. Error: %v", err)
+	}
+}
+
+func TestParseBadlist_BadSignatureDefaultSource_Fail(t *testing.T) {
+	_, restore := trustNewCAKey(t)
+	defer restore()
+	other, _ := signaturing.CreateKeyPair()
+	signedJson, _ := json.Marshal(sign(t, samplePayload(), other))
+	// A signed badlist that fails verification doesn't fall back to the unsigned path, even from the default source.
+	if _, err := parseBadlist(defaultBadlistSource, signedJson, true); err == nil {
+		t.Errorf("A badlist with an untrusted signature should not be applied from the default source.")
+	}
+}
+
+func TestBadlistMerge_Success(t *testing.T) {
+	merged := newBadlistPayload()
+	first := samplePayload()
+	second := newBadlistPayload()
+	second.Boards["bad board"] = BadBoard{Fingerprint: "bad board", Timestamp: 2}
+	second.Posts["bad post"] = BadPost{Fingerprint: "bad post"}
+	merged.add(&first, "first")
+	merged.add(&second, "second")
+	board := merged.Boards["bad board"]
+	if board.Timestamp != 2 {
+		t.Errorf("The data of an entry should come from the later source. Board: %#v", board)
+	}
+	if len(board.Sources) != 2 || board.Sources[0] != "first" || board.Sources[1] != "second" {
+		t.Errorf("An entry should record every source it came from. Sources: %#v", board.Sources)
+	}
+	if len(merged.Posts) != 1 || len(merged.Keys) != 1 {
+		t.Errorf("The entries of both sources should be in. Merged: %#v", merged)
+	}
+}
+
+func TestBadlistAllow_Success(t *testing.T) {
+	merged := newBadlistPayload()
+	remote := samplePayload()
+	merged.add(&remote, "remote")
+	local := localBadlist{Add: newBadlistPayload(), Allow: newBadlistPayload()}
+	local.Add.Threads["bad thread"] = BadThread{Fingerprint: "bad thread"}
+	// Only the key of an allow entry matters.
+	local.Allow.Boards["bad board"] = BadBoard{}
+	merged.add(&local.Add, localBadlistSource)
+	merged.allow(&local.Allow)
+	list := Badlist{Boards: merged.Boards, Threads: merged.Threads, Keys: merged.Keys}
+	if list.isBadBoard("bad board", "good owner") {
+		t.Errorf("A board in the allow list of the local badlist should not be bad, even if a source lists it.")
+	}
+	if !list.isBadThread("bad thread", "good board", "good owner") {
+		t.Errorf("A thread in the add list of the local badlist should be bad.")
+	}
+	if !list.isBadKey("bad key") {
+		t.Errorf("The entries that aren't allowed should stay bad.")
+	}
+	if threads := merged.Threads["bad thread"]; len(threads.Sources) != 1 || threads.Sources[0] != localBadlistSource {
+		t.Errorf("The entries of the local badlist should record it as their source. Sources: %#v", threads.Sources)
+	}
+}
@@ -4,6 +4,7 @@
 package configstore
 
 import (
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/toolbox"
 	"fmt"
 	"log"
@@ -65,7 +66,7 @@ func (n *ConnectionRecord) equal(c ConnectionRecord) bool {
 	// n.Port == c.Port && // This is causing a little too much pain. Let's remove that and see what happens.
 }
 func (n *ConnectionRecord) hasActiveInboundLease() bool {
-	cutoff := Timestamp(time.Now().Add(-(time.Duration(activeInboundLeaseDurationSeconds) * time.Second)).Unix())
+	cutoff := Timestamp(clock.Now().Add(-(time.Duration(activeInboundLeaseDurationSeconds) * time.Second)).Unix())
 	if n.LastAccess > cutoff {
 		return true
 	} else {
@@ -73,7 +74,7 @@ func (n *ConnectionRecord) hasActiveInboundLease() bool {
 	}
 }
 func (n *ConnectionRecord) hasActiveOutboundLease() bool {
-	cutoff := Timestamp(time.Now().Add(-(time.Duration(activeOutboundLeaseDurationSeconds) * time.Second)).Unix())
+	cutoff := Timestamp(clock.Now().Add(-(time.Duration(activeOutboundLeaseDurationSeconds) * time.Second)).Unix())
 	if n.LastAccess > cutoff {
 		return true
 	} else {
@@ -82,7 +83,7 @@ func (n *ConnectionRecord) hasActiveOutboundLease() bool {
 }
 
 func (n *ConnectionRecord) hasActivePingLease() bool {
-	cutoff := Timestamp(time.Now().Add(-(time.Duration(activePingLeaseDurationSeconds) * time.Second)).Unix())
+	cutoff := Timestamp(clock.Now().Add(-(time.Duration(activePingLeaseDurationSeconds) * time.Second)).Unix())
 	if n.LastAccess > cutoff {
 		return true
 	} else {
@@ -91,7 +92,7 @@ func (n *ConnectionRecord) hasActivePingLease() bool {
 }
 
 func (n *ConnectionRecord) hasHistoryInboundLease() bool {
-	cutoff := Timestamp(time.Now().Add(-(time.Duration(historyInboundLeaseDurationSeconds) * time.Second)).Unix())
+	cutoff := Timestamp(clock.Now().Add(-(time.Duration(historyInboundLeaseDurationSeconds) * time.Second)).Unix())
 	if n.LastAccess > cutoff {
 		return true
 	} else {
@@ -99,7 +100,7 @@ func (n *ConnectionRecord) hasHistoryInboundLease() bool {
 	}
 }
 func (n *ConnectionRecord) hasHistoryOutboundLease() bool {
-	cutoff := Timestamp(time.Now().Add(-(time.Duration(historyOutboundLeaseDurationSeconds) * time.Second)).Unix())
+	cutoff := Timestamp(clock.Now().Add(-(time.Duration(historyOutboundLeaseDurationSeconds) * time.Second)).Unix())
 	if n.LastAccess > cutoff {
 		return true
 	} else {
@@ -136,7 +137,7 @@ func (n *Bouncer) indexOf(direction string, loc, subloc string, port uint16, inb
 }
 
 func (n *Bouncer) insert(direction string, loc, subloc string, port uint16, isReverseConn bool) {
-	now := Timestamp(time.Now().Unix())
+	now := Timestamp(clock.Now().Unix())
 	entry := ConnectionRecord{Location: loc, Sublocation: subloc, Port: port, FirstAccess: now, LastAccess: now}
 	switch direction {
 	case "inbound":
@@ -192,11 +193,11 @@ func (n *Bouncer) flush() {
 
 func (n *Bouncer) flushActives() {
 	// If there's been a flush in the past 1 min, ignore flush. This is because flush is in a hot path, we want to avoid unnecessary repeats.
-	if n.ActivesLastFlush > Timestamp(time.Now().Add(-(time.Duration(minimumActivesFlushIntervalSeconds) * time.Second)).Unix()) {
+	if n.ActivesLastFlush > Timestamp(clock.Now().Add(-(time.Duration(minimumActivesFlushIntervalSeconds) * time.Second)).Unix()) {
 		return
 	}
 	// Set ActivesLastFlush to now if the gate above passes.
-	n.ActivesLastFlush = Timestamp(time.Now().Add(-(time.Duration(minimumActivesFlushIntervalSeconds) * time.Second)).Unix())
+	n.ActivesLastFlush = Timestamp(clock.Now().Add(-(time.Duration(minimumActivesFlushIntervalSeconds) * time.Second)).Unix())
 	for i := len(n.Inbounds) - 1; i >= 0; i-- {
 		if !n.Inbounds[i].hasActiveInboundLease() {
 			n.removeItem("inbound", i)
@@ -215,10 +216,10 @@ func (n *Bouncer) flushActives() {
 }
 
 func (n *Bouncer) flushHistory() {
-	if n.HistoryLastFlush > Timestamp(time.Now().Add(-(time.Duration(minimumHistoryFlushIntervalSeconds) * time.Second)).Unix()) {
+	if n.HistoryLastFlush > Timestamp(clock.Now().Add(-(time.Duration(minimumHistoryFlushIntervalSeconds) * time.Second)).Unix()) {
 		return
 	}
-	n.HistoryLastFlush = Timestamp(time.Now().Add(-(time.Duration(minimumHistoryFlushIntervalSeconds) * time.Second)).Unix())
+	n.HistoryLastFlush = Timestamp(clock.Now().Add(-(time.Duration(minimumHistoryFlushIntervalSeconds) * time.Second)).Unix())
 	for i := len(n.InboundHistory) - 1; i >= 0; i-- {
 		if !n.InboundHistory[i].hasHistoryInboundLease() {
 			n.removeItem("inboundHistory", i)
@@ -254,7 +255,7 @@ func (n *Bouncer) RequestInboundLease(loc, subloc, proxy string, port uint16, is
 	if leaseIndex != -1 && n.Inbounds[leaseIndex].hasActiveInboundLease() {
 		// fmt.Println("Lease was renewed.")
 		// fmt.Printf("lease index: %v, inbounds: %#v", leaseIndex, n.Inbounds)
-		n.Inbounds[leaseIndex].LastAccess = Timestamp(time.Now().Unix())
+		n.Inbounds[leaseIndex].LastAccess = Timestamp(clock.Now().Unix())
 		return true
 	} else {
 		if len(n.Inbounds) < bc.GetMaxInboundConns() ||
@@ -295,7 +296,7 @@ func (n *Bouncer) RequestOutboundLease(loc, subloc string, port uint16, isRevers
 	n.flush()
 	leaseIndex := n.indexOf("outbound", loc, subloc, port, false, isReverseConn)
 	if leaseIndex != -1 && n.Outbounds[leaseIndex].hasActiveOutboundLease() {
-		n.Outbounds[leaseIndex].LastAccess = Timestamp(time.Now().Unix())
+		n.Outbounds[leaseIndex].LastAccess = Timestamp(clock.Now().Unix())
 		return true
 	} else {
 		if len(n.Outbounds) < bc.GetMaxOutboundConns() { // || isReverseConn
@@ -337,7 +338,7 @@ func (n *Bouncer) RequestPingLease(loc, subloc, proxy string, port uint16) bool
 	if leaseIndex != -1 && n.Pings[leaseIndex].hasActivePingLease() {
 		// fmt.Println("Lease was renewed.")
 		// fmt.Printf("lease index: %v, pings: %#v", leaseIndex, n.Pings)
-		n.Pings[leaseIndex].LastAccess = Timestamp(time.Now().Unix())
+		n.Pings[leaseIndex].LastAccess = Timestamp(clock.Now().Unix())
 		return true
 	} else {
 		if len(n.Pings) < bc.GetMaxPingConns() {
@@ -363,7 +364,7 @@ func (n *Bouncer) ReleaseOutboundLease(loc, subloc string, port uint16, wasSucce
 	leaseIndex := n.indexOf("outbound", loc, subloc, port, false, isReverseConn)
 	// fmt.Printf("Lease index was %v\n", leaseIndex)
 	if leaseIndex != -1 {
-		n.Outbounds[leaseIndex].LastAccess = Timestamp(time.Now().Unix())
+		n.Outbounds[leaseIndex].LastAccess = Timestamp(clock.Now().Unix())
 		n.Outbounds[leaseIndex].Outbound_Successful = wasSuccessful
 		n.removeItem("outbound", leaseIndex)
 		allocated, max := n.GetOutboundSaturation()
@@ -379,7 +380,7 @@ func (n *Bouncer) ReleaseInboundLease(loc, subloc string, port uint16, wasSucces
 	leaseIndex := n.indexOf("inbound", loc, subloc, port, isReverseConn, false)
 	// fmt.Printf("Lease index was %v\n", leaseIndex)
 	if leaseIndex != -1 {
-		n.Inbounds[leaseIndex].LastAccess = Timestamp(time.Now().Unix())
+		n.Inbounds[leaseIndex].LastAccess = Timestamp(clock.Now().Unix())
 		if isReverseConn && wasSuccessful {
 			n.Inbounds[leaseIndex].Inbound_ReverseConn_Successful = wasSuccessful
 		}
@@ -43,11 +43,14 @@ const (
 	defaultExternalIp                              = "127.0.0.1" // Localhost, if this is still 127.0.0.1 at any point in the future we failed at finding this out.
 	defaultExternalIpType                          = 4           // IPv4
 	defaultExternalPort                            = 49999
-	defaultDbEngine                                = "sqlite" // 'sqlite' or 'mysql'
+	defaultDbEngine                                = "sqlite" // 'sqlite', 'mysql' or 'postgres'
 	defaultDBIp                                    = "127.0.0.1"
-	defaultDbPort                                  = 3306
+	defaultDbPort                                  = 3306 // MySQL
+	defaultPostgresDbPort                          = 5432
 	defaultDbUsername                              = "aether-app-db-access-user"
 	defaultDbPassword                              = "exventoveritas"
+	defaultDbName                                  = "aetherdb"
+	defaultDbSSLMode                               = "disable"
 	defaultNeighbourCount                          = 10
 	defaultMaxAddressTableSize                     = 1000
 	defaultMaxInboundConns                         = 10
@@ -58,6 +61,9 @@ const (
 	defaultBootstrapAfterOfflineMinutes            = 360
 	defaultNodeType                                = 2
 	defaultCacheGenerationInterval                 = 10 * time.Minute
+	defaultBackendAPIAccessTokenExpiry             = 24 * time.Hour
+	defaultFrontendRequestsPerMinute               = 600
+	defaultBackendMetricsExporterAddress           = "127.0.0.1:39990"
 )
 
 // Frontend defaults
@@ -75,6 +81,8 @@ const (
 	defaultMinimumVoteThresholdForElectionValidity = 100 // Short of 10 votes on any direction, an election is not valid because the size is too small.
 	defaultKvStoreRetentionDays                    = 180
 	defaultLocalDevBackendDirectory                = "../../../aether-core/aether/backend"
+	defaultFrontendMetricsExporterAddress          = "127.0.0.1:39991"
+	defaultThreadRanking                           = "hot"
 )
 
 // Shared defaults between frontend and backend
@@ -117,6 +125,10 @@ const (
 	defaultTruststateManifestsPageSize = 30000
 	defaultAddressManifestsPageSize    = 30000
 	// Manifests are all the same size, so they're all the same.
+
+	defaultDirectMessagesPageSize         = 1000
+	defaultDirectMessageIndexesPageSize   = 15000
+	defaultDirectMessageManifestsPageSize = 30000
 )
 
 /*----------  Entity-specific defaults  ----------*/
@@ -124,14 +136,15 @@ const (
 /*----------  Entity versions  ----------*/
 
 const (
-	defaultBoardEntityVersion       = 1
-	defaultThreadEntityVersion      = 1
-	defaultPostEntityVersion        = 1
-	defaultVoteEntityVersion        = 1
-	defaultKeyEntityVersion         = 1
-	defaultTruststateEntityVersion  = 1
-	defaultAddressEntityVersion     = 1
-	defaultApiResponseEntityVersion = 1
+	defaultBoardEntityVersion         = 1
+	defaultThreadEntityVersion        = 1
+	defaultPostEntityVersion          = 1
+	defaultVoteEntityVersion          = 1
+	defaultKeyEntityVersion           = 1
+	defaultTruststateEntityVersion    = 1
+	defaultDirectMessageEntityVersion = 1
+	defaultAddressEntityVersion       = 1
+	defaultApiResponseEntityVersion   = 1
 )
 
 /*----------  Other fields  ----------*/
@@ -35,11 +35,18 @@ type Thread struct {
 	LastSeen    int64
 }
 
+// BoardRanking is the ranking algorithm the user picked for a board. This is kept apart from the subscriptions, because the user can pick one for a board without subscribing to it.
+type BoardRanking struct {
+	Fingerprint string
+	Ranking     string
+}
+
 type ContentRelations struct {
 	lock          sync.Mutex
 	Initialised   bool
 	SubbedBoards  []Board
 	SubbedThreads []Thread
+	BoardRankings []BoardRanking
 	SFWList       sfwlist
 }
 
@@ -72,6 +79,17 @@ func (c *ContentRelations) FindBoard(fp string) int {
 	return -1
 }
 
+// IsSubbedThread returns whether the user follows this thread, and whether the new posts in it raise notifications.
+func (c *ContentRelations) IsSubbedThread(fp string) (isSubbed, notifyEnabled bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	loc := c.FindThread(fp)
+	if loc != -1 {
+		return true, c.SubbedThreads[loc].Notify
+	}
+	return false, false
+}
+
 func (c *ContentRelations) FindThread(fp string) int {
 	for key, _ := range c.SubbedThreads {
 		if c.SubbedThreads[key].Fingerprint == fp {
@@ -87,17 +105,43 @@ func (c *ContentRelations) GetAllSubbedBoards() []Board {
 	return c.SubbedBoards
 }
 
+func (c *ContentRelations) findBoardRanking(fp string) int {
+	for key, _ := range c.BoardRankings {
+		if c.BoardRankings[key].Fingerprint == fp {
+			return key
+		}
+	}
+	return -1
+}
+
+// GetBoardRanking returns the ranking the user picked for this board. Blank if the user hasn't picked one, in which case the default ranking applies.
+func (c *ContentRelations) GetBoardRanking(fp string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if i := c.findBoardRanking(fp); i != -1 {
+		return c.BoardRankings[i].Ranking
+	}
+	return ""
+}
+
 /*----------  Signals (silenced/notify, etc.) status  ----------*/
 
-// SetBoardSignal sets the board signal into the storage. If a board is subscribed, we set the notify signal as well, if a subscription is removed, we remove the entry.
+// SetBoardSignal sets the board signal into the storage. If a board is subscribed, we set the notify signal as well, if a subscription is removed, we remove the entry. The ranking is set only if it's given, or if this is a ranking-only signal, in which case a blank ranking goes back to the default.
 func (c *ContentRelations) SetBoardSignal(
-	fp string, subscribed, notify bool, lastseen int64, lastSeenOnly bool) (committed bool) {
+	fp string, subscribed, notify bool, lastseen int64, lastSeenOnly bool, ranking string, rankingOnly bool) (committed bool) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	if lastSeenOnly {
 		c.insertLastSeenForBoard(fp, lastseen)
 		return
 	}
+	if rankingOnly {
+		c.insertBoardRanking(fp, ranking)
+		return true
+	}
+	if len(ranking) > 0 {
+		c.insertBoardRanking(fp, ranking)
+	}
 	if subscribed {
 		c.insertBoard(fp, notify, lastseen, lastSeenOnly)
 	} else {
@@ -106,6 +150,18 @@ func (c *ContentRelations) SetBoardSignal(
 	return true
 }
 
+// SetThreadSignal sets the thread signal into the storage. This is following a thread. If a thread is followed, we set the notify signal as well, if it's unfollowed, we remove the entry.
+func (c *ContentRelations) SetThreadSignal(fp string, subscribed, notify bool) (committed bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if subscribed {
+		c.insertThread(fp, notify)
+	} else {
+		c.removeThread(fp)
+	}
+	return true
+}
+
 /*----------  Internal work functions  ----------*/
 
 func (c *ContentRelations) insertBoard(fp string, notify bool, lastseen int64, lastSeenOnly bool) {
@@ -128,6 +184,22 @@ func (c *ContentRelations) insertLastSeenForBoard(fp string, lastseen int64) {
 	}
 }
 
+func (c *ContentRelations) insertBoardRanking(fp string, ranking string) {
+	i := c.findBoardRanking(fp)
+	if len(ranking) == 0 {
+		if i != -1 {
+			c.BoardRankings = append(c.BoardRankings[0:i], c.BoardRankings[i+1:len(c.BoardRankings)]...)
+		}
+		return
+	}
+	if i != -1 {
+		c.BoardRankings[i].Ranking = ranking
+		return
+	}
+	c.BoardRankings = append(c.BoardRankings,
+		BoardRanking{Fingerprint: fp, Ranking: ranking})
+}
+
 func (c *ContentRelations) insertThread(fp string, notify bool) {
 	if i := c.FindThread(fp); i != -1 {
 		c.SubbedThreads[i].Notify = notify
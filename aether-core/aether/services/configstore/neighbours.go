@@ -38,6 +38,16 @@ If there are no spacers, the oldest entry is gone
 
 Why?
 
+Which neighbour do we pop?
+
+Pop gives out the oldest. PopPreferred lets the caller order the neighbours (the dispatcher orders them by how well they behaved in our syncs with them) and gives out the first in that order. The neighbours the caller leaves out of the order (such as the ones it won't connect to for a while) are removed, the same as a pop whose connection failed. Neighbours the caller ranks equal keep their oldest-first order.
+
+Example 4:
+
+10 9 8 7 6 5 4 3 2 1, and the order given is 7 3 1 10 9 8 5 4 2 (6 left out)
+
+S S 10 9 8 5 4 3 2 1 is the result, and 7 pops out.
+
 Assume that you're making a sync call to your neighbours every 1 minute. And you're connecting to a new node every 10 minutes. This structure will allow you to both keep connecting to nodes you know (by popping and pushing them into the stack) and keep the list updated via not pushing back nodes that go offline. It also allows the processes that do not pop from this stack to introduce a new neighbour without creating complex communication paths between processes and functions.
 
 */
@@ -146,3 +156,39 @@ func (m *NeighboursList) Pop() (string, string, uint16) {
 	m.insertSpacer()
 	return ejectedItem.Location, ejectedItem.Sublocation, ejectedItem.Port
 }
+
+// PopPreferred gives out the first neighbour in the order the rank function puts them in. The rank function gets the neighbours oldest first, and returns the ones it would connect to in the order it prefers them. If it returns none, this returns a blank address.
+func (m *NeighboursList) PopPreferred(rank func([]Address) []Address) (string, string, uint16) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.fillIfNeeded()
+	candidates := []Address{}
+	for i := len(m.Neighbours) - 1; i >= 0; i-- {
+		if !isSpacer(m.Neighbours[i]) {
+			candidates = append(candidates, m.Neighbours[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return "", "", 0
+	}
+	ranked := rank(candidates)
+	kept := make(map[Address]bool)
+	for k, _ := range ranked {
+		kept[ranked[k]] = true
+	}
+	chosen := Address{}
+	if len(ranked) > 0 {
+		chosen = ranked[0]
+	}
+	// Remove the ones left out of the order, and the one we give out. Each leaves a spacer behind, so the list keeps its size.
+	for k, _ := range candidates {
+		if kept[candidates[k]] && candidates[k] != chosen {
+			continue
+		}
+		if idx := m.indexOf(candidates[k]); idx != -1 {
+			m.removeByIndex(idx)
+			m.insertSpacer()
+		}
+	}
+	return chosen.Location, chosen.Sublocation, chosen.Port
+}
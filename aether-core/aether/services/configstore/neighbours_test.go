@@ -0,0 +1,113 @@
+package configstore
+
+// These test the neighbours list, which neighbour it gives out, and what it keeps after.
+
+import (
+	"testing"
+)
+
+// Infrastructure
+
+// neighbours makes a full list of ports, newest first, as the pushes would leave it.
+func neighbours(t *testing.T, ports ...uint16) (*NeighboursList, func()) {
+	priorInit, priorCount := bc.Initialised, bc.NeighbourCount
+	bc.Initialised, bc.NeighbourCount = true, uint(len(ports))
+	m := &NeighboursList{}
+	for i := len(ports) - 1; i >= 0; i-- {
+		m.Push("127.0.0.1", "", ports[i])
+	}
+	return m, func() { bc.Initialised, bc.NeighbourCount = priorInit, priorCount }
+}
+
+func ports(m *NeighboursList) []uint16 {
+	ps := []uint16{}
+	for _, a := range m.Neighbours {
+		ps = append(ps, a.Port)
+	}
+	return ps
+}
+
+func samePorts(a, b []uint16) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, _ := range a {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// rankBy orders the neighbours by the ports given, and leaves out the ones not given.
+func rankBy(order ...uint16) func([]Address) []Address {
+	return func(ns []Address) []Address {
+		ranked := []Address{}
+		for _, p := range order {
+			for _, a := range ns {
+				if a.Port == p {
+					ranked = append(ranked, a)
+				}
+			}
+		}
+		return ranked
+	}
+}
+
+// Tests
+
+func TestNeighboursPop_Success(t *testing.T) {
+	m, teardown := neighbours(t, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1)
+	defer teardown()
+	if _, _, port := m.Pop(); port != 1 {
+		t.Errorf("Pop should give out the oldest. Got: %v", port)
+	}
+	if expected := []uint16{0, 10, 9, 8, 7, 6, 5, 4, 3, 2}; !samePorts(ports(m), expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, ports(m))
+	}
+}
+
+func TestNeighboursPopPreferred_Success(t *testing.T) {
+	m, teardown := neighbours(t, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1)
+	defer teardown()
+	var given []uint16
+	rank := func(ns []Address) []Address {
+		for _, a := range ns {
+			given = append(given, a.Port)
+		}
+		// 6 is left out.
+		return rankBy(7, 3, 1, 10, 9, 8, 5, 4, 2)(ns)
+	}
+	if _, _, port := m.PopPreferred(rank); port != 7 {
+		t.Errorf("PopPreferred should give out the first in the order. Got: %v", port)
+	}
+	if expected := []uint16{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !samePorts(given, expected) {
+		t.Errorf("The neighbours should be given to the rank oldest first. Expected: %v, Got: %v", expected, given)
+	}
+	if expected := []uint16{0, 0, 10, 9, 8, 5, 4, 3, 2, 1}; !samePorts(ports(m), expected) {
+		t.Errorf("The one given out and the one left out should have been removed. Expected: %v, Got: %v", expected, ports(m))
+	}
+	// The one given out comes back as the newest once the sync with it is done.
+	m.Push("127.0.0.1", "", 7)
+	if expected := []uint16{7, 0, 10, 9, 8, 5, 4, 3, 2, 1}; !samePorts(ports(m), expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, ports(m))
+	}
+}
+
+func TestNeighboursPopPreferred_NoneRanked_Fail(t *testing.T) {
+	m, teardown := neighbours(t, 3, 2, 1)
+	defer teardown()
+	if loc, subloc, port := m.PopPreferred(rankBy()); loc != "" || subloc != "" || port != 0 {
+		t.Errorf("Nothing should be given out when none are ranked. Got: %v %v %v", loc, subloc, port)
+	}
+	if expected := []uint16{0, 0, 0}; !samePorts(ports(m), expected) {
+		t.Errorf("The ones left out should have been removed. Expected: %v, Got: %v", expected, ports(m))
+	}
+	// An empty list doesn't call the rank.
+	if _, _, port := m.PopPreferred(func([]Address) []Address {
+		t.Errorf("The rank should not be called with no neighbours.")
+		return nil
+	}); port != 0 {
+		t.Errorf("Nothing should be given out from an empty list. Got: %v", port)
+	}
+}
@@ -4,6 +4,7 @@
 package configstore
 
 import (
+	"aether-core/aether/services/ca"
 	"aether-core/aether/services/fingerprinting"
 	"aether-core/aether/services/signaturing"
 	"aether-core/aether/services/toolbox"
@@ -40,11 +41,15 @@ func invalidDataError(input interface{}) error {
 
 const (
 	maxPOWBailoutSeconds            = 3600 // 1h
-	maxCacheGenerationIntervalHours = 168  // 7 days
-	maxCacheDurationHours           = 72   // 3 days
+	maxPoWMintingThreads            = 1024
+	maxCacheGenerationIntervalHours = 168 // 7 days
+	maxCacheDurationHours           = 72  // 3 days
 	maxAbsolutePageSize             = 1000000
 	maxPOWStrength                  = 63 // Our PoWs are 64 bytes long
 	maxLocationSize                 = 2500
+	maxRankingNameSize              = 64
+	maxNotificationKeywords         = 100
+	maxNotificationKeywordSize      = 64
 )
 
 /*
@@ -86,6 +91,10 @@ type EntityPageSizes struct {
 	KeyManifests        int
 	TruststateManifests int
 	AddressManifests    int
+
+	DirectMessages         int
+	DirectMessageIndexes   int
+	DirectMessageManifests int
 }
 
 type MinimumPoWStrengths struct {
@@ -101,6 +110,7 @@ type MinimumPoWStrengths struct {
 	KeyUpdate        int
 	Truststate       int
 	TruststateUpdate int
+	DirectMessage    int
 	ApiResponse      int
 }
 
@@ -119,6 +129,12 @@ type SubprotocolShim struct {
 	SupportedEntities []string `json:"supported_entities"`
 }
 
+// AllowedFrontend is a frontend that is allowed to use the backend API of this node.
+type AllowedFrontend struct {
+	PublicKey         string `json:"public_key"`
+	RequestsPerMinute int    `json:"requests_per_minute"` // 0: DefaultFrontendRequestsPerMinute
+}
+
 // CONFIGS
 
 var bc BackendConfig
@@ -148,6 +164,9 @@ The minimum number of zeros hashcash algorithm needs to have at the beginning of
 ## PoWBailoutTimeSeconds
 How long does it take before a PoW timestamp is marked unattainable by the local computer. This is to make sure that the app doesn't keep attempting forever for an unattainably strong PoW it attempted to generate.
 
+## PoWMintingThreads
+How many cores a PoW minting uses. 0 means all of them.
+
 ## CacheGenerationIntervalHours
 How often does the node generate a new cache. By default, it generates a new cache every day. This is only used for the standard, static interval cache generation. DEPRECATED
 
@@ -210,7 +229,7 @@ How deeply do we want to keep logs, or if any. 0 is no logs, 1 is medium, 2 is d
 The external IP of this machine.
 
 ## ExternalIpType
-The external IP type of this machine. 4: IPv4, 6: IPv6, 3: URL (in case of static)
+The external IP type of this machine. 4: IPv4, 6: IPv6, 3: URL (in case of static), 7: Unix socket, 8: in-memory, 9: simulated network. The last three are for local swarms and tests, the external IP is then the location of the transport, such as "unix:/tmp/swarm".
 
 ## ExternalPort
 The external port type of this machine.
@@ -222,7 +241,19 @@ The last time we synced with a static node.
 The last time we synced with a live node.
 
 ## ServingSubprotocols
-The subprotocols that this machine supports. In this case, c0 and dweb.
+The subprotocols that this machine supports. In this case, c0 and dm0. c0 carries the boards, threads, posts, votes, keys and truststates, dm0 carries the direct messages between users.
+
+## ServedRealms
+The realms (shards) this node carries, in addition to the public realm. A realm is identified by its RealmId, and every entity declares the realm it belongs to. Entities with an empty RealmId are in the public realm, which every node carries. If this is empty, this node carries only the public realm, which is what you want unless you are part of a private or semi-private network. The node tells the remotes it talks to which realms it carries, in a form only the nodes carrying the same realm can recognise, requests entities only from these realms, and refuses to accept or serve entities from realms not in this list. Heads up: a realm has no access control other than its RealmId. Anybody who knows the RealmId can read the realm, so keep it to the members.
+
+## SubscribedBoards
+The fingerprints of the boards this node syncs the threads, posts and votes of. If this and FollowedUsers are both empty, the node syncs the whole network, which is what you want unless you're running a lightweight node that only cares about a handful of boards. Boards, keys, truststates, direct messages and addresses are always synced in full, they're small, and they're needed to verify and show the rest.
+
+## FollowedUsers
+The key fingerprints of the users whose threads, posts and votes this node syncs, in whichever board they are. This adds to SubscribedBoards.
+
+## SyncedBoards, SyncedUsers
+The subscriptions that the sync timestamps of the remotes were recorded with. When the subscriptions widen, the node forgets those timestamps, so that it can fetch the history of what it's newly subscribed to. Not user-changeable.
 
 ## NodeId
 The node id of this machine. This is a randomly generated number. It does not have much significance beyond letting remote nodes keep their sync timestamps in check.
@@ -237,7 +268,7 @@ Where we save the caches. This directory is given by the OS.
 Whether the configuration file is properly initialised. If this is false, the initialisation did not complete.
 
 ## DbEngine
-DbEngine allows the user to choose the database they want to use. SQLite is better for local installations where the app stays running on a desktop machine. It is simple and fast. MySQL is better when there are multiple users on the same backend, and it's a lot more robust against concurrent accesses. The preferred MySQL implementation is MariaDB, but original MySQL should also work.
+DbEngine allows the user to choose the database they want to use. SQLite is better for local installations where the app stays running on a desktop machine. It is simple and fast. MySQL is better when there are multiple users on the same backend, and it's a lot more robust against concurrent accesses. The preferred MySQL implementation is MariaDB, but original MySQL should also work. PostgreSQL ("postgres") is the other option for server deployments, and it works with managed Postgres offerings.
 
 Important: Do not forget that you have to create a DB called "aetherdb" in your preferred SQL engine with read/write access for the Username you give below.
 
@@ -247,7 +278,7 @@ Important: Do not forget that you have to create a DB called "aetherdb" in your
 This is the IP of the SQL server, if not SQLite3. By default, it's 127.0.0.1.
 
 ## DbPort
-Port of the SQL server, if not SQLite3. By default, it's 3306 (MySQL default port)
+Port of the SQL server, if not SQLite3. By default, it's 3306 (MySQL default port), or 5432 (Postgres default port) if the DbEngine is postgres. If you switch an existing config to postgres and the port is still the MySQL default, it's moved to the Postgres default.
 
 ## DbUsername
 DbUsername is the username of the account that has read/write access to the "aetherdb" database, if not SQLite3. By default it's "aether-app-db-access-user".
@@ -255,6 +286,12 @@ DbUsername is the username of the account that has read/write access to the "aet
 ## DbPassword
 The password of the DB user, if not SQLite3. By default it's "exventoveritas". It's highly recommended that you change this if you're using MySQL. If you don't know what you're using, you're not using MySQL.
 
+## DbName
+The name of the database to connect to, Postgres only. By default it's "aetherdb". MySQL creates and uses its own "AetherDB" database, so this does not apply there.
+
+## DbSSLMode
+The SSL mode of the Postgres connection. One of "disable", "require", "verify-ca", "verify-full". By default it's "disable", which is fine for a Postgres on the same machine. Managed Postgres offerings will usually want "require" or stricter.
+
 ## MetricsLevel
 ## MetricsToken
 
@@ -277,7 +314,7 @@ How many nodes we are interested in keeping in touch with on a rolling basis.
 How many nodes do we allow to be simultaneously connected to this node. This number depends on your bandwidth and CPU resources. Setting this number to zero renders the config invalid (same as most things in config) and it will automatically regenerate from scratch, removing all prior config data.
 
 # MaxOutboundConns
-How many outbounds do we allow. Otherwise same as MaxInboundConns.
+How many outbounds do we allow. This is also how many syncs can run at the same time, since every sync holds an outbound lease for its duration. Otherwise same as MaxInboundConns.
 
 # MaxPingConns
 How many ping (inbound 'hello's) do we allow. Otherwise same as MaxInboundConns.
@@ -379,6 +416,22 @@ This is the address of the frontend that has spawned the backend. Backend will u
 # AdminFrontendPublicKey
 This is the public key of the frontend that has spawned the backend. This is effectively the 'admin' frontend for the backend (there can be multiple frontends). The admin frontend can run privileged requests, and change the admin fe address to a specific frontend (or to itself).
 
+# AllowedFrontends
+These are the frontends, other than the admin frontend, that this backend is willing to serve. Each entry is the public key of a frontend, and optionally how many requests per minute that frontend can make. If the requests per minute is zero, DefaultFrontendRequestsPerMinute applies. The admin frontend is always allowed, so you only need this if you are serving your backend publicly to more than one frontend.
+
+# BackendAPIAccessTokenExpiry
+How long an access token given to a frontend through RequestBackendAccess is valid. After this, the frontend has to request access again.
+
+# DefaultFrontendRequestsPerMinute
+The rate limit for the frontends that don't have a specific rate limit set in AllowedFrontends. This includes the admin frontend.
+
+# MetricsExporterEnabled
+# MetricsExporterAddress
+If enabled, the backend serves its internals (bouncer saturation, sync durations and counts, purgatory sizes, cache generation timings, DB size, event horizon) in the Prometheus text format at http://<MetricsExporterAddress>/metrics, so that it can be scraped with standard tooling. This is disabled by default. The address is local by default, if you want to scrape it from another machine, set it to an address that machine can reach, e.g. 0.0.0.0:39990. There is no authentication on this endpoint, so only do this in a network you trust.
+
+# TrustedCAKeys
+The CA keys this node trusts, each with a label, a priority (lower number is higher priority) and a validity window (ValidFrom, ValidUntil, unix timestamps, 0 means open). Defaults to the Aether CA. Keys can be added and retired with a CA-signed rotation statement, see the rotateca command, so there is no need to edit this by hand unless you want to trust a CA of your own.
+
 # GRPCServiceTimeout
 How long does a GRPC service attempts to connect before considering the connection unusable.
 
@@ -402,6 +455,9 @@ Enabling this will make your bootstrap node be 'primary'. This means it will not
 
 # RenderNonconnectible
 Enabling this will make your node not provide its port number to other remote nodes, rendering them unable to access and sync with you. This means the content you post will *not* leave your computer unless your computer is capable of reverse opens. This is good for debugging and simulating cases where the port mapping fails, but this is not a state you want to be in if you can help it.
+
+# DirectMessagesOptInApplied
+The configs from before direct messages serve only c0. They're opted into dm0 once, at the first start with direct messages. This is set after that, so that if you take dm0 out of the ServingSubprotocols, it stays out.
 */
 
 // Every time you add a new item here, please add getters, setters and to blankcheck method
@@ -417,6 +473,7 @@ type BackendConfig struct {
 	EntityPageSizes                         EntityPageSizes     //
 	MinimumPoWStrengths                     MinimumPoWStrengths //
 	PoWBailoutTimeSeconds                   uint                // 30
+	PoWMintingThreads                       uint                // 0: all cores
 	CacheGenerationIntervalHours            uint                // 24
 	CacheDurationHours                      uint                // 6
 	ClientVersionMajor                      uint8               // 2 addr
@@ -441,6 +498,11 @@ type BackendConfig struct {
 	LastStaticAddressConnectionTimestamp    int64
 	LastLiveAddressConnectionTimestamp      int64
 	ServingSubprotocols                     []SubprotocolShim
+	ServedRealms                            []string // Empty: public realm only
+	SubscribedBoards                        []string // Empty (with FollowedUsers): whole network
+	FollowedUsers                           []string
+	SyncedBoards                            []string
+	SyncedUsers                             []string
 	NodeId                                  string
 	UserDirectory                           string
 	CachesDirectory                         string
@@ -450,6 +512,8 @@ type BackendConfig struct {
 	DbPort                                  uint16 // Only applies to non-sqlite
 	DbUsername                              string // Only applies to non-sqlite
 	DbPassword                              string // Only applies to non-sqlite
+	DbName                                  string // Only applies to postgres
+	DbSSLMode                               string // Only applies to postgres
 	MetricsLevel                            uint8  // 0: no metrics transmitted
 	MetricsToken                            string // If metrics level is not zero, metrics token is the anonymous identifier for the metrics server. Resetting this to 0 makes this node behave like a new node as far as metrics go, but if you don't want metrics to be collected, you can set it through the application or set the metrics level to zero in the JSON settings file.
 	BackendKeyPair                          string // This is the Aether key, not TLS key
@@ -477,6 +541,12 @@ type BackendConfig struct {
 	BackendAPIPort                          uint16
 	AdminFrontendAddress                    string // Format: "127.0.0.1:65535"
 	AdminFrontendPublicKey                  string
+	AllowedFrontends                        []AllowedFrontend
+	BackendAPIAccessTokenExpiry             time.Duration
+	DefaultFrontendRequestsPerMinute        int
+	MetricsExporterEnabled                  bool
+	MetricsExporterAddress                  string // Format: "127.0.0.1:65535"
+	TrustedCAKeys                           []ca.CAKey
 	GRPCServiceTimeout                      time.Duration
 	ExternalVerifyEnabled                   bool
 	SQLiteDBLocation                        string
@@ -485,6 +555,7 @@ type BackendConfig struct {
 	CacheGenerationInterval                 time.Duration
 	PrimaryBootstrap                        bool
 	RenderNonconnectible                    bool
+	DirectMessagesOptInApplied              bool
 }
 
 // GETTERS AND SETTERS
@@ -598,7 +669,14 @@ func (config *BackendConfig) GetEntityPageSizes() EntityPageSizes {
 		config.EntityPageSizes.TruststateManifests < maxAbsolutePageSize &&
 		config.EntityPageSizes.TruststateManifests > 0 &&
 		config.EntityPageSizes.AddressManifests < maxAbsolutePageSize &&
-		config.EntityPageSizes.AddressManifests > 0 {
+		config.EntityPageSizes.AddressManifests > 0 &&
+
+		config.EntityPageSizes.DirectMessages < maxAbsolutePageSize &&
+		config.EntityPageSizes.DirectMessages > 0 &&
+		config.EntityPageSizes.DirectMessageIndexes < maxAbsolutePageSize &&
+		config.EntityPageSizes.DirectMessageIndexes > 0 &&
+		config.EntityPageSizes.DirectMessageManifests < maxAbsolutePageSize &&
+		config.EntityPageSizes.DirectMessageManifests > 0 {
 		return config.EntityPageSizes
 	} else {
 		log.Fatal(fmt.Sprintf("%#v", invalidDataError(config.EntityPageSizes)) + " Trace: " + toolbox.Trace())
@@ -620,6 +698,7 @@ func (config *BackendConfig) GetMinimumPoWStrengths() MinimumPoWStrengths {
 		config.MinimumPoWStrengths.KeyUpdate < maxPOWStrength &&
 		config.MinimumPoWStrengths.Truststate < maxPOWStrength &&
 		config.MinimumPoWStrengths.TruststateUpdate < maxPOWStrength &&
+		config.MinimumPoWStrengths.DirectMessage < maxPOWStrength &&
 		config.MinimumPoWStrengths.ApiResponse < maxPOWStrength {
 		return config.MinimumPoWStrengths
 	} else {
@@ -628,6 +707,17 @@ func (config *BackendConfig) GetMinimumPoWStrengths() MinimumPoWStrengths {
 	log.Fatal("This should never happen." + toolbox.Trace())
 	return MinimumPoWStrengths{}
 }
+func (config *BackendConfig) GetPoWMintingThreads() int {
+	config.InitCheck()
+	if config.PoWMintingThreads <= maxPoWMintingThreads {
+		return int(config.PoWMintingThreads)
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.PoWMintingThreads) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return 0
+}
+
 func (config *BackendConfig) GetPoWBailoutTimeSeconds() int {
 	config.InitCheck()
 	if config.PoWBailoutTimeSeconds < maxPOWBailoutSeconds &&
@@ -872,9 +962,56 @@ func (config *BackendConfig) GetServingSubprotocols() []SubprotocolShim {
 	}
 	return config.ServingSubprotocols
 }
+
+// servesSubprotocol checks whether the given subprotocol is in the serving subprotocols, without the init check, so that it can be used in the sanity check.
+func (config *BackendConfig) servesSubprotocol(name string) bool {
+	for _, val := range config.ServingSubprotocols {
+		if val.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (config *BackendConfig) GetServedRealms() []string {
+	config.InitCheck()
+	for _, val := range config.ServedRealms {
+		if !realmIdValid(val) {
+			log.Fatal(invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace()))
+		}
+	}
+	return config.ServedRealms
+}
+func (config *BackendConfig) GetSubscribedBoards() []string {
+	config.InitCheck()
+	checkSubscriptionFingerprints(config.SubscribedBoards)
+	return config.SubscribedBoards
+}
+func (config *BackendConfig) GetFollowedUsers() []string {
+	config.InitCheck()
+	checkSubscriptionFingerprints(config.FollowedUsers)
+	return config.FollowedUsers
+}
+func (config *BackendConfig) GetSyncedBoards() []string {
+	config.InitCheck()
+	checkSubscriptionFingerprints(config.SyncedBoards)
+	return config.SyncedBoards
+}
+func (config *BackendConfig) GetSyncedUsers() []string {
+	config.InitCheck()
+	checkSubscriptionFingerprints(config.SyncedUsers)
+	return config.SyncedUsers
+}
+func checkSubscriptionFingerprints(vals []string) {
+	for _, val := range vals {
+		if !subscriptionFingerprintValid(val) {
+			log.Fatal(invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace()))
+		}
+	}
+}
 func (config *BackendConfig) GetExternalIpType() uint8 {
 	config.InitCheck()
-	if config.ExternalIpType == 6 || config.ExternalIpType == 4 || config.ExternalIpType == 3 { // 6: ipv6, 4: ipv4, 3: URL (useful in static nodes)
+	if config.ExternalIpType == 6 || config.ExternalIpType == 4 || config.ExternalIpType == 3 || config.ExternalIpType == 7 || config.ExternalIpType == 8 || config.ExternalIpType == 9 { // 6: ipv6, 4: ipv4, 3: URL (useful in static nodes), 7: unix socket, 8: in-memory, 9: simulated network
 		return config.ExternalIpType
 	} else {
 		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.ExternalIpType) + " Trace: " + toolbox.Trace()))
@@ -926,7 +1063,7 @@ func (config *BackendConfig) GetCachesDirectory() string {
 }
 func (config *BackendConfig) GetDbEngine() string {
 	config.InitCheck()
-	if config.DbEngine == "sqlite" || config.DbEngine == "mysql" {
+	if config.DbEngine == "sqlite" || config.DbEngine == "mysql" || config.DbEngine == "postgres" {
 		return config.DbEngine
 	} else {
 		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.DbEngine) + " Trace: " + toolbox.Trace()))
@@ -977,6 +1114,27 @@ func (config *BackendConfig) GetDbPassword() string {
 	log.Fatal("This should never happen." + toolbox.Trace())
 	return ""
 }
+func (config *BackendConfig) GetDbName() string {
+	config.InitCheck()
+	if len(config.DbName) < toolbox.MaxUint8 &&
+		len(config.DbName) > 0 {
+		return config.DbName
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.DbName) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return ""
+}
+func (config *BackendConfig) GetDbSSLMode() string {
+	config.InitCheck()
+	if config.DbSSLMode == "disable" || config.DbSSLMode == "require" || config.DbSSLMode == "verify-ca" || config.DbSSLMode == "verify-full" {
+		return config.DbSSLMode
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.DbSSLMode) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return ""
+}
 
 func (config *BackendConfig) GetMetricsLevel() uint8 {
 	config.InitCheck()
@@ -1247,6 +1405,61 @@ func (config *BackendConfig) GetAdminFrontendPublicKey() string {
 	config.InitCheck()
 	return config.AdminFrontendPublicKey
 }
+func (config *BackendConfig) GetAllowedFrontends() []AllowedFrontend {
+	config.InitCheck()
+	for _, val := range config.AllowedFrontends {
+		if len(val.PublicKey) == 0 || val.RequestsPerMinute < 0 {
+			log.Fatal(invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace()))
+		}
+	}
+	return config.AllowedFrontends
+}
+func (config *BackendConfig) GetBackendAPIAccessTokenExpiry() time.Duration {
+	config.InitCheck()
+	if config.BackendAPIAccessTokenExpiry >= 1*time.Minute {
+		return config.BackendAPIAccessTokenExpiry
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.BackendAPIAccessTokenExpiry) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return time.Duration(0)
+}
+func (config *BackendConfig) GetDefaultFrontendRequestsPerMinute() int {
+	config.InitCheck()
+	if config.DefaultFrontendRequestsPerMinute > 0 {
+		return config.DefaultFrontendRequestsPerMinute
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.DefaultFrontendRequestsPerMinute) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return 0
+}
+func (config *BackendConfig) GetMetricsExporterEnabled() bool {
+	config.InitCheck()
+	return config.MetricsExporterEnabled
+}
+func (config *BackendConfig) GetMetricsExporterAddress() string {
+	config.InitCheck()
+	if len(config.MetricsExporterAddress) > 0 &&
+		len(config.MetricsExporterAddress) < maxLocationSize {
+		return config.MetricsExporterAddress
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.MetricsExporterAddress) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return ""
+}
+func (config *BackendConfig) GetTrustedCAKeys() []ca.CAKey {
+	config.InitCheck()
+	if len(config.TrustedCAKeys) > 0 &&
+		ca.ValidateKeys(config.TrustedCAKeys) == nil {
+		return config.TrustedCAKeys
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.TrustedCAKeys) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return []ca.CAKey{}
+}
 func (config *BackendConfig) GetGRPCServiceTimeout() time.Duration {
 	config.InitCheck()
 	if config.GRPCServiceTimeout >= 1*time.Second { // Any value under is probably an attack.
@@ -1306,6 +1519,11 @@ func (config *BackendConfig) GetRenderNonconnectible() bool {
 	return config.RenderNonconnectible
 }
 
+func (config *BackendConfig) GetDirectMessagesOptInApplied() bool {
+	config.InitCheck()
+	return config.DirectMessagesOptInApplied
+}
+
 /*****************************************************************************/
 
 // Setters
@@ -1437,6 +1655,7 @@ func (config *BackendConfig) SetMinimumPoWStrengths(powStr int) error {
 		mps.KeyUpdate = powStr
 		mps.Truststate = powStr
 		mps.TruststateUpdate = powStr
+		mps.DirectMessage = powStr
 		mps.ApiResponse = powStr - 6
 		// ^ ApiResponse PoW strength is a little softer because remotes need it to make POST requests to each other. Temp value, subject to solidification or change in the future.
 		config.MinimumPoWStrengths = mps
@@ -1450,6 +1669,22 @@ func (config *BackendConfig) SetMinimumPoWStrengths(powStr int) error {
 	log.Fatal("This should never happen." + toolbox.Trace())
 	return nil
 }
+func (config *BackendConfig) SetPoWMintingThreads(val int) error {
+	config.InitCheck()
+	if val >= 0 && val <= maxPoWMintingThreads {
+		config.PoWMintingThreads = uint(val)
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
 func (config *BackendConfig) SetPoWBailoutTimeSeconds(val int) error {
 	config.InitCheck()
 	if val > 0 {
@@ -1806,9 +2041,75 @@ func (config *BackendConfig) SetServingSubprotocols(subprotocols []interface{})
 	}
 	return nil
 }
+
+// realmIdValid checks the realm ids we carry. These end up as directory names in the caches directory, so we're stricter than what a fingerprint would allow.
+func realmIdValid(val string) bool {
+	if len(val) == 0 || len(val) > 64 {
+		return false
+	}
+	for _, c := range val {
+		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+func (config *BackendConfig) SetServedRealms(realms []string) error {
+	config.InitCheck()
+	for _, val := range realms {
+		if !realmIdValid(val) {
+			return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+		}
+	}
+	config.ServedRealms = realms
+	commitErr := config.Commit()
+	if commitErr != nil {
+		return commitErr
+	}
+	return nil
+}
+
+// subscriptionFingerprintValid checks the board and key fingerprints of the subscriptions. These go into the filters of the POST requests, so they have to be within the bounds of a fingerprint.
+func subscriptionFingerprintValid(val string) bool {
+	if len(val) == 0 || len(val) > 64 {
+		return false
+	}
+	for _, c := range val {
+		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+func (config *BackendConfig) setSubscriptionFingerprints(field *[]string, vals []string) error {
+	config.InitCheck()
+	for _, val := range vals {
+		if !subscriptionFingerprintValid(val) {
+			return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+		}
+	}
+	*field = vals
+	commitErr := config.Commit()
+	if commitErr != nil {
+		return commitErr
+	}
+	return nil
+}
+func (config *BackendConfig) SetSubscribedBoards(boards []string) error {
+	return config.setSubscriptionFingerprints(&config.SubscribedBoards, boards)
+}
+func (config *BackendConfig) SetFollowedUsers(users []string) error {
+	return config.setSubscriptionFingerprints(&config.FollowedUsers, users)
+}
+func (config *BackendConfig) SetSyncedBoards(boards []string) error {
+	return config.setSubscriptionFingerprints(&config.SyncedBoards, boards)
+}
+func (config *BackendConfig) SetSyncedUsers(users []string) error {
+	return config.setSubscriptionFingerprints(&config.SyncedUsers, users)
+}
 func (config *BackendConfig) SetExternalIpType(val int) error {
 	config.InitCheck()
-	if val == 6 || val == 4 || val == 3 {
+	if val == 6 || val == 4 || val == 3 || val == 7 || val == 8 || val == 9 {
 		config.ExternalIpType = uint8(val)
 		commitErr := config.Commit()
 		if commitErr != nil {
@@ -1883,7 +2184,7 @@ func (config *BackendConfig) SetCachesDirectory(val string) error {
 }
 func (config *BackendConfig) SetDbEngine(val string) error {
 	config.InitCheck()
-	if val == "mysql" || val == "sqlite" {
+	if val == "mysql" || val == "sqlite" || val == "postgres" {
 		config.DbEngine = val
 		commitErr := config.Commit()
 		if commitErr != nil {
@@ -1956,6 +2257,36 @@ func (config *BackendConfig) SetDbPassword(val string) error {
 	log.Fatal("This should never happen." + toolbox.Trace())
 	return nil
 }
+func (config *BackendConfig) SetDbName(val string) error {
+	config.InitCheck()
+	if len(val) > 0 && len(val) < toolbox.MaxUint8 {
+		config.DbName = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+func (config *BackendConfig) SetDbSSLMode(val string) error {
+	config.InitCheck()
+	if val == "disable" || val == "require" || val == "verify-ca" || val == "verify-full" {
+		config.DbSSLMode = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
 
 func (config *BackendConfig) SetMetricsLevel(val int) error {
 	config.InitCheck()
@@ -2340,6 +2671,109 @@ func (config *BackendConfig) SetAdminFrontendPublicKey(val string) error {
 	return nil
 }
 
+func (config *BackendConfig) SetAllowedFrontends(frontends []AllowedFrontend) error {
+	config.InitCheck()
+	for _, val := range frontends {
+		if len(val.PublicKey) == 0 || val.RequestsPerMinute < 0 {
+			return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+		}
+	}
+	config.AllowedFrontends = frontends
+	commitErr := config.Commit()
+	if commitErr != nil {
+		return commitErr
+	}
+	return nil
+}
+
+func (config *BackendConfig) SetBackendAPIAccessTokenExpiry(val time.Duration) error {
+	config.InitCheck()
+	if val >= 1*time.Minute {
+		config.BackendAPIAccessTokenExpiry = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *BackendConfig) SetDefaultFrontendRequestsPerMinute(val int) error {
+	config.InitCheck()
+	if val > 0 {
+		config.DefaultFrontendRequestsPerMinute = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *BackendConfig) SetMetricsExporterEnabled(val bool) error {
+	config.InitCheck()
+	config.MetricsExporterEnabled = val
+	commitErr := config.Commit()
+	if commitErr != nil {
+		return commitErr
+	}
+	return nil
+}
+
+func (config *BackendConfig) SetMetricsExporterAddress(val string) error {
+	config.InitCheck()
+	if len(val) > 0 && len(val) < maxLocationSize {
+		config.MetricsExporterAddress = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *BackendConfig) SetTrustedCAKeys(val []ca.CAKey) error {
+	config.InitCheck()
+	if len(val) > 0 && ca.ValidateKeys(val) == nil {
+		config.TrustedCAKeys = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+// ApplyCARotation applies a CA-signed rotation statement to the trusted CA keys, and saves the result.
+func (config *BackendConfig) ApplyCARotation(stmt ca.RotationStatement) error {
+	keys, err := ca.ApplyRotation(config.GetTrustedCAKeys(), stmt)
+	if err != nil {
+		return err
+	}
+	err2 := config.SetTrustedCAKeys(keys)
+	if err2 != nil {
+		return err2
+	}
+	ca.SetTrustedKeys(keys)
+	return nil
+}
+
 func (config *BackendConfig) SetGRPCServiceTimeout(val time.Duration) error {
 	config.InitCheck()
 	if val >= 1*time.Second { // Any value under is probably an attack.
@@ -2438,6 +2872,16 @@ func (config *BackendConfig) SetRenderNonconnectible(val bool) error {
 	return nil
 }
 
+func (config *BackendConfig) SetDirectMessagesOptInApplied(val bool) error {
+	config.InitCheck()
+	config.DirectMessagesOptInApplied = val
+	commitErr := config.Commit()
+	if commitErr != nil {
+		return commitErr
+	}
+	return nil
+}
+
 /*****************************************************************************/
 
 // BlankCheck looks at all variables and if it finds they're at their zero value, sets the default value for it. This is a guard against a new item being added to the config store as a result of a version update, but it being zero value. If a zero'd value is found, we change it to its default before anything else happens. This also effectively runs at the first pass to set the defaults.
@@ -2469,6 +2913,7 @@ func (config *BackendConfig) BlankCheck() {
 		config.MinimumPoWStrengths.KeyUpdate == 0 ||
 		config.MinimumPoWStrengths.Truststate == 0 ||
 		config.MinimumPoWStrengths.TruststateUpdate == 0 ||
+		config.MinimumPoWStrengths.DirectMessage == 0 ||
 		config.MinimumPoWStrengths.ApiResponse == 0 {
 		// If PoW disabled, the default value is also zero, so this might run on every app boot. It's effectively no-op in that case.
 		config.SetMinimumPoWStrengths(defaultPowStrength)
@@ -2495,7 +2940,11 @@ func (config *BackendConfig) BlankCheck() {
 		config.EntityPageSizes.VoteManifests == 0 ||
 		config.EntityPageSizes.KeyManifests == 0 ||
 		config.EntityPageSizes.TruststateManifests == 0 ||
-		config.EntityPageSizes.AddressManifests == 0 {
+		config.EntityPageSizes.AddressManifests == 0 ||
+
+		config.EntityPageSizes.DirectMessages == 0 ||
+		config.EntityPageSizes.DirectMessageIndexes == 0 ||
+		config.EntityPageSizes.DirectMessageManifests == 0 {
 		config.setDefaultEntityPageSizes()
 	}
 	if config.PoWBailoutTimeSeconds == 0 {
@@ -2577,10 +3026,21 @@ func (config *BackendConfig) BlankCheck() {
 			}
 		}
 	}
+	dm0 := SubprotocolShim{Name: "dm0", VersionMajor: 1, VersionMinor: 0, SupportedEntities: []string{"directmessage"}}
 	if servingSubprotocolsNeedRegeneration {
 		c0 := SubprotocolShim{Name: "c0", VersionMajor: 1, VersionMinor: 0, SupportedEntities: []string{"board", "thread", "post", "vote", "key", "truststate"}}
 		// dweb := SubprotocolShim{Name: "dweb", VersionMajor: 1, VersionMinor: 0, SupportedEntities: []string{"page"}}
-		config.SetServingSubprotocols([]interface{}{c0})
+		config.SetServingSubprotocols([]interface{}{c0, dm0})
+	} else if !config.DirectMessagesOptInApplied && !config.servesSubprotocol(dm0.Name) {
+		// Configs from before direct messages only have c0. Relaying direct messages doesn't cost the node anything it can read, so we opt them in, like the new ones. Only once, though: if the user takes dm0 out after this, it stays out.
+		subprots := []interface{}{}
+		for _, val := range config.ServingSubprotocols {
+			subprots = append(subprots, val)
+		}
+		config.SetServingSubprotocols(append(subprots, dm0))
+	}
+	if !config.DirectMessagesOptInApplied {
+		config.SetDirectMessagesOptInApplied(true)
 	}
 	if len(config.UserDirectory) == 0 {
 		config.SetUserDirectory(cdir.New(Btc.OrgIdentifier, Btc.AppIdentifier).QueryFolders(cdir.Global)[0].Path)
@@ -2595,7 +3055,15 @@ func (config *BackendConfig) BlankCheck() {
 		config.SetDbIp(defaultDBIp)
 	}
 	if config.DbPort == 0 {
-		config.SetDbPort(defaultDbPort)
+		if config.DbEngine == "postgres" {
+			config.SetDbPort(defaultPostgresDbPort)
+		} else {
+			config.SetDbPort(defaultDbPort)
+		}
+	}
+	// The MySQL port is saved as the default on the first run (even on SQLite), so a config that was switched to postgres later would still carry it.
+	if config.DbEngine == "postgres" && config.DbPort == defaultDbPort {
+		config.SetDbPort(defaultPostgresDbPort)
 	}
 	if len(config.DbUsername) == 0 {
 		config.SetDbUsername(defaultDbUsername)
@@ -2603,6 +3071,12 @@ func (config *BackendConfig) BlankCheck() {
 	if len(config.DbPassword) == 0 {
 		config.SetDbPassword(defaultDbPassword)
 	}
+	if len(config.DbName) == 0 {
+		config.SetDbName(defaultDbName)
+	}
+	if len(config.DbSSLMode) == 0 {
+		config.SetDbSSLMode(defaultDbSSLMode)
+	}
 	// ::MetricsLevel: can be zero, no need to blank check.
 	// ::MetricsToken: can be zero, no need to blank check.
 	if len(config.BackendKeyPair) == 0 {
@@ -2665,6 +3139,20 @@ func (config *BackendConfig) BlankCheck() {
 	}
 	// ::AdminFrontendAddress: can be blank, no need to blank check.
 	// ::AdminFrontendPublicKey: can be blank, no need to blank check.
+	// ::AllowedFrontends: can be blank, no need to blank check.
+	if config.BackendAPIAccessTokenExpiry == 0 {
+		config.SetBackendAPIAccessTokenExpiry(defaultBackendAPIAccessTokenExpiry)
+	}
+	if config.DefaultFrontendRequestsPerMinute == 0 {
+		config.SetDefaultFrontendRequestsPerMinute(defaultFrontendRequestsPerMinute)
+	}
+	// ::MetricsExporterEnabled: can be false, no need to blank check.
+	if len(config.MetricsExporterAddress) == 0 {
+		config.SetMetricsExporterAddress(defaultBackendMetricsExporterAddress)
+	}
+	if len(config.TrustedCAKeys) == 0 {
+		config.SetTrustedCAKeys(ca.DefaultKeys())
+	}
 	if config.GRPCServiceTimeout == 0 {
 		config.SetGRPCServiceTimeout(defaultGRPCServiceTimeout)
 	}
@@ -2679,6 +3167,7 @@ func (config *BackendConfig) BlankCheck() {
 	}
 	// ::PrimaryBootstrap: can be false, no need to blank check.
 	// ::RenderNonconnectible: can be false, no need to blank check.
+	// ::DirectMessagesOptInApplied: set along with the ServingSubprotocols above.
 }
 
 // Resets
@@ -2715,6 +3204,7 @@ func (config *BackendConfig) SanityCheck() {
 		config.GetEntityPageSizes()
 		config.GetMinimumPoWStrengths()
 		config.GetPoWBailoutTimeSeconds()
+		config.GetPoWMintingThreads()
 		config.GetCacheGenerationIntervalHours()
 		config.GetCacheDurationHours()
 		config.GetClientVersionMajor()
@@ -2738,10 +3228,17 @@ func (config *BackendConfig) SanityCheck() {
 		config.GetLastStaticAddressConnectionTimestamp()
 		config.GetLastLiveAddressConnectionTimestamp()
 		config.GetServingSubprotocols()
+		config.GetServedRealms()
+		config.GetSubscribedBoards()
+		config.GetFollowedUsers()
+		config.GetSyncedBoards()
+		config.GetSyncedUsers()
 		config.GetDbEngine()
 		config.GetDbIp()
 		config.GetDbPort()
 		config.GetDbPassword()
+		config.GetDbName()
+		config.GetDbSSLMode()
 		config.GetMetricsLevel()
 		config.GetMetricsToken()
 		config.GetBackendKeyPair()
@@ -2766,6 +3263,11 @@ func (config *BackendConfig) SanityCheck() {
 		config.GetBackendAPIPublic()
 		config.GetAdminFrontendAddress()
 		config.GetAdminFrontendPublicKey()
+		config.GetAllowedFrontends()
+		config.GetBackendAPIAccessTokenExpiry()
+		config.GetDefaultFrontendRequestsPerMinute()
+		config.GetMetricsExporterAddress()
+		config.GetTrustedCAKeys()
 		config.GetGRPCServiceTimeout()
 		config.GetSQLiteDBLocation()
 		config.GetDeclineInboundReverseRequests()
@@ -2839,6 +3341,10 @@ func (config *BackendConfig) setDefaultEntityPageSizes() {
 	eps.KeyManifests = defaultKeyManifestsPageSize
 	eps.TruststateManifests = defaultTruststateManifestsPageSize
 	eps.AddressManifests = defaultAddressManifestsPageSize
+
+	eps.DirectMessages = defaultDirectMessagesPageSize
+	eps.DirectMessageIndexes = defaultDirectMessageIndexesPageSize
+	eps.DirectMessageManifests = defaultDirectMessageManifestsPageSize
 	config.SetEntityPageSizes(eps)
 }
 
@@ -2881,6 +3387,23 @@ This is the actual Key entity that the localUser owns (not just the PK, but also
 
 ## PoWBailoutTimeSeconds
 How long does it take before a PoW timestamp is marked unattainable by the local computer. This is to make sure that the app doesn't keep attempting forever for an unattainably strong PoW it attempted to generate.
+
+## PoWMintingThreads
+How many cores a PoW minting uses. 0 means all of them.
+
+# MetricsExporterEnabled
+# MetricsExporterAddress
+If enabled, the frontend serves its internals (refresh duration, and such) in the Prometheus text format at http://<MetricsExporterAddress>/metrics. Disabled by default. Same caveats as the backend exporter apply: there is no authentication, so keep it local unless you trust the network.
+
+# DefaultThreadRanking
+# PopularViewRanking
+The ranking algorithm that sorts the threads of a board that doesn't have one of its own (boards can pick one through the board signals), and the one that sorts the popular view. One of hot, new, top:day, top:week, top:month, top:year, top:all, controversial, wilson, rising. Both default to hot. An unknown ranking falls back to hot.
+
+# NotificationKeywords
+The words that raise a notification when they appear in a new post in a board you're subscribed to (and haven't silenced). Matched as whole words, case insensitive. Empty by default, up to 100 keywords.
+
+# TrustedCAKeys
+The CA keys this node trusts, each with a label, a priority (lower number is higher priority) and a validity window (ValidFrom, ValidUntil, unix timestamps, 0 means open). Defaults to the Aether CA. Keys can be added and retired with a CA-signed rotation statement, see the rotateca command, so there is no need to edit this by hand unless you want to trust a CA of your own.
 */
 
 // Frontend config base
@@ -2915,6 +3438,7 @@ type FrontendConfig struct {
 	DehydratedLocalUserKeyEntity            string
 	MinimumPoWStrengths                     MinimumPoWStrengths //
 	PoWBailoutTimeSeconds                   uint                // 30
+	PoWMintingThreads                       uint                // 0: all cores
 	OnboardComplete                         bool
 	SFWListDisabled                         bool
 	ModModeEnabled                          bool
@@ -2923,6 +3447,12 @@ type FrontendConfig struct {
 	LocalDevBackendDirectory                string
 	LastKnownClientVersion                  string
 	ExternalContentAutoloadDisabled         bool
+	MetricsExporterEnabled                  bool
+	MetricsExporterAddress                  string // Format: "127.0.0.1:65535"
+	TrustedCAKeys                           []ca.CAKey
+	DefaultThreadRanking                    string // hot
+	PopularViewRanking                      string // hot
+	NotificationKeywords                    []string
 }
 
 // Init check gate
@@ -2999,7 +3529,8 @@ func (config *FrontendConfig) GetFrontendKeyPair() *ed25519.PrivateKey {
 	config.InitCheck()
 	keyPair, err := signaturing.UnmarshalPrivateKey(config.FrontendKeyPair)
 	if err != nil {
-		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.GetFrontendKeyPair) + " Trace: " + toolbox.Trace() + "Error: " + err.Error()))
+		// The key pair is the private key, so it stays out of the log.
+		log.Fatal(errors.New(fmt.Sprintf("The frontend key pair in the config could not be read. Trace: %s Error: %s", toolbox.Trace(), err.Error())))
 	}
 	return &keyPair
 }
@@ -3022,7 +3553,7 @@ func (config *FrontendConfig) GetExternalIp() string {
 }
 func (config *FrontendConfig) GetExternalIpType() uint8 {
 	config.InitCheck()
-	if config.ExternalIpType == 6 || config.ExternalIpType == 4 || config.ExternalIpType == 3 { // 6: ipv6, 4: ipv4, 3: URL (useful in static nodes)
+	if config.ExternalIpType == 6 || config.ExternalIpType == 4 || config.ExternalIpType == 3 || config.ExternalIpType == 7 || config.ExternalIpType == 8 || config.ExternalIpType == 9 { // 6: ipv6, 4: ipv4, 3: URL (useful in static nodes), 7: unix socket, 8: in-memory, 9: simulated network
 		return config.ExternalIpType
 	} else {
 		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.ExternalIpType) + " Trace: " + toolbox.Trace()))
@@ -3250,6 +3781,7 @@ func (config *FrontendConfig) GetMinimumPoWStrengths() MinimumPoWStrengths {
 		config.MinimumPoWStrengths.KeyUpdate < maxPOWStrength &&
 		config.MinimumPoWStrengths.Truststate < maxPOWStrength &&
 		config.MinimumPoWStrengths.TruststateUpdate < maxPOWStrength &&
+		config.MinimumPoWStrengths.DirectMessage < maxPOWStrength &&
 		config.MinimumPoWStrengths.ApiResponse < maxPOWStrength {
 		return config.MinimumPoWStrengths
 	} else {
@@ -3259,6 +3791,17 @@ func (config *FrontendConfig) GetMinimumPoWStrengths() MinimumPoWStrengths {
 	return MinimumPoWStrengths{}
 }
 
+func (config *FrontendConfig) GetPoWMintingThreads() int {
+	config.InitCheck()
+	if config.PoWMintingThreads <= maxPoWMintingThreads {
+		return int(config.PoWMintingThreads)
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.PoWMintingThreads) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return 0
+}
+
 func (config *FrontendConfig) GetPoWBailoutTimeSeconds() int {
 	config.InitCheck()
 	if config.PoWBailoutTimeSeconds < maxPOWBailoutSeconds &&
@@ -3330,6 +3873,81 @@ func (config *FrontendConfig) GetExternalContentAutoloadDisabled() bool {
 	return config.ExternalContentAutoloadDisabled
 }
 
+func (config *FrontendConfig) GetMetricsExporterEnabled() bool {
+	config.InitCheck()
+	return config.MetricsExporterEnabled
+}
+
+func (config *FrontendConfig) GetMetricsExporterAddress() string {
+	config.InitCheck()
+	if len(config.MetricsExporterAddress) > 0 &&
+		len(config.MetricsExporterAddress) < maxLocationSize {
+		return config.MetricsExporterAddress
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.MetricsExporterAddress) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return ""
+}
+func (config *FrontendConfig) GetTrustedCAKeys() []ca.CAKey {
+	config.InitCheck()
+	if len(config.TrustedCAKeys) > 0 &&
+		ca.ValidateKeys(config.TrustedCAKeys) == nil {
+		return config.TrustedCAKeys
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.TrustedCAKeys) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return []ca.CAKey{}
+}
+
+func (config *FrontendConfig) GetDefaultThreadRanking() string {
+	config.InitCheck()
+	if len(config.DefaultThreadRanking) > 0 &&
+		len(config.DefaultThreadRanking) < maxRankingNameSize {
+		return config.DefaultThreadRanking
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.DefaultThreadRanking) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return ""
+}
+
+func (config *FrontendConfig) GetPopularViewRanking() string {
+	config.InitCheck()
+	if len(config.PopularViewRanking) > 0 &&
+		len(config.PopularViewRanking) < maxRankingNameSize {
+		return config.PopularViewRanking
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.PopularViewRanking) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return ""
+}
+
+func validNotificationKeywords(keywords []string) bool {
+	if len(keywords) > maxNotificationKeywords {
+		return false
+	}
+	for k, _ := range keywords {
+		if len(keywords[k]) == 0 || len(keywords[k]) > maxNotificationKeywordSize {
+			return false
+		}
+	}
+	return true
+}
+
+func (config *FrontendConfig) GetNotificationKeywords() []string {
+	config.InitCheck()
+	if validNotificationKeywords(config.NotificationKeywords) {
+		return config.NotificationKeywords
+	} else {
+		log.Fatal(invalidDataError(fmt.Sprintf("%#v", config.NotificationKeywords) + " Trace: " + toolbox.Trace()))
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return []string{}
+}
+
 /*****************************************************************************/
 
 // Setters
@@ -3454,7 +4072,7 @@ func (config *FrontendConfig) SetExternalIp(val string) error {
 
 func (config *FrontendConfig) SetExternalIpType(val int) error {
 	config.InitCheck()
-	if val == 6 || val == 4 || val == 3 {
+	if val == 6 || val == 4 || val == 3 || val == 7 || val == 8 || val == 9 {
 		config.ExternalIpType = uint8(val)
 		commitErr := config.Commit()
 		if commitErr != nil {
@@ -3768,6 +4386,7 @@ func (config *FrontendConfig) SetMinimumPoWStrengths(powStr int) error {
 		mps.KeyUpdate = powStr
 		mps.Truststate = powStr
 		mps.TruststateUpdate = powStr
+		mps.DirectMessage = powStr
 		mps.ApiResponse = powStr - 3
 		// ^ ApiResponse PoW strength is a little softer because remotes need it to make POST requests to each other. Temp value, subject to solidification or change in the future.
 		config.MinimumPoWStrengths = mps
@@ -3782,6 +4401,22 @@ func (config *FrontendConfig) SetMinimumPoWStrengths(powStr int) error {
 	return nil
 }
 
+func (config *FrontendConfig) SetPoWMintingThreads(val int) error {
+	config.InitCheck()
+	if val >= 0 && val <= maxPoWMintingThreads {
+		config.PoWMintingThreads = uint(val)
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
 func (config *FrontendConfig) SetPoWBailoutTimeSeconds(val int) error {
 	config.InitCheck()
 	if val > 0 {
@@ -3896,6 +4531,110 @@ func (config *FrontendConfig) SetExternalContentAutoloadDisabled(val bool) error
 	return nil
 }
 
+func (config *FrontendConfig) SetMetricsExporterEnabled(val bool) error {
+	config.InitCheck()
+	config.MetricsExporterEnabled = val
+	commitErr := config.Commit()
+	if commitErr != nil {
+		return commitErr
+	}
+	return nil
+}
+
+func (config *FrontendConfig) SetMetricsExporterAddress(val string) error {
+	config.InitCheck()
+	if len(val) > 0 && len(val) < maxLocationSize {
+		config.MetricsExporterAddress = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *FrontendConfig) SetDefaultThreadRanking(val string) error {
+	config.InitCheck()
+	if len(val) > 0 && len(val) < maxRankingNameSize {
+		config.DefaultThreadRanking = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *FrontendConfig) SetPopularViewRanking(val string) error {
+	config.InitCheck()
+	if len(val) > 0 && len(val) < maxRankingNameSize {
+		config.PopularViewRanking = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *FrontendConfig) SetNotificationKeywords(val []string) error {
+	config.InitCheck()
+	if validNotificationKeywords(val) {
+		config.NotificationKeywords = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+func (config *FrontendConfig) SetTrustedCAKeys(val []ca.CAKey) error {
+	config.InitCheck()
+	if len(val) > 0 && ca.ValidateKeys(val) == nil {
+		config.TrustedCAKeys = val
+		commitErr := config.Commit()
+		if commitErr != nil {
+			return commitErr
+		}
+		return nil
+	} else {
+		return invalidDataError(fmt.Sprintf("%#v", val) + " Trace: " + toolbox.Trace())
+	}
+	log.Fatal("This should never happen." + toolbox.Trace())
+	return nil
+}
+
+// ApplyCARotation applies a CA-signed rotation statement to the trusted CA keys, and saves the result.
+func (config *FrontendConfig) ApplyCARotation(stmt ca.RotationStatement) error {
+	keys, err := ca.ApplyRotation(config.GetTrustedCAKeys(), stmt)
+	if err != nil {
+		return err
+	}
+	err2 := config.SetTrustedCAKeys(keys)
+	if err2 != nil {
+		return err2
+	}
+	ca.SetTrustedKeys(keys)
+	return nil
+}
+
 /*****************************************************************************/
 
 // Frontend config methods
@@ -3995,6 +4734,7 @@ func (config *FrontendConfig) BlankCheck() {
 		config.MinimumPoWStrengths.KeyUpdate == 0 ||
 		config.MinimumPoWStrengths.Truststate == 0 ||
 		config.MinimumPoWStrengths.TruststateUpdate == 0 ||
+		config.MinimumPoWStrengths.DirectMessage == 0 ||
 		config.MinimumPoWStrengths.ApiResponse == 0 {
 		// If PoW disabled, the default value is also zero, so this might run on every app boot. It's effectively no-op in that case.
 		config.SetMinimumPoWStrengths(defaultPowStrength)
@@ -4014,6 +4754,20 @@ func (config *FrontendConfig) BlankCheck() {
 	}
 	// ::LastKnownClientVersion: can be false, no need to blank check.
 	// ::ExternalContentAutoloadDisabled: can be false, no need to blank check.
+	// ::MetricsExporterEnabled: can be false, no need to blank check.
+	if len(config.MetricsExporterAddress) == 0 {
+		config.SetMetricsExporterAddress(defaultFrontendMetricsExporterAddress)
+	}
+	if len(config.TrustedCAKeys) == 0 {
+		config.SetTrustedCAKeys(ca.DefaultKeys())
+	}
+	if len(config.DefaultThreadRanking) == 0 {
+		config.SetDefaultThreadRanking(defaultThreadRanking)
+	}
+	if len(config.PopularViewRanking) == 0 {
+		config.SetPopularViewRanking(defaultThreadRanking)
+	}
+	// ::NotificationKeywords: can be empty, no need to blank check.
 
 }
 func (config *FrontendConfig) SanityCheck() {
@@ -4041,8 +4795,14 @@ func (config *FrontendConfig) SanityCheck() {
 		config.GetMinimumVoteThresholdForElectionValidity()
 		config.GetMinimumPoWStrengths()
 		config.GetPoWBailoutTimeSeconds()
+		config.GetPoWMintingThreads()
 		config.GetKvStoreRetentionDays()
 		config.GetLocalDevBackendDirectory()
+		config.GetMetricsExporterAddress()
+		config.GetTrustedCAKeys()
+		config.GetDefaultThreadRanking()
+		config.GetPopularViewRanking()
+		config.GetNotificationKeywords()
 	}
 }
 
@@ -0,0 +1,109 @@
+package configstore
+
+// These test the blank checks that bring the configs from before a feature up to date with it, and the setters that check what they're given.
+
+import (
+	"testing"
+)
+
+// Infrastructure
+
+// readOnly keeps the setters from writing the configs to disk.
+func readOnly() func() {
+	prior := Btc.PermConfigReadOnly
+	Btc.PermConfigReadOnly = true
+	return func() { Btc.PermConfigReadOnly = prior }
+}
+
+// blankCheckedConfig runs the blank check on the given config without writing it to disk, as if the app started with it.
+func blankCheckedConfig(config *BackendConfig) *BackendConfig {
+	defer readOnly()()
+	config.BlankCheck()
+	return config
+}
+
+var c0Only = []interface{}{
+	SubprotocolShim{Name: "c0", VersionMajor: 1, VersionMinor: 0, SupportedEntities: []string{"board", "thread", "post", "vote", "key", "truststate"}},
+}
+
+// Tests
+
+func TestBlankCheck_DirectMessagesNewConfig_Success(t *testing.T) {
+	config := blankCheckedConfig(&BackendConfig{})
+	if !config.servesSubprotocol("c0") || !config.servesSubprotocol("dm0") {
+		t.Errorf("A new config should serve c0 and dm0. Serves: %#v", config.ServingSubprotocols)
+	}
+	if !config.DirectMessagesOptInApplied {
+		t.Errorf("A new config should count as opted into dm0 already.")
+	}
+}
+
+func TestBlankCheck_DirectMessagesOptIn_Success(t *testing.T) {
+	config := &BackendConfig{}
+	config.Initialised = true
+	config.SetServingSubprotocols(c0Only)
+	blankCheckedConfig(config)
+	if !config.servesSubprotocol("dm0") || !config.DirectMessagesOptInApplied {
+		t.Errorf("A config from before direct messages should be opted into dm0 once. Serves: %#v", config.ServingSubprotocols)
+	}
+	// The user takes dm0 out. The next start should leave it out.
+	config.SetServingSubprotocols(c0Only)
+	blankCheckedConfig(config)
+	if config.servesSubprotocol("dm0") {
+		t.Errorf("dm0 should not be added back after the user takes it out. Serves: %#v", config.ServingSubprotocols)
+	}
+	if !config.servesSubprotocol("c0") || len(config.ServingSubprotocols) != 1 {
+		t.Errorf("The rest of the subprotocols should be left as they are. Serves: %#v", config.ServingSubprotocols)
+	}
+}
+
+func TestBlankCheck_DbPortDefault_Success(t *testing.T) {
+	cases := []struct {
+		name     string
+		engine   string
+		port     uint16
+		expected uint16
+	}{
+		{"new sqlite config", "", 0, 3306},
+		{"new mysql config", "mysql", 0, 3306},
+		{"new postgres config", "postgres", 0, 5432},
+		{"config switched to postgres", "postgres", 3306, 5432},
+		{"postgres on a port of its own", "postgres", 6543, 6543},
+		{"mysql on a port of its own", "mysql", 3307, 3307},
+	}
+	for _, c := range cases {
+		config := blankCheckedConfig(&BackendConfig{DbEngine: c.engine, DbPort: c.port})
+		if config.DbPort != c.expected {
+			t.Errorf("Case: %v, Expected port: %v, Got: %v", c.name, c.expected, config.DbPort)
+		}
+	}
+}
+
+func TestSetExternalIpType_Success(t *testing.T) {
+	defer readOnly()()
+	// 9 is the simulated network, which the nodes in the dispatch tests are on.
+	for _, lt := range []int{3, 4, 6, 7, 8, 9} {
+		be := &BackendConfig{Initialised: true}
+		if err := be.SetExternalIpType(lt); err != nil || be.GetExternalIpType() != uint8(lt) {
+			t.Errorf("The backend should take this location type. Location type: %v, Error: %v", lt, err)
+		}
+		fe := &FrontendConfig{Initialised: true}
+		if err := fe.SetExternalIpType(lt); err != nil || fe.GetExternalIpType() != uint8(lt) {
+			t.Errorf("The frontend should take this location type. Location type: %v, Error: %v", lt, err)
+		}
+	}
+}
+
+func TestSetExternalIpType_Fail(t *testing.T) {
+	defer readOnly()()
+	for _, lt := range []int{0, 1, 5, 10, 255} {
+		be := &BackendConfig{Initialised: true, ExternalIpType: 4}
+		if err := be.SetExternalIpType(lt); err == nil || be.ExternalIpType != 4 {
+			t.Errorf("The backend should not take this location type. Location type: %v", lt)
+		}
+		fe := &FrontendConfig{Initialised: true, ExternalIpType: 4}
+		if err := fe.SetExternalIpType(lt); err == nil || fe.ExternalIpType != 4 {
+			t.Errorf("The frontend should not take this location type. Location type: %v", lt)
+		}
+	}
+}
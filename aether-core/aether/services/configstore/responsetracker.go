@@ -21,7 +21,6 @@ package configstore
 
 import (
 	"aether-core/aether/services/toolbox"
-	"fmt"
 	"path/filepath"
 	"sync"
 	"time"
@@ -211,6 +210,6 @@ func (r *POSTResponseRepo) Maintain() {
 }
 
 func (r *POSTResponseRepo) DeleteAllFromDisk() {
-	postDir := fmt.Sprintf("%s/", bc.GetProtURLVersion(), "/responses", bc.GetCachesDirectory())
+	postDir := filepath.Join(bc.GetCachesDirectory(), bc.GetProtURLVersion(), "responses")
 	toolbox.DeleteFromDisk(postDir)
 }
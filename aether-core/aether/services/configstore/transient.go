@@ -25,14 +25,15 @@ var Ftc FrontendTransientConfig
 // Default entity versions for this version of the app. This is not user adjustable.
 
 type entityVersions struct {
-	Board       int
-	Thread      int
-	Post        int
-	Vote        int
-	Key         int
-	Truststate  int
-	Address     int
-	ApiResponse int
+	Board         int
+	Thread        int
+	Post          int
+	Vote          int
+	Key           int
+	Truststate    int
+	DirectMessage int
+	Address       int
+	ApiResponse   int
 }
 
 /*
@@ -89,9 +90,6 @@ This is the channel to send the message to when you want to stop the cache gener
 ## AddressesScannerActive
 This is the mutex that gets activated when the address scanner is active, so that it cannot be triggered twice at the same time.
 
-## SyncActive
-There is currently an ongoing sync happening.
-
 ## CurrentMetricsPage
 This is the current metrics struct that we are building to send to the metrics server, if enabled.
 
@@ -161,7 +159,6 @@ type BackendTransientConfig struct {
 	StopCacheGenerationCycle   chan bool
 	StopBadlistRefreshCycle    chan bool
 	AddressesScannerActive     sync.Mutex
-	SyncActive                 sync.Mutex
 	CurrentMetricsPage         pb.Metrics
 	FingerprintCheckEnabled    bool
 	SignatureCheckEnabled      bool
@@ -208,14 +205,15 @@ func (config *BackendTransientConfig) SetDefaults() {
 	// config.TLSEnabled = false
 
 	ev := entityVersions{
-		Board:       defaultBoardEntityVersion,
-		Thread:      defaultThreadEntityVersion,
-		Post:        defaultPostEntityVersion,
-		Vote:        defaultVoteEntityVersion,
-		Key:         defaultKeyEntityVersion,
-		Truststate:  defaultTruststateEntityVersion,
-		Address:     defaultAddressEntityVersion,
-		ApiResponse: defaultApiResponseEntityVersion,
+		Board:         defaultBoardEntityVersion,
+		Thread:        defaultThreadEntityVersion,
+		Post:          defaultPostEntityVersion,
+		Vote:          defaultVoteEntityVersion,
+		Key:           defaultKeyEntityVersion,
+		Truststate:    defaultTruststateEntityVersion,
+		DirectMessage: defaultDirectMessageEntityVersion,
+		Address:       defaultAddressEntityVersion,
+		ApiResponse:   defaultApiResponseEntityVersion,
 	}
 	config.EntityVersions = ev
 }
@@ -250,6 +248,7 @@ type FrontendTransientConfig struct {
 	StopRefresherCycle          chan bool
 	StopSFWListUpdateCycle      chan bool
 	StopNotificationsPruneCycle chan bool
+	StopKvStoreMaintenanceCycle chan bool
 	BackendReady                bool
 	DefaultKeyType              string
 	EntityVersions              entityVersions
@@ -267,14 +266,15 @@ func (config *FrontendTransientConfig) SetDefaults() {
 	config.MinimumTrustedPoWStrength = defaultMinimumTrustedPoWStrength
 	config.DefaultKeyType = defaultKeyV1Type
 	ev := entityVersions{
-		Board:       defaultBoardEntityVersion,
-		Thread:      defaultThreadEntityVersion,
-		Post:        defaultPostEntityVersion,
-		Vote:        defaultVoteEntityVersion,
-		Key:         defaultKeyEntityVersion,
-		Truststate:  defaultTruststateEntityVersion,
-		Address:     defaultAddressEntityVersion,
-		ApiResponse: defaultApiResponseEntityVersion,
+		Board:         defaultBoardEntityVersion,
+		Thread:        defaultThreadEntityVersion,
+		Post:          defaultPostEntityVersion,
+		Vote:          defaultVoteEntityVersion,
+		Key:           defaultKeyEntityVersion,
+		Truststate:    defaultTruststateEntityVersion,
+		DirectMessage: defaultDirectMessageEntityVersion,
+		Address:       defaultAddressEntityVersion,
+		ApiResponse:   defaultApiResponseEntityVersion,
 	}
 	config.EntityVersions = ev
 }
@@ -5,16 +5,26 @@ package create
 
 import (
 	"aether-core/aether/io/api"
+	"aether-core/aether/services/encryption"
 	"aether-core/aether/services/globals"
+	"aether-core/aether/services/metaparse"
+	"aether-core/aether/services/proofofwork"
 	// "aether-core/aether/services/logging"
 	// "aether-core/aether/services/verify"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 )
 
+const (
+	// These go into the plaintext fields of encrypted entities, since those fields have minimum lengths. The real content is in EncrContent.
+	EncryptedThreadNamePlaceholder = "[encrypted]"
+	EncryptedPostBodyPlaceholder   = "[encrypted]"
+)
+
 // Bake is the function that handles the core signature / pow / fingerprint trio.
-func Bake(entity api.Provable) error {
+func Bake(entity api.Provable, mint ...*proofofwork.MintControl) error {
 	// 1) Signature
 	// 2) PoW
 	// 3) Fingerprint
@@ -27,17 +37,20 @@ func Bake(entity api.Provable) error {
 	err2 := *new(error)
 	switch ent := entity.(type) {
 	case *api.Board:
-		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Board)
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Board, mint...)
 	case *api.Thread:
-		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Thread)
+		// The board can require a stronger PoW than the node configs, so this reads what's needed before minting.
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), api.ThreadPoWStrength(ent.Board, "", ent.Creation, false), mint...)
 	case *api.Post:
-		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Post)
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), api.PostPoWStrength(ent.Board, "", ent.Creation, false), mint...)
 	case *api.Vote:
-		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Vote)
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Vote, mint...)
 	case *api.Key:
-		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Key)
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Key, mint...)
 	case *api.Truststate:
-		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Truststate)
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().Truststate, mint...)
+	case *api.DirectMessage:
+		err2 = ent.CreatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().DirectMessage, mint...)
 	}
 	if err2 != nil {
 		return errors.New(fmt.Sprintf(
@@ -49,7 +62,7 @@ func Bake(entity api.Provable) error {
 
 // Rebake saves the updates to the entity and updates the signature and pow accordingly based on given fields.
 
-func Rebake(entity api.Updateable) error {
+func Rebake(entity api.Updateable, mint ...*proofofwork.MintControl) error {
 	err := entity.CreateUpdateSignature(globals.FrontendConfig.GetUserKeyPair())
 	if err != nil {
 		return errors.New(fmt.Sprintf(
@@ -58,17 +71,18 @@ func Rebake(entity api.Updateable) error {
 	err2 := *new(error)
 	switch ent := entity.(type) {
 	case *api.Board:
-		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().BoardUpdate)
+		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().BoardUpdate, mint...)
 	case *api.Thread:
-		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().ThreadUpdate)
+		// Same as in Bake, the board's requirement applies.
+		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), api.ThreadPoWStrength(ent.Board, ent.Fingerprint, ent.LastUpdate, true), mint...)
 	case *api.Post:
-		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().PostUpdate)
+		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), api.PostPoWStrength(ent.Board, ent.Fingerprint, ent.LastUpdate, true), mint...)
 	case *api.Vote:
-		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().VoteUpdate)
+		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().VoteUpdate, mint...)
 	case *api.Key:
-		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().KeyUpdate)
+		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().KeyUpdate, mint...)
 	case *api.Truststate:
-		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().TruststateUpdate)
+		err2 = ent.CreateUpdatePoW(globals.FrontendConfig.GetUserKeyPair(), globals.FrontendConfig.GetMinimumPoWStrengths().TruststateUpdate, mint...)
 	}
 	if err2 != nil {
 		return errors.New(fmt.Sprintf(
@@ -102,6 +116,7 @@ func CreateBoard(
 	description string,
 	meta string,
 	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
 ) (api.Board, error) {
 
 	var entity api.Board
@@ -114,7 +129,7 @@ func CreateBoard(
 	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Board
 	entity.Meta = meta
 	entity.RealmId = realmId
-	err := Bake(&entity)
+	err := Bake(&entity, mint...)
 	if err != nil {
 		var blankEntity api.Board
 		return blankEntity, err
@@ -131,6 +146,7 @@ func CreateThread(
 	ownerPk string,
 	meta string,
 	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
 ) (api.Thread, error) {
 
 	var entity api.Thread
@@ -144,7 +160,7 @@ func CreateThread(
 	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Thread
 	entity.Meta = meta
 	entity.RealmId = realmId
-	err := Bake(&entity)
+	err := Bake(&entity, mint...)
 	if err != nil {
 		var blankEntity api.Thread
 		return blankEntity, err
@@ -161,6 +177,7 @@ func CreatePost(
 	ownerPk string,
 	meta string,
 	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
 ) (api.Post, error) {
 
 	var entity api.Post
@@ -174,7 +191,7 @@ func CreatePost(
 	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Post
 	entity.Meta = meta
 	entity.RealmId = realmId
-	err := Bake(&entity)
+	err := Bake(&entity, mint...)
 	if err != nil {
 		var blankEntity api.Post
 		return blankEntity, err
@@ -182,6 +199,183 @@ func CreatePost(
 	return entity, nil
 }
 
+/*----------  Encrypted boards  ----------*/
+
+func readBoardMeta(meta string) (*metaparse.BoardMeta, error) {
+	bm := &metaparse.BoardMeta{}
+	m, err := metaparse.ReadMeta("Board", meta)
+	if err != nil {
+		return bm, errors.New(fmt.Sprintf("The board meta could not be parsed. Error: %v, Meta: %s", err, meta))
+	}
+	if m != nil {
+		bm = m.(*metaparse.BoardMeta)
+	}
+	return bm, nil
+}
+
+// IssueBoardKey mints a new board key for the local user's board and wraps it for the local user and the given member public keys. It returns the new meta for the board, which the caller saves with UpdateBoard (or passes to CreateBoard). Calling this on a board that is already encrypted rotates its key.
+func IssueBoardKey(boardMeta string, memberPks []string) (string, error) {
+	bm, err := readBoardMeta(boardMeta)
+	if err != nil {
+		return "", err
+	}
+	_, err2 := encryption.IssueBoardKey(bm, *globals.FrontendConfig.GetUserKeyPair(), memberPks)
+	if err2 != nil {
+		return "", err2
+	}
+	return metaparse.CreateMetaString(bm)
+}
+
+// GrantBoardKey adds new members to the local user's encrypted board. Same as above, the returned meta needs to be saved with UpdateBoard.
+func GrantBoardKey(boardMeta string, memberPks []string) (string, error) {
+	bm, err := readBoardMeta(boardMeta)
+	if err != nil {
+		return "", err
+	}
+	err2 := encryption.GrantBoardKey(bm, *globals.FrontendConfig.GetUserKeyPair(), memberPks)
+	if err2 != nil {
+		return "", err2
+	}
+	return metaparse.CreateMetaString(bm)
+}
+
+// SetBoardMinimumPoW sets the minimum PoW strengths that the local user's board requires from its threads and posts. They apply to the content created from now on. Same as above, the returned meta needs to be saved with UpdateBoard. A nil requirement removes the board's own requirements, and leaves only the node configs.
+func SetBoardMinimumPoW(boardMeta string, pow *metaparse.BoardPoW) (string, error) {
+	bm, err := readBoardMeta(boardMeta)
+	if err != nil {
+		return "", err
+	}
+	if pow != nil {
+		for _, strength := range []int{pow.Thread, pow.ThreadUpdate, pow.Post, pow.PostUpdate} {
+			if strength < 0 || strength > api.MAX_BOARD_POW_V1 {
+				return "", errors.New(fmt.Sprintf("A board can't require a PoW strength out of bounds. Strength: %v, Max: %v", strength, api.MAX_BOARD_POW_V1))
+			}
+		}
+		p := *pow
+		p.Since = time.Now().Unix()
+		pow = &p
+	}
+	bm.MinimumPoW = pow
+	return metaparse.CreateMetaString(bm)
+}
+
+// IsEncryptedBoard tells whether threads and posts in this board need to be created with their encrypted counterparts.
+func IsEncryptedBoard(board *api.Board) bool {
+	bm, err := readBoardMeta(board.Meta)
+	if err != nil {
+		return false
+	}
+	return encryption.IsEncryptedBoard(bm)
+}
+
+// sealForBoard seals the payload with the key the local user holds for the board. An empty key id picks the newest key.
+func sealForBoard(board *api.Board, keyId string, payload interface{}) (string, error) {
+	bm, err := readBoardMeta(board.Meta)
+	if err != nil {
+		return "", err
+	}
+	key, err2 := encryption.FindBoardKey(bm, keyId, board.OwnerPublicKey, *globals.FrontendConfig.GetUserKeyPair())
+	if err2 != nil {
+		return "", err2
+	}
+	plain, err3 := json.Marshal(payload)
+	if err3 != nil {
+		return "", err3
+	}
+	return encryption.Seal(plain, key)
+}
+
+// openForBoard is the reverse of sealForBoard.
+func openForBoard(board *api.Board, sealed string, payload interface{}) error {
+	bm, err := readBoardMeta(board.Meta)
+	if err != nil {
+		return err
+	}
+	keyId, err2 := encryption.SealedKeyId(sealed)
+	if err2 != nil {
+		return err2
+	}
+	key, err3 := encryption.FindBoardKey(bm, keyId, board.OwnerPublicKey, *globals.FrontendConfig.GetUserKeyPair())
+	if err3 != nil {
+		return err3
+	}
+	plain, err4 := encryption.Open(sealed, key)
+	if err4 != nil {
+		return err4
+	}
+	return json.Unmarshal(plain, payload)
+}
+
+func CreateEncryptedThread(
+	board *api.Board,
+	name string,
+	body string,
+	link string,
+	ownerFp api.Fingerprint,
+	ownerPk string,
+	meta string,
+	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
+) (api.Thread, error) {
+
+	var entity api.Thread
+	encr, err := sealForBoard(board, "", encryption.ThreadContent{Name: name, Body: body, Link: link})
+	if err != nil {
+		return entity, errors.New(fmt.Sprintf("Thread content could not be encrypted. Error: %v", err))
+	}
+	entity.Creation = api.Timestamp(time.Now().Unix())
+	entity.Board = board.Fingerprint
+	entity.Name = EncryptedThreadNamePlaceholder
+	entity.Owner = ownerFp
+	entity.OwnerPublicKey = ownerPk
+	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Thread
+	entity.Meta = meta
+	entity.RealmId = realmId
+	entity.EncrContent = encr
+	err2 := Bake(&entity, mint...)
+	if err2 != nil {
+		var blankEntity api.Thread
+		return blankEntity, err2
+	}
+	return entity, nil
+}
+
+func CreateEncryptedPost(
+	board *api.Board,
+	threadFp api.Fingerprint,
+	parentFp api.Fingerprint,
+	body string,
+	ownerFp api.Fingerprint,
+	ownerPk string,
+	meta string,
+	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
+) (api.Post, error) {
+
+	var entity api.Post
+	encr, err := sealForBoard(board, "", encryption.PostContent{Body: body})
+	if err != nil {
+		return entity, errors.New(fmt.Sprintf("Post content could not be encrypted. Error: %v", err))
+	}
+	entity.Creation = api.Timestamp(time.Now().Unix())
+	entity.Board = board.Fingerprint
+	entity.Thread = threadFp
+	entity.Parent = parentFp
+	entity.Body = EncryptedPostBodyPlaceholder
+	entity.Owner = ownerFp
+	entity.OwnerPublicKey = ownerPk
+	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Post
+	entity.Meta = meta
+	entity.RealmId = realmId
+	entity.EncrContent = encr
+	err2 := Bake(&entity, mint...)
+	if err2 != nil {
+		var blankEntity api.Post
+		return blankEntity, err2
+	}
+	return entity, nil
+}
+
 func CreateVote(
 	boardFp api.Fingerprint,
 	threadFp api.Fingerprint,
@@ -192,6 +386,7 @@ func CreateVote(
 	voteType int,
 	meta string,
 	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
 ) (api.Vote, error) {
 
 	var entity api.Vote
@@ -206,7 +401,7 @@ func CreateVote(
 	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Vote
 	entity.Meta = meta
 	entity.RealmId = realmId
-	err := Bake(&entity)
+	err := Bake(&entity, mint...)
 	if err != nil {
 		var blankEntity api.Vote
 		return blankEntity, err
@@ -268,6 +463,7 @@ func CreateKey(
 	expiry api.Timestamp,
 	meta string,
 	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
 ) (api.Key, error) {
 
 	var entity api.Key
@@ -280,7 +476,7 @@ func CreateKey(
 	entity.Expiry = expiry
 	entity.Meta = meta
 	entity.RealmId = realmId // todo expiry
-	err := Bake(&entity)
+	err := Bake(&entity, mint...)
 	if err != nil {
 		var blankEntity api.Key
 		return blankEntity, err
@@ -298,6 +494,7 @@ func CreateTruststate(
 	expiry api.Timestamp,
 	meta string,
 	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
 ) (api.Truststate, error) {
 
 	var entity api.Truststate
@@ -312,7 +509,7 @@ func CreateTruststate(
 	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.Truststate
 	entity.Meta = meta
 	entity.RealmId = realmId
-	err := Bake(&entity)
+	err := Bake(&entity, mint...)
 	if err != nil {
 		var blankEntity api.Truststate
 		return blankEntity, err
@@ -320,6 +517,39 @@ func CreateTruststate(
 	return entity, nil
 }
 
+// CreateDirectMessage seals the plaintext to the recipient's key and bakes it. The recipient public key is the one in the recipient's key entity. Direct messages can't be updated, so there is no update counterpart to this.
+func CreateDirectMessage(
+	recipientFp api.Fingerprint,
+	recipientPk string,
+	plaintext string,
+	ownerFp api.Fingerprint,
+	ownerPk string,
+	meta string,
+	realmId api.Fingerprint,
+	mint ...*proofofwork.MintControl,
+) (api.DirectMessage, error) {
+
+	var entity api.DirectMessage
+	body, err := encryption.SealDirectMessage([]byte(plaintext), *globals.FrontendConfig.GetUserKeyPair(), recipientPk)
+	if err != nil {
+		return entity, errors.New(fmt.Sprintf("The direct message could not be sealed. Error: %v", err))
+	}
+	entity.Creation = api.Timestamp(time.Now().Unix())
+	entity.Recipient = recipientFp
+	entity.Body = body
+	entity.Owner = ownerFp
+	entity.OwnerPublicKey = ownerPk
+	entity.EntityVersion = globals.FrontendTransientConfig.EntityVersions.DirectMessage
+	entity.Meta = meta
+	entity.RealmId = realmId
+	err2 := Bake(&entity, mint...)
+	if err2 != nil {
+		var blankEntity api.DirectMessage
+		return blankEntity, err2
+	}
+	return entity, nil
+}
+
 // The functions below cannot be methods on the api types because they are defined in the api package, not here. If I try to extend that here, I get an error. If I try to import the create from api, it won't compile because of circular imports.
 
 type BoardUpdateRequest struct {
@@ -328,6 +558,9 @@ type BoardUpdateRequest struct {
 	NewBoardOwners     []api.BoardOwner
 	DescriptionUpdated bool
 	NewDescription     string
+	MetaUpdated        bool
+	NewMeta            string                   // Issuing or granting board keys goes through here.
+	Mint               *proofofwork.MintControl // Optional, to watch and cancel the PoW minting.
 }
 
 func UpdateBoard(request BoardUpdateRequest) error {
@@ -337,8 +570,11 @@ func UpdateBoard(request BoardUpdateRequest) error {
 	if request.DescriptionUpdated {
 		request.Entity.Description = request.NewDescription
 	}
+	if request.MetaUpdated {
+		request.Entity.Meta = request.NewMeta
+	}
 	request.Entity.LastUpdate = api.Timestamp(time.Now().Unix())
-	err := Rebake(request.Entity)
+	err := Rebake(request.Entity, request.Mint)
 	if err != nil {
 		return err
 	}
@@ -349,14 +585,32 @@ type ThreadUpdateRequest struct {
 	Entity      *api.Thread
 	BodyUpdated bool
 	NewBody     string
+	Board       *api.Board               // Only needed if the thread is encrypted.
+	Mint        *proofofwork.MintControl // Optional, to watch and cancel the PoW minting.
 }
 
 func UpdateThread(request ThreadUpdateRequest) error {
-	if request.BodyUpdated {
+	if request.BodyUpdated && len(request.Entity.EncrContent) > 0 {
+		if request.Board == nil {
+			return errors.New("This thread is encrypted, but the update request does not have the board to re-encrypt it with.")
+		}
+		tc := encryption.ThreadContent{}
+		err := openForBoard(request.Board, request.Entity.EncrContent, &tc)
+		if err != nil {
+			return errors.New(fmt.Sprintf("This encrypted thread could not be decrypted for the update. Error: %v", err))
+		}
+		tc.Body = request.NewBody
+		// Re-seal with the newest key, in case it was rotated since.
+		encr, err2 := sealForBoard(request.Board, "", tc)
+		if err2 != nil {
+			return errors.New(fmt.Sprintf("This encrypted thread could not be re-encrypted for the update. Error: %v", err2))
+		}
+		request.Entity.EncrContent = encr
+	} else if request.BodyUpdated {
 		request.Entity.Body = request.NewBody
 	}
 	request.Entity.LastUpdate = api.Timestamp(time.Now().Unix())
-	err := Rebake(request.Entity)
+	err := Rebake(request.Entity, request.Mint)
 	if err != nil {
 		return err
 	}
@@ -367,14 +621,25 @@ type PostUpdateRequest struct {
 	Entity      *api.Post
 	BodyUpdated bool
 	NewBody     string
+	Board       *api.Board               // Only needed if the post is encrypted.
+	Mint        *proofofwork.MintControl // Optional, to watch and cancel the PoW minting.
 }
 
 func UpdatePost(request PostUpdateRequest) error {
-	if request.BodyUpdated {
+	if request.BodyUpdated && len(request.Entity.EncrContent) > 0 {
+		if request.Board == nil {
+			return errors.New("This post is encrypted, but the update request does not have the board to re-encrypt it with.")
+		}
+		encr, err := sealForBoard(request.Board, "", encryption.PostContent{Body: request.NewBody})
+		if err != nil {
+			return errors.New(fmt.Sprintf("This encrypted post could not be re-encrypted for the update. Error: %v", err))
+		}
+		request.Entity.EncrContent = encr
+	} else if request.BodyUpdated {
 		request.Entity.Body = request.NewBody
 	}
 	request.Entity.LastUpdate = api.Timestamp(time.Now().Unix())
-	err := Rebake(request.Entity)
+	err := Rebake(request.Entity, request.Mint)
 	if err != nil {
 		return err
 	}
@@ -385,6 +650,7 @@ type VoteUpdateRequest struct {
 	Entity      *api.Vote
 	TypeUpdated bool
 	NewType     int
+	Mint        *proofofwork.MintControl // Optional, to watch and cancel the PoW minting.
 }
 
 func UpdateVote(request VoteUpdateRequest) error {
@@ -392,7 +658,7 @@ func UpdateVote(request VoteUpdateRequest) error {
 		request.Entity.Type = request.NewType
 	}
 	request.Entity.LastUpdate = api.Timestamp(time.Now().Unix())
-	err := Rebake(request.Entity)
+	err := Rebake(request.Entity, request.Mint)
 	if err != nil {
 		return err
 	}
@@ -405,6 +671,7 @@ type KeyUpdateRequest struct {
 	NewInfo       string
 	ExpiryUpdated bool
 	NewExpiry     api.Timestamp
+	Mint          *proofofwork.MintControl // Optional, to watch and cancel the PoW minting.
 }
 
 func UpdateKey(request KeyUpdateRequest) error {
@@ -415,7 +682,7 @@ func UpdateKey(request KeyUpdateRequest) error {
 		request.Entity.Expiry = request.NewExpiry
 	}
 	request.Entity.LastUpdate = api.Timestamp(time.Now().Unix())
-	err := Rebake(request.Entity)
+	err := Rebake(request.Entity, request.Mint)
 	if err != nil {
 		return err
 	}
@@ -428,6 +695,7 @@ type TruststateUpdateRequest struct {
 	NewType       int
 	ExpiryUpdated bool
 	NewExpiry     api.Timestamp
+	Mint          *proofofwork.MintControl // Optional, to watch and cancel the PoW minting.
 }
 
 func UpdateTruststate(request TruststateUpdateRequest) error {
@@ -438,7 +706,7 @@ func UpdateTruststate(request TruststateUpdateRequest) error {
 		request.Entity.Expiry = request.NewExpiry
 	}
 	request.Entity.LastUpdate = api.Timestamp(time.Now().Unix())
-	err := Rebake(request.Entity)
+	err := Rebake(request.Entity, request.Mint)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+// Services > Encryption > Board Keys
+// This file manages the keyrings of encrypted boards that are kept in the board's Meta.
+
+package encryption
+
+import (
+	"aether-core/aether/services/metaparse"
+	"aether-core/aether/services/signaturing"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+)
+
+// IsEncryptedBoard checks whether a board meta carries a keyring.
+func IsEncryptedBoard(bm *metaparse.BoardMeta) bool {
+	return bm != nil && len(bm.Keyrings) > 0
+}
+
+// IssueBoardKey mints a new board key, wraps it for the owner and all given members, and puts it in front of the keyrings. This is both how a board becomes encrypted and how its key is rotated.
+func IssueBoardKey(bm *metaparse.BoardMeta, ownerPrivKey ed25519.PrivateKey, memberPubKeys []string) (*BoardKey, error) {
+	key, err := NewBoardKey()
+	if err != nil {
+		return nil, err
+	}
+	kr := metaparse.BoardKeyring{KeyId: key.KeyId(), Keys: make(map[string]string)}
+	ownerPk := signaturing.MarshalPublicKey(ownerPrivKey.Public().(ed25519.PublicKey))
+	err2 := wrapInto(&kr, key, ownerPrivKey, append([]string{ownerPk}, memberPubKeys...))
+	if err2 != nil {
+		return nil, err2
+	}
+	bm.Keyrings = append([]metaparse.BoardKeyring{kr}, bm.Keyrings...)
+	return key, nil
+}
+
+// GrantBoardKey wraps every board key generation the owner can open for the given new members, so that they can read the board's history as well.
+func GrantBoardKey(bm *metaparse.BoardMeta, ownerPrivKey ed25519.PrivateKey, memberPubKeys []string) error {
+	if !IsEncryptedBoard(bm) {
+		return errors.New("This board is not encrypted, there is no key to grant.")
+	}
+	ownerPub := ownerPrivKey.Public().(ed25519.PublicKey)
+	ownerPk := signaturing.MarshalPublicKey(ownerPub)
+	for k, _ := range bm.Keyrings {
+		wrapped, ok := bm.Keyrings[k].Keys[ownerPk]
+		if !ok {
+			continue
+		}
+		key, err := UnwrapKey(wrapped, ownerPub, ownerPrivKey)
+		if err != nil {
+			return errors.New(fmt.Sprintf("The owner's own copy of the board key could not be unwrapped. KeyId: %s, Error: %v", bm.Keyrings[k].KeyId, err))
+		}
+		err2 := wrapInto(&bm.Keyrings[k], key, ownerPrivKey, memberPubKeys)
+		if err2 != nil {
+			return err2
+		}
+	}
+	return nil
+}
+
+// FindBoardKey unwraps the board key with the given id for the given member. An empty key id means the newest key, which is what new content should be sealed with.
+func FindBoardKey(bm *metaparse.BoardMeta, keyId string, boardOwnerPk string, memberPrivKey ed25519.PrivateKey) (*BoardKey, error) {
+	if !IsEncryptedBoard(bm) {
+		return nil, errors.New("This board is not encrypted.")
+	}
+	ownerPub, err := signaturing.UnmarshalPublicKey(boardOwnerPk)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("The board owner's public key could not be read. Error: %v", err))
+	}
+	memberPk := signaturing.MarshalPublicKey(memberPrivKey.Public().(ed25519.PublicKey))
+	for k, _ := range bm.Keyrings {
+		if len(keyId) > 0 && bm.Keyrings[k].KeyId != keyId {
+			continue
+		}
+		wrapped, ok := bm.Keyrings[k].Keys[memberPk]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("This member does not hold this board key. KeyId: %s", bm.Keyrings[k].KeyId))
+		}
+		return UnwrapKey(wrapped, ownerPub, memberPrivKey)
+	}
+	return nil, errors.New(fmt.Sprintf("This board has no key with the requested id. KeyId: %s", keyId))
+}
+
+func wrapInto(kr *metaparse.BoardKeyring, key *BoardKey, ownerPrivKey ed25519.PrivateKey, memberPubKeys []string) error {
+	for _, pk := range memberPubKeys {
+		pub, err := signaturing.UnmarshalPublicKey(pk)
+		if err != nil {
+			return errors.New(fmt.Sprintf("This member public key could not be read. Key: %s, Error: %v", pk, err))
+		}
+		wrapped, err2 := WrapKey(key, ownerPrivKey, pub)
+		if err2 != nil {
+			return err2
+		}
+		kr.Keys[pk] = wrapped
+	}
+	return nil
+}
+
+/*----------  Sealed payloads  ----------*/
+
+// ThreadContent is what an encrypted thread seals into its EncrContent. The plaintext fields of the thread carry placeholders.
+type ThreadContent struct {
+	Name string `json:"name"`
+	Body string `json:"body"`
+	Link string `json:"link"`
+}
+
+// PostContent is what an encrypted post seals into its EncrContent.
+type PostContent struct {
+	Body string `json:"body"`
+}
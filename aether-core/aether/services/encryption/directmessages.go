@@ -0,0 +1,77 @@
+// Services > Encryption > Direct Messages
+// This file seals and opens the bodies of direct messages.
+
+package encryption
+
+import (
+	"aether-core/aether/services/signaturing"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/nacl/box"
+	"strings"
+)
+
+/*
+A direct message body is a nacl box from the sender's key to the recipient's key, both converted to X25519 like the board key wrapping above. Box is symmetric in the sense that the two ends arrive at the same shared key, so the recipient opens it with the sender's public key, and the sender can open its own sent messages with the recipient's public key. Nobody else can, including the nodes that relay it.
+*/
+
+// DirectMessagePrefix marks the version of the sealed direct message format. The base64 of nonce + ciphertext follows.
+const DirectMessagePrefix = "dm1"
+
+// SealDirectMessage encrypts the plaintext from the sender to the recipient. The recipient public key is in its marshaled (hex) form, as it is in the key entities.
+func SealDirectMessage(plaintext []byte, senderPrivKey ed25519.PrivateKey, recipientPk string) (string, error) {
+	recipientPub, err := signaturing.UnmarshalPublicKey(recipientPk)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("The recipient's public key could not be read. Error: %v", err))
+	}
+	recipient, err := PublicKeyToCurve25519(recipientPub)
+	if err != nil {
+		return "", err
+	}
+	sender, err := PrivateKeyToCurve25519(senderPrivKey)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return "", err
+	}
+	sealed := box.Seal(nonce[:], plaintext, nonce, recipient, sender)
+	return fmt.Sprint(DirectMessagePrefix, ":", base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+// OpenDirectMessage decrypts a direct message body. The peer is the other end of the conversation: the sender if we're the recipient, the recipient if we're the sender.
+func OpenDirectMessage(sealed string, peerPk string, ownPrivKey ed25519.PrivateKey) ([]byte, error) {
+	parts := strings.SplitN(sealed, ":", 2)
+	if len(parts) != 2 || parts[0] != DirectMessagePrefix {
+		return nil, errors.New(fmt.Sprintf("This direct message is in a format we don't understand. Content: %s", sealed))
+	}
+	payload, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("This direct message is not valid base64. Error: %v", err))
+	}
+	if len(payload) < nonceSize+box.Overhead {
+		return nil, errors.New("This direct message is too short to be valid.")
+	}
+	peerPub, err := signaturing.UnmarshalPublicKey(peerPk)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("The peer's public key could not be read. Error: %v", err))
+	}
+	peer, err := PublicKeyToCurve25519(peerPub)
+	if err != nil {
+		return nil, err
+	}
+	own, err := PrivateKeyToCurve25519(ownPrivKey)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], payload[:nonceSize])
+	plaintext, ok := box.Open(nil, payload[nonceSize:], &nonce, peer, own)
+	if !ok {
+		return nil, errors.New("This direct message could not be opened with the given keys.")
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,209 @@
+// Services > Encryption
+// This module handles the symmetric board keys of encrypted boards, wrapping those keys for board members, and sealing / opening the content that goes into EncrContent.
+
+package encryption
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
+	"math/big"
+	"strings"
+)
+
+/*
+How this works:
+
+The board owner mints a random 32 byte board key. That key is wrapped (nacl box) for every member from the owner's ed25519 key to the member's ed25519 key, both converted to their X25519 counterparts. The wrapped keys live in the board's Meta, which is mutable, so the owner can add members or rotate keys with a regular board update.
+
+Members seal the bodies of their threads and posts with the board key (nacl secretbox) into EncrContent. The ciphertext is part of the signed and PoW'd payload like any other field, so relaying nodes that can't read it can still verify the entity.
+*/
+
+const (
+	// SealedContentPrefix marks the version of the sealed content format. The key id follows, then the base64 of nonce + ciphertext.
+	SealedContentPrefix = "sb1"
+	keySize             = 32
+	nonceSize           = 24
+)
+
+type BoardKey [keySize]byte
+
+// KeyId is a short, public identifier of the key, so that we know which key in the keyring opens a given piece of content without having to try all of them.
+func (k *BoardKey) KeyId() string {
+	sum := sha256.Sum256(k[:])
+	return hex.EncodeToString(sum[:8])
+}
+
+func NewBoardKey() (*BoardKey, error) {
+	var k BoardKey
+	_, err := rand.Read(k[:])
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Board key generation failed. Error: %v", err))
+	}
+	return &k, nil
+}
+
+func newNonce() (*[nonceSize]byte, error) {
+	var n [nonceSize]byte
+	_, err := rand.Read(n[:])
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Nonce generation failed. Error: %v", err))
+	}
+	return &n, nil
+}
+
+/*----------  Content  ----------*/
+
+// Seal encrypts the plaintext with the board key into the string form that goes into EncrContent.
+func Seal(plaintext []byte, key *BoardKey) (string, error) {
+	nonce, err := newNonce()
+	if err != nil {
+		return "", err
+	}
+	sealed := secretbox.Seal(nonce[:], plaintext, nonce, (*[keySize]byte)(key))
+	return fmt.Sprint(SealedContentPrefix, ":", key.KeyId(), ":", base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+// SealedKeyId returns the id of the key that the sealed content needs to be opened with.
+func SealedKeyId(sealed string) (string, error) {
+	keyId, _, err := splitSealed(sealed)
+	return keyId, err
+}
+
+// Open decrypts the content sealed by Seal.
+func Open(sealed string, key *BoardKey) ([]byte, error) {
+	keyId, payload, err := splitSealed(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if keyId != key.KeyId() {
+		return nil, errors.New(fmt.Sprintf("This content is sealed with a different key. Sealed with: %s, Given: %s", keyId, key.KeyId()))
+	}
+	if len(payload) < nonceSize+secretbox.Overhead {
+		return nil, errors.New("This sealed content is too short to be valid.")
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], payload[:nonceSize])
+	plaintext, ok := secretbox.Open(nil, payload[nonceSize:], &nonce, (*[keySize]byte)(key))
+	if !ok {
+		return nil, errors.New("This sealed content could not be opened with the given key.")
+	}
+	return plaintext, nil
+}
+
+func splitSealed(sealed string) (string, []byte, error) {
+	parts := strings.SplitN(sealed, ":", 3)
+	if len(parts) != 3 || parts[0] != SealedContentPrefix {
+		return "", nil, errors.New(fmt.Sprintf("This sealed content is in a format we don't understand. Content: %s", sealed))
+	}
+	payload, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, errors.New(fmt.Sprintf("This sealed content is not valid base64. Error: %v", err))
+	}
+	return parts[1], payload, nil
+}
+
+/*----------  Key wrapping  ----------*/
+
+// WrapKey wraps the board key so that only the holder of the recipient's private key can unwrap it. The recipient needs the sender's public key to do so, which for boards is the owner public key of the board.
+func WrapKey(key *BoardKey, senderPrivKey ed25519.PrivateKey, recipientPubKey ed25519.PublicKey) (string, error) {
+	recipient, err := PublicKeyToCurve25519(recipientPubKey)
+	if err != nil {
+		return "", err
+	}
+	sender, err := PrivateKeyToCurve25519(senderPrivKey)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return "", err
+	}
+	wrapped := box.Seal(nonce[:], key[:], nonce, recipient, sender)
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+// UnwrapKey is the reverse of WrapKey, called by the recipient.
+func UnwrapKey(wrapped string, senderPubKey ed25519.PublicKey, recipientPrivKey ed25519.PrivateKey) (*BoardKey, error) {
+	payload, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("This wrapped key is not valid base64. Error: %v", err))
+	}
+	if len(payload) != nonceSize+keySize+box.Overhead {
+		return nil, errors.New(fmt.Sprintf("This wrapped key has the wrong length. Length: %d", len(payload)))
+	}
+	sender, err := PublicKeyToCurve25519(senderPubKey)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := PrivateKeyToCurve25519(recipientPrivKey)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], payload[:nonceSize])
+	plain, ok := box.Open(nil, payload[nonceSize:], &nonce, sender, recipient)
+	if !ok {
+		return nil, errors.New("This wrapped key could not be unwrapped with the given keys.")
+	}
+	var k BoardKey
+	copy(k[:], plain)
+	return &k, nil
+}
+
+/*----------  ed25519 > X25519 conversion  ----------*/
+
+var curveP, _ = new(big.Int).SetString("57896044618658097711785492504343953926634992332820282019728792003956564819949", 10) // 2^255 - 19
+
+// PublicKeyToCurve25519 converts an ed25519 public key to the X25519 public key of the same key pair, via the birational map u = (1 + y) / (1 - y) from the Edwards curve to the Montgomery curve.
+func PublicKeyToCurve25519(pubKey ed25519.PublicKey) (*[keySize]byte, error) {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return nil, errors.New(fmt.Sprintf("This public key has the wrong length. Length: %d", len(pubKey)))
+	}
+	// The key is y in little endian, with the sign of x in the top bit.
+	be := make([]byte, keySize)
+	for i := 0; i < keySize; i++ {
+		be[i] = pubKey[keySize-1-i]
+	}
+	be[0] &= 0x7f
+	y := new(big.Int).SetBytes(be)
+	if y.Cmp(curveP) >= 0 {
+		return nil, errors.New("This public key is not a valid curve point.")
+	}
+	one := big.NewInt(1)
+	denom := new(big.Int).Sub(one, y)
+	denom.Mod(denom, curveP)
+	if denom.Sign() == 0 {
+		return nil, errors.New("This public key is the identity point and can't be used for encryption.")
+	}
+	u := new(big.Int).Add(one, y)
+	u.Mul(u, denom.ModInverse(denom, curveP))
+	u.Mod(u, curveP)
+	ub := u.Bytes()
+	var out [keySize]byte
+	for i := 0; i < len(ub); i++ {
+		out[i] = ub[len(ub)-1-i]
+	}
+	return &out, nil
+}
+
+// PrivateKeyToCurve25519 converts an ed25519 private key to the X25519 private key of the same key pair. This is the clamped first half of the SHA512 of the seed, the same scalar ed25519 signs with.
+func PrivateKeyToCurve25519(privKey ed25519.PrivateKey) (*[keySize]byte, error) {
+	if len(privKey) != ed25519.PrivateKeySize {
+		return nil, errors.New(fmt.Sprintf("This private key has the wrong length. Length: %d", len(privKey)))
+	}
+	h := sha512.Sum512(privKey.Seed())
+	var out [keySize]byte
+	copy(out[:], h[:keySize])
+	out[0] &= 248
+	out[31] &= 127
+	out[31] |= 64
+	return &out, nil
+}
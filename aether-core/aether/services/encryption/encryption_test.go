@@ -0,0 +1,122 @@
+package encryption_test
+
+import (
+	"aether-core/aether/services/encryption"
+	"aether-core/aether/services/signaturing"
+	"bytes"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+)
+
+// Tests
+
+func TestKeyConversion_Success(t *testing.T) {
+	for i := 0; i < 16; i++ {
+		pub, priv, _ := ed25519.GenerateKey(nil)
+		cpub, err := encryption.PublicKeyToCurve25519(pub)
+		if err != nil {
+			t.Fatalf("Public key conversion failed. Err: '%s'", err)
+		}
+		cpriv, err := encryption.PrivateKeyToCurve25519(priv)
+		if err != nil {
+			t.Fatalf("Private key conversion failed. Err: '%s'", err)
+		}
+		var derived [32]byte
+		curve25519.ScalarBaseMult(&derived, cpriv)
+		if derived != *cpub {
+			t.Errorf("Converted public key does not match the one derived from the converted private key.")
+		}
+	}
+}
+
+func TestSealOpen_Success(t *testing.T) {
+	key, err := encryption.NewBoardKey()
+	if err != nil {
+		t.Fatalf("Board key creation failed. Err: '%s'", err)
+	}
+	plain := []byte("Body of an encrypted post.")
+	sealed, err := encryption.Seal(plain, key)
+	if err != nil {
+		t.Fatalf("Sealing failed. Err: '%s'", err)
+	}
+	keyId, err := encryption.SealedKeyId(sealed)
+	if err != nil || keyId != key.KeyId() {
+		t.Errorf("Sealed content does not carry the right key id. Got: '%s', Expected: '%s', Err: '%v'", keyId, key.KeyId(), err)
+	}
+	opened, err := encryption.Open(sealed, key)
+	if err != nil {
+		t.Fatalf("Opening failed. Err: '%s'", err)
+	}
+	if !bytes.Equal(opened, plain) {
+		t.Errorf("Opened content does not match. Got: '%s'", opened)
+	}
+}
+
+func TestOpen_WrongKey_Fail(t *testing.T) {
+	key, _ := encryption.NewBoardKey()
+	other, _ := encryption.NewBoardKey()
+	sealed, _ := encryption.Seal([]byte("secret"), key)
+	_, err := encryption.Open(sealed, other)
+	if err == nil {
+		t.Errorf("Content opened with the wrong key.")
+	}
+}
+
+func TestWrapUnwrap_Success(t *testing.T) {
+	ownerPub, ownerPriv, _ := ed25519.GenerateKey(nil)
+	memberPub, memberPriv, _ := ed25519.GenerateKey(nil)
+	key, _ := encryption.NewBoardKey()
+	wrapped, err := encryption.WrapKey(key, ownerPriv, memberPub)
+	if err != nil {
+		t.Fatalf("Key wrapping failed. Err: '%s'", err)
+	}
+	unwrapped, err := encryption.UnwrapKey(wrapped, ownerPub, memberPriv)
+	if err != nil {
+		t.Fatalf("Key unwrapping failed. Err: '%s'", err)
+	}
+	if *unwrapped != *key {
+		t.Errorf("Unwrapped key does not match the original.")
+	}
+}
+
+func TestUnwrap_NotRecipient_Fail(t *testing.T) {
+	ownerPub, ownerPriv, _ := ed25519.GenerateKey(nil)
+	memberPub, _, _ := ed25519.GenerateKey(nil)
+	_, outsiderPriv, _ := ed25519.GenerateKey(nil)
+	key, _ := encryption.NewBoardKey()
+	wrapped, _ := encryption.WrapKey(key, ownerPriv, memberPub)
+	_, err := encryption.UnwrapKey(wrapped, ownerPub, outsiderPriv)
+	if err == nil {
+		t.Errorf("A key wrapped for someone else was unwrapped.")
+	}
+}
+
+func TestDirectMessage_BothEnds_Success(t *testing.T) {
+	senderPub, senderPriv, _ := ed25519.GenerateKey(nil)
+	recipientPub, recipientPriv, _ := ed25519.GenerateKey(nil)
+	plain := []byte("A private reply.")
+	sealed, err := encryption.SealDirectMessage(plain, senderPriv, signaturing.MarshalPublicKey(recipientPub))
+	if err != nil {
+		t.Fatalf("Sealing failed. Err: '%s'", err)
+	}
+	opened, err := encryption.OpenDirectMessage(sealed, signaturing.MarshalPublicKey(senderPub), recipientPriv)
+	if err != nil || !bytes.Equal(opened, plain) {
+		t.Errorf("Recipient could not open the message. Got: '%s', Err: '%v'", opened, err)
+	}
+	opened2, err := encryption.OpenDirectMessage(sealed, signaturing.MarshalPublicKey(recipientPub), senderPriv)
+	if err != nil || !bytes.Equal(opened2, plain) {
+		t.Errorf("Sender could not open its own message. Got: '%s', Err: '%v'", opened2, err)
+	}
+}
+
+func TestDirectMessage_Outsider_Fail(t *testing.T) {
+	senderPub, senderPriv, _ := ed25519.GenerateKey(nil)
+	recipientPub, _, _ := ed25519.GenerateKey(nil)
+	_, outsiderPriv, _ := ed25519.GenerateKey(nil)
+	sealed, _ := encryption.SealDirectMessage([]byte("secret"), senderPriv, signaturing.MarshalPublicKey(recipientPub))
+	_, err := encryption.OpenDirectMessage(sealed, signaturing.MarshalPublicKey(senderPub), outsiderPriv)
+	if err == nil {
+		t.Errorf("A direct message for someone else was opened.")
+	}
+}
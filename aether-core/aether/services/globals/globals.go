@@ -24,6 +24,12 @@ var KvInstance *storm.DB
 
 // GetDbSize gets the size of the database. This is here and not in toolbox because we need to access GetSQLiteDBLocation().
 func GetDbSize() int {
+	if BackendConfig.GetDbEngine() == "postgres" {
+		// There's no file to stat, ask the server.
+		var size int
+		DbInstance.Get(&size, `SELECT pg_database_size(current_database()) / 1000000`)
+		return size
+	}
 	dbLoc := filepath.Join(BackendConfig.GetSQLiteDBLocation(), "AetherDB.db")
 	fi, _ := os.Stat(dbLoc)
 	// get the size
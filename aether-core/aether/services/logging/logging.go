@@ -4,6 +4,7 @@
 package logging
 
 import (
+	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/toolbox"
 	"fmt"
@@ -24,6 +25,11 @@ type loggingCache struct {
 
 var llcache loggingCache
 
+func init() {
+	// The configstore can't import this package, so its logs come here through its Logf.
+	configstore.Logf = Logf
+}
+
 // Log prints to the standard logger.
 func Log(level int, input interface{}) {
 	if getLoggingLevel() >= level {
@@ -13,18 +13,95 @@ var (
 	json = jsoniter.ConfigCompatibleWithStandardLibrary
 )
 
+/*----------  Versioning  ----------*/
+
+/*
+MetaVersion is the version of the meta schemas below. It is stamped into every meta we create, so that the readers can tell what the creator knew about.
+
+Versions only ever add fields, they never change the meaning of an existing one. That means a reader that sees a meta with a version newer than its own still reads the fields it knows, and ignores the rest. Meta without a version (v0) is what the entities created before the schemas had, and it reads the same way.
+*/
+const MetaVersion = 1
+
+// Versioned is embedded into all meta payloads.
+type Versioned struct {
+	Version int `json:"v,omitempty"`
+}
+
+func (v *Versioned) stampVersion() {
+	v.Version = MetaVersion
+}
+
+type versioned interface {
+	stampVersion()
+}
+
 /*----------  Meta payloads  ----------*/
 
-type BoardMeta struct{}
-type ThreadMeta struct{}
-type PostMeta struct{}
+type BoardMeta struct {
+	Versioned
+	/*----------  Presentation  ----------*/
+	Rules    string       `json:"rules,omitempty"`     // Rules and guidelines of the board, shown to the people posting in it.
+	Flairs   []BoardFlair `json:"flairs,omitempty"`    // The flairs the threads in this board can pick from.
+	IconHash string       `json:"icon_hash,omitempty"` // Hash of the board icon. The image itself is not carried in the entity.
+	/*----------  Encrypted boards  ----------*/
+	// Newest first. Older keyrings are kept so that content sealed before a key rotation stays readable to members.
+	Keyrings []BoardKeyring `json:"keyrings,omitempty"`
+	/*----------  Proof of work  ----------*/
+	// The minimum proof of work strengths the board requires from its threads and posts, on top of the ones in the node configs. Nil if the board has no requirements of its own.
+	MinimumPoW *BoardPoW `json:"min_pow,omitempty"`
+}
+
+// BoardPoW is the minimum proof of work strengths that a board declares for its content. These only apply to the content created (or updated, for the update strengths) at or after Since, so that raising the bar does not drop what's already in the board.
+type BoardPoW struct {
+	Thread       int   `json:"thread,omitempty"`
+	ThreadUpdate int   `json:"thread_update,omitempty"`
+	Post         int   `json:"post,omitempty"`
+	PostUpdate   int   `json:"post_update,omitempty"`
+	Since        int64 `json:"since,omitempty"`
+}
+
+// BoardKeyring is one generation of the board key, wrapped for each member.
+type BoardKeyring struct {
+	KeyId string            `json:"key_id"`
+	Keys  map[string]string `json:"keys"` // Member public key > board key wrapped for that member
+}
+
+// BoardFlair is a label a board defines, which its threads can refer to by Id.
+type BoardFlair struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Colour string `json:"colour,omitempty"` // #rrggbb
+}
+
+type ThreadMeta struct {
+	Versioned
+	Flair           string   `json:"flair,omitempty"` // Id of a flair defined in the board's meta.
+	ContentWarnings []string `json:"cw,omitempty"`
+}
+type PostMeta struct {
+	Versioned
+	ContentWarnings []string `json:"cw,omitempty"`
+}
 type VoteMeta struct {
+	Versioned
 	/*----------  Follows guidelines  ----------*/
 	FGReason string `json:"fg_reason,omitempty"`
 	MAReason string `json:"ma_reason,omitempty"`
 }
-type KeyMeta struct{}
+type KeyMeta struct {
+	Versioned
+	AvatarHash string        `json:"avatar_hash,omitempty"` // Hash of the avatar. The image itself is not carried in the entity.
+	Links      []ProfileLink `json:"links,omitempty"`
+}
+
+// ProfileLink is a link the user puts on their profile, e.g. their website.
+type ProfileLink struct {
+	Label string `json:"label"`
+	Url   string `json:"url"`
+}
+
 type TruststateMeta struct {
+	Versioned
 	CanonicalName string `json:"canonical_name,omitempty"`
 }
 
@@ -45,11 +122,26 @@ func ReadMeta(entityType, metaAsString string) (MetaStruct, error) {
 	}
 	switch entityType {
 	case "Board":
-		return nil, nil
+		em := BoardMeta{}
+		err := json.Unmarshal([]byte(metaAsString), &em)
+		if err != nil {
+			return nil, err
+		}
+		return &em, nil
 	case "Thread":
-		return nil, nil
+		em := ThreadMeta{}
+		err := json.Unmarshal([]byte(metaAsString), &em)
+		if err != nil {
+			return nil, err
+		}
+		return &em, nil
 	case "Post":
-		return nil, nil
+		em := PostMeta{}
+		err := json.Unmarshal([]byte(metaAsString), &em)
+		if err != nil {
+			return nil, err
+		}
+		return &em, nil
 	case "Vote":
 		em := VoteMeta{}
 		err := json.Unmarshal([]byte(metaAsString), &em)
@@ -58,7 +150,12 @@ func ReadMeta(entityType, metaAsString string) (MetaStruct, error) {
 		}
 		return &em, nil
 	case "Key":
-		return nil, nil
+		em := KeyMeta{}
+		err := json.Unmarshal([]byte(metaAsString), &em)
+		if err != nil {
+			return nil, err
+		}
+		return &em, nil
 	case "Truststate":
 		em := TruststateMeta{}
 		err := json.Unmarshal([]byte(metaAsString), &em)
@@ -71,6 +168,9 @@ func ReadMeta(entityType, metaAsString string) (MetaStruct, error) {
 }
 
 func CreateMetaString(payloadStruct MetaStruct) (string, error) {
+	if v, ok := payloadStruct.(versioned); ok {
+		v.stampVersion()
+	}
 	jsonAsByte, err := json.Marshal(payloadStruct)
 	return string(jsonAsByte), err
 }
@@ -0,0 +1,235 @@
+package metaparse_test
+
+// These test the versioned meta schemas: reading the meta of the entities created under each version, writing it, and the bounds the entities carrying it are checked against.
+
+import (
+	"aether-core/aether/io/api"
+	"aether-core/aether/services/configstore"
+	"aether-core/aether/services/globals"
+	"aether-core/aether/services/metaparse"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Infrastructure
+
+// TestMain gives the tests an FE config, since the bounds checks log. Without a BE config, all realms count as served.
+func TestMain(m *testing.M) {
+	globals.FrontendConfig = &configstore.FrontendConfig{Initialised: true}
+	os.Exit(m.Run())
+}
+
+func v(version int) metaparse.Versioned {
+	return metaparse.Versioned{Version: version}
+}
+
+// Tests
+
+func TestReadMeta_Success(t *testing.T) {
+	cases := []struct {
+		name       string
+		entityType string
+		meta       string
+		expected   metaparse.MetaStruct
+	}{
+		// v0: created before the schemas, no version field.
+		{"v0 board", "Board", `{}`, &metaparse.BoardMeta{}},
+		{"v0 vote", "Vote", `{"fg_reason":"spam"}`, &metaparse.VoteMeta{FGReason: "spam"}},
+		{"v0 truststate", "Truststate", `{"canonical_name":"alice"}`, &metaparse.TruststateMeta{CanonicalName: "alice"}},
+		// v1
+		{"v1 board", "Board",
+			`{"v":1,"rules":"be nice","flairs":[{"id":"q","name":"Question","colour":"#00ff00"}],"icon_hash":"abc","min_pow":{"thread":20,"since":5}}`,
+			&metaparse.BoardMeta{
+				Versioned: v(1),
+				Rules:     "be nice",
+				Flairs:    []metaparse.BoardFlair{{Id: "q", Name: "Question", Colour: "#00ff00"}},
+				IconHash:  "abc",
+				MinimumPoW: &metaparse.BoardPoW{
+					Thread: 20,
+					Since:  5,
+				},
+			}},
+		{"v1 thread", "Thread", `{"v":1,"flair":"q","cw":["spoilers","nsfw"]}`,
+			&metaparse.ThreadMeta{Versioned: v(1), Flair: "q", ContentWarnings: []string{"spoilers", "nsfw"}}},
+		{"v1 post", "Post", `{"v":1,"cw":["spoilers"]}`,
+			&metaparse.PostMeta{Versioned: v(1), ContentWarnings: []string{"spoilers"}}},
+		{"v1 key", "Key", `{"v":1,"avatar_hash":"def","links":[{"label":"Site","url":"https://example.com"}]}`,
+			&metaparse.KeyMeta{Versioned: v(1), AvatarHash: "def", Links: []metaparse.ProfileLink{{Label: "Site", Url: "https://example.com"}}}},
+		// A version newer than ours: the fields we know are read, the rest is ignored.
+		{"newer thread", "Thread", `{"v":7,"flair":"q","sticky_until":12345,"cw":["nsfw"]}`,
+			&metaparse.ThreadMeta{Versioned: v(7), Flair: "q", ContentWarnings: []string{"nsfw"}}},
+		{"newer key", "Key", `{"v":7,"avatar_hash":"def","pronouns":"they/them"}`,
+			&metaparse.KeyMeta{Versioned: v(7), AvatarHash: "def"}},
+	}
+	for _, c := range cases {
+		m, err := metaparse.ReadMeta(c.entityType, c.meta)
+		if err != nil {
+			t.Errorf("The meta should have been read. Case: %v, Error: %v", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(m, c.expected) {
+			t.Errorf("The meta was read wrong. Case: %v, Expected: %#v, Got: %#v", c.name, c.expected, m)
+		}
+	}
+}
+
+func TestReadMeta_Empty_Success(t *testing.T) {
+	for _, entityType := range []string{"Board", "Thread", "Post", "Vote", "Key", "Truststate", "Not a type"} {
+		m, err := metaparse.ReadMeta(entityType, "")
+		if m != nil || err != nil {
+			t.Errorf("Empty meta should read as no meta. Type: %v, Meta: %#v, Error: %v", entityType, m, err)
+		}
+	}
+}
+
+func TestReadMeta_Fail(t *testing.T) {
+	cases := []struct {
+		name       string
+		entityType string
+		meta       string
+	}{
+		{"not json", "Board", "some text from before the schemas"},
+		{"wrong field type", "Thread", `{"v":1,"cw":"nsfw"}`},
+		{"wrong version type", "Post", `{"v":"one"}`},
+		{"unknown entity type", "Not a type", `{"v":1}`},
+	}
+	for _, c := range cases {
+		if m, err := metaparse.ReadMeta(c.entityType, c.meta); err == nil {
+			t.Errorf("The meta should not have been read. Case: %v, Got: %#v", c.name, m)
+		}
+	}
+}
+
+func TestCreateMetaString_Success(t *testing.T) {
+	cases := []struct {
+		name       string
+		entityType string
+		payload    metaparse.MetaStruct
+		expected   string
+	}{
+		{"empty board", "Board", &metaparse.BoardMeta{}, `{"v":1}`},
+		{"post", "Post", &metaparse.PostMeta{ContentWarnings: []string{"nsfw"}}, `{"v":1,"cw":["nsfw"]}`},
+		// A payload that claims some other version is stamped with ours, since we're the ones creating it.
+		{"thread", "Thread", &metaparse.ThreadMeta{Versioned: v(7), Flair: "q"}, `{"v":1,"flair":"q"}`},
+		{"key", "Key", &metaparse.KeyMeta{Links: []metaparse.ProfileLink{{Label: "Site", Url: "https://example.com"}}},
+			`{"v":1,"links":[{"label":"Site","url":"https://example.com"}]}`},
+	}
+	for _, c := range cases {
+		s, err := metaparse.CreateMetaString(c.payload)
+		if err != nil {
+			t.Errorf("The meta should have been created. Case: %v, Error: %v", c.name, err)
+			continue
+		}
+		if s != c.expected {
+			t.Errorf("The meta was created wrong. Case: %v, Expected: %v, Got: %v", c.name, c.expected, s)
+		}
+		// And it reads back to the same payload.
+		m, err := metaparse.ReadMeta(c.entityType, s)
+		if err != nil || !reflect.DeepEqual(m, c.payload) {
+			t.Errorf("The meta did not read back the same. Case: %v, Expected: %#v, Got: %#v, Error: %v", c.name, c.payload, m, err)
+		}
+	}
+}
+
+func TestMetaBounds_Board_Success(t *testing.T) {
+	cases := []struct {
+		name  string
+		meta  string
+		valid bool
+	}{
+		{"no meta", "", true},
+		{"meta from before the schemas", "some text", true},
+		{"v1", `{"v":1,"rules":"be nice","flairs":[{"id":"q","name":"Question","colour":"#00ff00"}]}`, true},
+		{"flair without colour", `{"v":1,"flairs":[{"id":"q","name":"Question"}]}`, true},
+		{"negative version", `{"v":-1}`, false},
+		{"rules too long", `{"v":1,"rules":"` + strings.Repeat("a", api.MAX_BOARD_RULES_V1+1) + `"}`, false},
+		{"icon hash too long", `{"v":1,"icon_hash":"` + strings.Repeat("a", api.MAX_META_HASH_V1+1) + `"}`, false},
+		{"flair without id", `{"v":1,"flairs":[{"id":"","name":"Question"}]}`, false},
+		{"flair without name", `{"v":1,"flairs":[{"id":"q","name":""}]}`, false},
+		{"flair colour without hash", `{"v":1,"flairs":[{"id":"q","name":"Question","colour":"00ff00"}]}`, false},
+		{"flair colour not hex", `{"v":1,"flairs":[{"id":"q","name":"Question","colour":"#00ffzz"}]}`, false},
+		{"too many flairs", `{"v":1,"flairs":[` + strings.Repeat(`{"id":"q","name":"Question"},`, api.MAX_BOARD_FLAIRS_V1) + `{"id":"q","name":"Question"}]}`, false},
+	}
+	for _, c := range cases {
+		var b api.Board
+		b.Name = "board name"
+		b.EntityVersion = 1
+		b.Meta = c.meta
+		valid, err := b.CheckBounds()
+		if err != nil {
+			t.Errorf("The bounds check errored. Case: %v, Error: %v", c.name, err)
+		} else if valid != c.valid {
+			t.Errorf("The board meta was bounds checked wrong. Case: %v, Expected: %v, Got: %v", c.name, c.valid, valid)
+		}
+	}
+}
+
+func TestMetaBounds_Content_Success(t *testing.T) {
+	cws := func(n, length int) string {
+		return `{"v":1,"cw":[` + strings.TrimSuffix(strings.Repeat(`"`+strings.Repeat("a", length)+`",`, n), ",") + `]}`
+	}
+	cases := []struct {
+		name  string
+		meta  string
+		valid bool
+	}{
+		{"content warnings", cws(2, 8), true},
+		{"most content warnings", cws(api.MAX_META_CONTENT_WARNINGS_V1, api.MAX_META_CONTENT_WARNING_V1), true},
+		{"too many content warnings", cws(api.MAX_META_CONTENT_WARNINGS_V1+1, 8), false},
+		{"content warning too long", cws(1, api.MAX_META_CONTENT_WARNING_V1+1), false},
+		{"empty content warning", `{"v":1,"cw":[""]}`, false},
+	}
+	for _, c := range cases {
+		var th api.Thread
+		th.Name = "thread name"
+		th.EntityVersion = 1
+		th.Meta = c.meta
+		var p api.Post
+		p.Body = "post body"
+		p.EntityVersion = 1
+		p.Meta = c.meta
+		if valid, err := th.CheckBounds(); err != nil || valid != c.valid {
+			t.Errorf("The thread meta was bounds checked wrong. Case: %v, Expected: %v, Got: %v, Error: %v", c.name, c.valid, valid, err)
+		}
+		if valid, err := p.CheckBounds(); err != nil || valid != c.valid {
+			t.Errorf("The post meta was bounds checked wrong. Case: %v, Expected: %v, Got: %v, Error: %v", c.name, c.valid, valid, err)
+		}
+	}
+	var th api.Thread
+	th.Name = "thread name"
+	th.EntityVersion = 1
+	th.Meta = `{"v":1,"flair":"` + strings.Repeat("a", api.MAX_BOARD_FLAIR_ID_V1+1) + `"}`
+	if valid, _ := th.CheckBounds(); valid {
+		t.Errorf("A thread flair longer than a flair id should be out of bounds.")
+	}
+}
+
+func TestMetaBounds_Key_Success(t *testing.T) {
+	cases := []struct {
+		name  string
+		meta  string
+		valid bool
+	}{
+		{"v1", `{"v":1,"avatar_hash":"def","links":[{"label":"Site","url":"https://example.com"}]}`, true},
+		{"avatar hash too long", `{"v":1,"avatar_hash":"` + strings.Repeat("a", api.MAX_META_HASH_V1+1) + `"}`, false},
+		{"link without label", `{"v":1,"links":[{"label":"","url":"https://example.com"}]}`, false},
+		{"link without url", `{"v":1,"links":[{"label":"Site","url":""}]}`, false},
+		{"link url too long", `{"v":1,"links":[{"label":"Site","url":"` + strings.Repeat("a", api.MAX_KEY_LINK_URL_V1+1) + `"}]}`, false},
+		{"too many links", `{"v":1,"links":[` + strings.Repeat(`{"label":"Site","url":"u"},`, api.MAX_KEY_LINKS_V1) + `{"label":"Site","url":"u"}]}`, false},
+	}
+	for _, c := range cases {
+		var k api.Key
+		k.Type = "ed25519"
+		k.Name = "key name"
+		k.EntityVersion = 1
+		k.Meta = c.meta
+		valid, err := k.CheckBounds()
+		if err != nil {
+			t.Errorf("The bounds check errored. Case: %v, Error: %v", c.name, err)
+		} else if valid != c.valid {
+			t.Errorf("The key meta was bounds checked wrong. Case: %v, Expected: %v, Got: %v", c.name, c.valid, valid)
+		}
+	}
+}
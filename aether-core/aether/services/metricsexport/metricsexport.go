@@ -0,0 +1,155 @@
+// Services > MetricsExport
+// This package serves the internals of the backend or the frontend at a local /metrics endpoint in the Prometheus text format, so that nodes can be scraped with standard tooling.
+
+package metricsexport
+
+import (
+	"aether-core/aether/services/logging"
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+How this works:
+
+The parts of the app that have something to report register a collector. A collector is a function that writes the current values into a Writer. Collectors run at scrape time, so there is nothing to keep updated in the background, and a node that is never scraped pays nothing.
+
+The Writer produces the Prometheus text exposition format (version 0.0.4), which is also accepted by OpenMetrics scrapers. A metric's HELP and TYPE lines are written the first time the metric is seen, so all samples of a metric have to be written by the same collector one after another.
+*/
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	collectorsLock sync.Mutex
+	collectors     []Collector
+)
+
+// Collector writes the current values of some metrics into the writer.
+type Collector func(w *Writer)
+
+// Label is a name-value pair attached to a sample.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// L is a shorthand to create a label.
+func L(name, value string) Label {
+	return Label{Name: name, Value: value}
+}
+
+// Writer collects the samples of one scrape in the Prometheus text format.
+type Writer struct {
+	buf       bytes.Buffer
+	described map[string]bool
+}
+
+func newWriter() *Writer {
+	return &Writer{described: make(map[string]bool)}
+}
+
+// Gauge writes a value that can go up and down.
+func (w *Writer) Gauge(name, help string, value float64, labels ...Label) {
+	w.describe(name, help, "gauge")
+	w.sample(name, value, labels)
+}
+
+// Counter writes a value that only goes up for the lifetime of the process.
+func (w *Writer) Counter(name, help string, value float64, labels ...Label) {
+	w.describe(name, help, "counter")
+	w.sample(name, value, labels)
+}
+
+// Summary writes the sum and the count of a set of observations, e.g. the total duration and the number of syncs.
+func (w *Writer) Summary(name, help string, sum float64, count int64, labels ...Label) {
+	w.describe(name, help, "summary")
+	w.sample(name+"_sum", sum, labels)
+	w.sample(name+"_count", float64(count), labels)
+}
+
+func (w *Writer) describe(name, help, metricType string) {
+	if w.described[name] {
+		return
+	}
+	w.described[name] = true
+	fmt.Fprintf(&w.buf, "# HELP %s %s\n", name, escapeHelp(help))
+	fmt.Fprintf(&w.buf, "# TYPE %s %s\n", name, metricType)
+}
+
+func (w *Writer) sample(name string, value float64, labels []Label) {
+	w.buf.WriteString(name)
+	if len(labels) > 0 {
+		pairs := []string{}
+		for _, l := range labels {
+			pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", l.Name, escapeLabelValue(l.Value)))
+		}
+		w.buf.WriteString("{" + strings.Join(pairs, ",") + "}")
+	}
+	w.buf.WriteString(" " + formatValue(value) + "\n")
+}
+
+// Bytes returns what has been written so far.
+func (w *Writer) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// Register adds a collector that will be run at every scrape.
+func Register(c Collector) {
+	collectorsLock.Lock()
+	defer collectorsLock.Unlock()
+	collectors = append(collectors, c)
+}
+
+// Collect runs all registered collectors and returns the result in the text format.
+func Collect() []byte {
+	collectorsLock.Lock()
+	cs := make([]Collector, len(collectors))
+	copy(cs, collectors)
+	collectorsLock.Unlock()
+	w := newWriter()
+	for _, c := range cs {
+		c(w)
+	}
+	return w.Bytes()
+}
+
+func handler(resp http.ResponseWriter, req *http.Request) {
+	resp.Header().Set("Content-Type", contentType)
+	resp.Write(Collect())
+}
+
+// StartServer serves the /metrics endpoint at the given address. This blocks, so it should be called in its own goroutine.
+func StartServer(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", handler)
+	logging.Logf(1, "The metrics exporter is starting. Address: http://%s/metrics", addr)
+	err := http.ListenAndServe(addr, mux)
+	if err != nil {
+		logging.Logf(1, "The metrics exporter has stopped. Error: %v", err)
+	}
+}
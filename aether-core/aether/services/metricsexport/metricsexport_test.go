@@ -0,0 +1,40 @@
+package metricsexport_test
+
+import (
+	"aether-core/aether/services/metricsexport"
+	"strings"
+	"testing"
+)
+
+// Tests
+
+func TestCollect_Success(t *testing.T) {
+	metricsexport.Register(func(w *metricsexport.Writer) {
+		w.Gauge("aether_test_saturation", "Test saturation.", 0.5, metricsexport.L("direction", "inbound"))
+		w.Gauge("aether_test_saturation", "Test saturation.", 1, metricsexport.L("direction", "outbound"))
+		w.Summary("aether_test_duration_seconds", "Test duration.", 12.5, 3)
+	})
+	out := string(metricsexport.Collect())
+	expected := `# HELP aether_test_saturation Test saturation.
+# TYPE aether_test_saturation gauge
+aether_test_saturation{direction="inbound"} 0.5
+aether_test_saturation{direction="outbound"} 1
+# HELP aether_test_duration_seconds Test duration.
+# TYPE aether_test_duration_seconds summary
+aether_test_duration_seconds_sum 12.5
+aether_test_duration_seconds_count 3
+`
+	if !strings.Contains(out, expected) {
+		t.Errorf("Collected output is not what was expected. Output: '%s'", out)
+	}
+}
+
+func TestCollect_LabelEscaping_Success(t *testing.T) {
+	metricsexport.Register(func(w *metricsexport.Writer) {
+		w.Counter("aether_test_escaped_total", "Test escaping.", 1, metricsexport.L("name", "a\"b\\c\nd"))
+	})
+	out := string(metricsexport.Collect())
+	if !strings.Contains(out, `aether_test_escaped_total{name="a\"b\\c\nd"} 1`) {
+		t.Errorf("Label value was not escaped. Output: '%s'", out)
+	}
+}
@@ -5,7 +5,8 @@
 package nonces
 
 import (
-	"aether-core/aether/services/toolbox"
+	"aether-core/aether/services/clock"
+	"errors"
 	"log"
 	"sync"
 	"time"
@@ -56,10 +57,15 @@ const (
 	minFlushIntervalMinutes = 1
 	// ^ We want to flush at most this often, not more often than that.
 	nonceExpirationMinutes = maximumAllowedClockSkewMinutes * 2.5
+	rateWindow             = 1 * time.Minute
+	// ^ The window the per-minute rate limits (CheckWithinRate) count the requests in.
 )
 
 var (
-	lastflush int64
+	ErrMalformed   = errors.New("The nonce, the public key or the timestamp is missing or malformed.")
+	ErrClockSkew   = errors.New("The timestamp is outside the maximum allowed clock skew.")
+	ErrReplay      = errors.New("This nonce has already been used.")
+	ErrRateLimited = errors.New("This public key has made too many requests.")
 )
 
 // Initialiser
@@ -74,6 +80,7 @@ func NewRemotesNonces() RemotesNonces {
 
 type RemotesNonces struct {
 	lock      sync.Mutex
+	lastflush int64
 	NoncesMap map[publicKey][]nonce // needs init
 }
 
@@ -91,7 +98,7 @@ func (n *nonce) afterCutoff(cutoff int64) bool {
 
 func (rn *RemotesNonces) flush(cutoff int64) {
 	// We don't want to run it every time it's called, only when some time has passed.
-	if lastflush > toolbox.CnvToCutoffMinutes(minFlushIntervalMinutes) {
+	if rn.lastflush > cutoffMinutes(minFlushIntervalMinutes) {
 		return
 	}
 	newRn := make(map[publicKey][]nonce)
@@ -108,49 +115,74 @@ func (rn *RemotesNonces) flush(cutoff int64) {
 	}
 	// Make new list the main list.
 	rn.NoncesMap = newRn
-	lastflush = time.Now().Unix()
+	rn.lastflush = clock.Now().Unix()
 }
 
 // IsValid checks for nonce validity. If nonextant, it is valid. If extant and within the expiration, it is valid. If pk is extant and nonce has changed, check minimum replacement age, and if it's older than that, replace and it is valid. One PK can only have one nonce
 func (rn *RemotesNonces) IsValid(pk, nonceStr string, apiRespTimestamp int64) bool {
+	return rn.CheckWithinLimit(pk, nonceStr, apiRespTimestamp, maxAllowedRequestsWithinClockSkew) == nil
+}
+
+// CheckWithinLimit is IsValid with a custom rate limit, and it tells why the nonce was declined. The limit is the number of requests a PK can make within the nonce expiration window.
+func (rn *RemotesNonces) CheckWithinLimit(pk, nonceStr string, apiRespTimestamp int64, maxRequests int) error {
+	return rn.check(pk, nonceStr, apiRespTimestamp, maxRequests, cutoffMinutes(nonceExpirationMinutes))
+}
+
+// CheckWithinRate is CheckWithinLimit with the limit counted over the last minute, instead of over the whole nonce expiration window. The nonces are still kept for the whole window, so that they can't be replayed.
+func (rn *RemotesNonces) CheckWithinRate(pk, nonceStr string, apiRespTimestamp int64, perMinute int) error {
+	return rn.check(pk, nonceStr, apiRespTimestamp, perMinute, clock.Now().Add(-rateWindow).Unix())
+}
+
+// check runs the checks of the nonce. The PK can have made at most maxRequests requests after rateCutoff.
+func (rn *RemotesNonces) check(pk, nonceStr string, apiRespTimestamp int64, maxRequests int, rateCutoff int64) error {
 	rn.lock.Lock()
 	defer rn.lock.Unlock()
 	// Guard against empty.
 	if len(pk) == 0 || len(nonceStr) == 0 || apiRespTimestamp == 0 {
-		return false
+		return ErrMalformed
 	}
 	// Guard against too long nonces.
 	if len(nonceStr) > 64 {
-		return false
+		return ErrMalformed
 	}
 	// Guard against clock skew.
-	futureClockSkewCutoff := toolbox.CnvToFutureCutoffMinutes(maximumAllowedClockSkewMinutes)
-	pastClockSkewCutoff := toolbox.CnvToCutoffMinutes(maximumAllowedClockSkewMinutes)
+	futureClockSkewCutoff := clock.Now().Add(maximumAllowedClockSkewMinutes * time.Minute).Unix()
+	pastClockSkewCutoff := cutoffMinutes(maximumAllowedClockSkewMinutes)
 	if !(apiRespTimestamp < futureClockSkewCutoff &&
 		apiRespTimestamp > pastClockSkewCutoff) {
-		log.Printf("This API response failed the nonce check because the provided timestamp is too old or too new. Current time: %v, Timestamp given: %v", time.Now().Unix(), apiRespTimestamp)
-		return false
+		log.Printf("This API response failed the nonce check because the provided timestamp is too old or too new. Current time: %v, Timestamp given: %v", clock.Now().Unix(), apiRespTimestamp)
+		return ErrClockSkew
 	}
 	// Set cutoffs.
-	expCutoff := toolbox.CnvToCutoffMinutes(nonceExpirationMinutes)
+	expCutoff := cutoffMinutes(nonceExpirationMinutes)
 	pkey := publicKey(pk)
 	// Flush to remove nonces older than expiration.
 	rn.flush(expCutoff)
 	// Guard against too many requests (rate limiter)
-	if len(rn.NoncesMap[pkey]) > maxAllowedRequestsWithinClockSkew {
-		return false
+	recent := 0
+	for i, _ := range rn.NoncesMap[pkey] {
+		if rn.NoncesMap[pkey][i].afterCutoff(rateCutoff) {
+			recent++
+		}
+	}
+	if recent >= maxRequests {
+		return ErrRateLimited
 	}
 	// Check if the nonce exists.
 	for i, _ := range rn.NoncesMap[pkey] {
 		if rn.NoncesMap[pkey][i].nStr == nonceStr {
-			return false // We already have this nonce, this is a replay.
+			return ErrReplay // We already have this nonce, this is a replay.
 		}
 	}
 	// It doesn't exist. Add it to our library, so it can't be reused.
 	rn.NoncesMap[pkey] = append(rn.NoncesMap[pkey],
 		nonce{
 			nStr:     nonceStr,
-			creation: time.Now().Unix(),
+			creation: clock.Now().Unix(),
 		})
-	return true
+	return nil
+}
+
+func cutoffMinutes(mins float64) int64 {
+	return clock.Now().Add(-time.Duration(mins * float64(time.Minute))).Unix()
 }
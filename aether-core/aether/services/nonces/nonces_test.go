@@ -0,0 +1,91 @@
+package nonces_test
+
+import (
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/nonces"
+	"aether-core/aether/services/signaturing"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+	"testing"
+	"time"
+)
+
+// Tests
+
+func TestSignedNonce_Success(t *testing.T) {
+	privKey, _ := signaturing.CreateKeyPair()
+	pk := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
+	ts := time.Now().Unix()
+	signed, err := nonces.NewSignedNonce("token", ts, privKey)
+	if err != nil {
+		t.Fatalf("Signed nonce creation failed. Err: '%s'", err)
+	}
+	n, valid := nonces.VerifySignedNonce(signed, "token", ts, pk)
+	if !valid || len(n) == 0 {
+		t.Errorf("Signed nonce did not verify.")
+	}
+}
+
+func TestSignedNonce_OtherRequest_Fail(t *testing.T) {
+	privKey, _ := signaturing.CreateKeyPair()
+	pk := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
+	ts := time.Now().Unix()
+	signed, _ := nonces.NewSignedNonce("token", ts, privKey)
+	if _, valid := nonces.VerifySignedNonce(signed, "othertoken", ts, pk); valid {
+		t.Errorf("Signed nonce verified for a different access token.")
+	}
+	if _, valid := nonces.VerifySignedNonce(signed, "token", ts+1, pk); valid {
+		t.Errorf("Signed nonce verified for a different timestamp.")
+	}
+}
+
+func TestCheckWithinLimit_Replay_Fail(t *testing.T) {
+	rn := nonces.NewRemotesNonces()
+	ts := time.Now().Unix()
+	if err := rn.CheckWithinLimit("pk", "nonce", ts, 10); err != nil {
+		t.Fatalf("First use of the nonce was declined. Err: '%s'", err)
+	}
+	if err := rn.CheckWithinLimit("pk", "nonce", ts, 10); err != nonces.ErrReplay {
+		t.Errorf("Replayed nonce was not declined as a replay. Err: '%v'", err)
+	}
+}
+
+func TestCheckWithinLimit_RateLimited_Fail(t *testing.T) {
+	rn := nonces.NewRemotesNonces()
+	ts := time.Now().Unix()
+	for i := 0; i < 3; i++ {
+		rn.CheckWithinLimit("pk", fmt.Sprint(i), ts, 2)
+	}
+	if err := rn.CheckWithinLimit("pk", "z", ts, 2); err != nonces.ErrRateLimited {
+		t.Errorf("Request over the limit was not rate limited. Err: '%v'", err)
+	}
+}
+
+func TestCheckWithinLimit_ClockSkew_Fail(t *testing.T) {
+	rn := nonces.NewRemotesNonces()
+	if err := rn.CheckWithinLimit("pk", "nonce", time.Now().Add(-time.Hour).Unix(), 10); err != nonces.ErrClockSkew {
+		t.Errorf("Old timestamp was not declined. Err: '%v'", err)
+	}
+}
+
+func TestCheckWithinRate_PerMinute_Success(t *testing.T) {
+	v := clock.NewVirtual(time.Now())
+	defer clock.Use(v)()
+	rn := nonces.NewRemotesNonces()
+	for i := 0; i < 3; i++ {
+		if err := rn.CheckWithinRate("pk", fmt.Sprint(i), clock.Now().Unix(), 3); err != nil {
+			t.Fatalf("Request within the per minute limit was declined. Request: %d, Err: '%s'", i, err)
+		}
+	}
+	if err := rn.CheckWithinRate("pk", "z", clock.Now().Unix(), 3); err != nonces.ErrRateLimited {
+		t.Errorf("Request over the per minute limit was not rate limited. Err: '%v'", err)
+	}
+	// The next minute, the limit starts over. The nonces of the last minute can't be replayed, though.
+	v.Advance(61 * time.Second)
+	if err := rn.CheckWithinRate("pk", "z", clock.Now().Unix(), 3); err != nil {
+		t.Errorf("Request in the next minute was declined. Err: '%v'", err)
+	}
+	if err := rn.CheckWithinRate("pk", "0", clock.Now().Unix(), 3); err != nonces.ErrReplay {
+		t.Errorf("Nonce from the last minute was not declined as a replay. Err: '%v'", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Services > Nonces > Signed
+// This file creates and verifies the signed nonces that frontends send to the backend API.
+
+package nonces
+
+import (
+	"aether-core/aether/services/signaturing"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+	"strings"
+)
+
+/*
+The RequesterId of the backend API carries a nonce, but no separate signature field. So the nonce the frontend sends is the nonce itself, followed by the signature of the nonce, the timestamp and the access token of the request, in the form "<nonce>.<signature>". The signature proves that the request comes from the holder of the public key in the RequesterId, and since it covers the timestamp and the nonce, it can't be lifted into another request.
+*/
+
+const signedNonceSeparator = "."
+
+func signedNonceInput(nonceStr, accessToken string, timestamp int64) string {
+	return fmt.Sprint(nonceStr, ":", timestamp, ":", accessToken)
+}
+
+// NewSignedNonce creates a fresh nonce and signs it with the given key for a request with the given access token and timestamp.
+func NewSignedNonce(accessToken string, timestamp int64, privKey *ed25519.PrivateKey) (string, error) {
+	raw := make([]byte, 16)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Nonce generation failed. Error: %v", err))
+	}
+	nonceStr := hex.EncodeToString(raw)
+	sig, err2 := signaturing.Sign(signedNonceInput(nonceStr, accessToken, timestamp), privKey)
+	if err2 != nil {
+		return "", err2
+	}
+	return fmt.Sprint(nonceStr, signedNonceSeparator, sig), nil
+}
+
+// VerifySignedNonce checks the signature of a signed nonce against the given public key, and returns the bare nonce if the signature is valid.
+func VerifySignedNonce(signedNonce, accessToken string, timestamp int64, pubKey string) (string, bool) {
+	parts := strings.SplitN(signedNonce, signedNonceSeparator, 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return "", false
+	}
+	if !signaturing.Verify(signedNonceInput(parts[0], accessToken, timestamp), parts[1], pubKey) {
+		return "", false
+	}
+	return parts[0], true
+}
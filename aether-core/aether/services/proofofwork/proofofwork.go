@@ -15,8 +15,11 @@ import (
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/signaturing"
 	"math/big"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,11 +29,11 @@ The workings of this can be outlined as such:
 0) The way hashcash works is that we check for partial hash collisions. That means, in simpler terms, we are looking to create a hash with a particular number of zeroes at the left side. The number of zeroes is the difficulty of the hashcash token - the more zeroes it has, the harder it was to generate.
 
 1) Create a salt. This makes Hashcash random even if the text is exactly the same.
-2) Calculate how many digits in hex (mind that one 0 digit in hex refers to 4 zeros in binary). This is floored, so if we need 4.5 hex digits (i.e. 22 binary digits) it will get floored to 20 binary digits = 4 hex digits. Don't worry, we will also be checking for the last 2 digits later.
-3) Create the zeroed strings for both hex and binary.
-4) Create the hash of the salt, the input, and the counter, combined.
-5) Check whether the generated hash in hex has the number of zeroes that the hex. If not, increment the counter and try again.
-6) If hex has enough digits, convert to binary, and check if binary has enough digits. Binary digits >= hex digits * 4. If so, congrats! You got a winner. If not, increment the counter and try again.
+2) Start a worker per core (or as many as the config says). Each worker tries its own set of counters, so that no two workers try the same one.
+3) Create the hash of the salt, the input, and the counter, combined.
+4) Check whether the hash starts with enough zero bits. If not, move to the next counter and try again.
+5) The first worker to find one wins, and the rest stop. The minting also stops if it is cancelled, or if it takes longer than the bailout time.
+6) While the workers are running, report how many hashes were tried, and an estimate of the time left.
 7) Format the result as needed, sign if needed, and return.
 */
 
@@ -91,10 +94,96 @@ func convertToBinaryString(input []byte) string {
 
 var bailoutTimeSeconds int
 
+/*----------  Minting control  ----------*/
+
+// MintControl lets the caller of a minting watch its progress and cancel it. Both fields are optional.
+type MintControl struct {
+	// Closing this stops the minting, and it returns ErrMintCancelled.
+	Cancel <-chan struct{}
+	// This is called about every progressInterval while the minting is running.
+	Progress func(MintProgress)
+}
+
+// MintProgress is the state of a minting that is still running.
+type MintProgress struct {
+	Difficulty           int
+	HashesTried          int64
+	HashesPerSecond      float64
+	EstimatedSecondsLeft int64
+}
+
+var ErrMintCancelled = errors.New("The proof of work minting was cancelled.")
+
+const (
+	progressInterval = time.Second
+	// How many hashes a worker computes before it checks whether it should stop, and reports what it has done.
+	workerBatchSize = 4096
+)
+
+// mintingWorkers is how many cores the minting uses.
+func mintingWorkers() int {
+	workers := 0
+	if globals.BackendConfig != nil {
+		workers = globals.BackendConfig.GetPoWMintingThreads()
+	}
+	if globals.FrontendConfig != nil {
+		workers = globals.FrontendConfig.GetPoWMintingThreads()
+	}
+	if workers <= 0 {
+		// 0 is all cores.
+		workers = runtime.NumCPU()
+	}
+	return workers
+}
+
+// estimateProgress estimates the time left by the expected number of hashes needed for this difficulty. It's an estimate: a minting can get lucky, or take a lot longer than expected, in which case the estimate stays at zero.
+func estimateProgress(difficulty int, tried int64, elapsed time.Duration) MintProgress {
+	p := MintProgress{Difficulty: difficulty, HashesTried: tried}
+	if elapsed <= 0 || tried == 0 {
+		return p
+	}
+	p.HashesPerSecond = float64(tried) / elapsed.Seconds()
+	expected := math.Pow(2, float64(difficulty))
+	if left := expected - float64(tried); left > 0 {
+		p.EstimatedSecondsLeft = int64(left / p.HashesPerSecond)
+	}
+	return p
+}
+
+// hasLeadingZeroBits tells whether the hash starts with at least n zero bits. This is the same check as the one in Verify, without the string conversions, since this is the tight loop.
+func hasLeadingZeroBits(hash []byte, n int) bool {
+	fullBytes := n / 8
+	if fullBytes > len(hash) {
+		return false
+	}
+	for i := 0; i < fullBytes; i++ {
+		if hash[i] != 0 {
+			return false
+		}
+	}
+	rem := uint(n % 8)
+	if rem == 0 {
+		return true
+	}
+	if fullBytes >= len(hash) {
+		return false
+	}
+	return hash[fullBytes]>>(8-rem) == 0
+}
+
 // Mid level functions
 
 // Create creates the Hashcash proof of with the given difficulty. This function has an inner loop which adds a random element to the input and tries to find enough zeros at the beginning of the SHA1 hash of the result.
 func Create(input string, difficulty int, privKey *ed25519.PrivateKey) (string, error) {
+	return CreateControlled(input, difficulty, privKey, nil)
+}
+
+/*
+CreateControlled is Create with a control that can watch and cancel the minting. The control can be nil.
+
+The minting is split between the workers by the counter: worker n tries the counters n, n + workers, n + 2*workers and so on. Every counter that gives enough zeros is a valid PoW, so whichever worker finds one first wins, and the others stop. The result is the same as the single core one, Verify does not know or care how many cores made it.
+*/
+func CreateControlled(input string, difficulty int, privKey *ed25519.PrivateKey, ctl *MintControl) (string, error) {
 	if difficulty <= 0 {
 		return "", nil
 		// Any value of 0 or less means there is no PoW implied.
@@ -118,22 +207,9 @@ func Create(input string, difficulty int, privKey *ed25519.PrivateKey) (string,
 		bailoutTimeSeconds = 600
 		// This is TODO to accommodate nameminter, whose config is separate. We should remove backend / frontend config dependency from this library in the future.
 	}
-	// Calculate number of zeros we need at the beginning of the hash.
-	// Hex is floored. So you still need to do additional checks.
-	lowerHexDigitsNeeded := int(math.Floor(float64(difficulty64) / float64(4)))
-	binDigitsNeeded := difficulty64
-	var zeroHexDigits string
-	var zeroBinDigits string
-	for i := 0; i < lowerHexDigitsNeeded; i++ {
-		zeroHexDigits = zeroHexDigits + "0"
-	}
-	for i := int64(0); i < binDigitsNeeded; i++ {
-		zeroBinDigits = zeroBinDigits + "0"
+	if ctl == nil {
+		ctl = &MintControl{}
 	}
-	// Create the counter
-	var counter int64
-	// Before creating the salt, we need to seed the random number generator first. We check if it is already seeded, we do nothing.
-	// fmt.Printf("%#v\n", rand.Seed)
 	// Create the salt.
 	saltBytes := make([]byte, 16)
 	for i := range saltBytes {
@@ -147,32 +223,68 @@ func Create(input string, difficulty int, privKey *ed25519.PrivateKey) (string,
 	// Add salt to the end of the input string.
 	inputToBePoWd := strconv.FormatInt(difficulty64, 10) +
 		input + string(saltBytes)
+	// Start the workers.
+	workers := mintingWorkers()
+	stop := make(chan struct{})
+	found := make(chan int64, 1)
+	var hashesTried int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(first int64) {
+			defer wg.Done()
+			for counter := first; ; {
+				for i := 0; i < workerBatchSize; i++ {
+					// This is the tight loop.
+					result := mimHash(inputToBePoWd + strconv.FormatInt(counter, 10))
+					if hasLeadingZeroBits(result, difficulty) {
+						select {
+						case found <- counter:
+						default:
+							// Another worker found one first.
+						}
+						return
+					}
+					counter += int64(workers)
+				}
+				atomic.AddInt64(&hashesTried, workerBatchSize)
+				select {
+				case <-stop:
+					return
+				default:
+				}
+			}
+		}(int64(w))
+	}
+	stopWorkers := func() {
+		close(stop)
+		wg.Wait()
+	}
 	// Take time here
-	timeCounter := int(time.Now().Unix())
-	bailoutSeconds := bailoutTimeSeconds
+	start := time.Now()
+	bailout := time.Duration(bailoutTimeSeconds) * time.Second
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	var counter int64
+MintLoop:
 	for {
-		// This is the tight loop.
-		// Check if the bailout time has passed.
-		now := int(time.Now().Unix())
-		if now-(timeCounter+bailoutSeconds) > 0 {
-			return "", errors.New(fmt.Sprint(
-				"The timestamp took too long to create."))
-		}
-		// Compute hash.
-		result := mimHash(inputToBePoWd + strconv.FormatInt(counter, 10))
-		resultHex := fmt.Sprintf("%x", result)
-		// Check hex first.
-		if resultHex[:lowerHexDigitsNeeded] == zeroHexDigits {
-			// It has passed the hex portion. So we need to check for the binary.
-			resultBinary := convertToBinaryString(result)
-			if resultBinary[:binDigitsNeeded] == zeroBinDigits {
-				// It passed both inspections, success!
-				break
-			} else {
-				counter++
+		select {
+		case counter = <-found:
+			stopWorkers()
+			break MintLoop
+		case <-ctl.Cancel:
+			stopWorkers()
+			return "", ErrMintCancelled
+		case <-ticker.C:
+			// Check if the bailout time has passed.
+			if time.Since(start) > bailout {
+				stopWorkers()
+				return "", errors.New(fmt.Sprint(
+					"The timestamp took too long to create."))
+			}
+			if ctl.Progress != nil {
+				ctl.Progress(estimateProgress(difficulty, atomic.LoadInt64(&hashesTried), time.Since(start)))
 			}
-		} else {
-			counter++
 		}
 	}
 	// Mind the terminating ":" in case of no signature.
@@ -5,15 +5,15 @@ import (
 	"aether-core/aether/services/configstore"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/proofofwork"
 	"aether-core/aether/services/signaturing"
 	// "fmt"
 	// "log"
-	"crypto/elliptic"
-	"encoding/hex"
 	"golang.org/x/crypto/ed25519"
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Infrastructure, setup and teardown
@@ -42,55 +42,58 @@ func TestMain(m *testing.M) {
 }
 
 func setup() {
-
+	// The PoW of entities is checked as the backend does. Only one of the FE or BE configs can be there at a time.
+	globals.BackendTransientConfig = &configstore.Btc
+	globals.BackendTransientConfig.SetDefaults()
+	globals.BackendTransientConfig.AppIdentifier = "A-UnitTest-PoW"
 	becfg, err := configstore.EstablishBackendConfig()
 	if err != nil {
 		logging.LogCrash(err)
 	}
 	becfg.Cycle()
 	globals.BackendConfig = becfg
-
-	fecfg, err := configstore.EstablishFrontendConfig()
-	if err != nil {
-		logging.LogCrash(err)
-	}
-	fecfg.Cycle()
-	globals.FrontendConfig = fecfg
+	globals.BackendTransientConfig.PermConfigReadOnly = true
+	globals.BackendTransientConfig.ProofOfWorkCheckEnabled = true
+	// So that TestCreatePoW_Fail_TookTooLong doesn't take the default bailout time. This is read on the first minting.
+	globals.BackendConfig.SetPoWBailoutTimeSeconds(10)
 
 	// Set up the min PoW strengths from services. This is normally in main()
 	globals.BackendConfig.SetMinimumPoWStrengths(16)
 
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	newboard.ProofOfWork = ""
-	newboard.ProofOfWork = "MIM1:20::::hvMazmkOQUvYriEB:630538:"
+	newboard.ProofOfWork = "MIM1:20::::pUDzbqaprHxbSmea:246979:"
 	// To regenerate:
 	// newboard.CreatePoW(new(ed25519.PrivateKey), 20)
 	// fmt.Println(newboard.ProofOfWork)
 
 	signedNewboard.Fingerprint = "my random fingerprint3"
 	signedNewboard.Creation = 4564654
+	signedNewboard.EntityVersion = 1
 	signedNewboard.Name = "my board name"
 	signedNewboard.Description = "my board description"
-	signedNewboard.ProofOfWork = "MIM1:20::::cJSTqJNnTcTcYgzH:2947609:3338744f899d411e399e35b7f23a48a43790142ff8533e13f5383991700788108c32564e773bc40e484ac915dbf059edc97e605b66587c4c4e70143922a96f3de0-0133ea996835ece4dd192165bd0a60f78872bf7ce84d8b0481cdb772e711dde0a69426cab70ce50d4385b5637068322167e1fcce77159f14d37794d31f91d1a4e59f"
+	signedNewboard.ProofOfWork = "MIM1:20::::zlsFVzhVoQPdePSD:1145025:3e9964b72acc1da05ac264b32be21d95b71bf9ea78333f0aaa026e80028ce6871d992e761dfa3350a48f4acbedf8298ff23972403611cd961a324d982144d80e"
 
 	// Marshaled pub key for this is:
-	//0400fa3aa273d67a3161069414879677f0ecf554a13edaa7d85387fe8fafaacea4beb1daf0284efa5f6ac0bc5181f3d20f748d53f79f3c1979cc58b7210dd7a161b0a4007bfb1315a6e78cd43855a4a40401fdc977a01c9f53616b52c92b16dc42fab04b84cc1788fbebc4f1449634cffe35e457680c432f28232d49aa8fc73fde8f374525
+	//da55b6d5c4bd12652d5bebd04ebef53d99060ed266f876930aa076281d58f4cf
 
 	// To regenerate:
 	// privKey, _ := signaturing.CreateKeyPair()
 	// signedNewboard.CreatePoW(privKey, 20)
-	// marshaledPubKey := hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey.PublicKey.X, privKey.PublicKey.Y))
+	// marshaledPubKey := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
 	// fmt.Println(signedNewboard.ProofOfWork)
 	// fmt.Println(marshaledPubKey)
 
 	// privKey, _ := signaturing.CreateKeyPair()
-	// fmt.Println(hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey.PublicKey.X, privKey.PublicKey.Y)))
-	signedNewBoardUpdatedPubkey = "04011c1b73221ac0afbd404ab0aa86ed7ea99c2042b2f05581bccd6b0e321edeab2eb5a56cbf2b0a952aed53cc18c47b2511552d1613eb710ea05b0b2646590e9b96ea01317c365886e4ec7302ad0ca12a667676c6fe2aff3afeeaa5439823976bfb2e9b60e787bceba8a2b529adb7a1057d60b054b62e333f8f84c37a9272ae3372e63952"
+	// fmt.Println(signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey)))
+	signedNewBoardUpdatedPubkey = "00d6970604c138e349b93bb629ec48325c3ee1c3b1fbfc45dfe8ba981e9ab96d"
 	signedNewboardUpdated.Fingerprint = "my random fingerprint"
 	signedNewboardUpdated.Creation = 4564654
+	signedNewboardUpdated.EntityVersion = 1
 	signedNewboardUpdated.Name = "my board name"
 	signedNewboardUpdated.Description = "description"
 	// signedNewboardUpdated.CreatePoW(privKey, 20)
@@ -98,23 +101,26 @@ func setup() {
 	signedNewboardUpdated.Description = "I updated this board's description"
 	// signedNewboardUpdated.CreateUpdatePoW(privKey, 20)
 	// fmt.Println(signedNewboardUpdated.UpdateProofOfWork)
-	signedNewboardUpdated.ProofOfWork = "MIM1:20::::nEGYzEPiiPlurnCt:630323:37767a77da2d0d6cb33042ad1e5841dd1acbac99ae13375555d5ffded151734d8493e39027259c2c0d5edc01f628f31b2a2e2ebf86952638992856b07c51fafa76-01ea31d15880cfc7c5d2519878f12c94fb4c8d7deebec6a06549a93d124ac754fc7087e4d1fbcb8a9d1bf2407fbf87b28d0c3aa0c7f4aea4319328866c090b9453d5"
-	signedNewboardUpdated.UpdateProofOfWork = "MIM1:20::::DQXchTAxFPlMMAXl:745645:a59a5df7c787b5742242de80b18850ec0dd5130ee9bd4d2beb3ae8d4e3bd8ced1342cba34767abc41df791a37d5b18a41ffaddc7d0929c398745703b49436ea581-c7f29291c813157c339560f1d349bf5d2d62d968f98950a0ff61db4ff6927120c03c0b2f80539b8b028b09d84b33b5a9df0c9b73d3397ad0108f871cfb2bd35dbd"
+	signedNewboardUpdated.ProofOfWork = "MIM1:20::::oxEDbmwHolAodXyM:393185:51c66d81b266a5ec60812a07bf2d5d78bab59bc14c4e65dab5842070bc9d11cdfdb584117b2e1773e0c19e53871d4f471a910e2d37e8f809e3f5f2be2953500e"
+	signedNewboardUpdated.UpdateProofOfWork = "MIM1:20::::CIBEiuXzqlbPxUAh:2384485:41f4981b5aef4d45afd5d71ab24356e44a6b699c81ffb9f2c9e2e78823add63be63f22d322375346ee23c24c611ee9d3d40930b11ed3da845f12a0d40620b901"
 
 	invalidPoWBoard.Fingerprint = "my random fingerprint"
 	invalidPoWBoard.Creation = 4564654
+	invalidPoWBoard.EntityVersion = 1
 	invalidPoWBoard.Name = "my board name"
 	invalidPoWBoard.Description = "my board description"
 	invalidPoWBoard.ProofOfWork = "MIM1:21::::QkaMjkJbvXInQLtW:1166891:"
 
 	weakPoWBoard.Fingerprint = "my random fingerprint"
 	weakPoWBoard.Creation = 4564654
+	weakPoWBoard.EntityVersion = 1
 	weakPoWBoard.Name = "my board name"
 	weakPoWBoard.Description = "my board description"
-	weakPoWBoard.ProofOfWork = "MIM1:18::::ZVqtpNqdZXkNcSpc:290736:"
+	weakPoWBoard.ProofOfWork = "MIM1:18::::IYkuEXxhrgJRKVZK:199358:"
 
 	fakeSignedBoard.Fingerprint = "my random fingerprint"
 	fakeSignedBoard.Creation = 4564654
+	fakeSignedBoard.EntityVersion = 1
 	fakeSignedBoard.Name = "my board name"
 	fakeSignedBoard.Description = "my board description"
 	fakeSignedBoard.ProofOfWork = "MIM1:20::::xDQPQMOBXYIMCDvE:1912024:fake key"
@@ -122,6 +128,8 @@ func setup() {
 }
 
 func teardown() {
+	os.RemoveAll(globals.BackendConfig.GetUserDirectory())
+	os.RemoveAll(globals.BackendConfig.GetCachesDirectory())
 }
 
 func ValidateTest(expected interface{}, actual interface{}, t *testing.T) {
@@ -146,7 +154,7 @@ func TestVerifyPoW_Success_WithoutKey(t *testing.T) {
 }
 
 func TestVerifyPoW_Success_WithKey(t *testing.T) {
-	marshaledPubKey := "0400fa3aa273d67a3161069414879677f0ecf554a13edaa7d85387fe8fafaacea4beb1daf0284efa5f6ac0bc5181f3d20f748d53f79f3c1979cc58b7210dd7a161b0a4007bfb1315a6e78cd43855a4a40401fdc977a01c9f53616b52c92b16dc42fab04b84cc1788fbebc4f1449634cffe35e457680c432f28232d49aa8fc73fde8f374525"
+	marshaledPubKey := "da55b6d5c4bd12652d5bebd04ebef53d99060ed266f876930aa076281d58f4cf"
 	// fmt.Printf("%#v\n", signedNewboard)
 	result, err := signedNewboard.VerifyPoW(marshaledPubKey)
 	if err != nil {
@@ -214,6 +222,7 @@ func TestVerifyPoW_Fail_UnrecognisedPoWVersion(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM2:21::::QkaMjkJbvXInQLtW:1166891:"
@@ -230,6 +239,7 @@ func TestVerifyPoW_Fail_ParsedDifficultyIsNegative(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:-20::::QkaMjkJbvXInQLtW:1166891:"
@@ -246,6 +256,7 @@ func TestVerifyPoW_Fail_ParsedCounterIsNegative(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:20::::QkaMjkJbvXInQLtW:-1166891:"
@@ -262,6 +273,7 @@ func TestVerifyPoW_Fail_ParsingFailure(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:20::::QkaMjkJbvXInQLtW:a1166891:"
@@ -278,6 +290,7 @@ func TestVerifyPoW_Fail_DataInFieldsThatShouldBeEmpty(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:20:AA:AA:AA:QkaMjkJbvXInQLtW:a1166891:"
@@ -294,6 +307,7 @@ func TestVerifyPoW_Fail_NoDataInFieldsThatShouldBeNotEmpty(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1::::::a1166891:"
@@ -310,6 +324,7 @@ func TestVerifyPoW_Fail_PoWTooLong(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:20::::QkaMjkJbvXInQLtWAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA:1166891:"
@@ -326,6 +341,7 @@ func TestVerifyPoW_Fail_TooManyFields(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:20::::QkaMjkJbvXInQLtW:1166891::A:A:A"
@@ -342,6 +358,7 @@ func TestVerifyPoW_Fail_TooFeWFields(t *testing.T) {
 	var brokenVersionPoWBoard api.Board
 	brokenVersionPoWBoard.Fingerprint = "my random fingerprint"
 	brokenVersionPoWBoard.Creation = 4564654
+	brokenVersionPoWBoard.EntityVersion = 1
 	brokenVersionPoWBoard.Name = "my board name"
 	brokenVersionPoWBoard.Description = "my board description"
 	brokenVersionPoWBoard.ProofOfWork = "MIM1:20::::QkaMjkJbvXInQLtW:"
@@ -361,15 +378,16 @@ func TestVerifyPoW_Fail_TooFeWFields(t *testing.T) {
 func TestVerifyUpdatePoW_Success_WithoutKey(t *testing.T) {
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my description"
 	// newboard.CreatePoW(new(ed25519.PrivateKey), 20)
 	// fmt.Println(newboard.ProofOfWork)
-	newboard.ProofOfWork = "MIM1:20::::VcoyLilzglhVKYdG:687101:"
+	newboard.ProofOfWork = "MIM1:20::::DJtPnLvvcYeCiYyl:4939:"
 	newboard.Description = "my updated description"
 	// newboard.CreateUpdatePoW(new(ed25519.PrivateKey), 20)
 	// fmt.Println(newboard.UpdateProofOfWork)
-	newboard.UpdateProofOfWork = "MIM1:20::::fhUxvaQuQpePjkwr:3315783:"
+	newboard.UpdateProofOfWork = "MIM1:20::::xtGUBjWmwpeqqLZp:210321:"
 	result, err := newboard.VerifyPoW("")
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -380,7 +398,6 @@ func TestVerifyUpdatePoW_Success_WithoutKey(t *testing.T) {
 
 func TestVerifyUpdatePoW_Success_WithKey(t *testing.T) {
 
-	// marshaledPubKey := "0401c6166de14f9a2e698951591c9c68f2b93b3f3c9f60c93785d0464d88845bfa4b849954a7b93f7e9c4a5f40acb8c8f59ea2183069ca9cdb57b4e528aaa86ebd58ec01e164ae48f0be3d63ed69e309760337047aea8b3d37f2c08f53e50937a94c2d44b2182055f34c4cef0aa5eb653c0af32493c65d8c95c79170742b352acd7af1647c"
 	result, err := signedNewboardUpdated.VerifyPoW(signedNewBoardUpdatedPubkey)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
@@ -392,6 +409,7 @@ func TestVerifyUpdatePoW_Success_WithKey(t *testing.T) {
 func TestVerifyUpdatePoW_Fail_UpdatePoWInvalid(t *testing.T) {
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my updated description"
 	newboard.ProofOfWork = "MIM1:20::::pLBjxwHwpcHNVGBk:928329:"
@@ -411,6 +429,7 @@ func TestCreatePoW_Success_WithoutKey(t *testing.T) {
 	var newboard2 api.Board
 	newboard2.Fingerprint = "my random fingerprint2"
 	newboard2.Creation = 4564654
+	newboard2.EntityVersion = 1
 	newboard2.Name = "my board name"
 	newboard2.Description = "my board description2"
 	err := newboard2.CreatePoW(new(ed25519.PrivateKey), 20)
@@ -430,6 +449,7 @@ func TestCreatePoW_RunTwice_Success_WithoutKey(t *testing.T) {
 	var newboard2 api.Board
 	newboard2.Fingerprint = "my random fingerprint2"
 	newboard2.Creation = 4564654
+	newboard2.EntityVersion = 1
 	newboard2.Name = "my board name"
 	newboard2.Description = "my board description2"
 	err := newboard2.CreatePoW(new(ed25519.PrivateKey), 20)
@@ -451,6 +471,7 @@ func TestCreatePoW_SaltIsDifferent_Success(t *testing.T) {
 	var newboard2 api.Board
 	newboard2.Fingerprint = "my random fingerprint2"
 	newboard2.Creation = 4564654
+	newboard2.EntityVersion = 1
 	newboard2.Name = "my board name"
 	newboard2.Description = "my board description2"
 	err := newboard2.CreatePoW(new(ed25519.PrivateKey), 20)
@@ -471,13 +492,14 @@ func TestCreatePoW_Success_WithKey(t *testing.T) {
 	var signedNewboard api.Board
 	signedNewboard.Fingerprint = "my random fingerprint3"
 	signedNewboard.Creation = 4564654
+	signedNewboard.EntityVersion = 1
 	signedNewboard.Name = "my board name"
 	signedNewboard.Description = "my board description"
 	err := signedNewboard.CreatePoW(privKey, 20)
 	if err != nil {
 		t.Errorf("Test failed, err: '%s'", err)
 	} else {
-		marshaledPubKey := hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey.PublicKey.X, privKey.PublicKey.Y))
+		marshaledPubKey := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
 		result, err := signedNewboard.VerifyPoW(marshaledPubKey)
 		if err != nil {
 			t.Errorf("Test failed, err: '%s'", err)
@@ -492,9 +514,10 @@ func TestCreatePoW_Fail_TookTooLong(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint2"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description2"
-	// In the unlikely case that your test machine can create a 32 bit hash collision in less than 30 seconds, increase it to 36 or 40. If so, on a completely unrelated note: can I borrow your computer?
+	// In the unlikely case that your test machine can create a 32 bit hash collision in less than 10 seconds, increase it to 36 or 40. If so, on a completely unrelated note: can I borrow your computer?
 	err := newboard.CreatePoW(new(ed25519.PrivateKey), 32)
 	errMessage := "The timestamp took too long to create."
 	if err == nil {
@@ -512,6 +535,7 @@ func TestCreateUpdatePoW_Success_WithoutKey(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(new(ed25519.PrivateKey), 20)
@@ -541,6 +565,7 @@ func TestCreateUpdatePoW_Success_WithKey(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(privKey, 20)
@@ -553,7 +578,7 @@ func TestCreateUpdatePoW_Success_WithKey(t *testing.T) {
 			t.Errorf("Test failed, err: '%s'", err2)
 		} else {
 			// fmt.Printf("%#v\n", newboard)
-			marshaledPubKey := hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey.PublicKey.X, privKey.PublicKey.Y))
+			marshaledPubKey := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
 			// fmt.Printf("%#v\n", marshaledPubKey)
 			result, err3 := newboard.VerifyPoW(marshaledPubKey)
 			if err3 != nil {
@@ -578,6 +603,7 @@ func TestCreateUpdatePoW_SignedByTwoSeparateKeys_WithKey(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(privKey, 20)
@@ -589,7 +615,7 @@ func TestCreateUpdatePoW_SignedByTwoSeparateKeys_WithKey(t *testing.T) {
 		if err2 != nil {
 			t.Errorf("Test failed, err: '%s'", err2)
 		} else {
-			marshaledPubKey2 := hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey2.PublicKey.X, privKey2.PublicKey.Y))
+			marshaledPubKey2 := signaturing.MarshalPublicKey(privKey2.Public().(ed25519.PublicKey))
 			result, err3 := newboard.VerifyPoW(marshaledPubKey2)
 			if err3 != nil {
 				t.Errorf("Test failed, err: '%s'", err3)
@@ -604,6 +630,7 @@ func TestCreateUpdatePoW_RunTwice_Success_WithoutKey(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(new(ed25519.PrivateKey), 20)
@@ -639,6 +666,7 @@ func TestCreateUpdatePoW_RunTwice_Success_WithKey(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(privKey, 20)
@@ -655,7 +683,7 @@ func TestCreateUpdatePoW_RunTwice_Success_WithKey(t *testing.T) {
 			if err3 != nil {
 				t.Errorf("Test failed, err: '%s'", err3)
 			} else {
-				marshaledPubKey := hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey.PublicKey.X, privKey.PublicKey.Y))
+				marshaledPubKey := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
 				result, err4 := newboard.VerifyPoW(marshaledPubKey)
 				if err4 != nil {
 					t.Errorf("Test failed, err: '%s'", err4)
@@ -671,6 +699,7 @@ func TestCreateUpdatePoW_RunTwice_ForgotReUpdatePoW_Fail_WithoutKey(t *testing.T
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(new(ed25519.PrivateKey), 20)
@@ -703,6 +732,7 @@ func TestCreateUpdatePoW_RunTwice_ForgotReUpdatePoW_Fail_WithKey(t *testing.T) {
 	var newboard api.Board
 	newboard.Fingerprint = "my random fingerprint"
 	newboard.Creation = 4564654
+	newboard.EntityVersion = 1
 	newboard.Name = "my board name"
 	newboard.Description = "my board description"
 	err := newboard.CreatePoW(privKey, 20)
@@ -716,7 +746,7 @@ func TestCreateUpdatePoW_RunTwice_ForgotReUpdatePoW_Fail_WithKey(t *testing.T) {
 		} else {
 			newboard.Description = "I updated this board's description twice"
 			// (but I forgot to generated a new UpdatePoW)
-			marshaledPubKey := hex.EncodeToString(elliptic.Marshal(elliptic.P521(), privKey.PublicKey.X, privKey.PublicKey.Y))
+			marshaledPubKey := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
 			_, err4 := newboard.VerifyPoW(marshaledPubKey)
 			errMessage := "This proof of work is invalid or malformed."
 			if err4 == nil {
@@ -727,3 +757,66 @@ func TestCreateUpdatePoW_RunTwice_ForgotReUpdatePoW_Fail_WithKey(t *testing.T) {
 		}
 	}
 }
+
+// Tests for the controlled minting
+
+func TestCreateControlled_Parallel_Success(t *testing.T) {
+	priorThreads := globals.BackendConfig.GetPoWMintingThreads()
+	globals.BackendConfig.SetPoWMintingThreads(4)
+	defer globals.BackendConfig.SetPoWMintingThreads(priorThreads)
+	privKey, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("Key pair creation failed. Err: '%s'", err)
+	}
+	marshaledPubKey := signaturing.MarshalPublicKey(privKey.Public().(ed25519.PublicKey))
+	// Run a few, so that the counters found by the workers other than the first one are verified, too.
+	for i := 0; i < 4; i++ {
+		pow, err := proofofwork.CreateControlled("parallel minting input", 16, privKey, nil)
+		if err != nil {
+			t.Fatalf("Test failed, err: '%s'", err)
+		}
+		valid, strength, err := proofofwork.Verify("parallel minting input", pow, marshaledPubKey)
+		if err != nil {
+			t.Errorf("Test failed, err: '%s'", err)
+		} else if !valid || strength != 16 {
+			t.Errorf("This PoW should be valid at the strength it was minted at but it is not. PoW: %s, Strength: %v", pow, strength)
+		}
+	}
+}
+
+func TestCreateControlled_Cancel_Fail(t *testing.T) {
+	privKey, err := signaturing.CreateKeyPair()
+	if err != nil {
+		t.Fatalf("Key pair creation failed. Err: '%s'", err)
+	}
+	cancel := make(chan struct{})
+	var progress []proofofwork.MintProgress
+	ctl := &proofofwork.MintControl{
+		Cancel: cancel,
+		Progress: func(p proofofwork.MintProgress) {
+			// Cancel as soon as the first progress comes in. This is strong enough that it won't be found before that.
+			if len(progress) == 0 {
+				close(cancel)
+			}
+			progress = append(progress, p)
+		},
+	}
+	start := time.Now()
+	pow, err := proofofwork.CreateControlled("cancelled minting input", 64, privKey, ctl)
+	if err != proofofwork.ErrMintCancelled {
+		t.Errorf("The minting should have been cancelled. PoW: '%s', Err: '%v'", pow, err)
+	}
+	if pow != "" {
+		t.Errorf("A cancelled minting should not return a PoW. PoW: '%s'", pow)
+	}
+	// The bailout is 10 seconds, this should come back well before that.
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("The minting took too long to stop after being cancelled. Took: %v", elapsed)
+	}
+	if len(progress) != 1 {
+		t.Fatalf("The progress should have been reported once before the cancel. Reported: %v", len(progress))
+	}
+	if progress[0].Difficulty != 64 || progress[0].HashesTried <= 0 {
+		t.Errorf("The progress should have the difficulty and the hashes tried so far. Progress: %#v", progress[0])
+	}
+}
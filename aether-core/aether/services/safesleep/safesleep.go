@@ -4,6 +4,7 @@
 package safesleep
 
 import (
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
 	"errors"
@@ -28,7 +29,7 @@ func Sleep(dur time.Duration, terminator *bool) error {
 	var blocks int
 	if sec <= 5 {
 		// Sleep for the exact time if it's less than or exactly 10 seconds.
-		time.Sleep(dur)
+		clock.Sleep(dur)
 		if *terminator || *shutdownIndicator {
 			logging.Log(2, fmt.Sprintf("Sleep terminator was flipped true, so SafeSleep is exiting. Duration was: %s", dur))
 			return errors.New("Sleep terminator was flipped true. Please exit gracefully.")
@@ -39,7 +40,7 @@ func Sleep(dur time.Duration, terminator *bool) error {
 		blocks = sec / 1
 	}
 	for i := 0; i < blocks; i++ {
-		time.Sleep(time.Duration(1) * time.Second)
+		clock.Sleep(time.Duration(1) * time.Second)
 		if *terminator || *shutdownIndicator {
 			logging.Log(2, fmt.Sprintf("Sleep terminator was flipped true, SafeSleep is exiting. Duration was: %s", dur))
 			return errors.New("Sleep terminator was flipped true. Please exit gracefully.")
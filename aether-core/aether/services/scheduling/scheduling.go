@@ -7,6 +7,7 @@ import (
 	// "fmt"
 	// "github.com/davecgh/go-spew/spew"
 	// "aether-core/aether/services/globals"
+	"aether-core/aether/services/clock"
 	"aether-core/aether/services/logging"
 	"aether-core/aether/services/safesleep"
 	"fmt"
@@ -34,7 +35,7 @@ func ScheduleRepeat(inputFunction func(), interval time.Duration, initialDelay t
 			}
 			inputFunction()
 			select {
-			case <-time.After(interval):
+			case <-clock.After(interval):
 			case <-stopChan:
 				return
 			}
@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -142,10 +143,33 @@ func IndexOfInt(searchInt int, intSlice []int) int {
 // GetInsecureRand gets a random number within the given range.
 // WARNING: GetRand is NOT cryptographically secure! Do not use it within, as an input of, as a way to process the output of, any cryptographic process.
 func GetInsecureRand(max int) int {
+	seededRandLock.Lock()
+	defer seededRandLock.Unlock()
+	if seededRand != nil {
+		return seededRand.Intn(max)
+	}
 	rand.Seed(time.Now().UnixNano())
 	return rand.Intn(max)
 }
 
+var (
+	seededRandLock sync.Mutex
+	seededRand     *rand.Rand
+)
+
+// SeedInsecureRand makes GetInsecureRand return the same numbers in the same order for the same seed, so that the tests using it can be reproduced. The app itself never calls this. UnseedInsecureRand goes back to the time-seeded numbers.
+func SeedInsecureRand(seed int64) {
+	seededRandLock.Lock()
+	defer seededRandLock.Unlock()
+	seededRand = rand.New(rand.NewSource(seed))
+}
+
+func UnseedInsecureRand() {
+	seededRandLock.Lock()
+	defer seededRandLock.Unlock()
+	seededRand = nil
+}
+
 func GetInsecureRands(max, count int) []int {
 	if max < count {
 		max = count
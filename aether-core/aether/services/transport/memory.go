@@ -0,0 +1,129 @@
+// Services > Transport > Memory
+// This file implements the in-memory transport, which connects nodes in the same process without touching the network.
+
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+const memoryPrefix = "memory:"
+
+// MemoryTransport carries the locations of the form "memory:<name>" over in-memory pipes. The listeners live in the transport, so nodes can only reach each other through the same MemoryTransport.
+type MemoryTransport struct {
+	lock      sync.Mutex
+	prefix    string // The simulated network reuses this with its own prefix.
+	listeners map[string]*memoryListener
+	dialed    uint64 // How many connections were dialed. This numbers the dialing ends.
+}
+
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{prefix: memoryPrefix, listeners: make(map[string]*memoryListener)}
+}
+
+func memoryKey(location string, port uint16) string {
+	return fmt.Sprint(location, ":", port)
+}
+
+func (m *MemoryTransport) Claims(location string) bool {
+	return strings.HasPrefix(location, m.prefix)
+}
+
+func (m *MemoryTransport) Dial(location string, port uint16) (net.Conn, error) {
+	m.lock.Lock()
+	l, exists := m.listeners[memoryKey(location, port)]
+	m.lock.Unlock()
+	if !exists {
+		return nil, errors.New(fmt.Sprintf("connection refused. Nothing is listening at this in-memory location. Location: %v, Port: %v", location, port))
+	}
+	serverEnd, clientEnd := m.pipe(l.addr)
+	select {
+	case l.conns <- serverEnd:
+		return clientEnd, nil
+	case <-l.closed:
+		serverEnd.Close()
+		clientEnd.Close()
+		return nil, errors.New(fmt.Sprintf("connection refused. The in-memory listener at this location is closed. Location: %v, Port: %v", location, port))
+	}
+}
+
+func (m *MemoryTransport) Listen(location string, port uint16) (net.Listener, error) {
+	if !m.Claims(location) {
+		return nil, errors.New(fmt.Sprintf("This location is not an in-memory location. Location: %v", location))
+	}
+	key := memoryKey(location, port)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, exists := m.listeners[key]; exists {
+		return nil, errors.New(fmt.Sprintf("address already in use. Location: %v, Port: %v", location, port))
+	}
+	l := &memoryListener{
+		addr:   memoryAddr(key),
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+		remove: func() {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+			delete(m.listeners, key)
+		},
+	}
+	m.listeners[key] = l
+	return l, nil
+}
+
+// pipe returns the two ends of a new connection to the listener at the address. The dialing end gets an address of its own, the way a TCP connection gets an ephemeral port, so that the listening side can tell its connections apart. The server uses this to tell a reverse connection from the others.
+func (m *MemoryTransport) pipe(listenerAddr memoryAddr) (net.Conn, net.Conn) {
+	m.lock.Lock()
+	m.dialed++
+	dialerAddr := memoryAddr(fmt.Sprint(strings.TrimSuffix(m.prefix, ":"), "-dialer-", m.dialed, ":", m.dialed%65535+1))
+	m.lock.Unlock()
+	serverEnd, clientEnd := net.Pipe()
+	return &memoryConn{Conn: serverEnd, local: listenerAddr, remote: dialerAddr}, &memoryConn{Conn: clientEnd, local: dialerAddr, remote: listenerAddr}
+}
+
+// memoryConn is one end of an in-memory connection. The ends of a bare net.Pipe have no addresses.
+type memoryConn struct {
+	net.Conn
+	local, remote memoryAddr
+}
+
+func (c *memoryConn) LocalAddr() net.Addr  { return c.local }
+func (c *memoryConn) RemoteAddr() net.Addr { return c.remote }
+
+type memoryAddr string
+
+func (a memoryAddr) Network() string { return "memory" }
+func (a memoryAddr) String() string  { return string(a) }
+
+type memoryListener struct {
+	addr      memoryAddr
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+	remove    func()
+}
+
+func (l *memoryListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, errors.New("use of closed network connection")
+	}
+}
+
+func (l *memoryListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		l.remove()
+	})
+	return nil
+}
+
+func (l *memoryListener) Addr() net.Addr {
+	return l.addr
+}
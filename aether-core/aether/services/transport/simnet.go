@@ -0,0 +1,118 @@
+// Services > Transport > SimNet
+// This file implements the simulated network, an in-memory transport with the latency, the loss, and the NATs of a real one, so that the tests can see how the node deals with them.
+
+package transport
+
+import (
+	"aether-core/aether/services/clock"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+)
+
+/*
+How this works:
+
+The simulated network carries the locations of the form "sim:<name>". The connections are in-memory pipes, the same as the in-memory transport, with the conditions of the node at the receiving end applied:
+
+- Latency: Every dial, and every write after, waits this long. The wait is on the clock package, so under a virtual clock it takes no real time, and the timestamps the node records still come out as if it did.
+- Loss: The chance (0 to 1) that a dial to the node is lost. A lost dial is a timeout, same as a real one. The chances are drawn from the seed of the network, so the same seed loses the same dials in the same order.
+- NAT: The node can dial out, but it can't be dialed. It can only be reached over a reverse connection it opened itself.
+
+To use it, a test registers a network of its own (transport.Register(transport.LocationTypeSim, net)), and gives its nodes sim locations.
+*/
+
+const simPrefix = "sim:"
+
+type LinkConditions struct {
+	Latency time.Duration
+	Loss    float64
+	NAT     bool
+}
+
+type SimNetwork struct {
+	lock       sync.Mutex
+	mem        *MemoryTransport
+	rnd        *rand.Rand
+	defaults   LinkConditions
+	conditions map[string]LinkConditions
+}
+
+func NewSimNetwork(seed int64) *SimNetwork {
+	return &SimNetwork{
+		mem:        &MemoryTransport{prefix: simPrefix, listeners: make(map[string]*memoryListener)},
+		rnd:        rand.New(rand.NewSource(seed)),
+		conditions: make(map[string]LinkConditions),
+	}
+}
+
+// SetDefaults sets the conditions of the nodes that don't have their own.
+func (s *SimNetwork) SetDefaults(lc LinkConditions) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.defaults = lc
+}
+
+// SetConditions sets the conditions of reaching the node at the location.
+func (s *SimNetwork) SetConditions(location string, lc LinkConditions) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.conditions[location] = lc
+}
+
+func (s *SimNetwork) Claims(location string) bool {
+	return s.mem.Claims(location)
+}
+
+func (s *SimNetwork) Dial(location string, port uint16) (net.Conn, error) {
+	s.lock.Lock()
+	lc, exists := s.conditions[location]
+	if !exists {
+		lc = s.defaults
+	}
+	lost := lc.Loss > 0 && s.rnd.Float64() < lc.Loss
+	s.lock.Unlock()
+	if lc.NAT {
+		return nil, &simError{msg: fmt.Sprintf("dial %s:%d: i/o timeout. The node is behind a NAT.", location, port), timeout: true}
+	}
+	if lost {
+		return nil, &simError{msg: fmt.Sprintf("dial %s:%d: i/o timeout. The dial was lost.", location, port), timeout: true}
+	}
+	clock.Sleep(lc.Latency)
+	conn, err := s.mem.Dial(location, port)
+	if err != nil {
+		return nil, err
+	}
+	return &simConn{Conn: conn, latency: lc.Latency}, nil
+}
+
+func (s *SimNetwork) Listen(location string, port uint16) (net.Listener, error) {
+	if !s.Claims(location) {
+		return nil, errors.New(fmt.Sprintf("This location is not a simulated location. Location: %v", location))
+	}
+	return s.mem.Listen(location, port)
+}
+
+// simConn delays the writes of the dialing end by the latency of the link.
+type simConn struct {
+	net.Conn
+	latency time.Duration
+}
+
+func (c *simConn) Write(b []byte) (int, error) {
+	clock.Sleep(c.latency)
+	return c.Conn.Write(b)
+}
+
+// simError is a net.Error, so that the callers can tell the timeouts apart the same way they do for the real network.
+type simError struct {
+	msg     string
+	timeout bool
+}
+
+func (e *simError) Error() string   { return e.msg }
+func (e *simError) Timeout() bool   { return e.timeout }
+func (e *simError) Temporary() bool { return e.timeout }
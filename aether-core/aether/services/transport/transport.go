@@ -0,0 +1,157 @@
+// Services > Transport
+// This package is the layer the Mim protocol runs on. Fetch dials remotes through it, and the Mim server listens through it, so that the connections can be something other than TCP.
+
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+/*
+How this works:
+
+Every transport carries the addresses of one or more location types. The location type is the LocationType field of an address: 4 for IPv4, 6 for IPv6 and 3 for URLs are carried by TCP, which the api package registers, since it owns the dialer, the proxy and the timeouts. The transports in this package get a location type each, and their locations are marked with a prefix, so that a location alone is enough to tell which transport it belongs to:
+
+- Unix sockets (7): "unix:<directory>". The node listening on port 51000 in that directory is at <directory>/mim-51000.sock. This is useful for swarms on a single machine, since it needs no ports.
+- In-memory (8): "memory:<name>". Both ends have to be in the same process. This is useful for tests that need to be deterministic.
+- Simulated (9): "sim:<name>". In-memory as well, but over a simulated network with latency, loss and NAT. See simnet.go.
+
+HTTP(S) runs on top of whatever the transport gives out, so the transports only deal with connections, not requests.
+
+Only TCP is carried by default. The transports in this package are registered when the config (the external IP type of the node) or a test enables them, so a production node doesn't dial the Unix sockets or the in-process listeners that the addresses it hears from the network point at. The prefixes are recognised regardless, so that those addresses can be told apart and dropped.
+*/
+
+// Location types. These are the values of the LocationType field of an address.
+const (
+	LocationTypeURL    = 3
+	LocationTypeIPv4   = 4
+	LocationTypeIPv6   = 6
+	LocationTypeUnix   = 7
+	LocationTypeMemory = 8
+	LocationTypeSim    = 9
+)
+
+// Transport carries the connections of the Mim protocol.
+type Transport interface {
+	// Claims returns whether the location is one this transport carries.
+	Claims(location string) bool
+	// Dial opens a connection to the node at the location and port.
+	Dial(location string, port uint16) (net.Conn, error)
+	// Listen opens a listener for this node at the location and port.
+	Listen(location string, port uint16) (net.Listener, error)
+}
+
+// local are the transports of this package, by the location type they carry.
+var local = map[uint8]struct {
+	prefix string
+	create func() Transport
+}{
+	LocationTypeUnix:   {unixPrefix, func() Transport { return &UnixTransport{} }},
+	LocationTypeMemory: {memoryPrefix, func() Transport { return NewMemoryTransport() }},
+	LocationTypeSim:    {simPrefix, func() Transport { return NewSimNetwork(0) }},
+}
+
+var (
+	registryLock sync.Mutex
+	registry     = make(map[uint8]Transport)
+	order        = []uint8{}
+)
+
+// Register makes the transport the carrier of the given location type. Registering a location type again replaces the transport it had.
+func Register(locationType uint8, t Transport) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, exists := registry[locationType]; !exists {
+		order = append(order, locationType)
+	}
+	registry[locationType] = t
+}
+
+// EnableLocal registers the transport of this package that carries the location type. If the location type already has a transport, that one is kept, since it's likely a test that registered it with its own conditions.
+func EnableLocal(locationType uint8) error {
+	l, exists := local[locationType]
+	if !exists {
+		return errors.New(fmt.Sprintf("There is no transport in this package for this location type. Location type: %v", locationType))
+	}
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, registered := registry[locationType]; registered {
+		return nil
+	}
+	order = append(order, locationType)
+	registry[locationType] = l.create()
+	return nil
+}
+
+// LocalTypeOf returns the location type of the location if it is one of the transports of this package, whether it is enabled or not.
+func LocalTypeOf(location string) (uint8, bool) {
+	for lt, l := range local {
+		if strings.HasPrefix(location, l.prefix) {
+			return lt, true
+		}
+	}
+	return 0, false
+}
+
+// Carried returns whether this node can reach the location. TCP locations always are, the others only if their transport is registered.
+func Carried(location string) bool {
+	lt := LocationTypeOf(location)
+	if IsTCP(lt) {
+		return true
+	}
+	_, err := ForType(lt)
+	return err == nil
+}
+
+// IsTCP returns whether the location type is one of the TCP ones.
+func IsTCP(locationType uint8) bool {
+	return locationType == LocationTypeURL || locationType == LocationTypeIPv4 || locationType == LocationTypeIPv6
+}
+
+// ForType returns the transport of the location type.
+func ForType(locationType uint8) (Transport, error) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	t, exists := registry[locationType]
+	if !exists {
+		return nil, errors.New(fmt.Sprintf("There is no transport registered for this location type. Location type: %v", locationType))
+	}
+	return t, nil
+}
+
+// For returns the location type of the location and the transport that carries it. The transports other than TCP are asked first, since TCP takes every location no other transport claims.
+func For(location string) (uint8, Transport, error) {
+	lt := LocationTypeOf(location)
+	t, err := ForType(lt)
+	return lt, t, err
+}
+
+// LocationTypeOf returns the location type of the location.
+func LocationTypeOf(location string) uint8 {
+	if lt, isLocal := LocalTypeOf(location); isLocal {
+		return lt
+	}
+	registryLock.Lock()
+	for _, lt := range order {
+		if IsTCP(lt) {
+			continue
+		}
+		if registry[lt].Claims(location) {
+			registryLock.Unlock()
+			return lt
+		}
+	}
+	registryLock.Unlock()
+	ip := net.ParseIP(location)
+	if ip == nil {
+		return LocationTypeURL
+	}
+	if ip.To4() == nil {
+		return LocationTypeIPv6
+	}
+	return LocationTypeIPv4
+}
@@ -0,0 +1,171 @@
+package transport_test
+
+import (
+	"aether-core/aether/services/clock"
+	"aether-core/aether/services/transport"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+// Infrastructure
+
+func serveAndFetch(t *testing.T, tr transport.Transport, location string, port uint16) string {
+	l, err := tr.Listen(location, port)
+	if err != nil {
+		t.Fatalf("Listen failed. Err: '%s'", err)
+	}
+	defer l.Close()
+	go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("mim"))
+	}))
+	client := &http.Client{Transport: &http.Transport{
+		Dial: func(network, address string) (net.Conn, error) {
+			return tr.Dial(location, port)
+		},
+		DisableKeepAlives: true,
+	}}
+	resp, err2 := client.Get("http://localhost/v0/status")
+	if err2 != nil {
+		t.Fatalf("Request failed. Err: '%s'", err2)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	return string(body)
+}
+
+// Tests
+
+func TestMemoryTransport_Success(t *testing.T) {
+	tr := transport.NewMemoryTransport()
+	if body := serveAndFetch(t, tr, "memory:node1", 51000); body != "mim" {
+		t.Errorf("Unexpected response over the in-memory transport. Body: '%s'", body)
+	}
+}
+
+func TestMemoryTransport_NotListening_Fail(t *testing.T) {
+	tr := transport.NewMemoryTransport()
+	_, err := tr.Dial("memory:nobody", 51000)
+	if err == nil {
+		t.Errorf("Dial to a location nothing listens at succeeded.")
+	}
+}
+
+func TestUnixTransport_Success(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mim-transport-test")
+	if err != nil {
+		t.Fatalf("Temp dir creation failed. Err: '%s'", err)
+	}
+	defer os.RemoveAll(dir)
+	tr := &transport.UnixTransport{}
+	if body := serveAndFetch(t, tr, "unix:"+dir, 51000); body != "mim" {
+		t.Errorf("Unexpected response over the Unix socket transport. Body: '%s'", body)
+	}
+}
+
+func TestLocationTypeOf_Success(t *testing.T) {
+	cases := map[string]uint8{
+		"127.0.0.1":     transport.LocationTypeIPv4,
+		"::1":           transport.LocationTypeIPv6,
+		"getaether.net": transport.LocationTypeURL,
+		"unix:/tmp/mim": transport.LocationTypeUnix,
+		"memory:node1":  transport.LocationTypeMemory,
+		"sim:node1":     transport.LocationTypeSim,
+	}
+	for loc, expected := range cases {
+		if lt := transport.LocationTypeOf(loc); lt != expected {
+			t.Errorf("Wrong location type. Location: '%s', Expected: %v, Got: %v", loc, expected, lt)
+		}
+	}
+}
+
+func TestCarried_EnableLocal_Success(t *testing.T) {
+	if !transport.Carried("getaether.net") || !transport.Carried("127.0.0.1") {
+		t.Errorf("TCP locations should always be carried.")
+	}
+	// Nothing in this test package enables the Unix sockets or the in-memory transport, so neither should be carried until they're enabled.
+	if transport.Carried("unix:/tmp/mim") || transport.Carried("memory:node1") {
+		t.Errorf("The transports other than TCP should not be carried by default.")
+	}
+	if err := transport.EnableLocal(transport.LocationTypeUnix); err != nil {
+		t.Fatalf("Enabling the Unix socket transport failed. Err: '%s'", err)
+	}
+	if !transport.Carried("unix:/tmp/mim") {
+		t.Errorf("The Unix socket transport should be carried once it's enabled.")
+	}
+	if transport.Carried("memory:node1") {
+		t.Errorf("Enabling one transport should not enable the others.")
+	}
+	if err := transport.EnableLocal(transport.LocationTypeURL); err == nil {
+		t.Errorf("TCP is not a transport of this package, enabling it should fail.")
+	}
+}
+
+func TestSimNetwork_Latency_Success(t *testing.T) {
+	v := clock.NewAutoVirtual(time.Unix(1500000000, 0))
+	defer clock.Use(v)()
+	tr := transport.NewSimNetwork(1)
+	tr.SetConditions("sim:node1", transport.LinkConditions{Latency: 100 * time.Millisecond})
+	if body := serveAndFetch(t, tr, "sim:node1", 51000); body != "mim" {
+		t.Errorf("Unexpected response over the simulated network. Body: '%s'", body)
+	}
+	// One for the dial, at least one for the request.
+	if elapsed := v.Now().Sub(time.Unix(1500000000, 0)); elapsed < 200*time.Millisecond {
+		t.Errorf("The latency should have passed on the clock. Elapsed: %v", elapsed)
+	}
+}
+
+func TestSimNetwork_NAT_Fail(t *testing.T) {
+	tr := transport.NewSimNetwork(1)
+	tr.SetConditions("sim:node1", transport.LinkConditions{NAT: true})
+	l, err := tr.Listen("sim:node1", 51000)
+	if err != nil {
+		t.Fatalf("Listen failed. Err: '%s'", err)
+	}
+	defer l.Close()
+	_, err2 := tr.Dial("sim:node1", 51000)
+	if nerr, ok := err2.(net.Error); !ok || !nerr.Timeout() {
+		t.Errorf("A dial to a node behind a NAT should time out. Err: '%v'", err2)
+	}
+}
+
+func TestSimNetwork_LossIsSeeded_Success(t *testing.T) {
+	outcomes := func(seed int64) string {
+		tr := transport.NewSimNetwork(seed)
+		tr.SetDefaults(transport.LinkConditions{Loss: 0.5})
+		l, err := tr.Listen("sim:node1", 51000)
+		if err != nil {
+			t.Fatalf("Listen failed. Err: '%s'", err)
+		}
+		defer l.Close()
+		go func() {
+			for {
+				c, err := l.Accept()
+				if err != nil {
+					return
+				}
+				c.Close()
+			}
+		}()
+		result := ""
+		for i := 0; i < 32; i++ {
+			if c, err := tr.Dial("sim:node1", 51000); err != nil {
+				result += "x"
+			} else {
+				c.Close()
+				result += "."
+			}
+		}
+		return result
+	}
+	first, second := outcomes(42), outcomes(42)
+	if first != second {
+		t.Errorf("The same seed should lose the same dials. First: %s, Second: %s", first, second)
+	}
+	if first == outcomes(43) {
+		t.Errorf("Another seed should lose other dials. Both: %s", first)
+	}
+}
@@ -0,0 +1,52 @@
+// Services > Transport > Unix
+// This file implements the Unix socket transport.
+
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const unixPrefix = "unix:"
+
+// UnixTransport carries the locations of the form "unix:<directory>" over Unix sockets in that directory.
+type UnixTransport struct{}
+
+func (u *UnixTransport) Claims(location string) bool {
+	return strings.HasPrefix(location, unixPrefix)
+}
+
+// SocketPath returns the path of the socket of the node at the location and port.
+func (u *UnixTransport) SocketPath(location string, port uint16) (string, error) {
+	if !u.Claims(location) {
+		return "", errors.New(fmt.Sprintf("This location is not a Unix socket location. Location: %v", location))
+	}
+	dir := strings.TrimPrefix(location, unixPrefix)
+	if len(dir) == 0 {
+		return "", errors.New(fmt.Sprintf("This Unix socket location has no directory. Location: %v", location))
+	}
+	return filepath.Join(dir, fmt.Sprintf("mim-%d.sock", port)), nil
+}
+
+func (u *UnixTransport) Dial(location string, port uint16) (net.Conn, error) {
+	path, err := u.SocketPath(location, port)
+	if err != nil {
+		return nil, err
+	}
+	return net.Dial("unix", path)
+}
+
+func (u *UnixTransport) Listen(location string, port uint16) (net.Listener, error) {
+	path, err := u.SocketPath(location, port)
+	if err != nil {
+		return nil, err
+	}
+	// A socket file left behind by a node that didn't shut down cleanly would make the listen fail, so we remove it first. If there is a node still listening on it, it won't receive any more connections, but two nodes shouldn't have the same location and port to begin with.
+	os.Remove(path)
+	return net.Listen("unix", path)
+}
@@ -7,6 +7,7 @@ import (
 	"aether-core/aether/backend/feapiconsumer"
 	"aether-core/aether/services/globals"
 	"aether-core/aether/services/logging"
+	"aether-core/aether/services/transport"
 	"fmt"
 	extUpnp "github.com/NebulousLabs/go-upnp"
 	// "time"
@@ -16,6 +17,11 @@ import (
 // var err error
 
 func MapPort() {
+	if !transport.IsTCP(globals.BackendConfig.GetExternalIpType()) {
+		// There is no router between the nodes of a Unix socket or in-memory swarm, and asking one for our external IP would overwrite the location of the transport.
+		feapiconsumer.BackendAmbientStatus.UPNPStatus = "Not needed, not on TCP"
+		return
+	}
 	feapiconsumer.BackendAmbientStatus.UPNPStatus = "In progress"
 	feapiconsumer.SendBackendAmbientStatus()       // send first state
 	defer feapiconsumer.SendBackendAmbientStatus() // send end state